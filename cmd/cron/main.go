@@ -2,14 +2,17 @@ package main
 
 import (
 	"context"
+	"errors"
 	"log/slog"
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
 
 	"github.com/fikryfahrezy/let-it-go/config"
 	"github.com/fikryfahrezy/let-it-go/pkg/database"
 	"github.com/fikryfahrezy/let-it-go/pkg/http_server"
+	"github.com/fikryfahrezy/let-it-go/pkg/lockout"
 	"github.com/fikryfahrezy/let-it-go/pkg/logger"
 	"github.com/go-co-op/gocron/v2"
 
@@ -60,6 +63,98 @@ func sampleTask(log *slog.Logger, userSrv userService.UserService, blogSrv blogS
 	}
 }
 
+func purgeExpiredSessions(log *slog.Logger, userSrv userService.UserService) func() {
+	return func() {
+		ctx := context.Background()
+		log.Info("Running purge expired sessions")
+		deleted, err := userSrv.PurgeExpiredSessions(ctx)
+		if err != nil {
+			log.Error("Failed to purge expired sessions",
+				slog.String("error", err.Error()),
+			)
+			return
+		}
+		log.Info("Purged expired sessions", slog.Int64("count", deleted))
+	}
+}
+
+func purgeLoginAttempts(log *slog.Logger, userSrv userService.UserService) func() {
+	return func() {
+		ctx := context.Background()
+		log.Info("Running purge login attempts")
+		deleted, err := userSrv.PurgeLoginAttempts(ctx)
+		if err != nil {
+			log.Error("Failed to purge login attempts",
+				slog.String("error", err.Error()),
+			)
+			return
+		}
+		log.Info("Purged login attempts", slog.Int64("count", deleted))
+	}
+}
+
+func purgeOIDCLoginRequests(log *slog.Logger, userSrv userService.UserService) func() {
+	return func() {
+		ctx := context.Background()
+		log.Info("Running purge OIDC login requests")
+		deleted, err := userSrv.PurgeExpiredOIDCLoginRequests(ctx)
+		if err != nil {
+			log.Error("Failed to purge OIDC login requests",
+				slog.String("error", err.Error()),
+			)
+			return
+		}
+		log.Info("Purged OIDC login requests", slog.Int64("count", deleted))
+	}
+}
+
+func eraseDeletedUsers(log *slog.Logger, userSrv userService.UserService) func() {
+	return func() {
+		ctx := context.Background()
+		log.Info("Running erase deleted users")
+		erased, err := userSrv.EraseDeletedUsers(ctx)
+		if err != nil {
+			log.Error("Failed to erase deleted users",
+				slog.String("error", err.Error()),
+			)
+			return
+		}
+		log.Info("Erased deleted users", slog.Int64("count", erased))
+	}
+}
+
+func publishScheduledBlogs(log *slog.Logger, blogSrv blogService.BlogService) func() {
+	return func() {
+		// The job has no caller, so it is marked as the one allowed to publish due blogs
+		ctx := blogService.ContextForScheduledPublishing(context.Background())
+		log.Info("Running publish scheduled blogs")
+		blogs, err := blogSrv.GetDueScheduledBlogs(ctx, time.Now())
+		if err != nil {
+			log.Error("Failed to fetch due scheduled blogs",
+				slog.String("error", err.Error()),
+			)
+			return
+		}
+
+		published := 0
+		for _, blog := range blogs {
+			if _, err := blogSrv.PublishBlog(ctx, blog.ID); err != nil {
+				// An overlapping run or an editor has already published it
+				if errors.Is(err, blogRepository.ErrBlogNotScheduled) {
+					continue
+				}
+				log.Error("Failed to publish scheduled blog",
+					slog.String("blog_id", blog.ID.String()),
+					slog.String("error", err.Error()),
+				)
+				continue
+			}
+			published++
+		}
+		log.Info("Published scheduled blogs", slog.Int("count", published))
+	}
+}
+
 func main() {
 	cfg := config.Load()
 
@@ -82,11 +177,31 @@ func main() {
 		os.Exit(1)
 	}
 
-	userRepo := userRepository.NewUserRepository(log, db)
-	userService := userService.NewUserService(log, userRepo)
+	limiter, err := lockout.New(cfg.Lockout, db)
+	if err != nil {
+		log.Error("Failed to initialize login limiter",
+			slog.String("error", err.Error()),
+		)
+		os.Exit(1)
+	}
 
+	userRepo := userRepository.NewUserRepository(log, db)
 	blogRepo := blogRepository.NewBlogRepository(log, db)
-	blogService := blogService.NewBlogService(log, blogRepo)
+	// Cron jobs never issue access tokens, send mail, check second factors or talk to the
+	// identity provider, so none of those is needed
+	userService := userService.NewUserService(log, userRepo, userService.Dependencies{
+		Limiter:    limiter,
+		BlogReader: blogRepo,
+	}, userService.Config{
+		DeletionGracePeriod: cfg.Auth.DeletionGracePeriod,
+	})
+
+	blogService := blogService.NewBlogService(log, blogRepo, blogService.Dependencies{
+		AuthorVerifier:    userService,
+		AuthorPreferences: userService,
+	}, blogService.Config{
+		RequireVerifiedEmail: cfg.Auth.RequireVerifiedEmail,
+	})
 
 	jobs := []Job{
 		{
@@ -94,6 +209,31 @@ func main() {
 			crontab: cfg.Crontab["sample_task"],
 			task:    sampleTask(log, userService, blogService),
 		},
+		{
+			name:    "purge_expired_sessions",
+			crontab: cfg.Crontab["purge_expired_sessions"],
+			task:    purgeExpiredSessions(log, userService),
+		},
+		{
+			name:    "purge_login_attempts",
+			crontab: cfg.Crontab["purge_login_attempts"],
+			task:    purgeLoginAttempts(log, userService),
+		},
+		{
+			name:    "purge_oidc_login_requests",
+			crontab: cfg.Crontab["purge_oidc_login_requests"],
+			task:    purgeOIDCLoginRequests(log, userService),
+		},
+		{
+			name:    "erase_deleted_users",
+			crontab: cfg.Crontab["erase_deleted_users"],
+			task:    eraseDeletedUsers(log, userService),
+		},
+		{
+			name:    "publish_scheduled_blogs",
+			crontab: cfg.Crontab["publish_scheduled_blogs"],
+			task:    publishScheduledBlogs(log, blogService),
+		},
 	}
 
 	for _, job := range jobs {
@@ -4,6 +4,10 @@
 // @host localhost:8080
 // @BasePath /api
 // @schemes http https
+// @securityDefinitions.apikey BearerAuth
+// @in header
+// @name Authorization
+// @description Type "Bearer" followed by a space and the access token
 package main
 
 import (
@@ -19,13 +23,24 @@ import (
 	blogHandler "github.com/fikryfahrezy/let-it-go/feature/blog/handler"
 	blogRepository "github.com/fikryfahrezy/let-it-go/feature/blog/repository"
 	blogService "github.com/fikryfahrezy/let-it-go/feature/blog/service"
+	commentHandler "github.com/fikryfahrezy/let-it-go/feature/comment/handler"
+	commentRepository "github.com/fikryfahrezy/let-it-go/feature/comment/repository"
+	commentService "github.com/fikryfahrezy/let-it-go/feature/comment/service"
 	healthHandler "github.com/fikryfahrezy/let-it-go/feature/health/handler"
+	invitationHandler "github.com/fikryfahrezy/let-it-go/feature/invitation/handler"
+	invitationRepository "github.com/fikryfahrezy/let-it-go/feature/invitation/repository"
+	invitationService "github.com/fikryfahrezy/let-it-go/feature/invitation/service"
 	userHandler "github.com/fikryfahrezy/let-it-go/feature/user/handler"
 	userRepository "github.com/fikryfahrezy/let-it-go/feature/user/repository"
 	userService "github.com/fikryfahrezy/let-it-go/feature/user/service"
 	"github.com/fikryfahrezy/let-it-go/pkg/database"
+	"github.com/fikryfahrezy/let-it-go/pkg/encryption"
 	server "github.com/fikryfahrezy/let-it-go/pkg/http_server"
+	"github.com/fikryfahrezy/let-it-go/pkg/lockout"
 	"github.com/fikryfahrezy/let-it-go/pkg/logger"
+	"github.com/fikryfahrezy/let-it-go/pkg/mailer"
+	"github.com/fikryfahrezy/let-it-go/pkg/oidc"
+	"github.com/fikryfahrezy/let-it-go/pkg/token"
 )
 
 var (
@@ -55,6 +70,54 @@ func main() {
 		os.Exit(1)
 	}
 
+	tokenManager, err := token.NewManager(cfg.Token)
+	if err != nil {
+		log.Error("Failed to initialize token manager",
+			slog.String("error", err.Error()),
+		)
+		os.Exit(1)
+	}
+
+	mail, err := mailer.New(cfg.Mailer)
+	if err != nil {
+		log.Error("Failed to initialize mailer",
+			slog.String("error", err.Error()),
+		)
+		os.Exit(1)
+	}
+
+	limiter, err := lockout.New(cfg.Lockout, db)
+	if err != nil {
+		log.Error("Failed to initialize login limiter",
+			slog.String("error", err.Error()),
+		)
+		os.Exit(1)
+	}
+
+	// Two factor authentication stays unavailable until an encryption key is configured
+	var twoFactorCipher *encryption.Cipher
+	if cfg.Auth.TwoFactorEncryptionKey != "" {
+		twoFactorCipher, err = encryption.NewCipher(cfg.Auth.TwoFactorEncryptionKey)
+		if err != nil {
+			log.Error("Failed to initialize two factor encryption",
+				slog.String("error", err.Error()),
+			)
+			os.Exit(1)
+		}
+	}
+
+	// OpenID Connect login stays unavailable until a provider is configured
+	var oidcProvider *oidc.Provider
+	if cfg.OIDC.IssuerURL != "" {
+		oidcProvider, err = oidc.NewProvider(cfg.OIDC, nil)
+		if err != nil {
+			log.Error("Failed to initialize OpenID Connect provider",
+				slog.String("error", err.Error()),
+			)
+			os.Exit(1)
+		}
+	}
+
 	// Create server configuration
 	serverConfig := server.Config{
 		Host: cfg.Server.Host,
@@ -63,24 +126,78 @@ func main() {
 
 	// Initialize feature dependencies
 	userRepo := userRepository.NewUserRepository(log, db)
-	userService := userService.NewUserService(log, userRepo)
+	blogRepo := blogRepository.NewBlogRepository(log, db)
+	commentRepo := commentRepository.NewCommentRepository(log, db)
+	userService := userService.NewUserService(log, userRepo, userService.Dependencies{
+		TokenManager: tokenManager,
+		Mailer:       mail,
+		Limiter:      limiter,
+		Cipher:       twoFactorCipher,
+		OIDCProvider: oidcProvider,
+		BlogReader:   blogRepo,
+	}, userService.Config{
+		PasswordResetTTL:     cfg.Auth.PasswordResetTTL,
+		PasswordResetURL:     cfg.Auth.PasswordResetURL,
+		EmailVerificationTTL: cfg.Auth.EmailVerificationTTL,
+		EmailVerificationURL: cfg.Auth.EmailVerificationURL,
+		BcryptCost:           cfg.Auth.BcryptCost,
+		PasswordPolicy: userService.PasswordPolicy{
+			MinLength:     cfg.Auth.PasswordMinLength,
+			RequireUpper:  cfg.Auth.PasswordRequireUpper,
+			RequireLower:  cfg.Auth.PasswordRequireLower,
+			RequireDigit:  cfg.Auth.PasswordRequireDigit,
+			RequireSymbol: cfg.Auth.PasswordRequireSymbol,
+		},
+		TwoFactor: userService.TwoFactorConfig{
+			Issuer:        cfg.Auth.TwoFactorIssuer,
+			ChallengeTTL:  cfg.Auth.TwoFactorChallengeTTL,
+			RequiredRoles: cfg.Auth.TwoFactorRequiredRoles,
+		},
+		OIDCLoginTTL:        cfg.Auth.OIDCLoginTTL,
+		DeletionGracePeriod: cfg.Auth.DeletionGracePeriod,
+		MaxBatchSize:        cfg.Auth.BatchMaxSize,
+		ImpersonationTTL:    cfg.Auth.ImpersonationTTL,
+	})
 	userHandlerInstance := userHandler.NewUserHandler(log, userService)
 
-	// Initialize blog dependencies
-	blogRepo := blogRepository.NewBlogRepository(log, db)
-	blogService := blogService.NewBlogService(log, blogRepo)
+	// Initialize blog dependencies, comment counts come from the comment repository
+	blogService := blogService.NewBlogService(log, blogRepo, blogService.Dependencies{
+		AuthorVerifier:    userService,
+		AuthorPreferences: userService,
+		CommentCounter:    commentRepo,
+	}, blogService.Config{
+		RequireVerifiedEmail: cfg.Auth.RequireVerifiedEmail,
+	})
 	blogHandlerInstance := blogHandler.NewBlogHandler(log, blogService)
 
+	// Initialize comment dependencies, comments are only taken on blogs read through the blog repository
+	commentService := commentService.NewCommentService(log, commentRepo, blogRepo)
+	commentHandlerInstance := commentHandler.NewCommentHandler(log, commentService)
+
+	// Initialize invitation dependencies, accepted invitations create users through the user service
+	invitationRepo := invitationRepository.NewInvitationRepository(log, db)
+	invitationService := invitationService.NewInvitationService(log, invitationRepo, userService, mail, invitationService.Config{
+		TTL:       cfg.Auth.InvitationTTL,
+		AcceptURL: cfg.Auth.InvitationURL,
+	})
+	invitationHandlerInstance := invitationHandler.NewInvitationHandler(log, invitationService)
+
 	// Initialize health handler
 	healthHandlerInstance := healthHandler.NewHealthHandler(db, version, commit, buildTime)
 
 	// Create and initialize server
 	srv := server.New(serverConfig)
+	// Bearer tokens are either access tokens or personal access tokens
+	srv.SetAuthenticator(server.AuthenticatorFunc(userService.AuthenticateBearer))
+	// Requests made by admins impersonating a user are added to the impersonation audit trail
+	srv.SetImpersonationAuditor(userService)
 
 	routeHandlers := []server.RouteHandler{
 		healthHandlerInstance,
 		userHandlerInstance,
 		blogHandlerInstance,
+		commentHandlerInstance,
+		invitationHandlerInstance,
 	}
 	if err := srv.Initialize(routeHandlers); err != nil {
 		log.Error("Failed to initialize server",
@@ -6,16 +6,28 @@ import (
 	"os"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/fikryfahrezy/let-it-go/pkg/database"
+	"github.com/fikryfahrezy/let-it-go/pkg/lockout"
 	"github.com/fikryfahrezy/let-it-go/pkg/logger"
+	"github.com/fikryfahrezy/let-it-go/pkg/mailer"
+	"github.com/fikryfahrezy/let-it-go/pkg/oidc"
+	"github.com/fikryfahrezy/let-it-go/pkg/rbac"
+	"github.com/fikryfahrezy/let-it-go/pkg/token"
 )
 
 type Config struct {
 	Server   ServerConfig
 	Database database.Config
 	Logger   logger.Config
-	Crontab  map[string]string
+	Token    token.Config
+	Mailer   mailer.Config
+	Auth     AuthConfig
+	Lockout  lockout.Config
+	// OIDC configures login through an OpenID Connect provider; it is disabled while the issuer URL is empty
+	OIDC    oidc.Config
+	Crontab map[string]string
 }
 
 type ServerConfig struct {
@@ -23,6 +35,37 @@ type ServerConfig struct {
 	Port int
 }
 
+type AuthConfig struct {
+	PasswordResetTTL      time.Duration
+	PasswordResetURL      string
+	EmailVerificationTTL  time.Duration
+	EmailVerificationURL  string
+	RequireVerifiedEmail  bool
+	BcryptCost            int
+	PasswordMinLength     int
+	PasswordRequireUpper  bool
+	PasswordRequireLower  bool
+	PasswordRequireDigit  bool
+	PasswordRequireSymbol bool
+	// TwoFactorEncryptionKey is the base64 encoded 32 byte key TOTP secrets are encrypted
+	// with; two factor authentication is unavailable while it is empty
+	TwoFactorEncryptionKey string
+	TwoFactorIssuer        string
+	TwoFactorChallengeTTL  time.Duration
+	TwoFactorRequiredRoles []string
+	OIDCLoginTTL           time.Duration
+	// DeletionGracePeriod is how long a deleted user can be restored before their personal data is erased
+	DeletionGracePeriod time.Duration
+	// BatchMaxSize is the most operations one request to the batch user endpoint may have
+	BatchMaxSize int
+	// InvitationTTL is how long an invitation stays valid unless the admin picks an expiry
+	InvitationTTL time.Duration
+	// InvitationURL is the page the emailed invitation link points to
+	InvitationURL string
+	// ImpersonationTTL is how long a token an admin gets to act as another user stays valid
+	ImpersonationTTL time.Duration
+}
+
 func Load() Config {
 	loadEnvFile(".env")
 
@@ -38,8 +81,69 @@ func Load() Config {
 			Level:  logger.ParseLevel(getEnv("LOG_LEVEL", "info")),
 			Format: logger.ParseFormat(getEnv("LOG_FORMAT", "text")),
 		},
+		Token: token.Config{
+			Issuer:            getEnv("TOKEN_ISSUER", "let-it-go"),
+			AccessTokenTTL:    getEnvAsDuration("TOKEN_ACCESS_TTL", 15*time.Minute),
+			RefreshTokenTTL:   getEnvAsDuration("TOKEN_REFRESH_TTL", 30*24*time.Hour),
+			Algorithm:         getEnv("TOKEN_ALGORITHM", token.AlgorithmHS256),
+			HMACSecret:        getEnv("TOKEN_HMAC_SECRET", ""),
+			Ed25519PrivateKey: getEnv("TOKEN_ED25519_PRIVATE_KEY", ""),
+			Ed25519PublicKey:  getEnv("TOKEN_ED25519_PUBLIC_KEY", ""),
+		},
+		Mailer: mailer.Config{
+			Driver:       getEnv("MAIL_DRIVER", mailer.DriverFile),
+			From:         getEnv("MAIL_FROM", "no-reply@let-it-go.local"),
+			SMTPHost:     getEnv("SMTP_HOST", "localhost"),
+			SMTPPort:     getEnvAsInt("SMTP_PORT", 587),
+			SMTPUsername: getEnv("SMTP_USERNAME", ""),
+			SMTPPassword: getEnv("SMTP_PASSWORD", ""),
+			FilePath:     getEnv("MAIL_FILE_PATH", ""),
+		},
+		Auth: AuthConfig{
+			PasswordResetTTL:       getEnvAsDuration("PASSWORD_RESET_TTL", time.Hour),
+			PasswordResetURL:       getEnv("PASSWORD_RESET_URL", "http://localhost:3000/reset-password"),
+			EmailVerificationTTL:   getEnvAsDuration("EMAIL_VERIFICATION_TTL", 48*time.Hour),
+			EmailVerificationURL:   getEnv("EMAIL_VERIFICATION_URL", "http://localhost:8080/v1/auth/verify"),
+			RequireVerifiedEmail:   getEnvAsBool("REQUIRE_VERIFIED_EMAIL", false),
+			BcryptCost:             getEnvAsInt("BCRYPT_COST", 12),
+			PasswordMinLength:      getEnvAsInt("PASSWORD_MIN_LENGTH", 8),
+			PasswordRequireUpper:   getEnvAsBool("PASSWORD_REQUIRE_UPPER", false),
+			PasswordRequireLower:   getEnvAsBool("PASSWORD_REQUIRE_LOWER", false),
+			PasswordRequireDigit:   getEnvAsBool("PASSWORD_REQUIRE_DIGIT", false),
+			PasswordRequireSymbol:  getEnvAsBool("PASSWORD_REQUIRE_SYMBOL", false),
+			TwoFactorEncryptionKey: getEnv("TWO_FACTOR_ENCRYPTION_KEY", ""),
+			TwoFactorIssuer:        getEnv("TWO_FACTOR_ISSUER", "Let It Go"),
+			TwoFactorChallengeTTL:  getEnvAsDuration("TWO_FACTOR_CHALLENGE_TTL", 5*time.Minute),
+			TwoFactorRequiredRoles: getEnvAsSlice("TWO_FACTOR_REQUIRED_ROLES", []string{rbac.RoleAdmin, rbac.RoleEditor}),
+			OIDCLoginTTL:           getEnvAsDuration("OIDC_LOGIN_TTL", 10*time.Minute),
+			DeletionGracePeriod:    getEnvAsDuration("USER_DELETION_GRACE_PERIOD", 30*24*time.Hour),
+			BatchMaxSize:           getEnvAsInt("USER_BATCH_MAX_SIZE", 100),
+			InvitationTTL:          getEnvAsDuration("INVITATION_TTL", 7*24*time.Hour),
+			InvitationURL:          getEnv("INVITATION_URL", "http://localhost:3000/accept-invitation"),
+			ImpersonationTTL:       getEnvAsDuration("IMPERSONATION_TTL", 15*time.Minute),
+		},
+		Lockout: lockout.Config{
+			Driver:             getEnv("LOCKOUT_DRIVER", lockout.DriverMemory),
+			AccountMaxAttempts: getEnvAsInt("LOCKOUT_ACCOUNT_MAX_ATTEMPTS", 5),
+			IPMaxAttempts:      getEnvAsInt("LOCKOUT_IP_MAX_ATTEMPTS", 20),
+			Window:             getEnvAsDuration("LOCKOUT_WINDOW", 15*time.Minute),
+			BaseLockout:        getEnvAsDuration("LOCKOUT_BASE_DURATION", time.Minute),
+			MaxLockout:         getEnvAsDuration("LOCKOUT_MAX_DURATION", time.Hour),
+		},
+		OIDC: oidc.Config{
+			IssuerURL:    getEnv("OIDC_ISSUER_URL", ""),
+			ClientID:     getEnv("OIDC_CLIENT_ID", ""),
+			ClientSecret: getEnv("OIDC_CLIENT_SECRET", ""),
+			RedirectURL:  getEnv("OIDC_REDIRECT_URL", "http://localhost:3000/oidc/callback"),
+			Scopes:       getEnvAsSlice("OIDC_SCOPES", []string{"openid", "email", "profile"}),
+		},
 		Crontab: map[string]string{
-			"sample_task": getEnv("CRON_SAMPLE_TASK", "0 * * * *"),
+			"sample_task":               getEnv("CRON_SAMPLE_TASK", "0 * * * *"),
+			"purge_expired_sessions":    getEnv("CRON_PURGE_EXPIRED_SESSIONS", "30 3 * * *"),
+			"purge_login_attempts":      getEnv("CRON_PURGE_LOGIN_ATTEMPTS", "45 * * * *"),
+			"purge_oidc_login_requests": getEnv("CRON_PURGE_OIDC_LOGIN_REQUESTS", "15 * * * *"),
+			"erase_deleted_users":       getEnv("CRON_ERASE_DELETED_USERS", "0 4 * * *"),
+			"publish_scheduled_blogs":   getEnv("CRON_PUBLISH_SCHEDULED_BLOGS", "* * * * *"),
 		},
 	}
 }
@@ -60,6 +164,40 @@ func getEnvAsInt(key string, defaultValue int) int {
 	return defaultValue
 }
 
+func getEnvAsBool(key string, defaultValue bool) bool {
+	if value := os.Getenv(key); value != "" {
+		if boolValue, err := strconv.ParseBool(value); err == nil {
+			return boolValue
+		}
+	}
+	return defaultValue
+}
+
+func getEnvAsDuration(key string, defaultValue time.Duration) time.Duration {
+	if value := os.Getenv(key); value != "" {
+		if durationValue, err := time.ParseDuration(value); err == nil {
+			return durationValue
+		}
+	}
+	return defaultValue
+}
+
+// getEnvAsSlice splits a comma separated value, ignoring blank items
+func getEnvAsSlice(key string, defaultValue []string) []string {
+	value, ok := os.LookupEnv(key)
+	if !ok {
+		return defaultValue
+	}
+
+	items := []string{}
+	for item := range strings.SplitSeq(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
 func loadEnvFile(filename string) {
 	file, err := os.Open(filename)
 	if err != nil {
@@ -4,11 +4,15 @@ import (
 	"errors"
 	"log/slog"
 	"math"
+	"net/http"
+	"net/url"
 	"strconv"
+	"strings"
 
 	"github.com/fikryfahrezy/let-it-go/feature/blog/repository"
 	"github.com/fikryfahrezy/let-it-go/feature/blog/service"
 	"github.com/fikryfahrezy/let-it-go/pkg/http_server"
+	"github.com/fikryfahrezy/let-it-go/pkg/rbac"
 	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
 )
@@ -30,6 +34,21 @@ func (h *BlogHandler) translateServiceError(c echo.Context, err error, defaultMe
 	if errors.Is(err, repository.ErrBlogNotFound) {
 		return http_server.NotFoundResponse(c, "Blog not found", err)
 	}
+	if errors.Is(err, repository.ErrInvalidSearchQuery) {
+		return http_server.BadRequestResponse(c, "Search query is not valid in boolean mode", err)
+	}
+	if errors.Is(err, repository.ErrSlugAlreadyTaken) {
+		return http_server.ErrorResponse(c, http.StatusConflict, "Could not find a free slug for this title, try a different title", err)
+	}
+	if errors.Is(err, service.ErrAuthorEmailNotVerified) {
+		return http_server.ForbiddenResponse(c, "Verify your email address before creating or publishing blogs", err)
+	}
+	if errors.Is(err, service.ErrBlogForbidden) {
+		return http_server.ForbiddenResponse(c, "You are not allowed to modify this blog", err)
+	}
+	if errors.Is(err, service.ErrBlogNotDraft) {
+		return http_server.ForbiddenResponse(c, "Only drafts can be changed by their author", err)
+	}
 	if errors.Is(err, service.ErrInvalidBlogStatus) {
 		return http_server.BadRequestResponse(c, "Invalid blog status", err)
 	}
@@ -39,6 +58,12 @@ func (h *BlogHandler) translateServiceError(c echo.Context, err error, defaultMe
 	if errors.Is(err, service.ErrBlogAlreadyArchived) {
 		return http_server.BadRequestResponse(c, "Blog is already archived", err)
 	}
+	if errors.Is(err, service.ErrScheduleNotInFuture) {
+		return http_server.BadRequestResponse(c, "Blogs can only be scheduled in the future", err)
+	}
+	if errors.Is(err, repository.ErrBlogNotScheduled) {
+		return http_server.ErrorResponse(c, http.StatusConflict, "Blog is no longer scheduled", err)
+	}
 	if errors.Is(err, service.ErrFailedToPublishBlog) {
 		return http_server.InternalServerErrorResponse(c, "Failed to publish blog", err)
 	}
@@ -56,13 +81,16 @@ func (h *BlogHandler) translateServiceError(c echo.Context, err error, defaultMe
 
 // CreateBlog creates a new blog
 // @Summary Create a new blog
-// @Description Create a new blog with the provided information
+// @Description Create a new blog with the provided information. Without a status the blog starts in the author's preferred default_blog_status, or as a draft. Readers cannot create blogs and only editors and admins can create them already published or archived. Personal access tokens need the blogs:write scope.
 // @Tags blogs
 // @Accept json
 // @Produce json
+// @Security BearerAuth
 // @Param blog body service.CreateBlogRequest true "Blog creation request"
 // @Success 201 {object} http_server.APIResponse{result=service.GetBlogResponse}
 // @Failure 400 {object} http_server.APIResponse
+// @Failure 401 {object} http_server.APIResponse
+// @Failure 403 {object} http_server.APIResponse
 // @Failure 500 {object} http_server.APIResponse
 // @Router /v1/blogs [post]
 func (h *BlogHandler) CreateBlog(c echo.Context) error {
@@ -116,16 +144,46 @@ func (h *BlogHandler) GetBlog(c echo.Context) error {
 	return http_server.SuccessResponse(c, "Blog retrieved successfully", blog)
 }
 
+// GetBlogBySlug retrieves a blog by slug
+// @Summary Get a blog by slug
+// @Description Retrieve a blog by its slug. A slug the blog had before its title changed answers with a 301 redirect to the blog's current slug.
+// @Tags blogs
+// @Accept json
+// @Produce json
+// @Param slug path string true "Blog slug"
+// @Success 200 {object} http_server.APIResponse{result=service.GetBlogResponse}
+// @Success 301 "Redirect to the current slug"
+// @Failure 404 {object} http_server.APIResponse
+// @Failure 500 {object} http_server.APIResponse
+// @Router /v1/blogs/slug/{slug} [get]
+func (h *BlogHandler) GetBlogBySlug(c echo.Context) error {
+	slug := c.Param("slug")
+
+	blog, err := h.blogService.GetBlogBySlug(c.Request().Context(), slug)
+	if err != nil {
+		return h.translateServiceError(c, err, "Failed to get blog")
+	}
+
+	if blog.Slug != slug {
+		return c.Redirect(http.StatusMovedPermanently, "/v1/blogs/slug/"+url.PathEscape(blog.Slug))
+	}
+
+	return http_server.SuccessResponse(c, "Blog retrieved successfully", blog)
+}
+
 // UpdateBlog updates an existing blog
 // @Summary Update a blog
-// @Description Update an existing blog with the provided information
+// @Description Update an existing blog with the provided information. Authors can only update their own drafts; admins can update any blog. Personal access tokens need the blogs:write scope.
 // @Tags blogs
 // @Accept json
 // @Produce json
+// @Security BearerAuth
 // @Param id path string true "Blog ID"
 // @Param blog body service.UpdateBlogRequest true "Blog update request"
 // @Success 200 {object} http_server.APIResponse{result=service.GetBlogResponse}
 // @Failure 400 {object} http_server.APIResponse
+// @Failure 401 {object} http_server.APIResponse
+// @Failure 403 {object} http_server.APIResponse
 // @Failure 404 {object} http_server.APIResponse
 // @Failure 500 {object} http_server.APIResponse
 // @Router /v1/blogs/{id} [put]
@@ -161,13 +219,16 @@ func (h *BlogHandler) UpdateBlog(c echo.Context) error {
 
 // DeleteBlog deletes a blog by ID
 // @Summary Delete a blog
-// @Description Delete a blog by its unique identifier
+// @Description Delete a blog by its unique identifier. Authors can only delete their own drafts; admins can delete any blog. Personal access tokens need the blogs:write scope.
 // @Tags blogs
 // @Accept json
 // @Produce json
+// @Security BearerAuth
 // @Param id path string true "Blog ID"
 // @Success 200 {object} http_server.APIResponse
 // @Failure 400 {object} http_server.APIResponse
+// @Failure 401 {object} http_server.APIResponse
+// @Failure 403 {object} http_server.APIResponse
 // @Failure 404 {object} http_server.APIResponse
 // @Failure 500 {object} http_server.APIResponse
 // @Router /v1/blogs/{id} [delete]
@@ -189,18 +250,34 @@ func (h *BlogHandler) DeleteBlog(c echo.Context) error {
 	return http_server.SuccessResponse(c, "Blog deleted successfully", nil)
 }
 
+// maxTagFilters bounds how many tags blogs can be filtered by at once
+const maxTagFilters = 10
+
 // ListBlogs retrieves a list of blogs with pagination
 // @Summary List blogs
-// @Description Retrieve a paginated list of blogs
+// @Description Retrieve a paginated list of blogs. Repeat the tag parameter to list only blogs having any of the tags, or all of them with match=all.
 // @Tags blogs
 // @Accept json
 // @Produce json
 // @Param page query int false "Page number" default(1)
 // @Param page_size query int false "Number of items per page" default(10)
+// @Param tag query []string false "Tag to filter by" collectionFormat(multi)
+// @Param match query string false "Whether blogs need any or all of the tags" Enums(any, all) default(any)
 // @Success 200 {object} http_server.ListAPIResponse{result=[]service.GetBlogResponse}
+// @Failure 400 {object} http_server.APIResponse
 // @Failure 500 {object} http_server.APIResponse
 // @Router /v1/blogs [get]
 func (h *BlogHandler) ListBlogs(c echo.Context) error {
+	tags := c.QueryParams()["tag"]
+	if len(tags) > maxTagFilters {
+		return http_server.BadRequestResponse(c, "Blogs can be filtered by at most 10 tags", nil)
+	}
+
+	match := c.QueryParam("match")
+	if match != "" && match != "any" && match != "all" {
+		return http_server.BadRequestResponse(c, "Match must be any or all", nil)
+	}
+
 	pageParam := c.QueryParam("page")
 	pageSizeParam := c.QueryParam("page_size")
 
@@ -224,6 +301,8 @@ func (h *BlogHandler) ListBlogs(c echo.Context) error {
 	}
 	blogs, totalCount, err := h.blogService.ListBlogs(c.Request().Context(), service.ListBlogsRequest{
 		PaginationRequest: paginationReq,
+		Tags:              tags,
+		MatchAll:          match == "all",
 	})
 	if err != nil {
 		return h.translateServiceError(c, err, "Failed to list blogs")
@@ -235,6 +314,87 @@ func (h *BlogHandler) ListBlogs(c echo.Context) error {
 	return http_server.ListSuccessResponse(c, "Blogs retrieved successfully", blogs, pagination)
 }
 
+// maxSearchQueryLength bounds the length of search queries
+const maxSearchQueryLength = 200
+
+// SearchBlogs searches blogs by the words of their title and content
+// @Summary Search blogs
+// @Description Full-text search over blog titles and content, the most relevant first unless sort=newest. With mode=boolean the query can use the MySQL boolean mode operators: +required, -excluded, prefix* and "exact phrases". Words shorter than 3 characters and common stopwords are ignored. The highlighted title and snippet are HTML escaped with the matching words wrapped in <mark> tags.
+// @Tags blogs
+// @Accept json
+// @Produce json
+// @Param q query string true "Search query"
+// @Param mode query string false "How the query is interpreted" Enums(natural, boolean) default(natural)
+// @Param status query string false "Status of the blogs to search" Enums(draft, published, archived, scheduled)
+// @Param sort query string false "Order of the results" Enums(relevance, newest) default(relevance)
+// @Param page query int false "Page number" default(1)
+// @Param page_size query int false "Number of items per page" default(10)
+// @Success 200 {object} http_server.ListAPIResponse{result=[]service.SearchBlogResponse}
+// @Failure 400 {object} http_server.APIResponse
+// @Failure 500 {object} http_server.APIResponse
+// @Router /v1/blogs/search [get]
+func (h *BlogHandler) SearchBlogs(c echo.Context) error {
+	query := strings.TrimSpace(c.QueryParam("q"))
+	if query == "" {
+		return http_server.BadRequestResponse(c, "Search query is required", nil)
+	}
+	if len([]rune(query)) > maxSearchQueryLength {
+		return http_server.BadRequestResponse(c, "Search query can be at most 200 characters", nil)
+	}
+
+	mode := c.QueryParam("mode")
+	if mode != "" && mode != "natural" && mode != "boolean" {
+		return http_server.BadRequestResponse(c, "Mode must be natural or boolean", nil)
+	}
+
+	status := c.QueryParam("status")
+	if status != "" && status != repository.StatusDraft && status != repository.StatusPublished && status != repository.StatusArchived && status != repository.StatusScheduled {
+		return http_server.BadRequestResponse(c, "Status must be draft, published, archived or scheduled", nil)
+	}
+
+	sort := c.QueryParam("sort")
+	if sort != "" && sort != repository.SearchSortRelevance && sort != repository.SearchSortNewest {
+		return http_server.BadRequestResponse(c, "Sort must be relevance or newest", nil)
+	}
+
+	pageParam := c.QueryParam("page")
+	pageSizeParam := c.QueryParam("page_size")
+
+	page := 1
+	if pageParam != "" {
+		if p, err := strconv.Atoi(pageParam); err == nil && p > 0 {
+			page = p
+		}
+	}
+
+	pageSize := 10
+	if pageSizeParam != "" {
+		if ps, err := strconv.Atoi(pageSizeParam); err == nil && ps > 0 && ps <= 100 {
+			pageSize = ps
+		}
+	}
+
+	paginationReq := http_server.PaginationRequest{
+		Page:     page,
+		PageSize: pageSize,
+	}
+	blogs, totalCount, err := h.blogService.SearchBlogs(c.Request().Context(), service.SearchBlogsRequest{
+		PaginationRequest: paginationReq,
+		Query:             query,
+		BooleanMode:       mode == "boolean",
+		Status:            status,
+		Sort:              sort,
+	})
+	if err != nil {
+		return h.translateServiceError(c, err, "Failed to search blogs")
+	}
+
+	totalPages := int64(math.Ceil(float64(totalCount) / float64(pageSize)))
+	pagination := http_server.CreatePaginationResponse(totalCount, totalPages, page, pageSize)
+
+	return http_server.ListSuccessResponse(c, "Blogs retrieved successfully", blogs, pagination)
+}
+
 // GetBlogsByAuthor retrieves blogs by author ID with pagination
 // @Summary Get blogs by author
 // @Description Retrieve a paginated list of blogs by author ID
@@ -347,13 +507,16 @@ func (h *BlogHandler) GetBlogsByStatus(c echo.Context) error {
 
 // PublishBlog publishes a blog by ID
 // @Summary Publish a blog
-// @Description Publish a blog by setting its status to published
+// @Description Publish a blog by setting its status to published. Requires the editor or admin role. Personal access tokens need the blogs:write scope.
 // @Tags blogs
 // @Accept json
 // @Produce json
+// @Security BearerAuth
 // @Param id path string true "Blog ID"
 // @Success 200 {object} http_server.APIResponse{result=service.GetBlogResponse}
 // @Failure 400 {object} http_server.APIResponse
+// @Failure 401 {object} http_server.APIResponse
+// @Failure 403 {object} http_server.APIResponse
 // @Failure 404 {object} http_server.APIResponse
 // @Failure 500 {object} http_server.APIResponse
 // @Router /v1/blogs/{id}/publish [post]
@@ -375,15 +538,65 @@ func (h *BlogHandler) PublishBlog(c echo.Context) error {
 	return http_server.SuccessResponse(c, "Blog published successfully", blog)
 }
 
+// ScheduleBlog schedules a blog to be published later
+// @Summary Schedule a blog
+// @Description Set a blog to be published at scheduled_at, an RFC 3339 time in the future. The cron job publishes it within a minute of that time. Drafts, archived and already scheduled blogs can be scheduled. Requires the editor or admin role. Personal access tokens need the blogs:write scope.
+// @Tags blogs
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Blog ID"
+// @Param schedule body service.ScheduleBlogRequest true "Blog schedule request"
+// @Success 200 {object} http_server.APIResponse{result=service.GetBlogResponse}
+// @Failure 400 {object} http_server.APIResponse
+// @Failure 401 {object} http_server.APIResponse
+// @Failure 403 {object} http_server.APIResponse
+// @Failure 404 {object} http_server.APIResponse
+// @Failure 409 {object} http_server.APIResponse
+// @Failure 500 {object} http_server.APIResponse
+// @Router /v1/blogs/{id}/schedule [post]
+func (h *BlogHandler) ScheduleBlog(c echo.Context) error {
+	idParam := c.Param("id")
+	id, err := uuid.Parse(idParam)
+	if err != nil {
+		h.log.Warn("Invalid blog ID parameter",
+			slog.String("id", idParam),
+		)
+		return http_server.BadRequestResponse(c, "Invalid blog UUID format", err)
+	}
+
+	var req service.ScheduleBlogRequest
+	if err := c.Bind(&req); err != nil {
+		h.log.Error("Failed to bind request",
+			slog.String("error", err.Error()),
+		)
+		return http_server.BadRequestResponse(c, "Invalid request format", err)
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return http_server.HandleValidationError(c, err)
+	}
+
+	blog, err := h.blogService.ScheduleBlog(c.Request().Context(), id, req)
+	if err != nil {
+		return h.translateServiceError(c, err, "Failed to schedule blog")
+	}
+
+	return http_server.SuccessResponse(c, "Blog scheduled successfully", blog)
+}
+
 // ArchiveBlog archives a blog by ID
 // @Summary Archive a blog
-// @Description Archive a blog by setting its status to archived
+// @Description Archive a blog by setting its status to archived. Requires the editor or admin role. Personal access tokens need the blogs:write scope.
 // @Tags blogs
 // @Accept json
 // @Produce json
+// @Security BearerAuth
 // @Param id path string true "Blog ID"
 // @Success 200 {object} http_server.APIResponse{result=service.GetBlogResponse}
 // @Failure 400 {object} http_server.APIResponse
+// @Failure 401 {object} http_server.APIResponse
+// @Failure 403 {object} http_server.APIResponse
 // @Failure 404 {object} http_server.APIResponse
 // @Failure 500 {object} http_server.APIResponse
 // @Router /v1/blogs/{id}/archive [post]
@@ -405,6 +618,90 @@ func (h *BlogHandler) ArchiveBlog(c echo.Context) error {
 	return http_server.SuccessResponse(c, "Blog archived successfully", blog)
 }
 
+// CloseComments closes the comments of a blog
+// @Summary Close blog comments
+// @Description Stop new comments and replies on a blog. Existing comments stay visible. Authors can close comments on their own blogs whatever their status, editors and admins on any blog. Personal access tokens need the blogs:write scope.
+// @Tags blogs
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Blog ID"
+// @Success 200 {object} http_server.APIResponse{result=service.GetBlogResponse}
+// @Failure 400 {object} http_server.APIResponse
+// @Failure 401 {object} http_server.APIResponse
+// @Failure 403 {object} http_server.APIResponse
+// @Failure 404 {object} http_server.APIResponse
+// @Failure 500 {object} http_server.APIResponse
+// @Router /v1/blogs/{id}/comments/close [post]
+func (h *BlogHandler) CloseComments(c echo.Context) error {
+	idParam := c.Param("id")
+	id, err := uuid.Parse(idParam)
+	if err != nil {
+		h.log.Warn("Invalid blog ID parameter",
+			slog.String("id", idParam),
+		)
+		return http_server.BadRequestResponse(c, "Invalid blog UUID format", err)
+	}
+
+	blog, err := h.blogService.CloseComments(c.Request().Context(), id)
+	if err != nil {
+		return h.translateServiceError(c, err, "Failed to close blog comments")
+	}
+
+	return http_server.SuccessResponse(c, "Blog comments closed successfully", blog)
+}
+
+// OpenComments opens the comments of a blog
+// @Summary Open blog comments
+// @Description Let readers comment on a blog again after its comments were closed. Authors can open comments on their own blogs, editors and admins on any blog. Personal access tokens need the blogs:write scope.
+// @Tags blogs
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Blog ID"
+// @Success 200 {object} http_server.APIResponse{result=service.GetBlogResponse}
+// @Failure 400 {object} http_server.APIResponse
+// @Failure 401 {object} http_server.APIResponse
+// @Failure 403 {object} http_server.APIResponse
+// @Failure 404 {object} http_server.APIResponse
+// @Failure 500 {object} http_server.APIResponse
+// @Router /v1/blogs/{id}/comments/open [post]
+func (h *BlogHandler) OpenComments(c echo.Context) error {
+	idParam := c.Param("id")
+	id, err := uuid.Parse(idParam)
+	if err != nil {
+		h.log.Warn("Invalid blog ID parameter",
+			slog.String("id", idParam),
+		)
+		return http_server.BadRequestResponse(c, "Invalid blog UUID format", err)
+	}
+
+	blog, err := h.blogService.OpenComments(c.Request().Context(), id)
+	if err != nil {
+		return h.translateServiceError(c, err, "Failed to open blog comments")
+	}
+
+	return http_server.SuccessResponse(c, "Blog comments opened successfully", blog)
+}
+
+// ListTags retrieves the tags in use
+// @Summary List tags
+// @Description Retrieve the tags used by at least one blog with the number of blogs using them, the most used first
+// @Tags blogs
+// @Accept json
+// @Produce json
+// @Success 200 {object} http_server.APIResponse{result=[]service.TagResponse}
+// @Failure 500 {object} http_server.APIResponse
+// @Router /v1/tags [get]
+func (h *BlogHandler) ListTags(c echo.Context) error {
+	tags, err := h.blogService.ListTags(c.Request().Context())
+	if err != nil {
+		return h.translateServiceError(c, err, "Failed to list tags")
+	}
+
+	return http_server.SuccessResponse(c, "Tags retrieved successfully", tags)
+}
+
 // SetupRoutes configures all API routes for blogs
 func (h *BlogHandler) SetupRoutes(server *http_server.Server) {
 	h.setupV1Routes(server)
@@ -413,13 +710,20 @@ func (h *BlogHandler) SetupRoutes(server *http_server.Server) {
 // setupV1Routes configures v1 API routes for blogs
 func (h *BlogHandler) setupV1Routes(server *http_server.Server) {
 	blogs := server.Echo().Group("/v1/blogs")
-	blogs.POST("", h.CreateBlog)
+	blogs.POST("", h.CreateBlog, http_server.RequireScope(rbac.ScopeBlogsWrite), rbac.RequirePermission(rbac.PermissionBlogsWrite))
 	blogs.GET("", h.ListBlogs)
+	blogs.GET("/search", h.SearchBlogs)
 	blogs.GET("/:id", h.GetBlog)
-	blogs.PUT("/:id", h.UpdateBlog)
-	blogs.DELETE("/:id", h.DeleteBlog)
+	blogs.GET("/slug/:slug", h.GetBlogBySlug)
+	blogs.PUT("/:id", h.UpdateBlog, http_server.RequireScope(rbac.ScopeBlogsWrite))
+	blogs.DELETE("/:id", h.DeleteBlog, http_server.RequireScope(rbac.ScopeBlogsWrite))
 	blogs.GET("/author/:author_id", h.GetBlogsByAuthor)
 	blogs.GET("/status/:status", h.GetBlogsByStatus)
-	blogs.POST("/:id/publish", h.PublishBlog)
-	blogs.POST("/:id/archive", h.ArchiveBlog)
+	blogs.POST("/:id/publish", h.PublishBlog, http_server.RequireScope(rbac.ScopeBlogsWrite), rbac.RequirePermission(rbac.PermissionBlogsPublish))
+	blogs.POST("/:id/schedule", h.ScheduleBlog, http_server.RequireScope(rbac.ScopeBlogsWrite), rbac.RequirePermission(rbac.PermissionBlogsPublish))
+	blogs.POST("/:id/archive", h.ArchiveBlog, http_server.RequireScope(rbac.ScopeBlogsWrite), rbac.RequirePermission(rbac.PermissionBlogsPublish))
+	blogs.POST("/:id/comments/close", h.CloseComments, http_server.RequireScope(rbac.ScopeBlogsWrite), rbac.RequirePermission(rbac.PermissionBlogsWrite))
+	blogs.POST("/:id/comments/open", h.OpenComments, http_server.RequireScope(rbac.ScopeBlogsWrite), rbac.RequirePermission(rbac.PermissionBlogsWrite))
+
+	server.Echo().GET("/v1/tags", h.ListTags)
 }
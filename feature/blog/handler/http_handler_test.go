@@ -2,9 +2,11 @@ package handler_test
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 	"time"
 
@@ -14,6 +16,7 @@ import (
 	"github.com/fikryfahrezy/let-it-go/feature/blog/service/servicefakes"
 	"github.com/fikryfahrezy/let-it-go/pkg/http_server"
 	"github.com/fikryfahrezy/let-it-go/pkg/logger"
+	"github.com/fikryfahrezy/let-it-go/pkg/rbac"
 	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
 	"github.com/stretchr/testify/assert"
@@ -45,10 +48,9 @@ func TestBlogHandler_CreateBlog_Success(t *testing.T) {
 	e := setupEcho()
 
 	requestBody := service.CreateBlogRequest{
-		Title:    "Test Blog",
-		Content:  "This is a test blog content",
-		AuthorID: authorID,
-		Status:   "draft",
+		Title:   "Test Blog",
+		Content: "This is a test blog content",
+		Status:  "draft",
 	}
 	body, err := json.Marshal(requestBody)
 	require.NoError(t, err)
@@ -67,7 +69,7 @@ func TestBlogHandler_CreateBlog_Success(t *testing.T) {
 	_, actualReq := mockService.CreateBlogArgsForCall(0)
 	assert.Equal(t, requestBody.Title, actualReq.Title)
 	assert.Equal(t, requestBody.Content, actualReq.Content)
-	assert.Equal(t, requestBody.AuthorID, actualReq.AuthorID)
+	assert.Equal(t, uuid.Nil, actualReq.AuthorID) // The author comes from the caller, never the body
 	assert.Equal(t, requestBody.Status, actualReq.Status)
 }
 
@@ -103,12 +105,10 @@ func TestBlogHandler_CreateBlog_InvalidStatus(t *testing.T) {
 	blogHandler := handler.NewBlogHandler(logger.NewDiscardLogger(), mockService)
 	e := setupEcho()
 
-	authorID := uuid.New()
 	requestBody := service.CreateBlogRequest{
-		Title:    "Test Blog",
-		Content:  "This is a test blog content",
-		AuthorID: authorID,
-		Status:   "invalid-status",
+		Title:   "Test Blog",
+		Content: "This is a test blog content",
+		Status:  "invalid-status",
 	}
 	body, err := json.Marshal(requestBody)
 	require.NoError(t, err)
@@ -421,3 +421,626 @@ func TestBlogHandler_GetBlogsByStatus_Success(t *testing.T) {
 	assert.Equal(t, 1, paginationReq.Page)
 	assert.Equal(t, 10, paginationReq.PageSize)
 }
+
+func TestBlogHandler_CreateBlog_Unauthenticated(t *testing.T) {
+	mockService := &servicefakes.FakeBlogService{}
+	blogHandler := handler.NewBlogHandler(logger.NewDiscardLogger(), mockService)
+
+	srv := http_server.New(http_server.Config{})
+	require.NoError(t, srv.Initialize([]http_server.RouteHandler{blogHandler}))
+
+	body, err := json.Marshal(service.CreateBlogRequest{
+		Title:   "Test Blog",
+		Content: "This is a test blog content",
+		Status:  "draft",
+	})
+	require.NoError(t, err)
+
+	req := httptest.NewRequest(http.MethodPost, "/v1/blogs", bytes.NewBuffer(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	srv.Echo().ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+
+	// Service should not be called for unauthenticated requests
+	assert.Equal(t, 0, mockService.CreateBlogCallCount())
+}
+
+func TestBlogHandler_DeleteBlog_Forbidden(t *testing.T) {
+	mockService := &servicefakes.FakeBlogService{}
+	blogID := uuid.New()
+	mockService.DeleteBlogReturns(service.ErrBlogForbidden)
+
+	blogHandler := handler.NewBlogHandler(logger.NewDiscardLogger(), mockService)
+	e := setupEcho()
+
+	req := httptest.NewRequest(http.MethodDelete, "/api/v1/blogs/"+blogID.String(), nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetPath("/api/v1/blogs/:id")
+	c.SetParamNames("id")
+	c.SetParamValues(blogID.String())
+
+	err := blogHandler.DeleteBlog(c)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+
+	var response http_server.APIResponse
+	err = json.Unmarshal(rec.Body.Bytes(), &response)
+	require.NoError(t, err)
+	assert.Equal(t, "BLOG-FORBIDDEN", response.Error)
+}
+
+func TestBlogHandler_PublishBlog_UnverifiedAuthor(t *testing.T) {
+	mockService := &servicefakes.FakeBlogService{}
+	blogID := uuid.New()
+	mockService.PublishBlogReturns(service.GetBlogResponse{}, service.ErrAuthorEmailNotVerified)
+
+	blogHandler := handler.NewBlogHandler(logger.NewDiscardLogger(), mockService)
+	e := setupEcho()
+
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/blogs/"+blogID.String()+"/publish", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetPath("/api/v1/blogs/:id/publish")
+	c.SetParamNames("id")
+	c.SetParamValues(blogID.String())
+
+	err := blogHandler.PublishBlog(c)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+
+	var response http_server.APIResponse
+	err = json.Unmarshal(rec.Body.Bytes(), &response)
+	require.NoError(t, err)
+	assert.Equal(t, "BLOG-AUTHOR_EMAIL_NOT_VERIFIED", response.Error)
+}
+
+func setupServer(t *testing.T, blogHandler *handler.BlogHandler, principal http_server.Principal) *echo.Echo {
+	t.Helper()
+
+	srv := http_server.New(http_server.Config{})
+	srv.SetAuthenticator(http_server.AuthenticatorFunc(func(ctx context.Context, token string) (http_server.Principal, error) {
+		return principal, nil
+	}))
+	require.NoError(t, srv.Initialize([]http_server.RouteHandler{blogHandler}))
+
+	return srv.Echo()
+}
+
+func TestBlogHandler_PublishBlog_RequiresPublishPermission(t *testing.T) {
+	tests := []struct {
+		name       string
+		role       string
+		statusCode int
+	}{
+		{name: "admin", role: rbac.RoleAdmin, statusCode: http.StatusOK},
+		{name: "editor", role: rbac.RoleEditor, statusCode: http.StatusOK},
+		{name: "author", role: rbac.RoleAuthor, statusCode: http.StatusForbidden},
+		{name: "reader", role: rbac.RoleReader, statusCode: http.StatusForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := &servicefakes.FakeBlogService{}
+			blogHandler := handler.NewBlogHandler(logger.NewDiscardLogger(), mockService)
+			e := setupServer(t, blogHandler, http_server.Principal{UserID: uuid.New(), Roles: []string{tt.role}})
+
+			req := httptest.NewRequest(http.MethodPost, "/v1/blogs/"+uuid.NewString()+"/publish", nil)
+			req.Header.Set(echo.HeaderAuthorization, "Bearer valid-token")
+			rec := httptest.NewRecorder()
+			e.ServeHTTP(rec, req)
+
+			assert.Equal(t, tt.statusCode, rec.Code)
+			if tt.statusCode == http.StatusForbidden {
+				assert.Equal(t, 0, mockService.PublishBlogCallCount())
+			}
+		})
+	}
+}
+
+func TestBlogHandler_CreateBlog_Reader(t *testing.T) {
+	mockService := &servicefakes.FakeBlogService{}
+	blogHandler := handler.NewBlogHandler(logger.NewDiscardLogger(), mockService)
+	e := setupServer(t, blogHandler, http_server.Principal{UserID: uuid.New(), Roles: []string{rbac.RoleReader}})
+
+	body, err := json.Marshal(service.CreateBlogRequest{
+		Title:   "Test Blog",
+		Content: "This is a test blog content",
+		Status:  "draft",
+	})
+	require.NoError(t, err)
+
+	req := httptest.NewRequest(http.MethodPost, "/v1/blogs", bytes.NewBuffer(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	req.Header.Set(echo.HeaderAuthorization, "Bearer valid-token")
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+	assert.Equal(t, 0, mockService.CreateBlogCallCount())
+}
+
+func TestBlogHandler_UpdateBlog_NotDraft(t *testing.T) {
+	mockService := &servicefakes.FakeBlogService{}
+	blogID := uuid.New()
+	mockService.UpdateBlogReturns(service.GetBlogResponse{}, service.ErrBlogNotDraft)
+
+	blogHandler := handler.NewBlogHandler(logger.NewDiscardLogger(), mockService)
+	e := setupServer(t, blogHandler, http_server.Principal{UserID: uuid.New(), Roles: []string{rbac.RoleAuthor}})
+
+	body, err := json.Marshal(service.UpdateBlogRequest{Title: "New Title"})
+	require.NoError(t, err)
+
+	req := httptest.NewRequest(http.MethodPut, "/v1/blogs/"+blogID.String(), bytes.NewBuffer(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	req.Header.Set(echo.HeaderAuthorization, "Bearer valid-token")
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+
+	var response http_server.APIResponse
+	err = json.Unmarshal(rec.Body.Bytes(), &response)
+	require.NoError(t, err)
+	assert.Equal(t, "BLOG-NOT_DRAFT", response.Error)
+}
+
+func TestBlogHandler_PublishBlog_PersonalAccessTokenScopes(t *testing.T) {
+	tests := []struct {
+		name       string
+		scopes     []string
+		statusCode int
+	}{
+		{name: "blogs:write", scopes: []string{rbac.ScopeBlogsWrite}, statusCode: http.StatusOK},
+		{name: "users:read", scopes: []string{rbac.ScopeUsersRead}, statusCode: http.StatusForbidden},
+		{name: "no scopes", scopes: []string{}, statusCode: http.StatusForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := &servicefakes.FakeBlogService{}
+			blogHandler := handler.NewBlogHandler(logger.NewDiscardLogger(), mockService)
+			e := setupServer(t, blogHandler, http_server.Principal{UserID: uuid.New(), Roles: []string{rbac.RoleEditor}, Scopes: tt.scopes})
+
+			req := httptest.NewRequest(http.MethodPost, "/v1/blogs/"+uuid.NewString()+"/publish", nil)
+			req.Header.Set(echo.HeaderAuthorization, "Bearer valid-token")
+			rec := httptest.NewRecorder()
+			e.ServeHTTP(rec, req)
+
+			assert.Equal(t, tt.statusCode, rec.Code)
+			if tt.statusCode == http.StatusForbidden {
+				assert.Equal(t, 0, mockService.PublishBlogCallCount())
+
+				var response http_server.APIResponse
+				require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+				assert.Equal(t, http_server.ErrInsufficientScope.Code, response.Error)
+			}
+		})
+	}
+}
+
+func TestBlogHandler_PublishBlog_PersonalAccessTokenKeepsRolePermissions(t *testing.T) {
+	mockService := &servicefakes.FakeBlogService{}
+	blogHandler := handler.NewBlogHandler(logger.NewDiscardLogger(), mockService)
+	// The scope admits the token to the route but the author role still cannot publish
+	e := setupServer(t, blogHandler, http_server.Principal{UserID: uuid.New(), Roles: []string{rbac.RoleAuthor}, Scopes: []string{rbac.ScopeBlogsWrite}})
+
+	req := httptest.NewRequest(http.MethodPost, "/v1/blogs/"+uuid.NewString()+"/publish", nil)
+	req.Header.Set(echo.HeaderAuthorization, "Bearer valid-token")
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+	assert.Equal(t, 0, mockService.PublishBlogCallCount())
+
+	var response http_server.APIResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	assert.Equal(t, http_server.ErrForbidden.Code, response.Error)
+}
+
+func TestBlogHandler_GetBlogBySlug_Success(t *testing.T) {
+	mockService := &servicefakes.FakeBlogService{}
+	mockService.GetBlogBySlugReturns(service.GetBlogResponse{ID: uuid.New(), Title: "Test Blog", Slug: "test-blog"}, nil)
+
+	blogHandler := handler.NewBlogHandler(logger.NewDiscardLogger(), mockService)
+	e := setupServer(t, blogHandler, http_server.Principal{})
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/blogs/slug/test-blog", nil)
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, 0, mockService.GetBlogByIDCallCount())
+	require.Equal(t, 1, mockService.GetBlogBySlugCallCount())
+	_, actualSlug := mockService.GetBlogBySlugArgsForCall(0)
+	assert.Equal(t, "test-blog", actualSlug)
+}
+
+func TestBlogHandler_GetBlogBySlug_PreviousSlug(t *testing.T) {
+	mockService := &servicefakes.FakeBlogService{}
+	// The service resolves an old slug to the blog with its current slug
+	mockService.GetBlogBySlugReturns(service.GetBlogResponse{ID: uuid.New(), Title: "Renamed Blog", Slug: "renamed-blog"}, nil)
+
+	blogHandler := handler.NewBlogHandler(logger.NewDiscardLogger(), mockService)
+	e := setupServer(t, blogHandler, http_server.Principal{})
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/blogs/slug/test-blog", nil)
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusMovedPermanently, rec.Code)
+	assert.Equal(t, "/v1/blogs/slug/renamed-blog", rec.Header().Get(echo.HeaderLocation))
+}
+
+func TestBlogHandler_GetBlogBySlug_NotFound(t *testing.T) {
+	mockService := &servicefakes.FakeBlogService{}
+	mockService.GetBlogBySlugReturns(service.GetBlogResponse{}, repository.ErrBlogNotFound)
+
+	blogHandler := handler.NewBlogHandler(logger.NewDiscardLogger(), mockService)
+	e := setupServer(t, blogHandler, http_server.Principal{})
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/blogs/slug/missing-blog", nil)
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+func TestBlogHandler_CreateBlog_SlugTaken(t *testing.T) {
+	mockService := &servicefakes.FakeBlogService{}
+	mockService.CreateBlogReturns(service.GetBlogResponse{}, repository.ErrSlugAlreadyTaken)
+
+	blogHandler := handler.NewBlogHandler(logger.NewDiscardLogger(), mockService)
+	e := setupServer(t, blogHandler, http_server.Principal{UserID: uuid.New(), Roles: []string{rbac.RoleAuthor}})
+
+	body, err := json.Marshal(service.CreateBlogRequest{Title: "Test Blog", Content: "This is a test blog content"})
+	require.NoError(t, err)
+
+	req := httptest.NewRequest(http.MethodPost, "/v1/blogs", bytes.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	req.Header.Set(echo.HeaderAuthorization, "Bearer valid-token")
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusConflict, rec.Code)
+}
+
+func TestBlogHandler_ListBlogs_ByTags(t *testing.T) {
+	tests := []struct {
+		name     string
+		query    string
+		matchAll bool
+	}{
+		{name: "any of the tags by default", query: "?tag=go&tag=mysql", matchAll: false},
+		{name: "any of the tags", query: "?tag=go&tag=mysql&match=any", matchAll: false},
+		{name: "all of the tags", query: "?tag=go&tag=mysql&match=all", matchAll: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := &servicefakes.FakeBlogService{}
+			mockService.ListBlogsReturns([]service.GetBlogResponse{}, 0, nil)
+
+			blogHandler := handler.NewBlogHandler(logger.NewDiscardLogger(), mockService)
+			e := setupServer(t, blogHandler, http_server.Principal{})
+
+			req := httptest.NewRequest(http.MethodGet, "/v1/blogs"+tt.query, nil)
+			rec := httptest.NewRecorder()
+			e.ServeHTTP(rec, req)
+
+			assert.Equal(t, http.StatusOK, rec.Code)
+			require.Equal(t, 1, mockService.ListBlogsCallCount())
+			_, actualReq := mockService.ListBlogsArgsForCall(0)
+			assert.Equal(t, []string{"go", "mysql"}, actualReq.Tags)
+			assert.Equal(t, tt.matchAll, actualReq.MatchAll)
+		})
+	}
+}
+
+func TestBlogHandler_ListBlogs_InvalidMatch(t *testing.T) {
+	mockService := &servicefakes.FakeBlogService{}
+	blogHandler := handler.NewBlogHandler(logger.NewDiscardLogger(), mockService)
+	e := setupServer(t, blogHandler, http_server.Principal{})
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/blogs?tag=go&match=some", nil)
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Equal(t, 0, mockService.ListBlogsCallCount())
+}
+
+func TestBlogHandler_ListTags_Success(t *testing.T) {
+	mockService := &servicefakes.FakeBlogService{}
+	mockService.ListTagsReturns([]service.TagResponse{
+		{Name: "go", BlogCount: 3},
+		{Name: "mysql", BlogCount: 1},
+	}, nil)
+
+	blogHandler := handler.NewBlogHandler(logger.NewDiscardLogger(), mockService)
+	e := setupServer(t, blogHandler, http_server.Principal{})
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/tags", nil)
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	var response struct {
+		Result []service.TagResponse `json:"result"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	assert.Equal(t, []service.TagResponse{
+		{Name: "go", BlogCount: 3},
+		{Name: "mysql", BlogCount: 1},
+	}, response.Result)
+}
+
+func TestBlogHandler_CreateBlog_InvalidTag(t *testing.T) {
+	mockService := &servicefakes.FakeBlogService{}
+	blogHandler := handler.NewBlogHandler(logger.NewDiscardLogger(), mockService)
+	e := setupServer(t, blogHandler, http_server.Principal{UserID: uuid.New(), Roles: []string{rbac.RoleAuthor}})
+
+	body, err := json.Marshal(service.CreateBlogRequest{
+		Title:   "Test Blog",
+		Content: "This is a test blog content",
+		Tags:    []string{"Not A Tag"},
+	})
+	require.NoError(t, err)
+
+	req := httptest.NewRequest(http.MethodPost, "/v1/blogs", bytes.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	req.Header.Set(echo.HeaderAuthorization, "Bearer valid-token")
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+	assert.Equal(t, 0, mockService.CreateBlogCallCount())
+}
+
+func TestBlogHandler_CloseComments_Success(t *testing.T) {
+	mockService := &servicefakes.FakeBlogService{}
+	blogID := uuid.New()
+	mockService.CloseCommentsReturns(service.GetBlogResponse{ID: blogID, CommentsClosed: true, CommentCount: 2}, nil)
+
+	blogHandler := handler.NewBlogHandler(logger.NewDiscardLogger(), mockService)
+	e := setupServer(t, blogHandler, http_server.Principal{UserID: uuid.New(), Roles: []string{rbac.RoleAuthor}})
+
+	req := httptest.NewRequest(http.MethodPost, "/v1/blogs/"+blogID.String()+"/comments/close", nil)
+	req.Header.Set(echo.HeaderAuthorization, "Bearer valid-token")
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, 1, mockService.CloseCommentsCallCount())
+	_, actualID := mockService.CloseCommentsArgsForCall(0)
+	assert.Equal(t, blogID, actualID)
+
+	var response struct {
+		Result service.GetBlogResponse `json:"result"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	assert.True(t, response.Result.CommentsClosed)
+	assert.Equal(t, int64(2), response.Result.CommentCount)
+}
+
+func TestBlogHandler_OpenComments_Forbidden(t *testing.T) {
+	mockService := &servicefakes.FakeBlogService{}
+	mockService.OpenCommentsReturns(service.GetBlogResponse{}, service.ErrBlogForbidden)
+
+	blogHandler := handler.NewBlogHandler(logger.NewDiscardLogger(), mockService)
+	e := setupServer(t, blogHandler, http_server.Principal{UserID: uuid.New(), Roles: []string{rbac.RoleAuthor}})
+
+	req := httptest.NewRequest(http.MethodPost, "/v1/blogs/"+uuid.NewString()+"/comments/open", nil)
+	req.Header.Set(echo.HeaderAuthorization, "Bearer valid-token")
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+
+	var response http_server.APIResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	assert.Equal(t, service.ErrBlogForbidden.Code, response.Error)
+}
+
+func TestBlogHandler_CloseComments_Reader(t *testing.T) {
+	mockService := &servicefakes.FakeBlogService{}
+	blogHandler := handler.NewBlogHandler(logger.NewDiscardLogger(), mockService)
+	e := setupServer(t, blogHandler, http_server.Principal{UserID: uuid.New(), Roles: []string{rbac.RoleReader}})
+
+	req := httptest.NewRequest(http.MethodPost, "/v1/blogs/"+uuid.NewString()+"/comments/close", nil)
+	req.Header.Set(echo.HeaderAuthorization, "Bearer valid-token")
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+	assert.Equal(t, 0, mockService.CloseCommentsCallCount())
+}
+
+func TestBlogHandler_SearchBlogs_Success(t *testing.T) {
+	mockService := &servicefakes.FakeBlogService{}
+	mockService.SearchBlogsReturns([]service.SearchBlogResponse{
+		{
+			GetBlogResponse: service.GetBlogResponse{ID: uuid.New(), Title: "Golang Tips"},
+			Relevance:       0.9,
+			TitleHighlight:  "<mark>Golang</mark> Tips",
+			Snippet:         "Write <mark>golang</mark>",
+		},
+	}, 11, nil)
+
+	blogHandler := handler.NewBlogHandler(logger.NewDiscardLogger(), mockService)
+	e := setupServer(t, blogHandler, http_server.Principal{})
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/blogs/search?q=%2Bgolang+-java&mode=boolean&status=published&sort=newest&page=2&page_size=5", nil)
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, 1, mockService.SearchBlogsCallCount())
+	_, actualReq := mockService.SearchBlogsArgsForCall(0)
+	assert.Equal(t, service.SearchBlogsRequest{
+		PaginationRequest: http_server.PaginationRequest{Page: 2, PageSize: 5},
+		Query:             "+golang -java",
+		BooleanMode:       true,
+		Status:            repository.StatusPublished,
+		Sort:              repository.SearchSortNewest,
+	}, actualReq)
+
+	var response struct {
+		Result     []service.SearchBlogResponse   `json:"result"`
+		Pagination http_server.PaginationResponse `json:"pagination"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	require.Len(t, response.Result, 1)
+	assert.Equal(t, "Golang Tips", response.Result[0].Title)
+	assert.Equal(t, "<mark>Golang</mark> Tips", response.Result[0].TitleHighlight)
+	assert.Equal(t, 0.9, response.Result[0].Relevance)
+	assert.Equal(t, int64(11), response.Pagination.TotalData)
+	assert.Equal(t, int64(3), response.Pagination.TotalPages)
+}
+
+func TestBlogHandler_SearchBlogs_Defaults(t *testing.T) {
+	mockService := &servicefakes.FakeBlogService{}
+	blogHandler := handler.NewBlogHandler(logger.NewDiscardLogger(), mockService)
+	e := setupServer(t, blogHandler, http_server.Principal{})
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/blogs/search?q=golang", nil)
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	_, actualReq := mockService.SearchBlogsArgsForCall(0)
+	assert.Equal(t, service.SearchBlogsRequest{
+		PaginationRequest: http_server.PaginationRequest{Page: 1, PageSize: 10},
+		Query:             "golang",
+	}, actualReq)
+}
+
+func TestBlogHandler_SearchBlogs_InvalidParams(t *testing.T) {
+	tests := []struct {
+		name  string
+		query string
+	}{
+		{name: "missing query", query: ""},
+		{name: "blank query", query: "?q=++"},
+		{name: "query too long", query: "?q=" + strings.Repeat("a", 201)},
+		{name: "unknown mode", query: "?q=golang&mode=regex"},
+		{name: "unknown status", query: "?q=golang&status=deleted"},
+		{name: "unknown sort", query: "?q=golang&sort=oldest"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := &servicefakes.FakeBlogService{}
+			blogHandler := handler.NewBlogHandler(logger.NewDiscardLogger(), mockService)
+			e := setupServer(t, blogHandler, http_server.Principal{})
+
+			req := httptest.NewRequest(http.MethodGet, "/v1/blogs/search"+tt.query, nil)
+			rec := httptest.NewRecorder()
+			e.ServeHTTP(rec, req)
+
+			assert.Equal(t, http.StatusBadRequest, rec.Code)
+			assert.Equal(t, 0, mockService.SearchBlogsCallCount())
+		})
+	}
+}
+
+func TestBlogHandler_SearchBlogs_InvalidBooleanQuery(t *testing.T) {
+	mockService := &servicefakes.FakeBlogService{}
+	mockService.SearchBlogsReturns(nil, 0, repository.ErrInvalidSearchQuery)
+
+	blogHandler := handler.NewBlogHandler(logger.NewDiscardLogger(), mockService)
+	e := setupServer(t, blogHandler, http_server.Principal{})
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/blogs/search?q=author%40example.com&mode=boolean", nil)
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+	var response http_server.APIResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	assert.Equal(t, repository.ErrInvalidSearchQuery.Code, response.Error)
+}
+
+func TestBlogHandler_ScheduleBlog_Success(t *testing.T) {
+	mockService := &servicefakes.FakeBlogService{}
+	blogID := uuid.New()
+	scheduledAt := time.Date(2030, 1, 2, 9, 0, 0, 0, time.UTC)
+	mockService.ScheduleBlogReturns(service.GetBlogResponse{ID: blogID, Status: repository.StatusScheduled, ScheduledAt: &scheduledAt}, nil)
+
+	blogHandler := handler.NewBlogHandler(logger.NewDiscardLogger(), mockService)
+	e := setupServer(t, blogHandler, http_server.Principal{UserID: uuid.New(), Roles: []string{rbac.RoleEditor}})
+
+	req := httptest.NewRequest(http.MethodPost, "/v1/blogs/"+blogID.String()+"/schedule", bytes.NewBufferString(`{"scheduled_at":"2030-01-02T10:00:00+01:00"}`))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	req.Header.Set(echo.HeaderAuthorization, "Bearer valid-token")
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, 1, mockService.ScheduleBlogCallCount())
+	_, actualID, actualReq := mockService.ScheduleBlogArgsForCall(0)
+	assert.Equal(t, blogID, actualID)
+	assert.True(t, scheduledAt.Equal(actualReq.ScheduledAt))
+
+	var response struct {
+		Result service.GetBlogResponse `json:"result"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	assert.Equal(t, repository.StatusScheduled, response.Result.Status)
+	require.NotNil(t, response.Result.ScheduledAt)
+	assert.True(t, scheduledAt.Equal(*response.Result.ScheduledAt))
+}
+
+func TestBlogHandler_ScheduleBlog_MissingTime(t *testing.T) {
+	mockService := &servicefakes.FakeBlogService{}
+	blogHandler := handler.NewBlogHandler(logger.NewDiscardLogger(), mockService)
+	e := setupServer(t, blogHandler, http_server.Principal{UserID: uuid.New(), Roles: []string{rbac.RoleEditor}})
+
+	req := httptest.NewRequest(http.MethodPost, "/v1/blogs/"+uuid.NewString()+"/schedule", bytes.NewBufferString(`{}`))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	req.Header.Set(echo.HeaderAuthorization, "Bearer valid-token")
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+	assert.Equal(t, 0, mockService.ScheduleBlogCallCount())
+}
+
+func TestBlogHandler_ScheduleBlog_NotInFuture(t *testing.T) {
+	mockService := &servicefakes.FakeBlogService{}
+	mockService.ScheduleBlogReturns(service.GetBlogResponse{}, service.ErrScheduleNotInFuture)
+
+	blogHandler := handler.NewBlogHandler(logger.NewDiscardLogger(), mockService)
+	e := setupServer(t, blogHandler, http_server.Principal{UserID: uuid.New(), Roles: []string{rbac.RoleEditor}})
+
+	req := httptest.NewRequest(http.MethodPost, "/v1/blogs/"+uuid.NewString()+"/schedule", bytes.NewBufferString(`{"scheduled_at":"2020-01-01T00:00:00Z"}`))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	req.Header.Set(echo.HeaderAuthorization, "Bearer valid-token")
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+	var response http_server.APIResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	assert.Equal(t, service.ErrScheduleNotInFuture.Code, response.Error)
+}
+
+func TestBlogHandler_ScheduleBlog_RequiresPublishPermission(t *testing.T) {
+	mockService := &servicefakes.FakeBlogService{}
+	blogHandler := handler.NewBlogHandler(logger.NewDiscardLogger(), mockService)
+	e := setupServer(t, blogHandler, http_server.Principal{UserID: uuid.New(), Roles: []string{rbac.RoleAuthor}})
+
+	req := httptest.NewRequest(http.MethodPost, "/v1/blogs/"+uuid.NewString()+"/schedule", bytes.NewBufferString(`{"scheduled_at":"2030-01-01T00:00:00Z"}`))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	req.Header.Set(echo.HeaderAuthorization, "Bearer valid-token")
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+	assert.Equal(t, 0, mockService.ScheduleBlogCallCount())
+}
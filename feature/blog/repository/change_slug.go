@@ -0,0 +1,92 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ChangeSlug gives the blog a new slug and keeps the old one in its slug history, in one
+// transaction. A blog can take back one of its own previous slugs, but a slug used by
+// another blog, currently or in its history, returns ErrSlugAlreadyTaken.
+func (r *blogRepository) ChangeSlug(ctx context.Context, id uuid.UUID, slug string) error {
+	err := r.withTx(ctx, "change slug", func(tx *sql.Tx) error {
+		var currentSlug string
+		err := tx.QueryRowContext(ctx, `SELECT slug FROM blogs WHERE id = ? FOR UPDATE`, id).Scan(&currentSlug)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return ErrBlogNotFound
+			}
+			r.log.Error("Failed to get blog slug",
+				slog.String("error", err.Error()),
+				slog.String("blog_id", id.String()),
+			)
+			return fmt.Errorf("%w: %w", ErrFailedToChangeSlug, err)
+		}
+
+		if currentSlug == slug {
+			return nil
+		}
+
+		var historyBlogID uuid.UUID
+		err = tx.QueryRowContext(ctx, `SELECT blog_id FROM blog_slug_history WHERE slug = ? FOR UPDATE`, slug).Scan(&historyBlogID)
+		if err != nil && err != sql.ErrNoRows {
+			r.log.Error("Failed to get slug history",
+				slog.String("error", err.Error()),
+				slog.String("slug", slug),
+			)
+			return fmt.Errorf("%w: %w", ErrFailedToChangeSlug, err)
+		}
+		if err == nil && historyBlogID != id {
+			return ErrSlugAlreadyTaken
+		}
+
+		now := time.Now()
+
+		statements := []struct {
+			query string
+			args  []any
+		}{
+			{
+				query: `DELETE FROM blog_slug_history WHERE slug = ?`,
+				args:  []any{slug},
+			},
+			{
+				query: `INSERT INTO blog_slug_history (slug, blog_id, created_at) VALUES (?, ?, ?)`,
+				args:  []any{currentSlug, id, now},
+			},
+			{
+				query: `UPDATE blogs SET slug = ?, updated_at = ? WHERE id = ?`,
+				args:  []any{slug, now, id},
+			},
+		}
+		for _, statement := range statements {
+			if _, err := tx.ExecContext(ctx, statement.query, statement.args...); err != nil {
+				if isDuplicateEntry(err) {
+					return ErrSlugAlreadyTaken
+				}
+				r.log.Error("Failed to change slug",
+					slog.String("error", err.Error()),
+					slog.String("blog_id", id.String()),
+				)
+				return fmt.Errorf("%w: %w", ErrFailedToChangeSlug, err)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	r.log.Info("Blog slug changed successfully",
+		slog.String("blog_id", id.String()),
+		slog.String("slug", slug),
+	)
+
+	return nil
+}
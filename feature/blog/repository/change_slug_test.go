@@ -0,0 +1,98 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/fikryfahrezy/let-it-go/feature/blog/repository"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createBlogWithSlug(t *testing.T, authorID uuid.UUID, title, slug string) repository.Blog {
+	t.Helper()
+
+	err := testRepository.Create(context.Background(), repository.Blog{
+		Title:    title,
+		Slug:     slug,
+		Content:  "This is a test blog content",
+		AuthorID: authorID,
+		Status:   repository.StatusDraft,
+	})
+	require.NoError(t, err)
+
+	blog, err := testRepository.GetBySlug(context.Background(), slug)
+	require.NoError(t, err)
+
+	return blog
+}
+
+func TestChangeSlug(t *testing.T) {
+	authorID := setupTest(t)
+	blog := createBlogWithSlug(t, authorID, "First Title", "first-title")
+
+	err := testRepository.ChangeSlug(context.Background(), blog.ID, "second-title")
+	require.NoError(t, err)
+
+	result, err := testRepository.GetByID(context.Background(), blog.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "second-title", result.Slug)
+
+	// The old slug is kept in the history
+	var historyBlogID uuid.UUID
+	err = db.QueryRow("SELECT blog_id FROM blog_slug_history WHERE slug = ?", "first-title").Scan(&historyBlogID)
+	require.NoError(t, err)
+	assert.Equal(t, blog.ID, historyBlogID)
+}
+
+func TestChangeSlugBackToPreviousSlug(t *testing.T) {
+	authorID := setupTest(t)
+	blog := createBlogWithSlug(t, authorID, "First Title", "first-title")
+
+	require.NoError(t, testRepository.ChangeSlug(context.Background(), blog.ID, "second-title"))
+	require.NoError(t, testRepository.ChangeSlug(context.Background(), blog.ID, "first-title"))
+
+	result, err := testRepository.GetBySlug(context.Background(), "first-title")
+	require.NoError(t, err)
+	assert.Equal(t, blog.ID, result.ID)
+
+	redirected, err := testRepository.GetByPreviousSlug(context.Background(), "second-title")
+	require.NoError(t, err)
+	assert.Equal(t, blog.ID, redirected.ID)
+}
+
+func TestChangeSlugTakenByAnotherBlog(t *testing.T) {
+	authorID := setupTest(t)
+	blog := createBlogWithSlug(t, authorID, "First Title", "first-title")
+	createBlogWithSlug(t, authorID, "Second Title", "second-title")
+
+	err := testRepository.ChangeSlug(context.Background(), blog.ID, "second-title")
+	assert.Equal(t, repository.ErrSlugAlreadyTaken, err)
+}
+
+func TestChangeSlugInAnotherBlogHistory(t *testing.T) {
+	authorID := setupTest(t)
+	blog := createBlogWithSlug(t, authorID, "First Title", "first-title")
+	other := createBlogWithSlug(t, authorID, "Second Title", "second-title")
+	require.NoError(t, testRepository.ChangeSlug(context.Background(), other.ID, "third-title"))
+
+	// Old slugs stay reserved so their redirects keep working
+	err := testRepository.ChangeSlug(context.Background(), blog.ID, "second-title")
+	assert.Equal(t, repository.ErrSlugAlreadyTaken, err)
+
+	err = testRepository.Create(context.Background(), repository.Blog{
+		Title:    "Second Title",
+		Slug:     "second-title",
+		AuthorID: authorID,
+		Status:   repository.StatusDraft,
+	})
+	assert.Equal(t, repository.ErrSlugAlreadyTaken, err)
+}
+
+func TestChangeSlugNotFound(t *testing.T) {
+	setupTest(t)
+
+	err := testRepository.ChangeSlug(context.Background(), uuid.New(), "missing-blog")
+	assert.Equal(t, repository.ErrBlogNotFound, err)
+}
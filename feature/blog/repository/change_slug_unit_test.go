@@ -0,0 +1,163 @@
+package repository_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/fikryfahrezy/let-it-go/feature/blog/repository"
+	"github.com/fikryfahrezy/let-it-go/pkg/database"
+	"github.com/fikryfahrezy/let-it-go/pkg/logger"
+	"github.com/go-sql-driver/mysql"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChangeSlugUnit(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	// nolint:errcheck
+	defer sqlDB.Close()
+
+	db := &database.DB{DB: sqlDB}
+	repo := repository.NewBlogRepository(logger.NewDiscardLogger(), db)
+	ctx := context.Background()
+
+	blogID := uuid.New()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT slug FROM blogs WHERE id = \\? FOR UPDATE").
+		WithArgs(blogID).
+		WillReturnRows(sqlmock.NewRows([]string{"slug"}).AddRow("old-title"))
+	mock.ExpectQuery("SELECT blog_id FROM blog_slug_history WHERE slug = \\? FOR UPDATE").
+		WithArgs("new-title").
+		WillReturnError(sql.ErrNoRows)
+	mock.ExpectExec("DELETE FROM blog_slug_history WHERE slug = ?").
+		WithArgs("new-title").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec("INSERT INTO blog_slug_history").
+		WithArgs("old-title", blogID, sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec("UPDATE blogs SET slug = \\?, updated_at = \\? WHERE id = \\?").
+		WithArgs("new-title", sqlmock.AnyArg(), blogID).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	err = repo.ChangeSlug(ctx, blogID, "new-title")
+	assert.NoError(t, err)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestChangeSlugUnchangedUnit(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	// nolint:errcheck
+	defer sqlDB.Close()
+
+	db := &database.DB{DB: sqlDB}
+	repo := repository.NewBlogRepository(logger.NewDiscardLogger(), db)
+	ctx := context.Background()
+
+	blogID := uuid.New()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT slug FROM blogs WHERE id = \\? FOR UPDATE").
+		WithArgs(blogID).
+		WillReturnRows(sqlmock.NewRows([]string{"slug"}).AddRow("same-title"))
+	mock.ExpectCommit()
+
+	err = repo.ChangeSlug(ctx, blogID, "same-title")
+	assert.NoError(t, err)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestChangeSlugNotFoundUnit(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	// nolint:errcheck
+	defer sqlDB.Close()
+
+	db := &database.DB{DB: sqlDB}
+	repo := repository.NewBlogRepository(logger.NewDiscardLogger(), db)
+	ctx := context.Background()
+
+	blogID := uuid.New()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT slug FROM blogs WHERE id = \\? FOR UPDATE").
+		WithArgs(blogID).
+		WillReturnError(sql.ErrNoRows)
+	mock.ExpectRollback()
+
+	err = repo.ChangeSlug(ctx, blogID, "new-title")
+	assert.Equal(t, repository.ErrBlogNotFound, err)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestChangeSlugInAnotherBlogHistoryUnit(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	// nolint:errcheck
+	defer sqlDB.Close()
+
+	db := &database.DB{DB: sqlDB}
+	repo := repository.NewBlogRepository(logger.NewDiscardLogger(), db)
+	ctx := context.Background()
+
+	blogID := uuid.New()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT slug FROM blogs WHERE id = \\? FOR UPDATE").
+		WithArgs(blogID).
+		WillReturnRows(sqlmock.NewRows([]string{"slug"}).AddRow("old-title"))
+	mock.ExpectQuery("SELECT blog_id FROM blog_slug_history WHERE slug = \\? FOR UPDATE").
+		WithArgs("new-title").
+		WillReturnRows(sqlmock.NewRows([]string{"blog_id"}).AddRow(uuid.New()))
+	mock.ExpectRollback()
+
+	err = repo.ChangeSlug(ctx, blogID, "new-title")
+	assert.Equal(t, repository.ErrSlugAlreadyTaken, err)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestChangeSlugTakenUnit(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	// nolint:errcheck
+	defer sqlDB.Close()
+
+	db := &database.DB{DB: sqlDB}
+	repo := repository.NewBlogRepository(logger.NewDiscardLogger(), db)
+	ctx := context.Background()
+
+	blogID := uuid.New()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT slug FROM blogs WHERE id = \\? FOR UPDATE").
+		WithArgs(blogID).
+		WillReturnRows(sqlmock.NewRows([]string{"slug"}).AddRow("old-title"))
+	mock.ExpectQuery("SELECT blog_id FROM blog_slug_history WHERE slug = \\? FOR UPDATE").
+		WithArgs("new-title").
+		WillReturnError(sql.ErrNoRows)
+	mock.ExpectExec("DELETE FROM blog_slug_history WHERE slug = ?").
+		WithArgs("new-title").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec("INSERT INTO blog_slug_history").
+		WithArgs("old-title", blogID, sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec("UPDATE blogs SET slug = \\?, updated_at = \\? WHERE id = \\?").
+		WithArgs("new-title", sqlmock.AnyArg(), blogID).
+		WillReturnError(&mysql.MySQLError{Number: 1062, Message: "Duplicate entry 'new-title' for key 'idx_slug'"})
+	mock.ExpectRollback()
+
+	err = repo.ChangeSlug(ctx, blogID, "new-title")
+	assert.Equal(t, repository.ErrSlugAlreadyTaken, err)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,27 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/google/uuid"
+)
+
+// CountByAuthorIDAndStatus is CountByStatus scoped to the blogs of one author
+func (r *blogRepository) CountByAuthorIDAndStatus(ctx context.Context, authorID uuid.UUID, status string) (int64, error) {
+	query := `SELECT COUNT(*) FROM blogs WHERE author_id = ? AND status = ?`
+
+	var count int64
+	err := r.db.QueryRowContext(ctx, query, authorID, status).Scan(&count)
+	if err != nil {
+		r.log.Error("Failed to count blogs by author ID and status",
+			slog.String("error", err.Error()),
+			slog.String("author_id", authorID.String()),
+			slog.String("status", status),
+		)
+		return 0, fmt.Errorf("%w: %w", ErrFailedToCountBlogsByStatus, err)
+	}
+
+	return count, nil
+}
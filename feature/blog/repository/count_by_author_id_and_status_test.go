@@ -0,0 +1,48 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/fikryfahrezy/let-it-go/feature/blog/repository"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCountByAuthorIDAndStatus(t *testing.T) {
+	authorID := setupTest(t)
+	ctx := context.Background()
+
+	// Create another author
+	author2ID := uuid.New()
+	_, err := db.Exec("INSERT INTO users (id, name, email, password) VALUES (?, ?, ?, ?)",
+		author2ID, "Test Author 2", "author2@example.com", "password")
+	require.NoError(t, err)
+
+	blogs := []repository.Blog{
+		{Title: "Draft 1", Content: "Content 1", AuthorID: authorID, Status: repository.StatusDraft},
+		{Title: "Draft 2", Content: "Content 2", AuthorID: authorID, Status: repository.StatusDraft},
+		{Title: "Published", Content: "Content 3", AuthorID: authorID, Status: repository.StatusPublished},
+		{Title: "Other Draft", Content: "Content 4", AuthorID: author2ID, Status: repository.StatusDraft},
+	}
+	for _, blog := range blogs {
+		require.NoError(t, testRepository.Create(ctx, blog))
+	}
+
+	count, err := testRepository.CountByAuthorIDAndStatus(ctx, authorID, repository.StatusDraft)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), count)
+
+	count, err = testRepository.CountByAuthorIDAndStatus(ctx, authorID, repository.StatusPublished)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), count)
+
+	count, err = testRepository.CountByAuthorIDAndStatus(ctx, author2ID, repository.StatusDraft)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), count)
+
+	count, err = testRepository.CountByAuthorIDAndStatus(ctx, author2ID, repository.StatusArchived)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), count)
+}
@@ -0,0 +1,60 @@
+package repository_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/fikryfahrezy/let-it-go/feature/blog/repository"
+	"github.com/fikryfahrezy/let-it-go/pkg/database"
+	"github.com/fikryfahrezy/let-it-go/pkg/logger"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCountByAuthorIDAndStatusBlogUnit(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	// nolint:errcheck
+	defer sqlDB.Close()
+
+	db := &database.DB{DB: sqlDB}
+	repo := repository.NewBlogRepository(logger.NewDiscardLogger(), db)
+	ctx := context.Background()
+
+	authorID := uuid.New()
+
+	rows := sqlmock.NewRows([]string{"count"}).AddRow(4)
+	mock.ExpectQuery("SELECT COUNT\\(\\*\\) FROM blogs WHERE author_id = \\? AND status = \\?").
+		WithArgs(authorID, repository.StatusDraft).
+		WillReturnRows(rows)
+
+	count, err := repo.CountByAuthorIDAndStatus(ctx, authorID, repository.StatusDraft)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(4), count)
+
+	// Verify all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCountByAuthorIDAndStatusBlogErrorUnit(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	// nolint:errcheck
+	defer sqlDB.Close()
+
+	db := &database.DB{DB: sqlDB}
+	repo := repository.NewBlogRepository(logger.NewDiscardLogger(), db)
+	ctx := context.Background()
+
+	mock.ExpectQuery("SELECT COUNT\\(\\*\\) FROM blogs WHERE author_id = \\? AND status = \\?").
+		WillReturnError(sql.ErrConnDone)
+
+	_, err = repo.CountByAuthorIDAndStatus(ctx, uuid.New(), repository.StatusDraft)
+	assert.ErrorIs(t, err, repository.ErrFailedToCountBlogsByStatus)
+
+	// Verify all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,39 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+)
+
+// CountByTags counts the blogs ListByTags lists
+func (r *blogRepository) CountByTags(ctx context.Context, tags []string, matchAll bool) (int64, error) {
+	if len(tags) == 0 {
+		return 0, nil
+	}
+
+	query := `
+		SELECT COUNT(*)
+		FROM (
+			SELECT bt.blog_id
+			FROM blog_tags bt
+			JOIN tags t ON t.id = bt.tag_id
+			WHERE t.name IN (` + placeholders(len(tags)) + `)
+			GROUP BY bt.blog_id
+			HAVING COUNT(*) >= ?
+		) AS matching_blogs
+	`
+
+	args := append(stringsToArgs(tags), matchingTagCount(tags, matchAll))
+
+	var count int64
+	err := r.db.QueryRowContext(ctx, query, args...).Scan(&count)
+	if err != nil {
+		r.log.Error("Failed to count blogs by tags",
+			slog.String("error", err.Error()),
+		)
+		return 0, fmt.Errorf("%w: %w", ErrFailedToCountBlogs, err)
+	}
+
+	return count, nil
+}
@@ -0,0 +1,25 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCountByTags(t *testing.T) {
+	authorID := setupTest(t)
+
+	blog := createBlogWithSlug(t, authorID, "Go Blog", "go-blog")
+	require.NoError(t, testRepository.SetTags(context.Background(), blog.ID, []string{"go"}))
+
+	// A tag repeated in the filter still only needs to match once
+	count, err := testRepository.CountByTags(context.Background(), []string{"go", "go"}, true)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), count)
+
+	count, err = testRepository.CountByTags(context.Background(), []string{"mysql"}, false)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), count)
+}
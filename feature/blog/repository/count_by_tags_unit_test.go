@@ -0,0 +1,55 @@
+package repository_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/fikryfahrezy/let-it-go/feature/blog/repository"
+	"github.com/fikryfahrezy/let-it-go/pkg/database"
+	"github.com/fikryfahrezy/let-it-go/pkg/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCountByTagsUnit(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	// nolint:errcheck
+	defer sqlDB.Close()
+
+	db := &database.DB{DB: sqlDB}
+	repo := repository.NewBlogRepository(logger.NewDiscardLogger(), db)
+	ctx := context.Background()
+
+	rows := sqlmock.NewRows([]string{"count"}).AddRow(int64(4))
+	mock.ExpectQuery("SELECT COUNT\\(\\*\\) FROM \\( SELECT bt.blog_id (.+) WHERE t.name IN \\(\\?, \\?\\) GROUP BY bt.blog_id HAVING COUNT\\(\\*\\) >= \\? \\) AS matching_blogs").
+		WithArgs("go", "mysql", 2).
+		WillReturnRows(rows)
+
+	count, err := repo.CountByTags(ctx, []string{"go", "mysql"}, true)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(4), count)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCountByTagsErrorUnit(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	// nolint:errcheck
+	defer sqlDB.Close()
+
+	db := &database.DB{DB: sqlDB}
+	repo := repository.NewBlogRepository(logger.NewDiscardLogger(), db)
+	ctx := context.Background()
+
+	mock.ExpectQuery("SELECT COUNT").
+		WillReturnError(errors.New("database connection error"))
+
+	_, err = repo.CountByTags(ctx, []string{"go"}, false)
+	assert.ErrorIs(t, err, repository.ErrFailedToCountBlogs)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
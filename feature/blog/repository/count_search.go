@@ -0,0 +1,28 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+)
+
+// CountSearch counts the blogs Search finds
+func (r *blogRepository) CountSearch(ctx context.Context, filter SearchFilter) (int64, error) {
+	condition, args := searchCondition(filter)
+
+	query := `SELECT COUNT(*) FROM blogs WHERE ` + condition
+
+	var count int64
+	err := r.db.QueryRowContext(ctx, query, args...).Scan(&count)
+	if err != nil {
+		if isFullTextSyntaxError(err) {
+			return 0, fmt.Errorf("%w: %w", ErrInvalidSearchQuery, err)
+		}
+		r.log.Error("Failed to count searched blogs",
+			slog.String("error", err.Error()),
+		)
+		return 0, fmt.Errorf("%w: %w", ErrFailedToCountBlogs, err)
+	}
+
+	return count, nil
+}
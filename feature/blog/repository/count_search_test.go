@@ -0,0 +1,23 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/fikryfahrezy/let-it-go/feature/blog/repository"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCountSearch(t *testing.T) {
+	authorID := setupTest(t)
+	createSearchableBlogs(t, authorID)
+
+	// Words shorter than the minimum token size are not indexed and match nothing
+	count, err := testRepository.CountSearch(context.Background(), repository.SearchFilter{Query: "to"})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), count)
+
+	count, err = testRepository.CountSearch(context.Background(), repository.SearchFilter{Query: "mysql indexes"})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), count)
+}
@@ -0,0 +1,73 @@
+package repository_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/fikryfahrezy/let-it-go/feature/blog/repository"
+	"github.com/fikryfahrezy/let-it-go/pkg/database"
+	"github.com/fikryfahrezy/let-it-go/pkg/logger"
+	"github.com/go-sql-driver/mysql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCountSearchUnit(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	// nolint:errcheck
+	defer sqlDB.Close()
+
+	db := &database.DB{DB: sqlDB}
+	repo := repository.NewBlogRepository(logger.NewDiscardLogger(), db)
+	ctx := context.Background()
+
+	rows := sqlmock.NewRows([]string{"count"}).AddRow(int64(3))
+	mock.ExpectQuery("SELECT COUNT\\(\\*\\) FROM blogs WHERE MATCH\\(title, content\\) AGAINST \\(\\? IN BOOLEAN MODE\\) AND status = \\?").
+		WithArgs("golang*", repository.StatusPublished).
+		WillReturnRows(rows)
+
+	count, err := repo.CountSearch(ctx, repository.SearchFilter{Query: "golang*", BooleanMode: true, Status: repository.StatusPublished})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), count)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCountSearchInvalidQueryUnit(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	// nolint:errcheck
+	defer sqlDB.Close()
+
+	db := &database.DB{DB: sqlDB}
+	repo := repository.NewBlogRepository(logger.NewDiscardLogger(), db)
+
+	mock.ExpectQuery("SELECT COUNT").
+		WillReturnError(&mysql.MySQLError{Number: 1064, Message: "syntax error, unexpected '@', expecting $end"})
+
+	_, err = repo.CountSearch(context.Background(), repository.SearchFilter{Query: "author@example.com", BooleanMode: true})
+	assert.ErrorIs(t, err, repository.ErrInvalidSearchQuery)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCountSearchErrorUnit(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	// nolint:errcheck
+	defer sqlDB.Close()
+
+	db := &database.DB{DB: sqlDB}
+	repo := repository.NewBlogRepository(logger.NewDiscardLogger(), db)
+
+	mock.ExpectQuery("SELECT COUNT").
+		WillReturnError(errors.New("database connection error"))
+
+	_, err = repo.CountSearch(context.Background(), repository.SearchFilter{Query: "golang"})
+	assert.ErrorIs(t, err, repository.ErrFailedToCountBlogs)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -2,17 +2,25 @@ package repository
 
 import (
 	"context"
+	"database/sql"
+	"errors"
 	"fmt"
 	"log/slog"
 	"time"
 
+	"github.com/go-sql-driver/mysql"
 	"github.com/google/uuid"
 )
 
+// Create inserts the blog along with its tags. A blog without a slug gets its ID as slug.
+// The slug must not be used by another blog, currently or in its slug history, or
+// ErrSlugAlreadyTaken is returned.
 func (r *blogRepository) Create(ctx context.Context, blog Blog) error {
 	query := `
-		INSERT INTO blogs (id, title, content, author_id, status, published_at, created_at, updated_at)
-		VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+		INSERT INTO blogs (id, title, slug, content, author_id, status, published_at, created_at, updated_at)
+		SELECT ?, ?, ?, ?, ?, ?, ?, ?, ?
+		FROM DUAL
+		WHERE NOT EXISTS (SELECT 1 FROM blog_slug_history WHERE slug = ?)
 	`
 
 	now := time.Now()
@@ -21,14 +29,44 @@ func (r *blogRepository) Create(ctx context.Context, blog Blog) error {
 
 	// Generate UUIDv7 for the blog ID
 	blog.ID = uuid.Must(uuid.NewV7())
+	if blog.Slug == "" {
+		blog.Slug = blog.ID.String()
+	}
+
+	err := r.withTx(ctx, "create blog", func(tx *sql.Tx) error {
+		result, err := tx.ExecContext(ctx, query, blog.ID, blog.Title, blog.Slug, blog.Content, blog.AuthorID, blog.Status, blog.PublishedAt, now, now, blog.Slug)
+		if err != nil {
+			if isDuplicateEntry(err) {
+				return ErrSlugAlreadyTaken
+			}
+			r.log.Error("Failed to create blog",
+				slog.String("error", err.Error()),
+				slog.String("title", blog.Title),
+			)
+			return fmt.Errorf("%w: %w", ErrFailedToCreateBlog, err)
+		}
+
+		rowsAffected, err := result.RowsAffected()
+		if err != nil {
+			r.log.Error("Failed to get rows affected",
+				slog.String("error", err.Error()),
+			)
+			return fmt.Errorf("%w: %w", ErrFailedToGetRowsAffected, err)
+		}
+
+		// Nothing is inserted when the slug is in the history of another blog
+		if rowsAffected == 0 {
+			return ErrSlugAlreadyTaken
+		}
 
-	_, err := r.db.ExecContext(ctx, query, blog.ID, blog.Title, blog.Content, blog.AuthorID, blog.Status, blog.PublishedAt, now, now)
+		if err := r.addTags(ctx, tx, blog.ID, blog.Tags); err != nil {
+			return err
+		}
+
+		return nil
+	})
 	if err != nil {
-		r.log.Error("Failed to create blog",
-			slog.String("error", err.Error()),
-			slog.String("title", blog.Title),
-		)
-		return fmt.Errorf("%w: %w", ErrFailedToCreateBlog, err)
+		return err
 	}
 
 	// No need to get last insert ID since we're using UUIDs
@@ -40,3 +78,11 @@ func (r *blogRepository) Create(ctx context.Context, blog Blog) error {
 
 	return nil
 }
+
+// mysqlErrDuplicateEntry is the MySQL error number of a unique key violation
+const mysqlErrDuplicateEntry = 1062
+
+func isDuplicateEntry(err error) bool {
+	var mysqlErr *mysql.MySQLError
+	return errors.As(err, &mysqlErr) && mysqlErr.Number == mysqlErrDuplicateEntry
+}
@@ -2,6 +2,7 @@ package repository_test
 
 import (
 	"context"
+	"errors"
 	"testing"
 	"time"
 
@@ -9,6 +10,7 @@ import (
 	"github.com/fikryfahrezy/let-it-go/feature/blog/repository"
 	"github.com/fikryfahrezy/let-it-go/pkg/database"
 	"github.com/fikryfahrezy/let-it-go/pkg/logger"
+	"github.com/go-sql-driver/mysql"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -27,15 +29,18 @@ func TestCreateBlogUnit(t *testing.T) {
 	authorID := uuid.New()
 	blog := repository.Blog{
 		Title:    "Test Blog",
+		Slug:     "test-blog",
 		Content:  "This is a test blog content",
 		AuthorID: authorID,
 		Status:   repository.StatusDraft,
 	}
 
 	// Mock the INSERT query
+	mock.ExpectBegin()
 	mock.ExpectExec("INSERT INTO blogs").
-		WithArgs(sqlmock.AnyArg(), blog.Title, blog.Content, blog.AuthorID, blog.Status, blog.PublishedAt, sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WithArgs(sqlmock.AnyArg(), blog.Title, blog.Slug, blog.Content, blog.AuthorID, blog.Status, blog.PublishedAt, sqlmock.AnyArg(), sqlmock.AnyArg(), blog.Slug).
 		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
 
 	err = repo.Create(ctx, blog)
 	assert.NoError(t, err)
@@ -58,6 +63,7 @@ func TestCreateBlogWithPublishedStatusUnit(t *testing.T) {
 	publishedAt := time.Now()
 	blog := repository.Blog{
 		Title:       "Published Blog",
+		Slug:        "published-blog",
 		Content:     "This is a published blog content",
 		AuthorID:    authorID,
 		Status:      repository.StatusPublished,
@@ -65,9 +71,11 @@ func TestCreateBlogWithPublishedStatusUnit(t *testing.T) {
 	}
 
 	// Mock the INSERT query
+	mock.ExpectBegin()
 	mock.ExpectExec("INSERT INTO blogs").
-		WithArgs(sqlmock.AnyArg(), blog.Title, blog.Content, blog.AuthorID, blog.Status, blog.PublishedAt, sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WithArgs(sqlmock.AnyArg(), blog.Title, blog.Slug, blog.Content, blog.AuthorID, blog.Status, blog.PublishedAt, sqlmock.AnyArg(), sqlmock.AnyArg(), blog.Slug).
 		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
 
 	err = repo.Create(ctx, blog)
 	assert.NoError(t, err)
@@ -75,3 +83,111 @@ func TestCreateBlogWithPublishedStatusUnit(t *testing.T) {
 	// Verify all expectations were met
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
+
+func TestCreateBlogSlugInHistoryUnit(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	// nolint:errcheck
+	defer sqlDB.Close()
+
+	db := &database.DB{DB: sqlDB}
+	repo := repository.NewBlogRepository(logger.NewDiscardLogger(), db)
+	ctx := context.Background()
+
+	// Nothing is inserted when another blog used to have the slug
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO blogs").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectRollback()
+
+	err = repo.Create(ctx, repository.Blog{Title: "Test Blog", Slug: "test-blog", AuthorID: uuid.New()})
+	assert.Equal(t, repository.ErrSlugAlreadyTaken, err)
+
+	// Verify all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCreateBlogDuplicateSlugUnit(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	// nolint:errcheck
+	defer sqlDB.Close()
+
+	db := &database.DB{DB: sqlDB}
+	repo := repository.NewBlogRepository(logger.NewDiscardLogger(), db)
+	ctx := context.Background()
+
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO blogs").
+		WillReturnError(&mysql.MySQLError{Number: 1062, Message: "Duplicate entry 'test-blog' for key 'blogs.idx_slug'"})
+	mock.ExpectRollback()
+
+	err = repo.Create(ctx, repository.Blog{Title: "Test Blog", Slug: "test-blog", AuthorID: uuid.New()})
+	assert.Equal(t, repository.ErrSlugAlreadyTaken, err)
+
+	// Verify all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCreateBlogWithTagsUnit(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	// nolint:errcheck
+	defer sqlDB.Close()
+
+	db := &database.DB{DB: sqlDB}
+	repo := repository.NewBlogRepository(logger.NewDiscardLogger(), db)
+	ctx := context.Background()
+
+	blog := repository.Blog{
+		Title:    "Test Blog",
+		Slug:     "test-blog",
+		Content:  "This is a test blog content",
+		AuthorID: uuid.New(),
+		Status:   repository.StatusDraft,
+		Tags:     []string{"go", "mysql"},
+	}
+
+	// The blog and its tags are inserted in one transaction
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO blogs").
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec("INSERT INTO tags \\(id, name, created_at\\) VALUES \\(\\?, \\?, \\?\\), \\(\\?, \\?, \\?\\) ON DUPLICATE KEY UPDATE").
+		WithArgs(sqlmock.AnyArg(), "go", sqlmock.AnyArg(), sqlmock.AnyArg(), "mysql", sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectExec("INSERT INTO blog_tags \\(blog_id, tag_id\\) SELECT \\?, id FROM tags WHERE name IN \\(\\?, \\?\\)").
+		WithArgs(sqlmock.AnyArg(), "go", "mysql").
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectCommit()
+
+	err = repo.Create(ctx, blog)
+	assert.NoError(t, err)
+
+	// Verify all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCreateBlogTagsErrorUnit(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	// nolint:errcheck
+	defer sqlDB.Close()
+
+	db := &database.DB{DB: sqlDB}
+	repo := repository.NewBlogRepository(logger.NewDiscardLogger(), db)
+	ctx := context.Background()
+
+	// The blog is not kept when its tags cannot be saved
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO blogs").
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec("INSERT INTO tags").
+		WillReturnError(errors.New("database connection error"))
+	mock.ExpectRollback()
+
+	err = repo.Create(ctx, repository.Blog{Title: "Test Blog", Slug: "test-blog", AuthorID: uuid.New(), Tags: []string{"go"}})
+	assert.ErrorIs(t, err, repository.ErrFailedToSetTags)
+
+	// Verify all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
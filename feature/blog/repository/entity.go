@@ -7,18 +7,63 @@ import (
 )
 
 type Blog struct {
-	ID          uuid.UUID `db:"id"` // UUIDv7
-	Title       string    `db:"title"`
-	Content     string    `db:"content"`
-	AuthorID    uuid.UUID `db:"author_id"` // UUIDv7
-	Status      string    `db:"status"`
-	PublishedAt *time.Time `db:"published_at"`
-	CreatedAt   time.Time `db:"created_at"`
-	UpdatedAt   time.Time `db:"updated_at"`
+	ID             uuid.UUID  `db:"id"` // UUIDv7
+	Title          string     `db:"title"`
+	Slug           string     `db:"slug"` // Unique and URL safe, derived from the title
+	Content        string     `db:"content"`
+	AuthorID       uuid.UUID  `db:"author_id"` // UUIDv7
+	Status         string     `db:"status"`
+	CommentsClosed bool       `db:"comments_closed"` // Set by the author to stop new comments
+	PublishedAt    *time.Time `db:"published_at"`
+	ScheduledAt    *time.Time `db:"scheduled_at"` // When a scheduled blog gets published
+	CreatedAt      time.Time  `db:"created_at"`
+	UpdatedAt      time.Time  `db:"updated_at"`
+	Tags           []string   `db:"-"` // Names of the tags, stored in blog_tags
 }
 
 const (
 	StatusDraft     = "draft"
 	StatusPublished = "published"
 	StatusArchived  = "archived"
-)
\ No newline at end of file
+	StatusScheduled = "scheduled"
+)
+
+// SlugHistory is a slug a blog had before its title changed. It stays reserved for
+// the blog so links using it can be redirected to the current slug.
+type SlugHistory struct {
+	Slug      string    `db:"slug"`
+	BlogID    uuid.UUID `db:"blog_id"` // UUIDv7
+	CreatedAt time.Time `db:"created_at"`
+}
+
+// Tag classifies blogs. Blogs using the same name share one tag.
+type Tag struct {
+	ID        uuid.UUID `db:"id"` // UUIDv7
+	Name      string    `db:"name"`
+	CreatedAt time.Time `db:"created_at"`
+}
+
+// TagUsage is a tag with the number of blogs using it
+type TagUsage struct {
+	Name      string `db:"name"`
+	BlogCount int64  `db:"blog_count"`
+}
+
+// SearchFilter selects the blogs Search finds
+type SearchFilter struct {
+	Query       string
+	BooleanMode bool   // Query uses the boolean mode operators such as +, -, * and quotes
+	Status      string // Blogs of any status when empty
+	Sort        string // SearchSortRelevance or SearchSortNewest
+}
+
+const (
+	SearchSortRelevance = "relevance"
+	SearchSortNewest    = "newest"
+)
+
+// SearchResult is a blog found by Search with how well it matches the query
+type SearchResult struct {
+	Blog
+	Relevance float64 `db:"relevance"`
+}
@@ -8,16 +8,36 @@ var (
 	ErrBlogNotFound = app_error.New("BLOG-BLOG_NOT_FOUND", "blog not found")
 
 	// Database operation errors
-	ErrFailedToCreateBlog       = app_error.New("BLOG-FAILED_TO_CREATE_BLOG", "failed to create blog")
-	ErrFailedToGetBlog          = app_error.New("BLOG-FAILED_TO_GET_BLOG", "failed to get blog by ID")
-	ErrFailedToGetBlogsByAuthor = app_error.New("BLOG-FAILED_TO_GET_BLOGS_BY_AUTHOR", "failed to get blogs by author ID")
-	ErrFailedToGetBlogsByStatus = app_error.New("BLOG-FAILED_TO_GET_BLOGS_BY_STATUS", "failed to get blogs by status")
-	ErrFailedToUpdateBlog       = app_error.New("BLOG-FAILED_TO_UPDATE_BLOG", "failed to update blog")
-	ErrFailedToDeleteBlog       = app_error.New("BLOG-FAILED_TO_DELETE_BLOG", "failed to delete blog")
-	ErrFailedToListBlogs        = app_error.New("BLOG-FAILED_TO_LIST_BLOGS", "failed to list blogs")
-	ErrFailedToCountBlogs       = app_error.New("BLOG-FAILED_TO_COUNT_BLOGS", "failed to count blogs")
+	ErrFailedToCreateBlog         = app_error.New("BLOG-FAILED_TO_CREATE_BLOG", "failed to create blog")
+	ErrFailedToGetBlog            = app_error.New("BLOG-FAILED_TO_GET_BLOG", "failed to get blog by ID")
+	ErrFailedToGetBlogsByAuthor   = app_error.New("BLOG-FAILED_TO_GET_BLOGS_BY_AUTHOR", "failed to get blogs by author ID")
+	ErrFailedToGetBlogsByStatus   = app_error.New("BLOG-FAILED_TO_GET_BLOGS_BY_STATUS", "failed to get blogs by status")
+	ErrFailedToUpdateBlog         = app_error.New("BLOG-FAILED_TO_UPDATE_BLOG", "failed to update blog")
+	ErrFailedToDeleteBlog         = app_error.New("BLOG-FAILED_TO_DELETE_BLOG", "failed to delete blog")
+	ErrFailedToListBlogs          = app_error.New("BLOG-FAILED_TO_LIST_BLOGS", "failed to list blogs")
+	ErrFailedToCountBlogs         = app_error.New("BLOG-FAILED_TO_COUNT_BLOGS", "failed to count blogs")
 	ErrFailedToCountBlogsByStatus = app_error.New("BLOG-FAILED_TO_COUNT_BLOGS_BY_STATUS", "failed to count blogs by status")
 
+	// Slug errors
+	ErrSlugAlreadyTaken      = app_error.New("BLOG-SLUG_ALREADY_TAKEN", "slug is already used by another blog")
+	ErrFailedToGetBlogBySlug = app_error.New("BLOG-FAILED_TO_GET_BLOG_BY_SLUG", "failed to get blog by slug")
+	ErrFailedToChangeSlug    = app_error.New("BLOG-FAILED_TO_CHANGE_SLUG", "failed to change blog slug")
+
+	// Comment errors
+	ErrFailedToSetCommentsClosed = app_error.New("BLOG-FAILED_TO_SET_COMMENTS_CLOSED", "failed to open or close blog comments")
+
+	// Tag errors
+	ErrFailedToSetTags  = app_error.New("BLOG-FAILED_TO_SET_TAGS", "failed to set blog tags")
+	ErrFailedToGetTags  = app_error.New("BLOG-FAILED_TO_GET_TAGS", "failed to get blog tags")
+	ErrFailedToListTags = app_error.New("BLOG-FAILED_TO_LIST_TAGS", "failed to list tags")
+
+	// Scheduling errors
+	ErrBlogNotScheduled = app_error.New("BLOG-NOT_SCHEDULED", "blog is no longer scheduled")
+
+	// Search errors
+	ErrInvalidSearchQuery  = app_error.New("BLOG-INVALID_SEARCH_QUERY", "search query is not valid in boolean mode")
+	ErrFailedToSearchBlogs = app_error.New("BLOG-FAILED_TO_SEARCH_BLOGS", "failed to search blogs")
+
 	// Row scanning errors
 	ErrFailedToScanBlogRow = app_error.New("BLOG-FAILED_TO_SCAN_BLOG_ROW", "failed to scan blog row")
 
@@ -25,4 +45,6 @@ var (
 	ErrFailedToGetLastInsertID = app_error.New("BLOG-FAILED_TO_GET_LAST_INSERT_ID", "failed to get last insert id")
 	ErrFailedToGetRowsAffected = app_error.New("BLOG-FAILED_TO_GET_ROWS_AFFECTED", "failed to get rows affected")
 	ErrFailedToIterateRows     = app_error.New("BLOG-FAILED_TO_ITERATE_ROWS", "error iterating blog rows")
-)
\ No newline at end of file
+	ErrFailedToBeginTx         = app_error.New("BLOG-FAILED_TO_BEGIN_TX", "failed to begin transaction")
+	ErrFailedToCommitTx        = app_error.New("BLOG-FAILED_TO_COMMIT_TX", "failed to commit transaction")
+)
@@ -10,7 +10,7 @@ import (
 
 func (r *blogRepository) GetByAuthorID(ctx context.Context, authorID uuid.UUID, limit, offset int) ([]Blog, error) {
 	query := `
-		SELECT id, title, content, author_id, status, published_at, created_at, updated_at
+		SELECT id, title, slug, content, author_id, status, comments_closed, published_at, scheduled_at, created_at, updated_at
 		FROM blogs
 		WHERE author_id = ?
 		ORDER BY created_at DESC
@@ -37,10 +37,13 @@ func (r *blogRepository) GetByAuthorID(ctx context.Context, authorID uuid.UUID,
 		err := rows.Scan(
 			&blog.ID,
 			&blog.Title,
+			&blog.Slug,
 			&blog.Content,
 			&blog.AuthorID,
 			&blog.Status,
+			&blog.CommentsClosed,
 			&blog.PublishedAt,
+			&blog.ScheduledAt,
 			&blog.CreatedAt,
 			&blog.UpdatedAt,
 		)
@@ -60,5 +63,9 @@ func (r *blogRepository) GetByAuthorID(ctx context.Context, authorID uuid.UUID,
 		return nil, fmt.Errorf("%w: %w", ErrFailedToIterateRows, err)
 	}
 
+	if err := r.loadTags(ctx, blogs); err != nil {
+		return nil, err
+	}
+
 	return blogs, nil
 }
@@ -47,14 +47,16 @@ func TestGetByAuthorIDUnit(t *testing.T) {
 	}
 
 	// Mock the SELECT query
-	rows := sqlmock.NewRows([]string{"id", "title", "content", "author_id", "status", "published_at", "created_at", "updated_at"})
+	rows := sqlmock.NewRows([]string{"id", "title", "slug", "content", "author_id", "status", "comments_closed", "published_at", "scheduled_at", "created_at", "updated_at"})
 	for _, blog := range blogs {
-		rows.AddRow(blog.ID, blog.Title, blog.Content, blog.AuthorID, blog.Status, blog.PublishedAt, blog.CreatedAt, blog.UpdatedAt)
+		rows.AddRow(blog.ID, blog.Title, blog.Slug, blog.Content, blog.AuthorID, blog.Status, false, blog.PublishedAt, nil, blog.CreatedAt, blog.UpdatedAt)
 	}
 
 	mock.ExpectQuery("SELECT (.+) FROM blogs WHERE author_id = (.+) ORDER BY created_at DESC LIMIT (.+) OFFSET (.+)").
 		WithArgs(authorID, 10, 0).
 		WillReturnRows(rows)
+	mock.ExpectQuery("SELECT bt.blog_id, t.name FROM blog_tags bt JOIN tags t").
+		WillReturnRows(sqlmock.NewRows([]string{"blog_id", "name"}))
 
 	result, err := repo.GetByAuthorID(ctx, authorID, 10, 0)
 	assert.NoError(t, err)
@@ -11,7 +11,7 @@ import (
 
 func (r *blogRepository) GetByID(ctx context.Context, id uuid.UUID) (Blog, error) {
 	query := `
-		SELECT id, title, content, author_id, status, published_at, created_at, updated_at
+		SELECT id, title, slug, content, author_id, status, comments_closed, published_at, scheduled_at, created_at, updated_at
 		FROM blogs
 		WHERE id = ?
 	`
@@ -20,10 +20,13 @@ func (r *blogRepository) GetByID(ctx context.Context, id uuid.UUID) (Blog, error
 	err := r.db.QueryRowContext(ctx, query, id).Scan(
 		&blog.ID,
 		&blog.Title,
+		&blog.Slug,
 		&blog.Content,
 		&blog.AuthorID,
 		&blog.Status,
+		&blog.CommentsClosed,
 		&blog.PublishedAt,
+		&blog.ScheduledAt,
 		&blog.CreatedAt,
 		&blog.UpdatedAt,
 	)
@@ -38,5 +41,10 @@ func (r *blogRepository) GetByID(ctx context.Context, id uuid.UUID) (Blog, error
 		return Blog{}, fmt.Errorf("%w: %w", ErrFailedToGetBlog, err)
 	}
 
-	return blog, nil
+	blogs := []Blog{blog}
+	if err := r.loadTags(ctx, blogs); err != nil {
+		return Blog{}, err
+	}
+
+	return blogs[0], nil
 }
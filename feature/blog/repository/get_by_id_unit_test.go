@@ -40,12 +40,14 @@ func TestGetByIDUnit(t *testing.T) {
 	}
 
 	// Mock the SELECT query
-	rows := sqlmock.NewRows([]string{"id", "title", "content", "author_id", "status", "published_at", "created_at", "updated_at"}).
-		AddRow(expectedBlog.ID, expectedBlog.Title, expectedBlog.Content, expectedBlog.AuthorID, expectedBlog.Status, expectedBlog.PublishedAt, expectedBlog.CreatedAt, expectedBlog.UpdatedAt)
+	rows := sqlmock.NewRows([]string{"id", "title", "slug", "content", "author_id", "status", "comments_closed", "published_at", "scheduled_at", "created_at", "updated_at"}).
+		AddRow(expectedBlog.ID, expectedBlog.Title, expectedBlog.Slug, expectedBlog.Content, expectedBlog.AuthorID, expectedBlog.Status, false, expectedBlog.PublishedAt, nil, expectedBlog.CreatedAt, expectedBlog.UpdatedAt)
 
 	mock.ExpectQuery("SELECT (.+) FROM blogs WHERE id = ?").
 		WithArgs(blogID).
 		WillReturnRows(rows)
+	mock.ExpectQuery("SELECT bt.blog_id, t.name FROM blog_tags bt JOIN tags t").
+		WillReturnRows(sqlmock.NewRows([]string{"blog_id", "name"}))
 
 	result, err := repo.GetByID(ctx, blogID)
 	assert.NoError(t, err)
@@ -0,0 +1,51 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+)
+
+// GetByPreviousSlug finds the blog that had the slug before its title changed. The
+// returned blog carries its current slug.
+func (r *blogRepository) GetByPreviousSlug(ctx context.Context, slug string) (Blog, error) {
+	query := `
+		SELECT b.id, b.title, b.slug, b.content, b.author_id, b.status, b.comments_closed, b.published_at, b.scheduled_at, b.created_at, b.updated_at
+		FROM blog_slug_history h
+		JOIN blogs b ON b.id = h.blog_id
+		WHERE h.slug = ?
+	`
+
+	var blog Blog
+	err := r.db.QueryRowContext(ctx, query, slug).Scan(
+		&blog.ID,
+		&blog.Title,
+		&blog.Slug,
+		&blog.Content,
+		&blog.AuthorID,
+		&blog.Status,
+		&blog.CommentsClosed,
+		&blog.PublishedAt,
+		&blog.ScheduledAt,
+		&blog.CreatedAt,
+		&blog.UpdatedAt,
+	)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return Blog{}, ErrBlogNotFound
+		}
+		r.log.Error("Failed to get blog by previous slug",
+			slog.String("error", err.Error()),
+			slog.String("slug", slug),
+		)
+		return Blog{}, fmt.Errorf("%w: %w", ErrFailedToGetBlogBySlug, err)
+	}
+
+	blogs := []Blog{blog}
+	if err := r.loadTags(ctx, blogs); err != nil {
+		return Blog{}, err
+	}
+
+	return blogs[0], nil
+}
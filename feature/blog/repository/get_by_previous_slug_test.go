@@ -0,0 +1,44 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/fikryfahrezy/let-it-go/feature/blog/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetByPreviousSlug(t *testing.T) {
+	authorID := setupTest(t)
+
+	blog := repository.Blog{
+		Title:    "Test Blog",
+		Slug:     "test-blog",
+		Content:  "This is a test blog content",
+		AuthorID: authorID,
+		Status:   repository.StatusDraft,
+	}
+
+	err := testRepository.Create(context.Background(), blog)
+	require.NoError(t, err)
+
+	createdBlog, err := getBlogByTitle(blog.Title)
+	require.NoError(t, err)
+
+	err = testRepository.ChangeSlug(context.Background(), createdBlog.ID, "renamed-blog")
+	require.NoError(t, err)
+
+	// The old slug resolves to the blog with its current slug
+	result, err := testRepository.GetByPreviousSlug(context.Background(), "test-blog")
+	assert.NoError(t, err)
+	assert.Equal(t, createdBlog.ID, result.ID)
+	assert.Equal(t, "renamed-blog", result.Slug)
+}
+
+func TestGetByPreviousSlugNotFound(t *testing.T) {
+	setupTest(t)
+
+	_, err := testRepository.GetByPreviousSlug(context.Background(), "missing-blog")
+	assert.Equal(t, repository.ErrBlogNotFound, err)
+}
@@ -0,0 +1,64 @@
+package repository_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/fikryfahrezy/let-it-go/feature/blog/repository"
+	"github.com/fikryfahrezy/let-it-go/pkg/database"
+	"github.com/fikryfahrezy/let-it-go/pkg/logger"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetByPreviousSlugUnit(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	// nolint:errcheck
+	defer sqlDB.Close()
+
+	db := &database.DB{DB: sqlDB}
+	repo := repository.NewBlogRepository(logger.NewDiscardLogger(), db)
+	ctx := context.Background()
+
+	blogID := uuid.New()
+	rows := sqlmock.NewRows([]string{"id", "title", "slug", "content", "author_id", "status", "comments_closed", "published_at", "scheduled_at", "created_at", "updated_at"}).
+		AddRow(blogID, "Renamed Blog", "renamed-blog", "Test content", uuid.New(), repository.StatusDraft, false, nil, nil, time.Now(), time.Now())
+
+	mock.ExpectQuery("SELECT (.+) FROM blog_slug_history h JOIN blogs b ON b.id = h.blog_id WHERE h.slug = ?").
+		WithArgs("test-blog").
+		WillReturnRows(rows)
+	mock.ExpectQuery("SELECT bt.blog_id, t.name FROM blog_tags bt JOIN tags t").
+		WillReturnRows(sqlmock.NewRows([]string{"blog_id", "name"}))
+
+	result, err := repo.GetByPreviousSlug(ctx, "test-blog")
+	assert.NoError(t, err)
+	assert.Equal(t, blogID, result.ID)
+	assert.Equal(t, "renamed-blog", result.Slug)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetByPreviousSlugNotFoundUnit(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	// nolint:errcheck
+	defer sqlDB.Close()
+
+	db := &database.DB{DB: sqlDB}
+	repo := repository.NewBlogRepository(logger.NewDiscardLogger(), db)
+	ctx := context.Background()
+
+	mock.ExpectQuery("SELECT (.+) FROM blog_slug_history h JOIN blogs b ON b.id = h.blog_id WHERE h.slug = ?").
+		WithArgs("missing-blog").
+		WillReturnError(sql.ErrNoRows)
+
+	_, err = repo.GetByPreviousSlug(ctx, "missing-blog")
+	assert.Equal(t, repository.ErrBlogNotFound, err)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
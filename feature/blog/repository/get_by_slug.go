@@ -0,0 +1,48 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+)
+
+func (r *blogRepository) GetBySlug(ctx context.Context, slug string) (Blog, error) {
+	query := `
+		SELECT id, title, slug, content, author_id, status, comments_closed, published_at, scheduled_at, created_at, updated_at
+		FROM blogs
+		WHERE slug = ?
+	`
+
+	var blog Blog
+	err := r.db.QueryRowContext(ctx, query, slug).Scan(
+		&blog.ID,
+		&blog.Title,
+		&blog.Slug,
+		&blog.Content,
+		&blog.AuthorID,
+		&blog.Status,
+		&blog.CommentsClosed,
+		&blog.PublishedAt,
+		&blog.ScheduledAt,
+		&blog.CreatedAt,
+		&blog.UpdatedAt,
+	)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return Blog{}, ErrBlogNotFound
+		}
+		r.log.Error("Failed to get blog by slug",
+			slog.String("error", err.Error()),
+			slog.String("slug", slug),
+		)
+		return Blog{}, fmt.Errorf("%w: %w", ErrFailedToGetBlogBySlug, err)
+	}
+
+	blogs := []Blog{blog}
+	if err := r.loadTags(ctx, blogs); err != nil {
+		return Blog{}, err
+	}
+
+	return blogs[0], nil
+}
@@ -0,0 +1,38 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/fikryfahrezy/let-it-go/feature/blog/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetBySlug(t *testing.T) {
+	authorID := setupTest(t)
+
+	blog := repository.Blog{
+		Title:    "Test Blog",
+		Slug:     "test-blog",
+		Content:  "This is a test blog content",
+		AuthorID: authorID,
+		Status:   repository.StatusDraft,
+	}
+
+	err := testRepository.Create(context.Background(), blog)
+	require.NoError(t, err)
+
+	result, err := testRepository.GetBySlug(context.Background(), "test-blog")
+	assert.NoError(t, err)
+	assert.Equal(t, blog.Title, result.Title)
+	assert.Equal(t, blog.Slug, result.Slug)
+	assert.Equal(t, blog.Content, result.Content)
+}
+
+func TestGetBySlugNotFound(t *testing.T) {
+	setupTest(t)
+
+	_, err := testRepository.GetBySlug(context.Background(), "missing-blog")
+	assert.Equal(t, repository.ErrBlogNotFound, err)
+}
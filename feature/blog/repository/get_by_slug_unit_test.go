@@ -0,0 +1,96 @@
+package repository_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/fikryfahrezy/let-it-go/feature/blog/repository"
+	"github.com/fikryfahrezy/let-it-go/pkg/database"
+	"github.com/fikryfahrezy/let-it-go/pkg/logger"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetBySlugUnit(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	// nolint:errcheck
+	defer sqlDB.Close()
+
+	db := &database.DB{DB: sqlDB}
+	repo := repository.NewBlogRepository(logger.NewDiscardLogger(), db)
+	ctx := context.Background()
+
+	expectedBlog := repository.Blog{
+		ID:        uuid.New(),
+		Title:     "Test Blog",
+		Slug:      "test-blog",
+		Content:   "Test content",
+		AuthorID:  uuid.New(),
+		Status:    repository.StatusDraft,
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+
+	rows := sqlmock.NewRows([]string{"id", "title", "slug", "content", "author_id", "status", "comments_closed", "published_at", "scheduled_at", "created_at", "updated_at"}).
+		AddRow(expectedBlog.ID, expectedBlog.Title, expectedBlog.Slug, expectedBlog.Content, expectedBlog.AuthorID, expectedBlog.Status, false, nil, nil, expectedBlog.CreatedAt, expectedBlog.UpdatedAt)
+
+	mock.ExpectQuery("SELECT (.+) FROM blogs WHERE slug = ?").
+		WithArgs("test-blog").
+		WillReturnRows(rows)
+	mock.ExpectQuery("SELECT bt.blog_id, t.name FROM blog_tags bt JOIN tags t").
+		WillReturnRows(sqlmock.NewRows([]string{"blog_id", "name"}))
+
+	result, err := repo.GetBySlug(ctx, "test-blog")
+	assert.NoError(t, err)
+	assert.Equal(t, expectedBlog.ID, result.ID)
+	assert.Equal(t, expectedBlog.Slug, result.Slug)
+	assert.Equal(t, expectedBlog.Title, result.Title)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetBySlugNotFoundUnit(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	// nolint:errcheck
+	defer sqlDB.Close()
+
+	db := &database.DB{DB: sqlDB}
+	repo := repository.NewBlogRepository(logger.NewDiscardLogger(), db)
+	ctx := context.Background()
+
+	mock.ExpectQuery("SELECT (.+) FROM blogs WHERE slug = ?").
+		WithArgs("missing-blog").
+		WillReturnError(sql.ErrNoRows)
+
+	_, err = repo.GetBySlug(ctx, "missing-blog")
+	assert.Equal(t, repository.ErrBlogNotFound, err)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetBySlugDatabaseErrorUnit(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	// nolint:errcheck
+	defer sqlDB.Close()
+
+	db := &database.DB{DB: sqlDB}
+	repo := repository.NewBlogRepository(logger.NewDiscardLogger(), db)
+	ctx := context.Background()
+
+	mock.ExpectQuery("SELECT (.+) FROM blogs WHERE slug = ?").
+		WithArgs("test-blog").
+		WillReturnError(errors.New("database connection error"))
+
+	_, err = repo.GetBySlug(ctx, "test-blog")
+	assert.ErrorIs(t, err, repository.ErrFailedToGetBlogBySlug)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,71 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+)
+
+// GetDueScheduled returns the scheduled blogs whose scheduled time is not after now,
+// the longest overdue first
+func (r *blogRepository) GetDueScheduled(ctx context.Context, now time.Time, limit int) ([]Blog, error) {
+	query := `
+		SELECT id, title, slug, content, author_id, status, comments_closed, published_at, scheduled_at, created_at, updated_at
+		FROM blogs
+		WHERE status = ? AND scheduled_at <= ?
+		ORDER BY scheduled_at ASC, id ASC
+		LIMIT ?
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, StatusScheduled, now, limit)
+	if err != nil {
+		r.log.Error("Failed to get due scheduled blogs",
+			slog.String("error", err.Error()),
+		)
+		return nil, fmt.Errorf("%w: %w", ErrFailedToGetBlogsByStatus, err)
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			r.log.Error("Failed to close get due scheduled blogs rows", slog.String("error", err.Error()))
+		}
+	}()
+
+	var blogs []Blog
+	for rows.Next() {
+		blog := Blog{}
+		err := rows.Scan(
+			&blog.ID,
+			&blog.Title,
+			&blog.Slug,
+			&blog.Content,
+			&blog.AuthorID,
+			&blog.Status,
+			&blog.CommentsClosed,
+			&blog.PublishedAt,
+			&blog.ScheduledAt,
+			&blog.CreatedAt,
+			&blog.UpdatedAt,
+		)
+		if err != nil {
+			r.log.Error("Failed to scan blog row",
+				slog.String("error", err.Error()),
+			)
+			return nil, fmt.Errorf("%w: %w", ErrFailedToScanBlogRow, err)
+		}
+		blogs = append(blogs, blog)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.log.Error("Error iterating blog rows",
+			slog.String("error", err.Error()),
+		)
+		return nil, fmt.Errorf("%w: %w", ErrFailedToIterateRows, err)
+	}
+
+	if err := r.loadTags(ctx, blogs); err != nil {
+		return nil, err
+	}
+
+	return blogs, nil
+}
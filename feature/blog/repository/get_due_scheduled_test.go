@@ -0,0 +1,48 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/fikryfahrezy/let-it-go/feature/blog/repository"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// scheduleBlog creates a blog scheduled to be published at scheduledAt
+func scheduleBlog(t *testing.T, authorID uuid.UUID, title, slug string, scheduledAt time.Time) repository.Blog {
+	t.Helper()
+
+	blog := createBlogWithSlug(t, authorID, title, slug)
+	blog.Status = repository.StatusScheduled
+	blog.ScheduledAt = &scheduledAt
+	require.NoError(t, testRepository.Update(context.Background(), blog))
+
+	return blog
+}
+
+func TestGetDueScheduled(t *testing.T) {
+	authorID := setupTest(t)
+	now := time.Now().Truncate(time.Second)
+
+	overdue := scheduleBlog(t, authorID, "Overdue Blog", "overdue-blog", now.Add(-time.Hour))
+	due := scheduleBlog(t, authorID, "Due Blog", "due-blog", now.Add(-time.Minute))
+	scheduleBlog(t, authorID, "Future Blog", "future-blog", now.Add(time.Hour))
+	createBlogWithSlug(t, authorID, "Draft Blog", "draft-blog")
+
+	blogs, err := testRepository.GetDueScheduled(context.Background(), now, 10)
+	require.NoError(t, err)
+	require.Len(t, blogs, 2)
+	assert.Equal(t, overdue.ID, blogs[0].ID)
+	assert.Equal(t, due.ID, blogs[1].ID)
+	assert.Equal(t, repository.StatusScheduled, blogs[0].Status)
+	require.NotNil(t, blogs[0].ScheduledAt)
+	assert.WithinDuration(t, now.Add(-time.Hour), *blogs[0].ScheduledAt, time.Second)
+
+	blogs, err = testRepository.GetDueScheduled(context.Background(), now, 1)
+	require.NoError(t, err)
+	require.Len(t, blogs, 1)
+	assert.Equal(t, overdue.ID, blogs[0].ID)
+}
@@ -0,0 +1,66 @@
+package repository_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/fikryfahrezy/let-it-go/feature/blog/repository"
+	"github.com/fikryfahrezy/let-it-go/pkg/database"
+	"github.com/fikryfahrezy/let-it-go/pkg/logger"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetDueScheduledUnit(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	// nolint:errcheck
+	defer sqlDB.Close()
+
+	db := &database.DB{DB: sqlDB}
+	repo := repository.NewBlogRepository(logger.NewDiscardLogger(), db)
+	ctx := context.Background()
+
+	now := time.Now()
+	scheduledAt := now.Add(-time.Minute)
+	blogID := uuid.New()
+	rows := sqlmock.NewRows([]string{"id", "title", "slug", "content", "author_id", "status", "comments_closed", "published_at", "scheduled_at", "created_at", "updated_at"}).
+		AddRow(blogID, "Scheduled Blog", "scheduled-blog", "Content", uuid.New(), repository.StatusScheduled, false, nil, scheduledAt, time.Now(), time.Now())
+	mock.ExpectQuery("SELECT (.+) FROM blogs WHERE status = \\? AND scheduled_at <= \\? ORDER BY scheduled_at ASC, id ASC LIMIT \\?").
+		WithArgs(repository.StatusScheduled, now, 50).
+		WillReturnRows(rows)
+	mock.ExpectQuery("SELECT bt.blog_id, t.name FROM blog_tags bt JOIN tags t").
+		WithArgs(blogID).
+		WillReturnRows(sqlmock.NewRows([]string{"blog_id", "name"}))
+
+	blogs, err := repo.GetDueScheduled(ctx, now, 50)
+	assert.NoError(t, err)
+	require.Len(t, blogs, 1)
+	assert.Equal(t, blogID, blogs[0].ID)
+	require.NotNil(t, blogs[0].ScheduledAt)
+	assert.Equal(t, scheduledAt, *blogs[0].ScheduledAt)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetDueScheduledErrorUnit(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	// nolint:errcheck
+	defer sqlDB.Close()
+
+	db := &database.DB{DB: sqlDB}
+	repo := repository.NewBlogRepository(logger.NewDiscardLogger(), db)
+
+	mock.ExpectQuery("SELECT (.+) FROM blogs WHERE status").
+		WillReturnError(errors.New("database connection error"))
+
+	_, err = repo.GetDueScheduled(context.Background(), time.Now(), 50)
+	assert.ErrorIs(t, err, repository.ErrFailedToGetBlogsByStatus)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,72 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/google/uuid"
+)
+
+// GetPublishedByAuthorID returns the most recently published blogs of an author
+func (r *blogRepository) GetPublishedByAuthorID(ctx context.Context, authorID uuid.UUID, limit int) ([]Blog, error) {
+	query := `
+		SELECT id, title, slug, content, author_id, status, comments_closed, published_at, scheduled_at, created_at, updated_at
+		FROM blogs
+		WHERE author_id = ? AND status = ?
+		ORDER BY published_at DESC
+		LIMIT ?
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, authorID, StatusPublished, limit)
+	if err != nil {
+		r.log.Error("Failed to get published blogs by author ID",
+			slog.String("error", err.Error()),
+			slog.String("author_id", authorID.String()),
+		)
+		return nil, fmt.Errorf("%w: %w", ErrFailedToGetBlogsByAuthor, err)
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			r.log.Error("Failed to close get published blogs by author ID", slog.String("error", err.Error()))
+		}
+	}()
+
+	var blogs []Blog
+	for rows.Next() {
+		blog := Blog{}
+		err := rows.Scan(
+			&blog.ID,
+			&blog.Title,
+			&blog.Slug,
+			&blog.Content,
+			&blog.AuthorID,
+			&blog.Status,
+			&blog.CommentsClosed,
+			&blog.PublishedAt,
+			&blog.ScheduledAt,
+			&blog.CreatedAt,
+			&blog.UpdatedAt,
+		)
+		if err != nil {
+			r.log.Error("Failed to scan blog row",
+				slog.String("error", err.Error()),
+			)
+			return nil, fmt.Errorf("%w: %w", ErrFailedToScanBlogRow, err)
+		}
+		blogs = append(blogs, blog)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.log.Error("Error iterating blog rows",
+			slog.String("error", err.Error()),
+		)
+		return nil, fmt.Errorf("%w: %w", ErrFailedToIterateRows, err)
+	}
+
+	if err := r.loadTags(ctx, blogs); err != nil {
+		return nil, err
+	}
+
+	return blogs, nil
+}
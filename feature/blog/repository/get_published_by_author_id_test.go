@@ -0,0 +1,39 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/fikryfahrezy/let-it-go/feature/blog/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetPublishedByAuthorID(t *testing.T) {
+	authorID := setupTest(t)
+	ctx := context.Background()
+
+	older := time.Now().Add(-2 * time.Hour).Truncate(time.Second)
+	newer := time.Now().Add(-time.Hour).Truncate(time.Second)
+	blogs := []repository.Blog{
+		{Title: "Older", Content: "Content 1", AuthorID: authorID, Status: repository.StatusPublished, PublishedAt: &older},
+		{Title: "Newer", Content: "Content 2", AuthorID: authorID, Status: repository.StatusPublished, PublishedAt: &newer},
+		{Title: "Draft", Content: "Content 3", AuthorID: authorID, Status: repository.StatusDraft},
+	}
+	for _, blog := range blogs {
+		require.NoError(t, testRepository.Create(ctx, blog))
+	}
+
+	// Newest publication first, drafts excluded
+	result, err := testRepository.GetPublishedByAuthorID(ctx, authorID, 10)
+	require.NoError(t, err)
+	require.Len(t, result, 2)
+	assert.Equal(t, "Newer", result[0].Title)
+	assert.Equal(t, "Older", result[1].Title)
+
+	result, err = testRepository.GetPublishedByAuthorID(ctx, authorID, 1)
+	require.NoError(t, err)
+	require.Len(t, result, 1)
+	assert.Equal(t, "Newer", result[0].Title)
+}
@@ -0,0 +1,68 @@
+package repository_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/fikryfahrezy/let-it-go/feature/blog/repository"
+	"github.com/fikryfahrezy/let-it-go/pkg/database"
+	"github.com/fikryfahrezy/let-it-go/pkg/logger"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetPublishedByAuthorIDUnit(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	// nolint:errcheck
+	defer sqlDB.Close()
+
+	db := &database.DB{DB: sqlDB}
+	repo := repository.NewBlogRepository(logger.NewDiscardLogger(), db)
+	ctx := context.Background()
+
+	authorID := uuid.New()
+	publishedAt := time.Now()
+	blogID := uuid.New()
+
+	rows := sqlmock.NewRows([]string{"id", "title", "slug", "content", "author_id", "status", "comments_closed", "published_at", "scheduled_at", "created_at", "updated_at"}).
+		AddRow(blogID, "Blog 1", "blog-1", "Content 1", authorID, repository.StatusPublished, false, publishedAt, nil, time.Now(), time.Now())
+	mock.ExpectQuery("SELECT (.+) FROM blogs WHERE author_id = \\? AND status = \\? ORDER BY published_at DESC LIMIT \\?").
+		WithArgs(authorID, repository.StatusPublished, 5).
+		WillReturnRows(rows)
+	mock.ExpectQuery("SELECT bt.blog_id, t.name FROM blog_tags bt JOIN tags t").
+		WillReturnRows(sqlmock.NewRows([]string{"blog_id", "name"}))
+
+	result, err := repo.GetPublishedByAuthorID(ctx, authorID, 5)
+	assert.NoError(t, err)
+	require.Len(t, result, 1)
+	assert.Equal(t, blogID, result[0].ID)
+	assert.Equal(t, "Blog 1", result[0].Title)
+
+	// Verify all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetPublishedByAuthorIDErrorUnit(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	// nolint:errcheck
+	defer sqlDB.Close()
+
+	db := &database.DB{DB: sqlDB}
+	repo := repository.NewBlogRepository(logger.NewDiscardLogger(), db)
+	ctx := context.Background()
+
+	mock.ExpectQuery("SELECT (.+) FROM blogs WHERE author_id = \\? AND status = \\?").
+		WillReturnError(sql.ErrConnDone)
+
+	_, err = repo.GetPublishedByAuthorID(ctx, uuid.New(), 5)
+	assert.ErrorIs(t, err, repository.ErrFailedToGetBlogsByAuthor)
+
+	// Verify all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
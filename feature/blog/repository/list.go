@@ -8,7 +8,7 @@ import (
 
 func (r *blogRepository) List(ctx context.Context, limit, offset int) ([]Blog, error) {
 	query := `
-		SELECT id, title, content, author_id, status, published_at, created_at, updated_at
+		SELECT id, title, slug, content, author_id, status, comments_closed, published_at, scheduled_at, created_at, updated_at
 		FROM blogs
 		ORDER BY created_at DESC
 		LIMIT ? OFFSET ?
@@ -33,10 +33,13 @@ func (r *blogRepository) List(ctx context.Context, limit, offset int) ([]Blog, e
 		err := rows.Scan(
 			&blog.ID,
 			&blog.Title,
+			&blog.Slug,
 			&blog.Content,
 			&blog.AuthorID,
 			&blog.Status,
+			&blog.CommentsClosed,
 			&blog.PublishedAt,
+			&blog.ScheduledAt,
 			&blog.CreatedAt,
 			&blog.UpdatedAt,
 		)
@@ -56,5 +59,9 @@ func (r *blogRepository) List(ctx context.Context, limit, offset int) ([]Blog, e
 		return nil, fmt.Errorf("%w: %w", ErrFailedToIterateRows, err)
 	}
 
+	if err := r.loadTags(ctx, blogs); err != nil {
+		return nil, err
+	}
+
 	return blogs, nil
 }
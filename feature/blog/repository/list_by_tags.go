@@ -0,0 +1,81 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+)
+
+// ListByTags lists the blogs having any of the tags, or all of them when matchAll is set
+func (r *blogRepository) ListByTags(ctx context.Context, tags []string, matchAll bool, limit, offset int) ([]Blog, error) {
+	if len(tags) == 0 {
+		return nil, nil
+	}
+
+	query := `
+		SELECT id, title, slug, content, author_id, status, comments_closed, published_at, scheduled_at, created_at, updated_at
+		FROM blogs
+		WHERE id IN (
+			SELECT bt.blog_id
+			FROM blog_tags bt
+			JOIN tags t ON t.id = bt.tag_id
+			WHERE t.name IN (` + placeholders(len(tags)) + `)
+			GROUP BY bt.blog_id
+			HAVING COUNT(*) >= ?
+		)
+		ORDER BY created_at DESC
+		LIMIT ? OFFSET ?
+	`
+
+	args := append(stringsToArgs(tags), matchingTagCount(tags, matchAll), limit, offset)
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		r.log.Error("Failed to list blogs by tags",
+			slog.String("error", err.Error()),
+		)
+		return nil, fmt.Errorf("%w: %w", ErrFailedToListBlogs, err)
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			r.log.Error("Failed to close list blogs by tags rows", slog.String("error", err.Error()))
+		}
+	}()
+
+	var blogs []Blog
+	for rows.Next() {
+		blog := Blog{}
+		err := rows.Scan(
+			&blog.ID,
+			&blog.Title,
+			&blog.Slug,
+			&blog.Content,
+			&blog.AuthorID,
+			&blog.Status,
+			&blog.CommentsClosed,
+			&blog.PublishedAt,
+			&blog.ScheduledAt,
+			&blog.CreatedAt,
+			&blog.UpdatedAt,
+		)
+		if err != nil {
+			r.log.Error("Failed to scan blog row",
+				slog.String("error", err.Error()),
+			)
+			return nil, fmt.Errorf("%w: %w", ErrFailedToScanBlogRow, err)
+		}
+		blogs = append(blogs, blog)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.log.Error("Error iterating blog rows",
+			slog.String("error", err.Error()),
+		)
+		return nil, fmt.Errorf("%w: %w", ErrFailedToIterateRows, err)
+	}
+
+	if err := r.loadTags(ctx, blogs); err != nil {
+		return nil, err
+	}
+
+	return blogs, nil
+}
@@ -0,0 +1,48 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListByTags(t *testing.T) {
+	authorID := setupTest(t)
+
+	goOnly := createBlogWithSlug(t, authorID, "Go Blog", "go-blog")
+	both := createBlogWithSlug(t, authorID, "Go and MySQL Blog", "go-and-mysql-blog")
+	createBlogWithSlug(t, authorID, "Untagged Blog", "untagged-blog")
+	require.NoError(t, testRepository.SetTags(context.Background(), goOnly.ID, []string{"go"}))
+	require.NoError(t, testRepository.SetTags(context.Background(), both.ID, []string{"go", "mysql"}))
+
+	// Any of the tags
+	blogs, err := testRepository.ListByTags(context.Background(), []string{"go", "mysql"}, false, 10, 0)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{goOnly.Title, both.Title}, []string{blogs[0].Title, blogs[1].Title})
+
+	count, err := testRepository.CountByTags(context.Background(), []string{"go", "mysql"}, false)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), count)
+
+	// All of the tags
+	blogs, err = testRepository.ListByTags(context.Background(), []string{"go", "mysql"}, true, 10, 0)
+	require.NoError(t, err)
+	require.Len(t, blogs, 1)
+	assert.Equal(t, both.ID, blogs[0].ID)
+	assert.Equal(t, []string{"go", "mysql"}, blogs[0].Tags)
+
+	count, err = testRepository.CountByTags(context.Background(), []string{"go", "mysql"}, true)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), count)
+}
+
+func TestListByTagsNoMatch(t *testing.T) {
+	authorID := setupTest(t)
+	createBlogWithSlug(t, authorID, "Untagged Blog", "untagged-blog")
+
+	blogs, err := testRepository.ListByTags(context.Background(), []string{"missing"}, false, 10, 0)
+	assert.NoError(t, err)
+	assert.Empty(t, blogs)
+}
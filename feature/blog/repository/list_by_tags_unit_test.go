@@ -0,0 +1,76 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/fikryfahrezy/let-it-go/feature/blog/repository"
+	"github.com/fikryfahrezy/let-it-go/pkg/database"
+	"github.com/fikryfahrezy/let-it-go/pkg/logger"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListByTagsUnit(t *testing.T) {
+	tests := []struct {
+		name       string
+		tags       []string
+		matchAll   bool
+		minMatches int
+	}{
+		{name: "any of the tags", tags: []string{"go", "mysql"}, matchAll: false, minMatches: 1},
+		{name: "all of the tags", tags: []string{"go", "mysql"}, matchAll: true, minMatches: 2},
+		{name: "all of repeated tags", tags: []string{"go", "go"}, matchAll: true, minMatches: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sqlDB, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			// nolint:errcheck
+			defer sqlDB.Close()
+
+			db := &database.DB{DB: sqlDB}
+			repo := repository.NewBlogRepository(logger.NewDiscardLogger(), db)
+			ctx := context.Background()
+
+			blogID := uuid.New()
+			rows := sqlmock.NewRows([]string{"id", "title", "slug", "content", "author_id", "status", "comments_closed", "published_at", "scheduled_at", "created_at", "updated_at"}).
+				AddRow(blogID, "Go Blog", "go-blog", "Content", uuid.New(), repository.StatusPublished, false, nil, nil, time.Now(), time.Now())
+			mock.ExpectQuery("SELECT (.+) FROM blogs WHERE id IN \\( SELECT bt.blog_id (.+) WHERE t.name IN \\(\\?, \\?\\) GROUP BY bt.blog_id HAVING COUNT\\(\\*\\) >= \\? \\) ORDER BY created_at DESC LIMIT \\? OFFSET \\?").
+				WithArgs(tt.tags[0], tt.tags[1], tt.minMatches, 10, 0).
+				WillReturnRows(rows)
+			mock.ExpectQuery("SELECT bt.blog_id, t.name FROM blog_tags bt JOIN tags t").
+				WithArgs(blogID).
+				WillReturnRows(sqlmock.NewRows([]string{"blog_id", "name"}).AddRow(blogID, "go"))
+
+			blogs, err := repo.ListByTags(ctx, tt.tags, tt.matchAll, 10, 0)
+			assert.NoError(t, err)
+			require.Len(t, blogs, 1)
+			assert.Equal(t, blogID, blogs[0].ID)
+			assert.Equal(t, []string{"go"}, blogs[0].Tags)
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestListByTagsWithoutTagsUnit(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	// nolint:errcheck
+	defer sqlDB.Close()
+
+	db := &database.DB{DB: sqlDB}
+	repo := repository.NewBlogRepository(logger.NewDiscardLogger(), db)
+
+	// No query is run without tags to filter by
+	blogs, err := repo.ListByTags(context.Background(), nil, false, 10, 0)
+	assert.NoError(t, err)
+	assert.Empty(t, blogs)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,52 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+)
+
+// ListTags returns the tags used by at least one blog, the most used first
+func (r *blogRepository) ListTags(ctx context.Context) ([]TagUsage, error) {
+	query := `
+		SELECT t.name, COUNT(*) AS blog_count
+		FROM tags t
+		JOIN blog_tags bt ON bt.tag_id = t.id
+		GROUP BY t.id, t.name
+		ORDER BY blog_count DESC, t.name
+	`
+
+	rows, err := r.db.QueryContext(ctx, query)
+	if err != nil {
+		r.log.Error("Failed to list tags",
+			slog.String("error", err.Error()),
+		)
+		return nil, fmt.Errorf("%w: %w", ErrFailedToListTags, err)
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			r.log.Error("Failed to close list tags rows", slog.String("error", err.Error()))
+		}
+	}()
+
+	var tags []TagUsage
+	for rows.Next() {
+		tag := TagUsage{}
+		if err := rows.Scan(&tag.Name, &tag.BlogCount); err != nil {
+			r.log.Error("Failed to scan tag row",
+				slog.String("error", err.Error()),
+			)
+			return nil, fmt.Errorf("%w: %w", ErrFailedToScanBlogRow, err)
+		}
+		tags = append(tags, tag)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.log.Error("Error iterating tag rows",
+			slog.String("error", err.Error()),
+		)
+		return nil, fmt.Errorf("%w: %w", ErrFailedToIterateRows, err)
+	}
+
+	return tags, nil
+}
@@ -0,0 +1,31 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/fikryfahrezy/let-it-go/feature/blog/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListTags(t *testing.T) {
+	authorID := setupTest(t)
+	_, err := db.Exec("DELETE FROM tags")
+	require.NoError(t, err)
+
+	first := createBlogWithSlug(t, authorID, "First Blog", "first-blog")
+	second := createBlogWithSlug(t, authorID, "Second Blog", "second-blog")
+	require.NoError(t, testRepository.SetTags(context.Background(), first.ID, []string{"go", "mysql"}))
+	require.NoError(t, testRepository.SetTags(context.Background(), second.ID, []string{"go"}))
+	// Tags no blog uses any more are not listed
+	require.NoError(t, testRepository.SetTags(context.Background(), second.ID, []string{"go", "unused"}))
+	require.NoError(t, testRepository.SetTags(context.Background(), second.ID, []string{"go"}))
+
+	tags, err := testRepository.ListTags(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, []repository.TagUsage{
+		{Name: "go", BlogCount: 2},
+		{Name: "mysql", BlogCount: 1},
+	}, tags)
+}
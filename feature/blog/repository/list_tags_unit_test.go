@@ -0,0 +1,59 @@
+package repository_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/fikryfahrezy/let-it-go/feature/blog/repository"
+	"github.com/fikryfahrezy/let-it-go/pkg/database"
+	"github.com/fikryfahrezy/let-it-go/pkg/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListTagsUnit(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	// nolint:errcheck
+	defer sqlDB.Close()
+
+	db := &database.DB{DB: sqlDB}
+	repo := repository.NewBlogRepository(logger.NewDiscardLogger(), db)
+	ctx := context.Background()
+
+	rows := sqlmock.NewRows([]string{"name", "blog_count"}).
+		AddRow("go", 3).
+		AddRow("mysql", 1)
+	mock.ExpectQuery("SELECT t.name, COUNT\\(\\*\\) AS blog_count FROM tags t JOIN blog_tags bt (.+) GROUP BY t.id, t.name").
+		WillReturnRows(rows)
+
+	tags, err := repo.ListTags(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, []repository.TagUsage{
+		{Name: "go", BlogCount: 3},
+		{Name: "mysql", BlogCount: 1},
+	}, tags)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestListTagsErrorUnit(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	// nolint:errcheck
+	defer sqlDB.Close()
+
+	db := &database.DB{DB: sqlDB}
+	repo := repository.NewBlogRepository(logger.NewDiscardLogger(), db)
+	ctx := context.Background()
+
+	mock.ExpectQuery("SELECT t.name").
+		WillReturnError(errors.New("database connection error"))
+
+	_, err = repo.ListTags(ctx)
+	assert.ErrorIs(t, err, repository.ErrFailedToListTags)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -46,22 +46,32 @@ func TestListBlogUnit(t *testing.T) {
 	}
 
 	// Mock the SELECT query
-	rows := sqlmock.NewRows([]string{"id", "title", "content", "author_id", "status", "published_at", "created_at", "updated_at"})
+	rows := sqlmock.NewRows([]string{"id", "title", "slug", "content", "author_id", "status", "comments_closed", "published_at", "scheduled_at", "created_at", "updated_at"})
 	for _, blog := range blogs {
-		rows.AddRow(blog.ID, blog.Title, blog.Content, blog.AuthorID, blog.Status, blog.PublishedAt, blog.CreatedAt, blog.UpdatedAt)
+		rows.AddRow(blog.ID, blog.Title, blog.Slug, blog.Content, blog.AuthorID, blog.Status, false, blog.PublishedAt, nil, blog.CreatedAt, blog.UpdatedAt)
 	}
 
 	mock.ExpectQuery("SELECT (.+) FROM blogs ORDER BY created_at DESC LIMIT (.+) OFFSET (.+)").
 		WithArgs(2, 0).
 		WillReturnRows(rows)
 
+	// Tags of all listed blogs are loaded with a single query
+	tagRows := sqlmock.NewRows([]string{"blog_id", "name"}).
+		AddRow(blogs[0].ID, "go").
+		AddRow(blogs[0].ID, "mysql")
+	mock.ExpectQuery("SELECT bt.blog_id, t.name FROM blog_tags bt JOIN tags t ON t.id = bt.tag_id WHERE bt.blog_id IN \\(\\?, \\?\\)").
+		WithArgs(blogs[0].ID, blogs[1].ID).
+		WillReturnRows(tagRows)
+
 	result, err := repo.List(ctx, 2, 0)
 	assert.NoError(t, err)
 	assert.Len(t, result, 2)
 	assert.Equal(t, blogs[0].ID, result[0].ID)
 	assert.Equal(t, blogs[0].Title, result[0].Title)
+	assert.Equal(t, []string{"go", "mysql"}, result[0].Tags)
 	assert.Equal(t, blogs[1].ID, result[1].ID)
 	assert.Equal(t, blogs[1].Title, result[1].Title)
+	assert.Empty(t, result[1].Tags)
 
 	// Verify all expectations were met
 	assert.NoError(t, mock.ExpectationsWereMet())
@@ -78,7 +88,7 @@ func TestListBlogEmptyUnit(t *testing.T) {
 	ctx := context.Background()
 
 	// Mock the SELECT query returning empty result
-	rows := sqlmock.NewRows([]string{"id", "title", "content", "author_id", "status", "published_at", "created_at", "updated_at"})
+	rows := sqlmock.NewRows([]string{"id", "title", "slug", "content", "author_id", "status", "comments_closed", "published_at", "scheduled_at", "created_at", "updated_at"})
 	mock.ExpectQuery("SELECT (.+) FROM blogs ORDER BY created_at DESC LIMIT (.+) OFFSET (.+)").
 		WithArgs(10, 0).
 		WillReturnRows(rows)
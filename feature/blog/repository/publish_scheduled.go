@@ -0,0 +1,48 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// PublishScheduled publishes a scheduled blog. Only one of several concurrent calls for the
+// same blog succeeds; the others, and calls for a blog that is no longer scheduled, get
+// ErrBlogNotScheduled.
+func (r *blogRepository) PublishScheduled(ctx context.Context, id uuid.UUID, publishedAt time.Time) error {
+	query := `
+		UPDATE blogs
+		SET status = ?, published_at = ?, scheduled_at = NULL, updated_at = ?
+		WHERE id = ? AND status = ?
+	`
+
+	result, err := r.db.ExecContext(ctx, query, StatusPublished, publishedAt, time.Now(), id, StatusScheduled)
+	if err != nil {
+		r.log.Error("Failed to publish scheduled blog",
+			slog.String("error", err.Error()),
+			slog.String("blog_id", id.String()),
+		)
+		return fmt.Errorf("%w: %w", ErrFailedToUpdateBlog, err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		r.log.Error("Failed to get rows affected",
+			slog.String("error", err.Error()),
+		)
+		return fmt.Errorf("%w: %w", ErrFailedToGetRowsAffected, err)
+	}
+
+	if rowsAffected == 0 {
+		return ErrBlogNotScheduled
+	}
+
+	r.log.Info("Scheduled blog published successfully",
+		slog.String("blog_id", id.String()),
+	)
+
+	return nil
+}
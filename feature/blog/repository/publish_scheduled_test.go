@@ -0,0 +1,48 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/fikryfahrezy/let-it-go/feature/blog/repository"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPublishScheduled(t *testing.T) {
+	authorID := setupTest(t)
+	ctx := context.Background()
+	blog := scheduleBlog(t, authorID, "Scheduled Blog", "scheduled-blog", time.Now().Add(-time.Minute))
+
+	publishedAt := time.Now().Truncate(time.Second)
+	err := testRepository.PublishScheduled(ctx, blog.ID, publishedAt)
+	require.NoError(t, err)
+
+	result, err := testRepository.GetByID(ctx, blog.ID)
+	require.NoError(t, err)
+	assert.Equal(t, repository.StatusPublished, result.Status)
+	require.NotNil(t, result.PublishedAt)
+	assert.WithinDuration(t, publishedAt, *result.PublishedAt, time.Second)
+	assert.Nil(t, result.ScheduledAt)
+
+	// Publishing it again does nothing
+	err = testRepository.PublishScheduled(ctx, blog.ID, time.Now().Add(time.Hour))
+	assert.Equal(t, repository.ErrBlogNotScheduled, err)
+
+	result, err = testRepository.GetByID(ctx, blog.ID)
+	require.NoError(t, err)
+	assert.WithinDuration(t, publishedAt, *result.PublishedAt, time.Second)
+}
+
+func TestPublishScheduledNotScheduled(t *testing.T) {
+	authorID := setupTest(t)
+	draft := createBlogWithSlug(t, authorID, "Draft Blog", "draft-blog")
+
+	err := testRepository.PublishScheduled(context.Background(), draft.ID, time.Now())
+	assert.Equal(t, repository.ErrBlogNotScheduled, err)
+
+	err = testRepository.PublishScheduled(context.Background(), uuid.New(), time.Now())
+	assert.Equal(t, repository.ErrBlogNotScheduled, err)
+}
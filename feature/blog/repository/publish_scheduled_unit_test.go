@@ -0,0 +1,74 @@
+package repository_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/fikryfahrezy/let-it-go/feature/blog/repository"
+	"github.com/fikryfahrezy/let-it-go/pkg/database"
+	"github.com/fikryfahrezy/let-it-go/pkg/logger"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPublishScheduledUnit(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	// nolint:errcheck
+	defer sqlDB.Close()
+
+	db := &database.DB{DB: sqlDB}
+	repo := repository.NewBlogRepository(logger.NewDiscardLogger(), db)
+	ctx := context.Background()
+
+	blogID := uuid.New()
+	publishedAt := time.Now()
+	mock.ExpectExec("UPDATE blogs SET status = \\?, published_at = \\?, scheduled_at = NULL, updated_at = \\? WHERE id = \\? AND status = \\?").
+		WithArgs(repository.StatusPublished, publishedAt, sqlmock.AnyArg(), blogID, repository.StatusScheduled).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	err = repo.PublishScheduled(ctx, blogID, publishedAt)
+	assert.NoError(t, err)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPublishScheduledNotScheduledUnit(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	// nolint:errcheck
+	defer sqlDB.Close()
+
+	db := &database.DB{DB: sqlDB}
+	repo := repository.NewBlogRepository(logger.NewDiscardLogger(), db)
+
+	mock.ExpectExec("UPDATE blogs SET status").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	err = repo.PublishScheduled(context.Background(), uuid.New(), time.Now())
+	assert.Equal(t, repository.ErrBlogNotScheduled, err)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPublishScheduledErrorUnit(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	// nolint:errcheck
+	defer sqlDB.Close()
+
+	db := &database.DB{DB: sqlDB}
+	repo := repository.NewBlogRepository(logger.NewDiscardLogger(), db)
+
+	mock.ExpectExec("UPDATE blogs SET status").
+		WillReturnError(errors.New("database connection error"))
+
+	err = repo.PublishScheduled(context.Background(), uuid.New(), time.Now())
+	assert.ErrorIs(t, err, repository.ErrFailedToUpdateBlog)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -4,6 +4,7 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 )
@@ -11,11 +12,26 @@ import (
 type BlogRepository interface {
 	Create(ctx context.Context, blog Blog) error
 	GetByID(ctx context.Context, id uuid.UUID) (Blog, error)
+	GetBySlug(ctx context.Context, slug string) (Blog, error)
+	GetByPreviousSlug(ctx context.Context, slug string) (Blog, error)
+	ChangeSlug(ctx context.Context, id uuid.UUID, slug string) error
 	GetByAuthorID(ctx context.Context, authorID uuid.UUID, limit, offset int) ([]Blog, error)
+	GetPublishedByAuthorID(ctx context.Context, authorID uuid.UUID, limit int) ([]Blog, error)
 	GetByStatus(ctx context.Context, status string, limit, offset int) ([]Blog, error)
+	GetDueScheduled(ctx context.Context, now time.Time, limit int) ([]Blog, error)
 	Update(ctx context.Context, blog Blog) error
 	Delete(ctx context.Context, id uuid.UUID) error
+	PublishScheduled(ctx context.Context, id uuid.UUID, publishedAt time.Time) error
+	Unschedule(ctx context.Context, id uuid.UUID) error
 	List(ctx context.Context, limit, offset int) ([]Blog, error)
 	Count(ctx context.Context) (int64, error)
 	CountByStatus(ctx context.Context, status string) (int64, error)
+	CountByAuthorIDAndStatus(ctx context.Context, authorID uuid.UUID, status string) (int64, error)
+	SetCommentsClosed(ctx context.Context, id uuid.UUID, closed bool) error
+	SetTags(ctx context.Context, blogID uuid.UUID, tags []string) error
+	ListTags(ctx context.Context) ([]TagUsage, error)
+	ListByTags(ctx context.Context, tags []string, matchAll bool, limit, offset int) ([]Blog, error)
+	CountByTags(ctx context.Context, tags []string, matchAll bool) (int64, error)
+	Search(ctx context.Context, filter SearchFilter, limit, offset int) ([]SearchResult, error)
+	CountSearch(ctx context.Context, filter SearchFilter) (int64, error)
 }
@@ -4,12 +4,26 @@ package repositoryfakes
 import (
 	"context"
 	"sync"
+	"time"
 
 	"github.com/fikryfahrezy/let-it-go/feature/blog/repository"
 	"github.com/google/uuid"
 )
 
 type FakeBlogRepository struct {
+	ChangeSlugStub        func(context.Context, uuid.UUID, string) error
+	changeSlugMutex       sync.RWMutex
+	changeSlugArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 string
+	}
+	changeSlugReturns struct {
+		result1 error
+	}
+	changeSlugReturnsOnCall map[int]struct {
+		result1 error
+	}
 	CountStub        func(context.Context) (int64, error)
 	countMutex       sync.RWMutex
 	countArgsForCall []struct {
@@ -23,6 +37,21 @@ type FakeBlogRepository struct {
 		result1 int64
 		result2 error
 	}
+	CountByAuthorIDAndStatusStub        func(context.Context, uuid.UUID, string) (int64, error)
+	countByAuthorIDAndStatusMutex       sync.RWMutex
+	countByAuthorIDAndStatusArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 string
+	}
+	countByAuthorIDAndStatusReturns struct {
+		result1 int64
+		result2 error
+	}
+	countByAuthorIDAndStatusReturnsOnCall map[int]struct {
+		result1 int64
+		result2 error
+	}
 	CountByStatusStub        func(context.Context, string) (int64, error)
 	countByStatusMutex       sync.RWMutex
 	countByStatusArgsForCall []struct {
@@ -37,6 +66,35 @@ type FakeBlogRepository struct {
 		result1 int64
 		result2 error
 	}
+	CountByTagsStub        func(context.Context, []string, bool) (int64, error)
+	countByTagsMutex       sync.RWMutex
+	countByTagsArgsForCall []struct {
+		arg1 context.Context
+		arg2 []string
+		arg3 bool
+	}
+	countByTagsReturns struct {
+		result1 int64
+		result2 error
+	}
+	countByTagsReturnsOnCall map[int]struct {
+		result1 int64
+		result2 error
+	}
+	CountSearchStub        func(context.Context, repository.SearchFilter) (int64, error)
+	countSearchMutex       sync.RWMutex
+	countSearchArgsForCall []struct {
+		arg1 context.Context
+		arg2 repository.SearchFilter
+	}
+	countSearchReturns struct {
+		result1 int64
+		result2 error
+	}
+	countSearchReturnsOnCall map[int]struct {
+		result1 int64
+		result2 error
+	}
 	CreateStub        func(context.Context, repository.Blog) error
 	createMutex       sync.RWMutex
 	createArgsForCall []struct {
@@ -91,6 +149,34 @@ type FakeBlogRepository struct {
 		result1 repository.Blog
 		result2 error
 	}
+	GetByPreviousSlugStub        func(context.Context, string) (repository.Blog, error)
+	getByPreviousSlugMutex       sync.RWMutex
+	getByPreviousSlugArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	getByPreviousSlugReturns struct {
+		result1 repository.Blog
+		result2 error
+	}
+	getByPreviousSlugReturnsOnCall map[int]struct {
+		result1 repository.Blog
+		result2 error
+	}
+	GetBySlugStub        func(context.Context, string) (repository.Blog, error)
+	getBySlugMutex       sync.RWMutex
+	getBySlugArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	getBySlugReturns struct {
+		result1 repository.Blog
+		result2 error
+	}
+	getBySlugReturnsOnCall map[int]struct {
+		result1 repository.Blog
+		result2 error
+	}
 	GetByStatusStub        func(context.Context, string, int, int) ([]repository.Blog, error)
 	getByStatusMutex       sync.RWMutex
 	getByStatusArgsForCall []struct {
@@ -107,6 +193,36 @@ type FakeBlogRepository struct {
 		result1 []repository.Blog
 		result2 error
 	}
+	GetDueScheduledStub        func(context.Context, time.Time, int) ([]repository.Blog, error)
+	getDueScheduledMutex       sync.RWMutex
+	getDueScheduledArgsForCall []struct {
+		arg1 context.Context
+		arg2 time.Time
+		arg3 int
+	}
+	getDueScheduledReturns struct {
+		result1 []repository.Blog
+		result2 error
+	}
+	getDueScheduledReturnsOnCall map[int]struct {
+		result1 []repository.Blog
+		result2 error
+	}
+	GetPublishedByAuthorIDStub        func(context.Context, uuid.UUID, int) ([]repository.Blog, error)
+	getPublishedByAuthorIDMutex       sync.RWMutex
+	getPublishedByAuthorIDArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 int
+	}
+	getPublishedByAuthorIDReturns struct {
+		result1 []repository.Blog
+		result2 error
+	}
+	getPublishedByAuthorIDReturnsOnCall map[int]struct {
+		result1 []repository.Blog
+		result2 error
+	}
 	ListStub        func(context.Context, int, int) ([]repository.Blog, error)
 	listMutex       sync.RWMutex
 	listArgsForCall []struct {
@@ -122,6 +238,103 @@ type FakeBlogRepository struct {
 		result1 []repository.Blog
 		result2 error
 	}
+	ListByTagsStub        func(context.Context, []string, bool, int, int) ([]repository.Blog, error)
+	listByTagsMutex       sync.RWMutex
+	listByTagsArgsForCall []struct {
+		arg1 context.Context
+		arg2 []string
+		arg3 bool
+		arg4 int
+		arg5 int
+	}
+	listByTagsReturns struct {
+		result1 []repository.Blog
+		result2 error
+	}
+	listByTagsReturnsOnCall map[int]struct {
+		result1 []repository.Blog
+		result2 error
+	}
+	ListTagsStub        func(context.Context) ([]repository.TagUsage, error)
+	listTagsMutex       sync.RWMutex
+	listTagsArgsForCall []struct {
+		arg1 context.Context
+	}
+	listTagsReturns struct {
+		result1 []repository.TagUsage
+		result2 error
+	}
+	listTagsReturnsOnCall map[int]struct {
+		result1 []repository.TagUsage
+		result2 error
+	}
+	PublishScheduledStub        func(context.Context, uuid.UUID, time.Time) error
+	publishScheduledMutex       sync.RWMutex
+	publishScheduledArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 time.Time
+	}
+	publishScheduledReturns struct {
+		result1 error
+	}
+	publishScheduledReturnsOnCall map[int]struct {
+		result1 error
+	}
+	SearchStub        func(context.Context, repository.SearchFilter, int, int) ([]repository.SearchResult, error)
+	searchMutex       sync.RWMutex
+	searchArgsForCall []struct {
+		arg1 context.Context
+		arg2 repository.SearchFilter
+		arg3 int
+		arg4 int
+	}
+	searchReturns struct {
+		result1 []repository.SearchResult
+		result2 error
+	}
+	searchReturnsOnCall map[int]struct {
+		result1 []repository.SearchResult
+		result2 error
+	}
+	SetCommentsClosedStub        func(context.Context, uuid.UUID, bool) error
+	setCommentsClosedMutex       sync.RWMutex
+	setCommentsClosedArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 bool
+	}
+	setCommentsClosedReturns struct {
+		result1 error
+	}
+	setCommentsClosedReturnsOnCall map[int]struct {
+		result1 error
+	}
+	SetTagsStub        func(context.Context, uuid.UUID, []string) error
+	setTagsMutex       sync.RWMutex
+	setTagsArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 []string
+	}
+	setTagsReturns struct {
+		result1 error
+	}
+	setTagsReturnsOnCall map[int]struct {
+		result1 error
+	}
+	UnscheduleStub        func(context.Context, uuid.UUID) error
+	unscheduleMutex       sync.RWMutex
+	unscheduleArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}
+	unscheduleReturns struct {
+		result1 error
+	}
+	unscheduleReturnsOnCall map[int]struct {
+		result1 error
+	}
 	UpdateStub        func(context.Context, repository.Blog) error
 	updateMutex       sync.RWMutex
 	updateArgsForCall []struct {
@@ -138,6 +351,69 @@ type FakeBlogRepository struct {
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakeBlogRepository) ChangeSlug(arg1 context.Context, arg2 uuid.UUID, arg3 string) error {
+	fake.changeSlugMutex.Lock()
+	ret, specificReturn := fake.changeSlugReturnsOnCall[len(fake.changeSlugArgsForCall)]
+	fake.changeSlugArgsForCall = append(fake.changeSlugArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.ChangeSlugStub
+	fakeReturns := fake.changeSlugReturns
+	fake.recordInvocation("ChangeSlug", []interface{}{arg1, arg2, arg3})
+	fake.changeSlugMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeBlogRepository) ChangeSlugCallCount() int {
+	fake.changeSlugMutex.RLock()
+	defer fake.changeSlugMutex.RUnlock()
+	return len(fake.changeSlugArgsForCall)
+}
+
+func (fake *FakeBlogRepository) ChangeSlugCalls(stub func(context.Context, uuid.UUID, string) error) {
+	fake.changeSlugMutex.Lock()
+	defer fake.changeSlugMutex.Unlock()
+	fake.ChangeSlugStub = stub
+}
+
+func (fake *FakeBlogRepository) ChangeSlugArgsForCall(i int) (context.Context, uuid.UUID, string) {
+	fake.changeSlugMutex.RLock()
+	defer fake.changeSlugMutex.RUnlock()
+	argsForCall := fake.changeSlugArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeBlogRepository) ChangeSlugReturns(result1 error) {
+	fake.changeSlugMutex.Lock()
+	defer fake.changeSlugMutex.Unlock()
+	fake.ChangeSlugStub = nil
+	fake.changeSlugReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeBlogRepository) ChangeSlugReturnsOnCall(i int, result1 error) {
+	fake.changeSlugMutex.Lock()
+	defer fake.changeSlugMutex.Unlock()
+	fake.ChangeSlugStub = nil
+	if fake.changeSlugReturnsOnCall == nil {
+		fake.changeSlugReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.changeSlugReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeBlogRepository) Count(arg1 context.Context) (int64, error) {
 	fake.countMutex.Lock()
 	ret, specificReturn := fake.countReturnsOnCall[len(fake.countArgsForCall)]
@@ -202,6 +478,72 @@ func (fake *FakeBlogRepository) CountReturnsOnCall(i int, result1 int64, result2
 	}{result1, result2}
 }
 
+func (fake *FakeBlogRepository) CountByAuthorIDAndStatus(arg1 context.Context, arg2 uuid.UUID, arg3 string) (int64, error) {
+	fake.countByAuthorIDAndStatusMutex.Lock()
+	ret, specificReturn := fake.countByAuthorIDAndStatusReturnsOnCall[len(fake.countByAuthorIDAndStatusArgsForCall)]
+	fake.countByAuthorIDAndStatusArgsForCall = append(fake.countByAuthorIDAndStatusArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.CountByAuthorIDAndStatusStub
+	fakeReturns := fake.countByAuthorIDAndStatusReturns
+	fake.recordInvocation("CountByAuthorIDAndStatus", []interface{}{arg1, arg2, arg3})
+	fake.countByAuthorIDAndStatusMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeBlogRepository) CountByAuthorIDAndStatusCallCount() int {
+	fake.countByAuthorIDAndStatusMutex.RLock()
+	defer fake.countByAuthorIDAndStatusMutex.RUnlock()
+	return len(fake.countByAuthorIDAndStatusArgsForCall)
+}
+
+func (fake *FakeBlogRepository) CountByAuthorIDAndStatusCalls(stub func(context.Context, uuid.UUID, string) (int64, error)) {
+	fake.countByAuthorIDAndStatusMutex.Lock()
+	defer fake.countByAuthorIDAndStatusMutex.Unlock()
+	fake.CountByAuthorIDAndStatusStub = stub
+}
+
+func (fake *FakeBlogRepository) CountByAuthorIDAndStatusArgsForCall(i int) (context.Context, uuid.UUID, string) {
+	fake.countByAuthorIDAndStatusMutex.RLock()
+	defer fake.countByAuthorIDAndStatusMutex.RUnlock()
+	argsForCall := fake.countByAuthorIDAndStatusArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeBlogRepository) CountByAuthorIDAndStatusReturns(result1 int64, result2 error) {
+	fake.countByAuthorIDAndStatusMutex.Lock()
+	defer fake.countByAuthorIDAndStatusMutex.Unlock()
+	fake.CountByAuthorIDAndStatusStub = nil
+	fake.countByAuthorIDAndStatusReturns = struct {
+		result1 int64
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBlogRepository) CountByAuthorIDAndStatusReturnsOnCall(i int, result1 int64, result2 error) {
+	fake.countByAuthorIDAndStatusMutex.Lock()
+	defer fake.countByAuthorIDAndStatusMutex.Unlock()
+	fake.CountByAuthorIDAndStatusStub = nil
+	if fake.countByAuthorIDAndStatusReturnsOnCall == nil {
+		fake.countByAuthorIDAndStatusReturnsOnCall = make(map[int]struct {
+			result1 int64
+			result2 error
+		})
+	}
+	fake.countByAuthorIDAndStatusReturnsOnCall[i] = struct {
+		result1 int64
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeBlogRepository) CountByStatus(arg1 context.Context, arg2 string) (int64, error) {
 	fake.countByStatusMutex.Lock()
 	ret, specificReturn := fake.countByStatusReturnsOnCall[len(fake.countByStatusArgsForCall)]
@@ -267,6 +609,142 @@ func (fake *FakeBlogRepository) CountByStatusReturnsOnCall(i int, result1 int64,
 	}{result1, result2}
 }
 
+func (fake *FakeBlogRepository) CountByTags(arg1 context.Context, arg2 []string, arg3 bool) (int64, error) {
+	var arg2Copy []string
+	if arg2 != nil {
+		arg2Copy = make([]string, len(arg2))
+		copy(arg2Copy, arg2)
+	}
+	fake.countByTagsMutex.Lock()
+	ret, specificReturn := fake.countByTagsReturnsOnCall[len(fake.countByTagsArgsForCall)]
+	fake.countByTagsArgsForCall = append(fake.countByTagsArgsForCall, struct {
+		arg1 context.Context
+		arg2 []string
+		arg3 bool
+	}{arg1, arg2Copy, arg3})
+	stub := fake.CountByTagsStub
+	fakeReturns := fake.countByTagsReturns
+	fake.recordInvocation("CountByTags", []interface{}{arg1, arg2Copy, arg3})
+	fake.countByTagsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeBlogRepository) CountByTagsCallCount() int {
+	fake.countByTagsMutex.RLock()
+	defer fake.countByTagsMutex.RUnlock()
+	return len(fake.countByTagsArgsForCall)
+}
+
+func (fake *FakeBlogRepository) CountByTagsCalls(stub func(context.Context, []string, bool) (int64, error)) {
+	fake.countByTagsMutex.Lock()
+	defer fake.countByTagsMutex.Unlock()
+	fake.CountByTagsStub = stub
+}
+
+func (fake *FakeBlogRepository) CountByTagsArgsForCall(i int) (context.Context, []string, bool) {
+	fake.countByTagsMutex.RLock()
+	defer fake.countByTagsMutex.RUnlock()
+	argsForCall := fake.countByTagsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeBlogRepository) CountByTagsReturns(result1 int64, result2 error) {
+	fake.countByTagsMutex.Lock()
+	defer fake.countByTagsMutex.Unlock()
+	fake.CountByTagsStub = nil
+	fake.countByTagsReturns = struct {
+		result1 int64
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBlogRepository) CountByTagsReturnsOnCall(i int, result1 int64, result2 error) {
+	fake.countByTagsMutex.Lock()
+	defer fake.countByTagsMutex.Unlock()
+	fake.CountByTagsStub = nil
+	if fake.countByTagsReturnsOnCall == nil {
+		fake.countByTagsReturnsOnCall = make(map[int]struct {
+			result1 int64
+			result2 error
+		})
+	}
+	fake.countByTagsReturnsOnCall[i] = struct {
+		result1 int64
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBlogRepository) CountSearch(arg1 context.Context, arg2 repository.SearchFilter) (int64, error) {
+	fake.countSearchMutex.Lock()
+	ret, specificReturn := fake.countSearchReturnsOnCall[len(fake.countSearchArgsForCall)]
+	fake.countSearchArgsForCall = append(fake.countSearchArgsForCall, struct {
+		arg1 context.Context
+		arg2 repository.SearchFilter
+	}{arg1, arg2})
+	stub := fake.CountSearchStub
+	fakeReturns := fake.countSearchReturns
+	fake.recordInvocation("CountSearch", []interface{}{arg1, arg2})
+	fake.countSearchMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeBlogRepository) CountSearchCallCount() int {
+	fake.countSearchMutex.RLock()
+	defer fake.countSearchMutex.RUnlock()
+	return len(fake.countSearchArgsForCall)
+}
+
+func (fake *FakeBlogRepository) CountSearchCalls(stub func(context.Context, repository.SearchFilter) (int64, error)) {
+	fake.countSearchMutex.Lock()
+	defer fake.countSearchMutex.Unlock()
+	fake.CountSearchStub = stub
+}
+
+func (fake *FakeBlogRepository) CountSearchArgsForCall(i int) (context.Context, repository.SearchFilter) {
+	fake.countSearchMutex.RLock()
+	defer fake.countSearchMutex.RUnlock()
+	argsForCall := fake.countSearchArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeBlogRepository) CountSearchReturns(result1 int64, result2 error) {
+	fake.countSearchMutex.Lock()
+	defer fake.countSearchMutex.Unlock()
+	fake.CountSearchStub = nil
+	fake.countSearchReturns = struct {
+		result1 int64
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBlogRepository) CountSearchReturnsOnCall(i int, result1 int64, result2 error) {
+	fake.countSearchMutex.Lock()
+	defer fake.countSearchMutex.Unlock()
+	fake.CountSearchStub = nil
+	if fake.countSearchReturnsOnCall == nil {
+		fake.countSearchReturnsOnCall = make(map[int]struct {
+			result1 int64
+			result2 error
+		})
+	}
+	fake.countSearchReturnsOnCall[i] = struct {
+		result1 int64
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeBlogRepository) Create(arg1 context.Context, arg2 repository.Blog) error {
 	fake.createMutex.Lock()
 	ret, specificReturn := fake.createReturnsOnCall[len(fake.createArgsForCall)]
@@ -523,17 +1001,147 @@ func (fake *FakeBlogRepository) GetByIDReturnsOnCall(i int, result1 repository.B
 	}{result1, result2}
 }
 
-func (fake *FakeBlogRepository) GetByStatus(arg1 context.Context, arg2 string, arg3 int, arg4 int) ([]repository.Blog, error) {
-	fake.getByStatusMutex.Lock()
-	ret, specificReturn := fake.getByStatusReturnsOnCall[len(fake.getByStatusArgsForCall)]
-	fake.getByStatusArgsForCall = append(fake.getByStatusArgsForCall, struct {
+func (fake *FakeBlogRepository) GetByPreviousSlug(arg1 context.Context, arg2 string) (repository.Blog, error) {
+	fake.getByPreviousSlugMutex.Lock()
+	ret, specificReturn := fake.getByPreviousSlugReturnsOnCall[len(fake.getByPreviousSlugArgsForCall)]
+	fake.getByPreviousSlugArgsForCall = append(fake.getByPreviousSlugArgsForCall, struct {
 		arg1 context.Context
 		arg2 string
-		arg3 int
-		arg4 int
-	}{arg1, arg2, arg3, arg4})
-	stub := fake.GetByStatusStub
-	fakeReturns := fake.getByStatusReturns
+	}{arg1, arg2})
+	stub := fake.GetByPreviousSlugStub
+	fakeReturns := fake.getByPreviousSlugReturns
+	fake.recordInvocation("GetByPreviousSlug", []interface{}{arg1, arg2})
+	fake.getByPreviousSlugMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeBlogRepository) GetByPreviousSlugCallCount() int {
+	fake.getByPreviousSlugMutex.RLock()
+	defer fake.getByPreviousSlugMutex.RUnlock()
+	return len(fake.getByPreviousSlugArgsForCall)
+}
+
+func (fake *FakeBlogRepository) GetByPreviousSlugCalls(stub func(context.Context, string) (repository.Blog, error)) {
+	fake.getByPreviousSlugMutex.Lock()
+	defer fake.getByPreviousSlugMutex.Unlock()
+	fake.GetByPreviousSlugStub = stub
+}
+
+func (fake *FakeBlogRepository) GetByPreviousSlugArgsForCall(i int) (context.Context, string) {
+	fake.getByPreviousSlugMutex.RLock()
+	defer fake.getByPreviousSlugMutex.RUnlock()
+	argsForCall := fake.getByPreviousSlugArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeBlogRepository) GetByPreviousSlugReturns(result1 repository.Blog, result2 error) {
+	fake.getByPreviousSlugMutex.Lock()
+	defer fake.getByPreviousSlugMutex.Unlock()
+	fake.GetByPreviousSlugStub = nil
+	fake.getByPreviousSlugReturns = struct {
+		result1 repository.Blog
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBlogRepository) GetByPreviousSlugReturnsOnCall(i int, result1 repository.Blog, result2 error) {
+	fake.getByPreviousSlugMutex.Lock()
+	defer fake.getByPreviousSlugMutex.Unlock()
+	fake.GetByPreviousSlugStub = nil
+	if fake.getByPreviousSlugReturnsOnCall == nil {
+		fake.getByPreviousSlugReturnsOnCall = make(map[int]struct {
+			result1 repository.Blog
+			result2 error
+		})
+	}
+	fake.getByPreviousSlugReturnsOnCall[i] = struct {
+		result1 repository.Blog
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBlogRepository) GetBySlug(arg1 context.Context, arg2 string) (repository.Blog, error) {
+	fake.getBySlugMutex.Lock()
+	ret, specificReturn := fake.getBySlugReturnsOnCall[len(fake.getBySlugArgsForCall)]
+	fake.getBySlugArgsForCall = append(fake.getBySlugArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.GetBySlugStub
+	fakeReturns := fake.getBySlugReturns
+	fake.recordInvocation("GetBySlug", []interface{}{arg1, arg2})
+	fake.getBySlugMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeBlogRepository) GetBySlugCallCount() int {
+	fake.getBySlugMutex.RLock()
+	defer fake.getBySlugMutex.RUnlock()
+	return len(fake.getBySlugArgsForCall)
+}
+
+func (fake *FakeBlogRepository) GetBySlugCalls(stub func(context.Context, string) (repository.Blog, error)) {
+	fake.getBySlugMutex.Lock()
+	defer fake.getBySlugMutex.Unlock()
+	fake.GetBySlugStub = stub
+}
+
+func (fake *FakeBlogRepository) GetBySlugArgsForCall(i int) (context.Context, string) {
+	fake.getBySlugMutex.RLock()
+	defer fake.getBySlugMutex.RUnlock()
+	argsForCall := fake.getBySlugArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeBlogRepository) GetBySlugReturns(result1 repository.Blog, result2 error) {
+	fake.getBySlugMutex.Lock()
+	defer fake.getBySlugMutex.Unlock()
+	fake.GetBySlugStub = nil
+	fake.getBySlugReturns = struct {
+		result1 repository.Blog
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBlogRepository) GetBySlugReturnsOnCall(i int, result1 repository.Blog, result2 error) {
+	fake.getBySlugMutex.Lock()
+	defer fake.getBySlugMutex.Unlock()
+	fake.GetBySlugStub = nil
+	if fake.getBySlugReturnsOnCall == nil {
+		fake.getBySlugReturnsOnCall = make(map[int]struct {
+			result1 repository.Blog
+			result2 error
+		})
+	}
+	fake.getBySlugReturnsOnCall[i] = struct {
+		result1 repository.Blog
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBlogRepository) GetByStatus(arg1 context.Context, arg2 string, arg3 int, arg4 int) ([]repository.Blog, error) {
+	fake.getByStatusMutex.Lock()
+	ret, specificReturn := fake.getByStatusReturnsOnCall[len(fake.getByStatusArgsForCall)]
+	fake.getByStatusArgsForCall = append(fake.getByStatusArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 int
+		arg4 int
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.GetByStatusStub
+	fakeReturns := fake.getByStatusReturns
 	fake.recordInvocation("GetByStatus", []interface{}{arg1, arg2, arg3, arg4})
 	fake.getByStatusMutex.Unlock()
 	if stub != nil {
@@ -590,6 +1198,138 @@ func (fake *FakeBlogRepository) GetByStatusReturnsOnCall(i int, result1 []reposi
 	}{result1, result2}
 }
 
+func (fake *FakeBlogRepository) GetDueScheduled(arg1 context.Context, arg2 time.Time, arg3 int) ([]repository.Blog, error) {
+	fake.getDueScheduledMutex.Lock()
+	ret, specificReturn := fake.getDueScheduledReturnsOnCall[len(fake.getDueScheduledArgsForCall)]
+	fake.getDueScheduledArgsForCall = append(fake.getDueScheduledArgsForCall, struct {
+		arg1 context.Context
+		arg2 time.Time
+		arg3 int
+	}{arg1, arg2, arg3})
+	stub := fake.GetDueScheduledStub
+	fakeReturns := fake.getDueScheduledReturns
+	fake.recordInvocation("GetDueScheduled", []interface{}{arg1, arg2, arg3})
+	fake.getDueScheduledMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeBlogRepository) GetDueScheduledCallCount() int {
+	fake.getDueScheduledMutex.RLock()
+	defer fake.getDueScheduledMutex.RUnlock()
+	return len(fake.getDueScheduledArgsForCall)
+}
+
+func (fake *FakeBlogRepository) GetDueScheduledCalls(stub func(context.Context, time.Time, int) ([]repository.Blog, error)) {
+	fake.getDueScheduledMutex.Lock()
+	defer fake.getDueScheduledMutex.Unlock()
+	fake.GetDueScheduledStub = stub
+}
+
+func (fake *FakeBlogRepository) GetDueScheduledArgsForCall(i int) (context.Context, time.Time, int) {
+	fake.getDueScheduledMutex.RLock()
+	defer fake.getDueScheduledMutex.RUnlock()
+	argsForCall := fake.getDueScheduledArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeBlogRepository) GetDueScheduledReturns(result1 []repository.Blog, result2 error) {
+	fake.getDueScheduledMutex.Lock()
+	defer fake.getDueScheduledMutex.Unlock()
+	fake.GetDueScheduledStub = nil
+	fake.getDueScheduledReturns = struct {
+		result1 []repository.Blog
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBlogRepository) GetDueScheduledReturnsOnCall(i int, result1 []repository.Blog, result2 error) {
+	fake.getDueScheduledMutex.Lock()
+	defer fake.getDueScheduledMutex.Unlock()
+	fake.GetDueScheduledStub = nil
+	if fake.getDueScheduledReturnsOnCall == nil {
+		fake.getDueScheduledReturnsOnCall = make(map[int]struct {
+			result1 []repository.Blog
+			result2 error
+		})
+	}
+	fake.getDueScheduledReturnsOnCall[i] = struct {
+		result1 []repository.Blog
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBlogRepository) GetPublishedByAuthorID(arg1 context.Context, arg2 uuid.UUID, arg3 int) ([]repository.Blog, error) {
+	fake.getPublishedByAuthorIDMutex.Lock()
+	ret, specificReturn := fake.getPublishedByAuthorIDReturnsOnCall[len(fake.getPublishedByAuthorIDArgsForCall)]
+	fake.getPublishedByAuthorIDArgsForCall = append(fake.getPublishedByAuthorIDArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 int
+	}{arg1, arg2, arg3})
+	stub := fake.GetPublishedByAuthorIDStub
+	fakeReturns := fake.getPublishedByAuthorIDReturns
+	fake.recordInvocation("GetPublishedByAuthorID", []interface{}{arg1, arg2, arg3})
+	fake.getPublishedByAuthorIDMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeBlogRepository) GetPublishedByAuthorIDCallCount() int {
+	fake.getPublishedByAuthorIDMutex.RLock()
+	defer fake.getPublishedByAuthorIDMutex.RUnlock()
+	return len(fake.getPublishedByAuthorIDArgsForCall)
+}
+
+func (fake *FakeBlogRepository) GetPublishedByAuthorIDCalls(stub func(context.Context, uuid.UUID, int) ([]repository.Blog, error)) {
+	fake.getPublishedByAuthorIDMutex.Lock()
+	defer fake.getPublishedByAuthorIDMutex.Unlock()
+	fake.GetPublishedByAuthorIDStub = stub
+}
+
+func (fake *FakeBlogRepository) GetPublishedByAuthorIDArgsForCall(i int) (context.Context, uuid.UUID, int) {
+	fake.getPublishedByAuthorIDMutex.RLock()
+	defer fake.getPublishedByAuthorIDMutex.RUnlock()
+	argsForCall := fake.getPublishedByAuthorIDArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeBlogRepository) GetPublishedByAuthorIDReturns(result1 []repository.Blog, result2 error) {
+	fake.getPublishedByAuthorIDMutex.Lock()
+	defer fake.getPublishedByAuthorIDMutex.Unlock()
+	fake.GetPublishedByAuthorIDStub = nil
+	fake.getPublishedByAuthorIDReturns = struct {
+		result1 []repository.Blog
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBlogRepository) GetPublishedByAuthorIDReturnsOnCall(i int, result1 []repository.Blog, result2 error) {
+	fake.getPublishedByAuthorIDMutex.Lock()
+	defer fake.getPublishedByAuthorIDMutex.Unlock()
+	fake.GetPublishedByAuthorIDStub = nil
+	if fake.getPublishedByAuthorIDReturnsOnCall == nil {
+		fake.getPublishedByAuthorIDReturnsOnCall = make(map[int]struct {
+			result1 []repository.Blog
+			result2 error
+		})
+	}
+	fake.getPublishedByAuthorIDReturnsOnCall[i] = struct {
+		result1 []repository.Blog
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeBlogRepository) List(arg1 context.Context, arg2 int, arg3 int) ([]repository.Blog, error) {
 	fake.listMutex.Lock()
 	ret, specificReturn := fake.listReturnsOnCall[len(fake.listArgsForCall)]
@@ -656,6 +1396,466 @@ func (fake *FakeBlogRepository) ListReturnsOnCall(i int, result1 []repository.Bl
 	}{result1, result2}
 }
 
+func (fake *FakeBlogRepository) ListByTags(arg1 context.Context, arg2 []string, arg3 bool, arg4 int, arg5 int) ([]repository.Blog, error) {
+	var arg2Copy []string
+	if arg2 != nil {
+		arg2Copy = make([]string, len(arg2))
+		copy(arg2Copy, arg2)
+	}
+	fake.listByTagsMutex.Lock()
+	ret, specificReturn := fake.listByTagsReturnsOnCall[len(fake.listByTagsArgsForCall)]
+	fake.listByTagsArgsForCall = append(fake.listByTagsArgsForCall, struct {
+		arg1 context.Context
+		arg2 []string
+		arg3 bool
+		arg4 int
+		arg5 int
+	}{arg1, arg2Copy, arg3, arg4, arg5})
+	stub := fake.ListByTagsStub
+	fakeReturns := fake.listByTagsReturns
+	fake.recordInvocation("ListByTags", []interface{}{arg1, arg2Copy, arg3, arg4, arg5})
+	fake.listByTagsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeBlogRepository) ListByTagsCallCount() int {
+	fake.listByTagsMutex.RLock()
+	defer fake.listByTagsMutex.RUnlock()
+	return len(fake.listByTagsArgsForCall)
+}
+
+func (fake *FakeBlogRepository) ListByTagsCalls(stub func(context.Context, []string, bool, int, int) ([]repository.Blog, error)) {
+	fake.listByTagsMutex.Lock()
+	defer fake.listByTagsMutex.Unlock()
+	fake.ListByTagsStub = stub
+}
+
+func (fake *FakeBlogRepository) ListByTagsArgsForCall(i int) (context.Context, []string, bool, int, int) {
+	fake.listByTagsMutex.RLock()
+	defer fake.listByTagsMutex.RUnlock()
+	argsForCall := fake.listByTagsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
+}
+
+func (fake *FakeBlogRepository) ListByTagsReturns(result1 []repository.Blog, result2 error) {
+	fake.listByTagsMutex.Lock()
+	defer fake.listByTagsMutex.Unlock()
+	fake.ListByTagsStub = nil
+	fake.listByTagsReturns = struct {
+		result1 []repository.Blog
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBlogRepository) ListByTagsReturnsOnCall(i int, result1 []repository.Blog, result2 error) {
+	fake.listByTagsMutex.Lock()
+	defer fake.listByTagsMutex.Unlock()
+	fake.ListByTagsStub = nil
+	if fake.listByTagsReturnsOnCall == nil {
+		fake.listByTagsReturnsOnCall = make(map[int]struct {
+			result1 []repository.Blog
+			result2 error
+		})
+	}
+	fake.listByTagsReturnsOnCall[i] = struct {
+		result1 []repository.Blog
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBlogRepository) ListTags(arg1 context.Context) ([]repository.TagUsage, error) {
+	fake.listTagsMutex.Lock()
+	ret, specificReturn := fake.listTagsReturnsOnCall[len(fake.listTagsArgsForCall)]
+	fake.listTagsArgsForCall = append(fake.listTagsArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	stub := fake.ListTagsStub
+	fakeReturns := fake.listTagsReturns
+	fake.recordInvocation("ListTags", []interface{}{arg1})
+	fake.listTagsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeBlogRepository) ListTagsCallCount() int {
+	fake.listTagsMutex.RLock()
+	defer fake.listTagsMutex.RUnlock()
+	return len(fake.listTagsArgsForCall)
+}
+
+func (fake *FakeBlogRepository) ListTagsCalls(stub func(context.Context) ([]repository.TagUsage, error)) {
+	fake.listTagsMutex.Lock()
+	defer fake.listTagsMutex.Unlock()
+	fake.ListTagsStub = stub
+}
+
+func (fake *FakeBlogRepository) ListTagsArgsForCall(i int) context.Context {
+	fake.listTagsMutex.RLock()
+	defer fake.listTagsMutex.RUnlock()
+	argsForCall := fake.listTagsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeBlogRepository) ListTagsReturns(result1 []repository.TagUsage, result2 error) {
+	fake.listTagsMutex.Lock()
+	defer fake.listTagsMutex.Unlock()
+	fake.ListTagsStub = nil
+	fake.listTagsReturns = struct {
+		result1 []repository.TagUsage
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBlogRepository) ListTagsReturnsOnCall(i int, result1 []repository.TagUsage, result2 error) {
+	fake.listTagsMutex.Lock()
+	defer fake.listTagsMutex.Unlock()
+	fake.ListTagsStub = nil
+	if fake.listTagsReturnsOnCall == nil {
+		fake.listTagsReturnsOnCall = make(map[int]struct {
+			result1 []repository.TagUsage
+			result2 error
+		})
+	}
+	fake.listTagsReturnsOnCall[i] = struct {
+		result1 []repository.TagUsage
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBlogRepository) PublishScheduled(arg1 context.Context, arg2 uuid.UUID, arg3 time.Time) error {
+	fake.publishScheduledMutex.Lock()
+	ret, specificReturn := fake.publishScheduledReturnsOnCall[len(fake.publishScheduledArgsForCall)]
+	fake.publishScheduledArgsForCall = append(fake.publishScheduledArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 time.Time
+	}{arg1, arg2, arg3})
+	stub := fake.PublishScheduledStub
+	fakeReturns := fake.publishScheduledReturns
+	fake.recordInvocation("PublishScheduled", []interface{}{arg1, arg2, arg3})
+	fake.publishScheduledMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeBlogRepository) PublishScheduledCallCount() int {
+	fake.publishScheduledMutex.RLock()
+	defer fake.publishScheduledMutex.RUnlock()
+	return len(fake.publishScheduledArgsForCall)
+}
+
+func (fake *FakeBlogRepository) PublishScheduledCalls(stub func(context.Context, uuid.UUID, time.Time) error) {
+	fake.publishScheduledMutex.Lock()
+	defer fake.publishScheduledMutex.Unlock()
+	fake.PublishScheduledStub = stub
+}
+
+func (fake *FakeBlogRepository) PublishScheduledArgsForCall(i int) (context.Context, uuid.UUID, time.Time) {
+	fake.publishScheduledMutex.RLock()
+	defer fake.publishScheduledMutex.RUnlock()
+	argsForCall := fake.publishScheduledArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeBlogRepository) PublishScheduledReturns(result1 error) {
+	fake.publishScheduledMutex.Lock()
+	defer fake.publishScheduledMutex.Unlock()
+	fake.PublishScheduledStub = nil
+	fake.publishScheduledReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeBlogRepository) PublishScheduledReturnsOnCall(i int, result1 error) {
+	fake.publishScheduledMutex.Lock()
+	defer fake.publishScheduledMutex.Unlock()
+	fake.PublishScheduledStub = nil
+	if fake.publishScheduledReturnsOnCall == nil {
+		fake.publishScheduledReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.publishScheduledReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeBlogRepository) Search(arg1 context.Context, arg2 repository.SearchFilter, arg3 int, arg4 int) ([]repository.SearchResult, error) {
+	fake.searchMutex.Lock()
+	ret, specificReturn := fake.searchReturnsOnCall[len(fake.searchArgsForCall)]
+	fake.searchArgsForCall = append(fake.searchArgsForCall, struct {
+		arg1 context.Context
+		arg2 repository.SearchFilter
+		arg3 int
+		arg4 int
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.SearchStub
+	fakeReturns := fake.searchReturns
+	fake.recordInvocation("Search", []interface{}{arg1, arg2, arg3, arg4})
+	fake.searchMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeBlogRepository) SearchCallCount() int {
+	fake.searchMutex.RLock()
+	defer fake.searchMutex.RUnlock()
+	return len(fake.searchArgsForCall)
+}
+
+func (fake *FakeBlogRepository) SearchCalls(stub func(context.Context, repository.SearchFilter, int, int) ([]repository.SearchResult, error)) {
+	fake.searchMutex.Lock()
+	defer fake.searchMutex.Unlock()
+	fake.SearchStub = stub
+}
+
+func (fake *FakeBlogRepository) SearchArgsForCall(i int) (context.Context, repository.SearchFilter, int, int) {
+	fake.searchMutex.RLock()
+	defer fake.searchMutex.RUnlock()
+	argsForCall := fake.searchArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeBlogRepository) SearchReturns(result1 []repository.SearchResult, result2 error) {
+	fake.searchMutex.Lock()
+	defer fake.searchMutex.Unlock()
+	fake.SearchStub = nil
+	fake.searchReturns = struct {
+		result1 []repository.SearchResult
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBlogRepository) SearchReturnsOnCall(i int, result1 []repository.SearchResult, result2 error) {
+	fake.searchMutex.Lock()
+	defer fake.searchMutex.Unlock()
+	fake.SearchStub = nil
+	if fake.searchReturnsOnCall == nil {
+		fake.searchReturnsOnCall = make(map[int]struct {
+			result1 []repository.SearchResult
+			result2 error
+		})
+	}
+	fake.searchReturnsOnCall[i] = struct {
+		result1 []repository.SearchResult
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBlogRepository) SetCommentsClosed(arg1 context.Context, arg2 uuid.UUID, arg3 bool) error {
+	fake.setCommentsClosedMutex.Lock()
+	ret, specificReturn := fake.setCommentsClosedReturnsOnCall[len(fake.setCommentsClosedArgsForCall)]
+	fake.setCommentsClosedArgsForCall = append(fake.setCommentsClosedArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 bool
+	}{arg1, arg2, arg3})
+	stub := fake.SetCommentsClosedStub
+	fakeReturns := fake.setCommentsClosedReturns
+	fake.recordInvocation("SetCommentsClosed", []interface{}{arg1, arg2, arg3})
+	fake.setCommentsClosedMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeBlogRepository) SetCommentsClosedCallCount() int {
+	fake.setCommentsClosedMutex.RLock()
+	defer fake.setCommentsClosedMutex.RUnlock()
+	return len(fake.setCommentsClosedArgsForCall)
+}
+
+func (fake *FakeBlogRepository) SetCommentsClosedCalls(stub func(context.Context, uuid.UUID, bool) error) {
+	fake.setCommentsClosedMutex.Lock()
+	defer fake.setCommentsClosedMutex.Unlock()
+	fake.SetCommentsClosedStub = stub
+}
+
+func (fake *FakeBlogRepository) SetCommentsClosedArgsForCall(i int) (context.Context, uuid.UUID, bool) {
+	fake.setCommentsClosedMutex.RLock()
+	defer fake.setCommentsClosedMutex.RUnlock()
+	argsForCall := fake.setCommentsClosedArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeBlogRepository) SetCommentsClosedReturns(result1 error) {
+	fake.setCommentsClosedMutex.Lock()
+	defer fake.setCommentsClosedMutex.Unlock()
+	fake.SetCommentsClosedStub = nil
+	fake.setCommentsClosedReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeBlogRepository) SetCommentsClosedReturnsOnCall(i int, result1 error) {
+	fake.setCommentsClosedMutex.Lock()
+	defer fake.setCommentsClosedMutex.Unlock()
+	fake.SetCommentsClosedStub = nil
+	if fake.setCommentsClosedReturnsOnCall == nil {
+		fake.setCommentsClosedReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.setCommentsClosedReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeBlogRepository) SetTags(arg1 context.Context, arg2 uuid.UUID, arg3 []string) error {
+	var arg3Copy []string
+	if arg3 != nil {
+		arg3Copy = make([]string, len(arg3))
+		copy(arg3Copy, arg3)
+	}
+	fake.setTagsMutex.Lock()
+	ret, specificReturn := fake.setTagsReturnsOnCall[len(fake.setTagsArgsForCall)]
+	fake.setTagsArgsForCall = append(fake.setTagsArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 []string
+	}{arg1, arg2, arg3Copy})
+	stub := fake.SetTagsStub
+	fakeReturns := fake.setTagsReturns
+	fake.recordInvocation("SetTags", []interface{}{arg1, arg2, arg3Copy})
+	fake.setTagsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeBlogRepository) SetTagsCallCount() int {
+	fake.setTagsMutex.RLock()
+	defer fake.setTagsMutex.RUnlock()
+	return len(fake.setTagsArgsForCall)
+}
+
+func (fake *FakeBlogRepository) SetTagsCalls(stub func(context.Context, uuid.UUID, []string) error) {
+	fake.setTagsMutex.Lock()
+	defer fake.setTagsMutex.Unlock()
+	fake.SetTagsStub = stub
+}
+
+func (fake *FakeBlogRepository) SetTagsArgsForCall(i int) (context.Context, uuid.UUID, []string) {
+	fake.setTagsMutex.RLock()
+	defer fake.setTagsMutex.RUnlock()
+	argsForCall := fake.setTagsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeBlogRepository) SetTagsReturns(result1 error) {
+	fake.setTagsMutex.Lock()
+	defer fake.setTagsMutex.Unlock()
+	fake.SetTagsStub = nil
+	fake.setTagsReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeBlogRepository) SetTagsReturnsOnCall(i int, result1 error) {
+	fake.setTagsMutex.Lock()
+	defer fake.setTagsMutex.Unlock()
+	fake.SetTagsStub = nil
+	if fake.setTagsReturnsOnCall == nil {
+		fake.setTagsReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.setTagsReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeBlogRepository) Unschedule(arg1 context.Context, arg2 uuid.UUID) error {
+	fake.unscheduleMutex.Lock()
+	ret, specificReturn := fake.unscheduleReturnsOnCall[len(fake.unscheduleArgsForCall)]
+	fake.unscheduleArgsForCall = append(fake.unscheduleArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}{arg1, arg2})
+	stub := fake.UnscheduleStub
+	fakeReturns := fake.unscheduleReturns
+	fake.recordInvocation("Unschedule", []interface{}{arg1, arg2})
+	fake.unscheduleMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeBlogRepository) UnscheduleCallCount() int {
+	fake.unscheduleMutex.RLock()
+	defer fake.unscheduleMutex.RUnlock()
+	return len(fake.unscheduleArgsForCall)
+}
+
+func (fake *FakeBlogRepository) UnscheduleCalls(stub func(context.Context, uuid.UUID) error) {
+	fake.unscheduleMutex.Lock()
+	defer fake.unscheduleMutex.Unlock()
+	fake.UnscheduleStub = stub
+}
+
+func (fake *FakeBlogRepository) UnscheduleArgsForCall(i int) (context.Context, uuid.UUID) {
+	fake.unscheduleMutex.RLock()
+	defer fake.unscheduleMutex.RUnlock()
+	argsForCall := fake.unscheduleArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeBlogRepository) UnscheduleReturns(result1 error) {
+	fake.unscheduleMutex.Lock()
+	defer fake.unscheduleMutex.Unlock()
+	fake.UnscheduleStub = nil
+	fake.unscheduleReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeBlogRepository) UnscheduleReturnsOnCall(i int, result1 error) {
+	fake.unscheduleMutex.Lock()
+	defer fake.unscheduleMutex.Unlock()
+	fake.UnscheduleStub = nil
+	if fake.unscheduleReturnsOnCall == nil {
+		fake.unscheduleReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.unscheduleReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeBlogRepository) Update(arg1 context.Context, arg2 repository.Blog) error {
 	fake.updateMutex.Lock()
 	ret, specificReturn := fake.updateReturnsOnCall[len(fake.updateArgsForCall)]
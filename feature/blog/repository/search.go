@@ -0,0 +1,124 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/go-sql-driver/mysql"
+)
+
+// Search finds the blogs whose title or content match the query, the most relevant first
+// unless the filter sorts them by newest
+func (r *blogRepository) Search(ctx context.Context, filter SearchFilter, limit, offset int) ([]SearchResult, error) {
+	match, matchArgs := matchAgainst(filter)
+	condition, conditionArgs := searchCondition(filter)
+
+	orderBy := "relevance DESC, created_at DESC"
+	if filter.Sort == SearchSortNewest {
+		orderBy = "created_at DESC"
+	}
+
+	query := `
+		SELECT id, title, slug, content, author_id, status, comments_closed, published_at, scheduled_at, created_at, updated_at,
+			` + match + ` AS relevance
+		FROM blogs
+		WHERE ` + condition + `
+		ORDER BY ` + orderBy + `
+		LIMIT ? OFFSET ?
+	`
+
+	args := append(matchArgs, conditionArgs...)
+	args = append(args, limit, offset)
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		if isFullTextSyntaxError(err) {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidSearchQuery, err)
+		}
+		r.log.Error("Failed to search blogs",
+			slog.String("error", err.Error()),
+		)
+		return nil, fmt.Errorf("%w: %w", ErrFailedToSearchBlogs, err)
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			r.log.Error("Failed to close search blogs rows", slog.String("error", err.Error()))
+		}
+	}()
+
+	var results []SearchResult
+	for rows.Next() {
+		result := SearchResult{}
+		err := rows.Scan(
+			&result.ID,
+			&result.Title,
+			&result.Slug,
+			&result.Content,
+			&result.AuthorID,
+			&result.Status,
+			&result.CommentsClosed,
+			&result.PublishedAt,
+			&result.ScheduledAt,
+			&result.CreatedAt,
+			&result.UpdatedAt,
+			&result.Relevance,
+		)
+		if err != nil {
+			r.log.Error("Failed to scan blog row",
+				slog.String("error", err.Error()),
+			)
+			return nil, fmt.Errorf("%w: %w", ErrFailedToScanBlogRow, err)
+		}
+		results = append(results, result)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.log.Error("Error iterating blog rows",
+			slog.String("error", err.Error()),
+		)
+		return nil, fmt.Errorf("%w: %w", ErrFailedToIterateRows, err)
+	}
+
+	blogs := make([]Blog, len(results))
+	for i, result := range results {
+		blogs[i] = result.Blog
+	}
+	if err := r.loadTags(ctx, blogs); err != nil {
+		return nil, err
+	}
+	for i := range results {
+		results[i].Tags = blogs[i].Tags
+	}
+
+	return results, nil
+}
+
+// matchAgainst returns the full-text match of the query against the title and content
+func matchAgainst(filter SearchFilter) (string, []any) {
+	mode := "IN NATURAL LANGUAGE MODE"
+	if filter.BooleanMode {
+		mode = "IN BOOLEAN MODE"
+	}
+	return "MATCH(title, content) AGAINST (? " + mode + ")", []any{filter.Query}
+}
+
+// searchCondition returns the WHERE condition of the blogs matching the filter
+func searchCondition(filter SearchFilter) (string, []any) {
+	condition, args := matchAgainst(filter)
+	if filter.Status != "" {
+		condition += " AND status = ?"
+		args = append(args, filter.Status)
+	}
+	return condition, args
+}
+
+// mysqlErrParse is the MySQL error number InnoDB answers malformed boolean mode queries with
+const mysqlErrParse = 1064
+
+// isFullTextSyntaxError reports whether the boolean mode query could not be parsed. Search
+// queries are fixed, so only the query text can be at fault.
+func isFullTextSyntaxError(err error) bool {
+	var mysqlErr *mysql.MySQLError
+	return errors.As(err, &mysqlErr) && mysqlErr.Number == mysqlErrParse
+}
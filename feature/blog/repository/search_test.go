@@ -0,0 +1,127 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/fikryfahrezy/let-it-go/feature/blog/repository"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createSearchableBlogs(t *testing.T, authorID uuid.UUID) (deploying, generics, tuning repository.Blog) {
+	t.Helper()
+
+	blogs := []repository.Blog{
+		{Title: "Deploying Golang Services", Slug: "deploying-golang-services", Content: "How to ship binaries to kubernetes clusters", Status: repository.StatusPublished},
+		{Title: "Golang Generics", Slug: "golang-generics", Content: "Type parameters arrived in golang, generics explained with golang examples", Status: repository.StatusDraft},
+		{Title: "Tuning MySQL", Slug: "tuning-mysql", Content: "Indexes, buffers and the query planner", Status: repository.StatusPublished},
+	}
+	for i := range blogs {
+		blogs[i].AuthorID = authorID
+		require.NoError(t, testRepository.Create(context.Background(), blogs[i]))
+
+		blog, err := testRepository.GetBySlug(context.Background(), blogs[i].Slug)
+		require.NoError(t, err)
+		blogs[i] = blog
+	}
+
+	return blogs[0], blogs[1], blogs[2]
+}
+
+func TestSearch(t *testing.T) {
+	authorID := setupTest(t)
+	deploying, generics, _ := createSearchableBlogs(t, authorID)
+
+	filter := repository.SearchFilter{Query: "golang", Sort: repository.SearchSortRelevance}
+	results, err := testRepository.Search(context.Background(), filter, 10, 0)
+	require.NoError(t, err)
+	require.Len(t, results, 2)
+
+	// The blog using the word the most comes first
+	assert.Equal(t, generics.ID, results[0].ID)
+	assert.Equal(t, deploying.ID, results[1].ID)
+	assert.Greater(t, results[0].Relevance, results[1].Relevance)
+	assert.Greater(t, results[1].Relevance, float64(0))
+	assert.Equal(t, []string{}, results[0].Tags)
+
+	count, err := testRepository.CountSearch(context.Background(), filter)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), count)
+
+	// Pages follow the same order
+	results, err = testRepository.Search(context.Background(), filter, 1, 1)
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	assert.Equal(t, deploying.ID, results[0].ID)
+}
+
+func TestSearchByStatus(t *testing.T) {
+	authorID := setupTest(t)
+	deploying, _, _ := createSearchableBlogs(t, authorID)
+
+	filter := repository.SearchFilter{Query: "golang", Status: repository.StatusPublished}
+	results, err := testRepository.Search(context.Background(), filter, 10, 0)
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	assert.Equal(t, deploying.ID, results[0].ID)
+
+	count, err := testRepository.CountSearch(context.Background(), filter)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), count)
+}
+
+func TestSearchBooleanMode(t *testing.T) {
+	authorID := setupTest(t)
+	deploying, generics, tuning := createSearchableBlogs(t, authorID)
+
+	tests := []struct {
+		name     string
+		query    string
+		expected []uuid.UUID
+	}{
+		{name: "required and excluded words", query: "+golang -kubernetes", expected: []uuid.UUID{generics.ID}},
+		{name: "prefix", query: "kube*", expected: []uuid.UUID{deploying.ID}},
+		{name: "phrase", query: `"query planner"`, expected: []uuid.UUID{tuning.ID}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filter := repository.SearchFilter{Query: tt.query, BooleanMode: true}
+			results, err := testRepository.Search(context.Background(), filter, 10, 0)
+			require.NoError(t, err)
+
+			ids := make([]uuid.UUID, len(results))
+			for i, result := range results {
+				ids[i] = result.ID
+			}
+			assert.Equal(t, tt.expected, ids)
+
+			count, err := testRepository.CountSearch(context.Background(), filter)
+			require.NoError(t, err)
+			assert.Equal(t, int64(len(tt.expected)), count)
+		})
+	}
+}
+
+func TestSearchNoMatch(t *testing.T) {
+	authorID := setupTest(t)
+	createSearchableBlogs(t, authorID)
+
+	results, err := testRepository.Search(context.Background(), repository.SearchFilter{Query: "rust"}, 10, 0)
+	assert.NoError(t, err)
+	assert.Empty(t, results)
+}
+
+func TestSearchInvalidBooleanQuery(t *testing.T) {
+	authorID := setupTest(t)
+	createSearchableBlogs(t, authorID)
+
+	filter := repository.SearchFilter{Query: "author@example.com", BooleanMode: true}
+	_, err := testRepository.Search(context.Background(), filter, 10, 0)
+	assert.ErrorIs(t, err, repository.ErrInvalidSearchQuery)
+
+	_, err = testRepository.CountSearch(context.Background(), filter)
+	assert.ErrorIs(t, err, repository.ErrInvalidSearchQuery)
+}
@@ -0,0 +1,108 @@
+package repository_test
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/fikryfahrezy/let-it-go/feature/blog/repository"
+	"github.com/fikryfahrezy/let-it-go/pkg/database"
+	"github.com/fikryfahrezy/let-it-go/pkg/logger"
+	"github.com/go-sql-driver/mysql"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSearchUnit(t *testing.T) {
+	tests := []struct {
+		name   string
+		filter repository.SearchFilter
+		query  string
+		args   []driver.Value
+	}{
+		{
+			name:   "natural language by relevance",
+			filter: repository.SearchFilter{Query: "golang", Sort: repository.SearchSortRelevance},
+			query:  "SELECT (.+), MATCH\\(title, content\\) AGAINST \\(\\? IN NATURAL LANGUAGE MODE\\) AS relevance FROM blogs WHERE MATCH\\(title, content\\) AGAINST \\(\\? IN NATURAL LANGUAGE MODE\\) ORDER BY relevance DESC, created_at DESC LIMIT \\? OFFSET \\?",
+			args:   []driver.Value{"golang", "golang", 10, 0},
+		},
+		{
+			name:   "boolean mode by newest with status",
+			filter: repository.SearchFilter{Query: "+golang -java", BooleanMode: true, Status: repository.StatusPublished, Sort: repository.SearchSortNewest},
+			query:  "SELECT (.+) AGAINST \\(\\? IN BOOLEAN MODE\\) AS relevance FROM blogs WHERE MATCH\\(title, content\\) AGAINST \\(\\? IN BOOLEAN MODE\\) AND status = \\? ORDER BY created_at DESC LIMIT \\? OFFSET \\?",
+			args:   []driver.Value{"+golang -java", "+golang -java", repository.StatusPublished, 10, 0},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sqlDB, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			// nolint:errcheck
+			defer sqlDB.Close()
+
+			db := &database.DB{DB: sqlDB}
+			repo := repository.NewBlogRepository(logger.NewDiscardLogger(), db)
+			ctx := context.Background()
+
+			blogID := uuid.New()
+			rows := sqlmock.NewRows([]string{"id", "title", "slug", "content", "author_id", "status", "comments_closed", "published_at", "scheduled_at", "created_at", "updated_at", "relevance"}).
+				AddRow(blogID, "Golang Blog", "golang-blog", "Content", uuid.New(), repository.StatusPublished, false, nil, nil, time.Now(), time.Now(), 0.75)
+			mock.ExpectQuery(tt.query).
+				WithArgs(tt.args...).
+				WillReturnRows(rows)
+			mock.ExpectQuery("SELECT bt.blog_id, t.name FROM blog_tags bt JOIN tags t").
+				WithArgs(blogID).
+				WillReturnRows(sqlmock.NewRows([]string{"blog_id", "name"}).AddRow(blogID, "go"))
+
+			results, err := repo.Search(ctx, tt.filter, 10, 0)
+			assert.NoError(t, err)
+			require.Len(t, results, 1)
+			assert.Equal(t, blogID, results[0].ID)
+			assert.Equal(t, 0.75, results[0].Relevance)
+			assert.Equal(t, []string{"go"}, results[0].Tags)
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestSearchInvalidQueryUnit(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	// nolint:errcheck
+	defer sqlDB.Close()
+
+	db := &database.DB{DB: sqlDB}
+	repo := repository.NewBlogRepository(logger.NewDiscardLogger(), db)
+
+	mock.ExpectQuery("SELECT (.+) FROM blogs WHERE MATCH").
+		WillReturnError(&mysql.MySQLError{Number: 1064, Message: "syntax error, unexpected '@', expecting $end"})
+
+	_, err = repo.Search(context.Background(), repository.SearchFilter{Query: "author@example.com", BooleanMode: true}, 10, 0)
+	assert.ErrorIs(t, err, repository.ErrInvalidSearchQuery)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSearchErrorUnit(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	// nolint:errcheck
+	defer sqlDB.Close()
+
+	db := &database.DB{DB: sqlDB}
+	repo := repository.NewBlogRepository(logger.NewDiscardLogger(), db)
+
+	mock.ExpectQuery("SELECT (.+) FROM blogs WHERE MATCH").
+		WillReturnError(errors.New("database connection error"))
+
+	_, err = repo.Search(context.Background(), repository.SearchFilter{Query: "golang"}, 10, 0)
+	assert.ErrorIs(t, err, repository.ErrFailedToSearchBlogs)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
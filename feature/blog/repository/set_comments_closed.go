@@ -0,0 +1,47 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/google/uuid"
+)
+
+// SetCommentsClosed opens or closes a blog for new comments. Closing a blog that is
+// already closed, or opening an open one, reports the blog as not found.
+func (r *blogRepository) SetCommentsClosed(ctx context.Context, id uuid.UUID, closed bool) error {
+	query := `
+		UPDATE blogs
+		SET comments_closed = ?
+		WHERE id = ? AND comments_closed <> ?
+	`
+
+	result, err := r.db.ExecContext(ctx, query, closed, id, closed)
+	if err != nil {
+		r.log.Error("Failed to set blog comments closed",
+			slog.String("error", err.Error()),
+			slog.String("blog_id", id.String()),
+		)
+		return fmt.Errorf("%w: %w", ErrFailedToSetCommentsClosed, err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		r.log.Error("Failed to get rows affected",
+			slog.String("error", err.Error()),
+		)
+		return fmt.Errorf("%w: %w", ErrFailedToGetRowsAffected, err)
+	}
+
+	if rowsAffected == 0 {
+		return ErrBlogNotFound
+	}
+
+	r.log.Info("Blog comments closed changed successfully",
+		slog.String("blog_id", id.String()),
+		slog.Bool("comments_closed", closed),
+	)
+
+	return nil
+}
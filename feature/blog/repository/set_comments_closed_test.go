@@ -0,0 +1,53 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/fikryfahrezy/let-it-go/feature/blog/repository"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetCommentsClosed(t *testing.T) {
+	authorID := setupTest(t)
+	ctx := context.Background()
+
+	err := testRepository.Create(ctx, repository.Blog{
+		Title:    "Test Blog",
+		Content:  "This is a test blog content",
+		AuthorID: authorID,
+		Status:   repository.StatusPublished,
+	})
+	require.NoError(t, err)
+
+	createdBlog, err := getBlogByTitle("Test Blog")
+	require.NoError(t, err)
+	assert.False(t, createdBlog.CommentsClosed)
+
+	err = testRepository.SetCommentsClosed(ctx, createdBlog.ID, true)
+	require.NoError(t, err)
+
+	blog, err := testRepository.GetByID(ctx, createdBlog.ID)
+	require.NoError(t, err)
+	assert.True(t, blog.CommentsClosed)
+
+	// Closing it again changes nothing
+	err = testRepository.SetCommentsClosed(ctx, createdBlog.ID, true)
+	assert.Equal(t, repository.ErrBlogNotFound, err)
+
+	err = testRepository.SetCommentsClosed(ctx, createdBlog.ID, false)
+	require.NoError(t, err)
+
+	blog, err = testRepository.GetByID(ctx, createdBlog.ID)
+	require.NoError(t, err)
+	assert.False(t, blog.CommentsClosed)
+}
+
+func TestSetCommentsClosedNotFound(t *testing.T) {
+	setupTest(t)
+
+	err := testRepository.SetCommentsClosed(context.Background(), uuid.New(), true)
+	assert.Equal(t, repository.ErrBlogNotFound, err)
+}
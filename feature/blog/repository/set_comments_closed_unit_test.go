@@ -0,0 +1,80 @@
+package repository_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/fikryfahrezy/let-it-go/feature/blog/repository"
+	"github.com/fikryfahrezy/let-it-go/pkg/database"
+	"github.com/fikryfahrezy/let-it-go/pkg/logger"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetCommentsClosedUnit(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	// nolint:errcheck
+	defer sqlDB.Close()
+
+	db := &database.DB{DB: sqlDB}
+	repo := repository.NewBlogRepository(logger.NewDiscardLogger(), db)
+	ctx := context.Background()
+
+	blogID := uuid.New()
+
+	// Mock the UPDATE query
+	mock.ExpectExec(`UPDATE blogs SET comments_closed = \? WHERE id = \? AND comments_closed <> \?`).
+		WithArgs(true, blogID, true).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	err = repo.SetCommentsClosed(ctx, blogID, true)
+	assert.NoError(t, err)
+
+	// Verify all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSetCommentsClosedNotFoundUnit(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	// nolint:errcheck
+	defer sqlDB.Close()
+
+	db := &database.DB{DB: sqlDB}
+	repo := repository.NewBlogRepository(logger.NewDiscardLogger(), db)
+	ctx := context.Background()
+
+	// Mock the UPDATE query to return 0 affected rows
+	mock.ExpectExec("UPDATE blogs SET comments_closed").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	err = repo.SetCommentsClosed(ctx, uuid.New(), false)
+	assert.Equal(t, repository.ErrBlogNotFound, err)
+
+	// Verify all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSetCommentsClosedErrorUnit(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	// nolint:errcheck
+	defer sqlDB.Close()
+
+	db := &database.DB{DB: sqlDB}
+	repo := repository.NewBlogRepository(logger.NewDiscardLogger(), db)
+	ctx := context.Background()
+
+	mock.ExpectExec("UPDATE blogs SET comments_closed").
+		WillReturnError(errors.New("connection lost"))
+
+	err = repo.SetCommentsClosed(ctx, uuid.New(), true)
+	assert.ErrorIs(t, err, repository.ErrFailedToSetCommentsClosed)
+
+	// Verify all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,39 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+
+	"github.com/google/uuid"
+)
+
+// SetTags replaces the tags of the blog in one transaction. An empty list removes them all.
+func (r *blogRepository) SetTags(ctx context.Context, blogID uuid.UUID, tags []string) error {
+	err := r.withTx(ctx, "set tags", func(tx *sql.Tx) error {
+		if _, err := tx.ExecContext(ctx, `DELETE FROM blog_tags WHERE blog_id = ?`, blogID); err != nil {
+			r.log.Error("Failed to remove blog tags",
+				slog.String("error", err.Error()),
+				slog.String("blog_id", blogID.String()),
+			)
+			return fmt.Errorf("%w: %w", ErrFailedToSetTags, err)
+		}
+
+		if err := r.addTags(ctx, tx, blogID, tags); err != nil {
+			return err
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	r.log.Info("Blog tags set successfully",
+		slog.String("blog_id", blogID.String()),
+		slog.Int("tags", len(tags)),
+	)
+
+	return nil
+}
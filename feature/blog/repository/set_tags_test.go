@@ -0,0 +1,50 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/fikryfahrezy/let-it-go/feature/blog/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetTags(t *testing.T) {
+	authorID := setupTest(t)
+	_, err := db.Exec("DELETE FROM tags")
+	require.NoError(t, err)
+
+	err = testRepository.Create(context.Background(), repository.Blog{
+		Title:    "Test Blog",
+		Slug:     "test-blog",
+		Content:  "This is a test blog content",
+		AuthorID: authorID,
+		Status:   repository.StatusDraft,
+		Tags:     []string{"go", "mysql"},
+	})
+	require.NoError(t, err)
+
+	blog, err := testRepository.GetBySlug(context.Background(), "test-blog")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"go", "mysql"}, blog.Tags)
+
+	// The tags are replaced, existing tags are reused
+	err = testRepository.SetTags(context.Background(), blog.ID, []string{"mysql", "testing"})
+	require.NoError(t, err)
+
+	result, err := testRepository.GetByID(context.Background(), blog.ID)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"mysql", "testing"}, result.Tags)
+
+	var tagCount int
+	require.NoError(t, db.QueryRow("SELECT COUNT(*) FROM tags").Scan(&tagCount))
+	assert.Equal(t, 3, tagCount)
+
+	// An empty list removes all tags
+	err = testRepository.SetTags(context.Background(), blog.ID, []string{})
+	require.NoError(t, err)
+
+	result, err = testRepository.GetByID(context.Background(), blog.ID)
+	require.NoError(t, err)
+	assert.Empty(t, result.Tags)
+}
@@ -0,0 +1,94 @@
+package repository_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/fikryfahrezy/let-it-go/feature/blog/repository"
+	"github.com/fikryfahrezy/let-it-go/pkg/database"
+	"github.com/fikryfahrezy/let-it-go/pkg/logger"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetTagsUnit(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	// nolint:errcheck
+	defer sqlDB.Close()
+
+	db := &database.DB{DB: sqlDB}
+	repo := repository.NewBlogRepository(logger.NewDiscardLogger(), db)
+	ctx := context.Background()
+
+	blogID := uuid.New()
+
+	mock.ExpectBegin()
+	mock.ExpectExec("DELETE FROM blog_tags WHERE blog_id = ?").
+		WithArgs(blogID).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("INSERT INTO tags (.+) ON DUPLICATE KEY UPDATE").
+		WithArgs(sqlmock.AnyArg(), "go", sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("INSERT INTO blog_tags \\(blog_id, tag_id\\) SELECT \\?, id FROM tags WHERE name IN \\(\\?\\)").
+		WithArgs(blogID, "go").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	err = repo.SetTags(ctx, blogID, []string{"go"})
+	assert.NoError(t, err)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSetTagsClearUnit(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	// nolint:errcheck
+	defer sqlDB.Close()
+
+	db := &database.DB{DB: sqlDB}
+	repo := repository.NewBlogRepository(logger.NewDiscardLogger(), db)
+	ctx := context.Background()
+
+	blogID := uuid.New()
+
+	// Without tags only the links are removed
+	mock.ExpectBegin()
+	mock.ExpectExec("DELETE FROM blog_tags WHERE blog_id = ?").
+		WithArgs(blogID).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectCommit()
+
+	err = repo.SetTags(ctx, blogID, nil)
+	assert.NoError(t, err)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSetTagsErrorUnit(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	// nolint:errcheck
+	defer sqlDB.Close()
+
+	db := &database.DB{DB: sqlDB}
+	repo := repository.NewBlogRepository(logger.NewDiscardLogger(), db)
+	ctx := context.Background()
+
+	blogID := uuid.New()
+
+	mock.ExpectBegin()
+	mock.ExpectExec("DELETE FROM blog_tags WHERE blog_id = ?").
+		WithArgs(blogID).
+		WillReturnError(errors.New("database connection error"))
+	mock.ExpectRollback()
+
+	err = repo.SetTags(ctx, blogID, []string{"go"})
+	assert.ErrorIs(t, err, repository.ErrFailedToSetTags)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
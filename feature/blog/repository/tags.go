@@ -0,0 +1,142 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// loadTags fills in the tags of the blogs with a single query, however many blogs there are
+func (r *blogRepository) loadTags(ctx context.Context, blogs []Blog) error {
+	if len(blogs) == 0 {
+		return nil
+	}
+
+	args := make([]any, len(blogs))
+	positions := make(map[uuid.UUID]int, len(blogs))
+	for i, blog := range blogs {
+		args[i] = blog.ID
+		positions[blog.ID] = i
+		blogs[i].Tags = []string{}
+	}
+
+	query := `
+		SELECT bt.blog_id, t.name
+		FROM blog_tags bt
+		JOIN tags t ON t.id = bt.tag_id
+		WHERE bt.blog_id IN (` + placeholders(len(args)) + `)
+		ORDER BY t.name
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		r.log.Error("Failed to get blog tags",
+			slog.String("error", err.Error()),
+		)
+		return fmt.Errorf("%w: %w", ErrFailedToGetTags, err)
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			r.log.Error("Failed to close blog tags rows", slog.String("error", err.Error()))
+		}
+	}()
+
+	for rows.Next() {
+		var blogID uuid.UUID
+		var name string
+		if err := rows.Scan(&blogID, &name); err != nil {
+			r.log.Error("Failed to scan blog tag row",
+				slog.String("error", err.Error()),
+			)
+			return fmt.Errorf("%w: %w", ErrFailedToScanBlogRow, err)
+		}
+		if i, ok := positions[blogID]; ok {
+			blogs[i].Tags = append(blogs[i].Tags, name)
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		r.log.Error("Error iterating blog tag rows",
+			slog.String("error", err.Error()),
+		)
+		return fmt.Errorf("%w: %w", ErrFailedToIterateRows, err)
+	}
+
+	return nil
+}
+
+// addTags links the blog to the tags, creating the tags nobody used before
+func (r *blogRepository) addTags(ctx context.Context, tx *sql.Tx, blogID uuid.UUID, tags []string) error {
+	if len(tags) == 0 {
+		return nil
+	}
+
+	now := time.Now()
+	values := make([]string, len(tags))
+	tagArgs := make([]any, 0, len(tags)*3)
+	nameArgs := make([]any, 0, len(tags)+1)
+	nameArgs = append(nameArgs, blogID)
+	for i, name := range tags {
+		values[i] = "(?, ?, ?)"
+		tagArgs = append(tagArgs, uuid.Must(uuid.NewV7()), name, now)
+		nameArgs = append(nameArgs, name)
+	}
+
+	statements := []struct {
+		query string
+		args  []any
+	}{
+		{
+			query: `INSERT INTO tags (id, name, created_at) VALUES ` + strings.Join(values, ", ") + ` ON DUPLICATE KEY UPDATE name = name`,
+			args:  tagArgs,
+		},
+		{
+			query: `INSERT INTO blog_tags (blog_id, tag_id) SELECT ?, id FROM tags WHERE name IN (` + placeholders(len(tags)) + `)`,
+			args:  nameArgs,
+		},
+	}
+	for _, statement := range statements {
+		if _, err := tx.ExecContext(ctx, statement.query, statement.args...); err != nil {
+			r.log.Error("Failed to add blog tags",
+				slog.String("error", err.Error()),
+				slog.String("blog_id", blogID.String()),
+			)
+			return fmt.Errorf("%w: %w", ErrFailedToSetTags, err)
+		}
+	}
+
+	return nil
+}
+
+// matchingTagCount returns how many of the tags a blog needs to be listed: all of them,
+// or any one
+func matchingTagCount(tags []string, matchAll bool) int {
+	if !matchAll {
+		return 1
+	}
+
+	unique := make(map[string]struct{}, len(tags))
+	for _, tag := range tags {
+		unique[tag] = struct{}{}
+	}
+	return len(unique)
+}
+
+// placeholders returns n comma separated bind parameters for an IN list
+func placeholders(n int) string {
+	return strings.TrimSuffix(strings.Repeat("?, ", n), ", ")
+}
+
+// stringsToArgs converts the strings to bind arguments
+func stringsToArgs(values []string) []any {
+	args := make([]any, len(values))
+	for i, value := range values {
+		args[i] = value
+	}
+	return args
+}
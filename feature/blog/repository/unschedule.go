@@ -0,0 +1,47 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Unschedule moves a scheduled blog back to draft and clears its scheduled time. Calls for
+// a blog that is no longer scheduled get ErrBlogNotScheduled.
+func (r *blogRepository) Unschedule(ctx context.Context, id uuid.UUID) error {
+	query := `
+		UPDATE blogs
+		SET status = ?, scheduled_at = NULL, updated_at = ?
+		WHERE id = ? AND status = ?
+	`
+
+	result, err := r.db.ExecContext(ctx, query, StatusDraft, time.Now(), id, StatusScheduled)
+	if err != nil {
+		r.log.Error("Failed to unschedule blog",
+			slog.String("error", err.Error()),
+			slog.String("blog_id", id.String()),
+		)
+		return fmt.Errorf("%w: %w", ErrFailedToUpdateBlog, err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		r.log.Error("Failed to get rows affected",
+			slog.String("error", err.Error()),
+		)
+		return fmt.Errorf("%w: %w", ErrFailedToGetRowsAffected, err)
+	}
+
+	if rowsAffected == 0 {
+		return ErrBlogNotScheduled
+	}
+
+	r.log.Info("Blog unscheduled successfully",
+		slog.String("blog_id", id.String()),
+	)
+
+	return nil
+}
@@ -0,0 +1,43 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/fikryfahrezy/let-it-go/feature/blog/repository"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnschedule(t *testing.T) {
+	authorID := setupTest(t)
+	ctx := context.Background()
+	blog := scheduleBlog(t, authorID, "Scheduled Blog", "scheduled-blog", time.Now().Add(-time.Minute))
+
+	err := testRepository.Unschedule(ctx, blog.ID)
+	require.NoError(t, err)
+
+	result, err := testRepository.GetByID(ctx, blog.ID)
+	require.NoError(t, err)
+	assert.Equal(t, repository.StatusDraft, result.Status)
+	assert.Nil(t, result.ScheduledAt)
+	assert.Nil(t, result.PublishedAt)
+
+	// It is no longer returned as due
+	due, err := testRepository.GetDueScheduled(ctx, time.Now(), 10)
+	require.NoError(t, err)
+	assert.Empty(t, due)
+}
+
+func TestUnscheduleNotScheduled(t *testing.T) {
+	authorID := setupTest(t)
+	draft := createBlogWithSlug(t, authorID, "Draft Blog", "draft-blog")
+
+	err := testRepository.Unschedule(context.Background(), draft.ID)
+	assert.Equal(t, repository.ErrBlogNotScheduled, err)
+
+	err = testRepository.Unschedule(context.Background(), uuid.New())
+	assert.Equal(t, repository.ErrBlogNotScheduled, err)
+}
@@ -0,0 +1,71 @@
+package repository_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/fikryfahrezy/let-it-go/feature/blog/repository"
+	"github.com/fikryfahrezy/let-it-go/pkg/database"
+	"github.com/fikryfahrezy/let-it-go/pkg/logger"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnscheduleUnit(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	// nolint:errcheck
+	defer sqlDB.Close()
+
+	db := &database.DB{DB: sqlDB}
+	repo := repository.NewBlogRepository(logger.NewDiscardLogger(), db)
+
+	blogID := uuid.New()
+	mock.ExpectExec("UPDATE blogs SET status = \\?, scheduled_at = NULL, updated_at = \\? WHERE id = \\? AND status = \\?").
+		WithArgs(repository.StatusDraft, sqlmock.AnyArg(), blogID, repository.StatusScheduled).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	err = repo.Unschedule(context.Background(), blogID)
+	assert.NoError(t, err)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUnscheduleNotScheduledUnit(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	// nolint:errcheck
+	defer sqlDB.Close()
+
+	db := &database.DB{DB: sqlDB}
+	repo := repository.NewBlogRepository(logger.NewDiscardLogger(), db)
+
+	mock.ExpectExec("UPDATE blogs SET status").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	err = repo.Unschedule(context.Background(), uuid.New())
+	assert.Equal(t, repository.ErrBlogNotScheduled, err)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUnscheduleErrorUnit(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	// nolint:errcheck
+	defer sqlDB.Close()
+
+	db := &database.DB{DB: sqlDB}
+	repo := repository.NewBlogRepository(logger.NewDiscardLogger(), db)
+
+	mock.ExpectExec("UPDATE blogs SET status").
+		WillReturnError(errors.New("database connection error"))
+
+	err = repo.Unschedule(context.Background(), uuid.New())
+	assert.ErrorIs(t, err, repository.ErrFailedToUpdateBlog)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -10,14 +10,14 @@ import (
 func (r *blogRepository) Update(ctx context.Context, blog Blog) error {
 	query := `
 		UPDATE blogs
-		SET title = ?, content = ?, status = ?, published_at = ?, updated_at = ?
+		SET title = ?, content = ?, status = ?, published_at = ?, scheduled_at = ?, updated_at = ?
 		WHERE id = ?
 	`
 
 	now := time.Now()
 	blog.UpdatedAt = now
 
-	result, err := r.db.ExecContext(ctx, query, blog.Title, blog.Content, blog.Status, blog.PublishedAt, now, blog.ID)
+	result, err := r.db.ExecContext(ctx, query, blog.Title, blog.Content, blog.Status, blog.PublishedAt, blog.ScheduledAt, now, blog.ID)
 	if err != nil {
 		r.log.Error("Failed to update blog",
 			slog.String("error", err.Error()),
@@ -38,7 +38,7 @@ func TestUpdateBlogUnit(t *testing.T) {
 
 	// Mock the UPDATE query - matches the actual query parameters
 	mock.ExpectExec("UPDATE blogs SET").
-		WithArgs(blog.Title, blog.Content, blog.Status, blog.PublishedAt, sqlmock.AnyArg(), blog.ID).
+		WithArgs(blog.Title, blog.Content, blog.Status, blog.PublishedAt, blog.ScheduledAt, sqlmock.AnyArg(), blog.ID).
 		WillReturnResult(sqlmock.NewResult(0, 1))
 
 	err = repo.Update(ctx, blog)
@@ -68,7 +68,7 @@ func TestUpdateBlogNotFoundUnit(t *testing.T) {
 
 	// Mock the UPDATE query to return 0 affected rows
 	mock.ExpectExec("UPDATE blogs SET").
-		WithArgs(blog.Title, blog.Content, blog.Status, blog.PublishedAt, sqlmock.AnyArg(), blog.ID).
+		WithArgs(blog.Title, blog.Content, blog.Status, blog.PublishedAt, blog.ScheduledAt, sqlmock.AnyArg(), blog.ID).
 		WillReturnResult(sqlmock.NewResult(0, 0))
 
 	err = repo.Update(ctx, blog)
@@ -8,7 +8,7 @@ import (
 )
 
 func (s *blogService) ArchiveBlog(ctx context.Context, id uuid.UUID) (GetBlogResponse, error) {
-	blog, err := s.blogRepo.GetByID(ctx, id)
+	blog, err := s.getPublishableBlog(ctx, id)
 	if err != nil {
 		return GetBlogResponse{}, err
 	}
@@ -20,5 +20,5 @@ func (s *blogService) ArchiveBlog(ctx context.Context, id uuid.UUID) (GetBlogRes
 		return GetBlogResponse{}, err
 	}
 
-	return BlogEntityToGetResponse(blog), nil
+	return s.withCommentCount(ctx, blog)
 }
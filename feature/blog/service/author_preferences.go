@@ -0,0 +1,42 @@
+package service
+
+//counterfeiter:generate -o servicefakes/fake_author_preferences.go . AuthorPreferences
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/fikryfahrezy/let-it-go/feature/blog/repository"
+	"github.com/fikryfahrezy/let-it-go/pkg/http_server"
+	"github.com/fikryfahrezy/let-it-go/pkg/rbac"
+	"github.com/google/uuid"
+)
+
+// AuthorPreferences reads the settings an author chose for their blogs.
+// It is implemented by the user service.
+type AuthorPreferences interface {
+	DefaultBlogStatus(ctx context.Context, userID uuid.UUID) (string, error)
+}
+
+// defaultStatus is the status of a new blog created without one: the author's preferred
+// status, or draft when they cannot publish or the service has no preferences to read
+func (s *blogService) defaultStatus(ctx context.Context, principal http_server.Principal) (string, error) {
+	if s.authorPreferences == nil {
+		return repository.StatusDraft, nil
+	}
+
+	status, err := s.authorPreferences.DefaultBlogStatus(ctx, principal.UserID)
+	if err != nil {
+		return "", err
+	}
+
+	if status != repository.StatusDraft && !rbac.Can(principal, rbac.PermissionBlogsPublish) {
+		s.log.Info("Preferred blog status needs the publish permission, creating a draft",
+			slog.String("author_id", principal.UserID.String()),
+			slog.String("preferred_status", status),
+		)
+		return repository.StatusDraft, nil
+	}
+
+	return status, nil
+}
@@ -0,0 +1,82 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/fikryfahrezy/let-it-go/feature/blog/repository"
+	"github.com/fikryfahrezy/let-it-go/feature/blog/repository/repositoryfakes"
+	"github.com/fikryfahrezy/let-it-go/feature/blog/service"
+	"github.com/fikryfahrezy/let-it-go/feature/blog/service/servicefakes"
+	"github.com/fikryfahrezy/let-it-go/pkg/http_server"
+	"github.com/fikryfahrezy/let-it-go/pkg/logger"
+	"github.com/fikryfahrezy/let-it-go/pkg/rbac"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBlogService_CreateBlog_PreferredStatus(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeBlogRepository{}
+	mockPreferences := &servicefakes.FakeAuthorPreferences{}
+	blogService := service.NewBlogService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{AuthorPreferences: mockPreferences}, service.Config{})
+	authorID := uuid.New()
+	ctx := http_server.ContextWithPrincipal(context.Background(), http_server.Principal{UserID: authorID, Roles: []string{rbac.RoleEditor}})
+
+	mockPreferences.DefaultBlogStatusReturns(repository.StatusPublished, nil)
+
+	result, err := blogService.CreateBlog(ctx, service.CreateBlogRequest{Title: "Test Blog", Content: "This is a test blog content"})
+
+	require.NoError(t, err)
+	assert.Equal(t, repository.StatusPublished, result.Status)
+	assert.NotNil(t, result.PublishedAt)
+
+	require.Equal(t, 1, mockPreferences.DefaultBlogStatusCallCount())
+	_, actualAuthorID := mockPreferences.DefaultBlogStatusArgsForCall(0)
+	assert.Equal(t, authorID, actualAuthorID)
+}
+
+func TestBlogService_CreateBlog_PreferredStatusWithoutPublishPermission(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeBlogRepository{}
+	mockPreferences := &servicefakes.FakeAuthorPreferences{}
+	blogService := service.NewBlogService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{AuthorPreferences: mockPreferences}, service.Config{})
+	ctx := http_server.ContextWithPrincipal(context.Background(), http_server.Principal{UserID: uuid.New(), Roles: []string{rbac.RoleAuthor}})
+
+	// The preference was saved while the user could still publish
+	mockPreferences.DefaultBlogStatusReturns(repository.StatusPublished, nil)
+
+	result, err := blogService.CreateBlog(ctx, service.CreateBlogRequest{Title: "Test Blog", Content: "This is a test blog content"})
+
+	require.NoError(t, err)
+	assert.Equal(t, repository.StatusDraft, result.Status)
+	assert.Nil(t, result.PublishedAt)
+}
+
+func TestBlogService_CreateBlog_ExplicitStatusIgnoresPreference(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeBlogRepository{}
+	mockPreferences := &servicefakes.FakeAuthorPreferences{}
+	blogService := service.NewBlogService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{AuthorPreferences: mockPreferences}, service.Config{})
+	ctx := http_server.ContextWithPrincipal(context.Background(), http_server.Principal{UserID: uuid.New(), Roles: []string{rbac.RoleEditor}})
+
+	result, err := blogService.CreateBlog(ctx, service.CreateBlogRequest{Title: "Test Blog", Content: "This is a test blog content", Status: repository.StatusDraft})
+
+	require.NoError(t, err)
+	assert.Equal(t, repository.StatusDraft, result.Status)
+	assert.Equal(t, 0, mockPreferences.DefaultBlogStatusCallCount())
+}
+
+func TestBlogService_CreateBlog_PreferencesError(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeBlogRepository{}
+	mockPreferences := &servicefakes.FakeAuthorPreferences{}
+	blogService := service.NewBlogService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{AuthorPreferences: mockPreferences}, service.Config{})
+	ctx := http_server.ContextWithPrincipal(context.Background(), http_server.Principal{UserID: uuid.New(), Roles: []string{rbac.RoleAuthor}})
+
+	preferencesError := errors.New("database connection error")
+	mockPreferences.DefaultBlogStatusReturns("", preferencesError)
+
+	_, err := blogService.CreateBlog(ctx, service.CreateBlogRequest{Title: "Test Blog", Content: "This is a test blog content"})
+
+	assert.Equal(t, preferencesError, err)
+	assert.Equal(t, 0, mockRepo.CreateCallCount())
+}
@@ -0,0 +1,37 @@
+package service
+
+//counterfeiter:generate -o servicefakes/fake_author_verifier.go . AuthorVerifier
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/google/uuid"
+)
+
+// AuthorVerifier reports whether an author confirmed their email address.
+// It is implemented by the user service.
+type AuthorVerifier interface {
+	IsEmailVerified(ctx context.Context, userID uuid.UUID) (bool, error)
+}
+
+// requireVerifiedAuthor rejects authors with an unconfirmed email when the service is configured to
+func (s *blogService) requireVerifiedAuthor(ctx context.Context, authorID uuid.UUID) error {
+	if !s.config.RequireVerifiedEmail {
+		return nil
+	}
+
+	verified, err := s.authorVerifier.IsEmailVerified(ctx, authorID)
+	if err != nil {
+		return err
+	}
+
+	if !verified {
+		s.log.Warn("Blog action denied for unverified author",
+			slog.String("author_id", authorID.String()),
+		)
+		return ErrAuthorEmailNotVerified
+	}
+
+	return nil
+}
@@ -0,0 +1,207 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/fikryfahrezy/let-it-go/feature/blog/repository"
+	"github.com/fikryfahrezy/let-it-go/feature/blog/repository/repositoryfakes"
+	"github.com/fikryfahrezy/let-it-go/feature/blog/service"
+	"github.com/fikryfahrezy/let-it-go/feature/blog/service/servicefakes"
+	"github.com/fikryfahrezy/let-it-go/pkg/http_server"
+	"github.com/fikryfahrezy/let-it-go/pkg/logger"
+	"github.com/fikryfahrezy/let-it-go/pkg/rbac"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBlogService_CreateBlog_UnverifiedAuthor(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeBlogRepository{}
+	mockVerifier := &servicefakes.FakeAuthorVerifier{}
+	blogService := service.NewBlogService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{AuthorVerifier: mockVerifier}, service.Config{RequireVerifiedEmail: true})
+	authorID := uuid.New()
+	ctx := http_server.ContextWithPrincipal(context.Background(), http_server.Principal{UserID: authorID, Roles: []string{rbac.RoleAuthor}})
+
+	mockVerifier.IsEmailVerifiedReturns(false, nil)
+
+	result, err := blogService.CreateBlog(ctx, service.CreateBlogRequest{Title: "Test Blog", Content: "Content"})
+
+	assert.Equal(t, service.ErrAuthorEmailNotVerified, err)
+	assert.Equal(t, service.GetBlogResponse{}, result)
+	assert.Equal(t, 0, mockRepo.CreateCallCount())
+
+	require.Equal(t, 1, mockVerifier.IsEmailVerifiedCallCount())
+	_, actualAuthorID := mockVerifier.IsEmailVerifiedArgsForCall(0)
+	assert.Equal(t, authorID, actualAuthorID)
+}
+
+func TestBlogService_CreateBlog_VerifiedAuthor(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeBlogRepository{}
+	mockVerifier := &servicefakes.FakeAuthorVerifier{}
+	blogService := service.NewBlogService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{AuthorVerifier: mockVerifier}, service.Config{RequireVerifiedEmail: true})
+	ctx := http_server.ContextWithPrincipal(context.Background(), http_server.Principal{UserID: uuid.New(), Roles: []string{rbac.RoleAuthor}})
+
+	mockVerifier.IsEmailVerifiedReturns(true, nil)
+
+	_, err := blogService.CreateBlog(ctx, service.CreateBlogRequest{Title: "Test Blog", Content: "Content"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, mockRepo.CreateCallCount())
+}
+
+func TestBlogService_CreateBlog_VerificationNotRequired(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeBlogRepository{}
+	mockVerifier := &servicefakes.FakeAuthorVerifier{}
+	blogService := service.NewBlogService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{AuthorVerifier: mockVerifier}, service.Config{})
+	ctx := http_server.ContextWithPrincipal(context.Background(), http_server.Principal{UserID: uuid.New(), Roles: []string{rbac.RoleAuthor}})
+
+	_, err := blogService.CreateBlog(ctx, service.CreateBlogRequest{Title: "Test Blog", Content: "Content"})
+
+	// The switch is off, so the author is not looked up at all
+	assert.NoError(t, err)
+	assert.Equal(t, 0, mockVerifier.IsEmailVerifiedCallCount())
+	assert.Equal(t, 1, mockRepo.CreateCallCount())
+}
+
+func TestBlogService_CreateBlog_VerifierError(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeBlogRepository{}
+	mockVerifier := &servicefakes.FakeAuthorVerifier{}
+	blogService := service.NewBlogService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{AuthorVerifier: mockVerifier}, service.Config{RequireVerifiedEmail: true})
+	ctx := http_server.ContextWithPrincipal(context.Background(), http_server.Principal{UserID: uuid.New(), Roles: []string{rbac.RoleAuthor}})
+
+	lookupError := errors.New("database connection error")
+	mockVerifier.IsEmailVerifiedReturns(false, lookupError)
+
+	_, err := blogService.CreateBlog(ctx, service.CreateBlogRequest{Title: "Test Blog", Content: "Content"})
+
+	assert.Equal(t, lookupError, err)
+	assert.Equal(t, 0, mockRepo.CreateCallCount())
+}
+
+func TestBlogService_PublishBlog_UnverifiedAuthor(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeBlogRepository{}
+	mockVerifier := &servicefakes.FakeAuthorVerifier{}
+	blogService := service.NewBlogService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{AuthorVerifier: mockVerifier}, service.Config{RequireVerifiedEmail: true})
+	authorID := uuid.New()
+	ctx := http_server.ContextWithPrincipal(context.Background(), http_server.Principal{UserID: authorID, Roles: []string{rbac.RoleEditor}})
+
+	blogID := uuid.New()
+	mockRepo.GetByIDReturns(repository.Blog{ID: blogID, AuthorID: authorID, Status: repository.StatusDraft}, nil)
+	mockVerifier.IsEmailVerifiedReturns(false, nil)
+
+	_, err := blogService.PublishBlog(ctx, blogID)
+
+	assert.Equal(t, service.ErrAuthorEmailNotVerified, err)
+	assert.Equal(t, 0, mockRepo.UpdateCallCount())
+}
+
+func TestBlogService_PublishBlog_VerifiedAuthor(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeBlogRepository{}
+	mockVerifier := &servicefakes.FakeAuthorVerifier{}
+	blogService := service.NewBlogService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{AuthorVerifier: mockVerifier}, service.Config{RequireVerifiedEmail: true})
+	authorID := uuid.New()
+	ctx := http_server.ContextWithPrincipal(context.Background(), http_server.Principal{UserID: authorID, Roles: []string{rbac.RoleEditor}})
+
+	blogID := uuid.New()
+	mockRepo.GetByIDReturns(repository.Blog{ID: blogID, AuthorID: authorID, Status: repository.StatusDraft}, nil)
+	mockVerifier.IsEmailVerifiedReturns(true, nil)
+
+	result, err := blogService.PublishBlog(ctx, blogID)
+
+	assert.NoError(t, err)
+	assert.Equal(t, repository.StatusPublished, result.Status)
+	assert.Equal(t, 1, mockRepo.UpdateCallCount())
+}
+
+func TestBlogService_PublishBlog_DueScheduledUnverifiedAuthor(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeBlogRepository{}
+	mockVerifier := &servicefakes.FakeAuthorVerifier{}
+	blogService := service.NewBlogService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{AuthorVerifier: mockVerifier}, service.Config{RequireVerifiedEmail: true})
+
+	blogID := uuid.New()
+	scheduledAt := time.Now().Add(-time.Minute)
+	mockRepo.GetByIDReturns(repository.Blog{ID: blogID, AuthorID: uuid.New(), Status: repository.StatusScheduled, ScheduledAt: &scheduledAt}, nil)
+	mockVerifier.IsEmailVerifiedReturns(false, nil)
+
+	_, err := blogService.PublishBlog(service.ContextForScheduledPublishing(context.Background()), blogID)
+
+	// The blog goes back to draft so it stops holding up newer due blogs
+	assert.Equal(t, service.ErrAuthorEmailNotVerified, err)
+	assert.Equal(t, 0, mockRepo.PublishScheduledCallCount())
+	require.Equal(t, 1, mockRepo.UnscheduleCallCount())
+	_, actualID := mockRepo.UnscheduleArgsForCall(0)
+	assert.Equal(t, blogID, actualID)
+}
+
+func TestBlogService_PublishBlog_EditorScheduledUnverifiedAuthor(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeBlogRepository{}
+	mockVerifier := &servicefakes.FakeAuthorVerifier{}
+	blogService := service.NewBlogService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{AuthorVerifier: mockVerifier}, service.Config{RequireVerifiedEmail: true})
+	ctx := http_server.ContextWithPrincipal(context.Background(), http_server.Principal{UserID: uuid.New(), Roles: []string{rbac.RoleEditor}})
+
+	scheduledAt := time.Now().Add(time.Hour)
+	mockRepo.GetByIDReturns(repository.Blog{ID: uuid.New(), AuthorID: uuid.New(), Status: repository.StatusScheduled, ScheduledAt: &scheduledAt}, nil)
+	mockVerifier.IsEmailVerifiedReturns(false, nil)
+
+	_, err := blogService.PublishBlog(ctx, uuid.New())
+
+	// Only the scheduled publishing job gives up on a blog, an editor's attempt leaves it scheduled
+	assert.Equal(t, service.ErrAuthorEmailNotVerified, err)
+	assert.Equal(t, 0, mockRepo.UnscheduleCallCount())
+}
+
+func TestBlogService_PublishBlog_DueScheduledVerifierError(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeBlogRepository{}
+	mockVerifier := &servicefakes.FakeAuthorVerifier{}
+	blogService := service.NewBlogService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{AuthorVerifier: mockVerifier}, service.Config{RequireVerifiedEmail: true})
+
+	scheduledAt := time.Now().Add(-time.Minute)
+	mockRepo.GetByIDReturns(repository.Blog{ID: uuid.New(), AuthorID: uuid.New(), Status: repository.StatusScheduled, ScheduledAt: &scheduledAt}, nil)
+	lookupError := errors.New("database connection error")
+	mockVerifier.IsEmailVerifiedReturns(false, lookupError)
+
+	_, err := blogService.PublishBlog(service.ContextForScheduledPublishing(context.Background()), uuid.New())
+
+	// A failed lookup is retried on the next run
+	assert.Equal(t, lookupError, err)
+	assert.Equal(t, 0, mockRepo.UnscheduleCallCount())
+}
+
+func TestBlogService_UpdateBlog_PublishUnverifiedAuthor(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeBlogRepository{}
+	mockVerifier := &servicefakes.FakeAuthorVerifier{}
+	blogService := service.NewBlogService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{AuthorVerifier: mockVerifier}, service.Config{RequireVerifiedEmail: true})
+	ctx := http_server.ContextWithPrincipal(context.Background(), http_server.Principal{UserID: uuid.New(), Roles: []string{rbac.RoleAdmin}})
+
+	authorID := uuid.New()
+	mockRepo.GetByIDReturns(repository.Blog{ID: uuid.New(), Title: "Draft", AuthorID: authorID, Status: repository.StatusDraft}, nil)
+	mockVerifier.IsEmailVerifiedReturns(false, nil)
+
+	_, err := blogService.UpdateBlog(ctx, uuid.New(), service.UpdateBlogRequest{Status: repository.StatusPublished})
+
+	assert.Equal(t, service.ErrAuthorEmailNotVerified, err)
+	assert.Equal(t, 0, mockRepo.UpdateCallCount())
+	require.Equal(t, 1, mockVerifier.IsEmailVerifiedCallCount())
+	_, actualAuthorID := mockVerifier.IsEmailVerifiedArgsForCall(0)
+	assert.Equal(t, authorID, actualAuthorID)
+}
+
+func TestBlogService_UpdateBlog_ArchiveUnverifiedAuthor(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeBlogRepository{}
+	mockVerifier := &servicefakes.FakeAuthorVerifier{}
+	blogService := service.NewBlogService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{AuthorVerifier: mockVerifier}, service.Config{RequireVerifiedEmail: true})
+	ctx := http_server.ContextWithPrincipal(context.Background(), http_server.Principal{UserID: uuid.New(), Roles: []string{rbac.RoleAdmin}})
+
+	mockRepo.GetByIDReturns(repository.Blog{ID: uuid.New(), Title: "Published", AuthorID: uuid.New(), Status: repository.StatusPublished}, nil)
+	mockVerifier.IsEmailVerifiedReturns(false, nil)
+
+	// Taking a blog down does not need a verified author
+	_, err := blogService.UpdateBlog(ctx, uuid.New(), service.UpdateBlogRequest{Status: repository.StatusArchived})
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, mockRepo.UpdateCallCount())
+	assert.Equal(t, 0, mockVerifier.IsEmailVerifiedCallCount())
+}
@@ -0,0 +1,50 @@
+package service
+
+//counterfeiter:generate -o servicefakes/fake_comment_counter.go . CommentCounter
+
+import (
+	"context"
+
+	"github.com/fikryfahrezy/let-it-go/feature/blog/repository"
+	"github.com/google/uuid"
+)
+
+// CommentCounter counts the comments shown on blogs.
+// It is implemented by the comment repository.
+type CommentCounter interface {
+	CountApprovedByBlogIDs(ctx context.Context, blogIDs []uuid.UUID) (map[uuid.UUID]int64, error)
+}
+
+// withCommentCounts converts the blogs to responses carrying the number of approved comments
+// of each, counted with a single query. Counts stay zero when the service has no counter.
+func (s *blogService) withCommentCounts(ctx context.Context, blogs []repository.Blog) ([]GetBlogResponse, error) {
+	responses := BlogEntitiesToGetResponses(blogs)
+	if s.commentCounter == nil || len(blogs) == 0 {
+		return responses, nil
+	}
+
+	blogIDs := make([]uuid.UUID, len(blogs))
+	for i, blog := range blogs {
+		blogIDs[i] = blog.ID
+	}
+
+	counts, err := s.commentCounter.CountApprovedByBlogIDs(ctx, blogIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range responses {
+		responses[i].CommentCount = counts[responses[i].ID]
+	}
+
+	return responses, nil
+}
+
+// withCommentCount converts the blog to a response carrying its number of approved comments
+func (s *blogService) withCommentCount(ctx context.Context, blog repository.Blog) (GetBlogResponse, error) {
+	responses, err := s.withCommentCounts(ctx, []repository.Blog{blog})
+	if err != nil {
+		return GetBlogResponse{}, err
+	}
+	return responses[0], nil
+}
@@ -0,0 +1,88 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/fikryfahrezy/let-it-go/feature/blog/repository"
+	"github.com/fikryfahrezy/let-it-go/feature/blog/repository/repositoryfakes"
+	"github.com/fikryfahrezy/let-it-go/feature/blog/service"
+	"github.com/fikryfahrezy/let-it-go/feature/blog/service/servicefakes"
+	"github.com/fikryfahrezy/let-it-go/pkg/http_server"
+	"github.com/fikryfahrezy/let-it-go/pkg/logger"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBlogService_GetBlogByID_CommentCount(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeBlogRepository{}
+	mockCounter := &servicefakes.FakeCommentCounter{}
+	blogService := service.NewBlogService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{CommentCounter: mockCounter}, service.Config{})
+
+	blog := repository.Blog{ID: uuid.New(), Title: "Test Blog", Status: repository.StatusPublished, CommentsClosed: true}
+	mockRepo.GetByIDReturns(blog, nil)
+	mockCounter.CountApprovedByBlogIDsReturns(map[uuid.UUID]int64{blog.ID: 3}, nil)
+
+	result, err := blogService.GetBlogByID(context.Background(), blog.ID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), result.CommentCount)
+	assert.True(t, result.CommentsClosed)
+
+	_, blogIDs := mockCounter.CountApprovedByBlogIDsArgsForCall(0)
+	assert.Equal(t, []uuid.UUID{blog.ID}, blogIDs)
+}
+
+func TestBlogService_ListBlogs_CommentCounts(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeBlogRepository{}
+	mockCounter := &servicefakes.FakeCommentCounter{}
+	blogService := service.NewBlogService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{CommentCounter: mockCounter}, service.Config{})
+
+	commented := repository.Blog{ID: uuid.New(), Title: "Commented"}
+	uncommented := repository.Blog{ID: uuid.New(), Title: "Uncommented"}
+	mockRepo.ListReturns([]repository.Blog{commented, uncommented}, nil)
+	mockRepo.CountReturns(2, nil)
+	mockCounter.CountApprovedByBlogIDsReturns(map[uuid.UUID]int64{commented.ID: 5, uncommented.ID: 0}, nil)
+
+	result, _, err := blogService.ListBlogs(context.Background(), service.ListBlogsRequest{
+		PaginationRequest: http_server.PaginationRequest{Page: 1, PageSize: 10},
+	})
+	require.NoError(t, err)
+	require.Len(t, result, 2)
+	assert.Equal(t, int64(5), result[0].CommentCount)
+	assert.Equal(t, int64(0), result[1].CommentCount)
+
+	// Every blog of the page is counted with one call
+	require.Equal(t, 1, mockCounter.CountApprovedByBlogIDsCallCount())
+	_, blogIDs := mockCounter.CountApprovedByBlogIDsArgsForCall(0)
+	assert.Equal(t, []uuid.UUID{commented.ID, uncommented.ID}, blogIDs)
+}
+
+func TestBlogService_ListBlogs_NoBlogsNotCounted(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeBlogRepository{}
+	mockCounter := &servicefakes.FakeCommentCounter{}
+	blogService := service.NewBlogService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{CommentCounter: mockCounter}, service.Config{})
+
+	result, _, err := blogService.ListBlogs(context.Background(), service.ListBlogsRequest{
+		PaginationRequest: http_server.PaginationRequest{Page: 1, PageSize: 10},
+	})
+
+	require.NoError(t, err)
+	assert.Empty(t, result)
+	assert.Equal(t, 0, mockCounter.CountApprovedByBlogIDsCallCount())
+}
+
+func TestBlogService_GetBlogByID_CommentCountError(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeBlogRepository{}
+	mockCounter := &servicefakes.FakeCommentCounter{}
+	blogService := service.NewBlogService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{CommentCounter: mockCounter}, service.Config{})
+
+	mockRepo.GetByIDReturns(repository.Blog{ID: uuid.New()}, nil)
+	countError := errors.New("database connection error")
+	mockCounter.CountApprovedByBlogIDsReturns(nil, countError)
+
+	_, err := blogService.GetBlogByID(context.Background(), uuid.New())
+
+	assert.Equal(t, countError, err)
+}
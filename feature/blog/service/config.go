@@ -0,0 +1,8 @@
+package service
+
+// Config holds the settings of the blog service
+type Config struct {
+	// RequireVerifiedEmail blocks authors who have not confirmed their email address
+	// from creating or publishing blogs
+	RequireVerifiedEmail bool
+}
@@ -4,16 +4,41 @@ import (
 	"context"
 
 	"github.com/fikryfahrezy/let-it-go/feature/blog/repository"
+	"github.com/fikryfahrezy/let-it-go/pkg/rbac"
 )
 
 func (s *blogService) CreateBlog(ctx context.Context, req CreateBlogRequest) (GetBlogResponse, error) {
+	principal, err := callerWith(ctx, rbac.PermissionBlogsWrite)
+	if err != nil {
+		return GetBlogResponse{}, err
+	}
+	authorID := principal.UserID
+	req.AuthorID = authorID
+
+	// Creating a blog in any other status than draft publishes or archives it right away
+	if req.Status != "" && req.Status != repository.StatusDraft && !rbac.Can(principal, rbac.PermissionBlogsPublish) {
+		return GetBlogResponse{}, ErrBlogForbidden
+	}
+
+	if err := s.requireVerifiedAuthor(ctx, authorID); err != nil {
+		return GetBlogResponse{}, err
+	}
+
 	if req.Status == "" {
-		req.Status = repository.StatusDraft
+		req.Status, err = s.defaultStatus(ctx, principal)
+		if err != nil {
+			return GetBlogResponse{}, err
+		}
 	}
 
 	blog := req.ToEntity()
 
-	if err := s.blogRepo.Create(ctx, blog); err != nil {
+	// Blogs with the same title get a suffixed slug
+	blog.Slug, err = withUniqueSlug(blog.Slug, func(candidate string) error {
+		blog.Slug = candidate
+		return s.blogRepo.Create(ctx, blog)
+	})
+	if err != nil {
 		return GetBlogResponse{}, err
 	}
 
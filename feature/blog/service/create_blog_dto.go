@@ -4,22 +4,26 @@ import (
 	"time"
 
 	"github.com/fikryfahrezy/let-it-go/feature/blog/repository"
+	"github.com/fikryfahrezy/let-it-go/pkg/slug"
 	"github.com/google/uuid"
 )
 
 type CreateBlogRequest struct {
 	Title    string    `json:"title" validate:"required,min=3,max=200"`
 	Content  string    `json:"content" validate:"required,min=10"`
-	AuthorID uuid.UUID `json:"author_id" validate:"required"`
-	Status   string    `json:"status" validate:"required,oneof=draft published archived"`
+	AuthorID uuid.UUID `json:"-"`                                                          // Set from the authenticated caller
+	Status   string    `json:"status" validate:"omitempty,oneof=draft published archived"` // Defaults to the author's preferred status
+	Tags     []string  `json:"tags,omitempty" validate:"omitempty,max=10,dive,max=50,slug"`
 }
 
 func (req CreateBlogRequest) ToEntity() repository.Blog {
 	blog := repository.Blog{
 		Title:    req.Title,
+		Slug:     slug.Make(req.Title),
 		Content:  req.Content,
 		AuthorID: req.AuthorID,
 		Status:   req.Status,
+		Tags:     uniqueTags(req.Tags),
 	}
 
 	// Set published_at if status is published
@@ -7,6 +7,10 @@ import (
 )
 
 func (s *blogService) DeleteBlog(ctx context.Context, id uuid.UUID) error {
+	if _, err := s.getEditableBlog(ctx, id); err != nil {
+		return err
+	}
+
 	if err := s.blogRepo.Delete(ctx, id); err != nil {
 		return err
 	}
@@ -7,17 +7,22 @@ import (
 	"github.com/fikryfahrezy/let-it-go/feature/blog/repository"
 	"github.com/fikryfahrezy/let-it-go/feature/blog/repository/repositoryfakes"
 	"github.com/fikryfahrezy/let-it-go/feature/blog/service"
+	"github.com/fikryfahrezy/let-it-go/pkg/http_server"
 	"github.com/fikryfahrezy/let-it-go/pkg/logger"
+	"github.com/fikryfahrezy/let-it-go/pkg/rbac"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 )
 
 func TestBlogService_DeleteBlog_Success(t *testing.T) {
 	mockRepo := &repositoryfakes.FakeBlogRepository{}
-	blogService := service.NewBlogService(logger.NewDiscardLogger(), mockRepo)
-	ctx := context.Background()
+	blogService := service.NewBlogService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{}, service.Config{})
 
 	blogID := uuid.New()
+	authorID := uuid.New()
+	ctx := http_server.ContextWithPrincipal(context.Background(), http_server.Principal{UserID: authorID, Roles: []string{rbac.RoleAuthor}})
+
+	mockRepo.GetByIDReturns(repository.Blog{ID: blogID, AuthorID: authorID, Status: repository.StatusDraft}, nil)
 	mockRepo.DeleteReturns(nil)
 
 	err := blogService.DeleteBlog(ctx, blogID)
@@ -25,6 +30,7 @@ func TestBlogService_DeleteBlog_Success(t *testing.T) {
 	assert.NoError(t, err)
 
 	// Verify repository calls
+	assert.Equal(t, 1, mockRepo.GetByIDCallCount())
 	assert.Equal(t, 1, mockRepo.DeleteCallCount())
 	_, actualID := mockRepo.DeleteArgsForCall(0)
 	assert.Equal(t, blogID, actualID)
@@ -32,17 +38,35 @@ func TestBlogService_DeleteBlog_Success(t *testing.T) {
 
 func TestBlogService_DeleteBlog_NotFound(t *testing.T) {
 	mockRepo := &repositoryfakes.FakeBlogRepository{}
-	blogService := service.NewBlogService(logger.NewDiscardLogger(), mockRepo)
-	ctx := context.Background()
+	blogService := service.NewBlogService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{}, service.Config{})
+	ctx := http_server.ContextWithPrincipal(context.Background(), http_server.Principal{UserID: uuid.New(), Roles: []string{rbac.RoleAuthor}})
 
 	blogID := uuid.New()
-	mockRepo.DeleteReturns(repository.ErrBlogNotFound)
+	mockRepo.GetByIDReturns(repository.Blog{}, repository.ErrBlogNotFound)
 
 	err := blogService.DeleteBlog(ctx, blogID)
 
 	assert.Error(t, err)
 	assert.Equal(t, repository.ErrBlogNotFound, err)
 
-	// Verify repository calls
-	assert.Equal(t, 1, mockRepo.DeleteCallCount())
+	// Delete should not be called for a missing blog
+	assert.Equal(t, 1, mockRepo.GetByIDCallCount())
+	assert.Equal(t, 0, mockRepo.DeleteCallCount())
+}
+
+func TestBlogService_DeleteBlog_NotOwner(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeBlogRepository{}
+	blogService := service.NewBlogService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{}, service.Config{})
+	ctx := http_server.ContextWithPrincipal(context.Background(), http_server.Principal{UserID: uuid.New(), Roles: []string{rbac.RoleAuthor}})
+
+	blogID := uuid.New()
+	mockRepo.GetByIDReturns(repository.Blog{ID: blogID, AuthorID: uuid.New()}, nil)
+
+	err := blogService.DeleteBlog(ctx, blogID)
+
+	assert.Error(t, err)
+	assert.Equal(t, service.ErrBlogForbidden, err)
+
+	// Delete should not be called for non-owners
+	assert.Equal(t, 0, mockRepo.DeleteCallCount())
 }
@@ -8,6 +8,12 @@ var (
 	ErrInvalidBlogStatus    = app_error.New("BLOG-INVALID_BLOG_STATUS", "invalid blog status")
 	ErrBlogAlreadyPublished = app_error.New("BLOG-BLOG_ALREADY_PUBLISHED", "blog is already published")
 	ErrBlogAlreadyArchived  = app_error.New("BLOG-BLOG_ALREADY_ARCHIVED", "blog is already archived")
+	ErrScheduleNotInFuture  = app_error.New("BLOG-SCHEDULE_NOT_IN_FUTURE", "blogs can only be scheduled in the future")
+
+	// Authorization errors
+	ErrBlogForbidden          = app_error.New("BLOG-FORBIDDEN", "you are not allowed to modify this blog")
+	ErrBlogNotDraft           = app_error.New("BLOG-NOT_DRAFT", "only drafts can be changed by their author")
+	ErrAuthorEmailNotVerified = app_error.New("BLOG-AUTHOR_EMAIL_NOT_VERIFIED", "verify your email address before creating or publishing blogs")
 
 	// Service-specific operation errors
 	ErrFailedToPublishBlog = app_error.New("BLOG-FAILED_TO_PUBLISH_BLOG", "failed to publish blog")
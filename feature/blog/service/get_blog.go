@@ -2,6 +2,9 @@ package service
 
 import (
 	"context"
+	"errors"
+
+	"github.com/fikryfahrezy/let-it-go/feature/blog/repository"
 
 	"github.com/google/uuid"
 )
@@ -12,5 +15,19 @@ func (s *blogService) GetBlogByID(ctx context.Context, id uuid.UUID) (GetBlogRes
 		return GetBlogResponse{}, err
 	}
 
-	return BlogEntityToGetResponse(blog), nil
+	return s.withCommentCount(ctx, blog)
+}
+
+// GetBlogBySlug finds a blog by its current slug, or by a slug it had before its title
+// changed. Callers compare the returned slug to tell the two apart.
+func (s *blogService) GetBlogBySlug(ctx context.Context, slug string) (GetBlogResponse, error) {
+	blog, err := s.blogRepo.GetBySlug(ctx, slug)
+	if errors.Is(err, repository.ErrBlogNotFound) {
+		blog, err = s.blogRepo.GetByPreviousSlug(ctx, slug)
+	}
+	if err != nil {
+		return GetBlogResponse{}, err
+	}
+
+	return s.withCommentCount(ctx, blog)
 }
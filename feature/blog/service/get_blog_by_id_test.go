@@ -15,7 +15,7 @@ import (
 
 func TestBlogService_GetBlogByID_Success(t *testing.T) {
 	mockRepo := &repositoryfakes.FakeBlogRepository{}
-	blogService := service.NewBlogService(logger.NewDiscardLogger(), mockRepo)
+	blogService := service.NewBlogService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{}, service.Config{})
 	ctx := context.Background()
 
 	blogID := uuid.New()
@@ -51,7 +51,7 @@ func TestBlogService_GetBlogByID_Success(t *testing.T) {
 
 func TestBlogService_GetBlogByID_NotFound(t *testing.T) {
 	mockRepo := &repositoryfakes.FakeBlogRepository{}
-	blogService := service.NewBlogService(logger.NewDiscardLogger(), mockRepo)
+	blogService := service.NewBlogService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{}, service.Config{})
 	ctx := context.Background()
 
 	blogID := uuid.New()
@@ -66,3 +66,52 @@ func TestBlogService_GetBlogByID_NotFound(t *testing.T) {
 	// Verify repository calls
 	assert.Equal(t, 1, mockRepo.GetByIDCallCount())
 }
+
+func TestBlogService_GetBlogBySlug_Success(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeBlogRepository{}
+	blogService := service.NewBlogService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{}, service.Config{})
+	ctx := context.Background()
+
+	expectedBlog := repository.Blog{ID: uuid.New(), Title: "Test Blog", Slug: "test-blog", Status: "published"}
+	mockRepo.GetBySlugReturns(expectedBlog, nil)
+
+	result, err := blogService.GetBlogBySlug(ctx, "test-blog")
+
+	assert.NoError(t, err)
+	assert.Equal(t, expectedBlog.ID, result.ID)
+	assert.Equal(t, "test-blog", result.Slug)
+	assert.Equal(t, 0, mockRepo.GetByPreviousSlugCallCount())
+}
+
+func TestBlogService_GetBlogBySlug_PreviousSlug(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeBlogRepository{}
+	blogService := service.NewBlogService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{}, service.Config{})
+	ctx := context.Background()
+
+	expectedBlog := repository.Blog{ID: uuid.New(), Title: "Renamed Blog", Slug: "renamed-blog", Status: "published"}
+	mockRepo.GetBySlugReturns(repository.Blog{}, repository.ErrBlogNotFound)
+	mockRepo.GetByPreviousSlugReturns(expectedBlog, nil)
+
+	result, err := blogService.GetBlogBySlug(ctx, "test-blog")
+
+	// The blog comes back with its current slug
+	assert.NoError(t, err)
+	assert.Equal(t, expectedBlog.ID, result.ID)
+	assert.Equal(t, "renamed-blog", result.Slug)
+	_, actualSlug := mockRepo.GetByPreviousSlugArgsForCall(0)
+	assert.Equal(t, "test-blog", actualSlug)
+}
+
+func TestBlogService_GetBlogBySlug_NotFound(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeBlogRepository{}
+	blogService := service.NewBlogService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{}, service.Config{})
+	ctx := context.Background()
+
+	mockRepo.GetBySlugReturns(repository.Blog{}, repository.ErrBlogNotFound)
+	mockRepo.GetByPreviousSlugReturns(repository.Blog{}, repository.ErrBlogNotFound)
+
+	result, err := blogService.GetBlogBySlug(ctx, "missing-blog")
+
+	assert.Equal(t, repository.ErrBlogNotFound, err)
+	assert.Equal(t, service.GetBlogResponse{}, result)
+}
@@ -8,26 +8,35 @@ import (
 )
 
 type GetBlogResponse struct {
-	ID          uuid.UUID  `json:"id"`
-	Title       string     `json:"title"`
-	Content     string     `json:"content"`
-	AuthorID    uuid.UUID  `json:"author_id"`
-	Status      string     `json:"status"`
-	PublishedAt *time.Time `json:"published_at,omitempty"`
-	CreatedAt   time.Time  `json:"created_at"`
-	UpdatedAt   time.Time  `json:"updated_at"`
+	ID             uuid.UUID  `json:"id"`
+	Title          string     `json:"title"`
+	Slug           string     `json:"slug"`
+	Content        string     `json:"content"`
+	AuthorID       uuid.UUID  `json:"author_id"`
+	Status         string     `json:"status"`
+	PublishedAt    *time.Time `json:"published_at,omitempty"`
+	ScheduledAt    *time.Time `json:"scheduled_at,omitempty"` // When a scheduled blog gets published
+	CreatedAt      time.Time  `json:"created_at"`
+	UpdatedAt      time.Time  `json:"updated_at"`
+	Tags           []string   `json:"tags"`
+	CommentsClosed bool       `json:"comments_closed"`
+	CommentCount   int64      `json:"comment_count"` // Approved comments that were not deleted
 }
 
 func BlogEntityToGetResponse(blog repository.Blog) GetBlogResponse {
 	return GetBlogResponse{
-		ID:          blog.ID,
-		Title:       blog.Title,
-		Content:     blog.Content,
-		AuthorID:    blog.AuthorID,
-		Status:      blog.Status,
-		PublishedAt: blog.PublishedAt,
-		CreatedAt:   blog.CreatedAt,
-		UpdatedAt:   blog.UpdatedAt,
+		ID:             blog.ID,
+		Title:          blog.Title,
+		Slug:           blog.Slug,
+		Content:        blog.Content,
+		AuthorID:       blog.AuthorID,
+		Status:         blog.Status,
+		PublishedAt:    blog.PublishedAt,
+		ScheduledAt:    blog.ScheduledAt,
+		CreatedAt:      blog.CreatedAt,
+		UpdatedAt:      blog.UpdatedAt,
+		Tags:           blog.Tags,
+		CommentsClosed: blog.CommentsClosed,
 	}
 }
 
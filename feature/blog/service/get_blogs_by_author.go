@@ -19,5 +19,10 @@ func (s *blogService) GetBlogsByAuthor(ctx context.Context, authorID uuid.UUID,
 		return nil, 0, err
 	}
 
-	return BlogEntitiesToGetResponses(blogs), totalItems, nil
+	responses, err := s.withCommentCounts(ctx, blogs)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return responses, totalItems, nil
 }
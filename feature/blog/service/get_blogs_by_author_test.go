@@ -16,7 +16,7 @@ import (
 
 func TestBlogService_GetBlogsByAuthor_Success(t *testing.T) {
 	mockRepo := &repositoryfakes.FakeBlogRepository{}
-	blogService := service.NewBlogService(logger.NewDiscardLogger(), mockRepo)
+	blogService := service.NewBlogService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{}, service.Config{})
 	ctx := context.Background()
 
 	authorID := uuid.New()
@@ -17,5 +17,10 @@ func (s *blogService) GetBlogsByStatus(ctx context.Context, status string, req G
 		return nil, 0, err
 	}
 
-	return BlogEntitiesToGetResponses(blogs), totalItems, nil
+	responses, err := s.withCommentCounts(ctx, blogs)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return responses, totalItems, nil
 }
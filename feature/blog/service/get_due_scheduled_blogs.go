@@ -0,0 +1,20 @@
+package service
+
+import (
+	"context"
+	"time"
+)
+
+// dueScheduledBatchSize bounds how many scheduled blogs GetDueScheduledBlogs returns at once,
+// the rest are returned once these are published
+const dueScheduledBatchSize = 100
+
+// GetDueScheduledBlogs returns the scheduled blogs to publish at now, the longest overdue first
+func (s *blogService) GetDueScheduledBlogs(ctx context.Context, now time.Time) ([]GetBlogResponse, error) {
+	blogs, err := s.blogRepo.GetDueScheduled(ctx, now, dueScheduledBatchSize)
+	if err != nil {
+		return nil, err
+	}
+
+	return BlogEntitiesToGetResponses(blogs), nil
+}
@@ -0,0 +1,158 @@
+package service
+
+import (
+	"html"
+	"strings"
+	"unicode"
+)
+
+const (
+	// snippetLength is how many characters of the content a search snippet shows
+	snippetLength = 200
+	// snippetLead is how many characters of the content a snippet shows before the first match
+	snippetLead = 60
+)
+
+// searchTerm is a word of a search query. Prefix terms, written word* in boolean mode,
+// match every word starting with them.
+type searchTerm struct {
+	word   string
+	prefix bool
+}
+
+func (t searchTerm) matches(word string) bool {
+	if t.prefix {
+		return strings.HasPrefix(word, t.word)
+	}
+	return word == t.word
+}
+
+// searchTerms returns the words of the query to highlight. In boolean mode the operators
+// are skipped, and so are the words or phrases the query excludes with -.
+func searchTerms(query string, booleanMode bool) []searchTerm {
+	var terms []searchTerm
+	seen := make(map[searchTerm]struct{})
+	excluded, inPhrase := false, false
+
+	runes := []rune(query)
+	for i := 0; i < len(runes); {
+		if !isWordRune(runes[i]) {
+			if booleanMode {
+				switch runes[i] {
+				case '-':
+					excluded = excluded || !inPhrase
+				case '"':
+					inPhrase = !inPhrase
+					excluded = excluded && inPhrase
+				}
+			}
+			i++
+			continue
+		}
+
+		end := i
+		for end < len(runes) && isWordRune(runes[end]) {
+			end++
+		}
+		term := searchTerm{
+			word:   strings.ToLower(string(runes[i:end])),
+			prefix: booleanMode && end < len(runes) && runes[end] == '*',
+		}
+		if _, ok := seen[term]; !ok && !excluded {
+			seen[term] = struct{}{}
+			terms = append(terms, term)
+		}
+		excluded = excluded && inPhrase
+		i = end
+	}
+
+	return terms
+}
+
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_'
+}
+
+// wordSpan is where a word starts and ends in a text, counted in runes
+type wordSpan struct {
+	start, end int
+}
+
+// matchingWords returns the words of the text matching any of the terms
+func matchingWords(text []rune, terms []searchTerm) []wordSpan {
+	var spans []wordSpan
+	for i := 0; i < len(text); {
+		if !isWordRune(text[i]) {
+			i++
+			continue
+		}
+
+		end := i
+		for end < len(text) && isWordRune(text[end]) {
+			end++
+		}
+		word := strings.ToLower(string(text[i:end]))
+		for _, term := range terms {
+			if term.matches(word) {
+				spans = append(spans, wordSpan{start: i, end: end})
+				break
+			}
+		}
+		i = end
+	}
+	return spans
+}
+
+// highlight escapes the text for HTML and wraps the words matching the terms in <mark> tags
+func highlight(text string, terms []searchTerm) string {
+	return highlightRunes([]rune(text), terms)
+}
+
+func highlightRunes(text []rune, terms []searchTerm) string {
+	var b strings.Builder
+	last := 0
+	for _, span := range matchingWords(text, terms) {
+		b.WriteString(html.EscapeString(string(text[last:span.start])))
+		b.WriteString("<mark>")
+		b.WriteString(html.EscapeString(string(text[span.start:span.end])))
+		b.WriteString("</mark>")
+		last = span.end
+	}
+	b.WriteString(html.EscapeString(string(text[last:])))
+	return b.String()
+}
+
+// snippet returns a highlighted excerpt of the content starting a little before the first
+// matching word, or its beginning when only the title matches. Words are never cut and
+// an ellipsis marks the content left out.
+func snippet(content string, terms []searchTerm) string {
+	text := []rune(strings.Join(strings.Fields(content), " "))
+
+	start := 0
+	if spans := matchingWords(text, terms); len(spans) > 0 {
+		start = max(spans[0].start-snippetLead, 0)
+		for start > 0 && text[start-1] != ' ' {
+			start--
+		}
+	}
+
+	end := min(start+snippetLength, len(text))
+	if end < len(text) {
+		cut := end
+		for cut > start && text[cut] != ' ' {
+			cut--
+		}
+		if cut > start {
+			end = cut
+		}
+	}
+
+	excerpt := highlightRunes(text[start:end], terms)
+	if start > 0 {
+		excerpt = "…" + excerpt
+	}
+	if end < len(text) {
+		excerpt += "…"
+	}
+	return excerpt
+}
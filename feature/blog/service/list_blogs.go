@@ -7,6 +7,10 @@ import (
 func (s *blogService) ListBlogs(ctx context.Context, req ListBlogsRequest) ([]GetBlogResponse, int64, error) {
 	offset := (req.Page - 1) * req.PageSize
 
+	if len(req.Tags) > 0 {
+		return s.listBlogsByTags(ctx, req, offset)
+	}
+
 	blogs, err := s.blogRepo.List(ctx, req.PageSize, offset)
 	if err != nil {
 		return nil, 0, err
@@ -17,5 +21,32 @@ func (s *blogService) ListBlogs(ctx context.Context, req ListBlogsRequest) ([]Ge
 		return nil, 0, err
 	}
 
-	return BlogEntitiesToGetResponses(blogs), totalItems, nil
+	responses, err := s.withCommentCounts(ctx, blogs)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return responses, totalItems, nil
+}
+
+func (s *blogService) listBlogsByTags(ctx context.Context, req ListBlogsRequest, offset int) ([]GetBlogResponse, int64, error) {
+	// A repeated tag would count twice towards the tags a blog must have with match=all
+	tags := uniqueTags(req.Tags)
+
+	blogs, err := s.blogRepo.ListByTags(ctx, tags, req.MatchAll, req.PageSize, offset)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	totalItems, err := s.blogRepo.CountByTags(ctx, tags, req.MatchAll)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	responses, err := s.withCommentCounts(ctx, blogs)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return responses, totalItems, nil
 }
@@ -2,9 +2,12 @@ package service
 
 import "github.com/fikryfahrezy/let-it-go/pkg/http_server"
 
-// ListBlogsRequest represents the request for listing blogs with pagination
+// ListBlogsRequest represents the request for listing blogs with pagination. With tags
+// only blogs having any of them are listed, or all of them when MatchAll is set.
 type ListBlogsRequest struct {
 	http_server.PaginationRequest
+	Tags     []string
+	MatchAll bool
 }
 
 // GetBlogsByAuthorRequest represents the request for getting blogs by author with pagination
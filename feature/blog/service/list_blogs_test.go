@@ -16,7 +16,7 @@ import (
 
 func TestBlogService_ListBlogs_Success(t *testing.T) {
 	mockRepo := &repositoryfakes.FakeBlogRepository{}
-	blogService := service.NewBlogService(logger.NewDiscardLogger(), mockRepo)
+	blogService := service.NewBlogService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{}, service.Config{})
 	ctx := context.Background()
 
 	expectedBlogs := []repository.Blog{
@@ -72,7 +72,7 @@ func TestBlogService_ListBlogs_Success(t *testing.T) {
 
 func TestBlogService_ListBlogs_WithCustomPagination(t *testing.T) {
 	mockRepo := &repositoryfakes.FakeBlogRepository{}
-	blogService := service.NewBlogService(logger.NewDiscardLogger(), mockRepo)
+	blogService := service.NewBlogService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{}, service.Config{})
 	ctx := context.Background()
 
 	expectedBlogs := []repository.Blog{
@@ -112,7 +112,7 @@ func TestBlogService_ListBlogs_WithCustomPagination(t *testing.T) {
 
 func TestBlogService_ListBlogs_EmptyResult(t *testing.T) {
 	mockRepo := &repositoryfakes.FakeBlogRepository{}
-	blogService := service.NewBlogService(logger.NewDiscardLogger(), mockRepo)
+	blogService := service.NewBlogService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{}, service.Config{})
 	ctx := context.Background()
 
 	mockRepo.ListReturns([]repository.Blog{}, nil)
@@ -135,3 +135,62 @@ func TestBlogService_ListBlogs_EmptyResult(t *testing.T) {
 	assert.Equal(t, 1, mockRepo.ListCallCount())
 	assert.Equal(t, 1, mockRepo.CountCallCount())
 }
+
+func TestBlogService_ListBlogs_ByTags(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeBlogRepository{}
+	blogService := service.NewBlogService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{}, service.Config{})
+	ctx := context.Background()
+
+	expectedBlogs := []repository.Blog{
+		{ID: uuid.New(), Title: "Blog 1", Tags: []string{"go", "mysql"}},
+	}
+	mockRepo.ListByTagsReturns(expectedBlogs, nil)
+	mockRepo.CountByTagsReturns(1, nil)
+
+	result, totalCount, err := blogService.ListBlogs(ctx, service.ListBlogsRequest{
+		PaginationRequest: http_server.PaginationRequest{
+			Page:     2,
+			PageSize: 10,
+		},
+		Tags:     []string{"go", "mysql"},
+		MatchAll: true,
+	})
+
+	assert.NoError(t, err)
+	assert.Len(t, result, 1)
+	assert.Equal(t, []string{"go", "mysql"}, result[0].Tags)
+	assert.Equal(t, int64(1), totalCount)
+
+	// The tag filter replaces the plain listing
+	assert.Equal(t, 0, mockRepo.ListCallCount())
+	assert.Equal(t, 1, mockRepo.ListByTagsCallCount())
+	_, actualTags, actualMatchAll, actualLimit, actualOffset := mockRepo.ListByTagsArgsForCall(0)
+	assert.Equal(t, []string{"go", "mysql"}, actualTags)
+	assert.True(t, actualMatchAll)
+	assert.Equal(t, 10, actualLimit)
+	assert.Equal(t, 10, actualOffset)
+	_, countTags, countMatchAll := mockRepo.CountByTagsArgsForCall(0)
+	assert.Equal(t, []string{"go", "mysql"}, countTags)
+	assert.True(t, countMatchAll)
+}
+
+func TestBlogService_ListBlogs_ByRepeatedTags(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeBlogRepository{}
+	blogService := service.NewBlogService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{}, service.Config{})
+
+	_, _, err := blogService.ListBlogs(context.Background(), service.ListBlogsRequest{
+		PaginationRequest: http_server.PaginationRequest{
+			Page:     1,
+			PageSize: 10,
+		},
+		Tags:     []string{"go", "go", "mysql", "go"},
+		MatchAll: true,
+	})
+
+	// Blogs tagged go and mysql still match all of ?tag=go&tag=go&tag=mysql
+	assert.NoError(t, err)
+	_, actualTags, _, _, _ := mockRepo.ListByTagsArgsForCall(0)
+	assert.Equal(t, []string{"go", "mysql"}, actualTags)
+	_, countTags, _ := mockRepo.CountByTagsArgsForCall(0)
+	assert.Equal(t, []string{"go", "mysql"}, countTags)
+}
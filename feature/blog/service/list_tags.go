@@ -0,0 +1,14 @@
+package service
+
+import (
+	"context"
+)
+
+func (s *blogService) ListTags(ctx context.Context) ([]TagResponse, error) {
+	tags, err := s.blogRepo.ListTags(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return TagUsagesToResponses(tags), nil
+}
@@ -0,0 +1,44 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/fikryfahrezy/let-it-go/feature/blog/repository"
+	"github.com/fikryfahrezy/let-it-go/feature/blog/repository/repositoryfakes"
+	"github.com/fikryfahrezy/let-it-go/feature/blog/service"
+	"github.com/fikryfahrezy/let-it-go/pkg/logger"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBlogService_ListTags_Success(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeBlogRepository{}
+	blogService := service.NewBlogService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{}, service.Config{})
+
+	mockRepo.ListTagsReturns([]repository.TagUsage{
+		{Name: "go", BlogCount: 3},
+		{Name: "mysql", BlogCount: 1},
+	}, nil)
+
+	result, err := blogService.ListTags(context.Background())
+
+	assert.NoError(t, err)
+	assert.Equal(t, []service.TagResponse{
+		{Name: "go", BlogCount: 3},
+		{Name: "mysql", BlogCount: 1},
+	}, result)
+}
+
+func TestBlogService_ListTags_Error(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeBlogRepository{}
+	blogService := service.NewBlogService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{}, service.Config{})
+
+	dbError := errors.New("database connection error")
+	mockRepo.ListTagsReturns(nil, dbError)
+
+	result, err := blogService.ListTags(context.Background())
+
+	assert.Equal(t, dbError, err)
+	assert.Nil(t, result)
+}
@@ -0,0 +1,138 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/fikryfahrezy/let-it-go/feature/blog/repository"
+	"github.com/fikryfahrezy/let-it-go/pkg/http_server"
+	"github.com/fikryfahrezy/let-it-go/pkg/rbac"
+	"github.com/google/uuid"
+)
+
+// callerWith returns the authenticated caller carried in the request context,
+// provided their role grants the permission
+func callerWith(ctx context.Context, permission rbac.Permission) (http_server.Principal, error) {
+	principal, ok := http_server.PrincipalFromContext(ctx)
+	if !ok || principal.UserID == uuid.Nil || !rbac.Can(principal, permission) {
+		return http_server.Principal{}, ErrBlogForbidden
+	}
+	return principal, nil
+}
+
+// getEditableBlog loads a blog and makes sure the caller may update or delete it.
+// Callers allowed to manage blogs may change any blog; writers only their own drafts.
+func (s *blogService) getEditableBlog(ctx context.Context, id uuid.UUID) (repository.Blog, error) {
+	principal, err := callerWith(ctx, rbac.PermissionBlogsWrite)
+	if err != nil {
+		return repository.Blog{}, err
+	}
+
+	blog, err := s.blogRepo.GetByID(ctx, id)
+	if err != nil {
+		return repository.Blog{}, err
+	}
+
+	if rbac.Can(principal, rbac.PermissionBlogsManage) {
+		return blog, nil
+	}
+
+	if blog.AuthorID != principal.UserID {
+		s.log.Warn("Blog access denied for non-owner",
+			slog.String("blog_id", id.String()),
+			slog.String("caller_id", principal.UserID.String()),
+		)
+		return repository.Blog{}, ErrBlogForbidden
+	}
+
+	if blog.Status != repository.StatusDraft {
+		s.log.Warn("Blog access denied, only drafts can be changed by their author",
+			slog.String("blog_id", id.String()),
+			slog.String("status", blog.Status),
+		)
+		return repository.Blog{}, ErrBlogNotDraft
+	}
+
+	return blog, nil
+}
+
+// getPublishableBlog loads a blog after making sure the caller may publish or archive blogs
+func (s *blogService) getPublishableBlog(ctx context.Context, id uuid.UUID) (repository.Blog, error) {
+	if _, err := callerWith(ctx, rbac.PermissionBlogsPublish); err != nil {
+		s.log.Warn("Blog publishing denied",
+			slog.String("blog_id", id.String()),
+		)
+		return repository.Blog{}, err
+	}
+
+	return s.blogRepo.GetByID(ctx, id)
+}
+
+type scheduledPublishingContextKey struct{}
+
+// ContextForScheduledPublishing returns a copy of ctx marking the caller as the job
+// publishing scheduled blogs. The job has no principal and may only publish blogs whose
+// time has come.
+func ContextForScheduledPublishing(ctx context.Context) context.Context {
+	return context.WithValue(ctx, scheduledPublishingContextKey{}, true)
+}
+
+// isScheduledPublishing reports whether ctx was marked with ContextForScheduledPublishing
+func isScheduledPublishing(ctx context.Context) bool {
+	marked, _ := ctx.Value(scheduledPublishingContextKey{}).(bool)
+	return marked
+}
+
+// getPublishableOrDueBlog loads a blog the caller may publish. Authenticated callers need
+// the publish permission. The scheduled publishing job may only publish scheduled blogs
+// whose time has come: an editor allowed it when scheduling them. Anyone else is refused.
+func (s *blogService) getPublishableOrDueBlog(ctx context.Context, id uuid.UUID) (repository.Blog, error) {
+	if _, ok := http_server.PrincipalFromContext(ctx); ok || !isScheduledPublishing(ctx) {
+		return s.getPublishableBlog(ctx, id)
+	}
+
+	blog, err := s.blogRepo.GetByID(ctx, id)
+	if err != nil {
+		return repository.Blog{}, err
+	}
+
+	if !isDue(blog, time.Now()) {
+		s.log.Warn("Blog publishing denied, it is not due",
+			slog.String("blog_id", id.String()),
+			slog.String("status", blog.Status),
+		)
+		return repository.Blog{}, ErrBlogForbidden
+	}
+
+	return blog, nil
+}
+
+// isDue reports whether the blog is scheduled to be published by now
+func isDue(blog repository.Blog, now time.Time) bool {
+	return blog.Status == repository.StatusScheduled && blog.ScheduledAt != nil && !blog.ScheduledAt.After(now)
+}
+
+// getOwnedBlog loads a blog whose comments the caller may open or close: any blog of their
+// own whatever its status, or every blog for callers allowed to manage blogs
+func (s *blogService) getOwnedBlog(ctx context.Context, id uuid.UUID) (repository.Blog, error) {
+	principal, err := callerWith(ctx, rbac.PermissionBlogsWrite)
+	if err != nil {
+		return repository.Blog{}, err
+	}
+
+	blog, err := s.blogRepo.GetByID(ctx, id)
+	if err != nil {
+		return repository.Blog{}, err
+	}
+
+	if blog.AuthorID != principal.UserID && !rbac.Can(principal, rbac.PermissionBlogsManage) {
+		s.log.Warn("Blog access denied for non-owner",
+			slog.String("blog_id", id.String()),
+			slog.String("caller_id", principal.UserID.String()),
+		)
+		return repository.Blog{}, ErrBlogForbidden
+	}
+
+	return blog, nil
+}
@@ -2,6 +2,8 @@ package service
 
 import (
 	"context"
+	"errors"
+	"log/slog"
 	"time"
 
 	"github.com/fikryfahrezy/let-it-go/feature/blog/repository"
@@ -9,18 +11,54 @@ import (
 )
 
 func (s *blogService) PublishBlog(ctx context.Context, id uuid.UUID) (GetBlogResponse, error) {
-	blog, err := s.blogRepo.GetByID(ctx, id)
+	blog, err := s.getPublishableOrDueBlog(ctx, id)
 	if err != nil {
 		return GetBlogResponse{}, err
 	}
 
-	blog.Status = repository.StatusPublished
+	if err := s.requireVerifiedAuthor(ctx, blog.AuthorID); err != nil {
+		// The job would retry a blog its author can no longer publish on every run, keeping
+		// newer due blogs waiting behind it, so the blog goes back to its author as a draft
+		if isScheduledPublishing(ctx) && errors.Is(err, ErrAuthorEmailNotVerified) {
+			s.unschedule(ctx, blog, err)
+		}
+		return GetBlogResponse{}, err
+	}
+
 	now := time.Now()
-	blog.PublishedAt = &now
+	if blog.Status == repository.StatusScheduled {
+		// Only one of the concurrent attempts to publish a scheduled blog succeeds
+		if err := s.blogRepo.PublishScheduled(ctx, id, now); err != nil {
+			return GetBlogResponse{}, err
+		}
+		blog.Status = repository.StatusPublished
+		blog.PublishedAt = &now
+		blog.ScheduledAt = nil
+	} else {
+		blog.Status = repository.StatusPublished
+		blog.PublishedAt = &now
 
-	if err := s.blogRepo.Update(ctx, blog); err != nil {
-		return GetBlogResponse{}, err
+		if err := s.blogRepo.Update(ctx, blog); err != nil {
+			return GetBlogResponse{}, err
+		}
+	}
+
+	return s.withCommentCount(ctx, blog)
+}
+
+// unschedule moves a scheduled blog that can not be published back to draft, logging why
+func (s *blogService) unschedule(ctx context.Context, blog repository.Blog, reason error) {
+	if err := s.blogRepo.Unschedule(ctx, blog.ID); err != nil {
+		s.log.Error("Failed to move unpublishable scheduled blog back to draft",
+			slog.String("blog_id", blog.ID.String()),
+			slog.String("error", err.Error()),
+		)
+		return
 	}
 
-	return BlogEntityToGetResponse(blog), nil
+	s.log.Warn("Scheduled blog moved back to draft",
+		slog.String("blog_id", blog.ID.String()),
+		slog.String("author_id", blog.AuthorID.String()),
+		slog.String("reason", reason.Error()),
+	)
 }
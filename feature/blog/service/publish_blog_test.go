@@ -0,0 +1,166 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/fikryfahrezy/let-it-go/feature/blog/repository"
+	"github.com/fikryfahrezy/let-it-go/feature/blog/repository/repositoryfakes"
+	"github.com/fikryfahrezy/let-it-go/feature/blog/service"
+	"github.com/fikryfahrezy/let-it-go/pkg/http_server"
+	"github.com/fikryfahrezy/let-it-go/pkg/logger"
+	"github.com/fikryfahrezy/let-it-go/pkg/rbac"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBlogService_PublishBlog_EditorAnyBlog(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeBlogRepository{}
+	blogService := service.NewBlogService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{}, service.Config{})
+	ctx := http_server.ContextWithPrincipal(context.Background(), http_server.Principal{UserID: uuid.New(), Roles: []string{rbac.RoleEditor}})
+
+	blogID := uuid.New()
+	mockRepo.GetByIDReturns(repository.Blog{ID: blogID, AuthorID: uuid.New(), Status: repository.StatusDraft}, nil)
+
+	result, err := blogService.PublishBlog(ctx, blogID)
+
+	require.NoError(t, err)
+	assert.Equal(t, repository.StatusPublished, result.Status)
+	assert.NotNil(t, result.PublishedAt)
+	assert.Equal(t, 1, mockRepo.UpdateCallCount())
+}
+
+func TestBlogService_PublishBlog_Author(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeBlogRepository{}
+	blogService := service.NewBlogService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{}, service.Config{})
+	authorID := uuid.New()
+	ctx := http_server.ContextWithPrincipal(context.Background(), http_server.Principal{UserID: authorID, Roles: []string{rbac.RoleAuthor}})
+
+	// Even their own draft is published by an editor
+	_, err := blogService.PublishBlog(ctx, uuid.New())
+
+	assert.Equal(t, service.ErrBlogForbidden, err)
+	assert.Equal(t, 0, mockRepo.GetByIDCallCount())
+	assert.Equal(t, 0, mockRepo.UpdateCallCount())
+}
+
+func TestBlogService_ArchiveBlog_EditorAnyBlog(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeBlogRepository{}
+	blogService := service.NewBlogService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{}, service.Config{})
+	ctx := http_server.ContextWithPrincipal(context.Background(), http_server.Principal{UserID: uuid.New(), Roles: []string{rbac.RoleEditor}})
+
+	blogID := uuid.New()
+	mockRepo.GetByIDReturns(repository.Blog{ID: blogID, AuthorID: uuid.New(), Status: repository.StatusPublished}, nil)
+
+	result, err := blogService.ArchiveBlog(ctx, blogID)
+
+	require.NoError(t, err)
+	assert.Equal(t, repository.StatusArchived, result.Status)
+	assert.Nil(t, result.PublishedAt)
+}
+
+func TestBlogService_ArchiveBlog_Author(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeBlogRepository{}
+	blogService := service.NewBlogService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{}, service.Config{})
+	ctx := http_server.ContextWithPrincipal(context.Background(), http_server.Principal{UserID: uuid.New(), Roles: []string{rbac.RoleAuthor}})
+
+	_, err := blogService.ArchiveBlog(ctx, uuid.New())
+
+	assert.Equal(t, service.ErrBlogForbidden, err)
+	assert.Equal(t, 0, mockRepo.UpdateCallCount())
+}
+
+func TestBlogService_PublishBlog_DueScheduledWithoutCaller(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeBlogRepository{}
+	blogService := service.NewBlogService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{}, service.Config{})
+
+	blogID := uuid.New()
+	scheduledAt := time.Now().Add(-time.Minute)
+	mockRepo.GetByIDReturns(repository.Blog{ID: blogID, AuthorID: uuid.New(), Status: repository.StatusScheduled, ScheduledAt: &scheduledAt}, nil)
+
+	// The cron job has no caller and publishes blogs once their time has come
+	result, err := blogService.PublishBlog(service.ContextForScheduledPublishing(context.Background()), blogID)
+	require.NoError(t, err)
+	assert.Equal(t, repository.StatusPublished, result.Status)
+	assert.NotNil(t, result.PublishedAt)
+	assert.Nil(t, result.ScheduledAt)
+
+	require.Equal(t, 1, mockRepo.PublishScheduledCallCount())
+	_, actualID, publishedAt := mockRepo.PublishScheduledArgsForCall(0)
+	assert.Equal(t, blogID, actualID)
+	assert.Equal(t, *result.PublishedAt, publishedAt)
+	assert.Equal(t, 0, mockRepo.UpdateCallCount())
+}
+
+func TestBlogService_PublishBlog_NotDueWithoutCaller(t *testing.T) {
+	scheduledAt := time.Now().Add(time.Hour)
+	tests := []struct {
+		name string
+		blog repository.Blog
+	}{
+		{name: "scheduled later", blog: repository.Blog{ID: uuid.New(), Status: repository.StatusScheduled, ScheduledAt: &scheduledAt}},
+		{name: "draft", blog: repository.Blog{ID: uuid.New(), Status: repository.StatusDraft}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := &repositoryfakes.FakeBlogRepository{}
+			blogService := service.NewBlogService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{}, service.Config{})
+			mockRepo.GetByIDReturns(tt.blog, nil)
+
+			_, err := blogService.PublishBlog(service.ContextForScheduledPublishing(context.Background()), tt.blog.ID)
+
+			assert.Equal(t, service.ErrBlogForbidden, err)
+			assert.Equal(t, 0, mockRepo.PublishScheduledCallCount())
+			assert.Equal(t, 0, mockRepo.UpdateCallCount())
+		})
+	}
+}
+
+func TestBlogService_PublishBlog_DueScheduledAnonymous(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeBlogRepository{}
+	blogService := service.NewBlogService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{}, service.Config{})
+
+	scheduledAt := time.Now().Add(-time.Minute)
+	mockRepo.GetByIDReturns(repository.Blog{ID: uuid.New(), Status: repository.StatusScheduled, ScheduledAt: &scheduledAt}, nil)
+
+	// Only the scheduled publishing job may publish without a caller
+	_, err := blogService.PublishBlog(context.Background(), uuid.New())
+
+	assert.Equal(t, service.ErrBlogForbidden, err)
+	assert.Equal(t, 0, mockRepo.GetByIDCallCount())
+	assert.Equal(t, 0, mockRepo.PublishScheduledCallCount())
+}
+
+func TestBlogService_PublishBlog_ScheduledTwice(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeBlogRepository{}
+	blogService := service.NewBlogService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{}, service.Config{})
+
+	scheduledAt := time.Now().Add(-time.Minute)
+	mockRepo.GetByIDReturns(repository.Blog{ID: uuid.New(), Status: repository.StatusScheduled, ScheduledAt: &scheduledAt}, nil)
+	// Another run published the blog between loading and publishing it
+	mockRepo.PublishScheduledReturns(repository.ErrBlogNotScheduled)
+
+	_, err := blogService.PublishBlog(service.ContextForScheduledPublishing(context.Background()), uuid.New())
+
+	assert.Equal(t, repository.ErrBlogNotScheduled, err)
+	assert.Equal(t, 0, mockRepo.UpdateCallCount())
+}
+
+func TestBlogService_PublishBlog_EditorScheduledBlogEarly(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeBlogRepository{}
+	blogService := service.NewBlogService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{}, service.Config{})
+	ctx := http_server.ContextWithPrincipal(context.Background(), http_server.Principal{UserID: uuid.New(), Roles: []string{rbac.RoleEditor}})
+
+	scheduledAt := time.Now().Add(time.Hour)
+	mockRepo.GetByIDReturns(repository.Blog{ID: uuid.New(), Status: repository.StatusScheduled, ScheduledAt: &scheduledAt}, nil)
+
+	// Editors publish scheduled blogs before their time, and the cron job then skips them
+	result, err := blogService.PublishBlog(ctx, uuid.New())
+	require.NoError(t, err)
+	assert.Equal(t, repository.StatusPublished, result.Status)
+	assert.Equal(t, 1, mockRepo.PublishScheduledCallCount())
+	assert.Equal(t, 0, mockRepo.UpdateCallCount())
+}
@@ -0,0 +1,47 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/fikryfahrezy/let-it-go/feature/blog/repository"
+	"github.com/google/uuid"
+)
+
+// ScheduleBlog sets a blog to be published by the cron job once the scheduled time has
+// passed. Scheduled blogs can be scheduled again to move their time.
+func (s *blogService) ScheduleBlog(ctx context.Context, id uuid.UUID, req ScheduleBlogRequest) (GetBlogResponse, error) {
+	blog, err := s.getPublishableBlog(ctx, id)
+	if err != nil {
+		return GetBlogResponse{}, err
+	}
+
+	if blog.Status == repository.StatusPublished {
+		return GetBlogResponse{}, ErrBlogAlreadyPublished
+	}
+
+	if !req.ScheduledAt.After(time.Now()) {
+		return GetBlogResponse{}, ErrScheduleNotInFuture
+	}
+
+	if err := s.requireVerifiedAuthor(ctx, blog.AuthorID); err != nil {
+		return GetBlogResponse{}, err
+	}
+
+	scheduledAt := req.ScheduledAt.UTC()
+	blog.Status = repository.StatusScheduled
+	blog.ScheduledAt = &scheduledAt
+	blog.PublishedAt = nil
+
+	if err := s.blogRepo.Update(ctx, blog); err != nil {
+		return GetBlogResponse{}, err
+	}
+
+	s.log.Info("Blog scheduled",
+		slog.String("blog_id", id.String()),
+		slog.Time("scheduled_at", scheduledAt),
+	)
+
+	return s.withCommentCount(ctx, blog)
+}
@@ -0,0 +1,8 @@
+package service
+
+import "time"
+
+// ScheduleBlogRequest sets when a blog gets published
+type ScheduleBlogRequest struct {
+	ScheduledAt time.Time `json:"scheduled_at" validate:"required"` // RFC 3339, in the future
+}
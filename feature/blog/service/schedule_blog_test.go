@@ -0,0 +1,126 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/fikryfahrezy/let-it-go/feature/blog/repository"
+	"github.com/fikryfahrezy/let-it-go/feature/blog/repository/repositoryfakes"
+	"github.com/fikryfahrezy/let-it-go/feature/blog/service"
+	"github.com/fikryfahrezy/let-it-go/feature/blog/service/servicefakes"
+	"github.com/fikryfahrezy/let-it-go/pkg/http_server"
+	"github.com/fikryfahrezy/let-it-go/pkg/logger"
+	"github.com/fikryfahrezy/let-it-go/pkg/rbac"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func editorContext() context.Context {
+	return http_server.ContextWithPrincipal(context.Background(), http_server.Principal{UserID: uuid.New(), Roles: []string{rbac.RoleEditor}})
+}
+
+func TestBlogService_ScheduleBlog_Success(t *testing.T) {
+	tests := []struct {
+		name   string
+		status string
+	}{
+		{name: "draft", status: repository.StatusDraft},
+		{name: "archived", status: repository.StatusArchived},
+		{name: "already scheduled", status: repository.StatusScheduled},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := &repositoryfakes.FakeBlogRepository{}
+			blogService := service.NewBlogService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{}, service.Config{})
+
+			blogID := uuid.New()
+			mockRepo.GetByIDReturns(repository.Blog{ID: blogID, AuthorID: uuid.New(), Status: tt.status}, nil)
+
+			scheduledAt := time.Now().Add(24 * time.Hour)
+			result, err := blogService.ScheduleBlog(editorContext(), blogID, service.ScheduleBlogRequest{ScheduledAt: scheduledAt})
+			require.NoError(t, err)
+			assert.Equal(t, repository.StatusScheduled, result.Status)
+			require.NotNil(t, result.ScheduledAt)
+			assert.True(t, scheduledAt.Equal(*result.ScheduledAt))
+			assert.Nil(t, result.PublishedAt)
+
+			require.Equal(t, 1, mockRepo.UpdateCallCount())
+			_, actualBlog := mockRepo.UpdateArgsForCall(0)
+			assert.Equal(t, blogID, actualBlog.ID)
+			assert.Equal(t, repository.StatusScheduled, actualBlog.Status)
+			require.NotNil(t, actualBlog.ScheduledAt)
+			assert.Equal(t, time.UTC, actualBlog.ScheduledAt.Location())
+		})
+	}
+}
+
+func TestBlogService_ScheduleBlog_Author(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeBlogRepository{}
+	blogService := service.NewBlogService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{}, service.Config{})
+	ctx := http_server.ContextWithPrincipal(context.Background(), http_server.Principal{UserID: uuid.New(), Roles: []string{rbac.RoleAuthor}})
+
+	// Scheduling is publishing later, which only editors and admins do
+	_, err := blogService.ScheduleBlog(ctx, uuid.New(), service.ScheduleBlogRequest{ScheduledAt: time.Now().Add(time.Hour)})
+
+	assert.Equal(t, service.ErrBlogForbidden, err)
+	assert.Equal(t, 0, mockRepo.GetByIDCallCount())
+	assert.Equal(t, 0, mockRepo.UpdateCallCount())
+}
+
+func TestBlogService_ScheduleBlog_NotInFuture(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeBlogRepository{}
+	blogService := service.NewBlogService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{}, service.Config{})
+	mockRepo.GetByIDReturns(repository.Blog{ID: uuid.New(), Status: repository.StatusDraft}, nil)
+
+	_, err := blogService.ScheduleBlog(editorContext(), uuid.New(), service.ScheduleBlogRequest{ScheduledAt: time.Now().Add(-time.Minute)})
+
+	assert.Equal(t, service.ErrScheduleNotInFuture, err)
+	assert.Equal(t, 0, mockRepo.UpdateCallCount())
+}
+
+func TestBlogService_ScheduleBlog_AlreadyPublished(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeBlogRepository{}
+	blogService := service.NewBlogService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{}, service.Config{})
+	mockRepo.GetByIDReturns(repository.Blog{ID: uuid.New(), Status: repository.StatusPublished}, nil)
+
+	_, err := blogService.ScheduleBlog(editorContext(), uuid.New(), service.ScheduleBlogRequest{ScheduledAt: time.Now().Add(time.Hour)})
+
+	assert.Equal(t, service.ErrBlogAlreadyPublished, err)
+	assert.Equal(t, 0, mockRepo.UpdateCallCount())
+}
+
+func TestBlogService_ScheduleBlog_UnverifiedAuthor(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeBlogRepository{}
+	mockVerifier := &servicefakes.FakeAuthorVerifier{}
+	blogService := service.NewBlogService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{AuthorVerifier: mockVerifier}, service.Config{RequireVerifiedEmail: true})
+	mockRepo.GetByIDReturns(repository.Blog{ID: uuid.New(), AuthorID: uuid.New(), Status: repository.StatusDraft}, nil)
+	mockVerifier.IsEmailVerifiedReturns(false, nil)
+
+	_, err := blogService.ScheduleBlog(editorContext(), uuid.New(), service.ScheduleBlogRequest{ScheduledAt: time.Now().Add(time.Hour)})
+
+	assert.Equal(t, service.ErrAuthorEmailNotVerified, err)
+	assert.Equal(t, 0, mockRepo.UpdateCallCount())
+}
+
+func TestBlogService_GetDueScheduledBlogs(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeBlogRepository{}
+	blogService := service.NewBlogService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{}, service.Config{})
+
+	scheduledAt := time.Now().Add(-time.Minute)
+	blog := repository.Blog{ID: uuid.New(), Status: repository.StatusScheduled, ScheduledAt: &scheduledAt}
+	mockRepo.GetDueScheduledReturns([]repository.Blog{blog}, nil)
+
+	now := time.Now()
+	result, err := blogService.GetDueScheduledBlogs(context.Background(), now)
+	require.NoError(t, err)
+	require.Len(t, result, 1)
+	assert.Equal(t, blog.ID, result[0].ID)
+	assert.Equal(t, &scheduledAt, result[0].ScheduledAt)
+
+	_, actualNow, limit := mockRepo.GetDueScheduledArgsForCall(0)
+	assert.Equal(t, now, actualNow)
+	assert.Equal(t, 100, limit)
+}
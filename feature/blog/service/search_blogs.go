@@ -0,0 +1,53 @@
+package service
+
+import (
+	"context"
+	"strings"
+
+	"github.com/fikryfahrezy/let-it-go/feature/blog/repository"
+)
+
+func (s *blogService) SearchBlogs(ctx context.Context, req SearchBlogsRequest) ([]SearchBlogResponse, int64, error) {
+	filter := repository.SearchFilter{
+		Query:       strings.TrimSpace(req.Query),
+		BooleanMode: req.BooleanMode,
+		Status:      req.Status,
+		Sort:        repository.SearchSortRelevance,
+	}
+	if req.Sort == repository.SearchSortNewest {
+		filter.Sort = repository.SearchSortNewest
+	}
+
+	offset := (req.Page - 1) * req.PageSize
+	results, err := s.blogRepo.Search(ctx, filter, req.PageSize, offset)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	totalItems, err := s.blogRepo.CountSearch(ctx, filter)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	blogs := make([]repository.Blog, len(results))
+	for i, result := range results {
+		blogs[i] = result.Blog
+	}
+	blogResponses, err := s.withCommentCounts(ctx, blogs)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	terms := searchTerms(filter.Query, filter.BooleanMode)
+	responses := make([]SearchBlogResponse, len(results))
+	for i, result := range results {
+		responses[i] = SearchBlogResponse{
+			GetBlogResponse: blogResponses[i],
+			Relevance:       result.Relevance,
+			TitleHighlight:  highlight(result.Title, terms),
+			Snippet:         snippet(result.Content, terms),
+		}
+	}
+
+	return responses, totalItems, nil
+}
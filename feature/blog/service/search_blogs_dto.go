@@ -0,0 +1,23 @@
+package service
+
+import "github.com/fikryfahrezy/let-it-go/pkg/http_server"
+
+// SearchBlogsRequest represents a full-text search of blogs with pagination. BooleanMode
+// lets the query use the MySQL boolean mode operators, such as +required, -excluded,
+// prefix* and "exact phrases". Blogs of any status are searched unless Status is set.
+type SearchBlogsRequest struct {
+	http_server.PaginationRequest
+	Query       string
+	BooleanMode bool
+	Status      string
+	Sort        string // relevance, the default, or newest
+}
+
+// SearchBlogResponse is a blog matching a search. The highlighted title and snippet are
+// HTML escaped, with the matching words wrapped in <mark> tags.
+type SearchBlogResponse struct {
+	GetBlogResponse
+	Relevance      float64 `json:"relevance"`
+	TitleHighlight string  `json:"title_highlight"`
+	Snippet        string  `json:"snippet"` // Excerpt of the content around the first match
+}
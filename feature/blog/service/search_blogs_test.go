@@ -0,0 +1,221 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/fikryfahrezy/let-it-go/feature/blog/repository"
+	"github.com/fikryfahrezy/let-it-go/feature/blog/repository/repositoryfakes"
+	"github.com/fikryfahrezy/let-it-go/feature/blog/service"
+	"github.com/fikryfahrezy/let-it-go/pkg/http_server"
+	"github.com/fikryfahrezy/let-it-go/pkg/logger"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func searchResult(title, content string, relevance float64) repository.SearchResult {
+	return repository.SearchResult{
+		Blog: repository.Blog{
+			ID:       uuid.New(),
+			Title:    title,
+			Content:  content,
+			AuthorID: uuid.New(),
+			Status:   repository.StatusPublished,
+		},
+		Relevance: relevance,
+	}
+}
+
+func TestBlogService_SearchBlogs_Success(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeBlogRepository{}
+	blogService := service.NewBlogService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{}, service.Config{})
+
+	result := searchResult("Deploying Golang Services", "How to ship Golang binaries to kubernetes", 1.5)
+	mockRepo.SearchReturns([]repository.SearchResult{result}, nil)
+	mockRepo.CountSearchReturns(11, nil)
+
+	responses, totalCount, err := blogService.SearchBlogs(context.Background(), service.SearchBlogsRequest{
+		PaginationRequest: http_server.PaginationRequest{Page: 2, PageSize: 10},
+		Query:             "  golang kubernetes ",
+		Status:            repository.StatusPublished,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int64(11), totalCount)
+	require.Len(t, responses, 1)
+	assert.Equal(t, result.ID, responses[0].ID)
+	assert.Equal(t, 1.5, responses[0].Relevance)
+	assert.Equal(t, "Deploying <mark>Golang</mark> Services", responses[0].TitleHighlight)
+	assert.Equal(t, "How to ship <mark>Golang</mark> binaries to <mark>kubernetes</mark>", responses[0].Snippet)
+
+	// The trimmed query is searched by relevance by default
+	_, filter, limit, offset := mockRepo.SearchArgsForCall(0)
+	assert.Equal(t, repository.SearchFilter{
+		Query:  "golang kubernetes",
+		Status: repository.StatusPublished,
+		Sort:   repository.SearchSortRelevance,
+	}, filter)
+	assert.Equal(t, 10, limit)
+	assert.Equal(t, 10, offset)
+
+	_, countFilter := mockRepo.CountSearchArgsForCall(0)
+	assert.Equal(t, filter, countFilter)
+}
+
+func TestBlogService_SearchBlogs_SortNewest(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeBlogRepository{}
+	blogService := service.NewBlogService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{}, service.Config{})
+
+	_, _, err := blogService.SearchBlogs(context.Background(), service.SearchBlogsRequest{
+		PaginationRequest: http_server.PaginationRequest{Page: 1, PageSize: 10},
+		Query:             "golang",
+		Sort:              repository.SearchSortNewest,
+	})
+	require.NoError(t, err)
+
+	_, filter, _, _ := mockRepo.SearchArgsForCall(0)
+	assert.Equal(t, repository.SearchSortNewest, filter.Sort)
+}
+
+func TestBlogService_SearchBlogs_BooleanModeHighlights(t *testing.T) {
+	tests := []struct {
+		name    string
+		query   string
+		content string
+		snippet string
+	}{
+		{
+			name:    "excluded words are not highlighted",
+			query:   "+golang -java",
+			content: "Golang is not Java",
+			snippet: "<mark>Golang</mark> is not Java",
+		},
+		{
+			name:    "prefix matches longer words",
+			query:   "kube*",
+			content: "Running kubernetes and kubectl, not kube-proxy tuning",
+			snippet: "Running <mark>kubernetes</mark> and <mark>kubectl</mark>, not <mark>kube</mark>-proxy tuning",
+		},
+		{
+			name:    "phrases highlight their words",
+			query:   `"query planner" -"full scan"`,
+			content: "The query planner avoids a full scan",
+			snippet: "The <mark>query</mark> <mark>planner</mark> avoids a full scan",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := &repositoryfakes.FakeBlogRepository{}
+			blogService := service.NewBlogService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{}, service.Config{})
+			mockRepo.SearchReturns([]repository.SearchResult{searchResult("Untitled", tt.content, 1)}, nil)
+
+			responses, _, err := blogService.SearchBlogs(context.Background(), service.SearchBlogsRequest{
+				PaginationRequest: http_server.PaginationRequest{Page: 1, PageSize: 10},
+				Query:             tt.query,
+				BooleanMode:       true,
+			})
+			require.NoError(t, err)
+			require.Len(t, responses, 1)
+			assert.Equal(t, tt.snippet, responses[0].Snippet)
+		})
+	}
+}
+
+func TestBlogService_SearchBlogs_NaturalModeIgnoresOperators(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeBlogRepository{}
+	blogService := service.NewBlogService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{}, service.Config{})
+	mockRepo.SearchReturns([]repository.SearchResult{searchResult("Untitled", "Golang or Java", 1)}, nil)
+
+	responses, _, err := blogService.SearchBlogs(context.Background(), service.SearchBlogsRequest{
+		PaginationRequest: http_server.PaginationRequest{Page: 1, PageSize: 10},
+		Query:             "golang -java",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "<mark>Golang</mark> or <mark>Java</mark>", responses[0].Snippet)
+}
+
+func TestBlogService_SearchBlogs_LongContentSnippet(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeBlogRepository{}
+	blogService := service.NewBlogService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{}, service.Config{})
+
+	content := strings.Repeat("lorem ipsum ", 30) + "the golang\nsection " + strings.Repeat("dolor sit ", 30)
+	mockRepo.SearchReturns([]repository.SearchResult{searchResult("Untitled", content, 1)}, nil)
+
+	responses, _, err := blogService.SearchBlogs(context.Background(), service.SearchBlogsRequest{
+		PaginationRequest: http_server.PaginationRequest{Page: 1, PageSize: 10},
+		Query:             "golang",
+	})
+	require.NoError(t, err)
+
+	// The snippet surrounds the match and cuts the content between words
+	snippet := responses[0].Snippet
+	assert.True(t, strings.HasPrefix(snippet, "…lorem ipsum"), snippet)
+	assert.True(t, strings.HasSuffix(snippet, "sit…") || strings.HasSuffix(snippet, "dolor…"), snippet)
+	assert.Contains(t, snippet, "the <mark>golang</mark> section")
+	assert.LessOrEqual(t, len([]rune(snippet)), 200+len("<mark></mark>")+2)
+}
+
+func TestBlogService_SearchBlogs_TitleOnlyMatch(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeBlogRepository{}
+	blogService := service.NewBlogService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{}, service.Config{})
+
+	content := strings.Repeat("word ", 100)
+	mockRepo.SearchReturns([]repository.SearchResult{searchResult("Golang Tips", content, 1)}, nil)
+
+	responses, _, err := blogService.SearchBlogs(context.Background(), service.SearchBlogsRequest{
+		PaginationRequest: http_server.PaginationRequest{Page: 1, PageSize: 10},
+		Query:             "golang",
+	})
+	require.NoError(t, err)
+
+	// Without a match in the content the snippet is its beginning
+	assert.Equal(t, "<mark>Golang</mark> Tips", responses[0].TitleHighlight)
+	assert.True(t, strings.HasPrefix(responses[0].Snippet, "word word"))
+	assert.True(t, strings.HasSuffix(responses[0].Snippet, "word…"))
+}
+
+func TestBlogService_SearchBlogs_EscapesHTML(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeBlogRepository{}
+	blogService := service.NewBlogService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{}, service.Config{})
+	mockRepo.SearchReturns([]repository.SearchResult{searchResult("<b>Golang</b>", `<script>alert("golang")</script>`, 1)}, nil)
+
+	responses, _, err := blogService.SearchBlogs(context.Background(), service.SearchBlogsRequest{
+		PaginationRequest: http_server.PaginationRequest{Page: 1, PageSize: 10},
+		Query:             "golang",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "&lt;b&gt;<mark>Golang</mark>&lt;/b&gt;", responses[0].TitleHighlight)
+	assert.Equal(t, "&lt;script&gt;alert(&#34;<mark>golang</mark>&#34;)&lt;/script&gt;", responses[0].Snippet)
+}
+
+func TestBlogService_SearchBlogs_InvalidQuery(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeBlogRepository{}
+	blogService := service.NewBlogService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{}, service.Config{})
+	mockRepo.SearchReturns(nil, repository.ErrInvalidSearchQuery)
+
+	_, _, err := blogService.SearchBlogs(context.Background(), service.SearchBlogsRequest{
+		PaginationRequest: http_server.PaginationRequest{Page: 1, PageSize: 10},
+		Query:             "author@example.com",
+		BooleanMode:       true,
+	})
+
+	assert.ErrorIs(t, err, repository.ErrInvalidSearchQuery)
+	assert.Equal(t, 0, mockRepo.CountSearchCallCount())
+}
+
+func TestBlogService_SearchBlogs_CountError(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeBlogRepository{}
+	blogService := service.NewBlogService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{}, service.Config{})
+	countError := errors.New("database connection error")
+	mockRepo.CountSearchReturns(0, countError)
+
+	_, _, err := blogService.SearchBlogs(context.Background(), service.SearchBlogsRequest{
+		PaginationRequest: http_server.PaginationRequest{Page: 1, PageSize: 10},
+		Query:             "golang",
+	})
+
+	assert.Equal(t, countError, err)
+}
@@ -6,14 +6,32 @@ import (
 	"github.com/fikryfahrezy/let-it-go/feature/blog/repository"
 )
 
+// Dependencies holds the optional collaborators of the blog service
+type Dependencies struct {
+	// AuthorVerifier checks authors' email addresses when Config.RequireVerifiedEmail is set
+	AuthorVerifier AuthorVerifier
+	// AuthorPreferences picks the status of new blogs created without one; nil creates drafts
+	AuthorPreferences AuthorPreferences
+	// CommentCounter fills in the comment counts of blogs; nil reports no comments
+	CommentCounter CommentCounter
+}
+
 type blogService struct {
-	blogRepo repository.BlogRepository
-	log      *slog.Logger
+	blogRepo          repository.BlogRepository
+	authorVerifier    AuthorVerifier
+	authorPreferences AuthorPreferences
+	commentCounter    CommentCounter
+	config            Config
+	log               *slog.Logger
 }
 
-func NewBlogService(log *slog.Logger, blogRepo repository.BlogRepository) *blogService {
+func NewBlogService(log *slog.Logger, blogRepo repository.BlogRepository, deps Dependencies, config Config) *blogService {
 	return &blogService{
-		blogRepo: blogRepo,
-		log:      log,
+		blogRepo:          blogRepo,
+		authorVerifier:    deps.AuthorVerifier,
+		authorPreferences: deps.AuthorPreferences,
+		commentCounter:    deps.CommentCounter,
+		config:            config,
+		log:               log,
 	}
 }
@@ -4,6 +4,7 @@ package service
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 )
@@ -11,11 +12,18 @@ import (
 type BlogService interface {
 	CreateBlog(ctx context.Context, req CreateBlogRequest) (GetBlogResponse, error)
 	GetBlogByID(ctx context.Context, id uuid.UUID) (GetBlogResponse, error)
+	GetBlogBySlug(ctx context.Context, slug string) (GetBlogResponse, error)
 	GetBlogsByAuthor(ctx context.Context, authorID uuid.UUID, req GetBlogsByAuthorRequest) ([]GetBlogResponse, int64, error)
 	GetBlogsByStatus(ctx context.Context, status string, req GetBlogsByStatusRequest) ([]GetBlogResponse, int64, error)
 	UpdateBlog(ctx context.Context, id uuid.UUID, req UpdateBlogRequest) (GetBlogResponse, error)
 	DeleteBlog(ctx context.Context, id uuid.UUID) error
 	ListBlogs(ctx context.Context, req ListBlogsRequest) ([]GetBlogResponse, int64, error)
+	SearchBlogs(ctx context.Context, req SearchBlogsRequest) ([]SearchBlogResponse, int64, error)
 	PublishBlog(ctx context.Context, id uuid.UUID) (GetBlogResponse, error)
+	ScheduleBlog(ctx context.Context, id uuid.UUID, req ScheduleBlogRequest) (GetBlogResponse, error)
+	GetDueScheduledBlogs(ctx context.Context, now time.Time) ([]GetBlogResponse, error)
 	ArchiveBlog(ctx context.Context, id uuid.UUID) (GetBlogResponse, error)
+	CloseComments(ctx context.Context, id uuid.UUID) (GetBlogResponse, error)
+	OpenComments(ctx context.Context, id uuid.UUID) (GetBlogResponse, error)
+	ListTags(ctx context.Context) ([]TagResponse, error)
 }
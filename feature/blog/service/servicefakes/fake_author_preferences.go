@@ -0,0 +1,118 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package servicefakes
+
+import (
+	"context"
+	"sync"
+
+	"github.com/fikryfahrezy/let-it-go/feature/blog/service"
+	"github.com/google/uuid"
+)
+
+type FakeAuthorPreferences struct {
+	DefaultBlogStatusStub        func(context.Context, uuid.UUID) (string, error)
+	defaultBlogStatusMutex       sync.RWMutex
+	defaultBlogStatusArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}
+	defaultBlogStatusReturns struct {
+		result1 string
+		result2 error
+	}
+	defaultBlogStatusReturnsOnCall map[int]struct {
+		result1 string
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeAuthorPreferences) DefaultBlogStatus(arg1 context.Context, arg2 uuid.UUID) (string, error) {
+	fake.defaultBlogStatusMutex.Lock()
+	ret, specificReturn := fake.defaultBlogStatusReturnsOnCall[len(fake.defaultBlogStatusArgsForCall)]
+	fake.defaultBlogStatusArgsForCall = append(fake.defaultBlogStatusArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}{arg1, arg2})
+	stub := fake.DefaultBlogStatusStub
+	fakeReturns := fake.defaultBlogStatusReturns
+	fake.recordInvocation("DefaultBlogStatus", []interface{}{arg1, arg2})
+	fake.defaultBlogStatusMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeAuthorPreferences) DefaultBlogStatusCallCount() int {
+	fake.defaultBlogStatusMutex.RLock()
+	defer fake.defaultBlogStatusMutex.RUnlock()
+	return len(fake.defaultBlogStatusArgsForCall)
+}
+
+func (fake *FakeAuthorPreferences) DefaultBlogStatusCalls(stub func(context.Context, uuid.UUID) (string, error)) {
+	fake.defaultBlogStatusMutex.Lock()
+	defer fake.defaultBlogStatusMutex.Unlock()
+	fake.DefaultBlogStatusStub = stub
+}
+
+func (fake *FakeAuthorPreferences) DefaultBlogStatusArgsForCall(i int) (context.Context, uuid.UUID) {
+	fake.defaultBlogStatusMutex.RLock()
+	defer fake.defaultBlogStatusMutex.RUnlock()
+	argsForCall := fake.defaultBlogStatusArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeAuthorPreferences) DefaultBlogStatusReturns(result1 string, result2 error) {
+	fake.defaultBlogStatusMutex.Lock()
+	defer fake.defaultBlogStatusMutex.Unlock()
+	fake.DefaultBlogStatusStub = nil
+	fake.defaultBlogStatusReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAuthorPreferences) DefaultBlogStatusReturnsOnCall(i int, result1 string, result2 error) {
+	fake.defaultBlogStatusMutex.Lock()
+	defer fake.defaultBlogStatusMutex.Unlock()
+	fake.DefaultBlogStatusStub = nil
+	if fake.defaultBlogStatusReturnsOnCall == nil {
+		fake.defaultBlogStatusReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 error
+		})
+	}
+	fake.defaultBlogStatusReturnsOnCall[i] = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAuthorPreferences) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeAuthorPreferences) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ service.AuthorPreferences = new(FakeAuthorPreferences)
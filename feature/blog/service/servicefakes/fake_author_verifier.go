@@ -0,0 +1,118 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package servicefakes
+
+import (
+	"context"
+	"sync"
+
+	"github.com/fikryfahrezy/let-it-go/feature/blog/service"
+	"github.com/google/uuid"
+)
+
+type FakeAuthorVerifier struct {
+	IsEmailVerifiedStub        func(context.Context, uuid.UUID) (bool, error)
+	isEmailVerifiedMutex       sync.RWMutex
+	isEmailVerifiedArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}
+	isEmailVerifiedReturns struct {
+		result1 bool
+		result2 error
+	}
+	isEmailVerifiedReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeAuthorVerifier) IsEmailVerified(arg1 context.Context, arg2 uuid.UUID) (bool, error) {
+	fake.isEmailVerifiedMutex.Lock()
+	ret, specificReturn := fake.isEmailVerifiedReturnsOnCall[len(fake.isEmailVerifiedArgsForCall)]
+	fake.isEmailVerifiedArgsForCall = append(fake.isEmailVerifiedArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}{arg1, arg2})
+	stub := fake.IsEmailVerifiedStub
+	fakeReturns := fake.isEmailVerifiedReturns
+	fake.recordInvocation("IsEmailVerified", []interface{}{arg1, arg2})
+	fake.isEmailVerifiedMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeAuthorVerifier) IsEmailVerifiedCallCount() int {
+	fake.isEmailVerifiedMutex.RLock()
+	defer fake.isEmailVerifiedMutex.RUnlock()
+	return len(fake.isEmailVerifiedArgsForCall)
+}
+
+func (fake *FakeAuthorVerifier) IsEmailVerifiedCalls(stub func(context.Context, uuid.UUID) (bool, error)) {
+	fake.isEmailVerifiedMutex.Lock()
+	defer fake.isEmailVerifiedMutex.Unlock()
+	fake.IsEmailVerifiedStub = stub
+}
+
+func (fake *FakeAuthorVerifier) IsEmailVerifiedArgsForCall(i int) (context.Context, uuid.UUID) {
+	fake.isEmailVerifiedMutex.RLock()
+	defer fake.isEmailVerifiedMutex.RUnlock()
+	argsForCall := fake.isEmailVerifiedArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeAuthorVerifier) IsEmailVerifiedReturns(result1 bool, result2 error) {
+	fake.isEmailVerifiedMutex.Lock()
+	defer fake.isEmailVerifiedMutex.Unlock()
+	fake.IsEmailVerifiedStub = nil
+	fake.isEmailVerifiedReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAuthorVerifier) IsEmailVerifiedReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.isEmailVerifiedMutex.Lock()
+	defer fake.isEmailVerifiedMutex.Unlock()
+	fake.IsEmailVerifiedStub = nil
+	if fake.isEmailVerifiedReturnsOnCall == nil {
+		fake.isEmailVerifiedReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.isEmailVerifiedReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAuthorVerifier) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeAuthorVerifier) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ service.AuthorVerifier = new(FakeAuthorVerifier)
@@ -4,6 +4,7 @@ package servicefakes
 import (
 	"context"
 	"sync"
+	"time"
 
 	"github.com/fikryfahrezy/let-it-go/feature/blog/service"
 	"github.com/google/uuid"
@@ -24,6 +25,20 @@ type FakeBlogService struct {
 		result1 service.GetBlogResponse
 		result2 error
 	}
+	CloseCommentsStub        func(context.Context, uuid.UUID) (service.GetBlogResponse, error)
+	closeCommentsMutex       sync.RWMutex
+	closeCommentsArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}
+	closeCommentsReturns struct {
+		result1 service.GetBlogResponse
+		result2 error
+	}
+	closeCommentsReturnsOnCall map[int]struct {
+		result1 service.GetBlogResponse
+		result2 error
+	}
 	CreateBlogStub        func(context.Context, service.CreateBlogRequest) (service.GetBlogResponse, error)
 	createBlogMutex       sync.RWMutex
 	createBlogArgsForCall []struct {
@@ -64,6 +79,20 @@ type FakeBlogService struct {
 		result1 service.GetBlogResponse
 		result2 error
 	}
+	GetBlogBySlugStub        func(context.Context, string) (service.GetBlogResponse, error)
+	getBlogBySlugMutex       sync.RWMutex
+	getBlogBySlugArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	getBlogBySlugReturns struct {
+		result1 service.GetBlogResponse
+		result2 error
+	}
+	getBlogBySlugReturnsOnCall map[int]struct {
+		result1 service.GetBlogResponse
+		result2 error
+	}
 	GetBlogsByAuthorStub        func(context.Context, uuid.UUID, service.GetBlogsByAuthorRequest) ([]service.GetBlogResponse, int64, error)
 	getBlogsByAuthorMutex       sync.RWMutex
 	getBlogsByAuthorArgsForCall []struct {
@@ -98,6 +127,20 @@ type FakeBlogService struct {
 		result2 int64
 		result3 error
 	}
+	GetDueScheduledBlogsStub        func(context.Context, time.Time) ([]service.GetBlogResponse, error)
+	getDueScheduledBlogsMutex       sync.RWMutex
+	getDueScheduledBlogsArgsForCall []struct {
+		arg1 context.Context
+		arg2 time.Time
+	}
+	getDueScheduledBlogsReturns struct {
+		result1 []service.GetBlogResponse
+		result2 error
+	}
+	getDueScheduledBlogsReturnsOnCall map[int]struct {
+		result1 []service.GetBlogResponse
+		result2 error
+	}
 	ListBlogsStub        func(context.Context, service.ListBlogsRequest) ([]service.GetBlogResponse, int64, error)
 	listBlogsMutex       sync.RWMutex
 	listBlogsArgsForCall []struct {
@@ -114,6 +157,33 @@ type FakeBlogService struct {
 		result2 int64
 		result3 error
 	}
+	ListTagsStub        func(context.Context) ([]service.TagResponse, error)
+	listTagsMutex       sync.RWMutex
+	listTagsArgsForCall []struct {
+		arg1 context.Context
+	}
+	listTagsReturns struct {
+		result1 []service.TagResponse
+		result2 error
+	}
+	listTagsReturnsOnCall map[int]struct {
+		result1 []service.TagResponse
+		result2 error
+	}
+	OpenCommentsStub        func(context.Context, uuid.UUID) (service.GetBlogResponse, error)
+	openCommentsMutex       sync.RWMutex
+	openCommentsArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}
+	openCommentsReturns struct {
+		result1 service.GetBlogResponse
+		result2 error
+	}
+	openCommentsReturnsOnCall map[int]struct {
+		result1 service.GetBlogResponse
+		result2 error
+	}
 	PublishBlogStub        func(context.Context, uuid.UUID) (service.GetBlogResponse, error)
 	publishBlogMutex       sync.RWMutex
 	publishBlogArgsForCall []struct {
@@ -128,6 +198,37 @@ type FakeBlogService struct {
 		result1 service.GetBlogResponse
 		result2 error
 	}
+	ScheduleBlogStub        func(context.Context, uuid.UUID, service.ScheduleBlogRequest) (service.GetBlogResponse, error)
+	scheduleBlogMutex       sync.RWMutex
+	scheduleBlogArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 service.ScheduleBlogRequest
+	}
+	scheduleBlogReturns struct {
+		result1 service.GetBlogResponse
+		result2 error
+	}
+	scheduleBlogReturnsOnCall map[int]struct {
+		result1 service.GetBlogResponse
+		result2 error
+	}
+	SearchBlogsStub        func(context.Context, service.SearchBlogsRequest) ([]service.SearchBlogResponse, int64, error)
+	searchBlogsMutex       sync.RWMutex
+	searchBlogsArgsForCall []struct {
+		arg1 context.Context
+		arg2 service.SearchBlogsRequest
+	}
+	searchBlogsReturns struct {
+		result1 []service.SearchBlogResponse
+		result2 int64
+		result3 error
+	}
+	searchBlogsReturnsOnCall map[int]struct {
+		result1 []service.SearchBlogResponse
+		result2 int64
+		result3 error
+	}
 	UpdateBlogStub        func(context.Context, uuid.UUID, service.UpdateBlogRequest) (service.GetBlogResponse, error)
 	updateBlogMutex       sync.RWMutex
 	updateBlogArgsForCall []struct {
@@ -212,6 +313,71 @@ func (fake *FakeBlogService) ArchiveBlogReturnsOnCall(i int, result1 service.Get
 	}{result1, result2}
 }
 
+func (fake *FakeBlogService) CloseComments(arg1 context.Context, arg2 uuid.UUID) (service.GetBlogResponse, error) {
+	fake.closeCommentsMutex.Lock()
+	ret, specificReturn := fake.closeCommentsReturnsOnCall[len(fake.closeCommentsArgsForCall)]
+	fake.closeCommentsArgsForCall = append(fake.closeCommentsArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}{arg1, arg2})
+	stub := fake.CloseCommentsStub
+	fakeReturns := fake.closeCommentsReturns
+	fake.recordInvocation("CloseComments", []interface{}{arg1, arg2})
+	fake.closeCommentsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeBlogService) CloseCommentsCallCount() int {
+	fake.closeCommentsMutex.RLock()
+	defer fake.closeCommentsMutex.RUnlock()
+	return len(fake.closeCommentsArgsForCall)
+}
+
+func (fake *FakeBlogService) CloseCommentsCalls(stub func(context.Context, uuid.UUID) (service.GetBlogResponse, error)) {
+	fake.closeCommentsMutex.Lock()
+	defer fake.closeCommentsMutex.Unlock()
+	fake.CloseCommentsStub = stub
+}
+
+func (fake *FakeBlogService) CloseCommentsArgsForCall(i int) (context.Context, uuid.UUID) {
+	fake.closeCommentsMutex.RLock()
+	defer fake.closeCommentsMutex.RUnlock()
+	argsForCall := fake.closeCommentsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeBlogService) CloseCommentsReturns(result1 service.GetBlogResponse, result2 error) {
+	fake.closeCommentsMutex.Lock()
+	defer fake.closeCommentsMutex.Unlock()
+	fake.CloseCommentsStub = nil
+	fake.closeCommentsReturns = struct {
+		result1 service.GetBlogResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBlogService) CloseCommentsReturnsOnCall(i int, result1 service.GetBlogResponse, result2 error) {
+	fake.closeCommentsMutex.Lock()
+	defer fake.closeCommentsMutex.Unlock()
+	fake.CloseCommentsStub = nil
+	if fake.closeCommentsReturnsOnCall == nil {
+		fake.closeCommentsReturnsOnCall = make(map[int]struct {
+			result1 service.GetBlogResponse
+			result2 error
+		})
+	}
+	fake.closeCommentsReturnsOnCall[i] = struct {
+		result1 service.GetBlogResponse
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeBlogService) CreateBlog(arg1 context.Context, arg2 service.CreateBlogRequest) (service.GetBlogResponse, error) {
 	fake.createBlogMutex.Lock()
 	ret, specificReturn := fake.createBlogReturnsOnCall[len(fake.createBlogArgsForCall)]
@@ -404,6 +570,71 @@ func (fake *FakeBlogService) GetBlogByIDReturnsOnCall(i int, result1 service.Get
 	}{result1, result2}
 }
 
+func (fake *FakeBlogService) GetBlogBySlug(arg1 context.Context, arg2 string) (service.GetBlogResponse, error) {
+	fake.getBlogBySlugMutex.Lock()
+	ret, specificReturn := fake.getBlogBySlugReturnsOnCall[len(fake.getBlogBySlugArgsForCall)]
+	fake.getBlogBySlugArgsForCall = append(fake.getBlogBySlugArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.GetBlogBySlugStub
+	fakeReturns := fake.getBlogBySlugReturns
+	fake.recordInvocation("GetBlogBySlug", []interface{}{arg1, arg2})
+	fake.getBlogBySlugMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeBlogService) GetBlogBySlugCallCount() int {
+	fake.getBlogBySlugMutex.RLock()
+	defer fake.getBlogBySlugMutex.RUnlock()
+	return len(fake.getBlogBySlugArgsForCall)
+}
+
+func (fake *FakeBlogService) GetBlogBySlugCalls(stub func(context.Context, string) (service.GetBlogResponse, error)) {
+	fake.getBlogBySlugMutex.Lock()
+	defer fake.getBlogBySlugMutex.Unlock()
+	fake.GetBlogBySlugStub = stub
+}
+
+func (fake *FakeBlogService) GetBlogBySlugArgsForCall(i int) (context.Context, string) {
+	fake.getBlogBySlugMutex.RLock()
+	defer fake.getBlogBySlugMutex.RUnlock()
+	argsForCall := fake.getBlogBySlugArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeBlogService) GetBlogBySlugReturns(result1 service.GetBlogResponse, result2 error) {
+	fake.getBlogBySlugMutex.Lock()
+	defer fake.getBlogBySlugMutex.Unlock()
+	fake.GetBlogBySlugStub = nil
+	fake.getBlogBySlugReturns = struct {
+		result1 service.GetBlogResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBlogService) GetBlogBySlugReturnsOnCall(i int, result1 service.GetBlogResponse, result2 error) {
+	fake.getBlogBySlugMutex.Lock()
+	defer fake.getBlogBySlugMutex.Unlock()
+	fake.GetBlogBySlugStub = nil
+	if fake.getBlogBySlugReturnsOnCall == nil {
+		fake.getBlogBySlugReturnsOnCall = make(map[int]struct {
+			result1 service.GetBlogResponse
+			result2 error
+		})
+	}
+	fake.getBlogBySlugReturnsOnCall[i] = struct {
+		result1 service.GetBlogResponse
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeBlogService) GetBlogsByAuthor(arg1 context.Context, arg2 uuid.UUID, arg3 service.GetBlogsByAuthorRequest) ([]service.GetBlogResponse, int64, error) {
 	fake.getBlogsByAuthorMutex.Lock()
 	ret, specificReturn := fake.getBlogsByAuthorReturnsOnCall[len(fake.getBlogsByAuthorArgsForCall)]
@@ -542,6 +773,71 @@ func (fake *FakeBlogService) GetBlogsByStatusReturnsOnCall(i int, result1 []serv
 	}{result1, result2, result3}
 }
 
+func (fake *FakeBlogService) GetDueScheduledBlogs(arg1 context.Context, arg2 time.Time) ([]service.GetBlogResponse, error) {
+	fake.getDueScheduledBlogsMutex.Lock()
+	ret, specificReturn := fake.getDueScheduledBlogsReturnsOnCall[len(fake.getDueScheduledBlogsArgsForCall)]
+	fake.getDueScheduledBlogsArgsForCall = append(fake.getDueScheduledBlogsArgsForCall, struct {
+		arg1 context.Context
+		arg2 time.Time
+	}{arg1, arg2})
+	stub := fake.GetDueScheduledBlogsStub
+	fakeReturns := fake.getDueScheduledBlogsReturns
+	fake.recordInvocation("GetDueScheduledBlogs", []interface{}{arg1, arg2})
+	fake.getDueScheduledBlogsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeBlogService) GetDueScheduledBlogsCallCount() int {
+	fake.getDueScheduledBlogsMutex.RLock()
+	defer fake.getDueScheduledBlogsMutex.RUnlock()
+	return len(fake.getDueScheduledBlogsArgsForCall)
+}
+
+func (fake *FakeBlogService) GetDueScheduledBlogsCalls(stub func(context.Context, time.Time) ([]service.GetBlogResponse, error)) {
+	fake.getDueScheduledBlogsMutex.Lock()
+	defer fake.getDueScheduledBlogsMutex.Unlock()
+	fake.GetDueScheduledBlogsStub = stub
+}
+
+func (fake *FakeBlogService) GetDueScheduledBlogsArgsForCall(i int) (context.Context, time.Time) {
+	fake.getDueScheduledBlogsMutex.RLock()
+	defer fake.getDueScheduledBlogsMutex.RUnlock()
+	argsForCall := fake.getDueScheduledBlogsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeBlogService) GetDueScheduledBlogsReturns(result1 []service.GetBlogResponse, result2 error) {
+	fake.getDueScheduledBlogsMutex.Lock()
+	defer fake.getDueScheduledBlogsMutex.Unlock()
+	fake.GetDueScheduledBlogsStub = nil
+	fake.getDueScheduledBlogsReturns = struct {
+		result1 []service.GetBlogResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBlogService) GetDueScheduledBlogsReturnsOnCall(i int, result1 []service.GetBlogResponse, result2 error) {
+	fake.getDueScheduledBlogsMutex.Lock()
+	defer fake.getDueScheduledBlogsMutex.Unlock()
+	fake.GetDueScheduledBlogsStub = nil
+	if fake.getDueScheduledBlogsReturnsOnCall == nil {
+		fake.getDueScheduledBlogsReturnsOnCall = make(map[int]struct {
+			result1 []service.GetBlogResponse
+			result2 error
+		})
+	}
+	fake.getDueScheduledBlogsReturnsOnCall[i] = struct {
+		result1 []service.GetBlogResponse
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeBlogService) ListBlogs(arg1 context.Context, arg2 service.ListBlogsRequest) ([]service.GetBlogResponse, int64, error) {
 	fake.listBlogsMutex.Lock()
 	ret, specificReturn := fake.listBlogsReturnsOnCall[len(fake.listBlogsArgsForCall)]
@@ -610,6 +906,135 @@ func (fake *FakeBlogService) ListBlogsReturnsOnCall(i int, result1 []service.Get
 	}{result1, result2, result3}
 }
 
+func (fake *FakeBlogService) ListTags(arg1 context.Context) ([]service.TagResponse, error) {
+	fake.listTagsMutex.Lock()
+	ret, specificReturn := fake.listTagsReturnsOnCall[len(fake.listTagsArgsForCall)]
+	fake.listTagsArgsForCall = append(fake.listTagsArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	stub := fake.ListTagsStub
+	fakeReturns := fake.listTagsReturns
+	fake.recordInvocation("ListTags", []interface{}{arg1})
+	fake.listTagsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeBlogService) ListTagsCallCount() int {
+	fake.listTagsMutex.RLock()
+	defer fake.listTagsMutex.RUnlock()
+	return len(fake.listTagsArgsForCall)
+}
+
+func (fake *FakeBlogService) ListTagsCalls(stub func(context.Context) ([]service.TagResponse, error)) {
+	fake.listTagsMutex.Lock()
+	defer fake.listTagsMutex.Unlock()
+	fake.ListTagsStub = stub
+}
+
+func (fake *FakeBlogService) ListTagsArgsForCall(i int) context.Context {
+	fake.listTagsMutex.RLock()
+	defer fake.listTagsMutex.RUnlock()
+	argsForCall := fake.listTagsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeBlogService) ListTagsReturns(result1 []service.TagResponse, result2 error) {
+	fake.listTagsMutex.Lock()
+	defer fake.listTagsMutex.Unlock()
+	fake.ListTagsStub = nil
+	fake.listTagsReturns = struct {
+		result1 []service.TagResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBlogService) ListTagsReturnsOnCall(i int, result1 []service.TagResponse, result2 error) {
+	fake.listTagsMutex.Lock()
+	defer fake.listTagsMutex.Unlock()
+	fake.ListTagsStub = nil
+	if fake.listTagsReturnsOnCall == nil {
+		fake.listTagsReturnsOnCall = make(map[int]struct {
+			result1 []service.TagResponse
+			result2 error
+		})
+	}
+	fake.listTagsReturnsOnCall[i] = struct {
+		result1 []service.TagResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBlogService) OpenComments(arg1 context.Context, arg2 uuid.UUID) (service.GetBlogResponse, error) {
+	fake.openCommentsMutex.Lock()
+	ret, specificReturn := fake.openCommentsReturnsOnCall[len(fake.openCommentsArgsForCall)]
+	fake.openCommentsArgsForCall = append(fake.openCommentsArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}{arg1, arg2})
+	stub := fake.OpenCommentsStub
+	fakeReturns := fake.openCommentsReturns
+	fake.recordInvocation("OpenComments", []interface{}{arg1, arg2})
+	fake.openCommentsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeBlogService) OpenCommentsCallCount() int {
+	fake.openCommentsMutex.RLock()
+	defer fake.openCommentsMutex.RUnlock()
+	return len(fake.openCommentsArgsForCall)
+}
+
+func (fake *FakeBlogService) OpenCommentsCalls(stub func(context.Context, uuid.UUID) (service.GetBlogResponse, error)) {
+	fake.openCommentsMutex.Lock()
+	defer fake.openCommentsMutex.Unlock()
+	fake.OpenCommentsStub = stub
+}
+
+func (fake *FakeBlogService) OpenCommentsArgsForCall(i int) (context.Context, uuid.UUID) {
+	fake.openCommentsMutex.RLock()
+	defer fake.openCommentsMutex.RUnlock()
+	argsForCall := fake.openCommentsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeBlogService) OpenCommentsReturns(result1 service.GetBlogResponse, result2 error) {
+	fake.openCommentsMutex.Lock()
+	defer fake.openCommentsMutex.Unlock()
+	fake.OpenCommentsStub = nil
+	fake.openCommentsReturns = struct {
+		result1 service.GetBlogResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBlogService) OpenCommentsReturnsOnCall(i int, result1 service.GetBlogResponse, result2 error) {
+	fake.openCommentsMutex.Lock()
+	defer fake.openCommentsMutex.Unlock()
+	fake.OpenCommentsStub = nil
+	if fake.openCommentsReturnsOnCall == nil {
+		fake.openCommentsReturnsOnCall = make(map[int]struct {
+			result1 service.GetBlogResponse
+			result2 error
+		})
+	}
+	fake.openCommentsReturnsOnCall[i] = struct {
+		result1 service.GetBlogResponse
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeBlogService) PublishBlog(arg1 context.Context, arg2 uuid.UUID) (service.GetBlogResponse, error) {
 	fake.publishBlogMutex.Lock()
 	ret, specificReturn := fake.publishBlogReturnsOnCall[len(fake.publishBlogArgsForCall)]
@@ -675,6 +1100,140 @@ func (fake *FakeBlogService) PublishBlogReturnsOnCall(i int, result1 service.Get
 	}{result1, result2}
 }
 
+func (fake *FakeBlogService) ScheduleBlog(arg1 context.Context, arg2 uuid.UUID, arg3 service.ScheduleBlogRequest) (service.GetBlogResponse, error) {
+	fake.scheduleBlogMutex.Lock()
+	ret, specificReturn := fake.scheduleBlogReturnsOnCall[len(fake.scheduleBlogArgsForCall)]
+	fake.scheduleBlogArgsForCall = append(fake.scheduleBlogArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 service.ScheduleBlogRequest
+	}{arg1, arg2, arg3})
+	stub := fake.ScheduleBlogStub
+	fakeReturns := fake.scheduleBlogReturns
+	fake.recordInvocation("ScheduleBlog", []interface{}{arg1, arg2, arg3})
+	fake.scheduleBlogMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeBlogService) ScheduleBlogCallCount() int {
+	fake.scheduleBlogMutex.RLock()
+	defer fake.scheduleBlogMutex.RUnlock()
+	return len(fake.scheduleBlogArgsForCall)
+}
+
+func (fake *FakeBlogService) ScheduleBlogCalls(stub func(context.Context, uuid.UUID, service.ScheduleBlogRequest) (service.GetBlogResponse, error)) {
+	fake.scheduleBlogMutex.Lock()
+	defer fake.scheduleBlogMutex.Unlock()
+	fake.ScheduleBlogStub = stub
+}
+
+func (fake *FakeBlogService) ScheduleBlogArgsForCall(i int) (context.Context, uuid.UUID, service.ScheduleBlogRequest) {
+	fake.scheduleBlogMutex.RLock()
+	defer fake.scheduleBlogMutex.RUnlock()
+	argsForCall := fake.scheduleBlogArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeBlogService) ScheduleBlogReturns(result1 service.GetBlogResponse, result2 error) {
+	fake.scheduleBlogMutex.Lock()
+	defer fake.scheduleBlogMutex.Unlock()
+	fake.ScheduleBlogStub = nil
+	fake.scheduleBlogReturns = struct {
+		result1 service.GetBlogResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBlogService) ScheduleBlogReturnsOnCall(i int, result1 service.GetBlogResponse, result2 error) {
+	fake.scheduleBlogMutex.Lock()
+	defer fake.scheduleBlogMutex.Unlock()
+	fake.ScheduleBlogStub = nil
+	if fake.scheduleBlogReturnsOnCall == nil {
+		fake.scheduleBlogReturnsOnCall = make(map[int]struct {
+			result1 service.GetBlogResponse
+			result2 error
+		})
+	}
+	fake.scheduleBlogReturnsOnCall[i] = struct {
+		result1 service.GetBlogResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBlogService) SearchBlogs(arg1 context.Context, arg2 service.SearchBlogsRequest) ([]service.SearchBlogResponse, int64, error) {
+	fake.searchBlogsMutex.Lock()
+	ret, specificReturn := fake.searchBlogsReturnsOnCall[len(fake.searchBlogsArgsForCall)]
+	fake.searchBlogsArgsForCall = append(fake.searchBlogsArgsForCall, struct {
+		arg1 context.Context
+		arg2 service.SearchBlogsRequest
+	}{arg1, arg2})
+	stub := fake.SearchBlogsStub
+	fakeReturns := fake.searchBlogsReturns
+	fake.recordInvocation("SearchBlogs", []interface{}{arg1, arg2})
+	fake.searchBlogsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeBlogService) SearchBlogsCallCount() int {
+	fake.searchBlogsMutex.RLock()
+	defer fake.searchBlogsMutex.RUnlock()
+	return len(fake.searchBlogsArgsForCall)
+}
+
+func (fake *FakeBlogService) SearchBlogsCalls(stub func(context.Context, service.SearchBlogsRequest) ([]service.SearchBlogResponse, int64, error)) {
+	fake.searchBlogsMutex.Lock()
+	defer fake.searchBlogsMutex.Unlock()
+	fake.SearchBlogsStub = stub
+}
+
+func (fake *FakeBlogService) SearchBlogsArgsForCall(i int) (context.Context, service.SearchBlogsRequest) {
+	fake.searchBlogsMutex.RLock()
+	defer fake.searchBlogsMutex.RUnlock()
+	argsForCall := fake.searchBlogsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeBlogService) SearchBlogsReturns(result1 []service.SearchBlogResponse, result2 int64, result3 error) {
+	fake.searchBlogsMutex.Lock()
+	defer fake.searchBlogsMutex.Unlock()
+	fake.SearchBlogsStub = nil
+	fake.searchBlogsReturns = struct {
+		result1 []service.SearchBlogResponse
+		result2 int64
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeBlogService) SearchBlogsReturnsOnCall(i int, result1 []service.SearchBlogResponse, result2 int64, result3 error) {
+	fake.searchBlogsMutex.Lock()
+	defer fake.searchBlogsMutex.Unlock()
+	fake.SearchBlogsStub = nil
+	if fake.searchBlogsReturnsOnCall == nil {
+		fake.searchBlogsReturnsOnCall = make(map[int]struct {
+			result1 []service.SearchBlogResponse
+			result2 int64
+			result3 error
+		})
+	}
+	fake.searchBlogsReturnsOnCall[i] = struct {
+		result1 []service.SearchBlogResponse
+		result2 int64
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeBlogService) UpdateBlog(arg1 context.Context, arg2 uuid.UUID, arg3 service.UpdateBlogRequest) (service.GetBlogResponse, error) {
 	fake.updateBlogMutex.Lock()
 	ret, specificReturn := fake.updateBlogReturnsOnCall[len(fake.updateBlogArgsForCall)]
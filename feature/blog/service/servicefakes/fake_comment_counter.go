@@ -0,0 +1,123 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package servicefakes
+
+import (
+	"context"
+	"sync"
+
+	"github.com/fikryfahrezy/let-it-go/feature/blog/service"
+	"github.com/google/uuid"
+)
+
+type FakeCommentCounter struct {
+	CountApprovedByBlogIDsStub        func(context.Context, []uuid.UUID) (map[uuid.UUID]int64, error)
+	countApprovedByBlogIDsMutex       sync.RWMutex
+	countApprovedByBlogIDsArgsForCall []struct {
+		arg1 context.Context
+		arg2 []uuid.UUID
+	}
+	countApprovedByBlogIDsReturns struct {
+		result1 map[uuid.UUID]int64
+		result2 error
+	}
+	countApprovedByBlogIDsReturnsOnCall map[int]struct {
+		result1 map[uuid.UUID]int64
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeCommentCounter) CountApprovedByBlogIDs(arg1 context.Context, arg2 []uuid.UUID) (map[uuid.UUID]int64, error) {
+	var arg2Copy []uuid.UUID
+	if arg2 != nil {
+		arg2Copy = make([]uuid.UUID, len(arg2))
+		copy(arg2Copy, arg2)
+	}
+	fake.countApprovedByBlogIDsMutex.Lock()
+	ret, specificReturn := fake.countApprovedByBlogIDsReturnsOnCall[len(fake.countApprovedByBlogIDsArgsForCall)]
+	fake.countApprovedByBlogIDsArgsForCall = append(fake.countApprovedByBlogIDsArgsForCall, struct {
+		arg1 context.Context
+		arg2 []uuid.UUID
+	}{arg1, arg2Copy})
+	stub := fake.CountApprovedByBlogIDsStub
+	fakeReturns := fake.countApprovedByBlogIDsReturns
+	fake.recordInvocation("CountApprovedByBlogIDs", []interface{}{arg1, arg2Copy})
+	fake.countApprovedByBlogIDsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeCommentCounter) CountApprovedByBlogIDsCallCount() int {
+	fake.countApprovedByBlogIDsMutex.RLock()
+	defer fake.countApprovedByBlogIDsMutex.RUnlock()
+	return len(fake.countApprovedByBlogIDsArgsForCall)
+}
+
+func (fake *FakeCommentCounter) CountApprovedByBlogIDsCalls(stub func(context.Context, []uuid.UUID) (map[uuid.UUID]int64, error)) {
+	fake.countApprovedByBlogIDsMutex.Lock()
+	defer fake.countApprovedByBlogIDsMutex.Unlock()
+	fake.CountApprovedByBlogIDsStub = stub
+}
+
+func (fake *FakeCommentCounter) CountApprovedByBlogIDsArgsForCall(i int) (context.Context, []uuid.UUID) {
+	fake.countApprovedByBlogIDsMutex.RLock()
+	defer fake.countApprovedByBlogIDsMutex.RUnlock()
+	argsForCall := fake.countApprovedByBlogIDsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeCommentCounter) CountApprovedByBlogIDsReturns(result1 map[uuid.UUID]int64, result2 error) {
+	fake.countApprovedByBlogIDsMutex.Lock()
+	defer fake.countApprovedByBlogIDsMutex.Unlock()
+	fake.CountApprovedByBlogIDsStub = nil
+	fake.countApprovedByBlogIDsReturns = struct {
+		result1 map[uuid.UUID]int64
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCommentCounter) CountApprovedByBlogIDsReturnsOnCall(i int, result1 map[uuid.UUID]int64, result2 error) {
+	fake.countApprovedByBlogIDsMutex.Lock()
+	defer fake.countApprovedByBlogIDsMutex.Unlock()
+	fake.CountApprovedByBlogIDsStub = nil
+	if fake.countApprovedByBlogIDsReturnsOnCall == nil {
+		fake.countApprovedByBlogIDsReturnsOnCall = make(map[int]struct {
+			result1 map[uuid.UUID]int64
+			result2 error
+		})
+	}
+	fake.countApprovedByBlogIDsReturnsOnCall[i] = struct {
+		result1 map[uuid.UUID]int64
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCommentCounter) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeCommentCounter) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ service.CommentCounter = new(FakeCommentCounter)
@@ -0,0 +1,39 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/google/uuid"
+)
+
+// CloseComments stops new comments and replies on a blog. Its comments stay visible.
+func (s *blogService) CloseComments(ctx context.Context, id uuid.UUID) (GetBlogResponse, error) {
+	return s.setCommentsClosed(ctx, id, true)
+}
+
+// OpenComments lets readers comment on a blog again
+func (s *blogService) OpenComments(ctx context.Context, id uuid.UUID) (GetBlogResponse, error) {
+	return s.setCommentsClosed(ctx, id, false)
+}
+
+func (s *blogService) setCommentsClosed(ctx context.Context, id uuid.UUID, closed bool) (GetBlogResponse, error) {
+	blog, err := s.getOwnedBlog(ctx, id)
+	if err != nil {
+		return GetBlogResponse{}, err
+	}
+
+	if blog.CommentsClosed != closed {
+		if err := s.blogRepo.SetCommentsClosed(ctx, id, closed); err != nil {
+			return GetBlogResponse{}, err
+		}
+		blog.CommentsClosed = closed
+
+		s.log.Info("Blog comments closed changed",
+			slog.String("blog_id", id.String()),
+			slog.Bool("comments_closed", closed),
+		)
+	}
+
+	return s.withCommentCount(ctx, blog)
+}
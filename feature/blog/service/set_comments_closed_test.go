@@ -0,0 +1,104 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/fikryfahrezy/let-it-go/feature/blog/repository"
+	"github.com/fikryfahrezy/let-it-go/feature/blog/repository/repositoryfakes"
+	"github.com/fikryfahrezy/let-it-go/feature/blog/service"
+	"github.com/fikryfahrezy/let-it-go/pkg/http_server"
+	"github.com/fikryfahrezy/let-it-go/pkg/logger"
+	"github.com/fikryfahrezy/let-it-go/pkg/rbac"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBlogService_CloseComments_OwnPublishedBlog(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeBlogRepository{}
+	blogService := service.NewBlogService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{}, service.Config{})
+
+	authorID := uuid.New()
+	ctx := http_server.ContextWithPrincipal(context.Background(), http_server.Principal{UserID: authorID, Roles: []string{rbac.RoleAuthor}})
+	blog := repository.Blog{ID: uuid.New(), AuthorID: authorID, Status: repository.StatusPublished}
+	mockRepo.GetByIDReturns(blog, nil)
+
+	// Authors close comments on their blogs even after they are published
+	result, err := blogService.CloseComments(ctx, blog.ID)
+	require.NoError(t, err)
+	assert.True(t, result.CommentsClosed)
+
+	require.Equal(t, 1, mockRepo.SetCommentsClosedCallCount())
+	_, actualID, closed := mockRepo.SetCommentsClosedArgsForCall(0)
+	assert.Equal(t, blog.ID, actualID)
+	assert.True(t, closed)
+}
+
+func TestBlogService_OpenComments_Manager(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeBlogRepository{}
+	blogService := service.NewBlogService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{}, service.Config{})
+
+	ctx := http_server.ContextWithPrincipal(context.Background(), http_server.Principal{UserID: uuid.New(), Roles: []string{rbac.RoleAdmin}})
+	mockRepo.GetByIDReturns(repository.Blog{ID: uuid.New(), AuthorID: uuid.New(), CommentsClosed: true}, nil)
+
+	result, err := blogService.OpenComments(ctx, uuid.New())
+	require.NoError(t, err)
+	assert.False(t, result.CommentsClosed)
+
+	_, _, closed := mockRepo.SetCommentsClosedArgsForCall(0)
+	assert.False(t, closed)
+}
+
+func TestBlogService_CloseComments_AlreadyClosed(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeBlogRepository{}
+	blogService := service.NewBlogService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{}, service.Config{})
+
+	authorID := uuid.New()
+	ctx := http_server.ContextWithPrincipal(context.Background(), http_server.Principal{UserID: authorID, Roles: []string{rbac.RoleAuthor}})
+	mockRepo.GetByIDReturns(repository.Blog{ID: uuid.New(), AuthorID: authorID, CommentsClosed: true}, nil)
+
+	result, err := blogService.CloseComments(ctx, uuid.New())
+
+	require.NoError(t, err)
+	assert.True(t, result.CommentsClosed)
+	assert.Equal(t, 0, mockRepo.SetCommentsClosedCallCount())
+}
+
+func TestBlogService_CloseComments_NotOwner(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeBlogRepository{}
+	blogService := service.NewBlogService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{}, service.Config{})
+
+	// Editors publish any blog but only close comments on their own
+	ctx := http_server.ContextWithPrincipal(context.Background(), http_server.Principal{UserID: uuid.New(), Roles: []string{rbac.RoleEditor}})
+	mockRepo.GetByIDReturns(repository.Blog{ID: uuid.New(), AuthorID: uuid.New()}, nil)
+
+	_, err := blogService.CloseComments(ctx, uuid.New())
+
+	assert.Equal(t, service.ErrBlogForbidden, err)
+	assert.Equal(t, 0, mockRepo.SetCommentsClosedCallCount())
+}
+
+func TestBlogService_CloseComments_Reader(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeBlogRepository{}
+	blogService := service.NewBlogService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{}, service.Config{})
+
+	ctx := http_server.ContextWithPrincipal(context.Background(), http_server.Principal{UserID: uuid.New(), Roles: []string{rbac.RoleReader}})
+
+	_, err := blogService.CloseComments(ctx, uuid.New())
+
+	assert.Equal(t, service.ErrBlogForbidden, err)
+	assert.Equal(t, 0, mockRepo.GetByIDCallCount())
+}
+
+func TestBlogService_CloseComments_NotFound(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeBlogRepository{}
+	blogService := service.NewBlogService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{}, service.Config{})
+
+	ctx := http_server.ContextWithPrincipal(context.Background(), http_server.Principal{UserID: uuid.New(), Roles: []string{rbac.RoleAuthor}})
+	mockRepo.GetByIDReturns(repository.Blog{}, repository.ErrBlogNotFound)
+
+	_, err := blogService.CloseComments(ctx, uuid.New())
+
+	assert.Equal(t, repository.ErrBlogNotFound, err)
+}
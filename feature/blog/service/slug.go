@@ -0,0 +1,39 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/fikryfahrezy/let-it-go/feature/blog/repository"
+	"github.com/google/uuid"
+)
+
+// maxSlugAttempts bounds how many candidates are tried before a slug collision is returned
+const maxSlugAttempts = 6
+
+// slugCandidate returns the slug to try on the given attempt: the base slug first, then
+// numbered suffixes, and finally random suffixes that are unlikely to be taken
+func slugCandidate(base string, attempt int) string {
+	switch {
+	case attempt == 0:
+		return base
+	case attempt < maxSlugAttempts-2:
+		return fmt.Sprintf("%s-%d", base, attempt+1)
+	default:
+		return fmt.Sprintf("%s-%s", base, uuid.NewString()[:8])
+	}
+}
+
+// withUniqueSlug calls save with slug candidates derived from base until one is not taken
+// and returns the slug that was saved
+func withUniqueSlug(base string, save func(slug string) error) (string, error) {
+	var err error
+	for attempt := range maxSlugAttempts {
+		candidate := slugCandidate(base, attempt)
+		err = save(candidate)
+		if !errors.Is(err, repository.ErrSlugAlreadyTaken) {
+			return candidate, err
+		}
+	}
+	return "", err
+}
@@ -0,0 +1,38 @@
+package service
+
+import "github.com/fikryfahrezy/let-it-go/feature/blog/repository"
+
+// TagResponse is a tag with the number of blogs using it
+type TagResponse struct {
+	Name      string `json:"name"`
+	BlogCount int64  `json:"blog_count"`
+}
+
+func TagUsagesToResponses(tags []repository.TagUsage) []TagResponse {
+	responses := make([]TagResponse, len(tags))
+	for i, tag := range tags {
+		responses[i] = TagResponse{
+			Name:      tag.Name,
+			BlogCount: tag.BlogCount,
+		}
+	}
+	return responses
+}
+
+// uniqueTags drops repeated tags, keeping the first occurrence. A nil list stays nil.
+func uniqueTags(tags []string) []string {
+	if tags == nil {
+		return nil
+	}
+
+	seen := make(map[string]struct{}, len(tags))
+	unique := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		if _, ok := seen[tag]; ok {
+			continue
+		}
+		seen[tag] = struct{}{}
+		unique = append(unique, tag)
+	}
+	return unique
+}
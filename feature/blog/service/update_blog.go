@@ -3,20 +3,56 @@ package service
 import (
 	"context"
 
+	"github.com/fikryfahrezy/let-it-go/feature/blog/repository"
+	"github.com/fikryfahrezy/let-it-go/pkg/rbac"
+	"github.com/fikryfahrezy/let-it-go/pkg/slug"
 	"github.com/google/uuid"
 )
 
 func (s *blogService) UpdateBlog(ctx context.Context, id uuid.UUID, req UpdateBlogRequest) (GetBlogResponse, error) {
-	blog, err := s.blogRepo.GetByID(ctx, id)
+	blog, err := s.getEditableBlog(ctx, id)
 	if err != nil {
 		return GetBlogResponse{}, err
 	}
 
-	req.ApplyToEntity(blog)
+	// Changing the status is publishing or archiving, which writers may not do
+	if req.Status != "" && req.Status != blog.Status {
+		if _, err := callerWith(ctx, rbac.PermissionBlogsPublish); err != nil {
+			return GetBlogResponse{}, err
+		}
+
+		// Publishing through an update needs a verified author, like PublishBlog does
+		if req.Status == repository.StatusPublished {
+			if err := s.requireVerifiedAuthor(ctx, blog.AuthorID); err != nil {
+				return GetBlogResponse{}, err
+			}
+		}
+	}
+
+	oldTitle := blog.Title
+	req.ApplyToEntity(&blog)
+
+	// A new title gets a new slug, the old one keeps redirecting to the blog. The slug is
+	// changed first: it is the write that fails on conflicts, and failing it must leave the
+	// rest of the blog untouched.
+	if base := slug.Make(blog.Title); base != slug.Make(oldTitle) {
+		blog.Slug, err = withUniqueSlug(base, func(candidate string) error {
+			return s.blogRepo.ChangeSlug(ctx, blog.ID, candidate)
+		})
+		if err != nil {
+			return GetBlogResponse{}, err
+		}
+	}
 
 	if err := s.blogRepo.Update(ctx, blog); err != nil {
 		return GetBlogResponse{}, err
 	}
 
-	return BlogEntityToGetResponse(blog), nil
+	if req.Tags != nil {
+		if err := s.blogRepo.SetTags(ctx, blog.ID, blog.Tags); err != nil {
+			return GetBlogResponse{}, err
+		}
+	}
+
+	return s.withCommentCount(ctx, blog)
 }
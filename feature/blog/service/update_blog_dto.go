@@ -10,15 +10,20 @@ type UpdateBlogRequest struct {
 	Title   string `json:"title,omitempty" validate:"omitempty,min=3,max=200"`
 	Content string `json:"content,omitempty" validate:"omitempty,min=10"`
 	Status  string `json:"status,omitempty" validate:"omitempty,oneof=draft published archived"`
+	// Tags replace the blog's tags when present, an empty list removes them all
+	Tags []string `json:"tags,omitempty" validate:"omitempty,max=10,dive,max=50,slug"`
 }
 
-func (req UpdateBlogRequest) ApplyToEntity(blog repository.Blog) {
+func (req UpdateBlogRequest) ApplyToEntity(blog *repository.Blog) {
 	if req.Title != "" {
 		blog.Title = req.Title
 	}
 	if req.Content != "" {
 		blog.Content = req.Content
 	}
+	if req.Tags != nil {
+		blog.Tags = uniqueTags(req.Tags)
+	}
 	if req.Status != "" {
 		// Handle status changes
 		oldStatus := blog.Status
@@ -34,5 +39,10 @@ func (req UpdateBlogRequest) ApplyToEntity(blog repository.Blog) {
 		if req.Status != repository.StatusPublished && oldStatus == repository.StatusPublished {
 			blog.PublishedAt = nil
 		}
+
+		// Unscheduled blogs are no longer published by the cron job
+		if req.Status != oldStatus && oldStatus == repository.StatusScheduled {
+			blog.ScheduledAt = nil
+		}
 	}
 }
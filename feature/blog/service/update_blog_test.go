@@ -8,18 +8,21 @@ import (
 	"github.com/fikryfahrezy/let-it-go/feature/blog/repository"
 	"github.com/fikryfahrezy/let-it-go/feature/blog/repository/repositoryfakes"
 	"github.com/fikryfahrezy/let-it-go/feature/blog/service"
+	"github.com/fikryfahrezy/let-it-go/pkg/http_server"
 	"github.com/fikryfahrezy/let-it-go/pkg/logger"
+	"github.com/fikryfahrezy/let-it-go/pkg/rbac"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestBlogService_UpdateBlog_Success(t *testing.T) {
 	mockRepo := &repositoryfakes.FakeBlogRepository{}
-	blogService := service.NewBlogService(logger.NewDiscardLogger(), mockRepo)
-	ctx := context.Background()
-
+	blogService := service.NewBlogService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{}, service.Config{})
 	blogID := uuid.New()
 	authorID := uuid.New()
+	// Editors may change the status of their drafts while updating them
+	ctx := http_server.ContextWithPrincipal(context.Background(), http_server.Principal{UserID: authorID, Roles: []string{rbac.RoleEditor}})
 	existingBlog := repository.Blog{
 		ID:        blogID,
 		Title:     "Old Title",
@@ -43,23 +46,31 @@ func TestBlogService_UpdateBlog_Success(t *testing.T) {
 
 	assert.NoError(t, err)
 	assert.Equal(t, blogID, result.ID)
-	// Note: Current service implementation has a design issue - the update request
-	// modifies a copy of the blog entity, so changes aren't reflected in the response
-	assert.Equal(t, existingBlog.Title, result.Title)     // Shows the current bug
-	assert.Equal(t, existingBlog.Content, result.Content) // Shows the current bug
-	assert.Equal(t, existingBlog.Status, result.Status)   // Shows the current bug
+	assert.Equal(t, req.Title, result.Title)
+	assert.Equal(t, req.Content, result.Content)
+	assert.Equal(t, req.Status, result.Status)
+	assert.NotNil(t, result.PublishedAt)
 	assert.Equal(t, existingBlog.CreatedAt, result.CreatedAt)
 
 	// Verify repository calls
 	assert.Equal(t, 1, mockRepo.GetByIDCallCount())
 	assert.Equal(t, 1, mockRepo.UpdateCallCount())
-	// The repository would receive the unmodified blog entity due to the design issue
+	_, updatedBlog := mockRepo.UpdateArgsForCall(0)
+	assert.Equal(t, req.Title, updatedBlog.Title)
+	assert.Equal(t, req.Content, updatedBlog.Content)
+
+	// The new title gets a new slug
+	assert.Equal(t, 1, mockRepo.ChangeSlugCallCount())
+	_, actualID, actualSlug := mockRepo.ChangeSlugArgsForCall(0)
+	assert.Equal(t, blogID, actualID)
+	assert.Equal(t, "new-title", actualSlug)
+	assert.Equal(t, "new-title", result.Slug)
 }
 
 func TestBlogService_UpdateBlog_NotFound(t *testing.T) {
 	mockRepo := &repositoryfakes.FakeBlogRepository{}
-	blogService := service.NewBlogService(logger.NewDiscardLogger(), mockRepo)
-	ctx := context.Background()
+	blogService := service.NewBlogService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{}, service.Config{})
+	ctx := http_server.ContextWithPrincipal(context.Background(), http_server.Principal{UserID: uuid.New(), Roles: []string{rbac.RoleAuthor}})
 
 	blogID := uuid.New()
 	mockRepo.GetByIDReturns(repository.Blog{}, repository.ErrBlogNotFound)
@@ -80,3 +91,228 @@ func TestBlogService_UpdateBlog_NotFound(t *testing.T) {
 	assert.Equal(t, 1, mockRepo.GetByIDCallCount())
 	assert.Equal(t, 0, mockRepo.UpdateCallCount()) // Update should not be called
 }
+
+func TestBlogService_UpdateBlog_NotOwner(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeBlogRepository{}
+	blogService := service.NewBlogService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{}, service.Config{})
+	ctx := http_server.ContextWithPrincipal(context.Background(), http_server.Principal{UserID: uuid.New(), Roles: []string{rbac.RoleAuthor}})
+
+	blogID := uuid.New()
+	mockRepo.GetByIDReturns(repository.Blog{
+		ID:       blogID,
+		Title:    "Someone else's blog",
+		AuthorID: uuid.New(),
+		Status:   "draft",
+	}, nil)
+
+	req := service.UpdateBlogRequest{
+		Title: "Hijacked Title",
+	}
+
+	result, err := blogService.UpdateBlog(ctx, blogID, req)
+
+	assert.Error(t, err)
+	assert.Equal(t, service.ErrBlogForbidden, err)
+	assert.Equal(t, service.GetBlogResponse{}, result)
+
+	// Update should not be called for non-owners
+	assert.Equal(t, 1, mockRepo.GetByIDCallCount())
+	assert.Equal(t, 0, mockRepo.UpdateCallCount())
+}
+
+func TestBlogService_UpdateBlog_AuthorOwnDraft(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeBlogRepository{}
+	blogService := service.NewBlogService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{}, service.Config{})
+	authorID := uuid.New()
+	ctx := http_server.ContextWithPrincipal(context.Background(), http_server.Principal{UserID: authorID, Roles: []string{rbac.RoleAuthor}})
+
+	blogID := uuid.New()
+	mockRepo.GetByIDReturns(repository.Blog{ID: blogID, AuthorID: authorID, Status: repository.StatusDraft}, nil)
+
+	_, err := blogService.UpdateBlog(ctx, blogID, service.UpdateBlogRequest{Title: "New Title"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, mockRepo.UpdateCallCount())
+}
+
+func TestBlogService_UpdateBlog_SameSlug(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeBlogRepository{}
+	blogService := service.NewBlogService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{}, service.Config{})
+	authorID := uuid.New()
+	ctx := http_server.ContextWithPrincipal(context.Background(), http_server.Principal{UserID: authorID, Roles: []string{rbac.RoleAuthor}})
+
+	blogID := uuid.New()
+	mockRepo.GetByIDReturns(repository.Blog{ID: blogID, Title: "Old Title", Slug: "old-title", AuthorID: authorID, Status: repository.StatusDraft}, nil)
+
+	// Only the punctuation changes, which does not change the slug
+	result, err := blogService.UpdateBlog(ctx, blogID, service.UpdateBlogRequest{Title: "Old Title!"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "old-title", result.Slug)
+	assert.Equal(t, 0, mockRepo.ChangeSlugCallCount())
+}
+
+func TestBlogService_UpdateBlog_SlugTaken(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeBlogRepository{}
+	blogService := service.NewBlogService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{}, service.Config{})
+	authorID := uuid.New()
+	ctx := http_server.ContextWithPrincipal(context.Background(), http_server.Principal{UserID: authorID, Roles: []string{rbac.RoleAuthor}})
+
+	blogID := uuid.New()
+	mockRepo.GetByIDReturns(repository.Blog{ID: blogID, Title: "Old Title", Slug: "old-title", AuthorID: authorID, Status: repository.StatusDraft}, nil)
+	mockRepo.ChangeSlugReturnsOnCall(0, repository.ErrSlugAlreadyTaken)
+
+	result, err := blogService.UpdateBlog(ctx, blogID, service.UpdateBlogRequest{Title: "New Title"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "new-title-2", result.Slug)
+	assert.Equal(t, 2, mockRepo.ChangeSlugCallCount())
+	_, _, actualSlug := mockRepo.ChangeSlugArgsForCall(1)
+	assert.Equal(t, "new-title-2", actualSlug)
+}
+
+func TestBlogService_UpdateBlog_SlugChangeFails(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeBlogRepository{}
+	blogService := service.NewBlogService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{}, service.Config{})
+	authorID := uuid.New()
+	ctx := http_server.ContextWithPrincipal(context.Background(), http_server.Principal{UserID: authorID, Roles: []string{rbac.RoleAuthor}})
+
+	blogID := uuid.New()
+	mockRepo.GetByIDReturns(repository.Blog{ID: blogID, Title: "Old Title", Slug: "old-title", AuthorID: authorID, Status: repository.StatusDraft}, nil)
+	mockRepo.ChangeSlugReturns(repository.ErrFailedToChangeSlug)
+
+	_, err := blogService.UpdateBlog(ctx, blogID, service.UpdateBlogRequest{Title: "New Title", Tags: []string{"go"}})
+
+	// Nothing else is written when the slug can not be changed
+	assert.Equal(t, repository.ErrFailedToChangeSlug, err)
+	assert.Equal(t, 0, mockRepo.UpdateCallCount())
+	assert.Equal(t, 0, mockRepo.SetTagsCallCount())
+}
+
+func TestBlogService_UpdateBlog_Tags(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeBlogRepository{}
+	blogService := service.NewBlogService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{}, service.Config{})
+	authorID := uuid.New()
+	ctx := http_server.ContextWithPrincipal(context.Background(), http_server.Principal{UserID: authorID, Roles: []string{rbac.RoleAuthor}})
+
+	blogID := uuid.New()
+	mockRepo.GetByIDReturns(repository.Blog{ID: blogID, Title: "Old Title", AuthorID: authorID, Status: repository.StatusDraft, Tags: []string{"go"}}, nil)
+
+	result, err := blogService.UpdateBlog(ctx, blogID, service.UpdateBlogRequest{Tags: []string{"mysql", "testing"}})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"mysql", "testing"}, result.Tags)
+	assert.Equal(t, 1, mockRepo.SetTagsCallCount())
+	_, actualID, actualTags := mockRepo.SetTagsArgsForCall(0)
+	assert.Equal(t, blogID, actualID)
+	assert.Equal(t, []string{"mysql", "testing"}, actualTags)
+}
+
+func TestBlogService_UpdateBlog_KeepsTags(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeBlogRepository{}
+	blogService := service.NewBlogService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{}, service.Config{})
+	authorID := uuid.New()
+	ctx := http_server.ContextWithPrincipal(context.Background(), http_server.Principal{UserID: authorID, Roles: []string{rbac.RoleAuthor}})
+
+	blogID := uuid.New()
+	mockRepo.GetByIDReturns(repository.Blog{ID: blogID, Title: "Old Title", AuthorID: authorID, Status: repository.StatusDraft, Tags: []string{"go"}}, nil)
+
+	// Without tags in the request the blog keeps its tags
+	result, err := blogService.UpdateBlog(ctx, blogID, service.UpdateBlogRequest{Content: "New content for the blog"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"go"}, result.Tags)
+	assert.Equal(t, 0, mockRepo.SetTagsCallCount())
+}
+
+func TestBlogService_UpdateBlog_ClearTags(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeBlogRepository{}
+	blogService := service.NewBlogService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{}, service.Config{})
+	authorID := uuid.New()
+	ctx := http_server.ContextWithPrincipal(context.Background(), http_server.Principal{UserID: authorID, Roles: []string{rbac.RoleAuthor}})
+
+	blogID := uuid.New()
+	mockRepo.GetByIDReturns(repository.Blog{ID: blogID, Title: "Old Title", AuthorID: authorID, Status: repository.StatusDraft, Tags: []string{"go"}}, nil)
+
+	result, err := blogService.UpdateBlog(ctx, blogID, service.UpdateBlogRequest{Tags: []string{}})
+
+	assert.NoError(t, err)
+	assert.Empty(t, result.Tags)
+	require.Equal(t, 1, mockRepo.SetTagsCallCount())
+	_, _, actualTags := mockRepo.SetTagsArgsForCall(0)
+	assert.Empty(t, actualTags)
+}
+
+func TestBlogService_UpdateBlog_AuthorCannotPublish(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeBlogRepository{}
+	blogService := service.NewBlogService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{}, service.Config{})
+	authorID := uuid.New()
+	ctx := http_server.ContextWithPrincipal(context.Background(), http_server.Principal{UserID: authorID, Roles: []string{rbac.RoleAuthor}})
+
+	blogID := uuid.New()
+	mockRepo.GetByIDReturns(repository.Blog{ID: blogID, AuthorID: authorID, Status: repository.StatusDraft}, nil)
+
+	_, err := blogService.UpdateBlog(ctx, blogID, service.UpdateBlogRequest{Status: repository.StatusPublished})
+
+	assert.Equal(t, service.ErrBlogForbidden, err)
+	assert.Equal(t, 0, mockRepo.UpdateCallCount())
+}
+
+func TestBlogService_UpdateBlog_AuthorPublishedBlog(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeBlogRepository{}
+	blogService := service.NewBlogService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{}, service.Config{})
+	authorID := uuid.New()
+	ctx := http_server.ContextWithPrincipal(context.Background(), http_server.Principal{UserID: authorID, Roles: []string{rbac.RoleAuthor}})
+
+	blogID := uuid.New()
+	mockRepo.GetByIDReturns(repository.Blog{ID: blogID, AuthorID: authorID, Status: repository.StatusPublished}, nil)
+
+	_, err := blogService.UpdateBlog(ctx, blogID, service.UpdateBlogRequest{Title: "New Title"})
+
+	assert.Equal(t, service.ErrBlogNotDraft, err)
+	assert.Equal(t, 0, mockRepo.UpdateCallCount())
+}
+
+func TestBlogService_UpdateBlog_AdminAnyBlog(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeBlogRepository{}
+	blogService := service.NewBlogService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{}, service.Config{})
+	ctx := http_server.ContextWithPrincipal(context.Background(), http_server.Principal{UserID: uuid.New(), Roles: []string{rbac.RoleAdmin}})
+
+	blogID := uuid.New()
+	mockRepo.GetByIDReturns(repository.Blog{ID: blogID, AuthorID: uuid.New(), Status: repository.StatusPublished}, nil)
+
+	_, err := blogService.UpdateBlog(ctx, blogID, service.UpdateBlogRequest{Title: "Moderated Title"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, mockRepo.UpdateCallCount())
+}
+
+func TestBlogService_UpdateBlog_Reader(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeBlogRepository{}
+	blogService := service.NewBlogService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{}, service.Config{})
+	readerID := uuid.New()
+	ctx := http_server.ContextWithPrincipal(context.Background(), http_server.Principal{UserID: readerID, Roles: []string{rbac.RoleReader}})
+
+	_, err := blogService.UpdateBlog(ctx, uuid.New(), service.UpdateBlogRequest{Title: "New Title"})
+
+	assert.Equal(t, service.ErrBlogForbidden, err)
+	assert.Equal(t, 0, mockRepo.GetByIDCallCount())
+}
+
+func TestBlogService_UpdateBlog_Unschedule(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeBlogRepository{}
+	blogService := service.NewBlogService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{}, service.Config{})
+	ctx := http_server.ContextWithPrincipal(context.Background(), http_server.Principal{UserID: uuid.New(), Roles: []string{rbac.RoleAdmin}})
+
+	scheduledAt := time.Now().Add(time.Hour)
+	mockRepo.GetByIDReturns(repository.Blog{ID: uuid.New(), Title: "Scheduled", AuthorID: uuid.New(), Status: repository.StatusScheduled, ScheduledAt: &scheduledAt}, nil)
+
+	// Moving a scheduled blog back to draft keeps the cron job from publishing it
+	result, err := blogService.UpdateBlog(ctx, uuid.New(), service.UpdateBlogRequest{Status: repository.StatusDraft})
+	require.NoError(t, err)
+	assert.Nil(t, result.ScheduledAt)
+
+	_, actualBlog := mockRepo.UpdateArgsForCall(0)
+	assert.Equal(t, repository.StatusDraft, actualBlog.Status)
+	assert.Nil(t, actualBlog.ScheduledAt)
+}
@@ -0,0 +1,353 @@
+package handler
+
+import (
+	"errors"
+	"log/slog"
+	"math"
+	"strconv"
+
+	blogRepository "github.com/fikryfahrezy/let-it-go/feature/blog/repository"
+	"github.com/fikryfahrezy/let-it-go/feature/comment/repository"
+	"github.com/fikryfahrezy/let-it-go/feature/comment/service"
+	"github.com/fikryfahrezy/let-it-go/pkg/http_server"
+	"github.com/fikryfahrezy/let-it-go/pkg/rbac"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+type CommentHandler struct {
+	commentService service.CommentService
+	log            *slog.Logger
+}
+
+func NewCommentHandler(log *slog.Logger, commentService service.CommentService) *CommentHandler {
+	return &CommentHandler{
+		commentService: commentService,
+		log:            log,
+	}
+}
+
+// translateServiceError converts service errors to appropriate HTTP responses
+func (h *CommentHandler) translateServiceError(c echo.Context, err error, defaultMessage string) error {
+	if errors.Is(err, repository.ErrCommentNotFound) {
+		return http_server.NotFoundResponse(c, "Comment not found", err)
+	}
+	if errors.Is(err, blogRepository.ErrBlogNotFound) {
+		return http_server.NotFoundResponse(c, "Blog not found", err)
+	}
+	if errors.Is(err, service.ErrCommentForbidden) {
+		return http_server.ForbiddenResponse(c, "You are not allowed to change this comment", err)
+	}
+	if errors.Is(err, service.ErrCommentsClosed) {
+		return http_server.ForbiddenResponse(c, "Comments are closed on this blog", err)
+	}
+	if errors.Is(err, service.ErrInvalidParent) {
+		return http_server.BadRequestResponse(c, "Replies must answer an approved comment of the same blog", err)
+	}
+	if errors.Is(err, service.ErrInvalidStatus) {
+		return http_server.BadRequestResponse(c, "Comment status must be pending, approved or rejected", err)
+	}
+
+	// Log unexpected errors
+	h.log.Error("Service error",
+		slog.String("error", err.Error()),
+		slog.String("operation", defaultMessage),
+	)
+	return http_server.InternalServerErrorResponse(c, defaultMessage, err)
+}
+
+// CreateComment comments on a blog
+// @Summary Comment on a blog
+// @Description Leave a comment on a published blog, or reply to one of its approved comments with parent_id. Comments of the blog's author and of moderators are approved right away; the others are pending until a moderator approves them. Blogs whose author closed comments refuse new ones.
+// @Tags comments
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Blog ID"
+// @Param comment body service.CreateCommentRequest true "Comment request"
+// @Success 201 {object} http_server.APIResponse{result=service.CommentResponse}
+// @Failure 400 {object} http_server.APIResponse
+// @Failure 401 {object} http_server.APIResponse
+// @Failure 403 {object} http_server.APIResponse
+// @Failure 404 {object} http_server.APIResponse
+// @Failure 422 {object} http_server.APIResponse
+// @Failure 500 {object} http_server.APIResponse
+// @Router /v1/blogs/{id}/comments [post]
+func (h *CommentHandler) CreateComment(c echo.Context) error {
+	idParam := c.Param("id")
+	blogID, err := uuid.Parse(idParam)
+	if err != nil {
+		h.log.Warn("Invalid blog ID parameter",
+			slog.String("id", idParam),
+		)
+		return http_server.BadRequestResponse(c, "Invalid blog UUID format", err)
+	}
+
+	var req service.CreateCommentRequest
+	if err := c.Bind(&req); err != nil {
+		h.log.Error("Failed to bind request",
+			slog.String("error", err.Error()),
+		)
+		return http_server.BadRequestResponse(c, "Invalid request format", err)
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return http_server.HandleValidationError(c, err)
+	}
+
+	comment, err := h.commentService.CreateComment(c.Request().Context(), blogID, req)
+	if err != nil {
+		return h.translateServiceError(c, err, "Failed to create comment")
+	}
+
+	return http_server.CreatedResponse(c, "Comment created successfully", comment)
+}
+
+// ListComments lists the comments of a blog
+// @Summary List blog comments
+// @Description Retrieve the approved comments of a published blog as threads, oldest first. Replies are nested under the comment they answer. Deleted comments that still have replies are kept without their content.
+// @Tags comments
+// @Accept json
+// @Produce json
+// @Param id path string true "Blog ID"
+// @Success 200 {object} http_server.APIResponse{result=[]service.CommentResponse}
+// @Failure 400 {object} http_server.APIResponse
+// @Failure 404 {object} http_server.APIResponse
+// @Failure 500 {object} http_server.APIResponse
+// @Router /v1/blogs/{id}/comments [get]
+func (h *CommentHandler) ListComments(c echo.Context) error {
+	idParam := c.Param("id")
+	blogID, err := uuid.Parse(idParam)
+	if err != nil {
+		h.log.Warn("Invalid blog ID parameter",
+			slog.String("id", idParam),
+		)
+		return http_server.BadRequestResponse(c, "Invalid blog UUID format", err)
+	}
+
+	comments, err := h.commentService.ListComments(c.Request().Context(), blogID)
+	if err != nil {
+		return h.translateServiceError(c, err, "Failed to list comments")
+	}
+
+	return http_server.SuccessResponse(c, "Comments retrieved successfully", comments)
+}
+
+// UpdateComment edits a comment
+// @Summary Edit a comment
+// @Description Change the content of one's own comment. Unless the author's comments are approved right away, the edited comment is pending again until a moderator approves it.
+// @Tags comments
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Comment ID"
+// @Param comment body service.UpdateCommentRequest true "Comment update request"
+// @Success 200 {object} http_server.APIResponse{result=service.CommentResponse}
+// @Failure 400 {object} http_server.APIResponse
+// @Failure 401 {object} http_server.APIResponse
+// @Failure 403 {object} http_server.APIResponse
+// @Failure 404 {object} http_server.APIResponse
+// @Failure 422 {object} http_server.APIResponse
+// @Failure 500 {object} http_server.APIResponse
+// @Router /v1/comments/{id} [put]
+func (h *CommentHandler) UpdateComment(c echo.Context) error {
+	idParam := c.Param("id")
+	id, err := uuid.Parse(idParam)
+	if err != nil {
+		h.log.Warn("Invalid comment ID parameter",
+			slog.String("id", idParam),
+		)
+		return http_server.BadRequestResponse(c, "Invalid comment UUID format", err)
+	}
+
+	var req service.UpdateCommentRequest
+	if err := c.Bind(&req); err != nil {
+		h.log.Error("Failed to bind request",
+			slog.String("error", err.Error()),
+		)
+		return http_server.BadRequestResponse(c, "Invalid request format", err)
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return http_server.HandleValidationError(c, err)
+	}
+
+	comment, err := h.commentService.UpdateComment(c.Request().Context(), id, req)
+	if err != nil {
+		return h.translateServiceError(c, err, "Failed to update comment")
+	}
+
+	return http_server.SuccessResponse(c, "Comment updated successfully", comment)
+}
+
+// DeleteComment deletes a comment
+// @Summary Delete a comment
+// @Description Delete one's own comment. Its replies stay on the blog under a comment without content.
+// @Tags comments
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Comment ID"
+// @Success 200 {object} http_server.APIResponse
+// @Failure 400 {object} http_server.APIResponse
+// @Failure 401 {object} http_server.APIResponse
+// @Failure 403 {object} http_server.APIResponse
+// @Failure 404 {object} http_server.APIResponse
+// @Failure 500 {object} http_server.APIResponse
+// @Router /v1/comments/{id} [delete]
+func (h *CommentHandler) DeleteComment(c echo.Context) error {
+	idParam := c.Param("id")
+	id, err := uuid.Parse(idParam)
+	if err != nil {
+		h.log.Warn("Invalid comment ID parameter",
+			slog.String("id", idParam),
+		)
+		return http_server.BadRequestResponse(c, "Invalid comment UUID format", err)
+	}
+
+	if err := h.commentService.DeleteComment(c.Request().Context(), id); err != nil {
+		return h.translateServiceError(c, err, "Failed to delete comment")
+	}
+
+	return http_server.SuccessResponse(c, "Comment deleted successfully", nil)
+}
+
+// ListModerationQueue lists the comments to moderate
+// @Summary List comments for moderation
+// @Description Retrieve a paginated list of the comments of every blog having the status, pending by default, oldest first. Requires the comments:moderate permission.
+// @Tags comments
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param status query string false "Comment status" Enums(pending, approved, rejected) default(pending)
+// @Param page query int false "Page number" default(1)
+// @Param page_size query int false "Number of items per page" default(10)
+// @Success 200 {object} http_server.ListAPIResponse{result=[]service.CommentResponse}
+// @Failure 400 {object} http_server.APIResponse
+// @Failure 401 {object} http_server.APIResponse
+// @Failure 403 {object} http_server.APIResponse
+// @Failure 500 {object} http_server.APIResponse
+// @Router /v1/admin/comments [get]
+func (h *CommentHandler) ListModerationQueue(c echo.Context) error {
+	pageParam := c.QueryParam("page")
+	pageSizeParam := c.QueryParam("page_size")
+
+	page := 1
+	if pageParam != "" {
+		if p, err := strconv.Atoi(pageParam); err == nil && p > 0 {
+			page = p
+		}
+	}
+
+	pageSize := 10
+	if pageSizeParam != "" {
+		if ps, err := strconv.Atoi(pageSizeParam); err == nil && ps > 0 && ps <= 100 {
+			pageSize = ps
+		}
+	}
+
+	req := service.ListModerationQueueRequest{
+		PaginationRequest: http_server.PaginationRequest{
+			Page:     page,
+			PageSize: pageSize,
+		},
+		Status: c.QueryParam("status"),
+	}
+
+	comments, totalCount, err := h.commentService.ListModerationQueue(c.Request().Context(), req)
+	if err != nil {
+		return h.translateServiceError(c, err, "Failed to list comments")
+	}
+
+	totalPages := int64(math.Ceil(float64(totalCount) / float64(pageSize)))
+	pagination := http_server.CreatePaginationResponse(totalCount, totalPages, page, pageSize)
+
+	return http_server.ListSuccessResponse(c, "Comments retrieved successfully", comments, pagination)
+}
+
+// ApproveComment approves a comment
+// @Summary Approve a comment
+// @Description Show a pending or rejected comment on its blog. Requires the comments:moderate permission.
+// @Tags comments
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Comment ID"
+// @Success 200 {object} http_server.APIResponse{result=service.CommentResponse}
+// @Failure 400 {object} http_server.APIResponse
+// @Failure 401 {object} http_server.APIResponse
+// @Failure 403 {object} http_server.APIResponse
+// @Failure 404 {object} http_server.APIResponse
+// @Failure 500 {object} http_server.APIResponse
+// @Router /v1/admin/comments/{id}/approve [post]
+func (h *CommentHandler) ApproveComment(c echo.Context) error {
+	idParam := c.Param("id")
+	id, err := uuid.Parse(idParam)
+	if err != nil {
+		h.log.Warn("Invalid comment ID parameter",
+			slog.String("id", idParam),
+		)
+		return http_server.BadRequestResponse(c, "Invalid comment UUID format", err)
+	}
+
+	comment, err := h.commentService.ApproveComment(c.Request().Context(), id)
+	if err != nil {
+		return h.translateServiceError(c, err, "Failed to approve comment")
+	}
+
+	return http_server.SuccessResponse(c, "Comment approved successfully", comment)
+}
+
+// RejectComment rejects a comment
+// @Summary Reject a comment
+// @Description Hide a comment, and the replies to it, from its blog. Requires the comments:moderate permission.
+// @Tags comments
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Comment ID"
+// @Success 200 {object} http_server.APIResponse{result=service.CommentResponse}
+// @Failure 400 {object} http_server.APIResponse
+// @Failure 401 {object} http_server.APIResponse
+// @Failure 403 {object} http_server.APIResponse
+// @Failure 404 {object} http_server.APIResponse
+// @Failure 500 {object} http_server.APIResponse
+// @Router /v1/admin/comments/{id}/reject [post]
+func (h *CommentHandler) RejectComment(c echo.Context) error {
+	idParam := c.Param("id")
+	id, err := uuid.Parse(idParam)
+	if err != nil {
+		h.log.Warn("Invalid comment ID parameter",
+			slog.String("id", idParam),
+		)
+		return http_server.BadRequestResponse(c, "Invalid comment UUID format", err)
+	}
+
+	comment, err := h.commentService.RejectComment(c.Request().Context(), id)
+	if err != nil {
+		return h.translateServiceError(c, err, "Failed to reject comment")
+	}
+
+	return http_server.SuccessResponse(c, "Comment rejected successfully", comment)
+}
+
+// SetupRoutes configures all API routes for comments
+func (h *CommentHandler) SetupRoutes(server *http_server.Server) {
+	h.setupV1Routes(server)
+}
+
+// setupV1Routes configures v1 API routes for comments
+func (h *CommentHandler) setupV1Routes(server *http_server.Server) {
+	blogComments := server.Echo().Group("/v1/blogs/:id/comments")
+	blogComments.GET("", h.ListComments)
+	blogComments.POST("", h.CreateComment, http_server.RequireAuth())
+
+	comments := server.Echo().Group("/v1/comments", http_server.RequireAuth())
+	comments.PUT("/:id", h.UpdateComment)
+	comments.DELETE("/:id", h.DeleteComment)
+
+	admin := server.Echo().Group("/v1/admin/comments", rbac.RequirePermission(rbac.PermissionCommentsModerate), http_server.ForbidImpersonation())
+	admin.GET("", h.ListModerationQueue)
+	admin.POST("/:id/approve", h.ApproveComment)
+	admin.POST("/:id/reject", h.RejectComment)
+}
@@ -0,0 +1,314 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	blogRepository "github.com/fikryfahrezy/let-it-go/feature/blog/repository"
+	"github.com/fikryfahrezy/let-it-go/feature/comment/handler"
+	"github.com/fikryfahrezy/let-it-go/feature/comment/repository"
+	"github.com/fikryfahrezy/let-it-go/feature/comment/service"
+	"github.com/fikryfahrezy/let-it-go/feature/comment/service/servicefakes"
+	"github.com/fikryfahrezy/let-it-go/pkg/http_server"
+	"github.com/fikryfahrezy/let-it-go/pkg/logger"
+	"github.com/fikryfahrezy/let-it-go/pkg/rbac"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setupServer(t *testing.T, commentHandler *handler.CommentHandler, principal http_server.Principal) *echo.Echo {
+	t.Helper()
+
+	srv := http_server.New(http_server.Config{})
+	srv.SetAuthenticator(http_server.AuthenticatorFunc(func(ctx context.Context, token string) (http_server.Principal, error) {
+		if token != "valid-token" {
+			return http_server.Principal{}, http_server.ErrUnauthenticated
+		}
+		return principal, nil
+	}))
+	require.NoError(t, srv.Initialize([]http_server.RouteHandler{commentHandler}))
+
+	return srv.Echo()
+}
+
+func readerPrincipal() http_server.Principal {
+	return http_server.Principal{UserID: uuid.New(), Roles: []string{rbac.RoleReader}}
+}
+
+func editorPrincipal() http_server.Principal {
+	return http_server.Principal{UserID: uuid.New(), Roles: []string{rbac.RoleEditor}}
+}
+
+func TestCommentHandler_CreateComment_Success(t *testing.T) {
+	mockService := &servicefakes.FakeCommentService{}
+	commentID := uuid.New()
+	mockService.CreateCommentReturns(service.CommentResponse{ID: commentID, Content: "Nice post", Status: repository.StatusPending}, nil)
+	e := setupServer(t, handler.NewCommentHandler(logger.NewDiscardLogger(), mockService), readerPrincipal())
+
+	blogID := uuid.New()
+	parentID := uuid.New()
+	req := httptest.NewRequest(http.MethodPost, "/v1/blogs/"+blogID.String()+"/comments", strings.NewReader(`{"content":"Nice post","parent_id":"`+parentID.String()+`"}`))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	req.Header.Set(echo.HeaderAuthorization, "Bearer valid-token")
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusCreated, rec.Code)
+
+	var response struct {
+		Result service.CommentResponse `json:"result"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	assert.Equal(t, commentID, response.Result.ID)
+	assert.Equal(t, repository.StatusPending, response.Result.Status)
+
+	require.Equal(t, 1, mockService.CreateCommentCallCount())
+	_, actualBlogID, actualReq := mockService.CreateCommentArgsForCall(0)
+	assert.Equal(t, blogID, actualBlogID)
+	assert.Equal(t, "Nice post", actualReq.Content)
+	require.NotNil(t, actualReq.ParentID)
+	assert.Equal(t, parentID, *actualReq.ParentID)
+}
+
+func TestCommentHandler_CreateComment_Unauthenticated(t *testing.T) {
+	mockService := &servicefakes.FakeCommentService{}
+	e := setupServer(t, handler.NewCommentHandler(logger.NewDiscardLogger(), mockService), readerPrincipal())
+
+	req := httptest.NewRequest(http.MethodPost, "/v1/blogs/"+uuid.New().String()+"/comments", strings.NewReader(`{"content":"Nice post"}`))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	assert.Equal(t, 0, mockService.CreateCommentCallCount())
+}
+
+func TestCommentHandler_CreateComment_ValidationError(t *testing.T) {
+	mockService := &servicefakes.FakeCommentService{}
+	e := setupServer(t, handler.NewCommentHandler(logger.NewDiscardLogger(), mockService), readerPrincipal())
+
+	req := httptest.NewRequest(http.MethodPost, "/v1/blogs/"+uuid.New().String()+"/comments", strings.NewReader(`{"content":""}`))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	req.Header.Set(echo.HeaderAuthorization, "Bearer valid-token")
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+	assert.Equal(t, 0, mockService.CreateCommentCallCount())
+}
+
+func TestCommentHandler_CreateComment_ServiceErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		wantCode int
+	}{
+		{name: "comments closed", err: service.ErrCommentsClosed, wantCode: http.StatusForbidden},
+		{name: "invalid parent", err: service.ErrInvalidParent, wantCode: http.StatusBadRequest},
+		{name: "blog not found", err: blogRepository.ErrBlogNotFound, wantCode: http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := &servicefakes.FakeCommentService{}
+			mockService.CreateCommentReturns(service.CommentResponse{}, tt.err)
+			e := setupServer(t, handler.NewCommentHandler(logger.NewDiscardLogger(), mockService), readerPrincipal())
+
+			req := httptest.NewRequest(http.MethodPost, "/v1/blogs/"+uuid.New().String()+"/comments", strings.NewReader(`{"content":"Nice post"}`))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			req.Header.Set(echo.HeaderAuthorization, "Bearer valid-token")
+			rec := httptest.NewRecorder()
+			e.ServeHTTP(rec, req)
+
+			assert.Equal(t, tt.wantCode, rec.Code)
+		})
+	}
+}
+
+func TestCommentHandler_ListComments_Public(t *testing.T) {
+	mockService := &servicefakes.FakeCommentService{}
+	replyID := uuid.New()
+	mockService.ListCommentsReturns([]service.CommentResponse{
+		{ID: uuid.New(), Content: "First", Replies: []service.CommentResponse{{ID: replyID, Content: "Reply"}}},
+	}, nil)
+	e := setupServer(t, handler.NewCommentHandler(logger.NewDiscardLogger(), mockService), readerPrincipal())
+
+	blogID := uuid.New()
+	req := httptest.NewRequest(http.MethodGet, "/v1/blogs/"+blogID.String()+"/comments", nil)
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	var response struct {
+		Result []service.CommentResponse `json:"result"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	require.Len(t, response.Result, 1)
+	require.Len(t, response.Result[0].Replies, 1)
+	assert.Equal(t, replyID, response.Result[0].Replies[0].ID)
+
+	_, actualBlogID := mockService.ListCommentsArgsForCall(0)
+	assert.Equal(t, blogID, actualBlogID)
+}
+
+func TestCommentHandler_ListComments_InvalidBlogID(t *testing.T) {
+	mockService := &servicefakes.FakeCommentService{}
+	e := setupServer(t, handler.NewCommentHandler(logger.NewDiscardLogger(), mockService), readerPrincipal())
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/blogs/not-a-uuid/comments", nil)
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Equal(t, 0, mockService.ListCommentsCallCount())
+}
+
+func TestCommentHandler_UpdateComment_Success(t *testing.T) {
+	mockService := &servicefakes.FakeCommentService{}
+	mockService.UpdateCommentReturns(service.CommentResponse{Content: "Edited", Status: repository.StatusPending}, nil)
+	e := setupServer(t, handler.NewCommentHandler(logger.NewDiscardLogger(), mockService), readerPrincipal())
+
+	commentID := uuid.New()
+	req := httptest.NewRequest(http.MethodPut, "/v1/comments/"+commentID.String(), strings.NewReader(`{"content":"Edited"}`))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	req.Header.Set(echo.HeaderAuthorization, "Bearer valid-token")
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	_, actualID, actualReq := mockService.UpdateCommentArgsForCall(0)
+	assert.Equal(t, commentID, actualID)
+	assert.Equal(t, "Edited", actualReq.Content)
+}
+
+func TestCommentHandler_UpdateComment_Forbidden(t *testing.T) {
+	mockService := &servicefakes.FakeCommentService{}
+	mockService.UpdateCommentReturns(service.CommentResponse{}, service.ErrCommentForbidden)
+	e := setupServer(t, handler.NewCommentHandler(logger.NewDiscardLogger(), mockService), readerPrincipal())
+
+	req := httptest.NewRequest(http.MethodPut, "/v1/comments/"+uuid.New().String(), strings.NewReader(`{"content":"Edited"}`))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	req.Header.Set(echo.HeaderAuthorization, "Bearer valid-token")
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+}
+
+func TestCommentHandler_DeleteComment_Success(t *testing.T) {
+	mockService := &servicefakes.FakeCommentService{}
+	e := setupServer(t, handler.NewCommentHandler(logger.NewDiscardLogger(), mockService), readerPrincipal())
+
+	commentID := uuid.New()
+	req := httptest.NewRequest(http.MethodDelete, "/v1/comments/"+commentID.String(), nil)
+	req.Header.Set(echo.HeaderAuthorization, "Bearer valid-token")
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	_, actualID := mockService.DeleteCommentArgsForCall(0)
+	assert.Equal(t, commentID, actualID)
+}
+
+func TestCommentHandler_DeleteComment_NotFound(t *testing.T) {
+	mockService := &servicefakes.FakeCommentService{}
+	mockService.DeleteCommentReturns(repository.ErrCommentNotFound)
+	e := setupServer(t, handler.NewCommentHandler(logger.NewDiscardLogger(), mockService), readerPrincipal())
+
+	req := httptest.NewRequest(http.MethodDelete, "/v1/comments/"+uuid.New().String(), nil)
+	req.Header.Set(echo.HeaderAuthorization, "Bearer valid-token")
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+func TestCommentHandler_ListModerationQueue_Success(t *testing.T) {
+	mockService := &servicefakes.FakeCommentService{}
+	mockService.ListModerationQueueReturns([]service.CommentResponse{{ID: uuid.New(), Status: repository.StatusRejected}}, 21, nil)
+	e := setupServer(t, handler.NewCommentHandler(logger.NewDiscardLogger(), mockService), editorPrincipal())
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/admin/comments?status=rejected&page=2&page_size=20", nil)
+	req.Header.Set(echo.HeaderAuthorization, "Bearer valid-token")
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	var response struct {
+		Pagination http_server.PaginationResponse `json:"pagination"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	assert.Equal(t, int64(21), response.Pagination.TotalData)
+	assert.Equal(t, int64(2), response.Pagination.TotalPages)
+
+	_, actualReq := mockService.ListModerationQueueArgsForCall(0)
+	assert.Equal(t, repository.StatusRejected, actualReq.Status)
+	assert.Equal(t, 2, actualReq.Page)
+	assert.Equal(t, 20, actualReq.PageSize)
+}
+
+func TestCommentHandler_ListModerationQueue_RequiresPermission(t *testing.T) {
+	mockService := &servicefakes.FakeCommentService{}
+	e := setupServer(t, handler.NewCommentHandler(logger.NewDiscardLogger(), mockService), readerPrincipal())
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/admin/comments", nil)
+	req.Header.Set(echo.HeaderAuthorization, "Bearer valid-token")
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+	assert.Equal(t, 0, mockService.ListModerationQueueCallCount())
+}
+
+func TestCommentHandler_ListModerationQueue_InvalidStatus(t *testing.T) {
+	mockService := &servicefakes.FakeCommentService{}
+	mockService.ListModerationQueueReturns(nil, 0, service.ErrInvalidStatus)
+	e := setupServer(t, handler.NewCommentHandler(logger.NewDiscardLogger(), mockService), editorPrincipal())
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/admin/comments?status=deleted", nil)
+	req.Header.Set(echo.HeaderAuthorization, "Bearer valid-token")
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+}
+
+func TestCommentHandler_ApproveComment_Success(t *testing.T) {
+	mockService := &servicefakes.FakeCommentService{}
+	mockService.ApproveCommentReturns(service.CommentResponse{Status: repository.StatusApproved}, nil)
+	e := setupServer(t, handler.NewCommentHandler(logger.NewDiscardLogger(), mockService), editorPrincipal())
+
+	commentID := uuid.New()
+	req := httptest.NewRequest(http.MethodPost, "/v1/admin/comments/"+commentID.String()+"/approve", nil)
+	req.Header.Set(echo.HeaderAuthorization, "Bearer valid-token")
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	_, actualID := mockService.ApproveCommentArgsForCall(0)
+	assert.Equal(t, commentID, actualID)
+}
+
+func TestCommentHandler_RejectComment_Success(t *testing.T) {
+	mockService := &servicefakes.FakeCommentService{}
+	mockService.RejectCommentReturns(service.CommentResponse{Status: repository.StatusRejected}, nil)
+	e := setupServer(t, handler.NewCommentHandler(logger.NewDiscardLogger(), mockService), editorPrincipal())
+
+	commentID := uuid.New()
+	req := httptest.NewRequest(http.MethodPost, "/v1/admin/comments/"+commentID.String()+"/reject", nil)
+	req.Header.Set(echo.HeaderAuthorization, "Bearer valid-token")
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	_, actualID := mockService.RejectCommentArgsForCall(0)
+	assert.Equal(t, commentID, actualID)
+}
@@ -0,0 +1,67 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// CountApprovedByBlogIDs counts the approved comments that were not deleted of each blog with
+// a single query. Every blog is in the result, those without comments with zero.
+func (r *commentRepository) CountApprovedByBlogIDs(ctx context.Context, blogIDs []uuid.UUID) (map[uuid.UUID]int64, error) {
+	counts := make(map[uuid.UUID]int64, len(blogIDs))
+	if len(blogIDs) == 0 {
+		return counts, nil
+	}
+
+	args := make([]any, 0, len(blogIDs)+1)
+	args = append(args, StatusApproved)
+	for _, blogID := range blogIDs {
+		args = append(args, blogID)
+		counts[blogID] = 0
+	}
+
+	query := `
+		SELECT blog_id, COUNT(*)
+		FROM comments
+		WHERE status = ? AND deleted_at IS NULL AND blog_id IN (` + strings.TrimSuffix(strings.Repeat("?, ", len(blogIDs)), ", ") + `)
+		GROUP BY blog_id
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		r.log.Error("Failed to count blog comments",
+			slog.String("error", err.Error()),
+		)
+		return nil, fmt.Errorf("%w: %w", ErrFailedToCountComments, err)
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			r.log.Error("Failed to close count blog comments rows", slog.String("error", err.Error()))
+		}
+	}()
+
+	for rows.Next() {
+		var blogID uuid.UUID
+		var count int64
+		if err := rows.Scan(&blogID, &count); err != nil {
+			r.log.Error("Failed to scan comment count row",
+				slog.String("error", err.Error()),
+			)
+			return nil, fmt.Errorf("%w: %w", ErrFailedToScanCommentRow, err)
+		}
+		counts[blogID] = count
+	}
+
+	if err := rows.Err(); err != nil {
+		r.log.Error("Error iterating comment count rows",
+			slog.String("error", err.Error()),
+		)
+		return nil, fmt.Errorf("%w: %w", ErrFailedToIterateRows, err)
+	}
+
+	return counts, nil
+}
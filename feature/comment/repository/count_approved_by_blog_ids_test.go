@@ -0,0 +1,33 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/fikryfahrezy/let-it-go/feature/comment/repository"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCountApprovedByBlogIDs(t *testing.T) {
+	userID, blogID := setupTest(t)
+	ctx := context.Background()
+
+	otherBlogID := uuid.Must(uuid.NewV7())
+	_, err := db.Exec("INSERT INTO blogs (id, title, slug, content, author_id, status) VALUES (?, ?, ?, ?, ?, ?)",
+		otherBlogID, "Other Blog", "other-blog", "Other content", userID, "published")
+	require.NoError(t, err)
+
+	deleted := newTestComment(blogID, userID, nil, "Deleted", repository.StatusApproved)
+	require.NoError(t, testRepository.Create(ctx, newTestComment(blogID, userID, nil, "First", repository.StatusApproved)))
+	require.NoError(t, testRepository.Create(ctx, newTestComment(blogID, userID, nil, "Second", repository.StatusApproved)))
+	require.NoError(t, testRepository.Create(ctx, newTestComment(blogID, userID, nil, "Pending", repository.StatusPending)))
+	require.NoError(t, testRepository.Create(ctx, deleted))
+	require.NoError(t, testRepository.Delete(ctx, deleted.ID, time.Now()))
+
+	counts, err := testRepository.CountApprovedByBlogIDs(ctx, []uuid.UUID{blogID, otherBlogID})
+	require.NoError(t, err)
+	assert.Equal(t, map[uuid.UUID]int64{blogID: 2, otherBlogID: 0}, counts)
+}
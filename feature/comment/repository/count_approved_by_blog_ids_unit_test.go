@@ -0,0 +1,81 @@
+package repository_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/fikryfahrezy/let-it-go/feature/comment/repository"
+	"github.com/fikryfahrezy/let-it-go/pkg/database"
+	"github.com/fikryfahrezy/let-it-go/pkg/logger"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCountApprovedByBlogIDsUnit(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	// nolint:errcheck
+	defer sqlDB.Close()
+
+	db := &database.DB{DB: sqlDB}
+	repo := repository.NewCommentRepository(logger.NewDiscardLogger(), db)
+	ctx := context.Background()
+
+	commented := uuid.New()
+	uncommented := uuid.New()
+
+	// Mock the grouped COUNT query
+	rows := sqlmock.NewRows([]string{"blog_id", "count"}).AddRow(commented, int64(2))
+	mock.ExpectQuery(`SELECT blog_id, COUNT\(\*\) FROM comments WHERE status = \? AND deleted_at IS NULL AND blog_id IN \(\?, \?\) GROUP BY blog_id`).
+		WithArgs(repository.StatusApproved, commented, uncommented).
+		WillReturnRows(rows)
+
+	counts, err := repo.CountApprovedByBlogIDs(ctx, []uuid.UUID{commented, uncommented})
+	assert.NoError(t, err)
+	assert.Equal(t, map[uuid.UUID]int64{commented: 2, uncommented: 0}, counts)
+
+	// Verify all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCountApprovedByBlogIDsNoBlogsUnit(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	// nolint:errcheck
+	defer sqlDB.Close()
+
+	db := &database.DB{DB: sqlDB}
+	repo := repository.NewCommentRepository(logger.NewDiscardLogger(), db)
+	ctx := context.Background()
+
+	// No query is made without blogs
+	counts, err := repo.CountApprovedByBlogIDs(ctx, nil)
+	assert.NoError(t, err)
+	assert.Empty(t, counts)
+
+	// Verify all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCountApprovedByBlogIDsErrorUnit(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	// nolint:errcheck
+	defer sqlDB.Close()
+
+	db := &database.DB{DB: sqlDB}
+	repo := repository.NewCommentRepository(logger.NewDiscardLogger(), db)
+	ctx := context.Background()
+
+	mock.ExpectQuery("SELECT blog_id, COUNT").
+		WillReturnError(errors.New("connection lost"))
+
+	_, err = repo.CountApprovedByBlogIDs(ctx, []uuid.UUID{uuid.New()})
+	assert.ErrorIs(t, err, repository.ErrFailedToCountComments)
+
+	// Verify all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,27 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+)
+
+// CountByStatus counts the comments ListByStatus returns
+func (r *commentRepository) CountByStatus(ctx context.Context, status string) (int64, error) {
+	query := `
+		SELECT COUNT(*)
+		FROM comments
+		WHERE status = ? AND deleted_at IS NULL
+	`
+
+	var count int64
+	if err := r.db.QueryRowContext(ctx, query, status).Scan(&count); err != nil {
+		r.log.Error("Failed to count comments by status",
+			slog.String("error", err.Error()),
+			slog.String("status", status),
+		)
+		return 0, fmt.Errorf("%w: %w", ErrFailedToCountComments, err)
+	}
+
+	return count, nil
+}
@@ -0,0 +1,30 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/fikryfahrezy/let-it-go/feature/comment/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCountByStatus(t *testing.T) {
+	userID, blogID := setupTest(t)
+	ctx := context.Background()
+
+	deleted := newTestComment(blogID, userID, nil, "Deleted", repository.StatusPending)
+	require.NoError(t, testRepository.Create(ctx, newTestComment(blogID, userID, nil, "First", repository.StatusPending)))
+	require.NoError(t, testRepository.Create(ctx, newTestComment(blogID, userID, nil, "Second", repository.StatusRejected)))
+	require.NoError(t, testRepository.Create(ctx, deleted))
+	require.NoError(t, testRepository.Delete(ctx, deleted.ID, time.Now()))
+
+	count, err := testRepository.CountByStatus(ctx, repository.StatusPending)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), count)
+
+	count, err = testRepository.CountByStatus(ctx, repository.StatusRejected)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), count)
+}
@@ -0,0 +1,58 @@
+package repository_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/fikryfahrezy/let-it-go/feature/comment/repository"
+	"github.com/fikryfahrezy/let-it-go/pkg/database"
+	"github.com/fikryfahrezy/let-it-go/pkg/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCountByStatusUnit(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	// nolint:errcheck
+	defer sqlDB.Close()
+
+	db := &database.DB{DB: sqlDB}
+	repo := repository.NewCommentRepository(logger.NewDiscardLogger(), db)
+	ctx := context.Background()
+
+	// Mock the COUNT query
+	rows := sqlmock.NewRows([]string{"count"}).AddRow(int64(3))
+	mock.ExpectQuery(`SELECT COUNT\(\*\) FROM comments WHERE status = \? AND deleted_at IS NULL`).
+		WithArgs(repository.StatusPending).
+		WillReturnRows(rows)
+
+	count, err := repo.CountByStatus(ctx, repository.StatusPending)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), count)
+
+	// Verify all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCountByStatusErrorUnit(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	// nolint:errcheck
+	defer sqlDB.Close()
+
+	db := &database.DB{DB: sqlDB}
+	repo := repository.NewCommentRepository(logger.NewDiscardLogger(), db)
+	ctx := context.Background()
+
+	mock.ExpectQuery("SELECT COUNT").
+		WillReturnError(errors.New("connection lost"))
+
+	_, err = repo.CountByStatus(ctx, repository.StatusPending)
+	assert.ErrorIs(t, err, repository.ErrFailedToCountComments)
+
+	// Verify all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
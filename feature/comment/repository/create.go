@@ -0,0 +1,41 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+)
+
+func (r *commentRepository) Create(ctx context.Context, comment Comment) error {
+	query := `
+		INSERT INTO comments (id, blog_id, parent_id, author_id, content, status, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+	`
+
+	now := time.Now()
+	_, err := r.db.ExecContext(ctx, query,
+		comment.ID,
+		comment.BlogID,
+		comment.ParentID,
+		comment.AuthorID,
+		comment.Content,
+		comment.Status,
+		now,
+		now,
+	)
+	if err != nil {
+		r.log.Error("Failed to create comment",
+			slog.String("error", err.Error()),
+			slog.String("blog_id", comment.BlogID.String()),
+		)
+		return fmt.Errorf("%w: %w", ErrFailedToCreateComment, err)
+	}
+
+	r.log.Info("Comment created successfully",
+		slog.String("comment_id", comment.ID.String()),
+		slog.String("blog_id", comment.BlogID.String()),
+	)
+
+	return nil
+}
@@ -0,0 +1,53 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/fikryfahrezy/let-it-go/feature/comment/repository"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreate(t *testing.T) {
+	userID, blogID := setupTest(t)
+	ctx := context.Background()
+
+	comment := newTestComment(blogID, userID, nil, "Nice post", repository.StatusPending)
+	err := testRepository.Create(ctx, comment)
+	require.NoError(t, err)
+
+	created, err := testRepository.GetByID(ctx, comment.ID)
+	require.NoError(t, err)
+	assert.Equal(t, blogID, created.BlogID)
+	assert.Equal(t, userID, created.AuthorID)
+	assert.Nil(t, created.ParentID)
+	assert.Equal(t, "Nice post", created.Content)
+	assert.Equal(t, repository.StatusPending, created.Status)
+	assert.Nil(t, created.ModeratedBy)
+	assert.False(t, created.CreatedAt.IsZero())
+}
+
+func TestCreateReply(t *testing.T) {
+	userID, blogID := setupTest(t)
+	ctx := context.Background()
+
+	parent := newTestComment(blogID, userID, nil, "Nice post", repository.StatusApproved)
+	require.NoError(t, testRepository.Create(ctx, parent))
+
+	reply := newTestComment(blogID, userID, &parent.ID, "Thanks", repository.StatusPending)
+	require.NoError(t, testRepository.Create(ctx, reply))
+
+	created, err := testRepository.GetByID(ctx, reply.ID)
+	require.NoError(t, err)
+	require.NotNil(t, created.ParentID)
+	assert.Equal(t, parent.ID, *created.ParentID)
+}
+
+func TestCreateUnknownBlog(t *testing.T) {
+	userID, _ := setupTest(t)
+
+	err := testRepository.Create(context.Background(), newTestComment(uuid.New(), userID, nil, "Nice post", repository.StatusPending))
+	assert.ErrorIs(t, err, repository.ErrFailedToCreateComment)
+}
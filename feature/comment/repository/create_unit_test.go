@@ -0,0 +1,60 @@
+package repository_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/fikryfahrezy/let-it-go/feature/comment/repository"
+	"github.com/fikryfahrezy/let-it-go/pkg/database"
+	"github.com/fikryfahrezy/let-it-go/pkg/logger"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateUnit(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	// nolint:errcheck
+	defer sqlDB.Close()
+
+	db := &database.DB{DB: sqlDB}
+	repo := repository.NewCommentRepository(logger.NewDiscardLogger(), db)
+	ctx := context.Background()
+
+	parentID := uuid.New()
+	comment := newTestComment(uuid.New(), uuid.New(), &parentID, "Nice post", repository.StatusPending)
+
+	// Mock the INSERT query
+	mock.ExpectExec("INSERT INTO comments").
+		WithArgs(comment.ID, comment.BlogID, comment.ParentID, comment.AuthorID, comment.Content, comment.Status, sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	err = repo.Create(ctx, comment)
+	assert.NoError(t, err)
+
+	// Verify all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCreateErrorUnit(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	// nolint:errcheck
+	defer sqlDB.Close()
+
+	db := &database.DB{DB: sqlDB}
+	repo := repository.NewCommentRepository(logger.NewDiscardLogger(), db)
+	ctx := context.Background()
+
+	mock.ExpectExec("INSERT INTO comments").
+		WillReturnError(errors.New("connection lost"))
+
+	err = repo.Create(ctx, newTestComment(uuid.New(), uuid.New(), nil, "Nice post", repository.StatusPending))
+	assert.ErrorIs(t, err, repository.ErrFailedToCreateComment)
+
+	// Verify all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
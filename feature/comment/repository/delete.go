@@ -0,0 +1,47 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Delete removes the content of a comment but keeps the comment, so its replies stay in
+// the thread. Deleted comments are no longer counted and cannot be changed.
+func (r *commentRepository) Delete(ctx context.Context, id uuid.UUID, deletedAt time.Time) error {
+	query := `
+		UPDATE comments
+		SET content = '', deleted_at = ?
+		WHERE id = ? AND deleted_at IS NULL
+	`
+
+	result, err := r.db.ExecContext(ctx, query, deletedAt, id)
+	if err != nil {
+		r.log.Error("Failed to delete comment",
+			slog.String("error", err.Error()),
+			slog.String("comment_id", id.String()),
+		)
+		return fmt.Errorf("%w: %w", ErrFailedToDeleteComment, err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		r.log.Error("Failed to get rows affected",
+			slog.String("error", err.Error()),
+		)
+		return fmt.Errorf("%w: %w", ErrFailedToGetRowsAffected, err)
+	}
+
+	if rowsAffected == 0 {
+		return ErrCommentNotFound
+	}
+
+	r.log.Info("Comment deleted successfully",
+		slog.String("comment_id", id.String()),
+	)
+
+	return nil
+}
@@ -0,0 +1,37 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/fikryfahrezy/let-it-go/feature/comment/repository"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDelete(t *testing.T) {
+	userID, blogID := setupTest(t)
+	ctx := context.Background()
+
+	comment := newTestComment(blogID, userID, nil, "Nice post", repository.StatusApproved)
+	require.NoError(t, testRepository.Create(ctx, comment))
+
+	err := testRepository.Delete(ctx, comment.ID, time.Now())
+	require.NoError(t, err)
+
+	_, err = testRepository.GetByID(ctx, comment.ID)
+	assert.Equal(t, repository.ErrCommentNotFound, err)
+
+	// Deleting it again reports it as not found
+	err = testRepository.Delete(ctx, comment.ID, time.Now())
+	assert.Equal(t, repository.ErrCommentNotFound, err)
+}
+
+func TestDeleteNotFound(t *testing.T) {
+	setupTest(t)
+
+	err := testRepository.Delete(context.Background(), uuid.New(), time.Now())
+	assert.Equal(t, repository.ErrCommentNotFound, err)
+}
@@ -0,0 +1,82 @@
+package repository_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/fikryfahrezy/let-it-go/feature/comment/repository"
+	"github.com/fikryfahrezy/let-it-go/pkg/database"
+	"github.com/fikryfahrezy/let-it-go/pkg/logger"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeleteUnit(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	// nolint:errcheck
+	defer sqlDB.Close()
+
+	db := &database.DB{DB: sqlDB}
+	repo := repository.NewCommentRepository(logger.NewDiscardLogger(), db)
+	ctx := context.Background()
+
+	id := uuid.New()
+	deletedAt := time.Now()
+
+	// Mock the UPDATE query clearing the content
+	mock.ExpectExec(`UPDATE comments SET content = '', deleted_at = \? WHERE id = \? AND deleted_at IS NULL`).
+		WithArgs(deletedAt, id).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	err = repo.Delete(ctx, id, deletedAt)
+	assert.NoError(t, err)
+
+	// Verify all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestDeleteNotFoundUnit(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	// nolint:errcheck
+	defer sqlDB.Close()
+
+	db := &database.DB{DB: sqlDB}
+	repo := repository.NewCommentRepository(logger.NewDiscardLogger(), db)
+	ctx := context.Background()
+
+	// Mock the UPDATE query to return 0 affected rows
+	mock.ExpectExec("UPDATE comments SET content = '', deleted_at").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	err = repo.Delete(ctx, uuid.New(), time.Now())
+	assert.Equal(t, repository.ErrCommentNotFound, err)
+
+	// Verify all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestDeleteErrorUnit(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	// nolint:errcheck
+	defer sqlDB.Close()
+
+	db := &database.DB{DB: sqlDB}
+	repo := repository.NewCommentRepository(logger.NewDiscardLogger(), db)
+	ctx := context.Background()
+
+	mock.ExpectExec("UPDATE comments SET content = '', deleted_at").
+		WillReturnError(errors.New("connection lost"))
+
+	err = repo.Delete(ctx, uuid.New(), time.Now())
+	assert.ErrorIs(t, err, repository.ErrFailedToDeleteComment)
+
+	// Verify all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,36 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Comment is left by a user on a blog, either at the top level or as a reply to another
+// comment of the same blog. Deleted comments keep their place in the thread without content.
+type Comment struct {
+	ID          uuid.UUID  `db:"id"`        // UUIDv7
+	BlogID      uuid.UUID  `db:"blog_id"`   // UUIDv7
+	ParentID    *uuid.UUID `db:"parent_id"` // Nil for top-level comments
+	AuthorID    uuid.UUID  `db:"author_id"` // UUIDv7
+	Content     string     `db:"content"`
+	Status      string     `db:"status"`
+	ModeratedBy *uuid.UUID `db:"moderated_by"` // UUIDv7 of the moderator who last approved or rejected it
+	ModeratedAt *time.Time `db:"moderated_at"`
+	DeletedAt   *time.Time `db:"deleted_at"`
+	CreatedAt   time.Time  `db:"created_at"`
+	UpdatedAt   time.Time  `db:"updated_at"`
+}
+
+// Comments wait in the moderation queue until they are approved or rejected.
+// Only approved comments are shown on the blog.
+const (
+	StatusPending  = "pending"
+	StatusApproved = "approved"
+	StatusRejected = "rejected"
+)
+
+// IsDeleted reports whether the author deleted the comment
+func (c Comment) IsDeleted() bool {
+	return c.DeletedAt != nil
+}
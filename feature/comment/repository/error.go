@@ -0,0 +1,24 @@
+package repository
+
+import "github.com/fikryfahrezy/let-it-go/pkg/app_error"
+
+// Repository errors
+var (
+	// Comment not found errors
+	ErrCommentNotFound = app_error.New("COMMENT-COMMENT_NOT_FOUND", "comment not found")
+
+	// Database operation errors
+	ErrFailedToCreateComment = app_error.New("COMMENT-FAILED_TO_CREATE_COMMENT", "failed to create comment")
+	ErrFailedToGetComment    = app_error.New("COMMENT-FAILED_TO_GET_COMMENT", "failed to get comment")
+	ErrFailedToListComments  = app_error.New("COMMENT-FAILED_TO_LIST_COMMENTS", "failed to list comments")
+	ErrFailedToCountComments = app_error.New("COMMENT-FAILED_TO_COUNT_COMMENTS", "failed to count comments")
+	ErrFailedToUpdateComment = app_error.New("COMMENT-FAILED_TO_UPDATE_COMMENT", "failed to update comment")
+	ErrFailedToDeleteComment = app_error.New("COMMENT-FAILED_TO_DELETE_COMMENT", "failed to delete comment")
+
+	// Row scanning errors
+	ErrFailedToScanCommentRow = app_error.New("COMMENT-FAILED_TO_SCAN_COMMENT_ROW", "failed to scan comment row")
+
+	// Database result errors
+	ErrFailedToGetRowsAffected = app_error.New("COMMENT-FAILED_TO_GET_ROWS_AFFECTED", "failed to get rows affected")
+	ErrFailedToIterateRows     = app_error.New("COMMENT-FAILED_TO_ITERATE_ROWS", "error iterating comment rows")
+)
@@ -0,0 +1,46 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+
+	"github.com/google/uuid"
+)
+
+// GetByID finds a comment that was not deleted
+func (r *commentRepository) GetByID(ctx context.Context, id uuid.UUID) (Comment, error) {
+	query := `
+		SELECT id, blog_id, parent_id, author_id, content, status, moderated_by, moderated_at, deleted_at, created_at, updated_at
+		FROM comments
+		WHERE id = ? AND deleted_at IS NULL
+	`
+
+	var comment Comment
+	err := r.db.QueryRowContext(ctx, query, id).Scan(
+		&comment.ID,
+		&comment.BlogID,
+		&comment.ParentID,
+		&comment.AuthorID,
+		&comment.Content,
+		&comment.Status,
+		&comment.ModeratedBy,
+		&comment.ModeratedAt,
+		&comment.DeletedAt,
+		&comment.CreatedAt,
+		&comment.UpdatedAt,
+	)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return Comment{}, ErrCommentNotFound
+		}
+		r.log.Error("Failed to get comment by ID",
+			slog.String("error", err.Error()),
+			slog.String("comment_id", id.String()),
+		)
+		return Comment{}, fmt.Errorf("%w: %w", ErrFailedToGetComment, err)
+	}
+
+	return comment, nil
+}
@@ -0,0 +1,44 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/fikryfahrezy/let-it-go/feature/comment/repository"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetByID(t *testing.T) {
+	userID, blogID := setupTest(t)
+	ctx := context.Background()
+
+	comment := newTestComment(blogID, userID, nil, "Nice post", repository.StatusApproved)
+	require.NoError(t, testRepository.Create(ctx, comment))
+
+	result, err := testRepository.GetByID(ctx, comment.ID)
+	require.NoError(t, err)
+	assert.Equal(t, comment.ID, result.ID)
+	assert.Equal(t, repository.StatusApproved, result.Status)
+}
+
+func TestGetByIDDeleted(t *testing.T) {
+	userID, blogID := setupTest(t)
+	ctx := context.Background()
+
+	comment := newTestComment(blogID, userID, nil, "Nice post", repository.StatusApproved)
+	require.NoError(t, testRepository.Create(ctx, comment))
+	require.NoError(t, testRepository.Delete(ctx, comment.ID, time.Now()))
+
+	_, err := testRepository.GetByID(ctx, comment.ID)
+	assert.Equal(t, repository.ErrCommentNotFound, err)
+}
+
+func TestGetByIDNotFound(t *testing.T) {
+	setupTest(t)
+
+	_, err := testRepository.GetByID(context.Background(), uuid.New())
+	assert.Equal(t, repository.ErrCommentNotFound, err)
+}
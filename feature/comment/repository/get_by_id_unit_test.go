@@ -0,0 +1,95 @@
+package repository_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/fikryfahrezy/let-it-go/feature/comment/repository"
+	"github.com/fikryfahrezy/let-it-go/pkg/database"
+	"github.com/fikryfahrezy/let-it-go/pkg/logger"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var commentColumns = []string{"id", "blog_id", "parent_id", "author_id", "content", "status", "moderated_by", "moderated_at", "deleted_at", "created_at", "updated_at"}
+
+func TestGetByIDUnit(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	// nolint:errcheck
+	defer sqlDB.Close()
+
+	db := &database.DB{DB: sqlDB}
+	repo := repository.NewCommentRepository(logger.NewDiscardLogger(), db)
+	ctx := context.Background()
+
+	comment := newTestComment(uuid.New(), uuid.New(), nil, "Nice post", repository.StatusApproved)
+	moderatorID := uuid.New()
+	now := time.Now()
+
+	// Mock the SELECT query
+	rows := sqlmock.NewRows(commentColumns).
+		AddRow(comment.ID, comment.BlogID, nil, comment.AuthorID, comment.Content, comment.Status, moderatorID, now, nil, now, now)
+	mock.ExpectQuery(`SELECT (.+) FROM comments WHERE id = \? AND deleted_at IS NULL`).
+		WithArgs(comment.ID).
+		WillReturnRows(rows)
+
+	result, err := repo.GetByID(ctx, comment.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, comment.ID, result.ID)
+	assert.Equal(t, comment.BlogID, result.BlogID)
+	assert.Nil(t, result.ParentID)
+	assert.Equal(t, "Nice post", result.Content)
+	require.NotNil(t, result.ModeratedBy)
+	assert.Equal(t, moderatorID, *result.ModeratedBy)
+	assert.False(t, result.IsDeleted())
+
+	// Verify all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetByIDNotFoundUnit(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	// nolint:errcheck
+	defer sqlDB.Close()
+
+	db := &database.DB{DB: sqlDB}
+	repo := repository.NewCommentRepository(logger.NewDiscardLogger(), db)
+	ctx := context.Background()
+
+	// Mock the SELECT query to return no rows
+	mock.ExpectQuery("SELECT (.+) FROM comments WHERE id = ?").
+		WillReturnError(sql.ErrNoRows)
+
+	_, err = repo.GetByID(ctx, uuid.New())
+	assert.Equal(t, repository.ErrCommentNotFound, err)
+
+	// Verify all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetByIDErrorUnit(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	// nolint:errcheck
+	defer sqlDB.Close()
+
+	db := &database.DB{DB: sqlDB}
+	repo := repository.NewCommentRepository(logger.NewDiscardLogger(), db)
+	ctx := context.Background()
+
+	mock.ExpectQuery("SELECT (.+) FROM comments").
+		WillReturnError(errors.New("connection lost"))
+
+	_, err = repo.GetByID(ctx, uuid.New())
+	assert.ErrorIs(t, err, repository.ErrFailedToGetComment)
+
+	// Verify all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,68 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/google/uuid"
+)
+
+// ListApprovedByBlogID lists every approved comment of a blog, oldest first. Deleted
+// comments are included so their replies keep a parent.
+func (r *commentRepository) ListApprovedByBlogID(ctx context.Context, blogID uuid.UUID) ([]Comment, error) {
+	query := `
+		SELECT id, blog_id, parent_id, author_id, content, status, moderated_by, moderated_at, deleted_at, created_at, updated_at
+		FROM comments
+		WHERE blog_id = ? AND status = ?
+		ORDER BY created_at ASC, id ASC
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, blogID, StatusApproved)
+	if err != nil {
+		r.log.Error("Failed to list blog comments",
+			slog.String("error", err.Error()),
+			slog.String("blog_id", blogID.String()),
+		)
+		return nil, fmt.Errorf("%w: %w", ErrFailedToListComments, err)
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			r.log.Error("Failed to close list blog comments rows", slog.String("error", err.Error()))
+		}
+	}()
+
+	var comments []Comment
+	for rows.Next() {
+		comment := Comment{}
+		err := rows.Scan(
+			&comment.ID,
+			&comment.BlogID,
+			&comment.ParentID,
+			&comment.AuthorID,
+			&comment.Content,
+			&comment.Status,
+			&comment.ModeratedBy,
+			&comment.ModeratedAt,
+			&comment.DeletedAt,
+			&comment.CreatedAt,
+			&comment.UpdatedAt,
+		)
+		if err != nil {
+			r.log.Error("Failed to scan comment row",
+				slog.String("error", err.Error()),
+			)
+			return nil, fmt.Errorf("%w: %w", ErrFailedToScanCommentRow, err)
+		}
+		comments = append(comments, comment)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.log.Error("Error iterating comment rows",
+			slog.String("error", err.Error()),
+		)
+		return nil, fmt.Errorf("%w: %w", ErrFailedToIterateRows, err)
+	}
+
+	return comments, nil
+}
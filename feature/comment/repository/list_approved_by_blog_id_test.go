@@ -0,0 +1,37 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/fikryfahrezy/let-it-go/feature/comment/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListApprovedByBlogID(t *testing.T) {
+	userID, blogID := setupTest(t)
+	ctx := context.Background()
+
+	parent := newTestComment(blogID, userID, nil, "First", repository.StatusApproved)
+	reply := newTestComment(blogID, userID, &parent.ID, "Reply", repository.StatusApproved)
+	pending := newTestComment(blogID, userID, nil, "Pending", repository.StatusPending)
+	rejected := newTestComment(blogID, userID, nil, "Rejected", repository.StatusRejected)
+	require.NoError(t, testRepository.Create(ctx, parent))
+	require.NoError(t, testRepository.Create(ctx, reply))
+	require.NoError(t, testRepository.Create(ctx, pending))
+	require.NoError(t, testRepository.Create(ctx, rejected))
+
+	// Deleted comments stay in the list so the reply keeps its parent
+	require.NoError(t, testRepository.Delete(ctx, parent.ID, time.Now()))
+
+	result, err := testRepository.ListApprovedByBlogID(ctx, blogID)
+	require.NoError(t, err)
+	require.Len(t, result, 2)
+	assert.Equal(t, parent.ID, result[0].ID)
+	assert.True(t, result[0].IsDeleted())
+	assert.Empty(t, result[0].Content)
+	assert.Equal(t, reply.ID, result[1].ID)
+	assert.Equal(t, "Reply", result[1].Content)
+}
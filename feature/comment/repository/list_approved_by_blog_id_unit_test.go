@@ -0,0 +1,70 @@
+package repository_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/fikryfahrezy/let-it-go/feature/comment/repository"
+	"github.com/fikryfahrezy/let-it-go/pkg/database"
+	"github.com/fikryfahrezy/let-it-go/pkg/logger"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListApprovedByBlogIDUnit(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	// nolint:errcheck
+	defer sqlDB.Close()
+
+	db := &database.DB{DB: sqlDB}
+	repo := repository.NewCommentRepository(logger.NewDiscardLogger(), db)
+	ctx := context.Background()
+
+	blogID := uuid.New()
+	parent := newTestComment(blogID, uuid.New(), nil, "First", repository.StatusApproved)
+	reply := newTestComment(blogID, uuid.New(), &parent.ID, "Reply", repository.StatusApproved)
+	now := time.Now()
+
+	// Mock the SELECT query
+	rows := sqlmock.NewRows(commentColumns).
+		AddRow(parent.ID, blogID, nil, parent.AuthorID, "", parent.Status, nil, nil, now, now, now).
+		AddRow(reply.ID, blogID, parent.ID, reply.AuthorID, reply.Content, reply.Status, nil, nil, nil, now, now)
+	mock.ExpectQuery(`SELECT (.+) FROM comments WHERE blog_id = \? AND status = \? ORDER BY created_at ASC, id ASC`).
+		WithArgs(blogID, repository.StatusApproved).
+		WillReturnRows(rows)
+
+	result, err := repo.ListApprovedByBlogID(ctx, blogID)
+	assert.NoError(t, err)
+	require.Len(t, result, 2)
+	assert.True(t, result[0].IsDeleted())
+	require.NotNil(t, result[1].ParentID)
+	assert.Equal(t, parent.ID, *result[1].ParentID)
+
+	// Verify all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestListApprovedByBlogIDErrorUnit(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	// nolint:errcheck
+	defer sqlDB.Close()
+
+	db := &database.DB{DB: sqlDB}
+	repo := repository.NewCommentRepository(logger.NewDiscardLogger(), db)
+	ctx := context.Background()
+
+	mock.ExpectQuery("SELECT (.+) FROM comments").
+		WillReturnError(errors.New("connection lost"))
+
+	_, err = repo.ListApprovedByBlogID(ctx, uuid.New())
+	assert.ErrorIs(t, err, repository.ErrFailedToListComments)
+
+	// Verify all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
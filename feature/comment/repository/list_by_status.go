@@ -0,0 +1,67 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+)
+
+// ListByStatus lists the comments of every blog having the status, oldest first, so the
+// moderation queue is worked through in the order comments arrived. Deleted comments are left out.
+func (r *commentRepository) ListByStatus(ctx context.Context, status string, limit, offset int) ([]Comment, error) {
+	query := `
+		SELECT id, blog_id, parent_id, author_id, content, status, moderated_by, moderated_at, deleted_at, created_at, updated_at
+		FROM comments
+		WHERE status = ? AND deleted_at IS NULL
+		ORDER BY created_at ASC, id ASC
+		LIMIT ? OFFSET ?
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, status, limit, offset)
+	if err != nil {
+		r.log.Error("Failed to list comments by status",
+			slog.String("error", err.Error()),
+			slog.String("status", status),
+		)
+		return nil, fmt.Errorf("%w: %w", ErrFailedToListComments, err)
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			r.log.Error("Failed to close list comments by status rows", slog.String("error", err.Error()))
+		}
+	}()
+
+	var comments []Comment
+	for rows.Next() {
+		comment := Comment{}
+		err := rows.Scan(
+			&comment.ID,
+			&comment.BlogID,
+			&comment.ParentID,
+			&comment.AuthorID,
+			&comment.Content,
+			&comment.Status,
+			&comment.ModeratedBy,
+			&comment.ModeratedAt,
+			&comment.DeletedAt,
+			&comment.CreatedAt,
+			&comment.UpdatedAt,
+		)
+		if err != nil {
+			r.log.Error("Failed to scan comment row",
+				slog.String("error", err.Error()),
+			)
+			return nil, fmt.Errorf("%w: %w", ErrFailedToScanCommentRow, err)
+		}
+		comments = append(comments, comment)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.log.Error("Error iterating comment rows",
+			slog.String("error", err.Error()),
+		)
+		return nil, fmt.Errorf("%w: %w", ErrFailedToIterateRows, err)
+	}
+
+	return comments, nil
+}
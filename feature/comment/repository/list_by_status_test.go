@@ -0,0 +1,49 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/fikryfahrezy/let-it-go/feature/comment/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListByStatus(t *testing.T) {
+	userID, blogID := setupTest(t)
+	ctx := context.Background()
+
+	first := newTestComment(blogID, userID, nil, "First", repository.StatusPending)
+	second := newTestComment(blogID, userID, nil, "Second", repository.StatusPending)
+	deleted := newTestComment(blogID, userID, nil, "Deleted", repository.StatusPending)
+	approved := newTestComment(blogID, userID, nil, "Approved", repository.StatusApproved)
+	require.NoError(t, testRepository.Create(ctx, first))
+	require.NoError(t, testRepository.Create(ctx, second))
+	require.NoError(t, testRepository.Create(ctx, deleted))
+	require.NoError(t, testRepository.Create(ctx, approved))
+	require.NoError(t, testRepository.Delete(ctx, deleted.ID, time.Now()))
+
+	result, err := testRepository.ListByStatus(ctx, repository.StatusPending, 10, 0)
+	require.NoError(t, err)
+	require.Len(t, result, 2)
+	assert.Equal(t, first.ID, result[0].ID)
+	assert.Equal(t, second.ID, result[1].ID)
+}
+
+func TestListByStatusPagination(t *testing.T) {
+	userID, blogID := setupTest(t)
+	ctx := context.Background()
+
+	for _, content := range []string{"First", "Second", "Third"} {
+		require.NoError(t, testRepository.Create(ctx, newTestComment(blogID, userID, nil, content, repository.StatusPending)))
+	}
+
+	firstPage, err := testRepository.ListByStatus(ctx, repository.StatusPending, 2, 0)
+	require.NoError(t, err)
+	assert.Len(t, firstPage, 2)
+
+	secondPage, err := testRepository.ListByStatus(ctx, repository.StatusPending, 2, 2)
+	require.NoError(t, err)
+	assert.Len(t, secondPage, 1)
+}
@@ -0,0 +1,68 @@
+package repository_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/fikryfahrezy/let-it-go/feature/comment/repository"
+	"github.com/fikryfahrezy/let-it-go/pkg/database"
+	"github.com/fikryfahrezy/let-it-go/pkg/logger"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListByStatusUnit(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	// nolint:errcheck
+	defer sqlDB.Close()
+
+	db := &database.DB{DB: sqlDB}
+	repo := repository.NewCommentRepository(logger.NewDiscardLogger(), db)
+	ctx := context.Background()
+
+	first := newTestComment(uuid.New(), uuid.New(), nil, "First", repository.StatusPending)
+	second := newTestComment(uuid.New(), uuid.New(), nil, "Second", repository.StatusPending)
+	now := time.Now()
+
+	// Mock the SELECT query
+	rows := sqlmock.NewRows(commentColumns).
+		AddRow(first.ID, first.BlogID, nil, first.AuthorID, first.Content, first.Status, nil, nil, nil, now, now).
+		AddRow(second.ID, second.BlogID, nil, second.AuthorID, second.Content, second.Status, nil, nil, nil, now, now)
+	mock.ExpectQuery(`SELECT (.+) FROM comments WHERE status = \? AND deleted_at IS NULL ORDER BY created_at ASC, id ASC LIMIT \? OFFSET \?`).
+		WithArgs(repository.StatusPending, 10, 0).
+		WillReturnRows(rows)
+
+	result, err := repo.ListByStatus(ctx, repository.StatusPending, 10, 0)
+	assert.NoError(t, err)
+	require.Len(t, result, 2)
+	assert.Equal(t, first.ID, result[0].ID)
+	assert.Equal(t, second.Content, result[1].Content)
+
+	// Verify all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestListByStatusErrorUnit(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	// nolint:errcheck
+	defer sqlDB.Close()
+
+	db := &database.DB{DB: sqlDB}
+	repo := repository.NewCommentRepository(logger.NewDiscardLogger(), db)
+	ctx := context.Background()
+
+	mock.ExpectQuery("SELECT (.+) FROM comments").
+		WillReturnError(errors.New("connection lost"))
+
+	_, err = repo.ListByStatus(ctx, repository.StatusPending, 10, 0)
+	assert.ErrorIs(t, err, repository.ErrFailedToListComments)
+
+	// Verify all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
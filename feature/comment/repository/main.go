@@ -0,0 +1,3 @@
+package repository
+
+//go:generate go tool counterfeiter -generate
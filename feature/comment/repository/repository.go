@@ -0,0 +1,19 @@
+package repository
+
+import (
+	"log/slog"
+
+	"github.com/fikryfahrezy/let-it-go/pkg/database"
+)
+
+type commentRepository struct {
+	db  *database.DB
+	log *slog.Logger
+}
+
+func NewCommentRepository(log *slog.Logger, db *database.DB) *commentRepository {
+	return &commentRepository{
+		db:  db,
+		log: log,
+	}
+}
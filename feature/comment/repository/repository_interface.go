@@ -0,0 +1,21 @@
+package repository
+
+//counterfeiter:generate -o repositoryfakes/fake_comment_repository.go . CommentRepository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type CommentRepository interface {
+	Create(ctx context.Context, comment Comment) error
+	GetByID(ctx context.Context, id uuid.UUID) (Comment, error)
+	ListApprovedByBlogID(ctx context.Context, blogID uuid.UUID) ([]Comment, error)
+	ListByStatus(ctx context.Context, status string, limit, offset int) ([]Comment, error)
+	CountByStatus(ctx context.Context, status string) (int64, error)
+	CountApprovedByBlogIDs(ctx context.Context, blogIDs []uuid.UUID) (map[uuid.UUID]int64, error)
+	Update(ctx context.Context, comment Comment) error
+	Delete(ctx context.Context, id uuid.UUID, deletedAt time.Time) error
+}
@@ -0,0 +1,668 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package repositoryfakes
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/fikryfahrezy/let-it-go/feature/comment/repository"
+	"github.com/google/uuid"
+)
+
+type FakeCommentRepository struct {
+	CountApprovedByBlogIDsStub        func(context.Context, []uuid.UUID) (map[uuid.UUID]int64, error)
+	countApprovedByBlogIDsMutex       sync.RWMutex
+	countApprovedByBlogIDsArgsForCall []struct {
+		arg1 context.Context
+		arg2 []uuid.UUID
+	}
+	countApprovedByBlogIDsReturns struct {
+		result1 map[uuid.UUID]int64
+		result2 error
+	}
+	countApprovedByBlogIDsReturnsOnCall map[int]struct {
+		result1 map[uuid.UUID]int64
+		result2 error
+	}
+	CountByStatusStub        func(context.Context, string) (int64, error)
+	countByStatusMutex       sync.RWMutex
+	countByStatusArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	countByStatusReturns struct {
+		result1 int64
+		result2 error
+	}
+	countByStatusReturnsOnCall map[int]struct {
+		result1 int64
+		result2 error
+	}
+	CreateStub        func(context.Context, repository.Comment) error
+	createMutex       sync.RWMutex
+	createArgsForCall []struct {
+		arg1 context.Context
+		arg2 repository.Comment
+	}
+	createReturns struct {
+		result1 error
+	}
+	createReturnsOnCall map[int]struct {
+		result1 error
+	}
+	DeleteStub        func(context.Context, uuid.UUID, time.Time) error
+	deleteMutex       sync.RWMutex
+	deleteArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 time.Time
+	}
+	deleteReturns struct {
+		result1 error
+	}
+	deleteReturnsOnCall map[int]struct {
+		result1 error
+	}
+	GetByIDStub        func(context.Context, uuid.UUID) (repository.Comment, error)
+	getByIDMutex       sync.RWMutex
+	getByIDArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}
+	getByIDReturns struct {
+		result1 repository.Comment
+		result2 error
+	}
+	getByIDReturnsOnCall map[int]struct {
+		result1 repository.Comment
+		result2 error
+	}
+	ListApprovedByBlogIDStub        func(context.Context, uuid.UUID) ([]repository.Comment, error)
+	listApprovedByBlogIDMutex       sync.RWMutex
+	listApprovedByBlogIDArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}
+	listApprovedByBlogIDReturns struct {
+		result1 []repository.Comment
+		result2 error
+	}
+	listApprovedByBlogIDReturnsOnCall map[int]struct {
+		result1 []repository.Comment
+		result2 error
+	}
+	ListByStatusStub        func(context.Context, string, int, int) ([]repository.Comment, error)
+	listByStatusMutex       sync.RWMutex
+	listByStatusArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 int
+		arg4 int
+	}
+	listByStatusReturns struct {
+		result1 []repository.Comment
+		result2 error
+	}
+	listByStatusReturnsOnCall map[int]struct {
+		result1 []repository.Comment
+		result2 error
+	}
+	UpdateStub        func(context.Context, repository.Comment) error
+	updateMutex       sync.RWMutex
+	updateArgsForCall []struct {
+		arg1 context.Context
+		arg2 repository.Comment
+	}
+	updateReturns struct {
+		result1 error
+	}
+	updateReturnsOnCall map[int]struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeCommentRepository) CountApprovedByBlogIDs(arg1 context.Context, arg2 []uuid.UUID) (map[uuid.UUID]int64, error) {
+	var arg2Copy []uuid.UUID
+	if arg2 != nil {
+		arg2Copy = make([]uuid.UUID, len(arg2))
+		copy(arg2Copy, arg2)
+	}
+	fake.countApprovedByBlogIDsMutex.Lock()
+	ret, specificReturn := fake.countApprovedByBlogIDsReturnsOnCall[len(fake.countApprovedByBlogIDsArgsForCall)]
+	fake.countApprovedByBlogIDsArgsForCall = append(fake.countApprovedByBlogIDsArgsForCall, struct {
+		arg1 context.Context
+		arg2 []uuid.UUID
+	}{arg1, arg2Copy})
+	stub := fake.CountApprovedByBlogIDsStub
+	fakeReturns := fake.countApprovedByBlogIDsReturns
+	fake.recordInvocation("CountApprovedByBlogIDs", []interface{}{arg1, arg2Copy})
+	fake.countApprovedByBlogIDsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeCommentRepository) CountApprovedByBlogIDsCallCount() int {
+	fake.countApprovedByBlogIDsMutex.RLock()
+	defer fake.countApprovedByBlogIDsMutex.RUnlock()
+	return len(fake.countApprovedByBlogIDsArgsForCall)
+}
+
+func (fake *FakeCommentRepository) CountApprovedByBlogIDsCalls(stub func(context.Context, []uuid.UUID) (map[uuid.UUID]int64, error)) {
+	fake.countApprovedByBlogIDsMutex.Lock()
+	defer fake.countApprovedByBlogIDsMutex.Unlock()
+	fake.CountApprovedByBlogIDsStub = stub
+}
+
+func (fake *FakeCommentRepository) CountApprovedByBlogIDsArgsForCall(i int) (context.Context, []uuid.UUID) {
+	fake.countApprovedByBlogIDsMutex.RLock()
+	defer fake.countApprovedByBlogIDsMutex.RUnlock()
+	argsForCall := fake.countApprovedByBlogIDsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeCommentRepository) CountApprovedByBlogIDsReturns(result1 map[uuid.UUID]int64, result2 error) {
+	fake.countApprovedByBlogIDsMutex.Lock()
+	defer fake.countApprovedByBlogIDsMutex.Unlock()
+	fake.CountApprovedByBlogIDsStub = nil
+	fake.countApprovedByBlogIDsReturns = struct {
+		result1 map[uuid.UUID]int64
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCommentRepository) CountApprovedByBlogIDsReturnsOnCall(i int, result1 map[uuid.UUID]int64, result2 error) {
+	fake.countApprovedByBlogIDsMutex.Lock()
+	defer fake.countApprovedByBlogIDsMutex.Unlock()
+	fake.CountApprovedByBlogIDsStub = nil
+	if fake.countApprovedByBlogIDsReturnsOnCall == nil {
+		fake.countApprovedByBlogIDsReturnsOnCall = make(map[int]struct {
+			result1 map[uuid.UUID]int64
+			result2 error
+		})
+	}
+	fake.countApprovedByBlogIDsReturnsOnCall[i] = struct {
+		result1 map[uuid.UUID]int64
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCommentRepository) CountByStatus(arg1 context.Context, arg2 string) (int64, error) {
+	fake.countByStatusMutex.Lock()
+	ret, specificReturn := fake.countByStatusReturnsOnCall[len(fake.countByStatusArgsForCall)]
+	fake.countByStatusArgsForCall = append(fake.countByStatusArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.CountByStatusStub
+	fakeReturns := fake.countByStatusReturns
+	fake.recordInvocation("CountByStatus", []interface{}{arg1, arg2})
+	fake.countByStatusMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeCommentRepository) CountByStatusCallCount() int {
+	fake.countByStatusMutex.RLock()
+	defer fake.countByStatusMutex.RUnlock()
+	return len(fake.countByStatusArgsForCall)
+}
+
+func (fake *FakeCommentRepository) CountByStatusCalls(stub func(context.Context, string) (int64, error)) {
+	fake.countByStatusMutex.Lock()
+	defer fake.countByStatusMutex.Unlock()
+	fake.CountByStatusStub = stub
+}
+
+func (fake *FakeCommentRepository) CountByStatusArgsForCall(i int) (context.Context, string) {
+	fake.countByStatusMutex.RLock()
+	defer fake.countByStatusMutex.RUnlock()
+	argsForCall := fake.countByStatusArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeCommentRepository) CountByStatusReturns(result1 int64, result2 error) {
+	fake.countByStatusMutex.Lock()
+	defer fake.countByStatusMutex.Unlock()
+	fake.CountByStatusStub = nil
+	fake.countByStatusReturns = struct {
+		result1 int64
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCommentRepository) CountByStatusReturnsOnCall(i int, result1 int64, result2 error) {
+	fake.countByStatusMutex.Lock()
+	defer fake.countByStatusMutex.Unlock()
+	fake.CountByStatusStub = nil
+	if fake.countByStatusReturnsOnCall == nil {
+		fake.countByStatusReturnsOnCall = make(map[int]struct {
+			result1 int64
+			result2 error
+		})
+	}
+	fake.countByStatusReturnsOnCall[i] = struct {
+		result1 int64
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCommentRepository) Create(arg1 context.Context, arg2 repository.Comment) error {
+	fake.createMutex.Lock()
+	ret, specificReturn := fake.createReturnsOnCall[len(fake.createArgsForCall)]
+	fake.createArgsForCall = append(fake.createArgsForCall, struct {
+		arg1 context.Context
+		arg2 repository.Comment
+	}{arg1, arg2})
+	stub := fake.CreateStub
+	fakeReturns := fake.createReturns
+	fake.recordInvocation("Create", []interface{}{arg1, arg2})
+	fake.createMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeCommentRepository) CreateCallCount() int {
+	fake.createMutex.RLock()
+	defer fake.createMutex.RUnlock()
+	return len(fake.createArgsForCall)
+}
+
+func (fake *FakeCommentRepository) CreateCalls(stub func(context.Context, repository.Comment) error) {
+	fake.createMutex.Lock()
+	defer fake.createMutex.Unlock()
+	fake.CreateStub = stub
+}
+
+func (fake *FakeCommentRepository) CreateArgsForCall(i int) (context.Context, repository.Comment) {
+	fake.createMutex.RLock()
+	defer fake.createMutex.RUnlock()
+	argsForCall := fake.createArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeCommentRepository) CreateReturns(result1 error) {
+	fake.createMutex.Lock()
+	defer fake.createMutex.Unlock()
+	fake.CreateStub = nil
+	fake.createReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeCommentRepository) CreateReturnsOnCall(i int, result1 error) {
+	fake.createMutex.Lock()
+	defer fake.createMutex.Unlock()
+	fake.CreateStub = nil
+	if fake.createReturnsOnCall == nil {
+		fake.createReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.createReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeCommentRepository) Delete(arg1 context.Context, arg2 uuid.UUID, arg3 time.Time) error {
+	fake.deleteMutex.Lock()
+	ret, specificReturn := fake.deleteReturnsOnCall[len(fake.deleteArgsForCall)]
+	fake.deleteArgsForCall = append(fake.deleteArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 time.Time
+	}{arg1, arg2, arg3})
+	stub := fake.DeleteStub
+	fakeReturns := fake.deleteReturns
+	fake.recordInvocation("Delete", []interface{}{arg1, arg2, arg3})
+	fake.deleteMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeCommentRepository) DeleteCallCount() int {
+	fake.deleteMutex.RLock()
+	defer fake.deleteMutex.RUnlock()
+	return len(fake.deleteArgsForCall)
+}
+
+func (fake *FakeCommentRepository) DeleteCalls(stub func(context.Context, uuid.UUID, time.Time) error) {
+	fake.deleteMutex.Lock()
+	defer fake.deleteMutex.Unlock()
+	fake.DeleteStub = stub
+}
+
+func (fake *FakeCommentRepository) DeleteArgsForCall(i int) (context.Context, uuid.UUID, time.Time) {
+	fake.deleteMutex.RLock()
+	defer fake.deleteMutex.RUnlock()
+	argsForCall := fake.deleteArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeCommentRepository) DeleteReturns(result1 error) {
+	fake.deleteMutex.Lock()
+	defer fake.deleteMutex.Unlock()
+	fake.DeleteStub = nil
+	fake.deleteReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeCommentRepository) DeleteReturnsOnCall(i int, result1 error) {
+	fake.deleteMutex.Lock()
+	defer fake.deleteMutex.Unlock()
+	fake.DeleteStub = nil
+	if fake.deleteReturnsOnCall == nil {
+		fake.deleteReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeCommentRepository) GetByID(arg1 context.Context, arg2 uuid.UUID) (repository.Comment, error) {
+	fake.getByIDMutex.Lock()
+	ret, specificReturn := fake.getByIDReturnsOnCall[len(fake.getByIDArgsForCall)]
+	fake.getByIDArgsForCall = append(fake.getByIDArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}{arg1, arg2})
+	stub := fake.GetByIDStub
+	fakeReturns := fake.getByIDReturns
+	fake.recordInvocation("GetByID", []interface{}{arg1, arg2})
+	fake.getByIDMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeCommentRepository) GetByIDCallCount() int {
+	fake.getByIDMutex.RLock()
+	defer fake.getByIDMutex.RUnlock()
+	return len(fake.getByIDArgsForCall)
+}
+
+func (fake *FakeCommentRepository) GetByIDCalls(stub func(context.Context, uuid.UUID) (repository.Comment, error)) {
+	fake.getByIDMutex.Lock()
+	defer fake.getByIDMutex.Unlock()
+	fake.GetByIDStub = stub
+}
+
+func (fake *FakeCommentRepository) GetByIDArgsForCall(i int) (context.Context, uuid.UUID) {
+	fake.getByIDMutex.RLock()
+	defer fake.getByIDMutex.RUnlock()
+	argsForCall := fake.getByIDArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeCommentRepository) GetByIDReturns(result1 repository.Comment, result2 error) {
+	fake.getByIDMutex.Lock()
+	defer fake.getByIDMutex.Unlock()
+	fake.GetByIDStub = nil
+	fake.getByIDReturns = struct {
+		result1 repository.Comment
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCommentRepository) GetByIDReturnsOnCall(i int, result1 repository.Comment, result2 error) {
+	fake.getByIDMutex.Lock()
+	defer fake.getByIDMutex.Unlock()
+	fake.GetByIDStub = nil
+	if fake.getByIDReturnsOnCall == nil {
+		fake.getByIDReturnsOnCall = make(map[int]struct {
+			result1 repository.Comment
+			result2 error
+		})
+	}
+	fake.getByIDReturnsOnCall[i] = struct {
+		result1 repository.Comment
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCommentRepository) ListApprovedByBlogID(arg1 context.Context, arg2 uuid.UUID) ([]repository.Comment, error) {
+	fake.listApprovedByBlogIDMutex.Lock()
+	ret, specificReturn := fake.listApprovedByBlogIDReturnsOnCall[len(fake.listApprovedByBlogIDArgsForCall)]
+	fake.listApprovedByBlogIDArgsForCall = append(fake.listApprovedByBlogIDArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}{arg1, arg2})
+	stub := fake.ListApprovedByBlogIDStub
+	fakeReturns := fake.listApprovedByBlogIDReturns
+	fake.recordInvocation("ListApprovedByBlogID", []interface{}{arg1, arg2})
+	fake.listApprovedByBlogIDMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeCommentRepository) ListApprovedByBlogIDCallCount() int {
+	fake.listApprovedByBlogIDMutex.RLock()
+	defer fake.listApprovedByBlogIDMutex.RUnlock()
+	return len(fake.listApprovedByBlogIDArgsForCall)
+}
+
+func (fake *FakeCommentRepository) ListApprovedByBlogIDCalls(stub func(context.Context, uuid.UUID) ([]repository.Comment, error)) {
+	fake.listApprovedByBlogIDMutex.Lock()
+	defer fake.listApprovedByBlogIDMutex.Unlock()
+	fake.ListApprovedByBlogIDStub = stub
+}
+
+func (fake *FakeCommentRepository) ListApprovedByBlogIDArgsForCall(i int) (context.Context, uuid.UUID) {
+	fake.listApprovedByBlogIDMutex.RLock()
+	defer fake.listApprovedByBlogIDMutex.RUnlock()
+	argsForCall := fake.listApprovedByBlogIDArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeCommentRepository) ListApprovedByBlogIDReturns(result1 []repository.Comment, result2 error) {
+	fake.listApprovedByBlogIDMutex.Lock()
+	defer fake.listApprovedByBlogIDMutex.Unlock()
+	fake.ListApprovedByBlogIDStub = nil
+	fake.listApprovedByBlogIDReturns = struct {
+		result1 []repository.Comment
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCommentRepository) ListApprovedByBlogIDReturnsOnCall(i int, result1 []repository.Comment, result2 error) {
+	fake.listApprovedByBlogIDMutex.Lock()
+	defer fake.listApprovedByBlogIDMutex.Unlock()
+	fake.ListApprovedByBlogIDStub = nil
+	if fake.listApprovedByBlogIDReturnsOnCall == nil {
+		fake.listApprovedByBlogIDReturnsOnCall = make(map[int]struct {
+			result1 []repository.Comment
+			result2 error
+		})
+	}
+	fake.listApprovedByBlogIDReturnsOnCall[i] = struct {
+		result1 []repository.Comment
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCommentRepository) ListByStatus(arg1 context.Context, arg2 string, arg3 int, arg4 int) ([]repository.Comment, error) {
+	fake.listByStatusMutex.Lock()
+	ret, specificReturn := fake.listByStatusReturnsOnCall[len(fake.listByStatusArgsForCall)]
+	fake.listByStatusArgsForCall = append(fake.listByStatusArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 int
+		arg4 int
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.ListByStatusStub
+	fakeReturns := fake.listByStatusReturns
+	fake.recordInvocation("ListByStatus", []interface{}{arg1, arg2, arg3, arg4})
+	fake.listByStatusMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeCommentRepository) ListByStatusCallCount() int {
+	fake.listByStatusMutex.RLock()
+	defer fake.listByStatusMutex.RUnlock()
+	return len(fake.listByStatusArgsForCall)
+}
+
+func (fake *FakeCommentRepository) ListByStatusCalls(stub func(context.Context, string, int, int) ([]repository.Comment, error)) {
+	fake.listByStatusMutex.Lock()
+	defer fake.listByStatusMutex.Unlock()
+	fake.ListByStatusStub = stub
+}
+
+func (fake *FakeCommentRepository) ListByStatusArgsForCall(i int) (context.Context, string, int, int) {
+	fake.listByStatusMutex.RLock()
+	defer fake.listByStatusMutex.RUnlock()
+	argsForCall := fake.listByStatusArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeCommentRepository) ListByStatusReturns(result1 []repository.Comment, result2 error) {
+	fake.listByStatusMutex.Lock()
+	defer fake.listByStatusMutex.Unlock()
+	fake.ListByStatusStub = nil
+	fake.listByStatusReturns = struct {
+		result1 []repository.Comment
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCommentRepository) ListByStatusReturnsOnCall(i int, result1 []repository.Comment, result2 error) {
+	fake.listByStatusMutex.Lock()
+	defer fake.listByStatusMutex.Unlock()
+	fake.ListByStatusStub = nil
+	if fake.listByStatusReturnsOnCall == nil {
+		fake.listByStatusReturnsOnCall = make(map[int]struct {
+			result1 []repository.Comment
+			result2 error
+		})
+	}
+	fake.listByStatusReturnsOnCall[i] = struct {
+		result1 []repository.Comment
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCommentRepository) Update(arg1 context.Context, arg2 repository.Comment) error {
+	fake.updateMutex.Lock()
+	ret, specificReturn := fake.updateReturnsOnCall[len(fake.updateArgsForCall)]
+	fake.updateArgsForCall = append(fake.updateArgsForCall, struct {
+		arg1 context.Context
+		arg2 repository.Comment
+	}{arg1, arg2})
+	stub := fake.UpdateStub
+	fakeReturns := fake.updateReturns
+	fake.recordInvocation("Update", []interface{}{arg1, arg2})
+	fake.updateMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeCommentRepository) UpdateCallCount() int {
+	fake.updateMutex.RLock()
+	defer fake.updateMutex.RUnlock()
+	return len(fake.updateArgsForCall)
+}
+
+func (fake *FakeCommentRepository) UpdateCalls(stub func(context.Context, repository.Comment) error) {
+	fake.updateMutex.Lock()
+	defer fake.updateMutex.Unlock()
+	fake.UpdateStub = stub
+}
+
+func (fake *FakeCommentRepository) UpdateArgsForCall(i int) (context.Context, repository.Comment) {
+	fake.updateMutex.RLock()
+	defer fake.updateMutex.RUnlock()
+	argsForCall := fake.updateArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeCommentRepository) UpdateReturns(result1 error) {
+	fake.updateMutex.Lock()
+	defer fake.updateMutex.Unlock()
+	fake.UpdateStub = nil
+	fake.updateReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeCommentRepository) UpdateReturnsOnCall(i int, result1 error) {
+	fake.updateMutex.Lock()
+	defer fake.updateMutex.Unlock()
+	fake.UpdateStub = nil
+	if fake.updateReturnsOnCall == nil {
+		fake.updateReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.updateReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeCommentRepository) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeCommentRepository) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ repository.CommentRepository = new(FakeCommentRepository)
@@ -0,0 +1,52 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+)
+
+// Update saves the content, status and moderation of a comment that was not deleted
+func (r *commentRepository) Update(ctx context.Context, comment Comment) error {
+	query := `
+		UPDATE comments
+		SET content = ?, status = ?, moderated_by = ?, moderated_at = ?, updated_at = ?
+		WHERE id = ? AND deleted_at IS NULL
+	`
+
+	result, err := r.db.ExecContext(ctx, query,
+		comment.Content,
+		comment.Status,
+		comment.ModeratedBy,
+		comment.ModeratedAt,
+		time.Now(),
+		comment.ID,
+	)
+	if err != nil {
+		r.log.Error("Failed to update comment",
+			slog.String("error", err.Error()),
+			slog.String("comment_id", comment.ID.String()),
+		)
+		return fmt.Errorf("%w: %w", ErrFailedToUpdateComment, err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		r.log.Error("Failed to get rows affected",
+			slog.String("error", err.Error()),
+		)
+		return fmt.Errorf("%w: %w", ErrFailedToGetRowsAffected, err)
+	}
+
+	if rowsAffected == 0 {
+		return ErrCommentNotFound
+	}
+
+	r.log.Info("Comment updated successfully",
+		slog.String("comment_id", comment.ID.String()),
+		slog.String("status", comment.Status),
+	)
+
+	return nil
+}
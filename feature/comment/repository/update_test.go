@@ -0,0 +1,59 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/fikryfahrezy/let-it-go/feature/comment/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpdate(t *testing.T) {
+	userID, blogID := setupTest(t)
+	ctx := context.Background()
+
+	comment := newTestComment(blogID, userID, nil, "Nice post", repository.StatusPending)
+	require.NoError(t, testRepository.Create(ctx, comment))
+
+	moderatedAt := time.Now().Truncate(time.Second)
+	comment.Status = repository.StatusApproved
+	comment.ModeratedBy = &userID
+	comment.ModeratedAt = &moderatedAt
+	require.NoError(t, testRepository.Update(ctx, comment))
+
+	updated, err := testRepository.GetByID(ctx, comment.ID)
+	require.NoError(t, err)
+	assert.Equal(t, repository.StatusApproved, updated.Status)
+	require.NotNil(t, updated.ModeratedBy)
+	assert.Equal(t, userID, *updated.ModeratedBy)
+	require.NotNil(t, updated.ModeratedAt)
+	assert.WithinDuration(t, moderatedAt, *updated.ModeratedAt, time.Second)
+
+	// An edit sends it back to the queue and clears the moderation
+	comment.Content = "Nice post, edited"
+	comment.Status = repository.StatusPending
+	comment.ModeratedBy = nil
+	comment.ModeratedAt = nil
+	require.NoError(t, testRepository.Update(ctx, comment))
+
+	updated, err = testRepository.GetByID(ctx, comment.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "Nice post, edited", updated.Content)
+	assert.Equal(t, repository.StatusPending, updated.Status)
+	assert.Nil(t, updated.ModeratedBy)
+}
+
+func TestUpdateDeleted(t *testing.T) {
+	userID, blogID := setupTest(t)
+	ctx := context.Background()
+
+	comment := newTestComment(blogID, userID, nil, "Nice post", repository.StatusApproved)
+	require.NoError(t, testRepository.Create(ctx, comment))
+	require.NoError(t, testRepository.Delete(ctx, comment.ID, time.Now()))
+
+	comment.Content = "Edited"
+	err := testRepository.Update(ctx, comment)
+	assert.Equal(t, repository.ErrCommentNotFound, err)
+}
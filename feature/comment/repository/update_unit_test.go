@@ -0,0 +1,85 @@
+package repository_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/fikryfahrezy/let-it-go/feature/comment/repository"
+	"github.com/fikryfahrezy/let-it-go/pkg/database"
+	"github.com/fikryfahrezy/let-it-go/pkg/logger"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpdateUnit(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	// nolint:errcheck
+	defer sqlDB.Close()
+
+	db := &database.DB{DB: sqlDB}
+	repo := repository.NewCommentRepository(logger.NewDiscardLogger(), db)
+	ctx := context.Background()
+
+	comment := newTestComment(uuid.New(), uuid.New(), nil, "Edited", repository.StatusApproved)
+	moderatorID := uuid.New()
+	moderatedAt := time.Now()
+	comment.ModeratedBy = &moderatorID
+	comment.ModeratedAt = &moderatedAt
+
+	// Mock the UPDATE query
+	mock.ExpectExec(`UPDATE comments SET content = \?, status = \?, moderated_by = \?, moderated_at = \?, updated_at = \? WHERE id = \? AND deleted_at IS NULL`).
+		WithArgs(comment.Content, comment.Status, comment.ModeratedBy, comment.ModeratedAt, sqlmock.AnyArg(), comment.ID).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	err = repo.Update(ctx, comment)
+	assert.NoError(t, err)
+
+	// Verify all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUpdateNotFoundUnit(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	// nolint:errcheck
+	defer sqlDB.Close()
+
+	db := &database.DB{DB: sqlDB}
+	repo := repository.NewCommentRepository(logger.NewDiscardLogger(), db)
+	ctx := context.Background()
+
+	// Mock the UPDATE query to return 0 affected rows
+	mock.ExpectExec("UPDATE comments SET content").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	err = repo.Update(ctx, newTestComment(uuid.New(), uuid.New(), nil, "Edited", repository.StatusPending))
+	assert.Equal(t, repository.ErrCommentNotFound, err)
+
+	// Verify all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUpdateErrorUnit(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	// nolint:errcheck
+	defer sqlDB.Close()
+
+	db := &database.DB{DB: sqlDB}
+	repo := repository.NewCommentRepository(logger.NewDiscardLogger(), db)
+	ctx := context.Background()
+
+	mock.ExpectExec("UPDATE comments SET content").
+		WillReturnError(errors.New("connection lost"))
+
+	err = repo.Update(ctx, newTestComment(uuid.New(), uuid.New(), nil, "Edited", repository.StatusPending))
+	assert.ErrorIs(t, err, repository.ErrFailedToUpdateComment)
+
+	// Verify all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,81 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+
+	blogRepository "github.com/fikryfahrezy/let-it-go/feature/blog/repository"
+	"github.com/fikryfahrezy/let-it-go/feature/comment/repository"
+	"github.com/fikryfahrezy/let-it-go/pkg/http_server"
+	"github.com/fikryfahrezy/let-it-go/pkg/rbac"
+	"github.com/google/uuid"
+)
+
+// caller returns the authenticated caller carried in the request context
+func caller(ctx context.Context) (http_server.Principal, error) {
+	principal, ok := http_server.PrincipalFromContext(ctx)
+	if !ok || principal.UserID == uuid.Nil {
+		return http_server.Principal{}, ErrCommentForbidden
+	}
+	return principal, nil
+}
+
+// authorizeModerator only lets users who moderate comments work through the moderation queue
+func (s *commentService) authorizeModerator(ctx context.Context) (http_server.Principal, error) {
+	principal, err := caller(ctx)
+	if err != nil || !rbac.Can(principal, rbac.PermissionCommentsModerate) {
+		s.log.Warn("Comment moderation denied",
+			slog.String("caller_id", principal.UserID.String()),
+		)
+		return http_server.Principal{}, ErrCommentForbidden
+	}
+	return principal, nil
+}
+
+// getPublishedBlog loads a blog comments can be read and left on. Blogs that are not
+// published are reported as not found, like they are to everyone but their author.
+func (s *commentService) getPublishedBlog(ctx context.Context, blogID uuid.UUID) (blogRepository.Blog, error) {
+	blog, err := s.blogReader.GetByID(ctx, blogID)
+	if err != nil {
+		return blogRepository.Blog{}, err
+	}
+
+	if blog.Status != blogRepository.StatusPublished {
+		return blogRepository.Blog{}, blogRepository.ErrBlogNotFound
+	}
+
+	return blog, nil
+}
+
+// getOwnComment loads a comment after making sure the caller wrote it. Only authors change
+// their comments; moderators approve or reject them instead.
+func (s *commentService) getOwnComment(ctx context.Context, id uuid.UUID) (http_server.Principal, repository.Comment, error) {
+	principal, err := caller(ctx)
+	if err != nil {
+		return http_server.Principal{}, repository.Comment{}, err
+	}
+
+	comment, err := s.commentRepo.GetByID(ctx, id)
+	if err != nil {
+		return http_server.Principal{}, repository.Comment{}, err
+	}
+
+	if comment.AuthorID != principal.UserID {
+		s.log.Warn("Comment access denied for non-author",
+			slog.String("comment_id", id.String()),
+			slog.String("caller_id", principal.UserID.String()),
+		)
+		return http_server.Principal{}, repository.Comment{}, ErrCommentForbidden
+	}
+
+	return principal, comment, nil
+}
+
+// initialStatus is the status of a comment the principal writes on the blog: approved for the
+// blog's author and for moderators, pending for everyone else
+func initialStatus(principal http_server.Principal, blog blogRepository.Blog) string {
+	if blog.AuthorID == principal.UserID || rbac.Can(principal, rbac.PermissionCommentsModerate) {
+		return repository.StatusApproved
+	}
+	return repository.StatusPending
+}
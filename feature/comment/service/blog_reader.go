@@ -0,0 +1,16 @@
+package service
+
+//counterfeiter:generate -o servicefakes/fake_blog_reader.go . BlogReader
+
+import (
+	"context"
+
+	blogRepository "github.com/fikryfahrezy/let-it-go/feature/blog/repository"
+	"github.com/google/uuid"
+)
+
+// BlogReader loads the blog a comment belongs to.
+// It is implemented by the blog repository.
+type BlogReader interface {
+	GetByID(ctx context.Context, id uuid.UUID) (blogRepository.Blog, error)
+}
@@ -0,0 +1,52 @@
+package service
+
+import (
+	"time"
+
+	"github.com/fikryfahrezy/let-it-go/feature/comment/repository"
+	"github.com/fikryfahrezy/let-it-go/pkg/http_server"
+	"github.com/google/uuid"
+)
+
+type CreateCommentRequest struct {
+	Content  string     `json:"content" validate:"required,max=5000"`
+	ParentID *uuid.UUID `json:"parent_id,omitempty"` // Omit for a top-level comment
+}
+
+type UpdateCommentRequest struct {
+	Content string `json:"content" validate:"required,max=5000"`
+}
+
+type ListModerationQueueRequest struct {
+	http_server.PaginationRequest
+	Status string // Pending when empty
+}
+
+// CommentResponse is a comment with its approved replies, oldest first. Deleted comments
+// that still have replies are kept without their content.
+type CommentResponse struct {
+	ID        uuid.UUID         `json:"id"`
+	BlogID    uuid.UUID         `json:"blog_id"`
+	ParentID  *uuid.UUID        `json:"parent_id,omitempty"`
+	AuthorID  uuid.UUID         `json:"author_id"`
+	Content   string            `json:"content"`
+	Status    string            `json:"status"`
+	Deleted   bool              `json:"deleted"`
+	CreatedAt time.Time         `json:"created_at"`
+	UpdatedAt time.Time         `json:"updated_at"`
+	Replies   []CommentResponse `json:"replies,omitempty"`
+}
+
+func ToCommentResponse(c repository.Comment) CommentResponse {
+	return CommentResponse{
+		ID:        c.ID,
+		BlogID:    c.BlogID,
+		ParentID:  c.ParentID,
+		AuthorID:  c.AuthorID,
+		Content:   c.Content,
+		Status:    c.Status,
+		Deleted:   c.IsDeleted(),
+		CreatedAt: c.CreatedAt,
+		UpdatedAt: c.UpdatedAt,
+	}
+}
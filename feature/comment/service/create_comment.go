@@ -0,0 +1,67 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/fikryfahrezy/let-it-go/feature/comment/repository"
+	"github.com/google/uuid"
+)
+
+// CreateComment leaves a comment on a published blog, or a reply when a parent is given.
+// Comments of the blog's author and of moderators are approved right away; the others
+// wait in the moderation queue.
+func (s *commentService) CreateComment(ctx context.Context, blogID uuid.UUID, req CreateCommentRequest) (CommentResponse, error) {
+	principal, err := caller(ctx)
+	if err != nil {
+		return CommentResponse{}, err
+	}
+
+	blog, err := s.getPublishedBlog(ctx, blogID)
+	if err != nil {
+		return CommentResponse{}, err
+	}
+
+	if blog.CommentsClosed {
+		return CommentResponse{}, ErrCommentsClosed
+	}
+
+	if req.ParentID != nil {
+		parent, err := s.commentRepo.GetByID(ctx, *req.ParentID)
+		if errors.Is(err, repository.ErrCommentNotFound) {
+			return CommentResponse{}, ErrInvalidParent
+		}
+		if err != nil {
+			return CommentResponse{}, err
+		}
+
+		if parent.BlogID != blogID || parent.Status != repository.StatusApproved {
+			return CommentResponse{}, ErrInvalidParent
+		}
+	}
+
+	now := time.Now()
+	comment := repository.Comment{
+		ID:        uuid.Must(uuid.NewV7()),
+		BlogID:    blogID,
+		ParentID:  req.ParentID,
+		AuthorID:  principal.UserID,
+		Content:   req.Content,
+		Status:    initialStatus(principal, blog),
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	if err := s.commentRepo.Create(ctx, comment); err != nil {
+		return CommentResponse{}, err
+	}
+
+	s.log.Info("Comment created",
+		slog.String("comment_id", comment.ID.String()),
+		slog.String("blog_id", blogID.String()),
+		slog.String("status", comment.Status),
+	)
+
+	return ToCommentResponse(comment), nil
+}
@@ -0,0 +1,182 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	blogRepository "github.com/fikryfahrezy/let-it-go/feature/blog/repository"
+	"github.com/fikryfahrezy/let-it-go/feature/comment/repository"
+	"github.com/fikryfahrezy/let-it-go/feature/comment/repository/repositoryfakes"
+	"github.com/fikryfahrezy/let-it-go/feature/comment/service"
+	"github.com/fikryfahrezy/let-it-go/feature/comment/service/servicefakes"
+	"github.com/fikryfahrezy/let-it-go/pkg/http_server"
+	"github.com/fikryfahrezy/let-it-go/pkg/logger"
+	"github.com/fikryfahrezy/let-it-go/pkg/rbac"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func userContext(userID uuid.UUID, role string) context.Context {
+	return http_server.ContextWithPrincipal(context.Background(), http_server.Principal{
+		UserID: userID,
+		Roles:  []string{role},
+	})
+}
+
+func publishedBlog(authorID uuid.UUID) blogRepository.Blog {
+	return blogRepository.Blog{
+		ID:       uuid.New(),
+		Title:    "Test Blog",
+		AuthorID: authorID,
+		Status:   blogRepository.StatusPublished,
+	}
+}
+
+func TestCommentService_CreateComment_Pending(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeCommentRepository{}
+	mockBlogs := &servicefakes.FakeBlogReader{}
+	commentService := service.NewCommentService(logger.NewDiscardLogger(), mockRepo, mockBlogs)
+
+	blog := publishedBlog(uuid.New())
+	mockBlogs.GetByIDReturns(blog, nil)
+	readerID := uuid.New()
+
+	result, err := commentService.CreateComment(userContext(readerID, rbac.RoleReader), blog.ID, service.CreateCommentRequest{
+		Content: "Nice post",
+	})
+	require.NoError(t, err)
+
+	// Comments of other readers wait for moderation
+	require.Equal(t, 1, mockRepo.CreateCallCount())
+	_, comment := mockRepo.CreateArgsForCall(0)
+	assert.Equal(t, blog.ID, comment.BlogID)
+	assert.Equal(t, readerID, comment.AuthorID)
+	assert.Nil(t, comment.ParentID)
+	assert.Equal(t, "Nice post", comment.Content)
+	assert.Equal(t, repository.StatusPending, comment.Status)
+	assert.Equal(t, comment.ID, result.ID)
+	assert.Equal(t, repository.StatusPending, result.Status)
+}
+
+func TestCommentService_CreateComment_ApprovedForTrustedCallers(t *testing.T) {
+	authorID := uuid.New()
+	tests := []struct {
+		name string
+		ctx  context.Context
+	}{
+		{name: "blog author", ctx: userContext(authorID, rbac.RoleAuthor)},
+		{name: "moderator", ctx: userContext(uuid.New(), rbac.RoleEditor)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := &repositoryfakes.FakeCommentRepository{}
+			mockBlogs := &servicefakes.FakeBlogReader{}
+			commentService := service.NewCommentService(logger.NewDiscardLogger(), mockRepo, mockBlogs)
+			mockBlogs.GetByIDReturns(publishedBlog(authorID), nil)
+
+			result, err := commentService.CreateComment(tt.ctx, uuid.New(), service.CreateCommentRequest{Content: "Thanks for reading"})
+			require.NoError(t, err)
+			assert.Equal(t, repository.StatusApproved, result.Status)
+		})
+	}
+}
+
+func TestCommentService_CreateComment_Reply(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeCommentRepository{}
+	mockBlogs := &servicefakes.FakeBlogReader{}
+	commentService := service.NewCommentService(logger.NewDiscardLogger(), mockRepo, mockBlogs)
+
+	blog := publishedBlog(uuid.New())
+	mockBlogs.GetByIDReturns(blog, nil)
+	parent := repository.Comment{ID: uuid.New(), BlogID: blog.ID, Status: repository.StatusApproved}
+	mockRepo.GetByIDReturns(parent, nil)
+
+	result, err := commentService.CreateComment(userContext(uuid.New(), rbac.RoleReader), blog.ID, service.CreateCommentRequest{
+		Content:  "I agree",
+		ParentID: &parent.ID,
+	})
+	require.NoError(t, err)
+
+	require.Equal(t, 1, mockRepo.CreateCallCount())
+	_, comment := mockRepo.CreateArgsForCall(0)
+	require.NotNil(t, comment.ParentID)
+	assert.Equal(t, parent.ID, *comment.ParentID)
+	assert.Equal(t, &parent.ID, result.ParentID)
+}
+
+func TestCommentService_CreateComment_InvalidParent(t *testing.T) {
+	blog := publishedBlog(uuid.New())
+	tests := []struct {
+		name      string
+		parent    repository.Comment
+		parentErr error
+	}{
+		{name: "not found", parentErr: repository.ErrCommentNotFound},
+		{name: "other blog", parent: repository.Comment{ID: uuid.New(), BlogID: uuid.New(), Status: repository.StatusApproved}},
+		{name: "pending", parent: repository.Comment{ID: uuid.New(), BlogID: blog.ID, Status: repository.StatusPending}},
+		{name: "rejected", parent: repository.Comment{ID: uuid.New(), BlogID: blog.ID, Status: repository.StatusRejected}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := &repositoryfakes.FakeCommentRepository{}
+			mockBlogs := &servicefakes.FakeBlogReader{}
+			commentService := service.NewCommentService(logger.NewDiscardLogger(), mockRepo, mockBlogs)
+			mockBlogs.GetByIDReturns(blog, nil)
+			mockRepo.GetByIDReturns(tt.parent, tt.parentErr)
+			parentID := uuid.New()
+
+			_, err := commentService.CreateComment(userContext(uuid.New(), rbac.RoleReader), blog.ID, service.CreateCommentRequest{
+				Content:  "I agree",
+				ParentID: &parentID,
+			})
+
+			assert.Equal(t, service.ErrInvalidParent, err)
+			assert.Equal(t, 0, mockRepo.CreateCallCount())
+		})
+	}
+}
+
+func TestCommentService_CreateComment_CommentsClosed(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeCommentRepository{}
+	mockBlogs := &servicefakes.FakeBlogReader{}
+	commentService := service.NewCommentService(logger.NewDiscardLogger(), mockRepo, mockBlogs)
+
+	blog := publishedBlog(uuid.New())
+	blog.CommentsClosed = true
+	mockBlogs.GetByIDReturns(blog, nil)
+
+	_, err := commentService.CreateComment(userContext(blog.AuthorID, rbac.RoleAuthor), blog.ID, service.CreateCommentRequest{Content: "Nice post"})
+
+	assert.Equal(t, service.ErrCommentsClosed, err)
+	assert.Equal(t, 0, mockRepo.CreateCallCount())
+}
+
+func TestCommentService_CreateComment_BlogNotPublished(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeCommentRepository{}
+	mockBlogs := &servicefakes.FakeBlogReader{}
+	commentService := service.NewCommentService(logger.NewDiscardLogger(), mockRepo, mockBlogs)
+
+	blog := publishedBlog(uuid.New())
+	blog.Status = blogRepository.StatusDraft
+	mockBlogs.GetByIDReturns(blog, nil)
+
+	_, err := commentService.CreateComment(userContext(uuid.New(), rbac.RoleReader), blog.ID, service.CreateCommentRequest{Content: "Nice post"})
+
+	// Drafts are hidden, so commenting on one looks like commenting on a missing blog
+	assert.Equal(t, blogRepository.ErrBlogNotFound, err)
+	assert.Equal(t, 0, mockRepo.CreateCallCount())
+}
+
+func TestCommentService_CreateComment_Unauthenticated(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeCommentRepository{}
+	mockBlogs := &servicefakes.FakeBlogReader{}
+	commentService := service.NewCommentService(logger.NewDiscardLogger(), mockRepo, mockBlogs)
+
+	_, err := commentService.CreateComment(context.Background(), uuid.New(), service.CreateCommentRequest{Content: "Nice post"})
+
+	assert.Equal(t, service.ErrCommentForbidden, err)
+	assert.Equal(t, 0, mockBlogs.GetByIDCallCount())
+}
@@ -0,0 +1,28 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// DeleteComment lets the author delete their comment. Its replies stay on the blog.
+func (s *commentService) DeleteComment(ctx context.Context, id uuid.UUID) error {
+	principal, _, err := s.getOwnComment(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if err := s.commentRepo.Delete(ctx, id, time.Now()); err != nil {
+		return err
+	}
+
+	s.log.Info("Comment deleted",
+		slog.String("comment_id", id.String()),
+		slog.String("deleted_by", principal.UserID.String()),
+	)
+
+	return nil
+}
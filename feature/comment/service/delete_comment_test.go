@@ -0,0 +1,53 @@
+package service_test
+
+import (
+	"testing"
+
+	"github.com/fikryfahrezy/let-it-go/feature/comment/repository"
+	"github.com/fikryfahrezy/let-it-go/feature/comment/repository/repositoryfakes"
+	"github.com/fikryfahrezy/let-it-go/feature/comment/service"
+	"github.com/fikryfahrezy/let-it-go/feature/comment/service/servicefakes"
+	"github.com/fikryfahrezy/let-it-go/pkg/logger"
+	"github.com/fikryfahrezy/let-it-go/pkg/rbac"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCommentService_DeleteComment_Success(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeCommentRepository{}
+	commentService := service.NewCommentService(logger.NewDiscardLogger(), mockRepo, &servicefakes.FakeBlogReader{})
+
+	readerID := uuid.New()
+	commentID := uuid.New()
+	mockRepo.GetByIDReturns(repository.Comment{ID: commentID, AuthorID: readerID}, nil)
+
+	err := commentService.DeleteComment(userContext(readerID, rbac.RoleReader), commentID)
+	require.NoError(t, err)
+
+	require.Equal(t, 1, mockRepo.DeleteCallCount())
+	_, actualID, _ := mockRepo.DeleteArgsForCall(0)
+	assert.Equal(t, commentID, actualID)
+}
+
+func TestCommentService_DeleteComment_NotAuthor(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeCommentRepository{}
+	commentService := service.NewCommentService(logger.NewDiscardLogger(), mockRepo, &servicefakes.FakeBlogReader{})
+	mockRepo.GetByIDReturns(repository.Comment{ID: uuid.New(), AuthorID: uuid.New()}, nil)
+
+	err := commentService.DeleteComment(userContext(uuid.New(), rbac.RoleEditor), uuid.New())
+
+	assert.Equal(t, service.ErrCommentForbidden, err)
+	assert.Equal(t, 0, mockRepo.DeleteCallCount())
+}
+
+func TestCommentService_DeleteComment_NotFound(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeCommentRepository{}
+	commentService := service.NewCommentService(logger.NewDiscardLogger(), mockRepo, &servicefakes.FakeBlogReader{})
+	mockRepo.GetByIDReturns(repository.Comment{}, repository.ErrCommentNotFound)
+
+	err := commentService.DeleteComment(userContext(uuid.New(), rbac.RoleReader), uuid.New())
+
+	assert.Equal(t, repository.ErrCommentNotFound, err)
+	assert.Equal(t, 0, mockRepo.DeleteCallCount())
+}
@@ -0,0 +1,14 @@
+package service
+
+import "github.com/fikryfahrezy/let-it-go/pkg/app_error"
+
+// Business logic errors (service-specific only)
+var (
+	// Authorization errors
+	ErrCommentForbidden = app_error.New("COMMENT-FORBIDDEN", "you are not allowed to change this comment")
+
+	// Comment errors
+	ErrCommentsClosed = app_error.New("COMMENT-COMMENTS_CLOSED", "comments are closed on this blog")
+	ErrInvalidParent  = app_error.New("COMMENT-INVALID_PARENT", "replies must answer an approved comment of the same blog")
+	ErrInvalidStatus  = app_error.New("COMMENT-INVALID_STATUS", "comment status must be pending, approved or rejected")
+)
@@ -0,0 +1,47 @@
+package service
+
+import (
+	"context"
+
+	"github.com/fikryfahrezy/let-it-go/feature/comment/repository"
+	"github.com/google/uuid"
+)
+
+// ListComments returns the approved comments of a published blog as threads, oldest first
+func (s *commentService) ListComments(ctx context.Context, blogID uuid.UUID) ([]CommentResponse, error) {
+	if _, err := s.getPublishedBlog(ctx, blogID); err != nil {
+		return nil, err
+	}
+
+	comments, err := s.commentRepo.ListApprovedByBlogID(ctx, blogID)
+	if err != nil {
+		return nil, err
+	}
+
+	replies := make(map[uuid.UUID][]repository.Comment)
+	var topLevel []repository.Comment
+	for _, comment := range comments {
+		if comment.ParentID == nil {
+			topLevel = append(topLevel, comment)
+			continue
+		}
+		replies[*comment.ParentID] = append(replies[*comment.ParentID], comment)
+	}
+
+	return threads(topLevel, replies), nil
+}
+
+// threads nests the replies under each comment. Replies to comments that are not listed are
+// left out with them, and so are deleted comments without any reply left.
+func threads(comments []repository.Comment, replies map[uuid.UUID][]repository.Comment) []CommentResponse {
+	responses := make([]CommentResponse, 0, len(comments))
+	for _, comment := range comments {
+		response := ToCommentResponse(comment)
+		response.Replies = threads(replies[comment.ID], replies)
+		if response.Deleted && len(response.Replies) == 0 {
+			continue
+		}
+		responses = append(responses, response)
+	}
+	return responses
+}
@@ -0,0 +1,83 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	blogRepository "github.com/fikryfahrezy/let-it-go/feature/blog/repository"
+	"github.com/fikryfahrezy/let-it-go/feature/comment/repository"
+	"github.com/fikryfahrezy/let-it-go/feature/comment/repository/repositoryfakes"
+	"github.com/fikryfahrezy/let-it-go/feature/comment/service"
+	"github.com/fikryfahrezy/let-it-go/feature/comment/service/servicefakes"
+	"github.com/fikryfahrezy/let-it-go/pkg/logger"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCommentService_ListComments_Threads(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeCommentRepository{}
+	mockBlogs := &servicefakes.FakeBlogReader{}
+	commentService := service.NewCommentService(logger.NewDiscardLogger(), mockRepo, mockBlogs)
+
+	blog := publishedBlog(uuid.New())
+	mockBlogs.GetByIDReturns(blog, nil)
+
+	deletedAt := time.Now()
+	first := repository.Comment{ID: uuid.New(), BlogID: blog.ID, Content: "First", Status: repository.StatusApproved}
+	second := repository.Comment{ID: uuid.New(), BlogID: blog.ID, Content: "Second", Status: repository.StatusApproved}
+	reply := repository.Comment{ID: uuid.New(), BlogID: blog.ID, ParentID: &first.ID, Content: "Reply", Status: repository.StatusApproved}
+	nested := repository.Comment{ID: uuid.New(), BlogID: blog.ID, ParentID: &reply.ID, Content: "Nested", Status: repository.StatusApproved}
+	deletedWithReply := repository.Comment{ID: uuid.New(), BlogID: blog.ID, Status: repository.StatusApproved, DeletedAt: &deletedAt}
+	replyToDeleted := repository.Comment{ID: uuid.New(), BlogID: blog.ID, ParentID: &deletedWithReply.ID, Content: "Still here", Status: repository.StatusApproved}
+	deletedAlone := repository.Comment{ID: uuid.New(), BlogID: blog.ID, Status: repository.StatusApproved, DeletedAt: &deletedAt}
+	rejectedParentID := uuid.New()
+	orphan := repository.Comment{ID: uuid.New(), BlogID: blog.ID, ParentID: &rejectedParentID, Content: "Orphan", Status: repository.StatusApproved}
+	mockRepo.ListApprovedByBlogIDReturns([]repository.Comment{first, second, reply, nested, deletedWithReply, replyToDeleted, deletedAlone, orphan}, nil)
+
+	result, err := commentService.ListComments(context.Background(), blog.ID)
+	require.NoError(t, err)
+
+	// Replies are nested, deleted comments only stay while they have replies and
+	// replies to comments that are not shown are left out
+	require.Len(t, result, 3)
+	assert.Equal(t, first.ID, result[0].ID)
+	require.Len(t, result[0].Replies, 1)
+	assert.Equal(t, reply.ID, result[0].Replies[0].ID)
+	require.Len(t, result[0].Replies[0].Replies, 1)
+	assert.Equal(t, nested.ID, result[0].Replies[0].Replies[0].ID)
+
+	assert.Equal(t, second.ID, result[1].ID)
+	assert.Empty(t, result[1].Replies)
+
+	assert.Equal(t, deletedWithReply.ID, result[2].ID)
+	assert.True(t, result[2].Deleted)
+	require.Len(t, result[2].Replies, 1)
+	assert.Equal(t, replyToDeleted.ID, result[2].Replies[0].ID)
+}
+
+func TestCommentService_ListComments_NoComments(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeCommentRepository{}
+	mockBlogs := &servicefakes.FakeBlogReader{}
+	commentService := service.NewCommentService(logger.NewDiscardLogger(), mockRepo, mockBlogs)
+	mockBlogs.GetByIDReturns(publishedBlog(uuid.New()), nil)
+
+	result, err := commentService.ListComments(context.Background(), uuid.New())
+
+	require.NoError(t, err)
+	assert.NotNil(t, result)
+	assert.Empty(t, result)
+}
+
+func TestCommentService_ListComments_BlogNotFound(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeCommentRepository{}
+	mockBlogs := &servicefakes.FakeBlogReader{}
+	commentService := service.NewCommentService(logger.NewDiscardLogger(), mockRepo, mockBlogs)
+	mockBlogs.GetByIDReturns(blogRepository.Blog{}, blogRepository.ErrBlogNotFound)
+
+	_, err := commentService.ListComments(context.Background(), uuid.New())
+
+	assert.Equal(t, blogRepository.ErrBlogNotFound, err)
+	assert.Equal(t, 0, mockRepo.ListApprovedByBlogIDCallCount())
+}
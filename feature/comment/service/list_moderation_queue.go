@@ -0,0 +1,50 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/fikryfahrezy/let-it-go/feature/comment/repository"
+)
+
+// ListModerationQueue pages through the comments of every blog having the status, pending
+// unless another is asked for, oldest first
+func (s *commentService) ListModerationQueue(ctx context.Context, req ListModerationQueueRequest) ([]CommentResponse, int64, error) {
+	if _, err := s.authorizeModerator(ctx); err != nil {
+		return nil, 0, err
+	}
+
+	status := req.Status
+	switch status {
+	case "":
+		status = repository.StatusPending
+	case repository.StatusPending, repository.StatusApproved, repository.StatusRejected:
+	default:
+		return nil, 0, ErrInvalidStatus
+	}
+
+	s.log.Info("Listing comments for moderation",
+		slog.String("status", status),
+		slog.Int("page", req.Page),
+		slog.Int("page_size", req.PageSize),
+	)
+
+	offset := (req.Page - 1) * req.PageSize
+
+	comments, err := s.commentRepo.ListByStatus(ctx, status, req.PageSize, offset)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	total, err := s.commentRepo.CountByStatus(ctx, status)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	responses := make([]CommentResponse, 0, len(comments))
+	for _, comment := range comments {
+		responses = append(responses, ToCommentResponse(comment))
+	}
+
+	return responses, total, nil
+}
@@ -0,0 +1,80 @@
+package service_test
+
+import (
+	"testing"
+
+	"github.com/fikryfahrezy/let-it-go/feature/comment/repository"
+	"github.com/fikryfahrezy/let-it-go/feature/comment/repository/repositoryfakes"
+	"github.com/fikryfahrezy/let-it-go/feature/comment/service"
+	"github.com/fikryfahrezy/let-it-go/feature/comment/service/servicefakes"
+	"github.com/fikryfahrezy/let-it-go/pkg/http_server"
+	"github.com/fikryfahrezy/let-it-go/pkg/logger"
+	"github.com/fikryfahrezy/let-it-go/pkg/rbac"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCommentService_ListModerationQueue_PendingByDefault(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeCommentRepository{}
+	commentService := service.NewCommentService(logger.NewDiscardLogger(), mockRepo, &servicefakes.FakeBlogReader{})
+
+	pending := repository.Comment{ID: uuid.New(), Content: "Nice post", Status: repository.StatusPending}
+	mockRepo.ListByStatusReturns([]repository.Comment{pending}, nil)
+	mockRepo.CountByStatusReturns(11, nil)
+
+	result, total, err := commentService.ListModerationQueue(userContext(uuid.New(), rbac.RoleEditor), service.ListModerationQueueRequest{
+		PaginationRequest: http_server.PaginationRequest{Page: 2, PageSize: 10},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int64(11), total)
+	require.Len(t, result, 1)
+	assert.Equal(t, pending.ID, result[0].ID)
+
+	require.Equal(t, 1, mockRepo.ListByStatusCallCount())
+	_, status, limit, offset := mockRepo.ListByStatusArgsForCall(0)
+	assert.Equal(t, repository.StatusPending, status)
+	assert.Equal(t, 10, limit)
+	assert.Equal(t, 10, offset)
+	_, countStatus := mockRepo.CountByStatusArgsForCall(0)
+	assert.Equal(t, repository.StatusPending, countStatus)
+}
+
+func TestCommentService_ListModerationQueue_Rejected(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeCommentRepository{}
+	commentService := service.NewCommentService(logger.NewDiscardLogger(), mockRepo, &servicefakes.FakeBlogReader{})
+
+	_, _, err := commentService.ListModerationQueue(userContext(uuid.New(), rbac.RoleAdmin), service.ListModerationQueueRequest{
+		PaginationRequest: http_server.PaginationRequest{Page: 1, PageSize: 10},
+		Status:            repository.StatusRejected,
+	})
+	require.NoError(t, err)
+
+	_, status, _, _ := mockRepo.ListByStatusArgsForCall(0)
+	assert.Equal(t, repository.StatusRejected, status)
+}
+
+func TestCommentService_ListModerationQueue_InvalidStatus(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeCommentRepository{}
+	commentService := service.NewCommentService(logger.NewDiscardLogger(), mockRepo, &servicefakes.FakeBlogReader{})
+
+	_, _, err := commentService.ListModerationQueue(userContext(uuid.New(), rbac.RoleEditor), service.ListModerationQueueRequest{
+		PaginationRequest: http_server.PaginationRequest{Page: 1, PageSize: 10},
+		Status:            "deleted",
+	})
+
+	assert.Equal(t, service.ErrInvalidStatus, err)
+	assert.Equal(t, 0, mockRepo.ListByStatusCallCount())
+}
+
+func TestCommentService_ListModerationQueue_NotModerator(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeCommentRepository{}
+	commentService := service.NewCommentService(logger.NewDiscardLogger(), mockRepo, &servicefakes.FakeBlogReader{})
+
+	_, _, err := commentService.ListModerationQueue(userContext(uuid.New(), rbac.RoleAuthor), service.ListModerationQueueRequest{
+		PaginationRequest: http_server.PaginationRequest{Page: 1, PageSize: 10},
+	})
+
+	assert.Equal(t, service.ErrCommentForbidden, err)
+	assert.Equal(t, 0, mockRepo.ListByStatusCallCount())
+}
@@ -0,0 +1,3 @@
+package service
+
+//go:generate go tool counterfeiter -generate
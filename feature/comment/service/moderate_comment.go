@@ -0,0 +1,56 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/fikryfahrezy/let-it-go/feature/comment/repository"
+	"github.com/google/uuid"
+)
+
+// ApproveComment shows a comment on its blog
+func (s *commentService) ApproveComment(ctx context.Context, id uuid.UUID) (CommentResponse, error) {
+	return s.moderate(ctx, id, repository.StatusApproved)
+}
+
+// RejectComment hides a comment, and the replies to it, from its blog
+func (s *commentService) RejectComment(ctx context.Context, id uuid.UUID) (CommentResponse, error) {
+	return s.moderate(ctx, id, repository.StatusRejected)
+}
+
+// moderate records the moderator's decision on a comment. Comments already having the
+// status are left untouched.
+func (s *commentService) moderate(ctx context.Context, id uuid.UUID, status string) (CommentResponse, error) {
+	principal, err := s.authorizeModerator(ctx)
+	if err != nil {
+		return CommentResponse{}, err
+	}
+
+	comment, err := s.commentRepo.GetByID(ctx, id)
+	if err != nil {
+		return CommentResponse{}, err
+	}
+
+	if comment.Status == status {
+		return ToCommentResponse(comment), nil
+	}
+
+	now := time.Now()
+	comment.Status = status
+	comment.ModeratedBy = &principal.UserID
+	comment.ModeratedAt = &now
+	comment.UpdatedAt = now
+
+	if err := s.commentRepo.Update(ctx, comment); err != nil {
+		return CommentResponse{}, err
+	}
+
+	s.log.Info("Comment moderated",
+		slog.String("comment_id", id.String()),
+		slog.String("status", status),
+		slog.String("moderated_by", principal.UserID.String()),
+	)
+
+	return ToCommentResponse(comment), nil
+}
@@ -0,0 +1,80 @@
+package service_test
+
+import (
+	"testing"
+
+	"github.com/fikryfahrezy/let-it-go/feature/comment/repository"
+	"github.com/fikryfahrezy/let-it-go/feature/comment/repository/repositoryfakes"
+	"github.com/fikryfahrezy/let-it-go/feature/comment/service"
+	"github.com/fikryfahrezy/let-it-go/feature/comment/service/servicefakes"
+	"github.com/fikryfahrezy/let-it-go/pkg/logger"
+	"github.com/fikryfahrezy/let-it-go/pkg/rbac"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCommentService_ApproveComment_Success(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeCommentRepository{}
+	commentService := service.NewCommentService(logger.NewDiscardLogger(), mockRepo, &servicefakes.FakeBlogReader{})
+
+	moderatorID := uuid.New()
+	mockRepo.GetByIDReturns(repository.Comment{ID: uuid.New(), Status: repository.StatusPending}, nil)
+
+	result, err := commentService.ApproveComment(userContext(moderatorID, rbac.RoleEditor), uuid.New())
+	require.NoError(t, err)
+	assert.Equal(t, repository.StatusApproved, result.Status)
+
+	// The decision is recorded with the moderator
+	require.Equal(t, 1, mockRepo.UpdateCallCount())
+	_, comment := mockRepo.UpdateArgsForCall(0)
+	assert.Equal(t, repository.StatusApproved, comment.Status)
+	require.NotNil(t, comment.ModeratedBy)
+	assert.Equal(t, moderatorID, *comment.ModeratedBy)
+	assert.NotNil(t, comment.ModeratedAt)
+}
+
+func TestCommentService_RejectComment_Success(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeCommentRepository{}
+	commentService := service.NewCommentService(logger.NewDiscardLogger(), mockRepo, &servicefakes.FakeBlogReader{})
+	mockRepo.GetByIDReturns(repository.Comment{ID: uuid.New(), Status: repository.StatusApproved}, nil)
+
+	result, err := commentService.RejectComment(userContext(uuid.New(), rbac.RoleAdmin), uuid.New())
+	require.NoError(t, err)
+	assert.Equal(t, repository.StatusRejected, result.Status)
+
+	_, comment := mockRepo.UpdateArgsForCall(0)
+	assert.Equal(t, repository.StatusRejected, comment.Status)
+}
+
+func TestCommentService_ApproveComment_AlreadyApproved(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeCommentRepository{}
+	commentService := service.NewCommentService(logger.NewDiscardLogger(), mockRepo, &servicefakes.FakeBlogReader{})
+	mockRepo.GetByIDReturns(repository.Comment{ID: uuid.New(), Status: repository.StatusApproved}, nil)
+
+	result, err := commentService.ApproveComment(userContext(uuid.New(), rbac.RoleEditor), uuid.New())
+
+	require.NoError(t, err)
+	assert.Equal(t, repository.StatusApproved, result.Status)
+	assert.Equal(t, 0, mockRepo.UpdateCallCount())
+}
+
+func TestCommentService_ApproveComment_NotModerator(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeCommentRepository{}
+	commentService := service.NewCommentService(logger.NewDiscardLogger(), mockRepo, &servicefakes.FakeBlogReader{})
+
+	_, err := commentService.ApproveComment(userContext(uuid.New(), rbac.RoleAuthor), uuid.New())
+
+	assert.Equal(t, service.ErrCommentForbidden, err)
+	assert.Equal(t, 0, mockRepo.GetByIDCallCount())
+}
+
+func TestCommentService_RejectComment_NotFound(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeCommentRepository{}
+	commentService := service.NewCommentService(logger.NewDiscardLogger(), mockRepo, &servicefakes.FakeBlogReader{})
+	mockRepo.GetByIDReturns(repository.Comment{}, repository.ErrCommentNotFound)
+
+	_, err := commentService.RejectComment(userContext(uuid.New(), rbac.RoleEditor), uuid.New())
+
+	assert.Equal(t, repository.ErrCommentNotFound, err)
+}
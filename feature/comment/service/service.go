@@ -0,0 +1,21 @@
+package service
+
+import (
+	"log/slog"
+
+	"github.com/fikryfahrezy/let-it-go/feature/comment/repository"
+)
+
+type commentService struct {
+	commentRepo repository.CommentRepository
+	blogReader  BlogReader
+	log         *slog.Logger
+}
+
+func NewCommentService(log *slog.Logger, commentRepo repository.CommentRepository, blogReader BlogReader) *commentService {
+	return &commentService{
+		commentRepo: commentRepo,
+		blogReader:  blogReader,
+		log:         log,
+	}
+}
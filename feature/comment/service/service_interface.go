@@ -0,0 +1,19 @@
+package service
+
+//counterfeiter:generate -o servicefakes/fake_comment_service.go . CommentService
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+type CommentService interface {
+	CreateComment(ctx context.Context, blogID uuid.UUID, req CreateCommentRequest) (CommentResponse, error)
+	ListComments(ctx context.Context, blogID uuid.UUID) ([]CommentResponse, error)
+	UpdateComment(ctx context.Context, id uuid.UUID, req UpdateCommentRequest) (CommentResponse, error)
+	DeleteComment(ctx context.Context, id uuid.UUID) error
+	ListModerationQueue(ctx context.Context, req ListModerationQueueRequest) ([]CommentResponse, int64, error)
+	ApproveComment(ctx context.Context, id uuid.UUID) (CommentResponse, error)
+	RejectComment(ctx context.Context, id uuid.UUID) (CommentResponse, error)
+}
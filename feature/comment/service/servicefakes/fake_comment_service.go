@@ -0,0 +1,596 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package servicefakes
+
+import (
+	"context"
+	"sync"
+
+	"github.com/fikryfahrezy/let-it-go/feature/comment/service"
+	"github.com/google/uuid"
+)
+
+type FakeCommentService struct {
+	ApproveCommentStub        func(context.Context, uuid.UUID) (service.CommentResponse, error)
+	approveCommentMutex       sync.RWMutex
+	approveCommentArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}
+	approveCommentReturns struct {
+		result1 service.CommentResponse
+		result2 error
+	}
+	approveCommentReturnsOnCall map[int]struct {
+		result1 service.CommentResponse
+		result2 error
+	}
+	CreateCommentStub        func(context.Context, uuid.UUID, service.CreateCommentRequest) (service.CommentResponse, error)
+	createCommentMutex       sync.RWMutex
+	createCommentArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 service.CreateCommentRequest
+	}
+	createCommentReturns struct {
+		result1 service.CommentResponse
+		result2 error
+	}
+	createCommentReturnsOnCall map[int]struct {
+		result1 service.CommentResponse
+		result2 error
+	}
+	DeleteCommentStub        func(context.Context, uuid.UUID) error
+	deleteCommentMutex       sync.RWMutex
+	deleteCommentArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}
+	deleteCommentReturns struct {
+		result1 error
+	}
+	deleteCommentReturnsOnCall map[int]struct {
+		result1 error
+	}
+	ListCommentsStub        func(context.Context, uuid.UUID) ([]service.CommentResponse, error)
+	listCommentsMutex       sync.RWMutex
+	listCommentsArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}
+	listCommentsReturns struct {
+		result1 []service.CommentResponse
+		result2 error
+	}
+	listCommentsReturnsOnCall map[int]struct {
+		result1 []service.CommentResponse
+		result2 error
+	}
+	ListModerationQueueStub        func(context.Context, service.ListModerationQueueRequest) ([]service.CommentResponse, int64, error)
+	listModerationQueueMutex       sync.RWMutex
+	listModerationQueueArgsForCall []struct {
+		arg1 context.Context
+		arg2 service.ListModerationQueueRequest
+	}
+	listModerationQueueReturns struct {
+		result1 []service.CommentResponse
+		result2 int64
+		result3 error
+	}
+	listModerationQueueReturnsOnCall map[int]struct {
+		result1 []service.CommentResponse
+		result2 int64
+		result3 error
+	}
+	RejectCommentStub        func(context.Context, uuid.UUID) (service.CommentResponse, error)
+	rejectCommentMutex       sync.RWMutex
+	rejectCommentArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}
+	rejectCommentReturns struct {
+		result1 service.CommentResponse
+		result2 error
+	}
+	rejectCommentReturnsOnCall map[int]struct {
+		result1 service.CommentResponse
+		result2 error
+	}
+	UpdateCommentStub        func(context.Context, uuid.UUID, service.UpdateCommentRequest) (service.CommentResponse, error)
+	updateCommentMutex       sync.RWMutex
+	updateCommentArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 service.UpdateCommentRequest
+	}
+	updateCommentReturns struct {
+		result1 service.CommentResponse
+		result2 error
+	}
+	updateCommentReturnsOnCall map[int]struct {
+		result1 service.CommentResponse
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeCommentService) ApproveComment(arg1 context.Context, arg2 uuid.UUID) (service.CommentResponse, error) {
+	fake.approveCommentMutex.Lock()
+	ret, specificReturn := fake.approveCommentReturnsOnCall[len(fake.approveCommentArgsForCall)]
+	fake.approveCommentArgsForCall = append(fake.approveCommentArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}{arg1, arg2})
+	stub := fake.ApproveCommentStub
+	fakeReturns := fake.approveCommentReturns
+	fake.recordInvocation("ApproveComment", []interface{}{arg1, arg2})
+	fake.approveCommentMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeCommentService) ApproveCommentCallCount() int {
+	fake.approveCommentMutex.RLock()
+	defer fake.approveCommentMutex.RUnlock()
+	return len(fake.approveCommentArgsForCall)
+}
+
+func (fake *FakeCommentService) ApproveCommentCalls(stub func(context.Context, uuid.UUID) (service.CommentResponse, error)) {
+	fake.approveCommentMutex.Lock()
+	defer fake.approveCommentMutex.Unlock()
+	fake.ApproveCommentStub = stub
+}
+
+func (fake *FakeCommentService) ApproveCommentArgsForCall(i int) (context.Context, uuid.UUID) {
+	fake.approveCommentMutex.RLock()
+	defer fake.approveCommentMutex.RUnlock()
+	argsForCall := fake.approveCommentArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeCommentService) ApproveCommentReturns(result1 service.CommentResponse, result2 error) {
+	fake.approveCommentMutex.Lock()
+	defer fake.approveCommentMutex.Unlock()
+	fake.ApproveCommentStub = nil
+	fake.approveCommentReturns = struct {
+		result1 service.CommentResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCommentService) ApproveCommentReturnsOnCall(i int, result1 service.CommentResponse, result2 error) {
+	fake.approveCommentMutex.Lock()
+	defer fake.approveCommentMutex.Unlock()
+	fake.ApproveCommentStub = nil
+	if fake.approveCommentReturnsOnCall == nil {
+		fake.approveCommentReturnsOnCall = make(map[int]struct {
+			result1 service.CommentResponse
+			result2 error
+		})
+	}
+	fake.approveCommentReturnsOnCall[i] = struct {
+		result1 service.CommentResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCommentService) CreateComment(arg1 context.Context, arg2 uuid.UUID, arg3 service.CreateCommentRequest) (service.CommentResponse, error) {
+	fake.createCommentMutex.Lock()
+	ret, specificReturn := fake.createCommentReturnsOnCall[len(fake.createCommentArgsForCall)]
+	fake.createCommentArgsForCall = append(fake.createCommentArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 service.CreateCommentRequest
+	}{arg1, arg2, arg3})
+	stub := fake.CreateCommentStub
+	fakeReturns := fake.createCommentReturns
+	fake.recordInvocation("CreateComment", []interface{}{arg1, arg2, arg3})
+	fake.createCommentMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeCommentService) CreateCommentCallCount() int {
+	fake.createCommentMutex.RLock()
+	defer fake.createCommentMutex.RUnlock()
+	return len(fake.createCommentArgsForCall)
+}
+
+func (fake *FakeCommentService) CreateCommentCalls(stub func(context.Context, uuid.UUID, service.CreateCommentRequest) (service.CommentResponse, error)) {
+	fake.createCommentMutex.Lock()
+	defer fake.createCommentMutex.Unlock()
+	fake.CreateCommentStub = stub
+}
+
+func (fake *FakeCommentService) CreateCommentArgsForCall(i int) (context.Context, uuid.UUID, service.CreateCommentRequest) {
+	fake.createCommentMutex.RLock()
+	defer fake.createCommentMutex.RUnlock()
+	argsForCall := fake.createCommentArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeCommentService) CreateCommentReturns(result1 service.CommentResponse, result2 error) {
+	fake.createCommentMutex.Lock()
+	defer fake.createCommentMutex.Unlock()
+	fake.CreateCommentStub = nil
+	fake.createCommentReturns = struct {
+		result1 service.CommentResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCommentService) CreateCommentReturnsOnCall(i int, result1 service.CommentResponse, result2 error) {
+	fake.createCommentMutex.Lock()
+	defer fake.createCommentMutex.Unlock()
+	fake.CreateCommentStub = nil
+	if fake.createCommentReturnsOnCall == nil {
+		fake.createCommentReturnsOnCall = make(map[int]struct {
+			result1 service.CommentResponse
+			result2 error
+		})
+	}
+	fake.createCommentReturnsOnCall[i] = struct {
+		result1 service.CommentResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCommentService) DeleteComment(arg1 context.Context, arg2 uuid.UUID) error {
+	fake.deleteCommentMutex.Lock()
+	ret, specificReturn := fake.deleteCommentReturnsOnCall[len(fake.deleteCommentArgsForCall)]
+	fake.deleteCommentArgsForCall = append(fake.deleteCommentArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}{arg1, arg2})
+	stub := fake.DeleteCommentStub
+	fakeReturns := fake.deleteCommentReturns
+	fake.recordInvocation("DeleteComment", []interface{}{arg1, arg2})
+	fake.deleteCommentMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeCommentService) DeleteCommentCallCount() int {
+	fake.deleteCommentMutex.RLock()
+	defer fake.deleteCommentMutex.RUnlock()
+	return len(fake.deleteCommentArgsForCall)
+}
+
+func (fake *FakeCommentService) DeleteCommentCalls(stub func(context.Context, uuid.UUID) error) {
+	fake.deleteCommentMutex.Lock()
+	defer fake.deleteCommentMutex.Unlock()
+	fake.DeleteCommentStub = stub
+}
+
+func (fake *FakeCommentService) DeleteCommentArgsForCall(i int) (context.Context, uuid.UUID) {
+	fake.deleteCommentMutex.RLock()
+	defer fake.deleteCommentMutex.RUnlock()
+	argsForCall := fake.deleteCommentArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeCommentService) DeleteCommentReturns(result1 error) {
+	fake.deleteCommentMutex.Lock()
+	defer fake.deleteCommentMutex.Unlock()
+	fake.DeleteCommentStub = nil
+	fake.deleteCommentReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeCommentService) DeleteCommentReturnsOnCall(i int, result1 error) {
+	fake.deleteCommentMutex.Lock()
+	defer fake.deleteCommentMutex.Unlock()
+	fake.DeleteCommentStub = nil
+	if fake.deleteCommentReturnsOnCall == nil {
+		fake.deleteCommentReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteCommentReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeCommentService) ListComments(arg1 context.Context, arg2 uuid.UUID) ([]service.CommentResponse, error) {
+	fake.listCommentsMutex.Lock()
+	ret, specificReturn := fake.listCommentsReturnsOnCall[len(fake.listCommentsArgsForCall)]
+	fake.listCommentsArgsForCall = append(fake.listCommentsArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}{arg1, arg2})
+	stub := fake.ListCommentsStub
+	fakeReturns := fake.listCommentsReturns
+	fake.recordInvocation("ListComments", []interface{}{arg1, arg2})
+	fake.listCommentsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeCommentService) ListCommentsCallCount() int {
+	fake.listCommentsMutex.RLock()
+	defer fake.listCommentsMutex.RUnlock()
+	return len(fake.listCommentsArgsForCall)
+}
+
+func (fake *FakeCommentService) ListCommentsCalls(stub func(context.Context, uuid.UUID) ([]service.CommentResponse, error)) {
+	fake.listCommentsMutex.Lock()
+	defer fake.listCommentsMutex.Unlock()
+	fake.ListCommentsStub = stub
+}
+
+func (fake *FakeCommentService) ListCommentsArgsForCall(i int) (context.Context, uuid.UUID) {
+	fake.listCommentsMutex.RLock()
+	defer fake.listCommentsMutex.RUnlock()
+	argsForCall := fake.listCommentsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeCommentService) ListCommentsReturns(result1 []service.CommentResponse, result2 error) {
+	fake.listCommentsMutex.Lock()
+	defer fake.listCommentsMutex.Unlock()
+	fake.ListCommentsStub = nil
+	fake.listCommentsReturns = struct {
+		result1 []service.CommentResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCommentService) ListCommentsReturnsOnCall(i int, result1 []service.CommentResponse, result2 error) {
+	fake.listCommentsMutex.Lock()
+	defer fake.listCommentsMutex.Unlock()
+	fake.ListCommentsStub = nil
+	if fake.listCommentsReturnsOnCall == nil {
+		fake.listCommentsReturnsOnCall = make(map[int]struct {
+			result1 []service.CommentResponse
+			result2 error
+		})
+	}
+	fake.listCommentsReturnsOnCall[i] = struct {
+		result1 []service.CommentResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCommentService) ListModerationQueue(arg1 context.Context, arg2 service.ListModerationQueueRequest) ([]service.CommentResponse, int64, error) {
+	fake.listModerationQueueMutex.Lock()
+	ret, specificReturn := fake.listModerationQueueReturnsOnCall[len(fake.listModerationQueueArgsForCall)]
+	fake.listModerationQueueArgsForCall = append(fake.listModerationQueueArgsForCall, struct {
+		arg1 context.Context
+		arg2 service.ListModerationQueueRequest
+	}{arg1, arg2})
+	stub := fake.ListModerationQueueStub
+	fakeReturns := fake.listModerationQueueReturns
+	fake.recordInvocation("ListModerationQueue", []interface{}{arg1, arg2})
+	fake.listModerationQueueMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeCommentService) ListModerationQueueCallCount() int {
+	fake.listModerationQueueMutex.RLock()
+	defer fake.listModerationQueueMutex.RUnlock()
+	return len(fake.listModerationQueueArgsForCall)
+}
+
+func (fake *FakeCommentService) ListModerationQueueCalls(stub func(context.Context, service.ListModerationQueueRequest) ([]service.CommentResponse, int64, error)) {
+	fake.listModerationQueueMutex.Lock()
+	defer fake.listModerationQueueMutex.Unlock()
+	fake.ListModerationQueueStub = stub
+}
+
+func (fake *FakeCommentService) ListModerationQueueArgsForCall(i int) (context.Context, service.ListModerationQueueRequest) {
+	fake.listModerationQueueMutex.RLock()
+	defer fake.listModerationQueueMutex.RUnlock()
+	argsForCall := fake.listModerationQueueArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeCommentService) ListModerationQueueReturns(result1 []service.CommentResponse, result2 int64, result3 error) {
+	fake.listModerationQueueMutex.Lock()
+	defer fake.listModerationQueueMutex.Unlock()
+	fake.ListModerationQueueStub = nil
+	fake.listModerationQueueReturns = struct {
+		result1 []service.CommentResponse
+		result2 int64
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCommentService) ListModerationQueueReturnsOnCall(i int, result1 []service.CommentResponse, result2 int64, result3 error) {
+	fake.listModerationQueueMutex.Lock()
+	defer fake.listModerationQueueMutex.Unlock()
+	fake.ListModerationQueueStub = nil
+	if fake.listModerationQueueReturnsOnCall == nil {
+		fake.listModerationQueueReturnsOnCall = make(map[int]struct {
+			result1 []service.CommentResponse
+			result2 int64
+			result3 error
+		})
+	}
+	fake.listModerationQueueReturnsOnCall[i] = struct {
+		result1 []service.CommentResponse
+		result2 int64
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCommentService) RejectComment(arg1 context.Context, arg2 uuid.UUID) (service.CommentResponse, error) {
+	fake.rejectCommentMutex.Lock()
+	ret, specificReturn := fake.rejectCommentReturnsOnCall[len(fake.rejectCommentArgsForCall)]
+	fake.rejectCommentArgsForCall = append(fake.rejectCommentArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}{arg1, arg2})
+	stub := fake.RejectCommentStub
+	fakeReturns := fake.rejectCommentReturns
+	fake.recordInvocation("RejectComment", []interface{}{arg1, arg2})
+	fake.rejectCommentMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeCommentService) RejectCommentCallCount() int {
+	fake.rejectCommentMutex.RLock()
+	defer fake.rejectCommentMutex.RUnlock()
+	return len(fake.rejectCommentArgsForCall)
+}
+
+func (fake *FakeCommentService) RejectCommentCalls(stub func(context.Context, uuid.UUID) (service.CommentResponse, error)) {
+	fake.rejectCommentMutex.Lock()
+	defer fake.rejectCommentMutex.Unlock()
+	fake.RejectCommentStub = stub
+}
+
+func (fake *FakeCommentService) RejectCommentArgsForCall(i int) (context.Context, uuid.UUID) {
+	fake.rejectCommentMutex.RLock()
+	defer fake.rejectCommentMutex.RUnlock()
+	argsForCall := fake.rejectCommentArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeCommentService) RejectCommentReturns(result1 service.CommentResponse, result2 error) {
+	fake.rejectCommentMutex.Lock()
+	defer fake.rejectCommentMutex.Unlock()
+	fake.RejectCommentStub = nil
+	fake.rejectCommentReturns = struct {
+		result1 service.CommentResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCommentService) RejectCommentReturnsOnCall(i int, result1 service.CommentResponse, result2 error) {
+	fake.rejectCommentMutex.Lock()
+	defer fake.rejectCommentMutex.Unlock()
+	fake.RejectCommentStub = nil
+	if fake.rejectCommentReturnsOnCall == nil {
+		fake.rejectCommentReturnsOnCall = make(map[int]struct {
+			result1 service.CommentResponse
+			result2 error
+		})
+	}
+	fake.rejectCommentReturnsOnCall[i] = struct {
+		result1 service.CommentResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCommentService) UpdateComment(arg1 context.Context, arg2 uuid.UUID, arg3 service.UpdateCommentRequest) (service.CommentResponse, error) {
+	fake.updateCommentMutex.Lock()
+	ret, specificReturn := fake.updateCommentReturnsOnCall[len(fake.updateCommentArgsForCall)]
+	fake.updateCommentArgsForCall = append(fake.updateCommentArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 service.UpdateCommentRequest
+	}{arg1, arg2, arg3})
+	stub := fake.UpdateCommentStub
+	fakeReturns := fake.updateCommentReturns
+	fake.recordInvocation("UpdateComment", []interface{}{arg1, arg2, arg3})
+	fake.updateCommentMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeCommentService) UpdateCommentCallCount() int {
+	fake.updateCommentMutex.RLock()
+	defer fake.updateCommentMutex.RUnlock()
+	return len(fake.updateCommentArgsForCall)
+}
+
+func (fake *FakeCommentService) UpdateCommentCalls(stub func(context.Context, uuid.UUID, service.UpdateCommentRequest) (service.CommentResponse, error)) {
+	fake.updateCommentMutex.Lock()
+	defer fake.updateCommentMutex.Unlock()
+	fake.UpdateCommentStub = stub
+}
+
+func (fake *FakeCommentService) UpdateCommentArgsForCall(i int) (context.Context, uuid.UUID, service.UpdateCommentRequest) {
+	fake.updateCommentMutex.RLock()
+	defer fake.updateCommentMutex.RUnlock()
+	argsForCall := fake.updateCommentArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeCommentService) UpdateCommentReturns(result1 service.CommentResponse, result2 error) {
+	fake.updateCommentMutex.Lock()
+	defer fake.updateCommentMutex.Unlock()
+	fake.UpdateCommentStub = nil
+	fake.updateCommentReturns = struct {
+		result1 service.CommentResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCommentService) UpdateCommentReturnsOnCall(i int, result1 service.CommentResponse, result2 error) {
+	fake.updateCommentMutex.Lock()
+	defer fake.updateCommentMutex.Unlock()
+	fake.UpdateCommentStub = nil
+	if fake.updateCommentReturnsOnCall == nil {
+		fake.updateCommentReturnsOnCall = make(map[int]struct {
+			result1 service.CommentResponse
+			result2 error
+		})
+	}
+	fake.updateCommentReturnsOnCall[i] = struct {
+		result1 service.CommentResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCommentService) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeCommentService) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ service.CommentService = new(FakeCommentService)
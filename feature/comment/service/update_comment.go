@@ -0,0 +1,47 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/fikryfahrezy/let-it-go/feature/comment/repository"
+	"github.com/google/uuid"
+)
+
+// UpdateComment lets the author change the content of their comment. The edit goes through
+// moderation again unless the author's comments are approved right away.
+func (s *commentService) UpdateComment(ctx context.Context, id uuid.UUID, req UpdateCommentRequest) (CommentResponse, error) {
+	principal, comment, err := s.getOwnComment(ctx, id)
+	if err != nil {
+		return CommentResponse{}, err
+	}
+
+	blog, err := s.getPublishedBlog(ctx, comment.BlogID)
+	if err != nil {
+		return CommentResponse{}, err
+	}
+
+	if req.Content == comment.Content {
+		return ToCommentResponse(comment), nil
+	}
+
+	comment.Content = req.Content
+	comment.UpdatedAt = time.Now()
+	comment.Status = initialStatus(principal, blog)
+	if comment.Status == repository.StatusPending {
+		comment.ModeratedBy = nil
+		comment.ModeratedAt = nil
+	}
+
+	if err := s.commentRepo.Update(ctx, comment); err != nil {
+		return CommentResponse{}, err
+	}
+
+	s.log.Info("Comment updated",
+		slog.String("comment_id", id.String()),
+		slog.String("status", comment.Status),
+	)
+
+	return ToCommentResponse(comment), nil
+}
@@ -0,0 +1,112 @@
+package service_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/fikryfahrezy/let-it-go/feature/comment/repository"
+	"github.com/fikryfahrezy/let-it-go/feature/comment/repository/repositoryfakes"
+	"github.com/fikryfahrezy/let-it-go/feature/comment/service"
+	"github.com/fikryfahrezy/let-it-go/feature/comment/service/servicefakes"
+	"github.com/fikryfahrezy/let-it-go/pkg/logger"
+	"github.com/fikryfahrezy/let-it-go/pkg/rbac"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCommentService_UpdateComment_BackToModeration(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeCommentRepository{}
+	mockBlogs := &servicefakes.FakeBlogReader{}
+	commentService := service.NewCommentService(logger.NewDiscardLogger(), mockRepo, mockBlogs)
+
+	blog := publishedBlog(uuid.New())
+	mockBlogs.GetByIDReturns(blog, nil)
+	readerID := uuid.New()
+	moderatorID := uuid.New()
+	moderatedAt := time.Now()
+	mockRepo.GetByIDReturns(repository.Comment{
+		ID:          uuid.New(),
+		BlogID:      blog.ID,
+		AuthorID:    readerID,
+		Content:     "Nice post",
+		Status:      repository.StatusApproved,
+		ModeratedBy: &moderatorID,
+		ModeratedAt: &moderatedAt,
+	}, nil)
+
+	result, err := commentService.UpdateComment(userContext(readerID, rbac.RoleReader), uuid.New(), service.UpdateCommentRequest{Content: "Nice post, edited"})
+	require.NoError(t, err)
+
+	// The edit has to be approved again
+	require.Equal(t, 1, mockRepo.UpdateCallCount())
+	_, comment := mockRepo.UpdateArgsForCall(0)
+	assert.Equal(t, "Nice post, edited", comment.Content)
+	assert.Equal(t, repository.StatusPending, comment.Status)
+	assert.Nil(t, comment.ModeratedBy)
+	assert.Nil(t, comment.ModeratedAt)
+	assert.Equal(t, repository.StatusPending, result.Status)
+}
+
+func TestCommentService_UpdateComment_BlogAuthorStaysApproved(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeCommentRepository{}
+	mockBlogs := &servicefakes.FakeBlogReader{}
+	commentService := service.NewCommentService(logger.NewDiscardLogger(), mockRepo, mockBlogs)
+
+	blog := publishedBlog(uuid.New())
+	mockBlogs.GetByIDReturns(blog, nil)
+	mockRepo.GetByIDReturns(repository.Comment{
+		ID:       uuid.New(),
+		BlogID:   blog.ID,
+		AuthorID: blog.AuthorID,
+		Content:  "Thanks",
+		Status:   repository.StatusApproved,
+	}, nil)
+
+	result, err := commentService.UpdateComment(userContext(blog.AuthorID, rbac.RoleAuthor), uuid.New(), service.UpdateCommentRequest{Content: "Thanks everyone"})
+	require.NoError(t, err)
+	assert.Equal(t, repository.StatusApproved, result.Status)
+	assert.Equal(t, "Thanks everyone", result.Content)
+}
+
+func TestCommentService_UpdateComment_Unchanged(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeCommentRepository{}
+	mockBlogs := &servicefakes.FakeBlogReader{}
+	commentService := service.NewCommentService(logger.NewDiscardLogger(), mockRepo, mockBlogs)
+
+	blog := publishedBlog(uuid.New())
+	mockBlogs.GetByIDReturns(blog, nil)
+	readerID := uuid.New()
+	mockRepo.GetByIDReturns(repository.Comment{ID: uuid.New(), BlogID: blog.ID, AuthorID: readerID, Content: "Nice post", Status: repository.StatusApproved}, nil)
+
+	result, err := commentService.UpdateComment(userContext(readerID, rbac.RoleReader), uuid.New(), service.UpdateCommentRequest{Content: "Nice post"})
+
+	// Saving the same content does not send the comment back to moderation
+	require.NoError(t, err)
+	assert.Equal(t, repository.StatusApproved, result.Status)
+	assert.Equal(t, 0, mockRepo.UpdateCallCount())
+}
+
+func TestCommentService_UpdateComment_NotAuthor(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeCommentRepository{}
+	mockBlogs := &servicefakes.FakeBlogReader{}
+	commentService := service.NewCommentService(logger.NewDiscardLogger(), mockRepo, mockBlogs)
+	mockRepo.GetByIDReturns(repository.Comment{ID: uuid.New(), AuthorID: uuid.New(), Content: "Nice post"}, nil)
+
+	// Not even admins change what others wrote
+	_, err := commentService.UpdateComment(userContext(uuid.New(), rbac.RoleAdmin), uuid.New(), service.UpdateCommentRequest{Content: "Edited"})
+
+	assert.Equal(t, service.ErrCommentForbidden, err)
+	assert.Equal(t, 0, mockRepo.UpdateCallCount())
+}
+
+func TestCommentService_UpdateComment_NotFound(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeCommentRepository{}
+	mockBlogs := &servicefakes.FakeBlogReader{}
+	commentService := service.NewCommentService(logger.NewDiscardLogger(), mockRepo, mockBlogs)
+	mockRepo.GetByIDReturns(repository.Comment{}, repository.ErrCommentNotFound)
+
+	_, err := commentService.UpdateComment(userContext(uuid.New(), rbac.RoleReader), uuid.New(), service.UpdateCommentRequest{Content: "Edited"})
+
+	assert.Equal(t, repository.ErrCommentNotFound, err)
+}
@@ -0,0 +1,229 @@
+package handler
+
+import (
+	"errors"
+	"log/slog"
+	"math"
+	"strconv"
+
+	"github.com/fikryfahrezy/let-it-go/feature/invitation/repository"
+	"github.com/fikryfahrezy/let-it-go/feature/invitation/service"
+	userService "github.com/fikryfahrezy/let-it-go/feature/user/service"
+	"github.com/fikryfahrezy/let-it-go/pkg/http_server"
+	"github.com/fikryfahrezy/let-it-go/pkg/rbac"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+type InvitationHandler struct {
+	invitationService service.InvitationService
+	log               *slog.Logger
+}
+
+func NewInvitationHandler(log *slog.Logger, invitationService service.InvitationService) *InvitationHandler {
+	return &InvitationHandler{
+		invitationService: invitationService,
+		log:               log,
+	}
+}
+
+// translateServiceError converts service errors to appropriate HTTP responses
+func (h *InvitationHandler) translateServiceError(c echo.Context, err error, defaultMessage string) error {
+	if errors.Is(err, repository.ErrInvitationNotFound) {
+		return http_server.NotFoundResponse(c, "Pending invitation not found", err)
+	}
+	if errors.Is(err, service.ErrInvitationForbidden) {
+		return http_server.ForbiddenResponse(c, "You are not allowed to manage invitations", err)
+	}
+	if errors.Is(err, service.ErrInvalidRole) {
+		return http_server.BadRequestResponse(c, "Role does not exist", err)
+	}
+	if errors.Is(err, service.ErrInvalidExpiry) {
+		return http_server.BadRequestResponse(c, "Invitation expiry must be in the future", err)
+	}
+	if errors.Is(err, service.ErrInvalidInvitation) {
+		return http_server.BadRequestResponse(c, "Invalid, expired, revoked or already accepted invitation", err)
+	}
+	if errors.Is(err, service.ErrFailedToSendInvitation) {
+		return http_server.InternalServerErrorResponse(c, "Failed to send invitation email", err)
+	}
+	if errors.Is(err, userService.ErrUserAlreadyExists) {
+		return http_server.BadRequestResponse(c, "Email address is already taken", err)
+	}
+	if errors.Is(err, userService.ErrPasswordPolicyViolation) {
+		return http_server.BadRequestResponse(c, "Password does not meet the password policy", err)
+	}
+
+	// Log unexpected errors
+	h.log.Error("Service error",
+		slog.String("error", err.Error()),
+		slog.String("operation", defaultMessage),
+	)
+	return http_server.InternalServerErrorResponse(c, defaultMessage, err)
+}
+
+// CreateInvitation invites someone to sign up
+// @Summary Invite a user
+// @Description Email a single-use link that lets the invitee sign up with the given role. Without expires_at the invitation expires after the configured TTL. Requires the users:manage permission.
+// @Tags invitations
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param invitation body service.CreateInvitationRequest true "Invitation request"
+// @Success 201 {object} http_server.APIResponse{result=service.InvitationResponse}
+// @Failure 400 {object} http_server.APIResponse
+// @Failure 401 {object} http_server.APIResponse
+// @Failure 403 {object} http_server.APIResponse
+// @Failure 422 {object} http_server.APIResponse
+// @Failure 500 {object} http_server.APIResponse
+// @Router /v1/admin/invitations [post]
+func (h *InvitationHandler) CreateInvitation(c echo.Context) error {
+	var req service.CreateInvitationRequest
+	if err := c.Bind(&req); err != nil {
+		h.log.Error("Failed to bind request",
+			slog.String("error", err.Error()),
+		)
+		return http_server.BadRequestResponse(c, "Invalid request format", err)
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return http_server.HandleValidationError(c, err)
+	}
+
+	invitation, err := h.invitationService.CreateInvitation(c.Request().Context(), req)
+	if err != nil {
+		return h.translateServiceError(c, err, "Failed to create invitation")
+	}
+
+	return http_server.CreatedResponse(c, "Invitation sent successfully", invitation)
+}
+
+// ListInvitations lists the pending invitations
+// @Summary List pending invitations
+// @Description Retrieve a paginated list of the invitations that were neither accepted nor revoked and have not expired, newest first. Requires the users:manage permission.
+// @Tags invitations
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param page query int false "Page number" default(1)
+// @Param page_size query int false "Number of items per page" default(10)
+// @Success 200 {object} http_server.ListAPIResponse{result=[]service.InvitationResponse}
+// @Failure 401 {object} http_server.APIResponse
+// @Failure 403 {object} http_server.APIResponse
+// @Failure 500 {object} http_server.APIResponse
+// @Router /v1/admin/invitations [get]
+func (h *InvitationHandler) ListInvitations(c echo.Context) error {
+	pageParam := c.QueryParam("page")
+	pageSizeParam := c.QueryParam("page_size")
+
+	page := 1
+	if pageParam != "" {
+		if p, err := strconv.Atoi(pageParam); err == nil && p > 0 {
+			page = p
+		}
+	}
+
+	pageSize := 10
+	if pageSizeParam != "" {
+		if ps, err := strconv.Atoi(pageSizeParam); err == nil && ps > 0 && ps <= 100 {
+			pageSize = ps
+		}
+	}
+
+	req := service.ListInvitationsRequest{
+		PaginationRequest: http_server.PaginationRequest{
+			Page:     page,
+			PageSize: pageSize,
+		},
+	}
+
+	invitations, totalCount, err := h.invitationService.ListInvitations(c.Request().Context(), req)
+	if err != nil {
+		return h.translateServiceError(c, err, "Failed to list invitations")
+	}
+
+	totalPages := int64(math.Ceil(float64(totalCount) / float64(pageSize)))
+	pagination := http_server.CreatePaginationResponse(totalCount, totalPages, page, pageSize)
+
+	return http_server.ListSuccessResponse(c, "Invitations retrieved successfully", invitations, pagination)
+}
+
+// RevokeInvitation revokes a pending invitation
+// @Summary Revoke an invitation
+// @Description Revoke a pending invitation. Its link stops working immediately. Requires the users:manage permission.
+// @Tags invitations
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Invitation ID"
+// @Success 200 {object} http_server.APIResponse
+// @Failure 400 {object} http_server.APIResponse
+// @Failure 401 {object} http_server.APIResponse
+// @Failure 403 {object} http_server.APIResponse
+// @Failure 404 {object} http_server.APIResponse
+// @Failure 500 {object} http_server.APIResponse
+// @Router /v1/admin/invitations/{id} [delete]
+func (h *InvitationHandler) RevokeInvitation(c echo.Context) error {
+	idParam := c.Param("id")
+	id, err := uuid.Parse(idParam)
+	if err != nil {
+		h.log.Warn("Invalid invitation ID parameter",
+			slog.String("id", idParam),
+		)
+		return http_server.BadRequestResponse(c, "Invalid invitation UUID format", err)
+	}
+
+	if err := h.invitationService.RevokeInvitation(c.Request().Context(), id); err != nil {
+		return h.translateServiceError(c, err, "Failed to revoke invitation")
+	}
+
+	return http_server.SuccessResponse(c, "Invitation revoked successfully", nil)
+}
+
+// AcceptInvitation signs up the invitee
+// @Summary Accept an invitation
+// @Description Create the invited user with the emailed token, a name and a password. The email and role come from the invitation and the email counts as verified. A token can be used once.
+// @Tags invitations
+// @Accept json
+// @Produce json
+// @Param invitation body service.AcceptInvitationRequest true "Accept invitation request"
+// @Success 201 {object} http_server.APIResponse{result=service.AcceptInvitationResponse}
+// @Failure 400 {object} http_server.APIResponse
+// @Failure 422 {object} http_server.APIResponse
+// @Failure 500 {object} http_server.APIResponse
+// @Router /v1/invitations/accept [post]
+func (h *InvitationHandler) AcceptInvitation(c echo.Context) error {
+	var req service.AcceptInvitationRequest
+	if err := c.Bind(&req); err != nil {
+		h.log.Error("Failed to bind request",
+			slog.String("error", err.Error()),
+		)
+		return http_server.BadRequestResponse(c, "Invalid request format", err)
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return http_server.HandleValidationError(c, err)
+	}
+
+	user, err := h.invitationService.AcceptInvitation(c.Request().Context(), req)
+	if err != nil {
+		return h.translateServiceError(c, err, "Failed to accept invitation")
+	}
+
+	return http_server.CreatedResponse(c, "Invitation accepted successfully", user)
+}
+
+func (h *InvitationHandler) SetupRoutes(server *http_server.Server) {
+	h.setupV1Routes(server)
+}
+
+// setupV1Routes configures v1 API routes for invitations
+func (h *InvitationHandler) setupV1Routes(server *http_server.Server) {
+	admin := server.Echo().Group("/v1/admin/invitations", rbac.RequirePermission(rbac.PermissionUsersManage), http_server.ForbidImpersonation())
+	admin.POST("", h.CreateInvitation)
+	admin.GET("", h.ListInvitations)
+	admin.DELETE("/:id", h.RevokeInvitation)
+
+	invitations := server.Echo().Group("/v1/invitations")
+	invitations.POST("/accept", h.AcceptInvitation)
+}
@@ -0,0 +1,273 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/fikryfahrezy/let-it-go/feature/invitation/handler"
+	"github.com/fikryfahrezy/let-it-go/feature/invitation/repository"
+	"github.com/fikryfahrezy/let-it-go/feature/invitation/service"
+	"github.com/fikryfahrezy/let-it-go/feature/invitation/service/servicefakes"
+	userService "github.com/fikryfahrezy/let-it-go/feature/user/service"
+	"github.com/fikryfahrezy/let-it-go/pkg/http_server"
+	"github.com/fikryfahrezy/let-it-go/pkg/logger"
+	"github.com/fikryfahrezy/let-it-go/pkg/rbac"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setupServer(t *testing.T, invitationHandler *handler.InvitationHandler, principal http_server.Principal) *echo.Echo {
+	t.Helper()
+
+	srv := http_server.New(http_server.Config{})
+	srv.SetAuthenticator(http_server.AuthenticatorFunc(func(ctx context.Context, token string) (http_server.Principal, error) {
+		if token != "valid-token" {
+			return http_server.Principal{}, http_server.ErrUnauthenticated
+		}
+		return principal, nil
+	}))
+	require.NoError(t, srv.Initialize([]http_server.RouteHandler{invitationHandler}))
+
+	return srv.Echo()
+}
+
+func adminPrincipal() http_server.Principal {
+	return http_server.Principal{UserID: uuid.New(), Roles: []string{rbac.RoleAdmin}}
+}
+
+func TestInvitationHandler_CreateInvitation_Success(t *testing.T) {
+	mockService := &servicefakes.FakeInvitationService{}
+	invitationID := uuid.New()
+	mockService.CreateInvitationReturns(service.InvitationResponse{
+		ID:        invitationID,
+		Email:     "jane@example.com",
+		Role:      rbac.RoleEditor,
+		ExpiresAt: time.Now().Add(time.Hour),
+	}, nil)
+	e := setupServer(t, handler.NewInvitationHandler(logger.NewDiscardLogger(), mockService), adminPrincipal())
+
+	req := httptest.NewRequest(http.MethodPost, "/v1/admin/invitations", strings.NewReader(`{"email":"jane@example.com","role":"editor"}`))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	req.Header.Set(echo.HeaderAuthorization, "Bearer valid-token")
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusCreated, rec.Code)
+
+	var response struct {
+		Result service.InvitationResponse `json:"result"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	assert.Equal(t, invitationID, response.Result.ID)
+
+	require.Equal(t, 1, mockService.CreateInvitationCallCount())
+	_, actualReq := mockService.CreateInvitationArgsForCall(0)
+	assert.Equal(t, "jane@example.com", actualReq.Email)
+	assert.Equal(t, rbac.RoleEditor, actualReq.Role)
+	assert.Nil(t, actualReq.ExpiresAt)
+}
+
+func TestInvitationHandler_CreateInvitation_ValidationError(t *testing.T) {
+	mockService := &servicefakes.FakeInvitationService{}
+	e := setupServer(t, handler.NewInvitationHandler(logger.NewDiscardLogger(), mockService), adminPrincipal())
+
+	req := httptest.NewRequest(http.MethodPost, "/v1/admin/invitations", strings.NewReader(`{"email":"not-an-email","role":"editor"}`))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	req.Header.Set(echo.HeaderAuthorization, "Bearer valid-token")
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+	assert.Equal(t, 0, mockService.CreateInvitationCallCount())
+}
+
+func TestInvitationHandler_CreateInvitation_InvalidRole(t *testing.T) {
+	mockService := &servicefakes.FakeInvitationService{}
+	mockService.CreateInvitationReturns(service.InvitationResponse{}, service.ErrInvalidRole)
+	e := setupServer(t, handler.NewInvitationHandler(logger.NewDiscardLogger(), mockService), adminPrincipal())
+
+	req := httptest.NewRequest(http.MethodPost, "/v1/admin/invitations", strings.NewReader(`{"email":"jane@example.com","role":"owner"}`))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	req.Header.Set(echo.HeaderAuthorization, "Bearer valid-token")
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+	var response http_server.APIResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	assert.Equal(t, service.ErrInvalidRole.Code, response.Error)
+}
+
+func TestInvitationHandler_AdminRoutes_RequireUsersManage(t *testing.T) {
+	mockService := &servicefakes.FakeInvitationService{}
+	e := setupServer(t, handler.NewInvitationHandler(logger.NewDiscardLogger(), mockService), http_server.Principal{
+		UserID: uuid.New(),
+		Roles:  []string{rbac.RoleEditor},
+	})
+
+	requests := []*http.Request{
+		httptest.NewRequest(http.MethodPost, "/v1/admin/invitations", strings.NewReader(`{"email":"jane@example.com","role":"editor"}`)),
+		httptest.NewRequest(http.MethodGet, "/v1/admin/invitations", nil),
+		httptest.NewRequest(http.MethodDelete, "/v1/admin/invitations/"+uuid.NewString(), nil),
+	}
+	for _, req := range requests {
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		req.Header.Set(echo.HeaderAuthorization, "Bearer valid-token")
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusForbidden, rec.Code, req.Method+" "+req.URL.Path)
+	}
+
+	assert.Equal(t, 0, mockService.CreateInvitationCallCount())
+	assert.Equal(t, 0, mockService.ListInvitationsCallCount())
+	assert.Equal(t, 0, mockService.RevokeInvitationCallCount())
+}
+
+func TestInvitationHandler_ListInvitations_Success(t *testing.T) {
+	mockService := &servicefakes.FakeInvitationService{}
+	mockService.ListInvitationsReturns([]service.InvitationResponse{
+		{ID: uuid.New(), Email: "jane@example.com"},
+	}, 21, nil)
+	e := setupServer(t, handler.NewInvitationHandler(logger.NewDiscardLogger(), mockService), adminPrincipal())
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/admin/invitations?page=2&page_size=10", nil)
+	req.Header.Set(echo.HeaderAuthorization, "Bearer valid-token")
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	var response http_server.ListAPIResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	assert.Equal(t, int64(21), response.Pagination.TotalData)
+	assert.Equal(t, int64(3), response.Pagination.TotalPages)
+
+	_, actualReq := mockService.ListInvitationsArgsForCall(0)
+	assert.Equal(t, 2, actualReq.Page)
+	assert.Equal(t, 10, actualReq.PageSize)
+}
+
+func TestInvitationHandler_RevokeInvitation_Success(t *testing.T) {
+	mockService := &servicefakes.FakeInvitationService{}
+	e := setupServer(t, handler.NewInvitationHandler(logger.NewDiscardLogger(), mockService), adminPrincipal())
+
+	invitationID := uuid.New()
+	req := httptest.NewRequest(http.MethodDelete, "/v1/admin/invitations/"+invitationID.String(), nil)
+	req.Header.Set(echo.HeaderAuthorization, "Bearer valid-token")
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, 1, mockService.RevokeInvitationCallCount())
+	_, actualID := mockService.RevokeInvitationArgsForCall(0)
+	assert.Equal(t, invitationID, actualID)
+}
+
+func TestInvitationHandler_RevokeInvitation_NotFound(t *testing.T) {
+	mockService := &servicefakes.FakeInvitationService{}
+	mockService.RevokeInvitationReturns(repository.ErrInvitationNotFound)
+	e := setupServer(t, handler.NewInvitationHandler(logger.NewDiscardLogger(), mockService), adminPrincipal())
+
+	req := httptest.NewRequest(http.MethodDelete, "/v1/admin/invitations/"+uuid.NewString(), nil)
+	req.Header.Set(echo.HeaderAuthorization, "Bearer valid-token")
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+func TestInvitationHandler_RevokeInvitation_InvalidUUID(t *testing.T) {
+	mockService := &servicefakes.FakeInvitationService{}
+	e := setupServer(t, handler.NewInvitationHandler(logger.NewDiscardLogger(), mockService), adminPrincipal())
+
+	req := httptest.NewRequest(http.MethodDelete, "/v1/admin/invitations/not-a-uuid", nil)
+	req.Header.Set(echo.HeaderAuthorization, "Bearer valid-token")
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Equal(t, 0, mockService.RevokeInvitationCallCount())
+}
+
+func TestInvitationHandler_AcceptInvitation_Success(t *testing.T) {
+	mockService := &servicefakes.FakeInvitationService{}
+	userID := uuid.New()
+	mockService.AcceptInvitationReturns(service.AcceptInvitationResponse{
+		UserID: userID,
+		Name:   "Jane Doe",
+		Email:  "jane@example.com",
+		Role:   rbac.RoleEditor,
+	}, nil)
+	e := setupServer(t, handler.NewInvitationHandler(logger.NewDiscardLogger(), mockService), http_server.Principal{})
+
+	// Accepting needs no login
+	req := httptest.NewRequest(http.MethodPost, "/v1/invitations/accept", strings.NewReader(`{"token":"raw-token","name":"Jane Doe","password":"password123"}`))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusCreated, rec.Code)
+
+	var response struct {
+		Result service.AcceptInvitationResponse `json:"result"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	assert.Equal(t, userID, response.Result.UserID)
+
+	_, actualReq := mockService.AcceptInvitationArgsForCall(0)
+	assert.Equal(t, "raw-token", actualReq.Token)
+	assert.Equal(t, "Jane Doe", actualReq.Name)
+}
+
+func TestInvitationHandler_AcceptInvitation_Errors(t *testing.T) {
+	tests := []struct {
+		name       string
+		password   string
+		err        error
+		statusCode int
+	}{
+		{name: "invalid invitation", password: "password123", err: service.ErrInvalidInvitation, statusCode: http.StatusBadRequest},
+		{name: "email taken", password: "password123", err: userService.ErrUserAlreadyExists, statusCode: http.StatusBadRequest},
+		// The password length is left to the password policy, so a short password reaches the service
+		{name: "weak password", password: "short", err: userService.ErrPasswordPolicyViolation, statusCode: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := &servicefakes.FakeInvitationService{}
+			mockService.AcceptInvitationReturns(service.AcceptInvitationResponse{}, tt.err)
+			e := setupServer(t, handler.NewInvitationHandler(logger.NewDiscardLogger(), mockService), http_server.Principal{})
+
+			body := `{"token":"raw-token","name":"Jane Doe","password":"` + tt.password + `"}`
+			req := httptest.NewRequest(http.MethodPost, "/v1/invitations/accept", strings.NewReader(body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			e.ServeHTTP(rec, req)
+
+			assert.Equal(t, tt.statusCode, rec.Code)
+			assert.Equal(t, 1, mockService.AcceptInvitationCallCount())
+		})
+	}
+}
+
+func TestInvitationHandler_AcceptInvitation_ValidationError(t *testing.T) {
+	mockService := &servicefakes.FakeInvitationService{}
+	e := setupServer(t, handler.NewInvitationHandler(logger.NewDiscardLogger(), mockService), http_server.Principal{})
+
+	req := httptest.NewRequest(http.MethodPost, "/v1/invitations/accept", strings.NewReader(`{"name":"Jane Doe","password":"password123"}`))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+	assert.Equal(t, 0, mockService.AcceptInvitationCallCount())
+}
@@ -0,0 +1,27 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+)
+
+// CountPending counts the invitations ListPending returns
+func (r *invitationRepository) CountPending(ctx context.Context, now time.Time) (int64, error) {
+	query := `
+		SELECT COUNT(*)
+		FROM invitations
+		WHERE accepted_at IS NULL AND revoked_at IS NULL AND expires_at > ?
+	`
+
+	var count int64
+	if err := r.db.QueryRowContext(ctx, query, now).Scan(&count); err != nil {
+		r.log.Error("Failed to count pending invitations",
+			slog.String("error", err.Error()),
+		)
+		return 0, fmt.Errorf("%w: %w", ErrFailedToCountInvitations, err)
+	}
+
+	return count, nil
+}
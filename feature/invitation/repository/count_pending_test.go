@@ -0,0 +1,28 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCountPending(t *testing.T) {
+	adminID := setupTest(t)
+	ctx := context.Background()
+
+	require.NoError(t, testRepository.Create(ctx, newTestInvitation(adminID, "a@example.com", "hash-a", time.Hour)))
+	require.NoError(t, testRepository.Create(ctx, newTestInvitation(adminID, "b@example.com", "hash-b", time.Hour)))
+	require.NoError(t, testRepository.Create(ctx, newTestInvitation(adminID, "c@example.com", "hash-c", -time.Hour)))
+
+	count, err := testRepository.CountPending(ctx, time.Now())
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), count)
+
+	// Both pending invitations have expired two hours from now
+	count, err = testRepository.CountPending(ctx, time.Now().Add(2*time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), count)
+}
@@ -0,0 +1,39 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+)
+
+func (r *invitationRepository) Create(ctx context.Context, invitation Invitation) error {
+	query := `
+		INSERT INTO invitations (id, email, role, token_hash, invited_by, expires_at, created_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?)
+	`
+
+	_, err := r.db.ExecContext(ctx, query,
+		invitation.ID,
+		invitation.Email,
+		invitation.Role,
+		invitation.TokenHash,
+		invitation.InvitedBy,
+		invitation.ExpiresAt,
+		time.Now(),
+	)
+	if err != nil {
+		r.log.Error("Failed to create invitation",
+			slog.String("error", err.Error()),
+			slog.String("email", invitation.Email),
+		)
+		return fmt.Errorf("%w: %w", ErrFailedToCreateInvitation, err)
+	}
+
+	r.log.Info("Invitation created successfully",
+		slog.String("invitation_id", invitation.ID.String()),
+		slog.String("email", invitation.Email),
+	)
+
+	return nil
+}
@@ -0,0 +1,39 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreate(t *testing.T) {
+	adminID := setupTest(t)
+	ctx := context.Background()
+
+	invitation := newTestInvitation(adminID, "jane@example.com", "create-hash", time.Hour)
+	err := testRepository.Create(ctx, invitation)
+	require.NoError(t, err)
+
+	result, err := testRepository.GetByTokenHash(ctx, "create-hash")
+	require.NoError(t, err)
+	assert.Equal(t, invitation.ID, result.ID)
+	assert.Equal(t, invitation.Email, result.Email)
+	assert.Equal(t, invitation.Role, result.Role)
+	assert.Equal(t, adminID, result.InvitedBy)
+	assert.True(t, invitation.ExpiresAt.Equal(result.ExpiresAt))
+	assert.Nil(t, result.AcceptedAt)
+	assert.Nil(t, result.RevokedAt)
+}
+
+func TestCreateDuplicateTokenHash(t *testing.T) {
+	adminID := setupTest(t)
+	ctx := context.Background()
+
+	require.NoError(t, testRepository.Create(ctx, newTestInvitation(adminID, "jane@example.com", "same-hash", time.Hour)))
+
+	err := testRepository.Create(ctx, newTestInvitation(adminID, "john@example.com", "same-hash", time.Hour))
+	assert.Error(t, err)
+}
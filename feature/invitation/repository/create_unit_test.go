@@ -0,0 +1,60 @@
+package repository_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/fikryfahrezy/let-it-go/feature/invitation/repository"
+	"github.com/fikryfahrezy/let-it-go/pkg/database"
+	"github.com/fikryfahrezy/let-it-go/pkg/logger"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateUnit(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	// nolint:errcheck
+	defer sqlDB.Close()
+
+	db := &database.DB{DB: sqlDB}
+	repo := repository.NewInvitationRepository(logger.NewDiscardLogger(), db)
+	ctx := context.Background()
+
+	invitation := newTestInvitation(uuid.New(), "jane@example.com", "token-hash", time.Hour)
+
+	// Mock the INSERT query
+	mock.ExpectExec("INSERT INTO invitations").
+		WithArgs(invitation.ID, invitation.Email, invitation.Role, invitation.TokenHash, invitation.InvitedBy, invitation.ExpiresAt, sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	err = repo.Create(ctx, invitation)
+	assert.NoError(t, err)
+
+	// Verify all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCreateErrorUnit(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	// nolint:errcheck
+	defer sqlDB.Close()
+
+	db := &database.DB{DB: sqlDB}
+	repo := repository.NewInvitationRepository(logger.NewDiscardLogger(), db)
+	ctx := context.Background()
+
+	mock.ExpectExec("INSERT INTO invitations").
+		WillReturnError(errors.New("connection lost"))
+
+	err = repo.Create(ctx, newTestInvitation(uuid.New(), "jane@example.com", "token-hash", time.Hour))
+	assert.ErrorIs(t, err, repository.ErrFailedToCreateInvitation)
+
+	// Verify all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
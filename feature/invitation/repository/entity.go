@@ -0,0 +1,26 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Invitation lets the person at Email sign up with Role. The raw token is only ever
+// emailed; it can be accepted once, until it expires or is revoked.
+type Invitation struct {
+	ID         uuid.UUID  `db:"id"` // UUIDv7
+	Email      string     `db:"email"`
+	Role       string     `db:"role"` // One of the rbac roles
+	TokenHash  string     `db:"token_hash"`
+	InvitedBy  uuid.UUID  `db:"invited_by"` // UUIDv7 of the admin who sent it
+	ExpiresAt  time.Time  `db:"expires_at"`
+	AcceptedAt *time.Time `db:"accepted_at"`
+	RevokedAt  *time.Time `db:"revoked_at"`
+	CreatedAt  time.Time  `db:"created_at"`
+}
+
+// IsPending reports whether the invitation can still be accepted at now
+func (i Invitation) IsPending(now time.Time) bool {
+	return i.AcceptedAt == nil && i.RevokedAt == nil && now.Before(i.ExpiresAt)
+}
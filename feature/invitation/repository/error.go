@@ -0,0 +1,24 @@
+package repository
+
+import "github.com/fikryfahrezy/let-it-go/pkg/app_error"
+
+// Repository errors
+var (
+	// Invitation not found errors
+	ErrInvitationNotFound = app_error.New("INVITATION-INVITATION_NOT_FOUND", "invitation not found")
+
+	// Database operation errors
+	ErrFailedToCreateInvitation = app_error.New("INVITATION-FAILED_TO_CREATE_INVITATION", "failed to create invitation")
+	ErrFailedToGetInvitation    = app_error.New("INVITATION-FAILED_TO_GET_INVITATION", "failed to get invitation")
+	ErrFailedToListInvitations  = app_error.New("INVITATION-FAILED_TO_LIST_INVITATIONS", "failed to list invitations")
+	ErrFailedToCountInvitations = app_error.New("INVITATION-FAILED_TO_COUNT_INVITATIONS", "failed to count invitations")
+	ErrFailedToRevokeInvitation = app_error.New("INVITATION-FAILED_TO_REVOKE_INVITATION", "failed to revoke invitation")
+	ErrFailedToAcceptInvitation = app_error.New("INVITATION-FAILED_TO_ACCEPT_INVITATION", "failed to accept invitation")
+
+	// Row scanning errors
+	ErrFailedToScanInvitationRow = app_error.New("INVITATION-FAILED_TO_SCAN_INVITATION_ROW", "failed to scan invitation row")
+
+	// Database result errors
+	ErrFailedToGetRowsAffected = app_error.New("INVITATION-FAILED_TO_GET_ROWS_AFFECTED", "failed to get rows affected")
+	ErrFailedToIterateRows     = app_error.New("INVITATION-FAILED_TO_ITERATE_ROWS", "error iterating invitation rows")
+)
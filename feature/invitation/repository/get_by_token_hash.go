@@ -0,0 +1,40 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+)
+
+func (r *invitationRepository) GetByTokenHash(ctx context.Context, tokenHash string) (Invitation, error) {
+	query := `
+		SELECT id, email, role, token_hash, invited_by, expires_at, accepted_at, revoked_at, created_at
+		FROM invitations
+		WHERE token_hash = ?
+	`
+
+	var invitation Invitation
+	err := r.db.QueryRowContext(ctx, query, tokenHash).Scan(
+		&invitation.ID,
+		&invitation.Email,
+		&invitation.Role,
+		&invitation.TokenHash,
+		&invitation.InvitedBy,
+		&invitation.ExpiresAt,
+		&invitation.AcceptedAt,
+		&invitation.RevokedAt,
+		&invitation.CreatedAt,
+	)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return Invitation{}, ErrInvitationNotFound
+		}
+		r.log.Error("Failed to get invitation by token hash",
+			slog.String("error", err.Error()),
+		)
+		return Invitation{}, fmt.Errorf("%w: %w", ErrFailedToGetInvitation, err)
+	}
+
+	return invitation, nil
+}
@@ -0,0 +1,31 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/fikryfahrezy/let-it-go/feature/invitation/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetByTokenHash(t *testing.T) {
+	adminID := setupTest(t)
+	ctx := context.Background()
+
+	invitation := newTestInvitation(adminID, "jane@example.com", "lookup-hash", time.Hour)
+	require.NoError(t, testRepository.Create(ctx, invitation))
+
+	result, err := testRepository.GetByTokenHash(ctx, "lookup-hash")
+	require.NoError(t, err)
+	assert.Equal(t, invitation.ID, result.ID)
+	assert.Equal(t, "lookup-hash", result.TokenHash)
+}
+
+func TestGetByTokenHashNotFound(t *testing.T) {
+	setupTest(t)
+
+	_, err := testRepository.GetByTokenHash(context.Background(), "unknown-hash")
+	assert.Equal(t, repository.ErrInvitationNotFound, err)
+}
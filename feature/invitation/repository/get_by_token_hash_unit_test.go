@@ -0,0 +1,70 @@
+package repository_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/fikryfahrezy/let-it-go/feature/invitation/repository"
+	"github.com/fikryfahrezy/let-it-go/pkg/database"
+	"github.com/fikryfahrezy/let-it-go/pkg/logger"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var invitationColumns = []string{"id", "email", "role", "token_hash", "invited_by", "expires_at", "accepted_at", "revoked_at", "created_at"}
+
+func TestGetByTokenHashUnit(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	// nolint:errcheck
+	defer sqlDB.Close()
+
+	db := &database.DB{DB: sqlDB}
+	repo := repository.NewInvitationRepository(logger.NewDiscardLogger(), db)
+	ctx := context.Background()
+
+	invitation := newTestInvitation(uuid.New(), "jane@example.com", "token-hash", time.Hour)
+
+	// Mock the SELECT query
+	rows := sqlmock.NewRows(invitationColumns).
+		AddRow(invitation.ID, invitation.Email, invitation.Role, invitation.TokenHash, invitation.InvitedBy, invitation.ExpiresAt, nil, nil, time.Now())
+	mock.ExpectQuery("SELECT (.+) FROM invitations WHERE token_hash = ?").
+		WithArgs("token-hash").
+		WillReturnRows(rows)
+
+	result, err := repo.GetByTokenHash(ctx, "token-hash")
+	assert.NoError(t, err)
+	assert.Equal(t, invitation.ID, result.ID)
+	assert.Equal(t, invitation.Email, result.Email)
+	assert.Equal(t, invitation.InvitedBy, result.InvitedBy)
+	assert.Nil(t, result.AcceptedAt)
+
+	// Verify all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetByTokenHashNotFoundUnit(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	// nolint:errcheck
+	defer sqlDB.Close()
+
+	db := &database.DB{DB: sqlDB}
+	repo := repository.NewInvitationRepository(logger.NewDiscardLogger(), db)
+	ctx := context.Background()
+
+	// Mock the SELECT query to return no rows
+	mock.ExpectQuery("SELECT (.+) FROM invitations WHERE token_hash = ?").
+		WithArgs("unknown-hash").
+		WillReturnError(sql.ErrNoRows)
+
+	_, err = repo.GetByTokenHash(ctx, "unknown-hash")
+	assert.Equal(t, repository.ErrInvitationNotFound, err)
+
+	// Verify all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,65 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+)
+
+// ListPending lists the invitations that were neither accepted nor revoked and have not
+// expired at now, newest first
+func (r *invitationRepository) ListPending(ctx context.Context, now time.Time, limit, offset int) ([]Invitation, error) {
+	query := `
+		SELECT id, email, role, token_hash, invited_by, expires_at, accepted_at, revoked_at, created_at
+		FROM invitations
+		WHERE accepted_at IS NULL AND revoked_at IS NULL AND expires_at > ?
+		ORDER BY created_at DESC, id DESC
+		LIMIT ? OFFSET ?
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, now, limit, offset)
+	if err != nil {
+		r.log.Error("Failed to list pending invitations",
+			slog.String("error", err.Error()),
+		)
+		return nil, fmt.Errorf("%w: %w", ErrFailedToListInvitations, err)
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			r.log.Error("Failed to close list pending invitations rows", slog.String("error", err.Error()))
+		}
+	}()
+
+	var invitations []Invitation
+	for rows.Next() {
+		invitation := Invitation{}
+		err := rows.Scan(
+			&invitation.ID,
+			&invitation.Email,
+			&invitation.Role,
+			&invitation.TokenHash,
+			&invitation.InvitedBy,
+			&invitation.ExpiresAt,
+			&invitation.AcceptedAt,
+			&invitation.RevokedAt,
+			&invitation.CreatedAt,
+		)
+		if err != nil {
+			r.log.Error("Failed to scan invitation row",
+				slog.String("error", err.Error()),
+			)
+			return nil, fmt.Errorf("%w: %w", ErrFailedToScanInvitationRow, err)
+		}
+		invitations = append(invitations, invitation)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.log.Error("Error iterating invitation rows",
+			slog.String("error", err.Error()),
+		)
+		return nil, fmt.Errorf("%w: %w", ErrFailedToIterateRows, err)
+	}
+
+	return invitations, nil
+}
@@ -0,0 +1,52 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListPending(t *testing.T) {
+	adminID := setupTest(t)
+	ctx := context.Background()
+
+	pending := newTestInvitation(adminID, "pending@example.com", "pending-hash", time.Hour)
+	expired := newTestInvitation(adminID, "expired@example.com", "expired-hash", -time.Hour)
+	revoked := newTestInvitation(adminID, "revoked@example.com", "revoked-hash", time.Hour)
+	accepted := newTestInvitation(adminID, "accepted@example.com", "accepted-hash", time.Hour)
+	require.NoError(t, testRepository.Create(ctx, pending))
+	require.NoError(t, testRepository.Create(ctx, expired))
+	require.NoError(t, testRepository.Create(ctx, revoked))
+	require.NoError(t, testRepository.Create(ctx, accepted))
+	require.NoError(t, testRepository.Revoke(ctx, revoked.ID, time.Now()))
+	require.NoError(t, testRepository.MarkAccepted(ctx, accepted.ID, time.Now()))
+
+	result, err := testRepository.ListPending(ctx, time.Now(), 10, 0)
+	require.NoError(t, err)
+	require.Len(t, result, 1)
+	assert.Equal(t, pending.ID, result[0].ID)
+
+	count, err := testRepository.CountPending(ctx, time.Now())
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), count)
+}
+
+func TestListPendingPagination(t *testing.T) {
+	adminID := setupTest(t)
+	ctx := context.Background()
+
+	require.NoError(t, testRepository.Create(ctx, newTestInvitation(adminID, "a@example.com", "hash-a", time.Hour)))
+	require.NoError(t, testRepository.Create(ctx, newTestInvitation(adminID, "b@example.com", "hash-b", time.Hour)))
+	require.NoError(t, testRepository.Create(ctx, newTestInvitation(adminID, "c@example.com", "hash-c", time.Hour)))
+
+	firstPage, err := testRepository.ListPending(ctx, time.Now(), 2, 0)
+	require.NoError(t, err)
+	assert.Len(t, firstPage, 2)
+
+	secondPage, err := testRepository.ListPending(ctx, time.Now(), 2, 2)
+	require.NoError(t, err)
+	assert.Len(t, secondPage, 1)
+}
@@ -0,0 +1,68 @@
+package repository_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/fikryfahrezy/let-it-go/feature/invitation/repository"
+	"github.com/fikryfahrezy/let-it-go/pkg/database"
+	"github.com/fikryfahrezy/let-it-go/pkg/logger"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListPendingUnit(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	// nolint:errcheck
+	defer sqlDB.Close()
+
+	db := &database.DB{DB: sqlDB}
+	repo := repository.NewInvitationRepository(logger.NewDiscardLogger(), db)
+	ctx := context.Background()
+
+	now := time.Now()
+	first := newTestInvitation(uuid.New(), "jane@example.com", "hash-1", time.Hour)
+	second := newTestInvitation(uuid.New(), "john@example.com", "hash-2", time.Hour)
+
+	// Mock the SELECT query
+	rows := sqlmock.NewRows(invitationColumns).
+		AddRow(first.ID, first.Email, first.Role, first.TokenHash, first.InvitedBy, first.ExpiresAt, nil, nil, now).
+		AddRow(second.ID, second.Email, second.Role, second.TokenHash, second.InvitedBy, second.ExpiresAt, nil, nil, now)
+	mock.ExpectQuery(`SELECT (.+) FROM invitations WHERE accepted_at IS NULL AND revoked_at IS NULL AND expires_at > \? ORDER BY created_at DESC, id DESC LIMIT \? OFFSET \?`).
+		WithArgs(now, 10, 0).
+		WillReturnRows(rows)
+
+	result, err := repo.ListPending(ctx, now, 10, 0)
+	assert.NoError(t, err)
+	require.Len(t, result, 2)
+	assert.Equal(t, first.ID, result[0].ID)
+	assert.Equal(t, second.Email, result[1].Email)
+
+	// Verify all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestListPendingErrorUnit(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	// nolint:errcheck
+	defer sqlDB.Close()
+
+	db := &database.DB{DB: sqlDB}
+	repo := repository.NewInvitationRepository(logger.NewDiscardLogger(), db)
+	ctx := context.Background()
+
+	mock.ExpectQuery("SELECT (.+) FROM invitations").
+		WillReturnError(errors.New("connection lost"))
+
+	_, err = repo.ListPending(ctx, time.Now(), 10, 0)
+	assert.ErrorIs(t, err, repository.ErrFailedToListInvitations)
+
+	// Verify all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,47 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// MarkAccepted uses up an invitation. Only a pending invitation can be accepted, so of
+// two concurrent acceptances one is reported as not found.
+func (r *invitationRepository) MarkAccepted(ctx context.Context, id uuid.UUID, acceptedAt time.Time) error {
+	query := `
+		UPDATE invitations
+		SET accepted_at = ?
+		WHERE id = ? AND accepted_at IS NULL AND revoked_at IS NULL AND expires_at > ?
+	`
+
+	result, err := r.db.ExecContext(ctx, query, acceptedAt, id, acceptedAt)
+	if err != nil {
+		r.log.Error("Failed to accept invitation",
+			slog.String("error", err.Error()),
+			slog.String("invitation_id", id.String()),
+		)
+		return fmt.Errorf("%w: %w", ErrFailedToAcceptInvitation, err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		r.log.Error("Failed to get rows affected",
+			slog.String("error", err.Error()),
+		)
+		return fmt.Errorf("%w: %w", ErrFailedToGetRowsAffected, err)
+	}
+
+	if rowsAffected == 0 {
+		return ErrInvitationNotFound
+	}
+
+	r.log.Info("Invitation accepted successfully",
+		slog.String("invitation_id", id.String()),
+	)
+
+	return nil
+}
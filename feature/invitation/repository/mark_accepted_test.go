@@ -0,0 +1,53 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/fikryfahrezy/let-it-go/feature/invitation/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMarkAccepted(t *testing.T) {
+	adminID := setupTest(t)
+	ctx := context.Background()
+
+	invitation := newTestInvitation(adminID, "jane@example.com", "accept-hash", time.Hour)
+	require.NoError(t, testRepository.Create(ctx, invitation))
+
+	err := testRepository.MarkAccepted(ctx, invitation.ID, time.Now())
+	require.NoError(t, err)
+
+	result, err := testRepository.GetByTokenHash(ctx, "accept-hash")
+	require.NoError(t, err)
+	assert.NotNil(t, result.AcceptedAt)
+
+	// Invitations are single-use
+	err = testRepository.MarkAccepted(ctx, invitation.ID, time.Now())
+	assert.Equal(t, repository.ErrInvitationNotFound, err)
+}
+
+func TestMarkAcceptedExpired(t *testing.T) {
+	adminID := setupTest(t)
+	ctx := context.Background()
+
+	invitation := newTestInvitation(adminID, "jane@example.com", "expired-hash", -time.Hour)
+	require.NoError(t, testRepository.Create(ctx, invitation))
+
+	err := testRepository.MarkAccepted(ctx, invitation.ID, time.Now())
+	assert.Equal(t, repository.ErrInvitationNotFound, err)
+}
+
+func TestMarkAcceptedRevoked(t *testing.T) {
+	adminID := setupTest(t)
+	ctx := context.Background()
+
+	invitation := newTestInvitation(adminID, "jane@example.com", "revoked-hash", time.Hour)
+	require.NoError(t, testRepository.Create(ctx, invitation))
+	require.NoError(t, testRepository.Revoke(ctx, invitation.ID, time.Now()))
+
+	err := testRepository.MarkAccepted(ctx, invitation.ID, time.Now())
+	assert.Equal(t, repository.ErrInvitationNotFound, err)
+}
@@ -0,0 +1,79 @@
+package repository_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/fikryfahrezy/let-it-go/feature/invitation/repository"
+	"github.com/fikryfahrezy/let-it-go/pkg/database"
+	"github.com/fikryfahrezy/let-it-go/pkg/logger"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMarkAcceptedUnit(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	// nolint:errcheck
+	defer sqlDB.Close()
+
+	db := &database.DB{DB: sqlDB}
+	repo := repository.NewInvitationRepository(logger.NewDiscardLogger(), db)
+	ctx := context.Background()
+
+	id := uuid.New()
+	acceptedAt := time.Now()
+	mock.ExpectExec(`UPDATE invitations SET accepted_at = \? WHERE id = \? AND accepted_at IS NULL AND revoked_at IS NULL AND expires_at > \?`).
+		WithArgs(acceptedAt, id, acceptedAt).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	err = repo.MarkAccepted(ctx, id, acceptedAt)
+	assert.NoError(t, err)
+
+	// Verify all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestMarkAcceptedNotPendingUnit(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	// nolint:errcheck
+	defer sqlDB.Close()
+
+	db := &database.DB{DB: sqlDB}
+	repo := repository.NewInvitationRepository(logger.NewDiscardLogger(), db)
+	ctx := context.Background()
+
+	mock.ExpectExec("UPDATE invitations SET accepted_at").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	err = repo.MarkAccepted(ctx, uuid.New(), time.Now())
+	assert.Equal(t, repository.ErrInvitationNotFound, err)
+
+	// Verify all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestMarkAcceptedErrorUnit(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	// nolint:errcheck
+	defer sqlDB.Close()
+
+	db := &database.DB{DB: sqlDB}
+	repo := repository.NewInvitationRepository(logger.NewDiscardLogger(), db)
+	ctx := context.Background()
+
+	mock.ExpectExec("UPDATE invitations SET accepted_at").
+		WillReturnError(errors.New("connection lost"))
+
+	err = repo.MarkAccepted(ctx, uuid.New(), time.Now())
+	assert.ErrorIs(t, err, repository.ErrFailedToAcceptInvitation)
+
+	// Verify all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
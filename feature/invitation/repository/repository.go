@@ -0,0 +1,19 @@
+package repository
+
+import (
+	"log/slog"
+
+	"github.com/fikryfahrezy/let-it-go/pkg/database"
+)
+
+type invitationRepository struct {
+	db  *database.DB
+	log *slog.Logger
+}
+
+func NewInvitationRepository(log *slog.Logger, db *database.DB) *invitationRepository {
+	return &invitationRepository{
+		db:  db,
+		log: log,
+	}
+}
@@ -0,0 +1,20 @@
+package repository
+
+//counterfeiter:generate -o repositoryfakes/fake_invitation_repository.go . InvitationRepository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type InvitationRepository interface {
+	Create(ctx context.Context, invitation Invitation) error
+	GetByTokenHash(ctx context.Context, tokenHash string) (Invitation, error)
+	ListPending(ctx context.Context, now time.Time, limit, offset int) ([]Invitation, error)
+	CountPending(ctx context.Context, now time.Time) (int64, error)
+	Revoke(ctx context.Context, id uuid.UUID, revokedAt time.Time) error
+	MarkAccepted(ctx context.Context, id uuid.UUID, acceptedAt time.Time) error
+	UnmarkAccepted(ctx context.Context, id uuid.UUID) error
+}
@@ -0,0 +1,581 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package repositoryfakes
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/fikryfahrezy/let-it-go/feature/invitation/repository"
+	"github.com/google/uuid"
+)
+
+type FakeInvitationRepository struct {
+	CountPendingStub        func(context.Context, time.Time) (int64, error)
+	countPendingMutex       sync.RWMutex
+	countPendingArgsForCall []struct {
+		arg1 context.Context
+		arg2 time.Time
+	}
+	countPendingReturns struct {
+		result1 int64
+		result2 error
+	}
+	countPendingReturnsOnCall map[int]struct {
+		result1 int64
+		result2 error
+	}
+	CreateStub        func(context.Context, repository.Invitation) error
+	createMutex       sync.RWMutex
+	createArgsForCall []struct {
+		arg1 context.Context
+		arg2 repository.Invitation
+	}
+	createReturns struct {
+		result1 error
+	}
+	createReturnsOnCall map[int]struct {
+		result1 error
+	}
+	GetByTokenHashStub        func(context.Context, string) (repository.Invitation, error)
+	getByTokenHashMutex       sync.RWMutex
+	getByTokenHashArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	getByTokenHashReturns struct {
+		result1 repository.Invitation
+		result2 error
+	}
+	getByTokenHashReturnsOnCall map[int]struct {
+		result1 repository.Invitation
+		result2 error
+	}
+	ListPendingStub        func(context.Context, time.Time, int, int) ([]repository.Invitation, error)
+	listPendingMutex       sync.RWMutex
+	listPendingArgsForCall []struct {
+		arg1 context.Context
+		arg2 time.Time
+		arg3 int
+		arg4 int
+	}
+	listPendingReturns struct {
+		result1 []repository.Invitation
+		result2 error
+	}
+	listPendingReturnsOnCall map[int]struct {
+		result1 []repository.Invitation
+		result2 error
+	}
+	MarkAcceptedStub        func(context.Context, uuid.UUID, time.Time) error
+	markAcceptedMutex       sync.RWMutex
+	markAcceptedArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 time.Time
+	}
+	markAcceptedReturns struct {
+		result1 error
+	}
+	markAcceptedReturnsOnCall map[int]struct {
+		result1 error
+	}
+	RevokeStub        func(context.Context, uuid.UUID, time.Time) error
+	revokeMutex       sync.RWMutex
+	revokeArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 time.Time
+	}
+	revokeReturns struct {
+		result1 error
+	}
+	revokeReturnsOnCall map[int]struct {
+		result1 error
+	}
+	UnmarkAcceptedStub        func(context.Context, uuid.UUID) error
+	unmarkAcceptedMutex       sync.RWMutex
+	unmarkAcceptedArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}
+	unmarkAcceptedReturns struct {
+		result1 error
+	}
+	unmarkAcceptedReturnsOnCall map[int]struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeInvitationRepository) CountPending(arg1 context.Context, arg2 time.Time) (int64, error) {
+	fake.countPendingMutex.Lock()
+	ret, specificReturn := fake.countPendingReturnsOnCall[len(fake.countPendingArgsForCall)]
+	fake.countPendingArgsForCall = append(fake.countPendingArgsForCall, struct {
+		arg1 context.Context
+		arg2 time.Time
+	}{arg1, arg2})
+	stub := fake.CountPendingStub
+	fakeReturns := fake.countPendingReturns
+	fake.recordInvocation("CountPending", []interface{}{arg1, arg2})
+	fake.countPendingMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeInvitationRepository) CountPendingCallCount() int {
+	fake.countPendingMutex.RLock()
+	defer fake.countPendingMutex.RUnlock()
+	return len(fake.countPendingArgsForCall)
+}
+
+func (fake *FakeInvitationRepository) CountPendingCalls(stub func(context.Context, time.Time) (int64, error)) {
+	fake.countPendingMutex.Lock()
+	defer fake.countPendingMutex.Unlock()
+	fake.CountPendingStub = stub
+}
+
+func (fake *FakeInvitationRepository) CountPendingArgsForCall(i int) (context.Context, time.Time) {
+	fake.countPendingMutex.RLock()
+	defer fake.countPendingMutex.RUnlock()
+	argsForCall := fake.countPendingArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeInvitationRepository) CountPendingReturns(result1 int64, result2 error) {
+	fake.countPendingMutex.Lock()
+	defer fake.countPendingMutex.Unlock()
+	fake.CountPendingStub = nil
+	fake.countPendingReturns = struct {
+		result1 int64
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInvitationRepository) CountPendingReturnsOnCall(i int, result1 int64, result2 error) {
+	fake.countPendingMutex.Lock()
+	defer fake.countPendingMutex.Unlock()
+	fake.CountPendingStub = nil
+	if fake.countPendingReturnsOnCall == nil {
+		fake.countPendingReturnsOnCall = make(map[int]struct {
+			result1 int64
+			result2 error
+		})
+	}
+	fake.countPendingReturnsOnCall[i] = struct {
+		result1 int64
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInvitationRepository) Create(arg1 context.Context, arg2 repository.Invitation) error {
+	fake.createMutex.Lock()
+	ret, specificReturn := fake.createReturnsOnCall[len(fake.createArgsForCall)]
+	fake.createArgsForCall = append(fake.createArgsForCall, struct {
+		arg1 context.Context
+		arg2 repository.Invitation
+	}{arg1, arg2})
+	stub := fake.CreateStub
+	fakeReturns := fake.createReturns
+	fake.recordInvocation("Create", []interface{}{arg1, arg2})
+	fake.createMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeInvitationRepository) CreateCallCount() int {
+	fake.createMutex.RLock()
+	defer fake.createMutex.RUnlock()
+	return len(fake.createArgsForCall)
+}
+
+func (fake *FakeInvitationRepository) CreateCalls(stub func(context.Context, repository.Invitation) error) {
+	fake.createMutex.Lock()
+	defer fake.createMutex.Unlock()
+	fake.CreateStub = stub
+}
+
+func (fake *FakeInvitationRepository) CreateArgsForCall(i int) (context.Context, repository.Invitation) {
+	fake.createMutex.RLock()
+	defer fake.createMutex.RUnlock()
+	argsForCall := fake.createArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeInvitationRepository) CreateReturns(result1 error) {
+	fake.createMutex.Lock()
+	defer fake.createMutex.Unlock()
+	fake.CreateStub = nil
+	fake.createReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeInvitationRepository) CreateReturnsOnCall(i int, result1 error) {
+	fake.createMutex.Lock()
+	defer fake.createMutex.Unlock()
+	fake.CreateStub = nil
+	if fake.createReturnsOnCall == nil {
+		fake.createReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.createReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeInvitationRepository) GetByTokenHash(arg1 context.Context, arg2 string) (repository.Invitation, error) {
+	fake.getByTokenHashMutex.Lock()
+	ret, specificReturn := fake.getByTokenHashReturnsOnCall[len(fake.getByTokenHashArgsForCall)]
+	fake.getByTokenHashArgsForCall = append(fake.getByTokenHashArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.GetByTokenHashStub
+	fakeReturns := fake.getByTokenHashReturns
+	fake.recordInvocation("GetByTokenHash", []interface{}{arg1, arg2})
+	fake.getByTokenHashMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeInvitationRepository) GetByTokenHashCallCount() int {
+	fake.getByTokenHashMutex.RLock()
+	defer fake.getByTokenHashMutex.RUnlock()
+	return len(fake.getByTokenHashArgsForCall)
+}
+
+func (fake *FakeInvitationRepository) GetByTokenHashCalls(stub func(context.Context, string) (repository.Invitation, error)) {
+	fake.getByTokenHashMutex.Lock()
+	defer fake.getByTokenHashMutex.Unlock()
+	fake.GetByTokenHashStub = stub
+}
+
+func (fake *FakeInvitationRepository) GetByTokenHashArgsForCall(i int) (context.Context, string) {
+	fake.getByTokenHashMutex.RLock()
+	defer fake.getByTokenHashMutex.RUnlock()
+	argsForCall := fake.getByTokenHashArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeInvitationRepository) GetByTokenHashReturns(result1 repository.Invitation, result2 error) {
+	fake.getByTokenHashMutex.Lock()
+	defer fake.getByTokenHashMutex.Unlock()
+	fake.GetByTokenHashStub = nil
+	fake.getByTokenHashReturns = struct {
+		result1 repository.Invitation
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInvitationRepository) GetByTokenHashReturnsOnCall(i int, result1 repository.Invitation, result2 error) {
+	fake.getByTokenHashMutex.Lock()
+	defer fake.getByTokenHashMutex.Unlock()
+	fake.GetByTokenHashStub = nil
+	if fake.getByTokenHashReturnsOnCall == nil {
+		fake.getByTokenHashReturnsOnCall = make(map[int]struct {
+			result1 repository.Invitation
+			result2 error
+		})
+	}
+	fake.getByTokenHashReturnsOnCall[i] = struct {
+		result1 repository.Invitation
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInvitationRepository) ListPending(arg1 context.Context, arg2 time.Time, arg3 int, arg4 int) ([]repository.Invitation, error) {
+	fake.listPendingMutex.Lock()
+	ret, specificReturn := fake.listPendingReturnsOnCall[len(fake.listPendingArgsForCall)]
+	fake.listPendingArgsForCall = append(fake.listPendingArgsForCall, struct {
+		arg1 context.Context
+		arg2 time.Time
+		arg3 int
+		arg4 int
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.ListPendingStub
+	fakeReturns := fake.listPendingReturns
+	fake.recordInvocation("ListPending", []interface{}{arg1, arg2, arg3, arg4})
+	fake.listPendingMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeInvitationRepository) ListPendingCallCount() int {
+	fake.listPendingMutex.RLock()
+	defer fake.listPendingMutex.RUnlock()
+	return len(fake.listPendingArgsForCall)
+}
+
+func (fake *FakeInvitationRepository) ListPendingCalls(stub func(context.Context, time.Time, int, int) ([]repository.Invitation, error)) {
+	fake.listPendingMutex.Lock()
+	defer fake.listPendingMutex.Unlock()
+	fake.ListPendingStub = stub
+}
+
+func (fake *FakeInvitationRepository) ListPendingArgsForCall(i int) (context.Context, time.Time, int, int) {
+	fake.listPendingMutex.RLock()
+	defer fake.listPendingMutex.RUnlock()
+	argsForCall := fake.listPendingArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeInvitationRepository) ListPendingReturns(result1 []repository.Invitation, result2 error) {
+	fake.listPendingMutex.Lock()
+	defer fake.listPendingMutex.Unlock()
+	fake.ListPendingStub = nil
+	fake.listPendingReturns = struct {
+		result1 []repository.Invitation
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInvitationRepository) ListPendingReturnsOnCall(i int, result1 []repository.Invitation, result2 error) {
+	fake.listPendingMutex.Lock()
+	defer fake.listPendingMutex.Unlock()
+	fake.ListPendingStub = nil
+	if fake.listPendingReturnsOnCall == nil {
+		fake.listPendingReturnsOnCall = make(map[int]struct {
+			result1 []repository.Invitation
+			result2 error
+		})
+	}
+	fake.listPendingReturnsOnCall[i] = struct {
+		result1 []repository.Invitation
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInvitationRepository) MarkAccepted(arg1 context.Context, arg2 uuid.UUID, arg3 time.Time) error {
+	fake.markAcceptedMutex.Lock()
+	ret, specificReturn := fake.markAcceptedReturnsOnCall[len(fake.markAcceptedArgsForCall)]
+	fake.markAcceptedArgsForCall = append(fake.markAcceptedArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 time.Time
+	}{arg1, arg2, arg3})
+	stub := fake.MarkAcceptedStub
+	fakeReturns := fake.markAcceptedReturns
+	fake.recordInvocation("MarkAccepted", []interface{}{arg1, arg2, arg3})
+	fake.markAcceptedMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeInvitationRepository) MarkAcceptedCallCount() int {
+	fake.markAcceptedMutex.RLock()
+	defer fake.markAcceptedMutex.RUnlock()
+	return len(fake.markAcceptedArgsForCall)
+}
+
+func (fake *FakeInvitationRepository) MarkAcceptedCalls(stub func(context.Context, uuid.UUID, time.Time) error) {
+	fake.markAcceptedMutex.Lock()
+	defer fake.markAcceptedMutex.Unlock()
+	fake.MarkAcceptedStub = stub
+}
+
+func (fake *FakeInvitationRepository) MarkAcceptedArgsForCall(i int) (context.Context, uuid.UUID, time.Time) {
+	fake.markAcceptedMutex.RLock()
+	defer fake.markAcceptedMutex.RUnlock()
+	argsForCall := fake.markAcceptedArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeInvitationRepository) MarkAcceptedReturns(result1 error) {
+	fake.markAcceptedMutex.Lock()
+	defer fake.markAcceptedMutex.Unlock()
+	fake.MarkAcceptedStub = nil
+	fake.markAcceptedReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeInvitationRepository) MarkAcceptedReturnsOnCall(i int, result1 error) {
+	fake.markAcceptedMutex.Lock()
+	defer fake.markAcceptedMutex.Unlock()
+	fake.MarkAcceptedStub = nil
+	if fake.markAcceptedReturnsOnCall == nil {
+		fake.markAcceptedReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.markAcceptedReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeInvitationRepository) Revoke(arg1 context.Context, arg2 uuid.UUID, arg3 time.Time) error {
+	fake.revokeMutex.Lock()
+	ret, specificReturn := fake.revokeReturnsOnCall[len(fake.revokeArgsForCall)]
+	fake.revokeArgsForCall = append(fake.revokeArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 time.Time
+	}{arg1, arg2, arg3})
+	stub := fake.RevokeStub
+	fakeReturns := fake.revokeReturns
+	fake.recordInvocation("Revoke", []interface{}{arg1, arg2, arg3})
+	fake.revokeMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeInvitationRepository) RevokeCallCount() int {
+	fake.revokeMutex.RLock()
+	defer fake.revokeMutex.RUnlock()
+	return len(fake.revokeArgsForCall)
+}
+
+func (fake *FakeInvitationRepository) RevokeCalls(stub func(context.Context, uuid.UUID, time.Time) error) {
+	fake.revokeMutex.Lock()
+	defer fake.revokeMutex.Unlock()
+	fake.RevokeStub = stub
+}
+
+func (fake *FakeInvitationRepository) RevokeArgsForCall(i int) (context.Context, uuid.UUID, time.Time) {
+	fake.revokeMutex.RLock()
+	defer fake.revokeMutex.RUnlock()
+	argsForCall := fake.revokeArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeInvitationRepository) RevokeReturns(result1 error) {
+	fake.revokeMutex.Lock()
+	defer fake.revokeMutex.Unlock()
+	fake.RevokeStub = nil
+	fake.revokeReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeInvitationRepository) RevokeReturnsOnCall(i int, result1 error) {
+	fake.revokeMutex.Lock()
+	defer fake.revokeMutex.Unlock()
+	fake.RevokeStub = nil
+	if fake.revokeReturnsOnCall == nil {
+		fake.revokeReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.revokeReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeInvitationRepository) UnmarkAccepted(arg1 context.Context, arg2 uuid.UUID) error {
+	fake.unmarkAcceptedMutex.Lock()
+	ret, specificReturn := fake.unmarkAcceptedReturnsOnCall[len(fake.unmarkAcceptedArgsForCall)]
+	fake.unmarkAcceptedArgsForCall = append(fake.unmarkAcceptedArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}{arg1, arg2})
+	stub := fake.UnmarkAcceptedStub
+	fakeReturns := fake.unmarkAcceptedReturns
+	fake.recordInvocation("UnmarkAccepted", []interface{}{arg1, arg2})
+	fake.unmarkAcceptedMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeInvitationRepository) UnmarkAcceptedCallCount() int {
+	fake.unmarkAcceptedMutex.RLock()
+	defer fake.unmarkAcceptedMutex.RUnlock()
+	return len(fake.unmarkAcceptedArgsForCall)
+}
+
+func (fake *FakeInvitationRepository) UnmarkAcceptedCalls(stub func(context.Context, uuid.UUID) error) {
+	fake.unmarkAcceptedMutex.Lock()
+	defer fake.unmarkAcceptedMutex.Unlock()
+	fake.UnmarkAcceptedStub = stub
+}
+
+func (fake *FakeInvitationRepository) UnmarkAcceptedArgsForCall(i int) (context.Context, uuid.UUID) {
+	fake.unmarkAcceptedMutex.RLock()
+	defer fake.unmarkAcceptedMutex.RUnlock()
+	argsForCall := fake.unmarkAcceptedArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeInvitationRepository) UnmarkAcceptedReturns(result1 error) {
+	fake.unmarkAcceptedMutex.Lock()
+	defer fake.unmarkAcceptedMutex.Unlock()
+	fake.UnmarkAcceptedStub = nil
+	fake.unmarkAcceptedReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeInvitationRepository) UnmarkAcceptedReturnsOnCall(i int, result1 error) {
+	fake.unmarkAcceptedMutex.Lock()
+	defer fake.unmarkAcceptedMutex.Unlock()
+	fake.UnmarkAcceptedStub = nil
+	if fake.unmarkAcceptedReturnsOnCall == nil {
+		fake.unmarkAcceptedReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.unmarkAcceptedReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeInvitationRepository) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeInvitationRepository) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ repository.InvitationRepository = new(FakeInvitationRepository)
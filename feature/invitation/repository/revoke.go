@@ -0,0 +1,47 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Revoke stops an invitation from being accepted. Accepted and already revoked
+// invitations are reported as not found.
+func (r *invitationRepository) Revoke(ctx context.Context, id uuid.UUID, revokedAt time.Time) error {
+	query := `
+		UPDATE invitations
+		SET revoked_at = ?
+		WHERE id = ? AND accepted_at IS NULL AND revoked_at IS NULL
+	`
+
+	result, err := r.db.ExecContext(ctx, query, revokedAt, id)
+	if err != nil {
+		r.log.Error("Failed to revoke invitation",
+			slog.String("error", err.Error()),
+			slog.String("invitation_id", id.String()),
+		)
+		return fmt.Errorf("%w: %w", ErrFailedToRevokeInvitation, err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		r.log.Error("Failed to get rows affected",
+			slog.String("error", err.Error()),
+		)
+		return fmt.Errorf("%w: %w", ErrFailedToGetRowsAffected, err)
+	}
+
+	if rowsAffected == 0 {
+		return ErrInvitationNotFound
+	}
+
+	r.log.Info("Invitation revoked successfully",
+		slog.String("invitation_id", id.String()),
+	)
+
+	return nil
+}
@@ -0,0 +1,50 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/fikryfahrezy/let-it-go/feature/invitation/repository"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRevoke(t *testing.T) {
+	adminID := setupTest(t)
+	ctx := context.Background()
+
+	invitation := newTestInvitation(adminID, "jane@example.com", "revoke-hash", time.Hour)
+	require.NoError(t, testRepository.Create(ctx, invitation))
+
+	err := testRepository.Revoke(ctx, invitation.ID, time.Now())
+	require.NoError(t, err)
+
+	result, err := testRepository.GetByTokenHash(ctx, "revoke-hash")
+	require.NoError(t, err)
+	assert.NotNil(t, result.RevokedAt)
+
+	// An invitation is only revoked once
+	err = testRepository.Revoke(ctx, invitation.ID, time.Now())
+	assert.Equal(t, repository.ErrInvitationNotFound, err)
+}
+
+func TestRevokeAccepted(t *testing.T) {
+	adminID := setupTest(t)
+	ctx := context.Background()
+
+	invitation := newTestInvitation(adminID, "jane@example.com", "accepted-hash", time.Hour)
+	require.NoError(t, testRepository.Create(ctx, invitation))
+	require.NoError(t, testRepository.MarkAccepted(ctx, invitation.ID, time.Now()))
+
+	err := testRepository.Revoke(ctx, invitation.ID, time.Now())
+	assert.Equal(t, repository.ErrInvitationNotFound, err)
+}
+
+func TestRevokeNotFound(t *testing.T) {
+	setupTest(t)
+
+	err := testRepository.Revoke(context.Background(), uuid.New(), time.Now())
+	assert.Equal(t, repository.ErrInvitationNotFound, err)
+}
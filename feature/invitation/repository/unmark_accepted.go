@@ -0,0 +1,46 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/google/uuid"
+)
+
+// UnmarkAccepted makes an accepted invitation pending again. It undoes MarkAccepted when
+// the acceptance fails after the invitation was claimed.
+func (r *invitationRepository) UnmarkAccepted(ctx context.Context, id uuid.UUID) error {
+	query := `
+		UPDATE invitations
+		SET accepted_at = NULL
+		WHERE id = ? AND accepted_at IS NOT NULL
+	`
+
+	result, err := r.db.ExecContext(ctx, query, id)
+	if err != nil {
+		r.log.Error("Failed to unmark accepted invitation",
+			slog.String("error", err.Error()),
+			slog.String("invitation_id", id.String()),
+		)
+		return fmt.Errorf("%w: %w", ErrFailedToAcceptInvitation, err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		r.log.Error("Failed to get rows affected",
+			slog.String("error", err.Error()),
+		)
+		return fmt.Errorf("%w: %w", ErrFailedToGetRowsAffected, err)
+	}
+
+	if rowsAffected == 0 {
+		return ErrInvitationNotFound
+	}
+
+	r.log.Info("Invitation unmarked as accepted successfully",
+		slog.String("invitation_id", id.String()),
+	)
+
+	return nil
+}
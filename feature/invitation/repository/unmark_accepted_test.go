@@ -0,0 +1,41 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/fikryfahrezy/let-it-go/feature/invitation/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnmarkAccepted(t *testing.T) {
+	adminID := setupTest(t)
+	ctx := context.Background()
+
+	invitation := newTestInvitation(adminID, "jane@example.com", "unmark-hash", time.Hour)
+	require.NoError(t, testRepository.Create(ctx, invitation))
+	require.NoError(t, testRepository.MarkAccepted(ctx, invitation.ID, time.Now()))
+
+	err := testRepository.UnmarkAccepted(ctx, invitation.ID)
+	require.NoError(t, err)
+
+	result, err := testRepository.GetByTokenHash(ctx, "unmark-hash")
+	require.NoError(t, err)
+	assert.Nil(t, result.AcceptedAt)
+
+	// The invitation is pending again and can still be accepted
+	require.NoError(t, testRepository.MarkAccepted(ctx, invitation.ID, time.Now()))
+}
+
+func TestUnmarkAcceptedPending(t *testing.T) {
+	adminID := setupTest(t)
+	ctx := context.Background()
+
+	invitation := newTestInvitation(adminID, "jane@example.com", "pending-hash", time.Hour)
+	require.NoError(t, testRepository.Create(ctx, invitation))
+
+	err := testRepository.UnmarkAccepted(ctx, invitation.ID)
+	assert.Equal(t, repository.ErrInvitationNotFound, err)
+}
@@ -0,0 +1,73 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/fikryfahrezy/let-it-go/feature/invitation/repository"
+	userService "github.com/fikryfahrezy/let-it-go/feature/user/service"
+	"github.com/fikryfahrezy/let-it-go/pkg/token"
+)
+
+// AcceptInvitation creates the invited user with the name and password they chose.
+// The invitation is claimed before the user is created, so of two concurrent acceptances
+// only one gets to create a user; it is released again when creating the user fails.
+func (s *invitationService) AcceptInvitation(ctx context.Context, req AcceptInvitationRequest) (AcceptInvitationResponse, error) {
+	invitation, err := s.invitationRepo.GetByTokenHash(ctx, token.Hash(req.Token))
+	if err != nil {
+		if errors.Is(err, repository.ErrInvitationNotFound) {
+			return AcceptInvitationResponse{}, ErrInvalidInvitation
+		}
+		return AcceptInvitationResponse{}, err
+	}
+
+	if !invitation.IsPending(time.Now()) {
+		s.log.Warn("Invitation is no longer pending",
+			slog.String("invitation_id", invitation.ID.String()),
+		)
+		return AcceptInvitationResponse{}, ErrInvalidInvitation
+	}
+
+	// Only a pending invitation can be claimed, a concurrent acceptance finds it accepted
+	if err := s.invitationRepo.MarkAccepted(ctx, invitation.ID, time.Now()); err != nil {
+		if errors.Is(err, repository.ErrInvitationNotFound) {
+			s.log.Warn("Invitation was claimed by another acceptance",
+				slog.String("invitation_id", invitation.ID.String()),
+			)
+			return AcceptInvitationResponse{}, ErrInvalidInvitation
+		}
+		return AcceptInvitationResponse{}, err
+	}
+
+	user, err := s.userCreator.CreateInvitedUser(ctx, userService.CreateInvitedUserRequest{
+		Name:     req.Name,
+		Email:    invitation.Email,
+		Password: req.Password,
+		Role:     invitation.Role,
+	})
+	if err != nil {
+		// No user was created, so the invitation can be accepted again
+		if err := s.invitationRepo.UnmarkAccepted(ctx, invitation.ID); err != nil {
+			s.log.Error("Failed to release invitation after a failed acceptance",
+				slog.String("error", err.Error()),
+				slog.String("invitation_id", invitation.ID.String()),
+			)
+		}
+		return AcceptInvitationResponse{}, err
+	}
+
+	s.log.Info("Invitation accepted",
+		slog.String("invitation_id", invitation.ID.String()),
+		slog.String("user_id", user.ID.String()),
+	)
+
+	return AcceptInvitationResponse{
+		UserID:    user.ID,
+		Name:      user.Name,
+		Email:     user.Email,
+		Role:      invitation.Role,
+		CreatedAt: user.CreatedAt,
+	}, nil
+}
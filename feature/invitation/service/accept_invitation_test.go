@@ -0,0 +1,154 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/fikryfahrezy/let-it-go/feature/invitation/repository"
+	"github.com/fikryfahrezy/let-it-go/feature/invitation/repository/repositoryfakes"
+	"github.com/fikryfahrezy/let-it-go/feature/invitation/service"
+	"github.com/fikryfahrezy/let-it-go/feature/invitation/service/servicefakes"
+	userService "github.com/fikryfahrezy/let-it-go/feature/user/service"
+	"github.com/fikryfahrezy/let-it-go/pkg/logger"
+	"github.com/fikryfahrezy/let-it-go/pkg/rbac"
+	"github.com/fikryfahrezy/let-it-go/pkg/token"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func pendingInvitation() repository.Invitation {
+	return repository.Invitation{
+		ID:        uuid.New(),
+		Email:     "jane@example.com",
+		Role:      rbac.RoleEditor,
+		TokenHash: token.Hash("raw-token"),
+		ExpiresAt: time.Now().Add(time.Hour),
+	}
+}
+
+func TestInvitationService_AcceptInvitation_Success(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeInvitationRepository{}
+	mockUserCreator := &servicefakes.FakeUserCreator{}
+	invitationService := service.NewInvitationService(logger.NewDiscardLogger(), mockRepo, mockUserCreator, nil, testServiceConfig)
+	invitation := pendingInvitation()
+	userID := uuid.New()
+	mockRepo.GetByTokenHashReturns(invitation, nil)
+	mockUserCreator.CreateInvitedUserReturns(userService.CreateUserResponse{ID: userID, Name: "Jane Doe", Email: invitation.Email}, nil)
+
+	result, err := invitationService.AcceptInvitation(context.Background(), service.AcceptInvitationRequest{
+		Token:    "raw-token",
+		Name:     "Jane Doe",
+		Password: "password123",
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, userID, result.UserID)
+	assert.Equal(t, rbac.RoleEditor, result.Role)
+
+	_, tokenHash := mockRepo.GetByTokenHashArgsForCall(0)
+	assert.Equal(t, token.Hash("raw-token"), tokenHash)
+
+	// The email and role come from the invitation, not the request
+	require.Equal(t, 1, mockUserCreator.CreateInvitedUserCallCount())
+	_, req := mockUserCreator.CreateInvitedUserArgsForCall(0)
+	assert.Equal(t, userService.CreateInvitedUserRequest{
+		Name:     "Jane Doe",
+		Email:    invitation.Email,
+		Password: "password123",
+		Role:     invitation.Role,
+	}, req)
+
+	require.Equal(t, 1, mockRepo.MarkAcceptedCallCount())
+	_, acceptedID, _ := mockRepo.MarkAcceptedArgsForCall(0)
+	assert.Equal(t, invitation.ID, acceptedID)
+}
+
+func TestInvitationService_AcceptInvitation_UnknownToken(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeInvitationRepository{}
+	mockUserCreator := &servicefakes.FakeUserCreator{}
+	invitationService := service.NewInvitationService(logger.NewDiscardLogger(), mockRepo, mockUserCreator, nil, testServiceConfig)
+	mockRepo.GetByTokenHashReturns(repository.Invitation{}, repository.ErrInvitationNotFound)
+
+	_, err := invitationService.AcceptInvitation(context.Background(), service.AcceptInvitationRequest{
+		Token:    "unknown",
+		Name:     "Jane Doe",
+		Password: "password123",
+	})
+
+	assert.Equal(t, service.ErrInvalidInvitation, err)
+	assert.Equal(t, 0, mockUserCreator.CreateInvitedUserCallCount())
+}
+
+func TestInvitationService_AcceptInvitation_NotPending(t *testing.T) {
+	acceptedAt := time.Now().Add(-time.Minute)
+	revokedAt := time.Now().Add(-time.Minute)
+	tests := map[string]func(*repository.Invitation){
+		"accepted": func(i *repository.Invitation) { i.AcceptedAt = &acceptedAt },
+		"revoked":  func(i *repository.Invitation) { i.RevokedAt = &revokedAt },
+		"expired":  func(i *repository.Invitation) { i.ExpiresAt = time.Now().Add(-time.Minute) },
+	}
+
+	for name, modify := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockRepo := &repositoryfakes.FakeInvitationRepository{}
+			mockUserCreator := &servicefakes.FakeUserCreator{}
+			invitationService := service.NewInvitationService(logger.NewDiscardLogger(), mockRepo, mockUserCreator, nil, testServiceConfig)
+			invitation := pendingInvitation()
+			modify(&invitation)
+			mockRepo.GetByTokenHashReturns(invitation, nil)
+
+			_, err := invitationService.AcceptInvitation(context.Background(), service.AcceptInvitationRequest{
+				Token:    "raw-token",
+				Name:     "Jane Doe",
+				Password: "password123",
+			})
+
+			assert.Equal(t, service.ErrInvalidInvitation, err)
+			assert.Equal(t, 0, mockUserCreator.CreateInvitedUserCallCount())
+			assert.Equal(t, 0, mockRepo.MarkAcceptedCallCount())
+		})
+	}
+}
+
+func TestInvitationService_AcceptInvitation_UserAlreadyExists(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeInvitationRepository{}
+	mockUserCreator := &servicefakes.FakeUserCreator{}
+	invitationService := service.NewInvitationService(logger.NewDiscardLogger(), mockRepo, mockUserCreator, nil, testServiceConfig)
+	invitation := pendingInvitation()
+	mockRepo.GetByTokenHashReturns(invitation, nil)
+	mockUserCreator.CreateInvitedUserReturns(userService.CreateUserResponse{}, userService.ErrUserAlreadyExists)
+
+	_, err := invitationService.AcceptInvitation(context.Background(), service.AcceptInvitationRequest{
+		Token:    "raw-token",
+		Name:     "Jane Doe",
+		Password: "password123",
+	})
+
+	assert.Equal(t, userService.ErrUserAlreadyExists, err)
+	// The invitation is released again when no user was created
+	require.Equal(t, 1, mockRepo.MarkAcceptedCallCount())
+	require.Equal(t, 1, mockRepo.UnmarkAcceptedCallCount())
+	_, releasedID := mockRepo.UnmarkAcceptedArgsForCall(0)
+	assert.Equal(t, invitation.ID, releasedID)
+}
+
+func TestInvitationService_AcceptInvitation_ClaimedConcurrently(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeInvitationRepository{}
+	mockUserCreator := &servicefakes.FakeUserCreator{}
+	invitationService := service.NewInvitationService(logger.NewDiscardLogger(), mockRepo, mockUserCreator, nil, testServiceConfig)
+	mockRepo.GetByTokenHashReturns(pendingInvitation(), nil)
+	// Another acceptance claimed the invitation after it was loaded
+	mockRepo.MarkAcceptedReturns(repository.ErrInvitationNotFound)
+
+	_, err := invitationService.AcceptInvitation(context.Background(), service.AcceptInvitationRequest{
+		Token:    "raw-token",
+		Name:     "Jane Doe",
+		Password: "password123",
+	})
+
+	assert.Equal(t, service.ErrInvalidInvitation, err)
+	assert.Equal(t, 0, mockUserCreator.CreateInvitedUserCallCount())
+	assert.Equal(t, 0, mockRepo.UnmarkAcceptedCallCount())
+}
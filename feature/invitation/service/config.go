@@ -0,0 +1,11 @@
+package service
+
+import "time"
+
+// Config holds the settings of the invitation service
+type Config struct {
+	// TTL is how long an invitation stays valid when no expiry is given; 7 days when zero
+	TTL time.Duration
+	// AcceptURL is the page the emailed invitation link points to; the token is appended as the token query parameter
+	AcceptURL string
+}
@@ -0,0 +1,118 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/fikryfahrezy/let-it-go/feature/invitation/repository"
+	"github.com/fikryfahrezy/let-it-go/pkg/http_server"
+	"github.com/fikryfahrezy/let-it-go/pkg/mailer"
+	"github.com/fikryfahrezy/let-it-go/pkg/rbac"
+	"github.com/fikryfahrezy/let-it-go/pkg/token"
+	"github.com/google/uuid"
+)
+
+const (
+	invitationTokenBytes = 32
+	// defaultTTL is used when Config.TTL is zero
+	defaultTTL = 7 * 24 * time.Hour
+)
+
+// CreateInvitation emails a single-use link that lets the invitee sign up with the role
+// picked by the admin
+func (s *invitationService) CreateInvitation(ctx context.Context, req CreateInvitationRequest) (InvitationResponse, error) {
+	principal, err := s.authorizeManager(ctx)
+	if err != nil {
+		return InvitationResponse{}, err
+	}
+
+	s.log.Info("Creating invitation",
+		slog.String("email", req.Email),
+		slog.String("role", req.Role),
+		slog.String("invited_by", principal.UserID.String()),
+	)
+
+	if !rbac.IsValidRole(req.Role) {
+		return InvitationResponse{}, ErrInvalidRole
+	}
+
+	now := time.Now()
+	expiresAt := now.Add(s.ttl())
+	if req.ExpiresAt != nil {
+		if !req.ExpiresAt.After(now) {
+			return InvitationResponse{}, ErrInvalidExpiry
+		}
+		expiresAt = *req.ExpiresAt
+	}
+
+	rawToken, err := token.GenerateOpaque(invitationTokenBytes)
+	if err != nil {
+		s.log.Error("Failed to generate invitation token",
+			slog.String("error", err.Error()),
+		)
+		return InvitationResponse{}, fmt.Errorf("%w: %w", ErrFailedToSendInvitation, err)
+	}
+
+	invitation := repository.Invitation{
+		ID:        uuid.Must(uuid.NewV7()),
+		Email:     req.Email,
+		Role:      req.Role,
+		TokenHash: token.Hash(rawToken),
+		InvitedBy: principal.UserID,
+		ExpiresAt: expiresAt,
+		CreatedAt: now,
+	}
+	if err := s.invitationRepo.Create(ctx, invitation); err != nil {
+		return InvitationResponse{}, err
+	}
+
+	message := mailer.Message{
+		To:      invitation.Email,
+		Subject: "You are invited",
+		Body: fmt.Sprintf(
+			"Hi,\n\nYou have been invited to join as %s. Use the link below to choose your name and password. It expires on %s and can be used once.\n\n%s\n",
+			invitation.Role, invitation.ExpiresAt.UTC().Format(time.RFC1123), token.Link(s.config.AcceptURL, rawToken),
+		),
+	}
+	if err := s.mailer.Send(ctx, message); err != nil {
+		s.log.Error("Failed to send invitation email",
+			slog.String("error", err.Error()),
+			slog.String("invitation_id", invitation.ID.String()),
+		)
+		// Nobody has the token, so the invitation must not show up as pending
+		if err := s.invitationRepo.Revoke(ctx, invitation.ID, time.Now()); err != nil {
+			s.log.Error("Failed to revoke unsent invitation",
+				slog.String("error", err.Error()),
+				slog.String("invitation_id", invitation.ID.String()),
+			)
+		}
+		return InvitationResponse{}, fmt.Errorf("%w: %w", ErrFailedToSendInvitation, err)
+	}
+
+	s.log.Info("Invitation sent",
+		slog.String("invitation_id", invitation.ID.String()),
+	)
+
+	return ToInvitationResponse(invitation), nil
+}
+
+func (s *invitationService) ttl() time.Duration {
+	if s.config.TTL > 0 {
+		return s.config.TTL
+	}
+	return defaultTTL
+}
+
+// authorizeManager only lets users who manage users handle invitations
+func (s *invitationService) authorizeManager(ctx context.Context) (http_server.Principal, error) {
+	principal, ok := http_server.PrincipalFromContext(ctx)
+	if !ok || !rbac.Can(principal, rbac.PermissionUsersManage) {
+		s.log.Warn("Invitation management denied",
+			slog.String("caller_id", principal.UserID.String()),
+		)
+		return http_server.Principal{}, ErrInvitationForbidden
+	}
+	return principal, nil
+}
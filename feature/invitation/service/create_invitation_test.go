@@ -0,0 +1,151 @@
+package service_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/url"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/fikryfahrezy/let-it-go/feature/invitation/repository/repositoryfakes"
+	"github.com/fikryfahrezy/let-it-go/feature/invitation/service"
+	"github.com/fikryfahrezy/let-it-go/pkg/http_server"
+	"github.com/fikryfahrezy/let-it-go/pkg/logger"
+	"github.com/fikryfahrezy/let-it-go/pkg/mailer"
+	"github.com/fikryfahrezy/let-it-go/pkg/rbac"
+	"github.com/fikryfahrezy/let-it-go/pkg/token"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testServiceConfig = service.Config{
+	TTL:       48 * time.Hour,
+	AcceptURL: "https://app.example.com/accept-invitation",
+}
+
+type failingMailer struct{}
+
+func (failingMailer) Send(context.Context, mailer.Message) error {
+	return mailer.ErrFailedToSendMail
+}
+
+func adminContext(adminID uuid.UUID) context.Context {
+	return http_server.ContextWithPrincipal(context.Background(), http_server.Principal{
+		UserID: adminID,
+		Roles:  []string{rbac.RoleAdmin},
+	})
+}
+
+func TestInvitationService_CreateInvitation_Success(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeInvitationRepository{}
+	var outbox bytes.Buffer
+	invitationService := service.NewInvitationService(logger.NewDiscardLogger(), mockRepo, nil, mailer.NewWriterMailer("no-reply@example.com", &outbox), testServiceConfig)
+	adminID := uuid.New()
+
+	result, err := invitationService.CreateInvitation(adminContext(adminID), service.CreateInvitationRequest{
+		Email: "jane@example.com",
+		Role:  rbac.RoleEditor,
+	})
+	require.NoError(t, err)
+
+	// The stored token is hashed and expires after the configured TTL
+	require.Equal(t, 1, mockRepo.CreateCallCount())
+	_, invitation := mockRepo.CreateArgsForCall(0)
+	assert.Equal(t, "jane@example.com", invitation.Email)
+	assert.Equal(t, rbac.RoleEditor, invitation.Role)
+	assert.Equal(t, adminID, invitation.InvitedBy)
+	assert.WithinDuration(t, time.Now().Add(48*time.Hour), invitation.ExpiresAt, time.Minute)
+	assert.Equal(t, invitation.ID, result.ID)
+	assert.Equal(t, adminID, result.InvitedBy)
+
+	// The email carries the raw token in the accept link
+	mail := outbox.String()
+	assert.Contains(t, mail, "To: jane@example.com")
+	link := regexp.MustCompile(`https://app\.example\.com/accept-invitation\?token=\S+`).FindString(mail)
+	require.NotEmpty(t, link)
+	parsed, err := url.Parse(link)
+	require.NoError(t, err)
+	assert.Equal(t, invitation.TokenHash, token.Hash(parsed.Query().Get("token")))
+	assert.Equal(t, 0, mockRepo.RevokeCallCount())
+}
+
+func TestInvitationService_CreateInvitation_CustomExpiry(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeInvitationRepository{}
+	invitationService := service.NewInvitationService(logger.NewDiscardLogger(), mockRepo, nil, mailer.NewWriterMailer("", io.Discard), testServiceConfig)
+	expiresAt := time.Now().Add(2 * time.Hour)
+
+	result, err := invitationService.CreateInvitation(adminContext(uuid.New()), service.CreateInvitationRequest{
+		Email:     "jane@example.com",
+		Role:      rbac.RoleAuthor,
+		ExpiresAt: &expiresAt,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, expiresAt, result.ExpiresAt)
+}
+
+func TestInvitationService_CreateInvitation_ExpiryInPast(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeInvitationRepository{}
+	invitationService := service.NewInvitationService(logger.NewDiscardLogger(), mockRepo, nil, mailer.NewWriterMailer("", io.Discard), testServiceConfig)
+	expiresAt := time.Now().Add(-time.Minute)
+
+	_, err := invitationService.CreateInvitation(adminContext(uuid.New()), service.CreateInvitationRequest{
+		Email:     "jane@example.com",
+		Role:      rbac.RoleAuthor,
+		ExpiresAt: &expiresAt,
+	})
+
+	assert.Equal(t, service.ErrInvalidExpiry, err)
+	assert.Equal(t, 0, mockRepo.CreateCallCount())
+}
+
+func TestInvitationService_CreateInvitation_InvalidRole(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeInvitationRepository{}
+	invitationService := service.NewInvitationService(logger.NewDiscardLogger(), mockRepo, nil, mailer.NewWriterMailer("", io.Discard), testServiceConfig)
+
+	_, err := invitationService.CreateInvitation(adminContext(uuid.New()), service.CreateInvitationRequest{
+		Email: "jane@example.com",
+		Role:  "owner",
+	})
+
+	assert.Equal(t, service.ErrInvalidRole, err)
+	assert.Equal(t, 0, mockRepo.CreateCallCount())
+}
+
+func TestInvitationService_CreateInvitation_Forbidden(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeInvitationRepository{}
+	invitationService := service.NewInvitationService(logger.NewDiscardLogger(), mockRepo, nil, mailer.NewWriterMailer("", io.Discard), testServiceConfig)
+	ctx := http_server.ContextWithPrincipal(context.Background(), http_server.Principal{
+		UserID: uuid.New(),
+		Roles:  []string{rbac.RoleEditor},
+	})
+
+	_, err := invitationService.CreateInvitation(ctx, service.CreateInvitationRequest{
+		Email: "jane@example.com",
+		Role:  rbac.RoleAuthor,
+	})
+
+	assert.Equal(t, service.ErrInvitationForbidden, err)
+	assert.Equal(t, 0, mockRepo.CreateCallCount())
+}
+
+func TestInvitationService_CreateInvitation_EmailFailure(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeInvitationRepository{}
+	invitationService := service.NewInvitationService(logger.NewDiscardLogger(), mockRepo, nil, failingMailer{}, testServiceConfig)
+
+	_, err := invitationService.CreateInvitation(adminContext(uuid.New()), service.CreateInvitationRequest{
+		Email: "jane@example.com",
+		Role:  rbac.RoleAuthor,
+	})
+
+	assert.ErrorIs(t, err, service.ErrFailedToSendInvitation)
+
+	// An invitation nobody received is revoked right away
+	require.Equal(t, 1, mockRepo.RevokeCallCount())
+	_, invitation := mockRepo.CreateArgsForCall(0)
+	_, revokedID, _ := mockRepo.RevokeArgsForCall(0)
+	assert.Equal(t, invitation.ID, revokedID)
+}
@@ -0,0 +1,15 @@
+package service
+
+import "github.com/fikryfahrezy/let-it-go/pkg/app_error"
+
+// Business logic errors (service-specific only)
+var (
+	// Authorization errors
+	ErrInvitationForbidden = app_error.New("INVITATION-FORBIDDEN", "you are not allowed to manage invitations")
+
+	// Invitation errors
+	ErrInvalidRole            = app_error.New("INVITATION-INVALID_ROLE", "role does not exist")
+	ErrInvalidExpiry          = app_error.New("INVITATION-INVALID_EXPIRY", "invitation expiry must be in the future")
+	ErrInvalidInvitation      = app_error.New("INVITATION-INVALID_INVITATION", "invalid, expired, revoked or already accepted invitation")
+	ErrFailedToSendInvitation = app_error.New("INVITATION-FAILED_TO_SEND_INVITATION", "failed to send invitation email")
+)
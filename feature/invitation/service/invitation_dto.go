@@ -0,0 +1,55 @@
+package service
+
+import (
+	"time"
+
+	"github.com/fikryfahrezy/let-it-go/feature/invitation/repository"
+	"github.com/fikryfahrezy/let-it-go/pkg/http_server"
+	"github.com/google/uuid"
+)
+
+type CreateInvitationRequest struct {
+	Email     string     `json:"email" validate:"required,email"`
+	Role      string     `json:"role" validate:"required"`
+	ExpiresAt *time.Time `json:"expires_at,omitempty"` // Omit for the configured invitation TTL
+}
+
+type ListInvitationsRequest struct {
+	http_server.PaginationRequest
+}
+
+type AcceptInvitationRequest struct {
+	Token    string `json:"token" validate:"required"`
+	Name     string `json:"name" validate:"required,min=2,max=100"`
+	Password string `json:"password" validate:"required"`
+}
+
+// InvitationResponse never carries the token, which is only ever emailed
+type InvitationResponse struct {
+	ID        uuid.UUID `json:"id"`
+	Email     string    `json:"email"`
+	Role      string    `json:"role"`
+	InvitedBy uuid.UUID `json:"invited_by"`
+	ExpiresAt time.Time `json:"expires_at"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// AcceptInvitationResponse is the user created for the invitation
+type AcceptInvitationResponse struct {
+	UserID    uuid.UUID `json:"user_id"`
+	Name      string    `json:"name"`
+	Email     string    `json:"email"`
+	Role      string    `json:"role"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+func ToInvitationResponse(i repository.Invitation) InvitationResponse {
+	return InvitationResponse{
+		ID:        i.ID,
+		Email:     i.Email,
+		Role:      i.Role,
+		InvitedBy: i.InvitedBy,
+		ExpiresAt: i.ExpiresAt,
+		CreatedAt: i.CreatedAt,
+	}
+}
@@ -0,0 +1,45 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+	"time"
+)
+
+// ListInvitations pages through the invitations that can still be accepted, newest first
+func (s *invitationService) ListInvitations(ctx context.Context, req ListInvitationsRequest) ([]InvitationResponse, int64, error) {
+	if _, err := s.authorizeManager(ctx); err != nil {
+		return nil, 0, err
+	}
+
+	s.log.Info("Listing pending invitations",
+		slog.Int("page", req.Page),
+		slog.Int("page_size", req.PageSize),
+	)
+
+	// One point in time for both queries, so the totals match the list
+	now := time.Now()
+	offset := (req.Page - 1) * req.PageSize
+
+	invitations, err := s.invitationRepo.ListPending(ctx, now, req.PageSize, offset)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	total, err := s.invitationRepo.CountPending(ctx, now)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	responses := make([]InvitationResponse, 0, len(invitations))
+	for _, invitation := range invitations {
+		responses = append(responses, ToInvitationResponse(invitation))
+	}
+
+	s.log.Info("Pending invitations listed successfully",
+		slog.Int("count", len(responses)),
+		slog.Int64("total", total),
+	)
+
+	return responses, total, nil
+}
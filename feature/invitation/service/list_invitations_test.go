@@ -0,0 +1,52 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/fikryfahrezy/let-it-go/feature/invitation/repository"
+	"github.com/fikryfahrezy/let-it-go/feature/invitation/repository/repositoryfakes"
+	"github.com/fikryfahrezy/let-it-go/feature/invitation/service"
+	"github.com/fikryfahrezy/let-it-go/pkg/http_server"
+	"github.com/fikryfahrezy/let-it-go/pkg/logger"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInvitationService_ListInvitations_Success(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeInvitationRepository{}
+	invitationService := service.NewInvitationService(logger.NewDiscardLogger(), mockRepo, nil, nil, testServiceConfig)
+	invitation := repository.Invitation{ID: uuid.New(), Email: "jane@example.com", Role: "author", ExpiresAt: time.Now().Add(time.Hour)}
+	mockRepo.ListPendingReturns([]repository.Invitation{invitation}, nil)
+	mockRepo.CountPendingReturns(11, nil)
+
+	result, total, err := invitationService.ListInvitations(adminContext(uuid.New()), service.ListInvitationsRequest{
+		PaginationRequest: http_server.PaginationRequest{Page: 2, PageSize: 10},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, int64(11), total)
+	require.Len(t, result, 1)
+	assert.Equal(t, invitation.ID, result[0].ID)
+
+	_, listedAt, limit, offset := mockRepo.ListPendingArgsForCall(0)
+	assert.Equal(t, 10, limit)
+	assert.Equal(t, 10, offset)
+	// Both queries see the same point in time
+	_, countedAt := mockRepo.CountPendingArgsForCall(0)
+	assert.Equal(t, listedAt, countedAt)
+}
+
+func TestInvitationService_ListInvitations_Forbidden(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeInvitationRepository{}
+	invitationService := service.NewInvitationService(logger.NewDiscardLogger(), mockRepo, nil, nil, testServiceConfig)
+
+	_, _, err := invitationService.ListInvitations(context.Background(), service.ListInvitationsRequest{
+		PaginationRequest: http_server.PaginationRequest{Page: 1, PageSize: 10},
+	})
+
+	assert.Equal(t, service.ErrInvitationForbidden, err)
+	assert.Equal(t, 0, mockRepo.ListPendingCallCount())
+}
@@ -0,0 +1,28 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// RevokeInvitation stops a pending invitation from being accepted
+func (s *invitationService) RevokeInvitation(ctx context.Context, id uuid.UUID) error {
+	principal, err := s.authorizeManager(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := s.invitationRepo.Revoke(ctx, id, time.Now()); err != nil {
+		return err
+	}
+
+	s.log.Info("Invitation revoked",
+		slog.String("invitation_id", id.String()),
+		slog.String("revoked_by", principal.UserID.String()),
+	)
+
+	return nil
+}
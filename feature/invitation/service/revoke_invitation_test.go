@@ -0,0 +1,36 @@
+package service_test
+
+import (
+	"testing"
+
+	"github.com/fikryfahrezy/let-it-go/feature/invitation/repository"
+	"github.com/fikryfahrezy/let-it-go/feature/invitation/repository/repositoryfakes"
+	"github.com/fikryfahrezy/let-it-go/feature/invitation/service"
+	"github.com/fikryfahrezy/let-it-go/pkg/logger"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInvitationService_RevokeInvitation_Success(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeInvitationRepository{}
+	invitationService := service.NewInvitationService(logger.NewDiscardLogger(), mockRepo, nil, nil, testServiceConfig)
+	invitationID := uuid.New()
+
+	err := invitationService.RevokeInvitation(adminContext(uuid.New()), invitationID)
+
+	require.NoError(t, err)
+	require.Equal(t, 1, mockRepo.RevokeCallCount())
+	_, actualID, _ := mockRepo.RevokeArgsForCall(0)
+	assert.Equal(t, invitationID, actualID)
+}
+
+func TestInvitationService_RevokeInvitation_NotPending(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeInvitationRepository{}
+	invitationService := service.NewInvitationService(logger.NewDiscardLogger(), mockRepo, nil, nil, testServiceConfig)
+	mockRepo.RevokeReturns(repository.ErrInvitationNotFound)
+
+	err := invitationService.RevokeInvitation(adminContext(uuid.New()), uuid.New())
+
+	assert.Equal(t, repository.ErrInvitationNotFound, err)
+}
@@ -0,0 +1,26 @@
+package service
+
+import (
+	"log/slog"
+
+	"github.com/fikryfahrezy/let-it-go/feature/invitation/repository"
+	"github.com/fikryfahrezy/let-it-go/pkg/mailer"
+)
+
+type invitationService struct {
+	invitationRepo repository.InvitationRepository
+	userCreator    UserCreator
+	mailer         mailer.Mailer
+	config         Config
+	log            *slog.Logger
+}
+
+func NewInvitationService(log *slog.Logger, invitationRepo repository.InvitationRepository, userCreator UserCreator, mailer mailer.Mailer, config Config) *invitationService {
+	return &invitationService{
+		invitationRepo: invitationRepo,
+		userCreator:    userCreator,
+		mailer:         mailer,
+		config:         config,
+		log:            log,
+	}
+}
@@ -0,0 +1,16 @@
+package service
+
+//counterfeiter:generate -o servicefakes/fake_invitation_service.go . InvitationService
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+type InvitationService interface {
+	CreateInvitation(ctx context.Context, req CreateInvitationRequest) (InvitationResponse, error)
+	ListInvitations(ctx context.Context, req ListInvitationsRequest) ([]InvitationResponse, int64, error)
+	RevokeInvitation(ctx context.Context, id uuid.UUID) error
+	AcceptInvitation(ctx context.Context, req AcceptInvitationRequest) (AcceptInvitationResponse, error)
+}
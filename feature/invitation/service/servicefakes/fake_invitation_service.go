@@ -0,0 +1,355 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package servicefakes
+
+import (
+	"context"
+	"sync"
+
+	"github.com/fikryfahrezy/let-it-go/feature/invitation/service"
+	"github.com/google/uuid"
+)
+
+type FakeInvitationService struct {
+	AcceptInvitationStub        func(context.Context, service.AcceptInvitationRequest) (service.AcceptInvitationResponse, error)
+	acceptInvitationMutex       sync.RWMutex
+	acceptInvitationArgsForCall []struct {
+		arg1 context.Context
+		arg2 service.AcceptInvitationRequest
+	}
+	acceptInvitationReturns struct {
+		result1 service.AcceptInvitationResponse
+		result2 error
+	}
+	acceptInvitationReturnsOnCall map[int]struct {
+		result1 service.AcceptInvitationResponse
+		result2 error
+	}
+	CreateInvitationStub        func(context.Context, service.CreateInvitationRequest) (service.InvitationResponse, error)
+	createInvitationMutex       sync.RWMutex
+	createInvitationArgsForCall []struct {
+		arg1 context.Context
+		arg2 service.CreateInvitationRequest
+	}
+	createInvitationReturns struct {
+		result1 service.InvitationResponse
+		result2 error
+	}
+	createInvitationReturnsOnCall map[int]struct {
+		result1 service.InvitationResponse
+		result2 error
+	}
+	ListInvitationsStub        func(context.Context, service.ListInvitationsRequest) ([]service.InvitationResponse, int64, error)
+	listInvitationsMutex       sync.RWMutex
+	listInvitationsArgsForCall []struct {
+		arg1 context.Context
+		arg2 service.ListInvitationsRequest
+	}
+	listInvitationsReturns struct {
+		result1 []service.InvitationResponse
+		result2 int64
+		result3 error
+	}
+	listInvitationsReturnsOnCall map[int]struct {
+		result1 []service.InvitationResponse
+		result2 int64
+		result3 error
+	}
+	RevokeInvitationStub        func(context.Context, uuid.UUID) error
+	revokeInvitationMutex       sync.RWMutex
+	revokeInvitationArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}
+	revokeInvitationReturns struct {
+		result1 error
+	}
+	revokeInvitationReturnsOnCall map[int]struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeInvitationService) AcceptInvitation(arg1 context.Context, arg2 service.AcceptInvitationRequest) (service.AcceptInvitationResponse, error) {
+	fake.acceptInvitationMutex.Lock()
+	ret, specificReturn := fake.acceptInvitationReturnsOnCall[len(fake.acceptInvitationArgsForCall)]
+	fake.acceptInvitationArgsForCall = append(fake.acceptInvitationArgsForCall, struct {
+		arg1 context.Context
+		arg2 service.AcceptInvitationRequest
+	}{arg1, arg2})
+	stub := fake.AcceptInvitationStub
+	fakeReturns := fake.acceptInvitationReturns
+	fake.recordInvocation("AcceptInvitation", []interface{}{arg1, arg2})
+	fake.acceptInvitationMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeInvitationService) AcceptInvitationCallCount() int {
+	fake.acceptInvitationMutex.RLock()
+	defer fake.acceptInvitationMutex.RUnlock()
+	return len(fake.acceptInvitationArgsForCall)
+}
+
+func (fake *FakeInvitationService) AcceptInvitationCalls(stub func(context.Context, service.AcceptInvitationRequest) (service.AcceptInvitationResponse, error)) {
+	fake.acceptInvitationMutex.Lock()
+	defer fake.acceptInvitationMutex.Unlock()
+	fake.AcceptInvitationStub = stub
+}
+
+func (fake *FakeInvitationService) AcceptInvitationArgsForCall(i int) (context.Context, service.AcceptInvitationRequest) {
+	fake.acceptInvitationMutex.RLock()
+	defer fake.acceptInvitationMutex.RUnlock()
+	argsForCall := fake.acceptInvitationArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeInvitationService) AcceptInvitationReturns(result1 service.AcceptInvitationResponse, result2 error) {
+	fake.acceptInvitationMutex.Lock()
+	defer fake.acceptInvitationMutex.Unlock()
+	fake.AcceptInvitationStub = nil
+	fake.acceptInvitationReturns = struct {
+		result1 service.AcceptInvitationResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInvitationService) AcceptInvitationReturnsOnCall(i int, result1 service.AcceptInvitationResponse, result2 error) {
+	fake.acceptInvitationMutex.Lock()
+	defer fake.acceptInvitationMutex.Unlock()
+	fake.AcceptInvitationStub = nil
+	if fake.acceptInvitationReturnsOnCall == nil {
+		fake.acceptInvitationReturnsOnCall = make(map[int]struct {
+			result1 service.AcceptInvitationResponse
+			result2 error
+		})
+	}
+	fake.acceptInvitationReturnsOnCall[i] = struct {
+		result1 service.AcceptInvitationResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInvitationService) CreateInvitation(arg1 context.Context, arg2 service.CreateInvitationRequest) (service.InvitationResponse, error) {
+	fake.createInvitationMutex.Lock()
+	ret, specificReturn := fake.createInvitationReturnsOnCall[len(fake.createInvitationArgsForCall)]
+	fake.createInvitationArgsForCall = append(fake.createInvitationArgsForCall, struct {
+		arg1 context.Context
+		arg2 service.CreateInvitationRequest
+	}{arg1, arg2})
+	stub := fake.CreateInvitationStub
+	fakeReturns := fake.createInvitationReturns
+	fake.recordInvocation("CreateInvitation", []interface{}{arg1, arg2})
+	fake.createInvitationMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeInvitationService) CreateInvitationCallCount() int {
+	fake.createInvitationMutex.RLock()
+	defer fake.createInvitationMutex.RUnlock()
+	return len(fake.createInvitationArgsForCall)
+}
+
+func (fake *FakeInvitationService) CreateInvitationCalls(stub func(context.Context, service.CreateInvitationRequest) (service.InvitationResponse, error)) {
+	fake.createInvitationMutex.Lock()
+	defer fake.createInvitationMutex.Unlock()
+	fake.CreateInvitationStub = stub
+}
+
+func (fake *FakeInvitationService) CreateInvitationArgsForCall(i int) (context.Context, service.CreateInvitationRequest) {
+	fake.createInvitationMutex.RLock()
+	defer fake.createInvitationMutex.RUnlock()
+	argsForCall := fake.createInvitationArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeInvitationService) CreateInvitationReturns(result1 service.InvitationResponse, result2 error) {
+	fake.createInvitationMutex.Lock()
+	defer fake.createInvitationMutex.Unlock()
+	fake.CreateInvitationStub = nil
+	fake.createInvitationReturns = struct {
+		result1 service.InvitationResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInvitationService) CreateInvitationReturnsOnCall(i int, result1 service.InvitationResponse, result2 error) {
+	fake.createInvitationMutex.Lock()
+	defer fake.createInvitationMutex.Unlock()
+	fake.CreateInvitationStub = nil
+	if fake.createInvitationReturnsOnCall == nil {
+		fake.createInvitationReturnsOnCall = make(map[int]struct {
+			result1 service.InvitationResponse
+			result2 error
+		})
+	}
+	fake.createInvitationReturnsOnCall[i] = struct {
+		result1 service.InvitationResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInvitationService) ListInvitations(arg1 context.Context, arg2 service.ListInvitationsRequest) ([]service.InvitationResponse, int64, error) {
+	fake.listInvitationsMutex.Lock()
+	ret, specificReturn := fake.listInvitationsReturnsOnCall[len(fake.listInvitationsArgsForCall)]
+	fake.listInvitationsArgsForCall = append(fake.listInvitationsArgsForCall, struct {
+		arg1 context.Context
+		arg2 service.ListInvitationsRequest
+	}{arg1, arg2})
+	stub := fake.ListInvitationsStub
+	fakeReturns := fake.listInvitationsReturns
+	fake.recordInvocation("ListInvitations", []interface{}{arg1, arg2})
+	fake.listInvitationsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeInvitationService) ListInvitationsCallCount() int {
+	fake.listInvitationsMutex.RLock()
+	defer fake.listInvitationsMutex.RUnlock()
+	return len(fake.listInvitationsArgsForCall)
+}
+
+func (fake *FakeInvitationService) ListInvitationsCalls(stub func(context.Context, service.ListInvitationsRequest) ([]service.InvitationResponse, int64, error)) {
+	fake.listInvitationsMutex.Lock()
+	defer fake.listInvitationsMutex.Unlock()
+	fake.ListInvitationsStub = stub
+}
+
+func (fake *FakeInvitationService) ListInvitationsArgsForCall(i int) (context.Context, service.ListInvitationsRequest) {
+	fake.listInvitationsMutex.RLock()
+	defer fake.listInvitationsMutex.RUnlock()
+	argsForCall := fake.listInvitationsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeInvitationService) ListInvitationsReturns(result1 []service.InvitationResponse, result2 int64, result3 error) {
+	fake.listInvitationsMutex.Lock()
+	defer fake.listInvitationsMutex.Unlock()
+	fake.ListInvitationsStub = nil
+	fake.listInvitationsReturns = struct {
+		result1 []service.InvitationResponse
+		result2 int64
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeInvitationService) ListInvitationsReturnsOnCall(i int, result1 []service.InvitationResponse, result2 int64, result3 error) {
+	fake.listInvitationsMutex.Lock()
+	defer fake.listInvitationsMutex.Unlock()
+	fake.ListInvitationsStub = nil
+	if fake.listInvitationsReturnsOnCall == nil {
+		fake.listInvitationsReturnsOnCall = make(map[int]struct {
+			result1 []service.InvitationResponse
+			result2 int64
+			result3 error
+		})
+	}
+	fake.listInvitationsReturnsOnCall[i] = struct {
+		result1 []service.InvitationResponse
+		result2 int64
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeInvitationService) RevokeInvitation(arg1 context.Context, arg2 uuid.UUID) error {
+	fake.revokeInvitationMutex.Lock()
+	ret, specificReturn := fake.revokeInvitationReturnsOnCall[len(fake.revokeInvitationArgsForCall)]
+	fake.revokeInvitationArgsForCall = append(fake.revokeInvitationArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}{arg1, arg2})
+	stub := fake.RevokeInvitationStub
+	fakeReturns := fake.revokeInvitationReturns
+	fake.recordInvocation("RevokeInvitation", []interface{}{arg1, arg2})
+	fake.revokeInvitationMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeInvitationService) RevokeInvitationCallCount() int {
+	fake.revokeInvitationMutex.RLock()
+	defer fake.revokeInvitationMutex.RUnlock()
+	return len(fake.revokeInvitationArgsForCall)
+}
+
+func (fake *FakeInvitationService) RevokeInvitationCalls(stub func(context.Context, uuid.UUID) error) {
+	fake.revokeInvitationMutex.Lock()
+	defer fake.revokeInvitationMutex.Unlock()
+	fake.RevokeInvitationStub = stub
+}
+
+func (fake *FakeInvitationService) RevokeInvitationArgsForCall(i int) (context.Context, uuid.UUID) {
+	fake.revokeInvitationMutex.RLock()
+	defer fake.revokeInvitationMutex.RUnlock()
+	argsForCall := fake.revokeInvitationArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeInvitationService) RevokeInvitationReturns(result1 error) {
+	fake.revokeInvitationMutex.Lock()
+	defer fake.revokeInvitationMutex.Unlock()
+	fake.RevokeInvitationStub = nil
+	fake.revokeInvitationReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeInvitationService) RevokeInvitationReturnsOnCall(i int, result1 error) {
+	fake.revokeInvitationMutex.Lock()
+	defer fake.revokeInvitationMutex.Unlock()
+	fake.RevokeInvitationStub = nil
+	if fake.revokeInvitationReturnsOnCall == nil {
+		fake.revokeInvitationReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.revokeInvitationReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeInvitationService) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeInvitationService) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ service.InvitationService = new(FakeInvitationService)
@@ -0,0 +1,118 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package servicefakes
+
+import (
+	"context"
+	"sync"
+
+	"github.com/fikryfahrezy/let-it-go/feature/invitation/service"
+	servicea "github.com/fikryfahrezy/let-it-go/feature/user/service"
+)
+
+type FakeUserCreator struct {
+	CreateInvitedUserStub        func(context.Context, servicea.CreateInvitedUserRequest) (servicea.CreateUserResponse, error)
+	createInvitedUserMutex       sync.RWMutex
+	createInvitedUserArgsForCall []struct {
+		arg1 context.Context
+		arg2 servicea.CreateInvitedUserRequest
+	}
+	createInvitedUserReturns struct {
+		result1 servicea.CreateUserResponse
+		result2 error
+	}
+	createInvitedUserReturnsOnCall map[int]struct {
+		result1 servicea.CreateUserResponse
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeUserCreator) CreateInvitedUser(arg1 context.Context, arg2 servicea.CreateInvitedUserRequest) (servicea.CreateUserResponse, error) {
+	fake.createInvitedUserMutex.Lock()
+	ret, specificReturn := fake.createInvitedUserReturnsOnCall[len(fake.createInvitedUserArgsForCall)]
+	fake.createInvitedUserArgsForCall = append(fake.createInvitedUserArgsForCall, struct {
+		arg1 context.Context
+		arg2 servicea.CreateInvitedUserRequest
+	}{arg1, arg2})
+	stub := fake.CreateInvitedUserStub
+	fakeReturns := fake.createInvitedUserReturns
+	fake.recordInvocation("CreateInvitedUser", []interface{}{arg1, arg2})
+	fake.createInvitedUserMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeUserCreator) CreateInvitedUserCallCount() int {
+	fake.createInvitedUserMutex.RLock()
+	defer fake.createInvitedUserMutex.RUnlock()
+	return len(fake.createInvitedUserArgsForCall)
+}
+
+func (fake *FakeUserCreator) CreateInvitedUserCalls(stub func(context.Context, servicea.CreateInvitedUserRequest) (servicea.CreateUserResponse, error)) {
+	fake.createInvitedUserMutex.Lock()
+	defer fake.createInvitedUserMutex.Unlock()
+	fake.CreateInvitedUserStub = stub
+}
+
+func (fake *FakeUserCreator) CreateInvitedUserArgsForCall(i int) (context.Context, servicea.CreateInvitedUserRequest) {
+	fake.createInvitedUserMutex.RLock()
+	defer fake.createInvitedUserMutex.RUnlock()
+	argsForCall := fake.createInvitedUserArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeUserCreator) CreateInvitedUserReturns(result1 servicea.CreateUserResponse, result2 error) {
+	fake.createInvitedUserMutex.Lock()
+	defer fake.createInvitedUserMutex.Unlock()
+	fake.CreateInvitedUserStub = nil
+	fake.createInvitedUserReturns = struct {
+		result1 servicea.CreateUserResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUserCreator) CreateInvitedUserReturnsOnCall(i int, result1 servicea.CreateUserResponse, result2 error) {
+	fake.createInvitedUserMutex.Lock()
+	defer fake.createInvitedUserMutex.Unlock()
+	fake.CreateInvitedUserStub = nil
+	if fake.createInvitedUserReturnsOnCall == nil {
+		fake.createInvitedUserReturnsOnCall = make(map[int]struct {
+			result1 servicea.CreateUserResponse
+			result2 error
+		})
+	}
+	fake.createInvitedUserReturnsOnCall[i] = struct {
+		result1 servicea.CreateUserResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUserCreator) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeUserCreator) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ service.UserCreator = new(FakeUserCreator)
@@ -0,0 +1,15 @@
+package service
+
+//counterfeiter:generate -o servicefakes/fake_user_creator.go . UserCreator
+
+import (
+	"context"
+
+	userService "github.com/fikryfahrezy/let-it-go/feature/user/service"
+)
+
+// UserCreator creates the user of an accepted invitation.
+// It is implemented by the user service.
+type UserCreator interface {
+	CreateInvitedUser(ctx context.Context, req userService.CreateInvitedUserRequest) (userService.CreateUserResponse, error)
+}
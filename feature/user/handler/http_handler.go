@@ -6,10 +6,12 @@ import (
 	"math"
 	"net/http"
 	"strconv"
+	"time"
 
 	"github.com/fikryfahrezy/let-it-go/feature/user/repository"
 	"github.com/fikryfahrezy/let-it-go/feature/user/service"
 	"github.com/fikryfahrezy/let-it-go/pkg/http_server"
+	"github.com/fikryfahrezy/let-it-go/pkg/rbac"
 	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
 )
@@ -34,9 +36,111 @@ func (h *UserHandler) translateServiceError(c echo.Context, err error, defaultMe
 	if errors.Is(err, service.ErrFailedToHashPassword) {
 		return http_server.InternalServerErrorResponse(c, "Password processing failed", err)
 	}
+	if errors.Is(err, service.ErrIncorrectCurrentPassword) {
+		return http_server.BadRequestResponse(c, "Current password is incorrect", err)
+	}
+	if errors.Is(err, service.ErrPasswordPolicyViolation) {
+		return http_server.BadRequestResponse(c, "Password does not meet the password policy", err)
+	}
+	if errors.Is(err, service.ErrInvalidCredentials) {
+		return http_server.UnauthorizedResponse(c, "Invalid email or password", err)
+	}
+	if errors.Is(err, service.ErrAccountLocked) {
+		return http_server.TooManyRequestsResponse(c, "Too many failed attempts, try again later", err)
+	}
+	if errors.Is(err, service.ErrInvalidTwoFactorCode) {
+		return http_server.BadRequestResponse(c, "Invalid two factor code", err)
+	}
+	if errors.Is(err, service.ErrInvalidTwoFactorChallenge) {
+		return http_server.UnauthorizedResponse(c, "Invalid or expired two factor challenge, please log in again", err)
+	}
+	if errors.Is(err, service.ErrTwoFactorNotEnabled) {
+		return http_server.BadRequestResponse(c, "Two factor authentication is not enabled", err)
+	}
+	if errors.Is(err, repository.ErrTwoFactorAlreadyEnabled) {
+		return http_server.BadRequestResponse(c, "Two factor authentication is already enabled", err)
+	}
+	if errors.Is(err, repository.ErrTwoFactorCredentialNotFound) {
+		return http_server.BadRequestResponse(c, "Two factor enrollment has not been started", err)
+	}
+	if errors.Is(err, service.ErrTwoFactorUnavailable) {
+		return http_server.ErrorResponse(c, http.StatusServiceUnavailable, "Two factor authentication is not available", err)
+	}
+	if errors.Is(err, service.ErrInvalidOIDCState) {
+		return http_server.BadRequestResponse(c, "Invalid or expired login state, please start again", err)
+	}
+	if errors.Is(err, service.ErrOIDCLoginFailed) {
+		return http_server.UnauthorizedResponse(c, "The identity provider did not confirm the login", err)
+	}
+	if errors.Is(err, service.ErrOIDCEmailMissing) {
+		return http_server.BadRequestResponse(c, "The identity provider did not share an email address", err)
+	}
+	if errors.Is(err, service.ErrOIDCEmailUnverified) {
+		return http_server.ErrorResponse(c, http.StatusConflict, "An account with this email already exists, log in with your password instead", err)
+	}
+	if errors.Is(err, service.ErrOIDCUserUnverified) {
+		return http_server.ErrorResponse(c, http.StatusConflict, "An account with this email already exists and is not verified, log in with your password instead", err)
+	}
+	if errors.Is(err, service.ErrOIDCUnavailable) {
+		return http_server.ErrorResponse(c, http.StatusServiceUnavailable, "OpenID Connect login is not available", err)
+	}
+	if errors.Is(err, service.ErrInvalidRefreshToken) {
+		return http_server.UnauthorizedResponse(c, "Invalid or expired refresh token", err)
+	}
+	if errors.Is(err, service.ErrRefreshTokenReused) {
+		return http_server.UnauthorizedResponse(c, "Refresh token was already used, please log in again", err)
+	}
+	if errors.Is(err, service.ErrInvalidPasswordResetToken) {
+		return http_server.BadRequestResponse(c, "Invalid or expired password reset token", err)
+	}
+	if errors.Is(err, service.ErrInvalidEmailVerificationToken) {
+		return http_server.BadRequestResponse(c, "Invalid or expired email verification token", err)
+	}
+	if errors.Is(err, service.ErrInvalidRole) {
+		return http_server.BadRequestResponse(c, "Role does not exist", err)
+	}
+	if errors.Is(err, service.ErrCannotChangeOwnRole) {
+		return http_server.BadRequestResponse(c, "You cannot change your own role", err)
+	}
+	if errors.Is(err, service.ErrCannotImpersonate) {
+		return http_server.BadRequestResponse(c, "You cannot impersonate yourself or a user who can manage users", err)
+	}
+	if errors.Is(err, service.ErrInvalidScope) {
+		return http_server.BadRequestResponse(c, "Scope does not exist", err)
+	}
+	if errors.Is(err, service.ErrInvalidDateRange) {
+		return http_server.BadRequestResponse(c, "created_from must be before created_to", err)
+	}
+	if errors.Is(err, service.ErrBatchTooLarge) {
+		return http_server.ErrorResponse(c, http.StatusRequestEntityTooLarge, "Batch has more operations than allowed", err)
+	}
+	if errors.Is(err, service.ErrInvalidTokenExpiry) {
+		return http_server.BadRequestResponse(c, "Token expiry must be in the future", err)
+	}
+	if errors.Is(err, repository.ErrPersonalAccessTokenNotFound) {
+		return http_server.NotFoundResponse(c, "Personal access token not found", err)
+	}
+	if errors.Is(err, service.ErrUserForbidden) {
+		return http_server.ForbiddenResponse(c, "You are not allowed to access this user", err)
+	}
 	if errors.Is(err, repository.ErrUserNotFound) {
 		return http_server.NotFoundResponse(c, "User not found", err)
 	}
+	if errors.Is(err, repository.ErrHandleAlreadyTaken) {
+		return http_server.ErrorResponse(c, http.StatusConflict, "Handle is already taken", err)
+	}
+	if errors.Is(err, repository.ErrAuthorProfileNotFound) {
+		return http_server.NotFoundResponse(c, "Author not found", err)
+	}
+	if errors.Is(err, repository.ErrDeletedUserNotFound) {
+		return http_server.NotFoundResponse(c, "No deleted user to restore, the grace period may be over", err)
+	}
+	if errors.Is(err, service.ErrExportUnavailable) {
+		return http_server.ErrorResponse(c, http.StatusServiceUnavailable, "User data export is not available", err)
+	}
+	if errors.Is(err, service.ErrProfileUnavailable) {
+		return http_server.ErrorResponse(c, http.StatusServiceUnavailable, "User profiles are not available", err)
+	}
 
 	// Log unexpected errors
 	h.log.Error("Service error",
@@ -80,13 +184,16 @@ func (h *UserHandler) CreateUser(c echo.Context) error {
 
 // GetUser retrieves a user by ID
 // @Summary Get a user by ID
-// @Description Retrieve a user by their unique identifier
+// @Description Retrieve a user by their unique identifier. Users can only get themselves unless they have the users:manage permission. Personal access tokens need the users:read scope.
 // @Tags users
 // @Accept json
 // @Produce json
+// @Security BearerAuth
 // @Param id path string true "User ID"
 // @Success 200 {object} http_server.APIResponse{result=service.GetUserResponse}
 // @Failure 400 {object} http_server.APIResponse
+// @Failure 401 {object} http_server.APIResponse
+// @Failure 403 {object} http_server.APIResponse
 // @Failure 404 {object} http_server.APIResponse
 // @Failure 500 {object} http_server.APIResponse
 // @Router /v1/users/{id} [get]
@@ -114,10 +221,12 @@ func (h *UserHandler) GetUser(c echo.Context) error {
 // @Tags users
 // @Accept json
 // @Produce json
+// @Security BearerAuth
 // @Param id path string true "User ID"
 // @Param user body service.UpdateUserRequest true "User update request"
 // @Success 200 {object} http_server.APIResponse{result=service.GetUserResponse}
 // @Failure 400 {object} http_server.APIResponse
+// @Failure 401 {object} http_server.APIResponse
 // @Failure 404 {object} http_server.APIResponse
 // @Failure 500 {object} http_server.APIResponse
 // @Router /v1/users/{id} [put]
@@ -153,13 +262,15 @@ func (h *UserHandler) UpdateUser(c echo.Context) error {
 
 // DeleteUser deletes a user by ID
 // @Summary Delete a user
-// @Description Delete a user by their unique identifier
+// @Description Delete a user by their unique identifier. The user is signed out everywhere and can be restored by an admin until the deletion grace period ends, after which their personal data is erased. Their blogs are kept.
 // @Tags users
 // @Accept json
 // @Produce json
+// @Security BearerAuth
 // @Param id path string true "User ID"
 // @Success 200 {object} http_server.APIResponse
 // @Failure 400 {object} http_server.APIResponse
+// @Failure 401 {object} http_server.APIResponse
 // @Failure 404 {object} http_server.APIResponse
 // @Failure 500 {object} http_server.APIResponse
 // @Router /v1/users/{id} [delete]
@@ -181,15 +292,60 @@ func (h *UserHandler) DeleteUser(c echo.Context) error {
 	return http_server.SuccessResponse(c, "User deleted successfully", nil)
 }
 
+// ExportUser returns an archive of a user's data
+// @Summary Export user data
+// @Description Download the user's profile, blogs, signed-in devices and personal access tokens as one JSON document
+// @Tags users
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "User ID"
+// @Success 200 {object} http_server.APIResponse{result=service.ExportUserResponse}
+// @Failure 400 {object} http_server.APIResponse
+// @Failure 401 {object} http_server.APIResponse
+// @Failure 403 {object} http_server.APIResponse
+// @Failure 404 {object} http_server.APIResponse
+// @Failure 500 {object} http_server.APIResponse
+// @Router /v1/users/{id}/export [get]
+func (h *UserHandler) ExportUser(c echo.Context) error {
+	idParam := c.Param("id")
+	id, err := uuid.Parse(idParam)
+	if err != nil {
+		h.log.Warn("Invalid user ID parameter",
+			slog.String("id", idParam),
+		)
+		return http_server.BadRequestResponse(c, "Invalid user UUID format", err)
+	}
+
+	export, err := h.userService.ExportUser(c.Request().Context(), id)
+	if err != nil {
+		return h.translateServiceError(c, err, "Failed to export user data")
+	}
+
+	// Browsers save the archive instead of displaying it
+	c.Response().Header().Set(echo.HeaderContentDisposition, `attachment; filename="user-`+id.String()+`.json"`)
+	return http_server.SuccessResponse(c, "User data exported successfully", export)
+}
+
 // ListUsers retrieves a list of users with pagination
 // @Summary List users
-// @Description Retrieve a paginated list of users
+// @Description Retrieve a paginated list of users, optionally searched by name or email prefix, filtered by creation time and sorted. Requires the users:manage permission; personal access tokens also need the users:read scope.
 // @Tags users
 // @Accept json
 // @Produce json
+// @Security BearerAuth
 // @Param page query int false "Page number" default(1)
 // @Param page_size query int false "Number of items per page" default(10)
+// @Param search query string false "Prefix of the name or email"
+// @Param created_from query string false "Only users created at or after this RFC 3339 time"
+// @Param created_to query string false "Only users created before this RFC 3339 time"
+// @Param sort_by query string false "Column to sort by" Enums(name, email, created_at, updated_at) default(created_at)
+// @Param sort_order query string false "Sort direction" Enums(asc, desc) default(desc)
 // @Success 200 {object} http_server.ListAPIResponse{result=[]service.GetUserResponse}
+// @Failure 400 {object} http_server.APIResponse
+// @Failure 401 {object} http_server.APIResponse
+// @Failure 403 {object} http_server.APIResponse
+// @Failure 422 {object} http_server.APIResponse
 // @Failure 500 {object} http_server.APIResponse
 // @Router /v1/users [get]
 func (h *UserHandler) ListUsers(c echo.Context) error {
@@ -210,13 +366,29 @@ func (h *UserHandler) ListUsers(c echo.Context) error {
 		}
 	}
 
-	paginationReq := http_server.PaginationRequest{
-		Page:     page,
-		PageSize: pageSize,
+	req := service.ListUsersRequest{
+		PaginationRequest: http_server.PaginationRequest{
+			Page:     page,
+			PageSize: pageSize,
+		},
+		Search:    c.QueryParam("search"),
+		SortBy:    c.QueryParam("sort_by"),
+		SortOrder: c.QueryParam("sort_order"),
 	}
-	users, totalCount, err := h.userService.ListUsers(c.Request().Context(), service.ListUsersRequest{
-		PaginationRequest: paginationReq,
-	})
+
+	var err error
+	if req.CreatedFrom, err = parseTimeQueryParam(c, "created_from"); err != nil {
+		return http_server.BadRequestResponse(c, "Invalid created_from, expected an RFC 3339 time", err)
+	}
+	if req.CreatedTo, err = parseTimeQueryParam(c, "created_to"); err != nil {
+		return http_server.BadRequestResponse(c, "Invalid created_to, expected an RFC 3339 time", err)
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return http_server.HandleValidationError(c, err)
+	}
+
+	users, totalCount, err := h.userService.ListUsers(c.Request().Context(), req)
 	if err != nil {
 		return h.translateServiceError(c, err, "Failed to list users")
 	}
@@ -227,6 +399,20 @@ func (h *UserHandler) ListUsers(c echo.Context) error {
 	return http_server.ListSuccessResponse(c, "Users retrieved successfully", users, pagination)
 }
 
+// parseTimeQueryParam parses an optional RFC 3339 query parameter, returning nil when it is absent
+func parseTimeQueryParam(c echo.Context, name string) (*time.Time, error) {
+	value := c.QueryParam(name)
+	if value == "" {
+		return nil, nil
+	}
+
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
+
 func (h *UserHandler) HealthCheck(c echo.Context) error {
 	return c.JSON(http.StatusOK, map[string]any{
 		"status":  "ok",
@@ -238,17 +424,28 @@ func (h *UserHandler) HealthCheck(c echo.Context) error {
 func (h *UserHandler) SetupRoutes(server *http_server.Server) {
 	// v1 routes
 	h.setupV1Routes(server)
+	h.setupAuthRoutes(server)
+	h.setupAdminRoutes(server)
+	h.setupTokenRoutes(server)
+	h.setupTwoFactorRoutes(server)
+	h.setupOIDCRoutes(server)
+	h.setupAuthorRoutes(server)
+	h.setupPreferencesRoutes(server)
 
 	// v2 routes with enhanced features
 	h.setupV2Routes(server)
 }
 
-// setupV1Routes configures v1 API routes for users
+// setupV1Routes configures v1 API routes for users. Changing, deleting or exporting an
+// account and changing its password are not allowed while impersonating its user.
 func (h *UserHandler) setupV1Routes(server *http_server.Server) {
 	users := server.Echo().Group("/v1/users")
 	users.POST("", h.CreateUser)
-	users.GET("", h.ListUsers)
-	users.GET("/:id", h.GetUser)
-	users.PUT("/:id", h.UpdateUser)
-	users.DELETE("/:id", h.DeleteUser)
+	users.GET("", h.ListUsers, http_server.RequireScope(rbac.ScopeUsersRead), rbac.RequirePermission(rbac.PermissionUsersManage))
+	users.GET("/:id", h.GetUser, http_server.RequireScope(rbac.ScopeUsersRead))
+	users.PUT("/:id", h.UpdateUser, http_server.RequireAuth(), http_server.ForbidImpersonation())
+	users.DELETE("/:id", h.DeleteUser, http_server.RequireAuth(), http_server.ForbidImpersonation())
+	users.GET("/:id/export", h.ExportUser, http_server.RequireAuth(), http_server.ForbidImpersonation())
+	users.GET("/:id/sessions", h.ListSessions, http_server.RequireAuth())
+	users.PUT("/:id/password", h.ChangePassword, http_server.RequireAuth(), http_server.ForbidImpersonation())
 }
@@ -0,0 +1,199 @@
+package handler
+
+import (
+	"log/slog"
+
+	"github.com/fikryfahrezy/let-it-go/feature/user/service"
+	"github.com/fikryfahrezy/let-it-go/pkg/http_server"
+	"github.com/fikryfahrezy/let-it-go/pkg/rbac"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+// setupAdminRoutes configures v1 user administration routes, restricted to callers allowed to manage users.
+// None of them can be used with an impersonation token.
+func (h *UserHandler) setupAdminRoutes(server *http_server.Server) {
+	admin := server.Echo().Group("/v1/admin", rbac.RequirePermission(rbac.PermissionUsersManage), http_server.ForbidImpersonation())
+	admin.GET("/roles", h.ListRoles)
+	admin.PUT("/users/:id/role", h.AssignRole)
+	admin.POST("/users/:id/unlock", h.UnlockUser)
+	admin.POST("/users/:id/restore", h.RestoreUser)
+	admin.POST("/users/:id/impersonate", h.StartImpersonation)
+}
+
+// ListRoles lists the roles and their permissions
+// @Summary List roles
+// @Description List every role and the permissions it grants
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} http_server.APIResponse{result=[]service.RoleResponse}
+// @Failure 401 {object} http_server.APIResponse
+// @Failure 403 {object} http_server.APIResponse
+// @Router /v1/admin/roles [get]
+func (h *UserHandler) ListRoles(c echo.Context) error {
+	roles := h.userService.ListRoles(c.Request().Context())
+	return http_server.SuccessResponse(c, "Roles retrieved successfully", roles)
+}
+
+// AssignRole changes the role of a user
+// @Summary Assign a role
+// @Description Change the role of a user. The new role applies from the user's next login or token refresh. Admins cannot change their own role.
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "User ID"
+// @Param request body service.AssignRoleRequest true "New role"
+// @Success 200 {object} http_server.APIResponse{result=service.GetUserResponse}
+// @Failure 400 {object} http_server.APIResponse
+// @Failure 401 {object} http_server.APIResponse
+// @Failure 403 {object} http_server.APIResponse
+// @Failure 404 {object} http_server.APIResponse
+// @Failure 422 {object} http_server.APIResponse
+// @Failure 500 {object} http_server.APIResponse
+// @Router /v1/admin/users/{id}/role [put]
+func (h *UserHandler) AssignRole(c echo.Context) error {
+	idParam := c.Param("id")
+	id, err := uuid.Parse(idParam)
+	if err != nil {
+		h.log.Warn("Invalid user ID parameter",
+			slog.String("id", idParam),
+		)
+		return http_server.BadRequestResponse(c, "Invalid user UUID format", err)
+	}
+
+	var req service.AssignRoleRequest
+	if err := c.Bind(&req); err != nil {
+		h.log.Error("Failed to bind request",
+			slog.String("error", err.Error()),
+		)
+		return http_server.BadRequestResponse(c, "Invalid request format", err)
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return http_server.HandleValidationError(c, err)
+	}
+
+	user, err := h.userService.AssignRole(c.Request().Context(), id, req)
+	if err != nil {
+		return h.translateServiceError(c, err, "Failed to assign role")
+	}
+
+	return http_server.SuccessResponse(c, "Role assigned successfully", user)
+}
+
+// UnlockUser lifts the lockout of a user's account
+// @Summary Unlock an account
+// @Description Lift the lockout caused by too many failed login attempts and forget the account's failed attempts. Lockouts of client IPs expire on their own.
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "User ID"
+// @Success 200 {object} http_server.APIResponse
+// @Failure 400 {object} http_server.APIResponse
+// @Failure 401 {object} http_server.APIResponse
+// @Failure 403 {object} http_server.APIResponse
+// @Failure 404 {object} http_server.APIResponse
+// @Failure 500 {object} http_server.APIResponse
+// @Router /v1/admin/users/{id}/unlock [post]
+func (h *UserHandler) UnlockUser(c echo.Context) error {
+	idParam := c.Param("id")
+	id, err := uuid.Parse(idParam)
+	if err != nil {
+		h.log.Warn("Invalid user ID parameter",
+			slog.String("id", idParam),
+		)
+		return http_server.BadRequestResponse(c, "Invalid user UUID format", err)
+	}
+
+	if err := h.userService.UnlockUser(c.Request().Context(), id); err != nil {
+		return h.translateServiceError(c, err, "Failed to unlock account")
+	}
+
+	return http_server.SuccessResponse(c, "Account unlocked successfully", nil)
+}
+
+// RestoreUser undoes the deletion of a user
+// @Summary Restore a deleted user
+// @Description Undo the deletion of a user within the deletion grace period. The user has to log in again on every device.
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "User ID"
+// @Success 200 {object} http_server.APIResponse
+// @Failure 400 {object} http_server.APIResponse
+// @Failure 401 {object} http_server.APIResponse
+// @Failure 403 {object} http_server.APIResponse
+// @Failure 404 {object} http_server.APIResponse
+// @Failure 500 {object} http_server.APIResponse
+// @Router /v1/admin/users/{id}/restore [post]
+func (h *UserHandler) RestoreUser(c echo.Context) error {
+	idParam := c.Param("id")
+	id, err := uuid.Parse(idParam)
+	if err != nil {
+		h.log.Warn("Invalid user ID parameter",
+			slog.String("id", idParam),
+		)
+		return http_server.BadRequestResponse(c, "Invalid user UUID format", err)
+	}
+
+	if err := h.userService.RestoreUser(c.Request().Context(), id); err != nil {
+		return h.translateServiceError(c, err, "Failed to restore user")
+	}
+
+	return http_server.SuccessResponse(c, "User restored successfully", nil)
+}
+
+// StartImpersonation issues a token to act as a user
+// @Summary Impersonate a user
+// @Description Issue a short-lived access token that lets the calling admin use the API exactly as the user would. The token carries both identities and cannot be refreshed. The session, its reason and every request made with the token are recorded. Deleting or changing the account, its password, two factor authentication or personal access tokens, exporting its data and admin routes are refused while impersonating. Users who can manage users cannot be impersonated.
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "User ID"
+// @Param request body service.StartImpersonationRequest true "Reason for the impersonation"
+// @Success 201 {object} http_server.APIResponse{result=service.ImpersonationResponse}
+// @Failure 400 {object} http_server.APIResponse
+// @Failure 401 {object} http_server.APIResponse
+// @Failure 403 {object} http_server.APIResponse
+// @Failure 404 {object} http_server.APIResponse
+// @Failure 422 {object} http_server.APIResponse
+// @Failure 500 {object} http_server.APIResponse
+// @Router /v1/admin/users/{id}/impersonate [post]
+func (h *UserHandler) StartImpersonation(c echo.Context) error {
+	idParam := c.Param("id")
+	id, err := uuid.Parse(idParam)
+	if err != nil {
+		h.log.Warn("Invalid user ID parameter",
+			slog.String("id", idParam),
+		)
+		return http_server.BadRequestResponse(c, "Invalid user UUID format", err)
+	}
+
+	var req service.StartImpersonationRequest
+	if err := c.Bind(&req); err != nil {
+		h.log.Error("Failed to bind request",
+			slog.String("error", err.Error()),
+		)
+		return http_server.BadRequestResponse(c, "Invalid request format", err)
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return http_server.HandleValidationError(c, err)
+	}
+
+	req.UserAgent = c.Request().UserAgent()
+	req.IPAddress = c.RealIP()
+
+	impersonation, err := h.userService.StartImpersonation(c.Request().Context(), id, req)
+	if err != nil {
+		return h.translateServiceError(c, err, "Failed to start impersonation")
+	}
+
+	return http_server.CreatedResponse(c, "Impersonation started successfully", impersonation)
+}
@@ -0,0 +1,371 @@
+package handler_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/fikryfahrezy/let-it-go/feature/user/handler"
+	"github.com/fikryfahrezy/let-it-go/feature/user/repository"
+	"github.com/fikryfahrezy/let-it-go/feature/user/service"
+	"github.com/fikryfahrezy/let-it-go/feature/user/service/servicefakes"
+	"github.com/fikryfahrezy/let-it-go/pkg/http_server"
+	"github.com/fikryfahrezy/let-it-go/pkg/logger"
+	"github.com/fikryfahrezy/let-it-go/pkg/rbac"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUserHandler_AssignRole_Success(t *testing.T) {
+	mockService := &servicefakes.FakeUserService{}
+	userID := uuid.New()
+	mockService.AssignRoleReturns(service.GetUserResponse{ID: userID, Role: rbac.RoleEditor}, nil)
+
+	userHandler := handler.NewUserHandler(logger.NewDiscardLogger(), mockService)
+	e := setupServer(t, userHandler, http_server.Principal{UserID: uuid.New(), Roles: []string{rbac.RoleAdmin}})
+
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, newJSONRequest(t, http.MethodPut, "/v1/admin/users/"+userID.String()+"/role", service.AssignRoleRequest{Role: rbac.RoleEditor}))
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	require.Equal(t, 1, mockService.AssignRoleCallCount())
+	_, actualID, actualReq := mockService.AssignRoleArgsForCall(0)
+	assert.Equal(t, userID, actualID)
+	assert.Equal(t, rbac.RoleEditor, actualReq.Role)
+}
+
+func TestUserHandler_AssignRole_NotAdmin(t *testing.T) {
+	mockService := &servicefakes.FakeUserService{}
+	userHandler := handler.NewUserHandler(logger.NewDiscardLogger(), mockService)
+	e := setupServer(t, userHandler, http_server.Principal{UserID: uuid.New(), Roles: []string{rbac.RoleEditor}})
+
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, newJSONRequest(t, http.MethodPut, "/v1/admin/users/"+uuid.New().String()+"/role", service.AssignRoleRequest{Role: rbac.RoleAdmin}))
+
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+	assert.Equal(t, 0, mockService.AssignRoleCallCount())
+
+	var response http_server.APIResponse
+	err := json.Unmarshal(rec.Body.Bytes(), &response)
+	require.NoError(t, err)
+	assert.Equal(t, http_server.ErrForbidden.Code, response.Error)
+}
+
+func TestUserHandler_AssignRole_TwoFactorSetupRequired(t *testing.T) {
+	mockService := &servicefakes.FakeUserService{}
+	userHandler := handler.NewUserHandler(logger.NewDiscardLogger(), mockService)
+	// An admin who has not enrolled the second factor their role requires
+	e := setupServer(t, userHandler, http_server.Principal{UserID: uuid.New(), Roles: []string{rbac.RoleAdmin}, TwoFactorSetupRequired: true})
+
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, newJSONRequest(t, http.MethodPut, "/v1/admin/users/"+uuid.New().String()+"/role", service.AssignRoleRequest{Role: rbac.RoleEditor}))
+
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+	assert.Equal(t, 0, mockService.AssignRoleCallCount())
+}
+
+func TestUserHandler_AssignRole_UnknownRole(t *testing.T) {
+	mockService := &servicefakes.FakeUserService{}
+	userHandler := handler.NewUserHandler(logger.NewDiscardLogger(), mockService)
+	e := setupServer(t, userHandler, http_server.Principal{UserID: uuid.New(), Roles: []string{rbac.RoleAdmin}})
+
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, newJSONRequest(t, http.MethodPut, "/v1/admin/users/"+uuid.New().String()+"/role", service.AssignRoleRequest{Role: "superuser"}))
+
+	assert.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+	assert.Equal(t, 0, mockService.AssignRoleCallCount())
+}
+
+func TestUserHandler_AssignRole_OwnRole(t *testing.T) {
+	mockService := &servicefakes.FakeUserService{}
+	mockService.AssignRoleReturns(service.GetUserResponse{}, service.ErrCannotChangeOwnRole)
+
+	userHandler := handler.NewUserHandler(logger.NewDiscardLogger(), mockService)
+	adminID := uuid.New()
+	e := setupServer(t, userHandler, http_server.Principal{UserID: adminID, Roles: []string{rbac.RoleAdmin}})
+
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, newJSONRequest(t, http.MethodPut, "/v1/admin/users/"+adminID.String()+"/role", service.AssignRoleRequest{Role: rbac.RoleReader}))
+
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+	var response http_server.APIResponse
+	err := json.Unmarshal(rec.Body.Bytes(), &response)
+	require.NoError(t, err)
+	assert.Equal(t, "USER-CANNOT_CHANGE_OWN_ROLE", response.Error)
+}
+
+func TestUserHandler_ListRoles_Success(t *testing.T) {
+	mockService := &servicefakes.FakeUserService{}
+	mockService.ListRolesReturns([]service.RoleResponse{
+		{Name: rbac.RoleAdmin, Permissions: rbac.Permissions(rbac.RoleAdmin)},
+	})
+
+	userHandler := handler.NewUserHandler(logger.NewDiscardLogger(), mockService)
+	e := setupServer(t, userHandler, http_server.Principal{UserID: uuid.New(), Roles: []string{rbac.RoleAdmin}})
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/admin/roles", nil)
+	req.Header.Set(echo.HeaderAuthorization, "Bearer valid-token")
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	var response http_server.APIResponse
+	err := json.Unmarshal(rec.Body.Bytes(), &response)
+	require.NoError(t, err)
+	result, ok := response.Result.([]any)
+	require.True(t, ok)
+	assert.Len(t, result, 1)
+}
+
+func TestUserHandler_ListRoles_Unauthenticated(t *testing.T) {
+	mockService := &servicefakes.FakeUserService{}
+	userHandler := handler.NewUserHandler(logger.NewDiscardLogger(), mockService)
+	e := setupServer(t, userHandler, http_server.Principal{UserID: uuid.New(), Roles: []string{rbac.RoleAdmin}})
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/admin/roles", nil)
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	assert.Equal(t, 0, mockService.ListRolesCallCount())
+}
+
+func TestUserHandler_UnlockUser_Success(t *testing.T) {
+	mockService := &servicefakes.FakeUserService{}
+	userHandler := handler.NewUserHandler(logger.NewDiscardLogger(), mockService)
+	e := setupServer(t, userHandler, http_server.Principal{UserID: uuid.New(), Roles: []string{rbac.RoleAdmin}})
+
+	userID := uuid.New()
+	req := httptest.NewRequest(http.MethodPost, "/v1/admin/users/"+userID.String()+"/unlock", nil)
+	req.Header.Set(echo.HeaderAuthorization, "Bearer valid-token")
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, 1, mockService.UnlockUserCallCount())
+	_, actualID := mockService.UnlockUserArgsForCall(0)
+	assert.Equal(t, userID, actualID)
+}
+
+func TestUserHandler_UnlockUser_NotAdmin(t *testing.T) {
+	mockService := &servicefakes.FakeUserService{}
+	userHandler := handler.NewUserHandler(logger.NewDiscardLogger(), mockService)
+	e := setupServer(t, userHandler, http_server.Principal{UserID: uuid.New(), Roles: []string{rbac.RoleEditor}})
+
+	req := httptest.NewRequest(http.MethodPost, "/v1/admin/users/"+uuid.NewString()+"/unlock", nil)
+	req.Header.Set(echo.HeaderAuthorization, "Bearer valid-token")
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+	assert.Equal(t, 0, mockService.UnlockUserCallCount())
+}
+
+func TestUserHandler_UnlockUser_NotFound(t *testing.T) {
+	mockService := &servicefakes.FakeUserService{}
+	mockService.UnlockUserReturns(repository.ErrUserNotFound)
+	userHandler := handler.NewUserHandler(logger.NewDiscardLogger(), mockService)
+	e := setupServer(t, userHandler, http_server.Principal{UserID: uuid.New(), Roles: []string{rbac.RoleAdmin}})
+
+	req := httptest.NewRequest(http.MethodPost, "/v1/admin/users/"+uuid.NewString()+"/unlock", nil)
+	req.Header.Set(echo.HeaderAuthorization, "Bearer valid-token")
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+func TestUserHandler_RestoreUser_Success(t *testing.T) {
+	mockService := &servicefakes.FakeUserService{}
+	userHandler := handler.NewUserHandler(logger.NewDiscardLogger(), mockService)
+	e := setupServer(t, userHandler, http_server.Principal{UserID: uuid.New(), Roles: []string{rbac.RoleAdmin}})
+
+	userID := uuid.New()
+	req := httptest.NewRequest(http.MethodPost, "/v1/admin/users/"+userID.String()+"/restore", nil)
+	req.Header.Set(echo.HeaderAuthorization, "Bearer valid-token")
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, 1, mockService.RestoreUserCallCount())
+	_, actualID := mockService.RestoreUserArgsForCall(0)
+	assert.Equal(t, userID, actualID)
+}
+
+func TestUserHandler_RestoreUser_NotAdmin(t *testing.T) {
+	mockService := &servicefakes.FakeUserService{}
+	userHandler := handler.NewUserHandler(logger.NewDiscardLogger(), mockService)
+	e := setupServer(t, userHandler, http_server.Principal{UserID: uuid.New(), Roles: []string{rbac.RoleAuthor}})
+
+	req := httptest.NewRequest(http.MethodPost, "/v1/admin/users/"+uuid.NewString()+"/restore", nil)
+	req.Header.Set(echo.HeaderAuthorization, "Bearer valid-token")
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+	assert.Equal(t, 0, mockService.RestoreUserCallCount())
+}
+
+func TestUserHandler_RestoreUser_GracePeriodOver(t *testing.T) {
+	mockService := &servicefakes.FakeUserService{}
+	mockService.RestoreUserReturns(repository.ErrDeletedUserNotFound)
+	userHandler := handler.NewUserHandler(logger.NewDiscardLogger(), mockService)
+	e := setupServer(t, userHandler, http_server.Principal{UserID: uuid.New(), Roles: []string{rbac.RoleAdmin}})
+
+	req := httptest.NewRequest(http.MethodPost, "/v1/admin/users/"+uuid.NewString()+"/restore", nil)
+	req.Header.Set(echo.HeaderAuthorization, "Bearer valid-token")
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+// impersonatingPrincipal is an admin acting as an author
+func impersonatingPrincipal() http_server.Principal {
+	return http_server.Principal{
+		UserID:          uuid.New(),
+		Roles:           []string{rbac.RoleAuthor},
+		ImpersonatorID:  uuid.New(),
+		ImpersonationID: uuid.New(),
+	}
+}
+
+func TestUserHandler_StartImpersonation_Success(t *testing.T) {
+	mockService := &servicefakes.FakeUserService{}
+	userID := uuid.New()
+	mockService.StartImpersonationReturns(service.ImpersonationResponse{
+		ImpersonationID: uuid.New(),
+		UserID:          userID,
+		AccessToken:     "impersonation-token",
+		TokenType:       service.TokenTypeBearer,
+	}, nil)
+
+	userHandler := handler.NewUserHandler(logger.NewDiscardLogger(), mockService)
+	e := setupServer(t, userHandler, http_server.Principal{UserID: uuid.New(), Roles: []string{rbac.RoleAdmin}})
+
+	req := newJSONRequest(t, http.MethodPost, "/v1/admin/users/"+userID.String()+"/impersonate", service.StartImpersonationRequest{Reason: "Ticket #42"})
+	req.Header.Set("User-Agent", "support-console")
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusCreated, rec.Code)
+
+	require.Equal(t, 1, mockService.StartImpersonationCallCount())
+	_, actualID, actualReq := mockService.StartImpersonationArgsForCall(0)
+	assert.Equal(t, userID, actualID)
+	assert.Equal(t, "Ticket #42", actualReq.Reason)
+	assert.Equal(t, "support-console", actualReq.UserAgent)
+}
+
+func TestUserHandler_StartImpersonation_MissingReason(t *testing.T) {
+	mockService := &servicefakes.FakeUserService{}
+	userHandler := handler.NewUserHandler(logger.NewDiscardLogger(), mockService)
+	e := setupServer(t, userHandler, http_server.Principal{UserID: uuid.New(), Roles: []string{rbac.RoleAdmin}})
+
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, newJSONRequest(t, http.MethodPost, "/v1/admin/users/"+uuid.New().String()+"/impersonate", service.StartImpersonationRequest{Reason: ""}))
+
+	assert.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+	assert.Equal(t, 0, mockService.StartImpersonationCallCount())
+}
+
+func TestUserHandler_StartImpersonation_CannotImpersonate(t *testing.T) {
+	mockService := &servicefakes.FakeUserService{}
+	mockService.StartImpersonationReturns(service.ImpersonationResponse{}, service.ErrCannotImpersonate)
+
+	userHandler := handler.NewUserHandler(logger.NewDiscardLogger(), mockService)
+	e := setupServer(t, userHandler, http_server.Principal{UserID: uuid.New(), Roles: []string{rbac.RoleAdmin}})
+
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, newJSONRequest(t, http.MethodPost, "/v1/admin/users/"+uuid.New().String()+"/impersonate", service.StartImpersonationRequest{Reason: "Ticket #42"}))
+
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+	var response http_server.APIResponse
+	err := json.Unmarshal(rec.Body.Bytes(), &response)
+	require.NoError(t, err)
+	assert.Equal(t, service.ErrCannotImpersonate.Code, response.Error)
+}
+
+func TestUserHandler_Impersonating_DangerousActionsForbidden(t *testing.T) {
+	principal := impersonatingPrincipal()
+	userID := principal.UserID.String()
+
+	tests := []struct {
+		name   string
+		method string
+		path   string
+		code   string
+	}{
+		{name: "delete user", method: http.MethodDelete, path: "/v1/users/" + userID, code: http_server.ErrImpersonationForbidden.Code},
+		{name: "delete user v2", method: http.MethodDelete, path: "/v2/users/" + userID, code: http_server.ErrImpersonationForbidden.Code},
+		{name: "change password", method: http.MethodPut, path: "/v1/users/" + userID + "/password", code: http_server.ErrImpersonationForbidden.Code},
+		{name: "update user", method: http.MethodPut, path: "/v1/users/" + userID, code: http_server.ErrImpersonationForbidden.Code},
+		{name: "export user", method: http.MethodGet, path: "/v1/users/" + userID + "/export", code: http_server.ErrImpersonationForbidden.Code},
+		{name: "disable two factor", method: http.MethodPost, path: "/v1/users/" + userID + "/2fa/disable", code: http_server.ErrImpersonationForbidden.Code},
+		{name: "create token", method: http.MethodPost, path: "/v1/users/" + userID + "/tokens", code: http_server.ErrImpersonationForbidden.Code},
+		// Impersonated users never manage users, so the admin routes refuse them before checking impersonation
+		{name: "impersonate again", method: http.MethodPost, path: "/v1/admin/users/" + uuid.NewString() + "/impersonate", code: http_server.ErrForbidden.Code},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := &servicefakes.FakeUserService{}
+			userHandler := handler.NewUserHandler(logger.NewDiscardLogger(), mockService)
+			e := setupServer(t, userHandler, principal)
+
+			req := httptest.NewRequest(tt.method, tt.path, bytes.NewBufferString("{}"))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			req.Header.Set(echo.HeaderAuthorization, "Bearer valid-token")
+			rec := httptest.NewRecorder()
+			e.ServeHTTP(rec, req)
+
+			assert.Equal(t, http.StatusForbidden, rec.Code)
+			assert.Equal(t, 0, mockService.DeleteUserCallCount())
+			assert.Equal(t, 0, mockService.ChangePasswordCallCount())
+
+			var response http_server.APIResponse
+			err := json.Unmarshal(rec.Body.Bytes(), &response)
+			require.NoError(t, err)
+			assert.Equal(t, tt.code, response.Error)
+		})
+	}
+}
+
+func TestUserHandler_Impersonating_RequestsAreRecorded(t *testing.T) {
+	mockService := &servicefakes.FakeUserService{}
+	principal := impersonatingPrincipal()
+	mockService.ListSessionsReturns([]service.SessionResponse{}, nil)
+
+	userHandler := handler.NewUserHandler(logger.NewDiscardLogger(), mockService)
+	srv := http_server.New(http_server.Config{})
+	srv.SetAuthenticator(http_server.AuthenticatorFunc(func(ctx context.Context, token string) (http_server.Principal, error) {
+		return principal, nil
+	}))
+	srv.SetImpersonationAuditor(mockService)
+	require.NoError(t, srv.Initialize([]http_server.RouteHandler{userHandler}))
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/users/"+principal.UserID.String()+"/sessions", nil)
+	req.Header.Set(echo.HeaderAuthorization, "Bearer impersonation-token")
+	rec := httptest.NewRecorder()
+	srv.Echo().ServeHTTP(rec, req)
+
+	// Impersonated reads work as they would for the user
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, 1, mockService.ListSessionsCallCount())
+
+	require.Equal(t, 1, mockService.RecordImpersonatedRequestCallCount())
+	_, actualPrincipal, actualRequest := mockService.RecordImpersonatedRequestArgsForCall(0)
+	assert.Equal(t, principal.ImpersonationID, actualPrincipal.ImpersonationID)
+	assert.Equal(t, http.MethodGet, actualRequest.Method)
+	assert.Equal(t, "/v1/users/"+principal.UserID.String()+"/sessions", actualRequest.Path)
+	assert.Equal(t, http.StatusOK, actualRequest.Status)
+}
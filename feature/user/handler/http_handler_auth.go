@@ -0,0 +1,310 @@
+package handler
+
+import (
+	"log/slog"
+
+	"github.com/fikryfahrezy/let-it-go/feature/user/service"
+	"github.com/fikryfahrezy/let-it-go/pkg/http_server"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+// setupAuthRoutes configures v1 authentication routes
+func (h *UserHandler) setupAuthRoutes(server *http_server.Server) {
+	auth := server.Echo().Group("/v1/auth")
+	auth.POST("/login", h.Login)
+	auth.POST("/2fa/verify", h.VerifyTwoFactor)
+	auth.POST("/refresh", h.RefreshToken)
+	auth.POST("/logout", h.Logout)
+	auth.POST("/password/forgot", h.ForgotPassword)
+	auth.POST("/password/reset", h.ResetPassword)
+	auth.GET("/verify", h.VerifyEmail)
+}
+
+// Login authenticates a user and issues an access token
+// @Summary Log in
+// @Description Authenticate with email and password and receive a signed access token and a refresh token. Too many failed attempts lock the account or the client IP out for a while. Users with two factor authentication enabled receive a service.TwoFactorChallengeResponse instead of tokens and finish logging in with POST /v1/auth/2fa/verify.
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Param credentials body service.AuthenticateRequest true "Login credentials"
+// @Success 200 {object} http_server.APIResponse{result=service.AuthenticateResponse}
+// @Failure 400 {object} http_server.APIResponse
+// @Failure 401 {object} http_server.APIResponse
+// @Failure 422 {object} http_server.APIResponse
+// @Failure 429 {object} http_server.APIResponse
+// @Failure 500 {object} http_server.APIResponse
+// @Router /v1/auth/login [post]
+func (h *UserHandler) Login(c echo.Context) error {
+	var req service.AuthenticateRequest
+	if err := c.Bind(&req); err != nil {
+		h.log.Error("Failed to bind request",
+			slog.String("error", err.Error()),
+		)
+		return http_server.BadRequestResponse(c, "Invalid request format", err)
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return http_server.HandleValidationError(c, err)
+	}
+
+	req.UserAgent = c.Request().UserAgent()
+	req.IPAddress = c.RealIP()
+
+	result, err := h.userService.Authenticate(c.Request().Context(), req)
+	if err != nil {
+		return h.translateServiceError(c, err, "Failed to log in")
+	}
+
+	if result.TwoFactorChallenge != nil {
+		return http_server.SuccessResponse(c, "Two factor verification required", result.TwoFactorChallenge)
+	}
+
+	return http_server.SuccessResponse(c, "Logged in successfully", result)
+}
+
+// RefreshToken exchanges a refresh token for a new token pair
+// @Summary Refresh tokens
+// @Description Exchange a refresh token for a new access token and refresh token. Every refresh token can be used once; reusing one revokes the whole session.
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Param request body service.RefreshTokenRequest true "Refresh token"
+// @Success 200 {object} http_server.APIResponse{result=service.AuthenticateResponse}
+// @Failure 400 {object} http_server.APIResponse
+// @Failure 401 {object} http_server.APIResponse
+// @Failure 422 {object} http_server.APIResponse
+// @Failure 500 {object} http_server.APIResponse
+// @Router /v1/auth/refresh [post]
+func (h *UserHandler) RefreshToken(c echo.Context) error {
+	var req service.RefreshTokenRequest
+	if err := c.Bind(&req); err != nil {
+		h.log.Error("Failed to bind request",
+			slog.String("error", err.Error()),
+		)
+		return http_server.BadRequestResponse(c, "Invalid request format", err)
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return http_server.HandleValidationError(c, err)
+	}
+
+	req.UserAgent = c.Request().UserAgent()
+	req.IPAddress = c.RealIP()
+
+	result, err := h.userService.RefreshToken(c.Request().Context(), req)
+	if err != nil {
+		return h.translateServiceError(c, err, "Failed to refresh token")
+	}
+
+	return http_server.SuccessResponse(c, "Token refreshed successfully", result)
+}
+
+// Logout revokes the session of a refresh token
+// @Summary Log out
+// @Description Revoke the session the refresh token belongs to
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Param request body service.LogoutRequest true "Refresh token"
+// @Success 200 {object} http_server.APIResponse
+// @Failure 400 {object} http_server.APIResponse
+// @Failure 401 {object} http_server.APIResponse
+// @Failure 422 {object} http_server.APIResponse
+// @Failure 500 {object} http_server.APIResponse
+// @Router /v1/auth/logout [post]
+func (h *UserHandler) Logout(c echo.Context) error {
+	var req service.LogoutRequest
+	if err := c.Bind(&req); err != nil {
+		h.log.Error("Failed to bind request",
+			slog.String("error", err.Error()),
+		)
+		return http_server.BadRequestResponse(c, "Invalid request format", err)
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return http_server.HandleValidationError(c, err)
+	}
+
+	if err := h.userService.Logout(c.Request().Context(), req); err != nil {
+		return h.translateServiceError(c, err, "Failed to log out")
+	}
+
+	return http_server.SuccessResponse(c, "Logged out successfully", nil)
+}
+
+// ListSessions lists the active sessions of a user
+// @Summary List user sessions
+// @Description List the devices the user is signed in on. Users can only list their own sessions.
+// @Tags users
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "User ID"
+// @Success 200 {object} http_server.APIResponse{result=[]service.SessionResponse}
+// @Failure 400 {object} http_server.APIResponse
+// @Failure 401 {object} http_server.APIResponse
+// @Failure 403 {object} http_server.APIResponse
+// @Failure 500 {object} http_server.APIResponse
+// @Router /v1/users/{id}/sessions [get]
+func (h *UserHandler) ListSessions(c echo.Context) error {
+	idParam := c.Param("id")
+	id, err := uuid.Parse(idParam)
+	if err != nil {
+		h.log.Warn("Invalid user ID parameter",
+			slog.String("id", idParam),
+		)
+		return http_server.BadRequestResponse(c, "Invalid user UUID format", err)
+	}
+
+	sessions, err := h.userService.ListSessions(c.Request().Context(), id)
+	if err != nil {
+		return h.translateServiceError(c, err, "Failed to list sessions")
+	}
+
+	return http_server.SuccessResponse(c, "Sessions retrieved successfully", sessions)
+}
+
+// ChangePassword changes the password of a user
+// @Summary Change password
+// @Description Change the password after confirming the current one. Every other session of the user is signed out. Users can only change their own password.
+// @Tags users
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "User ID"
+// @Param request body service.ChangePasswordRequest true "Current and new password"
+// @Success 200 {object} http_server.APIResponse
+// @Failure 400 {object} http_server.APIResponse
+// @Failure 401 {object} http_server.APIResponse
+// @Failure 403 {object} http_server.APIResponse
+// @Failure 422 {object} http_server.APIResponse
+// @Failure 429 {object} http_server.APIResponse
+// @Failure 500 {object} http_server.APIResponse
+// @Router /v1/users/{id}/password [put]
+func (h *UserHandler) ChangePassword(c echo.Context) error {
+	idParam := c.Param("id")
+	id, err := uuid.Parse(idParam)
+	if err != nil {
+		h.log.Warn("Invalid user ID parameter",
+			slog.String("id", idParam),
+		)
+		return http_server.BadRequestResponse(c, "Invalid user UUID format", err)
+	}
+
+	var req service.ChangePasswordRequest
+	if err := c.Bind(&req); err != nil {
+		h.log.Error("Failed to bind request",
+			slog.String("error", err.Error()),
+		)
+		return http_server.BadRequestResponse(c, "Invalid request format", err)
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return http_server.HandleValidationError(c, err)
+	}
+
+	req.IPAddress = c.RealIP()
+
+	if err := h.userService.ChangePassword(c.Request().Context(), id, req); err != nil {
+		return h.translateServiceError(c, err, "Failed to change password")
+	}
+
+	return http_server.SuccessResponse(c, "Password changed successfully", nil)
+}
+
+// ForgotPassword emails a password reset link
+// @Summary Request a password reset
+// @Description Email a single-use password reset link. The response is the same whether or not the email belongs to an account.
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Param request body service.ForgotPasswordRequest true "Account email"
+// @Success 200 {object} http_server.APIResponse
+// @Failure 400 {object} http_server.APIResponse
+// @Failure 422 {object} http_server.APIResponse
+// @Failure 500 {object} http_server.APIResponse
+// @Router /v1/auth/password/forgot [post]
+func (h *UserHandler) ForgotPassword(c echo.Context) error {
+	var req service.ForgotPasswordRequest
+	if err := c.Bind(&req); err != nil {
+		h.log.Error("Failed to bind request",
+			slog.String("error", err.Error()),
+		)
+		return http_server.BadRequestResponse(c, "Invalid request format", err)
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return http_server.HandleValidationError(c, err)
+	}
+
+	if err := h.userService.ForgotPassword(c.Request().Context(), req); err != nil {
+		return h.translateServiceError(c, err, "Failed to request password reset")
+	}
+
+	return http_server.SuccessResponse(c, "If the email belongs to an account, a password reset link has been sent", nil)
+}
+
+// ResetPassword sets a new password with a reset token
+// @Summary Reset password
+// @Description Set a new password using the token from the password reset email. The token works once and all sessions are signed out.
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Param request body service.ResetPasswordRequest true "Reset token and new password"
+// @Success 200 {object} http_server.APIResponse
+// @Failure 400 {object} http_server.APIResponse
+// @Failure 422 {object} http_server.APIResponse
+// @Failure 500 {object} http_server.APIResponse
+// @Router /v1/auth/password/reset [post]
+func (h *UserHandler) ResetPassword(c echo.Context) error {
+	var req service.ResetPasswordRequest
+	if err := c.Bind(&req); err != nil {
+		h.log.Error("Failed to bind request",
+			slog.String("error", err.Error()),
+		)
+		return http_server.BadRequestResponse(c, "Invalid request format", err)
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return http_server.HandleValidationError(c, err)
+	}
+
+	if err := h.userService.ResetPassword(c.Request().Context(), req); err != nil {
+		return h.translateServiceError(c, err, "Failed to reset password")
+	}
+
+	return http_server.SuccessResponse(c, "Password reset successfully", nil)
+}
+
+// VerifyEmail confirms a user's email address
+// @Summary Verify email
+// @Description Confirm the email address with the token from the verification email sent on signup
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Param token query string true "Email verification token"
+// @Success 200 {object} http_server.APIResponse
+// @Failure 400 {object} http_server.APIResponse
+// @Failure 422 {object} http_server.APIResponse
+// @Failure 500 {object} http_server.APIResponse
+// @Router /v1/auth/verify [get]
+func (h *UserHandler) VerifyEmail(c echo.Context) error {
+	var req service.VerifyEmailRequest
+	if err := c.Bind(&req); err != nil {
+		h.log.Error("Failed to bind request",
+			slog.String("error", err.Error()),
+		)
+		return http_server.BadRequestResponse(c, "Invalid request format", err)
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return http_server.HandleValidationError(c, err)
+	}
+
+	if err := h.userService.VerifyEmail(c.Request().Context(), req); err != nil {
+		return h.translateServiceError(c, err, "Failed to verify email")
+	}
+
+	return http_server.SuccessResponse(c, "Email verified successfully", nil)
+}
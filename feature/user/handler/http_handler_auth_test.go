@@ -0,0 +1,619 @@
+package handler_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/fikryfahrezy/let-it-go/feature/user/handler"
+	"github.com/fikryfahrezy/let-it-go/feature/user/service"
+	"github.com/fikryfahrezy/let-it-go/feature/user/service/servicefakes"
+	"github.com/fikryfahrezy/let-it-go/pkg/app_error"
+	"github.com/fikryfahrezy/let-it-go/pkg/http_server"
+	"github.com/fikryfahrezy/let-it-go/pkg/logger"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUserHandler_Login_Success(t *testing.T) {
+	mockService := &servicefakes.FakeUserService{}
+	expectedResponse := service.AuthenticateResponse{
+		AccessToken: "signed.jwt.token",
+		TokenType:   service.TokenTypeBearer,
+		ExpiresAt:   time.Now().Add(15 * time.Minute),
+	}
+	mockService.AuthenticateReturns(expectedResponse, nil)
+
+	userHandler := handler.NewUserHandler(logger.NewDiscardLogger(), mockService)
+	e := setupEcho()
+
+	requestBody := service.AuthenticateRequest{
+		Email:    "john@example.com",
+		Password: "password123",
+	}
+	body, err := json.Marshal(requestBody)
+	require.NoError(t, err)
+
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/auth/login", bytes.NewBuffer(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	req.Header.Set("User-Agent", "test-agent")
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	err = userHandler.Login(c)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	var response http_server.APIResponse
+	err = json.Unmarshal(rec.Body.Bytes(), &response)
+	require.NoError(t, err)
+	result, ok := response.Result.(map[string]any)
+	require.True(t, ok)
+	assert.Equal(t, expectedResponse.AccessToken, result["access_token"])
+	assert.Equal(t, service.TokenTypeBearer, result["token_type"])
+
+	// Verify service was called with the submitted credentials
+	assert.Equal(t, 1, mockService.AuthenticateCallCount())
+	_, actualReq := mockService.AuthenticateArgsForCall(0)
+	assert.Equal(t, requestBody.Email, actualReq.Email)
+	assert.Equal(t, requestBody.Password, actualReq.Password)
+	assert.Equal(t, "test-agent", actualReq.UserAgent)
+	assert.NotEmpty(t, actualReq.IPAddress)
+}
+
+func TestUserHandler_Login_ValidationError(t *testing.T) {
+	mockService := &servicefakes.FakeUserService{}
+	userHandler := handler.NewUserHandler(logger.NewDiscardLogger(), mockService)
+	e := setupEcho()
+
+	// Missing password
+	requestBody := service.AuthenticateRequest{
+		Email: "john@example.com",
+	}
+	body, err := json.Marshal(requestBody)
+	require.NoError(t, err)
+
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/auth/login", bytes.NewBuffer(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	err = userHandler.Login(c)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+
+	// Service should not be called on validation error
+	assert.Equal(t, 0, mockService.AuthenticateCallCount())
+}
+
+func TestUserHandler_Login_InvalidCredentials(t *testing.T) {
+	mockService := &servicefakes.FakeUserService{}
+	mockService.AuthenticateReturns(service.AuthenticateResponse{}, service.ErrInvalidCredentials)
+
+	userHandler := handler.NewUserHandler(logger.NewDiscardLogger(), mockService)
+	e := setupEcho()
+
+	requestBody := service.AuthenticateRequest{
+		Email:    "john@example.com",
+		Password: "wrong-password",
+	}
+	body, err := json.Marshal(requestBody)
+	require.NoError(t, err)
+
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/auth/login", bytes.NewBuffer(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	err = userHandler.Login(c)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+
+	var response http_server.APIResponse
+	err = json.Unmarshal(rec.Body.Bytes(), &response)
+	require.NoError(t, err)
+	assert.Equal(t, "USER-INVALID_CREDENTIALS", response.Error)
+
+	// Verify service was called
+	assert.Equal(t, 1, mockService.AuthenticateCallCount())
+}
+
+func TestUserHandler_Login_AccountLocked(t *testing.T) {
+	mockService := &servicefakes.FakeUserService{}
+	mockService.AuthenticateReturns(service.AuthenticateResponse{}, app_error.New(service.ErrAccountLocked.Code, "too many failed attempts, try again in 2m0s"))
+
+	userHandler := handler.NewUserHandler(logger.NewDiscardLogger(), mockService)
+	e := setupEcho()
+
+	body, err := json.Marshal(service.AuthenticateRequest{
+		Email:    "john@example.com",
+		Password: "password123",
+	})
+	require.NoError(t, err)
+
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/auth/login", bytes.NewBuffer(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	req.Header.Set(echo.HeaderXRealIP, "10.0.0.1")
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	err = userHandler.Login(c)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusTooManyRequests, rec.Code)
+
+	var response http_server.APIResponse
+	err = json.Unmarshal(rec.Body.Bytes(), &response)
+	require.NoError(t, err)
+	assert.Equal(t, "USER-ACCOUNT_LOCKED", response.Error)
+	assert.Equal(t, "too many failed attempts, try again in 2m0s", response.Message)
+
+	// The client IP is passed on for per-IP tracking
+	_, actualReq := mockService.AuthenticateArgsForCall(0)
+	assert.Equal(t, "10.0.0.1", actualReq.IPAddress)
+}
+
+func TestUserHandler_RefreshToken_Success(t *testing.T) {
+	mockService := &servicefakes.FakeUserService{}
+	expectedResponse := service.AuthenticateResponse{
+		AccessToken:  "signed.jwt.token",
+		TokenType:    service.TokenTypeBearer,
+		ExpiresAt:    time.Now().Add(15 * time.Minute),
+		RefreshToken: "new-refresh-token",
+	}
+	mockService.RefreshTokenReturns(expectedResponse, nil)
+
+	userHandler := handler.NewUserHandler(logger.NewDiscardLogger(), mockService)
+	e := setupEcho()
+
+	body, err := json.Marshal(service.RefreshTokenRequest{RefreshToken: "old-refresh-token"})
+	require.NoError(t, err)
+
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/auth/refresh", bytes.NewBuffer(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	err = userHandler.RefreshToken(c)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	var response http_server.APIResponse
+	err = json.Unmarshal(rec.Body.Bytes(), &response)
+	require.NoError(t, err)
+	result, ok := response.Result.(map[string]any)
+	require.True(t, ok)
+	assert.Equal(t, "new-refresh-token", result["refresh_token"])
+
+	// Verify service was called with the submitted refresh token
+	assert.Equal(t, 1, mockService.RefreshTokenCallCount())
+	_, actualReq := mockService.RefreshTokenArgsForCall(0)
+	assert.Equal(t, "old-refresh-token", actualReq.RefreshToken)
+}
+
+func TestUserHandler_RefreshToken_Reused(t *testing.T) {
+	mockService := &servicefakes.FakeUserService{}
+	mockService.RefreshTokenReturns(service.AuthenticateResponse{}, service.ErrRefreshTokenReused)
+
+	userHandler := handler.NewUserHandler(logger.NewDiscardLogger(), mockService)
+	e := setupEcho()
+
+	body, err := json.Marshal(service.RefreshTokenRequest{RefreshToken: "old-refresh-token"})
+	require.NoError(t, err)
+
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/auth/refresh", bytes.NewBuffer(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	err = userHandler.RefreshToken(c)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+
+	var response http_server.APIResponse
+	err = json.Unmarshal(rec.Body.Bytes(), &response)
+	require.NoError(t, err)
+	assert.Equal(t, "USER-REFRESH_TOKEN_REUSED", response.Error)
+}
+
+func TestUserHandler_RefreshToken_ValidationError(t *testing.T) {
+	mockService := &servicefakes.FakeUserService{}
+	userHandler := handler.NewUserHandler(logger.NewDiscardLogger(), mockService)
+	e := setupEcho()
+
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/auth/refresh", bytes.NewBufferString("{}"))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	err := userHandler.RefreshToken(c)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+
+	// Service should not be called on validation error
+	assert.Equal(t, 0, mockService.RefreshTokenCallCount())
+}
+
+func TestUserHandler_Logout_Success(t *testing.T) {
+	mockService := &servicefakes.FakeUserService{}
+	mockService.LogoutReturns(nil)
+
+	userHandler := handler.NewUserHandler(logger.NewDiscardLogger(), mockService)
+	e := setupEcho()
+
+	body, err := json.Marshal(service.LogoutRequest{RefreshToken: "refresh-token"})
+	require.NoError(t, err)
+
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/auth/logout", bytes.NewBuffer(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	err = userHandler.Logout(c)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	// Verify service was called with the submitted refresh token
+	assert.Equal(t, 1, mockService.LogoutCallCount())
+	_, actualReq := mockService.LogoutArgsForCall(0)
+	assert.Equal(t, "refresh-token", actualReq.RefreshToken)
+}
+
+func TestUserHandler_Logout_InvalidToken(t *testing.T) {
+	mockService := &servicefakes.FakeUserService{}
+	mockService.LogoutReturns(service.ErrInvalidRefreshToken)
+
+	userHandler := handler.NewUserHandler(logger.NewDiscardLogger(), mockService)
+	e := setupEcho()
+
+	body, err := json.Marshal(service.LogoutRequest{RefreshToken: "unknown"})
+	require.NoError(t, err)
+
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/auth/logout", bytes.NewBuffer(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	err = userHandler.Logout(c)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+}
+
+func TestUserHandler_ListSessions_Success(t *testing.T) {
+	mockService := &servicefakes.FakeUserService{}
+	userID := uuid.New()
+	mockService.ListSessionsReturns([]service.SessionResponse{
+		{ID: uuid.New(), UserAgent: "laptop", Current: true},
+	}, nil)
+
+	userHandler := handler.NewUserHandler(logger.NewDiscardLogger(), mockService)
+	e := setupServer(t, userHandler, http_server.Principal{UserID: userID})
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/users/"+userID.String()+"/sessions", nil)
+	req.Header.Set(echo.HeaderAuthorization, "Bearer valid-token")
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	var response http_server.APIResponse
+	err := json.Unmarshal(rec.Body.Bytes(), &response)
+	require.NoError(t, err)
+	result, ok := response.Result.([]any)
+	require.True(t, ok)
+	assert.Len(t, result, 1)
+
+	// Verify the principal reaches the service
+	assert.Equal(t, 1, mockService.ListSessionsCallCount())
+	ctx, actualID := mockService.ListSessionsArgsForCall(0)
+	assert.Equal(t, userID, actualID)
+	principal, ok := http_server.PrincipalFromContext(ctx)
+	require.True(t, ok)
+	assert.Equal(t, userID, principal.UserID)
+}
+
+func TestUserHandler_ListSessions_Forbidden(t *testing.T) {
+	mockService := &servicefakes.FakeUserService{}
+	mockService.ListSessionsReturns(nil, service.ErrUserForbidden)
+
+	userHandler := handler.NewUserHandler(logger.NewDiscardLogger(), mockService)
+	e := setupServer(t, userHandler, http_server.Principal{UserID: uuid.New()})
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/users/"+uuid.NewString()+"/sessions", nil)
+	req.Header.Set(echo.HeaderAuthorization, "Bearer valid-token")
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+
+	var response http_server.APIResponse
+	err := json.Unmarshal(rec.Body.Bytes(), &response)
+	require.NoError(t, err)
+	assert.Equal(t, "USER-FORBIDDEN", response.Error)
+}
+
+func TestUserHandler_ListSessions_Unauthenticated(t *testing.T) {
+	mockService := &servicefakes.FakeUserService{}
+	userHandler := handler.NewUserHandler(logger.NewDiscardLogger(), mockService)
+	e := setupServer(t, userHandler, http_server.Principal{UserID: uuid.New()})
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/users/"+uuid.NewString()+"/sessions", nil)
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	assert.Equal(t, 0, mockService.ListSessionsCallCount())
+}
+
+func TestUserHandler_ChangePassword_Success(t *testing.T) {
+	mockService := &servicefakes.FakeUserService{}
+	mockService.ChangePasswordReturns(nil)
+
+	userID := uuid.New()
+	userHandler := handler.NewUserHandler(logger.NewDiscardLogger(), mockService)
+	e := setupServer(t, userHandler, http_server.Principal{UserID: userID})
+
+	body, err := json.Marshal(service.ChangePasswordRequest{
+		CurrentPassword: "old-password",
+		NewPassword:     "new-password",
+	})
+	require.NoError(t, err)
+
+	req := httptest.NewRequest(http.MethodPut, "/v1/users/"+userID.String()+"/password", bytes.NewBuffer(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	req.Header.Set(echo.HeaderAuthorization, "Bearer valid-token")
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	// Verify service was called with the submitted passwords
+	require.Equal(t, 1, mockService.ChangePasswordCallCount())
+	_, actualID, actualReq := mockService.ChangePasswordArgsForCall(0)
+	assert.Equal(t, userID, actualID)
+	assert.Equal(t, "old-password", actualReq.CurrentPassword)
+	assert.Equal(t, "new-password", actualReq.NewPassword)
+}
+
+func TestUserHandler_ChangePassword_ServiceErrors(t *testing.T) {
+	tests := []struct {
+		name       string
+		err        error
+		statusCode int
+		errorCode  string
+	}{
+		{
+			name:       "incorrect current password",
+			err:        service.ErrIncorrectCurrentPassword,
+			statusCode: http.StatusBadRequest,
+			errorCode:  "USER-INCORRECT_CURRENT_PASSWORD",
+		},
+		{
+			name:       "password policy violation",
+			err:        service.ErrPasswordPolicyViolation,
+			statusCode: http.StatusBadRequest,
+			errorCode:  "USER-PASSWORD_POLICY_VIOLATION",
+		},
+		{
+			name:       "other user",
+			err:        service.ErrUserForbidden,
+			statusCode: http.StatusForbidden,
+			errorCode:  "USER-FORBIDDEN",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := &servicefakes.FakeUserService{}
+			mockService.ChangePasswordReturns(tt.err)
+
+			userHandler := handler.NewUserHandler(logger.NewDiscardLogger(), mockService)
+			e := setupServer(t, userHandler, http_server.Principal{UserID: uuid.New()})
+
+			body, err := json.Marshal(service.ChangePasswordRequest{
+				CurrentPassword: "old-password",
+				NewPassword:     "new-password",
+			})
+			require.NoError(t, err)
+
+			req := httptest.NewRequest(http.MethodPut, "/v1/users/"+uuid.NewString()+"/password", bytes.NewBuffer(body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			req.Header.Set(echo.HeaderAuthorization, "Bearer valid-token")
+			rec := httptest.NewRecorder()
+			e.ServeHTTP(rec, req)
+
+			assert.Equal(t, tt.statusCode, rec.Code)
+
+			var response http_server.APIResponse
+			err = json.Unmarshal(rec.Body.Bytes(), &response)
+			require.NoError(t, err)
+			assert.Equal(t, tt.errorCode, response.Error)
+		})
+	}
+}
+
+func TestUserHandler_ChangePassword_ValidationError(t *testing.T) {
+	mockService := &servicefakes.FakeUserService{}
+	userHandler := handler.NewUserHandler(logger.NewDiscardLogger(), mockService)
+	e := setupServer(t, userHandler, http_server.Principal{UserID: uuid.New()})
+
+	body, err := json.Marshal(service.ChangePasswordRequest{NewPassword: "new-password"})
+	require.NoError(t, err)
+
+	req := httptest.NewRequest(http.MethodPut, "/v1/users/"+uuid.NewString()+"/password", bytes.NewBuffer(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	req.Header.Set(echo.HeaderAuthorization, "Bearer valid-token")
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+	assert.Equal(t, 0, mockService.ChangePasswordCallCount())
+}
+
+func TestUserHandler_ChangePassword_Unauthenticated(t *testing.T) {
+	mockService := &servicefakes.FakeUserService{}
+	userHandler := handler.NewUserHandler(logger.NewDiscardLogger(), mockService)
+	e := setupServer(t, userHandler, http_server.Principal{UserID: uuid.New()})
+
+	req := httptest.NewRequest(http.MethodPut, "/v1/users/"+uuid.NewString()+"/password", nil)
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	assert.Equal(t, 0, mockService.ChangePasswordCallCount())
+}
+
+func TestUserHandler_ForgotPassword_Success(t *testing.T) {
+	mockService := &servicefakes.FakeUserService{}
+	mockService.ForgotPasswordReturns(nil)
+
+	userHandler := handler.NewUserHandler(logger.NewDiscardLogger(), mockService)
+	e := setupEcho()
+
+	body, err := json.Marshal(service.ForgotPasswordRequest{Email: "john@example.com"})
+	require.NoError(t, err)
+
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/auth/password/forgot", bytes.NewBuffer(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	err = userHandler.ForgotPassword(c)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	// Verify service was called with the submitted email
+	assert.Equal(t, 1, mockService.ForgotPasswordCallCount())
+	_, actualReq := mockService.ForgotPasswordArgsForCall(0)
+	assert.Equal(t, "john@example.com", actualReq.Email)
+}
+
+func TestUserHandler_ForgotPassword_ValidationError(t *testing.T) {
+	mockService := &servicefakes.FakeUserService{}
+	userHandler := handler.NewUserHandler(logger.NewDiscardLogger(), mockService)
+	e := setupEcho()
+
+	body, err := json.Marshal(service.ForgotPasswordRequest{Email: "not-an-email"})
+	require.NoError(t, err)
+
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/auth/password/forgot", bytes.NewBuffer(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	err = userHandler.ForgotPassword(c)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+
+	// Service should not be called on validation error
+	assert.Equal(t, 0, mockService.ForgotPasswordCallCount())
+}
+
+func TestUserHandler_ResetPassword_Success(t *testing.T) {
+	mockService := &servicefakes.FakeUserService{}
+	mockService.ResetPasswordReturns(nil)
+
+	userHandler := handler.NewUserHandler(logger.NewDiscardLogger(), mockService)
+	e := setupEcho()
+
+	requestBody := service.ResetPasswordRequest{Token: "reset-token", NewPassword: "new-password"}
+	body, err := json.Marshal(requestBody)
+	require.NoError(t, err)
+
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/auth/password/reset", bytes.NewBuffer(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	err = userHandler.ResetPassword(c)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	// Verify service was called with the submitted token and password
+	assert.Equal(t, 1, mockService.ResetPasswordCallCount())
+	_, actualReq := mockService.ResetPasswordArgsForCall(0)
+	assert.Equal(t, requestBody, actualReq)
+}
+
+func TestUserHandler_ResetPassword_InvalidToken(t *testing.T) {
+	mockService := &servicefakes.FakeUserService{}
+	mockService.ResetPasswordReturns(service.ErrInvalidPasswordResetToken)
+
+	userHandler := handler.NewUserHandler(logger.NewDiscardLogger(), mockService)
+	e := setupEcho()
+
+	body, err := json.Marshal(service.ResetPasswordRequest{Token: "used-token", NewPassword: "new-password"})
+	require.NoError(t, err)
+
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/auth/password/reset", bytes.NewBuffer(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	err = userHandler.ResetPassword(c)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+	var response http_server.APIResponse
+	err = json.Unmarshal(rec.Body.Bytes(), &response)
+	require.NoError(t, err)
+	assert.Equal(t, "USER-INVALID_PASSWORD_RESET_TOKEN", response.Error)
+}
+
+func TestUserHandler_VerifyEmail_Success(t *testing.T) {
+	mockService := &servicefakes.FakeUserService{}
+	mockService.VerifyEmailReturns(nil)
+
+	userHandler := handler.NewUserHandler(logger.NewDiscardLogger(), mockService)
+	e := setupServer(t, userHandler, http_server.Principal{})
+
+	// The endpoint is public so the link works straight from the email
+	req := httptest.NewRequest(http.MethodGet, "/v1/auth/verify?token=verify-token", nil)
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	// Verify service was called with the token from the query string
+	assert.Equal(t, 1, mockService.VerifyEmailCallCount())
+	_, actualReq := mockService.VerifyEmailArgsForCall(0)
+	assert.Equal(t, "verify-token", actualReq.Token)
+}
+
+func TestUserHandler_VerifyEmail_MissingToken(t *testing.T) {
+	mockService := &servicefakes.FakeUserService{}
+	userHandler := handler.NewUserHandler(logger.NewDiscardLogger(), mockService)
+	e := setupServer(t, userHandler, http_server.Principal{})
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/auth/verify", nil)
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+
+	// Service should not be called on validation error
+	assert.Equal(t, 0, mockService.VerifyEmailCallCount())
+}
+
+func TestUserHandler_VerifyEmail_InvalidToken(t *testing.T) {
+	mockService := &servicefakes.FakeUserService{}
+	mockService.VerifyEmailReturns(service.ErrInvalidEmailVerificationToken)
+
+	userHandler := handler.NewUserHandler(logger.NewDiscardLogger(), mockService)
+	e := setupServer(t, userHandler, http_server.Principal{})
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/auth/verify?token=expired-token", nil)
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+	var response http_server.APIResponse
+	err := json.Unmarshal(rec.Body.Bytes(), &response)
+	require.NoError(t, err)
+	assert.Equal(t, "USER-INVALID_EMAIL_VERIFICATION_TOKEN", response.Error)
+}
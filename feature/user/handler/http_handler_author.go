@@ -0,0 +1,84 @@
+package handler
+
+import (
+	"log/slog"
+
+	"github.com/fikryfahrezy/let-it-go/feature/user/service"
+	"github.com/fikryfahrezy/let-it-go/pkg/http_server"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+// setupAuthorRoutes configures v1 author profile routes. Author pages are public.
+func (h *UserHandler) setupAuthorRoutes(server *http_server.Server) {
+	server.Echo().PUT("/v1/users/:id/profile", h.UpdateAuthorProfile, http_server.RequireAuth())
+	server.Echo().GET("/v1/authors/:handle", h.GetAuthor)
+}
+
+// UpdateAuthorProfile sets up or replaces the author profile of a user
+// @Summary Update an author profile
+// @Description Set up or replace the public author profile of a user: a unique handle of lowercase letters, numbers and hyphens, a bio, avatar and website URLs and social handles keyed by network (github, x, linkedin, mastodon, bluesky, instagram or youtube). Users update their own profile; admins can update any.
+// @Tags users
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "User ID"
+// @Param request body service.UpdateAuthorProfileRequest true "Author profile"
+// @Success 200 {object} http_server.APIResponse{result=service.GetUserResponse}
+// @Failure 400 {object} http_server.APIResponse
+// @Failure 401 {object} http_server.APIResponse
+// @Failure 403 {object} http_server.APIResponse
+// @Failure 404 {object} http_server.APIResponse
+// @Failure 409 {object} http_server.APIResponse
+// @Failure 422 {object} http_server.APIResponse
+// @Failure 500 {object} http_server.APIResponse
+// @Router /v1/users/{id}/profile [put]
+func (h *UserHandler) UpdateAuthorProfile(c echo.Context) error {
+	idParam := c.Param("id")
+	id, err := uuid.Parse(idParam)
+	if err != nil {
+		h.log.Warn("Invalid user ID parameter",
+			slog.String("id", idParam),
+		)
+		return http_server.BadRequestResponse(c, "Invalid user UUID format", err)
+	}
+
+	var req service.UpdateAuthorProfileRequest
+	if err := c.Bind(&req); err != nil {
+		h.log.Error("Failed to bind request",
+			slog.String("error", err.Error()),
+		)
+		return http_server.BadRequestResponse(c, "Invalid request format", err)
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return http_server.HandleValidationError(c, err)
+	}
+
+	user, err := h.userService.UpdateAuthorProfile(c.Request().Context(), id, req)
+	if err != nil {
+		return h.translateServiceError(c, err, "Failed to update author profile")
+	}
+
+	return http_server.SuccessResponse(c, "Author profile updated successfully", user)
+}
+
+// GetAuthor gets the public profile of an author
+// @Summary Get an author
+// @Description Get the public profile of an author by their handle. The email address is never included.
+// @Tags users
+// @Accept json
+// @Produce json
+// @Param handle path string true "Author handle"
+// @Success 200 {object} http_server.APIResponse{result=service.AuthorResponse}
+// @Failure 404 {object} http_server.APIResponse
+// @Failure 500 {object} http_server.APIResponse
+// @Router /v1/authors/{handle} [get]
+func (h *UserHandler) GetAuthor(c echo.Context) error {
+	author, err := h.userService.GetAuthor(c.Request().Context(), c.Param("handle"))
+	if err != nil {
+		return h.translateServiceError(c, err, "Failed to get author")
+	}
+
+	return http_server.SuccessResponse(c, "Author retrieved successfully", author)
+}
@@ -0,0 +1,116 @@
+package handler_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/fikryfahrezy/let-it-go/feature/user/handler"
+	"github.com/fikryfahrezy/let-it-go/feature/user/repository"
+	"github.com/fikryfahrezy/let-it-go/feature/user/service"
+	"github.com/fikryfahrezy/let-it-go/feature/user/service/servicefakes"
+	"github.com/fikryfahrezy/let-it-go/pkg/http_server"
+	"github.com/fikryfahrezy/let-it-go/pkg/logger"
+	"github.com/fikryfahrezy/let-it-go/pkg/rbac"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUserHandler_UpdateAuthorProfile_Success(t *testing.T) {
+	mockService := &servicefakes.FakeUserService{}
+	userID := uuid.New()
+	mockService.UpdateAuthorProfileReturns(service.GetUserResponse{ID: userID, Handle: "jane"}, nil)
+
+	userHandler := handler.NewUserHandler(logger.NewDiscardLogger(), mockService)
+	e := setupServer(t, userHandler, http_server.Principal{UserID: userID, Roles: []string{rbac.RoleAuthor}})
+
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, newJSONRequest(t, http.MethodPut, "/v1/users/"+userID.String()+"/profile", service.UpdateAuthorProfileRequest{
+		Handle:      "jane",
+		Bio:         "Writes about Go",
+		AvatarURL:   "https://cdn.example.com/jane.png",
+		SocialLinks: map[string]string{"github": "jane"},
+	}))
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	require.Equal(t, 1, mockService.UpdateAuthorProfileCallCount())
+	_, actualID, actualReq := mockService.UpdateAuthorProfileArgsForCall(0)
+	assert.Equal(t, userID, actualID)
+	assert.Equal(t, "jane", actualReq.Handle)
+	assert.Equal(t, map[string]string{"github": "jane"}, actualReq.SocialLinks)
+}
+
+func TestUserHandler_UpdateAuthorProfile_ValidationError(t *testing.T) {
+	mockService := &servicefakes.FakeUserService{}
+	userID := uuid.New()
+	userHandler := handler.NewUserHandler(logger.NewDiscardLogger(), mockService)
+	e := setupServer(t, userHandler, http_server.Principal{UserID: userID, Roles: []string{rbac.RoleAuthor}})
+
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, newJSONRequest(t, http.MethodPut, "/v1/users/"+userID.String()+"/profile", service.UpdateAuthorProfileRequest{
+		Handle:      "Jane Doe!",
+		WebsiteURL:  "javascript:alert(1)",
+		SocialLinks: map[string]string{"myspace": "jane"},
+	}))
+
+	assert.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+	assert.Equal(t, 0, mockService.UpdateAuthorProfileCallCount())
+
+	var response http_server.APIResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	assert.Contains(t, response.ErrorFields, "handle")
+	assert.Contains(t, response.ErrorFields, "website_url")
+	assert.Contains(t, response.ErrorFields, "social_links[myspace]")
+}
+
+func TestUserHandler_UpdateAuthorProfile_HandleTaken(t *testing.T) {
+	mockService := &servicefakes.FakeUserService{}
+	userID := uuid.New()
+	mockService.UpdateAuthorProfileReturns(service.GetUserResponse{}, repository.ErrHandleAlreadyTaken)
+
+	userHandler := handler.NewUserHandler(logger.NewDiscardLogger(), mockService)
+	e := setupServer(t, userHandler, http_server.Principal{UserID: userID, Roles: []string{rbac.RoleAuthor}})
+
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, newJSONRequest(t, http.MethodPut, "/v1/users/"+userID.String()+"/profile", service.UpdateAuthorProfileRequest{Handle: "taken"}))
+
+	assert.Equal(t, http.StatusConflict, rec.Code)
+}
+
+func TestUserHandler_GetAuthor_Success(t *testing.T) {
+	mockService := &servicefakes.FakeUserService{}
+	mockService.GetAuthorReturns(service.AuthorResponse{ID: uuid.New(), Handle: "jane", Name: "Jane Doe"}, nil)
+
+	userHandler := handler.NewUserHandler(logger.NewDiscardLogger(), mockService)
+	e := setupServer(t, userHandler, http_server.Principal{})
+
+	// Author pages are public, so no token is sent
+	req := httptest.NewRequest(http.MethodGet, "/v1/authors/jane", nil)
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), `"handle":"jane"`)
+	assert.NotContains(t, rec.Body.String(), "email")
+
+	require.Equal(t, 1, mockService.GetAuthorCallCount())
+	_, actualHandle := mockService.GetAuthorArgsForCall(0)
+	assert.Equal(t, "jane", actualHandle)
+}
+
+func TestUserHandler_GetAuthor_NotFound(t *testing.T) {
+	mockService := &servicefakes.FakeUserService{}
+	mockService.GetAuthorReturns(service.AuthorResponse{}, repository.ErrAuthorProfileNotFound)
+
+	userHandler := handler.NewUserHandler(logger.NewDiscardLogger(), mockService)
+	e := setupServer(t, userHandler, http_server.Principal{})
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/authors/nobody", nil)
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
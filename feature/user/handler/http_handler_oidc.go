@@ -0,0 +1,77 @@
+package handler
+
+import (
+	"log/slog"
+
+	"github.com/fikryfahrezy/let-it-go/feature/user/service"
+	"github.com/fikryfahrezy/let-it-go/pkg/http_server"
+	"github.com/labstack/echo/v4"
+)
+
+// setupOIDCRoutes configures v1 OpenID Connect login routes
+func (h *UserHandler) setupOIDCRoutes(server *http_server.Server) {
+	oidc := server.Echo().Group("/v1/auth/oidc")
+	oidc.GET("/authorize", h.OIDCAuthorize)
+	oidc.POST("/callback", h.OIDCCallback)
+}
+
+// OIDCAuthorize starts an OpenID Connect login
+// @Summary Start OpenID Connect login
+// @Description Start an authorization code login with PKCE at the configured OpenID Connect provider. Send the user to authorization_url; the provider redirects back to the configured redirect URL with a code and the state, which are then posted to POST /v1/auth/oidc/callback.
+// @Tags auth
+// @Produce json
+// @Success 200 {object} http_server.APIResponse{result=service.OIDCAuthorizeResponse}
+// @Failure 500 {object} http_server.APIResponse
+// @Failure 503 {object} http_server.APIResponse
+// @Router /v1/auth/oidc/authorize [get]
+func (h *UserHandler) OIDCAuthorize(c echo.Context) error {
+	result, err := h.userService.OIDCAuthorize(c.Request().Context())
+	if err != nil {
+		return h.translateServiceError(c, err, "Failed to start OpenID Connect login")
+	}
+
+	return http_server.SuccessResponse(c, "OpenID Connect login started", result)
+}
+
+// OIDCCallback completes an OpenID Connect login
+// @Summary Complete OpenID Connect login
+// @Description Exchange the code and state the provider redirected back with for an access token and a refresh token. The first login links the external account to the user with the same email when the provider verified it, or creates a new user. Users with two factor authentication enabled receive a service.TwoFactorChallengeResponse instead of tokens and finish logging in with POST /v1/auth/2fa/verify.
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Param request body service.OIDCCallbackRequest true "Code and state from the provider redirect"
+// @Success 200 {object} http_server.APIResponse{result=service.AuthenticateResponse}
+// @Failure 400 {object} http_server.APIResponse
+// @Failure 401 {object} http_server.APIResponse
+// @Failure 409 {object} http_server.APIResponse
+// @Failure 422 {object} http_server.APIResponse
+// @Failure 500 {object} http_server.APIResponse
+// @Failure 503 {object} http_server.APIResponse
+// @Router /v1/auth/oidc/callback [post]
+func (h *UserHandler) OIDCCallback(c echo.Context) error {
+	var req service.OIDCCallbackRequest
+	if err := c.Bind(&req); err != nil {
+		h.log.Error("Failed to bind request",
+			slog.String("error", err.Error()),
+		)
+		return http_server.BadRequestResponse(c, "Invalid request format", err)
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return http_server.HandleValidationError(c, err)
+	}
+
+	req.UserAgent = c.Request().UserAgent()
+	req.IPAddress = c.RealIP()
+
+	result, err := h.userService.OIDCCallback(c.Request().Context(), req)
+	if err != nil {
+		return h.translateServiceError(c, err, "Failed to complete OpenID Connect login")
+	}
+
+	if result.TwoFactorChallenge != nil {
+		return http_server.SuccessResponse(c, "Two factor verification required", result.TwoFactorChallenge)
+	}
+
+	return http_server.SuccessResponse(c, "Logged in successfully", result)
+}
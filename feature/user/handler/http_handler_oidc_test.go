@@ -0,0 +1,139 @@
+package handler_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/fikryfahrezy/let-it-go/feature/user/handler"
+	"github.com/fikryfahrezy/let-it-go/feature/user/service"
+	"github.com/fikryfahrezy/let-it-go/feature/user/service/servicefakes"
+	"github.com/fikryfahrezy/let-it-go/pkg/http_server"
+	"github.com/fikryfahrezy/let-it-go/pkg/logger"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUserHandler_OIDCAuthorize_Success(t *testing.T) {
+	mockService := &servicefakes.FakeUserService{}
+	mockService.OIDCAuthorizeReturns(service.OIDCAuthorizeResponse{
+		AuthorizationURL: "https://idp.example.com/authorize?state=state",
+		State:            "state",
+		ExpiresAt:        time.Now().Add(10 * time.Minute),
+	}, nil)
+
+	userHandler := handler.NewUserHandler(logger.NewDiscardLogger(), mockService)
+	e := setupServer(t, userHandler, http_server.Principal{UserID: uuid.New()})
+
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/v1/auth/oidc/authorize", nil))
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), "https://idp.example.com/authorize")
+	assert.Equal(t, 1, mockService.OIDCAuthorizeCallCount())
+}
+
+func TestUserHandler_OIDCAuthorize_Unavailable(t *testing.T) {
+	mockService := &servicefakes.FakeUserService{}
+	mockService.OIDCAuthorizeReturns(service.OIDCAuthorizeResponse{}, service.ErrOIDCUnavailable)
+
+	userHandler := handler.NewUserHandler(logger.NewDiscardLogger(), mockService)
+	e := setupServer(t, userHandler, http_server.Principal{UserID: uuid.New()})
+
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/v1/auth/oidc/authorize", nil))
+
+	assert.Equal(t, http.StatusServiceUnavailable, rec.Code)
+}
+
+func TestUserHandler_OIDCCallback_Success(t *testing.T) {
+	mockService := &servicefakes.FakeUserService{}
+	mockService.OIDCCallbackReturns(service.AuthenticateResponse{
+		AccessToken:  "access-token",
+		TokenType:    service.TokenTypeBearer,
+		RefreshToken: "refresh-token",
+	}, nil)
+
+	userHandler := handler.NewUserHandler(logger.NewDiscardLogger(), mockService)
+	e := setupServer(t, userHandler, http_server.Principal{UserID: uuid.New()})
+
+	req := newJSONRequest(t, http.MethodPost, "/v1/auth/oidc/callback", service.OIDCCallbackRequest{Code: "code", State: "state"})
+	req.Header.Set("User-Agent", "test-agent")
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), "access-token")
+
+	require.Equal(t, 1, mockService.OIDCCallbackCallCount())
+	_, actualReq := mockService.OIDCCallbackArgsForCall(0)
+	assert.Equal(t, "code", actualReq.Code)
+	assert.Equal(t, "state", actualReq.State)
+	assert.Equal(t, "test-agent", actualReq.UserAgent)
+	assert.NotEmpty(t, actualReq.IPAddress)
+}
+
+func TestUserHandler_OIDCCallback_TwoFactorChallenge(t *testing.T) {
+	mockService := &servicefakes.FakeUserService{}
+	mockService.OIDCCallbackReturns(service.AuthenticateResponse{
+		TwoFactorChallenge: &service.TwoFactorChallengeResponse{
+			TwoFactorRequired: true,
+			ChallengeToken:    "challenge-token",
+			ExpiresAt:         time.Now().Add(5 * time.Minute),
+		},
+	}, nil)
+
+	userHandler := handler.NewUserHandler(logger.NewDiscardLogger(), mockService)
+	e := setupServer(t, userHandler, http_server.Principal{UserID: uuid.New()})
+
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, newJSONRequest(t, http.MethodPost, "/v1/auth/oidc/callback", service.OIDCCallbackRequest{Code: "code", State: "state"}))
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), "challenge-token")
+	assert.NotContains(t, rec.Body.String(), "access_token")
+}
+
+func TestUserHandler_OIDCCallback_MissingState(t *testing.T) {
+	mockService := &servicefakes.FakeUserService{}
+	userHandler := handler.NewUserHandler(logger.NewDiscardLogger(), mockService)
+	e := setupServer(t, userHandler, http_server.Principal{UserID: uuid.New()})
+
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, newJSONRequest(t, http.MethodPost, "/v1/auth/oidc/callback", service.OIDCCallbackRequest{Code: "code"}))
+
+	assert.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+	assert.Equal(t, 0, mockService.OIDCCallbackCallCount())
+}
+
+func TestUserHandler_OIDCCallback_Errors(t *testing.T) {
+	tests := []struct {
+		name       string
+		err        error
+		wantStatus int
+	}{
+		{name: "invalid state", err: service.ErrInvalidOIDCState, wantStatus: http.StatusBadRequest},
+		{name: "login failed", err: service.ErrOIDCLoginFailed, wantStatus: http.StatusUnauthorized},
+		{name: "email missing", err: service.ErrOIDCEmailMissing, wantStatus: http.StatusBadRequest},
+		{name: "email unverified", err: service.ErrOIDCEmailUnverified, wantStatus: http.StatusConflict},
+		{name: "account never verified", err: service.ErrOIDCUserUnverified, wantStatus: http.StatusConflict},
+		{name: "unavailable", err: service.ErrOIDCUnavailable, wantStatus: http.StatusServiceUnavailable},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := &servicefakes.FakeUserService{}
+			mockService.OIDCCallbackReturns(service.AuthenticateResponse{}, tt.err)
+
+			userHandler := handler.NewUserHandler(logger.NewDiscardLogger(), mockService)
+			e := setupServer(t, userHandler, http_server.Principal{UserID: uuid.New()})
+
+			rec := httptest.NewRecorder()
+			e.ServeHTTP(rec, newJSONRequest(t, http.MethodPost, "/v1/auth/oidc/callback", service.OIDCCallbackRequest{Code: "code", State: "state"}))
+
+			assert.Equal(t, tt.wantStatus, rec.Code)
+		})
+	}
+}
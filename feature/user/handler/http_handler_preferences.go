@@ -0,0 +1,97 @@
+package handler
+
+import (
+	"log/slog"
+
+	"github.com/fikryfahrezy/let-it-go/feature/user/service"
+	"github.com/fikryfahrezy/let-it-go/pkg/http_server"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+// setupPreferencesRoutes configures v1 user preferences routes
+func (h *UserHandler) setupPreferencesRoutes(server *http_server.Server) {
+	preferences := server.Echo().Group("/v1/users/:id/preferences", http_server.RequireAuth())
+	preferences.GET("", h.GetPreferences)
+	preferences.PUT("", h.UpdatePreferences)
+}
+
+// GetPreferences gets the preferences of a user
+// @Summary Get user preferences
+// @Description Get the timezone, locale, email notification opt-ins and default status of new blogs of a user. Users who never changed them get the defaults and a null updated_at.
+// @Tags users
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "User ID"
+// @Success 200 {object} http_server.APIResponse{result=service.PreferencesResponse}
+// @Failure 400 {object} http_server.APIResponse
+// @Failure 401 {object} http_server.APIResponse
+// @Failure 403 {object} http_server.APIResponse
+// @Failure 404 {object} http_server.APIResponse
+// @Failure 500 {object} http_server.APIResponse
+// @Router /v1/users/{id}/preferences [get]
+func (h *UserHandler) GetPreferences(c echo.Context) error {
+	idParam := c.Param("id")
+	id, err := uuid.Parse(idParam)
+	if err != nil {
+		h.log.Warn("Invalid user ID parameter",
+			slog.String("id", idParam),
+		)
+		return http_server.BadRequestResponse(c, "Invalid user UUID format", err)
+	}
+
+	preferences, err := h.userService.GetPreferences(c.Request().Context(), id)
+	if err != nil {
+		return h.translateServiceError(c, err, "Failed to get preferences")
+	}
+
+	return http_server.SuccessResponse(c, "Preferences retrieved successfully", preferences)
+}
+
+// UpdatePreferences replaces the preferences of a user
+// @Summary Update user preferences
+// @Description Replace every preference of a user. The timezone is an IANA name such as Europe/Berlin, the locale a BCP 47 tag such as en-US and default_blog_status the status of blogs created without one (draft or published). Users update their own preferences; admins can update any.
+// @Tags users
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "User ID"
+// @Param request body service.Preferences true "Preferences"
+// @Success 200 {object} http_server.APIResponse{result=service.PreferencesResponse}
+// @Failure 400 {object} http_server.APIResponse
+// @Failure 401 {object} http_server.APIResponse
+// @Failure 403 {object} http_server.APIResponse
+// @Failure 404 {object} http_server.APIResponse
+// @Failure 422 {object} http_server.APIResponse
+// @Failure 500 {object} http_server.APIResponse
+// @Router /v1/users/{id}/preferences [put]
+func (h *UserHandler) UpdatePreferences(c echo.Context) error {
+	idParam := c.Param("id")
+	id, err := uuid.Parse(idParam)
+	if err != nil {
+		h.log.Warn("Invalid user ID parameter",
+			slog.String("id", idParam),
+		)
+		return http_server.BadRequestResponse(c, "Invalid user UUID format", err)
+	}
+
+	var req service.Preferences
+	if err := c.Bind(&req); err != nil {
+		h.log.Error("Failed to bind request",
+			slog.String("error", err.Error()),
+		)
+		return http_server.BadRequestResponse(c, "Invalid request format", err)
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return http_server.HandleValidationError(c, err)
+	}
+
+	preferences, err := h.userService.UpdatePreferences(c.Request().Context(), id, req)
+	if err != nil {
+		return h.translateServiceError(c, err, "Failed to update preferences")
+	}
+
+	return http_server.SuccessResponse(c, "Preferences updated successfully", preferences)
+}
@@ -0,0 +1,89 @@
+package handler_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/fikryfahrezy/let-it-go/feature/user/handler"
+	"github.com/fikryfahrezy/let-it-go/feature/user/service"
+	"github.com/fikryfahrezy/let-it-go/feature/user/service/servicefakes"
+	"github.com/fikryfahrezy/let-it-go/pkg/http_server"
+	"github.com/fikryfahrezy/let-it-go/pkg/logger"
+	"github.com/fikryfahrezy/let-it-go/pkg/rbac"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUserHandler_GetPreferences_Success(t *testing.T) {
+	mockService := &servicefakes.FakeUserService{}
+	userID := uuid.New()
+	mockService.GetPreferencesReturns(service.PreferencesResponse{Preferences: service.DefaultPreferences(), SchemaVersion: 1}, nil)
+
+	userHandler := handler.NewUserHandler(logger.NewDiscardLogger(), mockService)
+	e := setupServer(t, userHandler, http_server.Principal{UserID: userID, Roles: []string{rbac.RoleAuthor}})
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/users/"+userID.String()+"/preferences", nil)
+	req.Header.Set(echo.HeaderAuthorization, "Bearer valid-token")
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), `"timezone":"UTC"`)
+	assert.Contains(t, rec.Body.String(), `"schema_version":1`)
+
+	require.Equal(t, 1, mockService.GetPreferencesCallCount())
+	_, actualID := mockService.GetPreferencesArgsForCall(0)
+	assert.Equal(t, userID, actualID)
+}
+
+func TestUserHandler_UpdatePreferences_Success(t *testing.T) {
+	mockService := &servicefakes.FakeUserService{}
+	userID := uuid.New()
+	userHandler := handler.NewUserHandler(logger.NewDiscardLogger(), mockService)
+	e := setupServer(t, userHandler, http_server.Principal{UserID: userID, Roles: []string{rbac.RoleAuthor}})
+
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, newJSONRequest(t, http.MethodPut, "/v1/users/"+userID.String()+"/preferences", map[string]any{
+		"timezone":            "Asia/Jakarta",
+		"locale":              "id-ID",
+		"email_notifications": map[string]bool{"new_comments": false, "product_updates": true},
+		"default_blog_status": "published",
+	}))
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	require.Equal(t, 1, mockService.UpdatePreferencesCallCount())
+	_, actualID, actualReq := mockService.UpdatePreferencesArgsForCall(0)
+	assert.Equal(t, userID, actualID)
+	assert.Equal(t, "Asia/Jakarta", actualReq.Timezone)
+	assert.True(t, actualReq.EmailNotifications.ProductUpdates)
+	assert.False(t, actualReq.EmailNotifications.NewComments)
+}
+
+func TestUserHandler_UpdatePreferences_ValidationError(t *testing.T) {
+	mockService := &servicefakes.FakeUserService{}
+	userID := uuid.New()
+	userHandler := handler.NewUserHandler(logger.NewDiscardLogger(), mockService)
+	e := setupServer(t, userHandler, http_server.Principal{UserID: userID, Roles: []string{rbac.RoleAuthor}})
+
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, newJSONRequest(t, http.MethodPut, "/v1/users/"+userID.String()+"/preferences", map[string]any{
+		"timezone":            "Mars/Olympus_Mons",
+		"locale":              "not a locale",
+		"default_blog_status": "archived",
+	}))
+
+	assert.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+	assert.Equal(t, 0, mockService.UpdatePreferencesCallCount())
+
+	var response http_server.APIResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	assert.Contains(t, response.ErrorFields, "timezone")
+	assert.Contains(t, response.ErrorFields, "locale")
+	assert.Contains(t, response.ErrorFields, "default_blog_status")
+	assert.Contains(t, rec.Body.String(), "must be an IANA time zone")
+}
@@ -2,6 +2,7 @@ package handler_test
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
@@ -14,6 +15,7 @@ import (
 	"github.com/fikryfahrezy/let-it-go/feature/user/service/servicefakes"
 	"github.com/fikryfahrezy/let-it-go/pkg/http_server"
 	"github.com/fikryfahrezy/let-it-go/pkg/logger"
+	"github.com/fikryfahrezy/let-it-go/pkg/rbac"
 	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
 	"github.com/stretchr/testify/assert"
@@ -120,6 +122,37 @@ func TestUserHandler_CreateUser_ServiceError(t *testing.T) {
 	assert.Equal(t, 1, mockService.CreateUserCallCount())
 }
 
+func TestUserHandler_CreateUser_PasswordPolicyViolation(t *testing.T) {
+	mockService := &servicefakes.FakeUserService{}
+	mockService.CreateUserReturns(service.CreateUserResponse{}, service.ErrPasswordPolicyViolation)
+
+	userHandler := handler.NewUserHandler(logger.NewDiscardLogger(), mockService)
+	e := setupEcho()
+
+	// The password length is left to the configured password policy, not the request validation
+	requestBody := service.CreateUserRequest{
+		Name:     "John Doe",
+		Email:    "john@example.com",
+		Password: "short",
+	}
+	body, err := json.Marshal(requestBody)
+	require.NoError(t, err)
+
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/users", bytes.NewBuffer(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	err = userHandler.CreateUser(c)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+	// Verify the short password reached the service
+	require.Equal(t, 1, mockService.CreateUserCallCount())
+	_, actualReq := mockService.CreateUserArgsForCall(0)
+	assert.Equal(t, requestBody.Password, actualReq.Password)
+}
+
 func TestUserHandler_GetUser_Success(t *testing.T) {
 	mockService := &servicefakes.FakeUserService{}
 	userID := uuid.New()
@@ -264,6 +297,78 @@ func TestUserHandler_ListUsers_WithPagination(t *testing.T) {
 	assert.Equal(t, 5, paginationReq.PageSize)
 }
 
+func TestUserHandler_ListUsers_WithFilter(t *testing.T) {
+	mockService := &servicefakes.FakeUserService{}
+	mockService.ListUsersReturns([]service.ListUsersResponse{}, 0, nil)
+
+	userHandler := handler.NewUserHandler(logger.NewDiscardLogger(), mockService)
+	e := setupEcho()
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/users?search=jo&created_from=2025-01-01T00:00:00Z&created_to=2025-02-01T00:00:00%2B07:00&sort_by=email&sort_order=asc", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	err := userHandler.ListUsers(c)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	require.Equal(t, 1, mockService.ListUsersCallCount())
+	_, listReq := mockService.ListUsersArgsForCall(0)
+	assert.Equal(t, "jo", listReq.Search)
+	assert.Equal(t, "email", listReq.SortBy)
+	assert.Equal(t, "asc", listReq.SortOrder)
+	require.NotNil(t, listReq.CreatedFrom)
+	assert.True(t, listReq.CreatedFrom.Equal(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)))
+	require.NotNil(t, listReq.CreatedTo)
+	assert.True(t, listReq.CreatedTo.Equal(time.Date(2025, 1, 31, 17, 0, 0, 0, time.UTC)))
+}
+
+func TestUserHandler_ListUsers_InvalidSort(t *testing.T) {
+	mockService := &servicefakes.FakeUserService{}
+	userHandler := handler.NewUserHandler(logger.NewDiscardLogger(), mockService)
+	e := setupEcho()
+
+	// Only whitelisted columns can be sorted by
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/users?sort_by=password", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	err := userHandler.ListUsers(c)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+	assert.Equal(t, 0, mockService.ListUsersCallCount())
+}
+
+func TestUserHandler_ListUsers_InvalidTime(t *testing.T) {
+	mockService := &servicefakes.FakeUserService{}
+	userHandler := handler.NewUserHandler(logger.NewDiscardLogger(), mockService)
+	e := setupEcho()
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/users?created_from=yesterday", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	err := userHandler.ListUsers(c)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Equal(t, 0, mockService.ListUsersCallCount())
+}
+
+func TestUserHandler_ListUsers_InvalidDateRange(t *testing.T) {
+	mockService := &servicefakes.FakeUserService{}
+	mockService.ListUsersReturns(nil, 0, service.ErrInvalidDateRange)
+	userHandler := handler.NewUserHandler(logger.NewDiscardLogger(), mockService)
+	e := setupEcho()
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/users?created_from=2025-02-01T00:00:00Z&created_to=2025-01-01T00:00:00Z", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	err := userHandler.ListUsers(c)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+}
+
 func TestUserHandler_DeleteUser_Success(t *testing.T) {
 	mockService := &servicefakes.FakeUserService{}
 	userID := uuid.New()
@@ -312,6 +417,66 @@ func TestUserHandler_DeleteUser_NotFound(t *testing.T) {
 	assert.Equal(t, 1, mockService.DeleteUserCallCount())
 }
 
+func TestUserHandler_ExportUser_Success(t *testing.T) {
+	mockService := &servicefakes.FakeUserService{}
+	userID := uuid.New()
+	mockService.ExportUserReturns(service.ExportUserResponse{
+		Profile: service.GetUserResponse{ID: userID, Email: "john@example.com"},
+		Blogs:   []service.ExportedBlogResponse{{ID: uuid.New(), Title: "First"}},
+	}, nil)
+
+	userHandler := handler.NewUserHandler(logger.NewDiscardLogger(), mockService)
+	e := setupServer(t, userHandler, http_server.Principal{UserID: userID})
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/users/"+userID.String()+"/export", nil)
+	req.Header.Set(echo.HeaderAuthorization, "Bearer valid-token")
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Header().Get(echo.HeaderContentDisposition), "attachment")
+
+	var response struct {
+		Result service.ExportUserResponse `json:"result"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	assert.Equal(t, "john@example.com", response.Result.Profile.Email)
+	require.Len(t, response.Result.Blogs, 1)
+	assert.Equal(t, "First", response.Result.Blogs[0].Title)
+
+	require.Equal(t, 1, mockService.ExportUserCallCount())
+	_, actualID := mockService.ExportUserArgsForCall(0)
+	assert.Equal(t, userID, actualID)
+}
+
+func TestUserHandler_ExportUser_WithoutToken(t *testing.T) {
+	mockService := &servicefakes.FakeUserService{}
+	userHandler := handler.NewUserHandler(logger.NewDiscardLogger(), mockService)
+	e := setupServer(t, userHandler, http_server.Principal{UserID: uuid.New()})
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/users/"+uuid.NewString()+"/export", nil)
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	assert.Equal(t, 0, mockService.ExportUserCallCount())
+}
+
+func TestUserHandler_ExportUser_Forbidden(t *testing.T) {
+	mockService := &servicefakes.FakeUserService{}
+	mockService.ExportUserReturns(service.ExportUserResponse{}, service.ErrUserForbidden)
+	userHandler := handler.NewUserHandler(logger.NewDiscardLogger(), mockService)
+	e := setupServer(t, userHandler, http_server.Principal{UserID: uuid.New()})
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/users/"+uuid.NewString()+"/export", nil)
+	req.Header.Set(echo.HeaderAuthorization, "Bearer valid-token")
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+	assert.Empty(t, rec.Header().Get(echo.HeaderContentDisposition))
+}
+
 func TestUserHandler_HealthCheck(t *testing.T) {
 	mockService := &servicefakes.FakeUserService{}
 	userHandler := handler.NewUserHandler(logger.NewDiscardLogger(), mockService)
@@ -338,3 +503,145 @@ func TestUserHandler_HealthCheck(t *testing.T) {
 	assert.Equal(t, 0, mockService.DeleteUserCallCount())
 	assert.Equal(t, 0, mockService.ListUsersCallCount())
 }
+
+func setupServer(t *testing.T, userHandler *handler.UserHandler, principal http_server.Principal) *echo.Echo {
+	t.Helper()
+
+	srv := http_server.New(http_server.Config{})
+	srv.SetAuthenticator(http_server.AuthenticatorFunc(func(ctx context.Context, token string) (http_server.Principal, error) {
+		if token != "valid-token" {
+			return http_server.Principal{}, http_server.ErrUnauthenticated
+		}
+		return principal, nil
+	}))
+	require.NoError(t, srv.Initialize([]http_server.RouteHandler{userHandler}))
+
+	return srv.Echo()
+}
+
+// newJSONRequest builds a request sending body as JSON with the token setupServer accepts
+func newJSONRequest(t *testing.T, method, path string, body any) *http.Request {
+	t.Helper()
+
+	payload, err := json.Marshal(body)
+	require.NoError(t, err)
+
+	req := httptest.NewRequest(method, path, bytes.NewBuffer(payload))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	req.Header.Set(echo.HeaderAuthorization, "Bearer valid-token")
+	return req
+}
+
+func TestUserHandler_ProtectedRoute_WithoutToken(t *testing.T) {
+	mockService := &servicefakes.FakeUserService{}
+	userHandler := handler.NewUserHandler(logger.NewDiscardLogger(), mockService)
+	e := setupServer(t, userHandler, http_server.Principal{UserID: uuid.New()})
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/users/"+uuid.NewString(), nil)
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+
+	var response http_server.APIResponse
+	err := json.Unmarshal(rec.Body.Bytes(), &response)
+	require.NoError(t, err)
+	assert.Equal(t, http_server.ErrUnauthenticated.Code, response.Error)
+
+	// Service should not be called without a principal
+	assert.Equal(t, 0, mockService.GetUserByIDCallCount())
+}
+
+func TestUserHandler_ProtectedRoute_InvalidToken(t *testing.T) {
+	mockService := &servicefakes.FakeUserService{}
+	userHandler := handler.NewUserHandler(logger.NewDiscardLogger(), mockService)
+	e := setupServer(t, userHandler, http_server.Principal{UserID: uuid.New()})
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/users/"+uuid.NewString(), nil)
+	req.Header.Set(echo.HeaderAuthorization, "Bearer invalid-token")
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	assert.Equal(t, 0, mockService.GetUserByIDCallCount())
+}
+
+func TestUserHandler_ProtectedRoute_WithToken(t *testing.T) {
+	mockService := &servicefakes.FakeUserService{}
+	userID := uuid.New()
+	mockService.GetUserByIDReturns(service.GetUserResponse{ID: userID}, nil)
+
+	userHandler := handler.NewUserHandler(logger.NewDiscardLogger(), mockService)
+	principal := http_server.Principal{UserID: userID}
+	e := setupServer(t, userHandler, principal)
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/users/"+userID.String(), nil)
+	req.Header.Set(echo.HeaderAuthorization, "Bearer valid-token")
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	// The principal must be available to the service through the request context
+	assert.Equal(t, 1, mockService.GetUserByIDCallCount())
+	ctx, _ := mockService.GetUserByIDArgsForCall(0)
+	actualPrincipal, ok := http_server.PrincipalFromContext(ctx)
+	assert.True(t, ok)
+	assert.Equal(t, principal, actualPrincipal)
+}
+
+func TestUserHandler_PublicRoute_WithoutToken(t *testing.T) {
+	mockService := &servicefakes.FakeUserService{}
+	userHandler := handler.NewUserHandler(logger.NewDiscardLogger(), mockService)
+	e := setupServer(t, userHandler, http_server.Principal{})
+
+	body, err := json.Marshal(service.CreateUserRequest{
+		Name:     "John Doe",
+		Email:    "john@example.com",
+		Password: "password123",
+	})
+	require.NoError(t, err)
+
+	req := httptest.NewRequest(http.MethodPost, "/v1/users", bytes.NewBuffer(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusCreated, rec.Code)
+	assert.Equal(t, 1, mockService.CreateUserCallCount())
+}
+
+func TestUserHandler_ListUsers_Forbidden(t *testing.T) {
+	mockService := &servicefakes.FakeUserService{}
+	userHandler := handler.NewUserHandler(logger.NewDiscardLogger(), mockService)
+	// Listing users exposes every email address, so it needs the users:manage permission
+	e := setupServer(t, userHandler, http_server.Principal{UserID: uuid.New(), Roles: []string{rbac.RoleAuthor}})
+
+	for _, path := range []string{"/v1/users", "/v2/users"} {
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		req.Header.Set(echo.HeaderAuthorization, "Bearer valid-token")
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusForbidden, rec.Code, path)
+	}
+	assert.Equal(t, 0, mockService.ListUsersCallCount())
+}
+
+func TestUserHandler_GetUser_Forbidden(t *testing.T) {
+	mockService := &servicefakes.FakeUserService{}
+	mockService.GetUserByIDReturns(service.GetUserResponse{}, service.ErrUserForbidden)
+	mockService.GetUserProfileReturns(service.UserProfileResponse{}, service.ErrUserForbidden)
+	userHandler := handler.NewUserHandler(logger.NewDiscardLogger(), mockService)
+	e := setupServer(t, userHandler, http_server.Principal{UserID: uuid.New(), Roles: []string{rbac.RoleAuthor}})
+
+	id := uuid.NewString()
+	for _, path := range []string{"/v1/users/" + id, "/v2/users/" + id, "/v2/users/" + id + "/profile"} {
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		req.Header.Set(echo.HeaderAuthorization, "Bearer valid-token")
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusForbidden, rec.Code, path)
+	}
+}
@@ -0,0 +1,140 @@
+package handler
+
+import (
+	"log/slog"
+
+	"github.com/fikryfahrezy/let-it-go/feature/user/service"
+	"github.com/fikryfahrezy/let-it-go/pkg/http_server"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+// setupTokenRoutes configures v1 personal access token routes. Personal access tokens
+// themselves are rejected here, so a leaked token cannot create or revoke tokens. Admins
+// impersonating the owner can list the tokens but not create or revoke them.
+func (h *UserHandler) setupTokenRoutes(server *http_server.Server) {
+	tokens := server.Echo().Group("/v1/users/:id/tokens", http_server.RequireAuth())
+	tokens.POST("", h.CreatePersonalAccessToken, http_server.ForbidImpersonation())
+	tokens.GET("", h.ListPersonalAccessTokens)
+	tokens.DELETE("/:token_id", h.RevokePersonalAccessToken, http_server.ForbidImpersonation())
+}
+
+// CreatePersonalAccessToken creates a personal access token
+// @Summary Create a personal access token
+// @Description Create a named long-lived token restricted to scopes, for automation. Send it as a bearer token. The token is only returned once. Users can only create tokens for themselves.
+// @Tags users
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "User ID"
+// @Param request body service.CreatePersonalAccessTokenRequest true "Token name, scopes and optional expiry"
+// @Success 201 {object} http_server.APIResponse{result=service.CreatePersonalAccessTokenResponse}
+// @Failure 400 {object} http_server.APIResponse
+// @Failure 401 {object} http_server.APIResponse
+// @Failure 403 {object} http_server.APIResponse
+// @Failure 422 {object} http_server.APIResponse
+// @Failure 500 {object} http_server.APIResponse
+// @Router /v1/users/{id}/tokens [post]
+func (h *UserHandler) CreatePersonalAccessToken(c echo.Context) error {
+	idParam := c.Param("id")
+	id, err := uuid.Parse(idParam)
+	if err != nil {
+		h.log.Warn("Invalid user ID parameter",
+			slog.String("id", idParam),
+		)
+		return http_server.BadRequestResponse(c, "Invalid user UUID format", err)
+	}
+
+	var req service.CreatePersonalAccessTokenRequest
+	if err := c.Bind(&req); err != nil {
+		h.log.Error("Failed to bind request",
+			slog.String("error", err.Error()),
+		)
+		return http_server.BadRequestResponse(c, "Invalid request format", err)
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return http_server.HandleValidationError(c, err)
+	}
+
+	result, err := h.userService.CreatePersonalAccessToken(c.Request().Context(), id, req)
+	if err != nil {
+		return h.translateServiceError(c, err, "Failed to create personal access token")
+	}
+
+	return http_server.CreatedResponse(c, "Personal access token created successfully", result)
+}
+
+// ListPersonalAccessTokens lists the personal access tokens of a user
+// @Summary List personal access tokens
+// @Description List the unrevoked personal access tokens of a user, including when each was last used. The tokens themselves are never returned.
+// @Tags users
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "User ID"
+// @Success 200 {object} http_server.APIResponse{result=[]service.PersonalAccessTokenResponse}
+// @Failure 400 {object} http_server.APIResponse
+// @Failure 401 {object} http_server.APIResponse
+// @Failure 403 {object} http_server.APIResponse
+// @Failure 500 {object} http_server.APIResponse
+// @Router /v1/users/{id}/tokens [get]
+func (h *UserHandler) ListPersonalAccessTokens(c echo.Context) error {
+	idParam := c.Param("id")
+	id, err := uuid.Parse(idParam)
+	if err != nil {
+		h.log.Warn("Invalid user ID parameter",
+			slog.String("id", idParam),
+		)
+		return http_server.BadRequestResponse(c, "Invalid user UUID format", err)
+	}
+
+	tokens, err := h.userService.ListPersonalAccessTokens(c.Request().Context(), id)
+	if err != nil {
+		return h.translateServiceError(c, err, "Failed to list personal access tokens")
+	}
+
+	return http_server.SuccessResponse(c, "Personal access tokens retrieved successfully", tokens)
+}
+
+// RevokePersonalAccessToken revokes a personal access token
+// @Summary Revoke a personal access token
+// @Description Revoke a personal access token of a user. It stops working immediately.
+// @Tags users
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "User ID"
+// @Param token_id path string true "Personal access token ID"
+// @Success 200 {object} http_server.APIResponse
+// @Failure 400 {object} http_server.APIResponse
+// @Failure 401 {object} http_server.APIResponse
+// @Failure 403 {object} http_server.APIResponse
+// @Failure 404 {object} http_server.APIResponse
+// @Failure 500 {object} http_server.APIResponse
+// @Router /v1/users/{id}/tokens/{token_id} [delete]
+func (h *UserHandler) RevokePersonalAccessToken(c echo.Context) error {
+	idParam := c.Param("id")
+	id, err := uuid.Parse(idParam)
+	if err != nil {
+		h.log.Warn("Invalid user ID parameter",
+			slog.String("id", idParam),
+		)
+		return http_server.BadRequestResponse(c, "Invalid user UUID format", err)
+	}
+
+	tokenIDParam := c.Param("token_id")
+	tokenID, err := uuid.Parse(tokenIDParam)
+	if err != nil {
+		h.log.Warn("Invalid token ID parameter",
+			slog.String("token_id", tokenIDParam),
+		)
+		return http_server.BadRequestResponse(c, "Invalid token UUID format", err)
+	}
+
+	if err := h.userService.RevokePersonalAccessToken(c.Request().Context(), id, tokenID); err != nil {
+		return h.translateServiceError(c, err, "Failed to revoke personal access token")
+	}
+
+	return http_server.SuccessResponse(c, "Personal access token revoked successfully", nil)
+}
@@ -0,0 +1,195 @@
+package handler_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/fikryfahrezy/let-it-go/feature/user/handler"
+	"github.com/fikryfahrezy/let-it-go/feature/user/repository"
+	"github.com/fikryfahrezy/let-it-go/feature/user/service"
+	"github.com/fikryfahrezy/let-it-go/feature/user/service/servicefakes"
+	"github.com/fikryfahrezy/let-it-go/pkg/http_server"
+	"github.com/fikryfahrezy/let-it-go/pkg/logger"
+	"github.com/fikryfahrezy/let-it-go/pkg/rbac"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUserHandler_CreatePersonalAccessToken_Success(t *testing.T) {
+	mockService := &servicefakes.FakeUserService{}
+	userID := uuid.New()
+	mockService.CreatePersonalAccessTokenReturns(service.CreatePersonalAccessTokenResponse{
+		PersonalAccessTokenResponse: service.PersonalAccessTokenResponse{ID: uuid.New(), Name: "ci", Scopes: []string{rbac.ScopeBlogsWrite}},
+		Token:                       service.PersonalAccessTokenPrefix + "secret",
+	}, nil)
+
+	userHandler := handler.NewUserHandler(logger.NewDiscardLogger(), mockService)
+	e := setupServer(t, userHandler, http_server.Principal{UserID: userID, Roles: []string{rbac.RoleAuthor}})
+
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, newJSONRequest(t, http.MethodPost, "/v1/users/"+userID.String()+"/tokens", service.CreatePersonalAccessTokenRequest{
+		Name:   "ci",
+		Scopes: []string{rbac.ScopeBlogsWrite},
+	}))
+
+	assert.Equal(t, http.StatusCreated, rec.Code)
+	assert.Contains(t, rec.Body.String(), service.PersonalAccessTokenPrefix+"secret")
+
+	require.Equal(t, 1, mockService.CreatePersonalAccessTokenCallCount())
+	_, actualID, actualReq := mockService.CreatePersonalAccessTokenArgsForCall(0)
+	assert.Equal(t, userID, actualID)
+	assert.Equal(t, []string{rbac.ScopeBlogsWrite}, actualReq.Scopes)
+}
+
+func TestUserHandler_CreatePersonalAccessToken_UnknownScope(t *testing.T) {
+	mockService := &servicefakes.FakeUserService{}
+	userID := uuid.New()
+	userHandler := handler.NewUserHandler(logger.NewDiscardLogger(), mockService)
+	e := setupServer(t, userHandler, http_server.Principal{UserID: userID, Roles: []string{rbac.RoleAuthor}})
+
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, newJSONRequest(t, http.MethodPost, "/v1/users/"+userID.String()+"/tokens", service.CreatePersonalAccessTokenRequest{
+		Name:   "ci",
+		Scopes: []string{"users:manage"},
+	}))
+
+	assert.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+	assert.Equal(t, 0, mockService.CreatePersonalAccessTokenCallCount())
+}
+
+func TestUserHandler_CreatePersonalAccessToken_WithPersonalAccessToken(t *testing.T) {
+	mockService := &servicefakes.FakeUserService{}
+	userID := uuid.New()
+	userHandler := handler.NewUserHandler(logger.NewDiscardLogger(), mockService)
+	e := setupServer(t, userHandler, http_server.Principal{
+		UserID: userID,
+		Roles:  []string{rbac.RoleAuthor},
+		Scopes: []string{rbac.ScopeBlogsWrite, rbac.ScopeUsersRead},
+	})
+
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, newJSONRequest(t, http.MethodPost, "/v1/users/"+userID.String()+"/tokens", service.CreatePersonalAccessTokenRequest{
+		Name:   "ci",
+		Scopes: []string{rbac.ScopeBlogsWrite},
+	}))
+
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+	assert.Equal(t, 0, mockService.CreatePersonalAccessTokenCallCount())
+
+	var response http_server.APIResponse
+	err := json.Unmarshal(rec.Body.Bytes(), &response)
+	require.NoError(t, err)
+	assert.Equal(t, http_server.ErrInsufficientScope.Code, response.Error)
+}
+
+func TestUserHandler_ListPersonalAccessTokens_Success(t *testing.T) {
+	mockService := &servicefakes.FakeUserService{}
+	userID := uuid.New()
+	mockService.ListPersonalAccessTokensReturns([]service.PersonalAccessTokenResponse{{ID: uuid.New(), Name: "ci"}}, nil)
+
+	userHandler := handler.NewUserHandler(logger.NewDiscardLogger(), mockService)
+	e := setupServer(t, userHandler, http_server.Principal{UserID: userID, Roles: []string{rbac.RoleAuthor}})
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/users/"+userID.String()+"/tokens", nil)
+	req.Header.Set(echo.HeaderAuthorization, "Bearer valid-token")
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, 1, mockService.ListPersonalAccessTokensCallCount())
+	_, actualID := mockService.ListPersonalAccessTokensArgsForCall(0)
+	assert.Equal(t, userID, actualID)
+}
+
+func TestUserHandler_RevokePersonalAccessToken_NotFound(t *testing.T) {
+	mockService := &servicefakes.FakeUserService{}
+	userID := uuid.New()
+	mockService.RevokePersonalAccessTokenReturns(repository.ErrPersonalAccessTokenNotFound)
+
+	userHandler := handler.NewUserHandler(logger.NewDiscardLogger(), mockService)
+	e := setupServer(t, userHandler, http_server.Principal{UserID: userID, Roles: []string{rbac.RoleAuthor}})
+
+	tokenID := uuid.New()
+	req := httptest.NewRequest(http.MethodDelete, "/v1/users/"+userID.String()+"/tokens/"+tokenID.String(), nil)
+	req.Header.Set(echo.HeaderAuthorization, "Bearer valid-token")
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+	require.Equal(t, 1, mockService.RevokePersonalAccessTokenCallCount())
+	_, actualUserID, actualTokenID := mockService.RevokePersonalAccessTokenArgsForCall(0)
+	assert.Equal(t, userID, actualUserID)
+	assert.Equal(t, tokenID, actualTokenID)
+}
+
+func TestUserHandler_PersonalAccessTokenScopes(t *testing.T) {
+	userID := uuid.New()
+
+	tests := []struct {
+		name         string
+		scopes       []string
+		method       string
+		path         string
+		expectedCode int
+	}{
+		{
+			name:         "UsersReadCanGetUser",
+			scopes:       []string{rbac.ScopeUsersRead},
+			method:       http.MethodGet,
+			path:         "/v1/users/" + userID.String(),
+			expectedCode: http.StatusOK,
+		},
+		{
+			name:         "UsersReadCanListUsers",
+			scopes:       []string{rbac.ScopeUsersRead},
+			method:       http.MethodGet,
+			path:         "/v1/users",
+			expectedCode: http.StatusOK,
+		},
+		{
+			name:         "BlogsWriteCannotGetUser",
+			scopes:       []string{rbac.ScopeBlogsWrite},
+			method:       http.MethodGet,
+			path:         "/v1/users/" + userID.String(),
+			expectedCode: http.StatusForbidden,
+		},
+		{
+			name:         "UsersReadCannotDeleteUser",
+			scopes:       []string{rbac.ScopeUsersRead},
+			method:       http.MethodDelete,
+			path:         "/v1/users/" + userID.String(),
+			expectedCode: http.StatusForbidden,
+		},
+		{
+			name:         "UsersReadCannotListSessions",
+			scopes:       []string{rbac.ScopeUsersRead},
+			method:       http.MethodGet,
+			path:         "/v1/users/" + userID.String() + "/sessions",
+			expectedCode: http.StatusForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := &servicefakes.FakeUserService{}
+			userHandler := handler.NewUserHandler(logger.NewDiscardLogger(), mockService)
+			e := setupServer(t, userHandler, http_server.Principal{UserID: userID, Roles: []string{rbac.RoleAdmin}, Scopes: tt.scopes})
+
+			req := httptest.NewRequest(tt.method, tt.path, nil)
+			req.Header.Set(echo.HeaderAuthorization, "Bearer valid-token")
+			rec := httptest.NewRecorder()
+			e.ServeHTTP(rec, req)
+
+			assert.Equal(t, tt.expectedCode, rec.Code)
+			if tt.expectedCode == http.StatusForbidden {
+				assert.Equal(t, 0, mockService.DeleteUserCallCount())
+				assert.Equal(t, 0, mockService.GetUserByIDCallCount())
+				assert.Equal(t, 0, mockService.ListSessionsCallCount())
+			}
+		})
+	}
+}
@@ -0,0 +1,182 @@
+package handler
+
+import (
+	"log/slog"
+
+	"github.com/fikryfahrezy/let-it-go/feature/user/service"
+	"github.com/fikryfahrezy/let-it-go/pkg/http_server"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+// setupTwoFactorRoutes configures v1 two factor management routes. Personal access
+// tokens and impersonating admins are rejected, so neither can change how the owner logs in.
+func (h *UserHandler) setupTwoFactorRoutes(server *http_server.Server) {
+	twoFactor := server.Echo().Group("/v1/users/:id/2fa", http_server.RequireAuth(), http_server.ForbidImpersonation())
+	twoFactor.POST("", h.EnrollTwoFactor)
+	twoFactor.POST("/confirm", h.ConfirmTwoFactor)
+	twoFactor.POST("/disable", h.DisableTwoFactor)
+}
+
+// EnrollTwoFactor starts two factor enrollment
+// @Summary Start two factor enrollment
+// @Description Generate a new TOTP secret and its otpauth URI for an authenticator app. Two factor authentication is only enabled once a first code is confirmed; enrolling again before that replaces the secret. Users can only enroll themselves.
+// @Tags users
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "User ID"
+// @Success 200 {object} http_server.APIResponse{result=service.EnrollTwoFactorResponse}
+// @Failure 400 {object} http_server.APIResponse
+// @Failure 401 {object} http_server.APIResponse
+// @Failure 403 {object} http_server.APIResponse
+// @Failure 500 {object} http_server.APIResponse
+// @Failure 503 {object} http_server.APIResponse
+// @Router /v1/users/{id}/2fa [post]
+func (h *UserHandler) EnrollTwoFactor(c echo.Context) error {
+	idParam := c.Param("id")
+	id, err := uuid.Parse(idParam)
+	if err != nil {
+		h.log.Warn("Invalid user ID parameter",
+			slog.String("id", idParam),
+		)
+		return http_server.BadRequestResponse(c, "Invalid user UUID format", err)
+	}
+
+	result, err := h.userService.EnrollTwoFactor(c.Request().Context(), id)
+	if err != nil {
+		return h.translateServiceError(c, err, "Failed to start two factor enrollment")
+	}
+
+	return http_server.SuccessResponse(c, "Two factor enrollment started", result)
+}
+
+// ConfirmTwoFactor enables two factor authentication
+// @Summary Confirm two factor enrollment
+// @Description Enable two factor authentication with a first code from the authenticator app. Returns ten single-use recovery codes, which are only shown once.
+// @Tags users
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "User ID"
+// @Param request body service.ConfirmTwoFactorRequest true "Code from the authenticator app"
+// @Success 200 {object} http_server.APIResponse{result=service.ConfirmTwoFactorResponse}
+// @Failure 400 {object} http_server.APIResponse
+// @Failure 401 {object} http_server.APIResponse
+// @Failure 403 {object} http_server.APIResponse
+// @Failure 422 {object} http_server.APIResponse
+// @Failure 500 {object} http_server.APIResponse
+// @Failure 503 {object} http_server.APIResponse
+// @Router /v1/users/{id}/2fa/confirm [post]
+func (h *UserHandler) ConfirmTwoFactor(c echo.Context) error {
+	idParam := c.Param("id")
+	id, err := uuid.Parse(idParam)
+	if err != nil {
+		h.log.Warn("Invalid user ID parameter",
+			slog.String("id", idParam),
+		)
+		return http_server.BadRequestResponse(c, "Invalid user UUID format", err)
+	}
+
+	var req service.ConfirmTwoFactorRequest
+	if err := c.Bind(&req); err != nil {
+		h.log.Error("Failed to bind request",
+			slog.String("error", err.Error()),
+		)
+		return http_server.BadRequestResponse(c, "Invalid request format", err)
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return http_server.HandleValidationError(c, err)
+	}
+
+	result, err := h.userService.ConfirmTwoFactor(c.Request().Context(), id, req)
+	if err != nil {
+		return h.translateServiceError(c, err, "Failed to confirm two factor enrollment")
+	}
+
+	return http_server.SuccessResponse(c, "Two factor authentication enabled", result)
+}
+
+// DisableTwoFactor turns two factor authentication off
+// @Summary Disable two factor authentication
+// @Description Disable two factor authentication and delete the recovery codes. Users confirm with their password; admins can disable it for another user without one.
+// @Tags users
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "User ID"
+// @Param request body service.DisableTwoFactorRequest true "Current password"
+// @Success 200 {object} http_server.APIResponse
+// @Failure 400 {object} http_server.APIResponse
+// @Failure 401 {object} http_server.APIResponse
+// @Failure 403 {object} http_server.APIResponse
+// @Failure 404 {object} http_server.APIResponse
+// @Failure 429 {object} http_server.APIResponse
+// @Failure 500 {object} http_server.APIResponse
+// @Router /v1/users/{id}/2fa/disable [post]
+func (h *UserHandler) DisableTwoFactor(c echo.Context) error {
+	idParam := c.Param("id")
+	id, err := uuid.Parse(idParam)
+	if err != nil {
+		h.log.Warn("Invalid user ID parameter",
+			slog.String("id", idParam),
+		)
+		return http_server.BadRequestResponse(c, "Invalid user UUID format", err)
+	}
+
+	var req service.DisableTwoFactorRequest
+	if err := c.Bind(&req); err != nil {
+		h.log.Error("Failed to bind request",
+			slog.String("error", err.Error()),
+		)
+		return http_server.BadRequestResponse(c, "Invalid request format", err)
+	}
+
+	req.IPAddress = c.RealIP()
+
+	if err := h.userService.DisableTwoFactor(c.Request().Context(), id, req); err != nil {
+		return h.translateServiceError(c, err, "Failed to disable two factor authentication")
+	}
+
+	return http_server.SuccessResponse(c, "Two factor authentication disabled", nil)
+}
+
+// VerifyTwoFactor completes a login that requires a second factor
+// @Summary Verify the second factor
+// @Description Finish logging in with the challenge token from login and either a code from the authenticator app or a recovery code. Each code works once. Wrong codes count towards the account lockout.
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Param request body service.VerifyTwoFactorRequest true "Challenge token and code"
+// @Success 200 {object} http_server.APIResponse{result=service.AuthenticateResponse}
+// @Failure 400 {object} http_server.APIResponse
+// @Failure 401 {object} http_server.APIResponse
+// @Failure 422 {object} http_server.APIResponse
+// @Failure 429 {object} http_server.APIResponse
+// @Failure 500 {object} http_server.APIResponse
+// @Failure 503 {object} http_server.APIResponse
+// @Router /v1/auth/2fa/verify [post]
+func (h *UserHandler) VerifyTwoFactor(c echo.Context) error {
+	var req service.VerifyTwoFactorRequest
+	if err := c.Bind(&req); err != nil {
+		h.log.Error("Failed to bind request",
+			slog.String("error", err.Error()),
+		)
+		return http_server.BadRequestResponse(c, "Invalid request format", err)
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return http_server.HandleValidationError(c, err)
+	}
+
+	req.UserAgent = c.Request().UserAgent()
+	req.IPAddress = c.RealIP()
+
+	result, err := h.userService.VerifyTwoFactor(c.Request().Context(), req)
+	if err != nil {
+		return h.translateServiceError(c, err, "Failed to verify two factor code")
+	}
+
+	return http_server.SuccessResponse(c, "Logged in successfully", result)
+}
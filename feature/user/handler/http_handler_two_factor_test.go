@@ -0,0 +1,251 @@
+package handler_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/fikryfahrezy/let-it-go/feature/user/handler"
+	"github.com/fikryfahrezy/let-it-go/feature/user/repository"
+	"github.com/fikryfahrezy/let-it-go/feature/user/service"
+	"github.com/fikryfahrezy/let-it-go/feature/user/service/servicefakes"
+	"github.com/fikryfahrezy/let-it-go/pkg/http_server"
+	"github.com/fikryfahrezy/let-it-go/pkg/logger"
+	"github.com/fikryfahrezy/let-it-go/pkg/rbac"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUserHandler_EnrollTwoFactor_Success(t *testing.T) {
+	mockService := &servicefakes.FakeUserService{}
+	mockService.EnrollTwoFactorReturns(service.EnrollTwoFactorResponse{
+		Secret:     "JBSWY3DPEHPK3PXP",
+		OTPAuthURI: "otpauth://totp/Let%20It%20Go:john@example.com?secret=JBSWY3DPEHPK3PXP",
+	}, nil)
+
+	userID := uuid.New()
+	userHandler := handler.NewUserHandler(logger.NewDiscardLogger(), mockService)
+	e := setupServer(t, userHandler, http_server.Principal{UserID: userID, Roles: []string{rbac.RoleEditor}})
+
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, newJSONRequest(t, http.MethodPost, "/v1/users/"+userID.String()+"/2fa", nil))
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), "otpauth://totp/")
+
+	require.Equal(t, 1, mockService.EnrollTwoFactorCallCount())
+	_, actualID := mockService.EnrollTwoFactorArgsForCall(0)
+	assert.Equal(t, userID, actualID)
+}
+
+func TestUserHandler_EnrollTwoFactor_WithPersonalAccessToken(t *testing.T) {
+	mockService := &servicefakes.FakeUserService{}
+	userID := uuid.New()
+	userHandler := handler.NewUserHandler(logger.NewDiscardLogger(), mockService)
+	e := setupServer(t, userHandler, http_server.Principal{UserID: userID, Roles: []string{rbac.RoleEditor}, Scopes: []string{rbac.ScopeUsersRead}})
+
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, newJSONRequest(t, http.MethodPost, "/v1/users/"+userID.String()+"/2fa", nil))
+
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+	assert.Equal(t, 0, mockService.EnrollTwoFactorCallCount())
+}
+
+func TestUserHandler_EnrollTwoFactor_Unavailable(t *testing.T) {
+	mockService := &servicefakes.FakeUserService{}
+	mockService.EnrollTwoFactorReturns(service.EnrollTwoFactorResponse{}, service.ErrTwoFactorUnavailable)
+
+	userID := uuid.New()
+	userHandler := handler.NewUserHandler(logger.NewDiscardLogger(), mockService)
+	e := setupServer(t, userHandler, http_server.Principal{UserID: userID, Roles: []string{rbac.RoleEditor}})
+
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, newJSONRequest(t, http.MethodPost, "/v1/users/"+userID.String()+"/2fa", nil))
+
+	assert.Equal(t, http.StatusServiceUnavailable, rec.Code)
+}
+
+func TestUserHandler_ConfirmTwoFactor_Success(t *testing.T) {
+	mockService := &servicefakes.FakeUserService{}
+	mockService.ConfirmTwoFactorReturns(service.ConfirmTwoFactorResponse{RecoveryCodes: []string{"abcde-fghij"}}, nil)
+
+	userID := uuid.New()
+	userHandler := handler.NewUserHandler(logger.NewDiscardLogger(), mockService)
+	e := setupServer(t, userHandler, http_server.Principal{UserID: userID, Roles: []string{rbac.RoleEditor}})
+
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, newJSONRequest(t, http.MethodPost, "/v1/users/"+userID.String()+"/2fa/confirm", service.ConfirmTwoFactorRequest{Code: "123456"}))
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), "abcde-fghij")
+
+	require.Equal(t, 1, mockService.ConfirmTwoFactorCallCount())
+	_, actualID, actualReq := mockService.ConfirmTwoFactorArgsForCall(0)
+	assert.Equal(t, userID, actualID)
+	assert.Equal(t, "123456", actualReq.Code)
+}
+
+func TestUserHandler_ConfirmTwoFactor_InvalidCode(t *testing.T) {
+	mockService := &servicefakes.FakeUserService{}
+	mockService.ConfirmTwoFactorReturns(service.ConfirmTwoFactorResponse{}, service.ErrInvalidTwoFactorCode)
+
+	userID := uuid.New()
+	userHandler := handler.NewUserHandler(logger.NewDiscardLogger(), mockService)
+	e := setupServer(t, userHandler, http_server.Principal{UserID: userID, Roles: []string{rbac.RoleEditor}})
+
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, newJSONRequest(t, http.MethodPost, "/v1/users/"+userID.String()+"/2fa/confirm", service.ConfirmTwoFactorRequest{Code: "000000"}))
+
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+	var response http_server.APIResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	assert.Equal(t, "USER-INVALID_TWO_FACTOR_CODE", response.Error)
+}
+
+func TestUserHandler_ConfirmTwoFactor_MissingCode(t *testing.T) {
+	mockService := &servicefakes.FakeUserService{}
+	userID := uuid.New()
+	userHandler := handler.NewUserHandler(logger.NewDiscardLogger(), mockService)
+	e := setupServer(t, userHandler, http_server.Principal{UserID: userID, Roles: []string{rbac.RoleEditor}})
+
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, newJSONRequest(t, http.MethodPost, "/v1/users/"+userID.String()+"/2fa/confirm", service.ConfirmTwoFactorRequest{}))
+
+	assert.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+	assert.Equal(t, 0, mockService.ConfirmTwoFactorCallCount())
+}
+
+func TestUserHandler_DisableTwoFactor_Success(t *testing.T) {
+	mockService := &servicefakes.FakeUserService{}
+	userID := uuid.New()
+	userHandler := handler.NewUserHandler(logger.NewDiscardLogger(), mockService)
+	e := setupServer(t, userHandler, http_server.Principal{UserID: userID, Roles: []string{rbac.RoleEditor}})
+
+	req := newJSONRequest(t, http.MethodPost, "/v1/users/"+userID.String()+"/2fa/disable", service.DisableTwoFactorRequest{Password: "password123"})
+	req.Header.Set(echo.HeaderXRealIP, "10.0.0.1")
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	require.Equal(t, 1, mockService.DisableTwoFactorCallCount())
+	_, actualID, actualReq := mockService.DisableTwoFactorArgsForCall(0)
+	assert.Equal(t, userID, actualID)
+	assert.Equal(t, "password123", actualReq.Password)
+	assert.Equal(t, "10.0.0.1", actualReq.IPAddress)
+}
+
+func TestUserHandler_DisableTwoFactor_NotEnabled(t *testing.T) {
+	mockService := &servicefakes.FakeUserService{}
+	mockService.DisableTwoFactorReturns(service.ErrTwoFactorNotEnabled)
+
+	userID := uuid.New()
+	userHandler := handler.NewUserHandler(logger.NewDiscardLogger(), mockService)
+	e := setupServer(t, userHandler, http_server.Principal{UserID: userID, Roles: []string{rbac.RoleEditor}})
+
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, newJSONRequest(t, http.MethodPost, "/v1/users/"+userID.String()+"/2fa/disable", service.DisableTwoFactorRequest{Password: "password123"}))
+
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+}
+
+func TestUserHandler_Login_TwoFactorChallenge(t *testing.T) {
+	mockService := &servicefakes.FakeUserService{}
+	expiresAt := time.Now().Add(5 * time.Minute)
+	mockService.AuthenticateReturns(service.AuthenticateResponse{
+		TwoFactorChallenge: &service.TwoFactorChallengeResponse{
+			TwoFactorRequired: true,
+			ChallengeToken:    "challenge",
+			ExpiresAt:         expiresAt,
+		},
+	}, nil)
+
+	userHandler := handler.NewUserHandler(logger.NewDiscardLogger(), mockService)
+	e := setupServer(t, userHandler, http_server.Principal{})
+
+	req := newJSONRequest(t, http.MethodPost, "/v1/auth/login", service.AuthenticateRequest{Email: "john@example.com", Password: "password123"})
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	// Only the challenge is returned, without empty token fields
+	var response struct {
+		Result map[string]any `json:"result"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	assert.Equal(t, true, response.Result["two_factor_required"])
+	assert.Equal(t, "challenge", response.Result["challenge_token"])
+	assert.NotContains(t, response.Result, "access_token")
+}
+
+func TestUserHandler_VerifyTwoFactor_Success(t *testing.T) {
+	mockService := &servicefakes.FakeUserService{}
+	mockService.VerifyTwoFactorReturns(service.AuthenticateResponse{
+		AccessToken:  "access-token",
+		TokenType:    service.TokenTypeBearer,
+		RefreshToken: "refresh-token",
+	}, nil)
+
+	userHandler := handler.NewUserHandler(logger.NewDiscardLogger(), mockService)
+	e := setupServer(t, userHandler, http_server.Principal{})
+
+	req := newJSONRequest(t, http.MethodPost, "/v1/auth/2fa/verify", service.VerifyTwoFactorRequest{ChallengeToken: "challenge", Code: "123456"})
+	req.Header.Del(echo.HeaderAuthorization)
+	req.Header.Set("User-Agent", "test-agent")
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), "access-token")
+
+	require.Equal(t, 1, mockService.VerifyTwoFactorCallCount())
+	_, actualReq := mockService.VerifyTwoFactorArgsForCall(0)
+	assert.Equal(t, "challenge", actualReq.ChallengeToken)
+	assert.Equal(t, "123456", actualReq.Code)
+	assert.Equal(t, "test-agent", actualReq.UserAgent)
+}
+
+func TestUserHandler_VerifyTwoFactor_MissingCode(t *testing.T) {
+	mockService := &servicefakes.FakeUserService{}
+	userHandler := handler.NewUserHandler(logger.NewDiscardLogger(), mockService)
+	e := setupServer(t, userHandler, http_server.Principal{})
+
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, newJSONRequest(t, http.MethodPost, "/v1/auth/2fa/verify", service.VerifyTwoFactorRequest{ChallengeToken: "challenge"}))
+
+	assert.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+	assert.Equal(t, 0, mockService.VerifyTwoFactorCallCount())
+}
+
+func TestUserHandler_VerifyTwoFactor_InvalidChallenge(t *testing.T) {
+	mockService := &servicefakes.FakeUserService{}
+	mockService.VerifyTwoFactorReturns(service.AuthenticateResponse{}, service.ErrInvalidTwoFactorChallenge)
+
+	userHandler := handler.NewUserHandler(logger.NewDiscardLogger(), mockService)
+	e := setupServer(t, userHandler, http_server.Principal{})
+
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, newJSONRequest(t, http.MethodPost, "/v1/auth/2fa/verify", service.VerifyTwoFactorRequest{ChallengeToken: "expired", RecoveryCode: "abcde-fghij"}))
+
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+}
+
+func TestUserHandler_EnrollTwoFactor_AlreadyEnabled(t *testing.T) {
+	mockService := &servicefakes.FakeUserService{}
+	mockService.EnrollTwoFactorReturns(service.EnrollTwoFactorResponse{}, repository.ErrTwoFactorAlreadyEnabled)
+
+	userID := uuid.New()
+	userHandler := handler.NewUserHandler(logger.NewDiscardLogger(), mockService)
+	e := setupServer(t, userHandler, http_server.Principal{UserID: userID, Roles: []string{rbac.RoleEditor}})
+
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, newJSONRequest(t, http.MethodPost, "/v1/users/"+userID.String()+"/2fa", nil))
+
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+}
@@ -1,7 +1,12 @@
 package handler
 
 import (
+	"log/slog"
+
+	"github.com/fikryfahrezy/let-it-go/feature/user/service"
 	"github.com/fikryfahrezy/let-it-go/pkg/http_server"
+	"github.com/fikryfahrezy/let-it-go/pkg/rbac"
+	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
 )
 
@@ -9,30 +14,95 @@ import (
 func (h *UserHandler) setupV2Routes(server *http_server.Server) {
 	users := server.Echo().Group("/v2/users")
 	users.POST("", h.CreateUser)
-	users.GET("", h.ListUsers)
-	users.GET("/:id", h.GetUser)
-	users.PUT("/:id", h.UpdateUser)
-	users.DELETE("/:id", h.DeleteUser)
+	users.GET("", h.ListUsers, http_server.RequireScope(rbac.ScopeUsersRead), rbac.RequirePermission(rbac.PermissionUsersManage))
+	users.GET("/:id", h.GetUser, http_server.RequireScope(rbac.ScopeUsersRead))
+	users.PUT("/:id", h.UpdateUser, http_server.RequireAuth(), http_server.ForbidImpersonation())
+	users.DELETE("/:id", h.DeleteUser, http_server.RequireAuth(), http_server.ForbidImpersonation())
 
 	// v2 specific endpoints
-	users.GET("/:id/profile", h.GetUserProfile)
-	users.POST("/batch", h.BatchUserOperations)
+	users.GET("/:id/profile", h.GetUserProfile, http_server.RequireScope(rbac.ScopeUsersRead))
+	users.POST("/batch", h.BatchUserOperations, rbac.RequirePermission(rbac.PermissionUsersManage), http_server.ForbidImpersonation())
 }
 
-// GetUserProfile handles enhanced user profile endpoint for v2
+// GetUserProfile retrieves a user with a summary of their blogs and activity
+// @Summary Get a user profile
+// @Description Get a user with the number of their blogs per status, their most recently published posts and when they were last active. Users can only get their own profile unless they have the users:manage permission. Personal access tokens need the users:read scope.
+// @Tags users
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "User ID"
+// @Success 200 {object} http_server.APIResponse{result=service.UserProfileResponse}
+// @Failure 400 {object} http_server.APIResponse
+// @Failure 401 {object} http_server.APIResponse
+// @Failure 403 {object} http_server.APIResponse
+// @Failure 404 {object} http_server.APIResponse
+// @Failure 500 {object} http_server.APIResponse
+// @Router /v2/users/{id}/profile [get]
 func (h *UserHandler) GetUserProfile(c echo.Context) error {
-	data := map[string]any{
-		"user_id": c.Param("id"),
-		"version": "v2",
+	idParam := c.Param("id")
+	id, err := uuid.Parse(idParam)
+	if err != nil {
+		h.log.Warn("Invalid user ID parameter",
+			slog.String("id", idParam),
+		)
+		return http_server.BadRequestResponse(c, "Invalid user UUID format", err)
+	}
+
+	profile, err := h.userService.GetUserProfile(c.Request().Context(), id)
+	if err != nil {
+		return h.translateServiceError(c, err, "Failed to get user profile")
 	}
-	return http_server.SuccessResponse(c, "Enhanced user profile endpoint (v2)", data)
+
+	return http_server.SuccessResponse(c, "User profile retrieved successfully", profile)
 }
 
-// BatchUserOperations handles batch user operations for v2
+// BatchUserOperations creates, updates and deletes users in one request
+// @Summary Apply a batch of user operations
+// @Description Create, update and delete users in one request. In all_or_nothing mode every operation is applied in one transaction, or none is when one fails; in best_effort mode each operation is applied on its own. Every operation gets a result with its index, status, error code and validation errors. Requires the users:manage permission.
+// @Tags users
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param batch body service.BatchUserOperationsRequest true "Batch of user operations"
+// @Success 200 {object} http_server.APIResponse{result=service.BatchUserOperationsResponse}
+// @Failure 400 {object} http_server.APIResponse
+// @Failure 401 {object} http_server.APIResponse
+// @Failure 403 {object} http_server.APIResponse
+// @Failure 413 {object} http_server.APIResponse
+// @Failure 422 {object} http_server.APIResponse
+// @Failure 500 {object} http_server.APIResponse
+// @Router /v2/users/batch [post]
 func (h *UserHandler) BatchUserOperations(c echo.Context) error {
-	data := map[string]any{
-		"version": "v2",
-		"status":  "pending",
+	var req service.BatchUserOperationsRequest
+	if err := c.Bind(&req); err != nil {
+		h.log.Error("Failed to bind request",
+			slog.String("error", err.Error()),
+		)
+		return http_server.BadRequestResponse(c, "Invalid request format", err)
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return http_server.HandleValidationError(c, err)
 	}
-	return http_server.SuccessResponse(c, "Batch user operations (v2)", data)
+
+	// An invalid operation fails on its own instead of rejecting the whole batch
+	for i := range req.Operations {
+		err := c.Validate(&req.Operations[i])
+		if err == nil {
+			continue
+		}
+		ve, ok := err.(*http_server.ValidationError)
+		if !ok {
+			return err
+		}
+		req.Operations[i].ValidationErrors = ve.Fields
+	}
+
+	result, err := h.userService.BatchUserOperations(c.Request().Context(), req)
+	if err != nil {
+		return h.translateServiceError(c, err, "Failed to apply batch user operations")
+	}
+
+	return http_server.SuccessResponse(c, "Batch user operations applied", result)
 }
@@ -0,0 +1,211 @@
+package handler_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/fikryfahrezy/let-it-go/feature/user/handler"
+	"github.com/fikryfahrezy/let-it-go/feature/user/repository"
+	"github.com/fikryfahrezy/let-it-go/feature/user/service"
+	"github.com/fikryfahrezy/let-it-go/feature/user/service/servicefakes"
+	"github.com/fikryfahrezy/let-it-go/pkg/http_server"
+	"github.com/fikryfahrezy/let-it-go/pkg/logger"
+	"github.com/fikryfahrezy/let-it-go/pkg/rbac"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUserHandler_GetUserProfile_Success(t *testing.T) {
+	mockService := &servicefakes.FakeUserService{}
+	userID := uuid.New()
+	lastActiveAt := time.Now().UTC().Truncate(time.Second)
+	mockService.GetUserProfileReturns(service.UserProfileResponse{
+		User:         service.GetUserResponse{ID: userID, Name: "John Doe"},
+		BlogCounts:   map[string]int64{"draft": 1, "published": 2, "archived": 0},
+		RecentPosts:  []service.ProfilePostResponse{{ID: uuid.New(), Title: "Latest"}},
+		LastActiveAt: lastActiveAt,
+	}, nil)
+
+	userHandler := handler.NewUserHandler(logger.NewDiscardLogger(), mockService)
+	e := setupServer(t, userHandler, http_server.Principal{UserID: uuid.New()})
+
+	req := httptest.NewRequest(http.MethodGet, "/v2/users/"+userID.String()+"/profile", nil)
+	req.Header.Set(echo.HeaderAuthorization, "Bearer valid-token")
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	var response struct {
+		Result service.UserProfileResponse `json:"result"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	assert.Equal(t, userID, response.Result.User.ID)
+	assert.Equal(t, int64(2), response.Result.BlogCounts["published"])
+	require.Len(t, response.Result.RecentPosts, 1)
+	assert.Equal(t, "Latest", response.Result.RecentPosts[0].Title)
+	assert.True(t, lastActiveAt.Equal(response.Result.LastActiveAt))
+
+	_, actualID := mockService.GetUserProfileArgsForCall(0)
+	assert.Equal(t, userID, actualID)
+}
+
+func TestUserHandler_GetUserProfile_InvalidUUID(t *testing.T) {
+	mockService := &servicefakes.FakeUserService{}
+	userHandler := handler.NewUserHandler(logger.NewDiscardLogger(), mockService)
+	e := setupServer(t, userHandler, http_server.Principal{UserID: uuid.New()})
+
+	req := httptest.NewRequest(http.MethodGet, "/v2/users/not-a-uuid/profile", nil)
+	req.Header.Set(echo.HeaderAuthorization, "Bearer valid-token")
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Equal(t, 0, mockService.GetUserProfileCallCount())
+}
+
+func TestUserHandler_GetUserProfile_NotFound(t *testing.T) {
+	mockService := &servicefakes.FakeUserService{}
+	mockService.GetUserProfileReturns(service.UserProfileResponse{}, repository.ErrUserNotFound)
+	userHandler := handler.NewUserHandler(logger.NewDiscardLogger(), mockService)
+	e := setupServer(t, userHandler, http_server.Principal{UserID: uuid.New()})
+
+	req := httptest.NewRequest(http.MethodGet, "/v2/users/"+uuid.NewString()+"/profile", nil)
+	req.Header.Set(echo.HeaderAuthorization, "Bearer valid-token")
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+func TestUserHandler_GetUserProfile_WithoutToken(t *testing.T) {
+	mockService := &servicefakes.FakeUserService{}
+	userHandler := handler.NewUserHandler(logger.NewDiscardLogger(), mockService)
+	e := setupServer(t, userHandler, http_server.Principal{UserID: uuid.New()})
+
+	req := httptest.NewRequest(http.MethodGet, "/v2/users/"+uuid.NewString()+"/profile", nil)
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	assert.Equal(t, 0, mockService.GetUserProfileCallCount())
+}
+
+func TestUserHandler_BatchUserOperations_Success(t *testing.T) {
+	mockService := &servicefakes.FakeUserService{}
+	userID := uuid.New()
+	mockService.BatchUserOperationsReturns(service.BatchUserOperationsResponse{
+		Mode:      service.BatchModeBestEffort,
+		Succeeded: 1,
+		Results: []service.BatchUserOperationResult{
+			{Index: 0, Action: "delete", Status: service.BatchStatusSucceeded, UserID: &userID},
+		},
+	}, nil)
+	userHandler := handler.NewUserHandler(logger.NewDiscardLogger(), mockService)
+	e := setupServer(t, userHandler, http_server.Principal{UserID: uuid.New(), Roles: []string{rbac.RoleAdmin}})
+
+	body := `{"mode":"best_effort","operations":[{"action":"delete","id":"` + userID.String() + `"}]}`
+	req := httptest.NewRequest(http.MethodPost, "/v2/users/batch", strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	req.Header.Set(echo.HeaderAuthorization, "Bearer valid-token")
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	var response struct {
+		Result service.BatchUserOperationsResponse `json:"result"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	assert.Equal(t, 1, response.Result.Succeeded)
+	require.Len(t, response.Result.Results, 1)
+	assert.Equal(t, userID, *response.Result.Results[0].UserID)
+
+	require.Equal(t, 1, mockService.BatchUserOperationsCallCount())
+	_, actualReq := mockService.BatchUserOperationsArgsForCall(0)
+	assert.Equal(t, service.BatchModeBestEffort, actualReq.Mode)
+	require.Len(t, actualReq.Operations, 1)
+	assert.Equal(t, userID, actualReq.Operations[0].ID)
+	assert.Empty(t, actualReq.Operations[0].ValidationErrors)
+}
+
+func TestUserHandler_BatchUserOperations_InvalidOperation(t *testing.T) {
+	mockService := &servicefakes.FakeUserService{}
+	userHandler := handler.NewUserHandler(logger.NewDiscardLogger(), mockService)
+	e := setupServer(t, userHandler, http_server.Principal{UserID: uuid.New(), Roles: []string{rbac.RoleAdmin}})
+
+	body := `{"mode":"all_or_nothing","operations":[` +
+		`{"action":"create","create":{"name":"John Doe","email":"not-an-email","password":"password123"}},` +
+		`{"action":"delete"}]}`
+	req := httptest.NewRequest(http.MethodPost, "/v2/users/batch", strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	req.Header.Set(echo.HeaderAuthorization, "Bearer valid-token")
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	// Invalid operations are reported by the service next to the other results
+	assert.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, 1, mockService.BatchUserOperationsCallCount())
+	_, actualReq := mockService.BatchUserOperationsArgsForCall(0)
+	require.Len(t, actualReq.Operations, 2)
+	assert.Contains(t, actualReq.Operations[0].ValidationErrors, "create.email")
+	assert.Contains(t, actualReq.Operations[1].ValidationErrors, "id")
+}
+
+func TestUserHandler_BatchUserOperations_InvalidMode(t *testing.T) {
+	mockService := &servicefakes.FakeUserService{}
+	userHandler := handler.NewUserHandler(logger.NewDiscardLogger(), mockService)
+	e := setupServer(t, userHandler, http_server.Principal{UserID: uuid.New(), Roles: []string{rbac.RoleAdmin}})
+
+	body := `{"mode":"sometimes","operations":[{"action":"delete","id":"` + uuid.NewString() + `"}]}`
+	req := httptest.NewRequest(http.MethodPost, "/v2/users/batch", strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	req.Header.Set(echo.HeaderAuthorization, "Bearer valid-token")
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+	assert.Equal(t, 0, mockService.BatchUserOperationsCallCount())
+}
+
+func TestUserHandler_BatchUserOperations_TooLarge(t *testing.T) {
+	mockService := &servicefakes.FakeUserService{}
+	mockService.BatchUserOperationsReturns(service.BatchUserOperationsResponse{}, service.ErrBatchTooLarge)
+	userHandler := handler.NewUserHandler(logger.NewDiscardLogger(), mockService)
+	e := setupServer(t, userHandler, http_server.Principal{UserID: uuid.New(), Roles: []string{rbac.RoleAdmin}})
+
+	body := `{"mode":"best_effort","operations":[{"action":"delete","id":"` + uuid.NewString() + `"}]}`
+	req := httptest.NewRequest(http.MethodPost, "/v2/users/batch", strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	req.Header.Set(echo.HeaderAuthorization, "Bearer valid-token")
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusRequestEntityTooLarge, rec.Code)
+
+	var response http_server.APIResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	assert.Equal(t, service.ErrBatchTooLarge.Code, response.Error)
+}
+
+func TestUserHandler_BatchUserOperations_NotAdmin(t *testing.T) {
+	mockService := &servicefakes.FakeUserService{}
+	userHandler := handler.NewUserHandler(logger.NewDiscardLogger(), mockService)
+	e := setupServer(t, userHandler, http_server.Principal{UserID: uuid.New(), Roles: []string{rbac.RoleAuthor}})
+
+	body := `{"mode":"best_effort","operations":[{"action":"delete","id":"` + uuid.NewString() + `"}]}`
+	req := httptest.NewRequest(http.MethodPost, "/v2/users/batch", strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	req.Header.Set(echo.HeaderAuthorization, "Bearer valid-token")
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+	assert.Equal(t, 0, mockService.BatchUserOperationsCallCount())
+}
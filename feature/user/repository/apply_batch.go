@@ -0,0 +1,61 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"log/slog"
+	"time"
+)
+
+// Actions of a UserChange
+const (
+	UserChangeCreate = "create"
+	UserChangeUpdate = "update"
+	UserChangeDelete = "delete"
+)
+
+// UserChange is one write of ApplyBatch. Creates insert User as is, including its ID and
+// timestamps, updates set its name and email and deletes only use its ID.
+type UserChange struct {
+	Action string
+	User   User
+}
+
+// ApplyBatch applies every change in one transaction, in order. When a change fails
+// nothing is written and the index of that change is returned with its error; the index
+// is -1 when the transaction itself failed.
+func (r *userRepository) ApplyBatch(ctx context.Context, changes []UserChange) (int, error) {
+	failed := -1
+	err := r.withTx(ctx, "user batch", func(tx *sql.Tx) error {
+		now := time.Now()
+		for i, change := range changes {
+			var err error
+			switch change.Action {
+			case UserChangeCreate:
+				err = r.insertUser(ctx, tx, change.User)
+			case UserChangeUpdate:
+				change.User.UpdatedAt = now
+				err = r.updateUser(ctx, tx, change.User)
+			case UserChangeDelete:
+				err = r.softDeleteUser(ctx, tx, change.User.ID, now)
+			default:
+				err = ErrInvalidUserChange
+			}
+			if err != nil {
+				failed = i
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return failed, err
+	}
+
+	r.log.Info("User batch applied successfully",
+		slog.Int("changes", len(changes)),
+	)
+
+	return -1, nil
+}
@@ -0,0 +1,89 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/fikryfahrezy/let-it-go/feature/user/repository"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestBatchUser(email string) repository.User {
+	now := time.Now()
+	return repository.User{
+		ID:        uuid.Must(uuid.NewV7()),
+		Name:      "Batch User",
+		Email:     email,
+		Password:  "hashedpassword",
+		Role:      "author",
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+}
+
+func TestApplyBatch(t *testing.T) {
+	setupTest(t)
+	ctx := context.Background()
+
+	toUpdate := createTestUser(t, "batch-update@example.com")
+	toDelete := createTestUser(t, "batch-delete@example.com")
+	created := newTestBatchUser("batch-create@example.com")
+
+	toUpdate.Name = "Renamed"
+	failedIndex, err := testRepository.ApplyBatch(ctx, []repository.UserChange{
+		{Action: repository.UserChangeCreate, User: created},
+		{Action: repository.UserChangeUpdate, User: toUpdate},
+		{Action: repository.UserChangeDelete, User: repository.User{ID: toDelete.ID}},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, -1, failedIndex)
+
+	// Created users keep the given ID
+	user, err := testRepository.GetByID(ctx, created.ID)
+	require.NoError(t, err)
+	assert.Equal(t, created.Email, user.Email)
+
+	user, err = testRepository.GetByID(ctx, toUpdate.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "Renamed", user.Name)
+
+	_, err = testRepository.GetByID(ctx, toDelete.ID)
+	assert.Equal(t, repository.ErrUserNotFound, err)
+}
+
+func TestApplyBatchRollsBack(t *testing.T) {
+	setupTest(t)
+	ctx := context.Background()
+
+	existing := createTestUser(t, "batch-existing@example.com")
+	created := newTestBatchUser("batch-new@example.com")
+
+	// The second create reuses a taken email
+	failedIndex, err := testRepository.ApplyBatch(ctx, []repository.UserChange{
+		{Action: repository.UserChangeCreate, User: created},
+		{Action: repository.UserChangeCreate, User: newTestBatchUser(existing.Email)},
+		{Action: repository.UserChangeDelete, User: repository.User{ID: existing.ID}},
+	})
+	assert.Equal(t, repository.ErrEmailAlreadyTaken, err)
+	assert.Equal(t, 1, failedIndex)
+
+	// Nothing was written
+	_, err = testRepository.GetByID(ctx, created.ID)
+	assert.Equal(t, repository.ErrUserNotFound, err)
+	_, err = testRepository.GetByID(ctx, existing.ID)
+	assert.NoError(t, err)
+}
+
+func TestApplyBatchUnknownUser(t *testing.T) {
+	setupTest(t)
+	ctx := context.Background()
+
+	failedIndex, err := testRepository.ApplyBatch(ctx, []repository.UserChange{
+		{Action: repository.UserChangeDelete, User: repository.User{ID: uuid.New()}},
+	})
+	assert.Equal(t, repository.ErrUserNotFound, err)
+	assert.Equal(t, 0, failedIndex)
+}
@@ -0,0 +1,133 @@
+package repository_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/fikryfahrezy/let-it-go/feature/user/repository"
+	"github.com/fikryfahrezy/let-it-go/pkg/database"
+	"github.com/fikryfahrezy/let-it-go/pkg/logger"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApplyBatchUnit(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	// nolint:errcheck
+	defer sqlDB.Close()
+
+	db := &database.DB{DB: sqlDB}
+	repo := repository.NewUserRepository(logger.NewDiscardLogger(), db)
+	ctx := context.Background()
+
+	created := repository.User{ID: uuid.New(), Name: "New", Email: "new@example.com", Password: "hash", Role: "author"}
+	updated := repository.User{ID: uuid.New(), Name: "Renamed", Email: "renamed@example.com"}
+	deletedID := uuid.New()
+
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO users").
+		WithArgs(created.ID, created.Name, created.Email, created.Password, created.Role, created.VerifiedAt, sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("UPDATE email_verification_tokens").
+		WithArgs(sqlmock.AnyArg(), updated.ID, updated.Email).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec("UPDATE users SET name = \\?, email = \\?, verified_at = \\?, updated_at = \\? WHERE id = \\? AND deleted_at IS NULL").
+		WithArgs(updated.Name, updated.Email, updated.VerifiedAt, sqlmock.AnyArg(), updated.ID).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("UPDATE users SET deleted_at").
+		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), deletedID).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("UPDATE sessions SET revoked_at").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec("UPDATE personal_access_tokens SET revoked_at").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectCommit()
+
+	failedIndex, err := repo.ApplyBatch(ctx, []repository.UserChange{
+		{Action: repository.UserChangeCreate, User: created},
+		{Action: repository.UserChangeUpdate, User: updated},
+		{Action: repository.UserChangeDelete, User: repository.User{ID: deletedID}},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, -1, failedIndex)
+
+	// Verify all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestApplyBatchFailedChangeUnit(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	// nolint:errcheck
+	defer sqlDB.Close()
+
+	db := &database.DB{DB: sqlDB}
+	repo := repository.NewUserRepository(logger.NewDiscardLogger(), db)
+	ctx := context.Background()
+
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE email_verification_tokens").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec("UPDATE users SET name").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("UPDATE email_verification_tokens").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec("UPDATE users SET name").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectRollback()
+
+	failedIndex, err := repo.ApplyBatch(ctx, []repository.UserChange{
+		{Action: repository.UserChangeUpdate, User: repository.User{ID: uuid.New()}},
+		{Action: repository.UserChangeUpdate, User: repository.User{ID: uuid.New()}},
+	})
+	assert.Equal(t, repository.ErrUserNotFound, err)
+	assert.Equal(t, 1, failedIndex)
+
+	// Verify all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestApplyBatchUnknownActionUnit(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	// nolint:errcheck
+	defer sqlDB.Close()
+
+	db := &database.DB{DB: sqlDB}
+	repo := repository.NewUserRepository(logger.NewDiscardLogger(), db)
+	ctx := context.Background()
+
+	mock.ExpectBegin()
+	mock.ExpectRollback()
+
+	failedIndex, err := repo.ApplyBatch(ctx, []repository.UserChange{{Action: "truncate"}})
+	assert.Equal(t, repository.ErrInvalidUserChange, err)
+	assert.Equal(t, 0, failedIndex)
+
+	// Verify all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestApplyBatchBeginErrorUnit(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	// nolint:errcheck
+	defer sqlDB.Close()
+
+	db := &database.DB{DB: sqlDB}
+	repo := repository.NewUserRepository(logger.NewDiscardLogger(), db)
+	ctx := context.Background()
+
+	mock.ExpectBegin().WillReturnError(sql.ErrConnDone)
+
+	failedIndex, err := repo.ApplyBatch(ctx, []repository.UserChange{{Action: repository.UserChangeDelete}})
+	assert.ErrorIs(t, err, repository.ErrFailedToBeginTx)
+	assert.Equal(t, -1, failedIndex)
+
+	// Verify all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
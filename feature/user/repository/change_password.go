@@ -0,0 +1,80 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ChangePassword sets the new password hash in one transaction with invalidating
+// the user's outstanding reset tokens and revoking every session except the
+// keepFamilyID family, so the device that changed the password stays signed in.
+// Pass uuid.Nil to revoke every session.
+func (r *userRepository) ChangePassword(ctx context.Context, userID uuid.UUID, passwordHash string, keepFamilyID uuid.UUID) error {
+	err := r.withTx(ctx, "change password", func(tx *sql.Tx) error {
+		now := time.Now()
+
+		result, err := tx.ExecContext(ctx, `
+			UPDATE users
+			SET password = ?, updated_at = ?
+			WHERE id = ?
+		`, passwordHash, now, userID)
+		if err != nil {
+			r.log.Error("Failed to change password",
+				slog.String("error", err.Error()),
+				slog.String("user_id", userID.String()),
+			)
+			return fmt.Errorf("%w: %w", ErrFailedToUpdatePassword, err)
+		}
+
+		rowsAffected, err := result.RowsAffected()
+		if err != nil {
+			r.log.Error("Failed to get rows affected",
+				slog.String("error", err.Error()),
+			)
+			return fmt.Errorf("%w: %w", ErrFailedToGetRowsAffected, err)
+		}
+
+		if rowsAffected == 0 {
+			return ErrUserNotFound
+		}
+
+		statements := []struct {
+			query string
+			args  []any
+		}{
+			{
+				query: `UPDATE password_reset_tokens SET used_at = ? WHERE user_id = ? AND used_at IS NULL`,
+				args:  []any{now, userID},
+			},
+			{
+				query: `UPDATE sessions SET revoked_at = ?, updated_at = ? WHERE user_id = ? AND family_id <> ? AND revoked_at IS NULL`,
+				args:  []any{now, now, userID, keepFamilyID},
+			},
+		}
+		for _, statement := range statements {
+			if _, err := tx.ExecContext(ctx, statement.query, statement.args...); err != nil {
+				r.log.Error("Failed to change password",
+					slog.String("error", err.Error()),
+					slog.String("user_id", userID.String()),
+				)
+				return fmt.Errorf("%w: %w", ErrFailedToUpdatePassword, err)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	r.log.Info("Password changed successfully",
+		slog.String("user_id", userID.String()),
+	)
+
+	return nil
+}
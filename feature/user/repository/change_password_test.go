@@ -0,0 +1,52 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/fikryfahrezy/let-it-go/feature/user/repository"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChangePassword(t *testing.T) {
+	setupTest(t)
+
+	ctx := context.Background()
+	user := createTestUser(t, "change@example.com")
+	current := newTestSession(user.ID, "hash-current", time.Hour)
+	require.NoError(t, testRepository.CreateSession(ctx, current))
+	other := newTestSession(user.ID, "hash-other", time.Hour)
+	require.NoError(t, testRepository.CreateSession(ctx, other))
+	resetToken := createTestPasswordResetToken(t, user.ID, "hash-reset", time.Hour)
+
+	err := testRepository.ChangePassword(ctx, user.ID, "new-password-hash", current.FamilyID)
+	require.NoError(t, err)
+
+	updated, err := testRepository.GetByID(ctx, user.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "new-password-hash", updated.Password)
+
+	// The session that changed the password stays signed in
+	kept, err := testRepository.GetSessionByRefreshTokenHash(ctx, current.RefreshTokenHash)
+	require.NoError(t, err)
+	assert.Nil(t, kept.RevokedAt)
+
+	revoked, err := testRepository.GetSessionByRefreshTokenHash(ctx, other.RefreshTokenHash)
+	require.NoError(t, err)
+	assert.NotNil(t, revoked.RevokedAt)
+
+	// An outstanding reset link can no longer override the new password
+	result, err := testRepository.GetPasswordResetTokenByHash(ctx, resetToken.TokenHash)
+	require.NoError(t, err)
+	assert.NotNil(t, result.UsedAt)
+}
+
+func TestChangePasswordNotFound(t *testing.T) {
+	setupTest(t)
+
+	err := testRepository.ChangePassword(context.Background(), uuid.New(), "new-password-hash", uuid.Nil)
+	assert.Equal(t, repository.ErrUserNotFound, err)
+}
@@ -0,0 +1,54 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+)
+
+// ConsumeOIDCLoginRequest returns the login request with the state hash and deletes it
+// in one transaction, so a state can complete at most one login. Expired requests are
+// returned as well; checking the expiry is up to the caller.
+func (r *userRepository) ConsumeOIDCLoginRequest(ctx context.Context, stateHash string) (OIDCLoginRequest, error) {
+	var loginRequest OIDCLoginRequest
+	err := r.withTx(ctx, "consume OIDC login request", func(tx *sql.Tx) error {
+		err := tx.QueryRowContext(ctx, `
+			SELECT id, state_hash, nonce, code_verifier, expires_at, created_at
+			FROM oidc_login_requests
+			WHERE state_hash = ?
+			FOR UPDATE
+		`, stateHash).Scan(
+			&loginRequest.ID,
+			&loginRequest.StateHash,
+			&loginRequest.Nonce,
+			&loginRequest.CodeVerifier,
+			&loginRequest.ExpiresAt,
+			&loginRequest.CreatedAt,
+		)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return ErrOIDCLoginRequestNotFound
+			}
+			r.log.Error("Failed to get OIDC login request",
+				slog.String("error", err.Error()),
+			)
+			return fmt.Errorf("%w: %w", ErrFailedToConsumeOIDCLoginRequest, err)
+		}
+
+		if _, err := tx.ExecContext(ctx, `DELETE FROM oidc_login_requests WHERE id = ?`, loginRequest.ID); err != nil {
+			r.log.Error("Failed to delete OIDC login request",
+				slog.String("error", err.Error()),
+				slog.String("login_request_id", loginRequest.ID.String()),
+			)
+			return fmt.Errorf("%w: %w", ErrFailedToConsumeOIDCLoginRequest, err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return OIDCLoginRequest{}, err
+	}
+
+	return loginRequest, nil
+}
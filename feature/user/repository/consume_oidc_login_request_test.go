@@ -0,0 +1,29 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/fikryfahrezy/let-it-go/feature/user/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConsumeOIDCLoginRequest(t *testing.T) {
+	setupTest(t)
+
+	ctx := context.Background()
+	loginRequest := newTestOIDCLoginRequest("consume-state-hash", 10*time.Minute)
+	require.NoError(t, testRepository.CreateOIDCLoginRequest(ctx, loginRequest))
+
+	consumed, err := testRepository.ConsumeOIDCLoginRequest(ctx, "consume-state-hash")
+	require.NoError(t, err)
+	assert.Equal(t, loginRequest.ID, consumed.ID)
+	assert.Equal(t, "consume-state-hash", consumed.StateHash)
+	assert.WithinDuration(t, loginRequest.ExpiresAt, consumed.ExpiresAt, time.Second)
+
+	// A state completes at most one login
+	_, err = testRepository.ConsumeOIDCLoginRequest(ctx, "consume-state-hash")
+	assert.Equal(t, repository.ErrOIDCLoginRequestNotFound, err)
+}
@@ -0,0 +1,98 @@
+package repository_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/fikryfahrezy/let-it-go/feature/user/repository"
+	"github.com/fikryfahrezy/let-it-go/pkg/database"
+	"github.com/fikryfahrezy/let-it-go/pkg/logger"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConsumeOIDCLoginRequestUnit(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	// nolint:errcheck
+	defer sqlDB.Close()
+
+	db := &database.DB{DB: sqlDB}
+	repo := repository.NewUserRepository(logger.NewDiscardLogger(), db)
+	ctx := context.Background()
+
+	loginRequestID := uuid.New()
+	rows := sqlmock.NewRows([]string{"id", "state_hash", "nonce", "code_verifier", "expires_at", "created_at"}).
+		AddRow(loginRequestID, "state-hash", "nonce", "code-verifier", time.Now().Add(10*time.Minute), time.Now())
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT (.+) FROM oidc_login_requests WHERE state_hash = \\? FOR UPDATE").
+		WithArgs("state-hash").
+		WillReturnRows(rows)
+	mock.ExpectExec("DELETE FROM oidc_login_requests WHERE id = ?").
+		WithArgs(loginRequestID).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	result, err := repo.ConsumeOIDCLoginRequest(ctx, "state-hash")
+	assert.NoError(t, err)
+	assert.Equal(t, loginRequestID, result.ID)
+	assert.Equal(t, "nonce", result.Nonce)
+	assert.Equal(t, "code-verifier", result.CodeVerifier)
+
+	// Verify all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestConsumeOIDCLoginRequestNotFoundUnit(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	// nolint:errcheck
+	defer sqlDB.Close()
+
+	db := &database.DB{DB: sqlDB}
+	repo := repository.NewUserRepository(logger.NewDiscardLogger(), db)
+	ctx := context.Background()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT (.+) FROM oidc_login_requests").
+		WillReturnError(sql.ErrNoRows)
+	mock.ExpectRollback()
+
+	_, err = repo.ConsumeOIDCLoginRequest(ctx, "state-hash")
+	assert.Equal(t, repository.ErrOIDCLoginRequestNotFound, err)
+
+	// Verify all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestConsumeOIDCLoginRequestErrorUnit(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	// nolint:errcheck
+	defer sqlDB.Close()
+
+	db := &database.DB{DB: sqlDB}
+	repo := repository.NewUserRepository(logger.NewDiscardLogger(), db)
+	ctx := context.Background()
+
+	rows := sqlmock.NewRows([]string{"id", "state_hash", "nonce", "code_verifier", "expires_at", "created_at"}).
+		AddRow(uuid.New(), "state-hash", "nonce", "code-verifier", time.Now(), time.Now())
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT (.+) FROM oidc_login_requests").
+		WillReturnRows(rows)
+	mock.ExpectExec("DELETE FROM oidc_login_requests").
+		WillReturnError(sql.ErrConnDone)
+	mock.ExpectRollback()
+
+	_, err = repo.ConsumeOIDCLoginRequest(ctx, "state-hash")
+	assert.ErrorIs(t, err, repository.ErrFailedToConsumeOIDCLoginRequest)
+
+	// Verify all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -6,11 +6,13 @@ import (
 	"log/slog"
 )
 
-func (r *userRepository) Count(ctx context.Context) (int64, error) {
-	query := `SELECT COUNT(*) FROM users`
+// Count returns how many users match the filter; its sort fields are ignored
+func (r *userRepository) Count(ctx context.Context, filter ListFilter) (int64, error) {
+	where, args := filter.where()
+	query := `SELECT COUNT(*) FROM users ` + where
 
 	var count int64
-	err := r.db.QueryRowContext(ctx, query).Scan(&count)
+	err := r.db.QueryRowContext(ctx, query, args...).Scan(&count)
 	if err != nil {
 		r.log.Error("Failed to count users",
 			slog.String("error", err.Error()),
@@ -13,7 +13,7 @@ func TestCount(t *testing.T) {
 	setupTest(t)
 
 	// Initially empty
-	count, err := testRepository.Count(context.Background())
+	count, err := testRepository.Count(context.Background(), repository.ListFilter{})
 	assert.NoError(t, err)
 	assert.Equal(t, int64(0), count)
 
@@ -36,7 +36,25 @@ func TestCount(t *testing.T) {
 		require.NoError(t, err)
 	}
 
-	count, err = testRepository.Count(context.Background())
+	count, err = testRepository.Count(context.Background(), repository.ListFilter{})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), count)
+}
+
+func TestCountWithFilter(t *testing.T) {
+	setupTest(t)
+	ctx := context.Background()
+
+	for _, user := range []repository.User{
+		{Name: "Alice", Email: "alice@example.com", Password: "password1"},
+		{Name: "Alina", Email: "alina@example.com", Password: "password2"},
+		{Name: "Bob", Email: "bob@example.com", Password: "password3"},
+	} {
+		require.NoError(t, testRepository.Create(ctx, user))
+	}
+
+	// The total matches the filtered list, not the table
+	count, err := testRepository.Count(ctx, repository.ListFilter{Search: "ali"})
 	assert.NoError(t, err)
 	assert.Equal(t, int64(2), count)
 }
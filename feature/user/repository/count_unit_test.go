@@ -3,6 +3,7 @@ package repository_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/fikryfahrezy/let-it-go/feature/user/repository"
@@ -26,10 +27,10 @@ func TestCountUnit(t *testing.T) {
 
 	// Mock the COUNT query
 	rows := sqlmock.NewRows([]string{"count"}).AddRow(expectedCount)
-	mock.ExpectQuery("SELECT COUNT\\(\\*\\) FROM users").
+	mock.ExpectQuery("SELECT COUNT\\(\\*\\) FROM users WHERE deleted_at IS NULL").
 		WillReturnRows(rows)
 
-	count, err := repo.Count(ctx)
+	count, err := repo.Count(ctx, repository.ListFilter{})
 	assert.NoError(t, err)
 	assert.Equal(t, expectedCount, count)
 
@@ -49,13 +50,43 @@ func TestCountZeroUnit(t *testing.T) {
 
 	// Mock the COUNT query returning 0
 	rows := sqlmock.NewRows([]string{"count"}).AddRow(0)
-	mock.ExpectQuery("SELECT COUNT\\(\\*\\) FROM users").
+	mock.ExpectQuery("SELECT COUNT\\(\\*\\) FROM users WHERE deleted_at IS NULL").
 		WillReturnRows(rows)
 
-	count, err := repo.Count(ctx)
+	count, err := repo.Count(ctx, repository.ListFilter{})
 	assert.NoError(t, err)
 	assert.Equal(t, int64(0), count)
 
 	// Verify all expectations were met
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
+
+func TestCountWithFilterUnit(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	// nolint:errcheck
+	defer sqlDB.Close()
+
+	db := &database.DB{DB: sqlDB}
+	repo := repository.NewUserRepository(logger.NewDiscardLogger(), db)
+	ctx := context.Background()
+
+	createdFrom := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	// The same conditions as List, without the sort
+	rows := sqlmock.NewRows([]string{"count"}).AddRow(3)
+	mock.ExpectQuery("SELECT COUNT\\(\\*\\) FROM users WHERE deleted_at IS NULL AND \\(name LIKE \\? OR email LIKE \\?\\) AND created_at >= \\?$").
+		WithArgs("john%", "john%", createdFrom).
+		WillReturnRows(rows)
+
+	count, err := repo.Count(ctx, repository.ListFilter{
+		Search:      "john",
+		CreatedFrom: &createdFrom,
+		SortBy:      repository.SortByEmail,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), count)
+
+	// Verify all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
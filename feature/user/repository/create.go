@@ -2,33 +2,27 @@ package repository
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
 	"time"
 
+	"github.com/go-sql-driver/mysql"
 	"github.com/google/uuid"
 )
 
 func (r *userRepository) Create(ctx context.Context, user User) error {
-	query := `
-		INSERT INTO users (id, name, email, password, created_at, updated_at)
-		VALUES (?, ?, ?, ?, ?, ?)
-	`
-
 	now := time.Now()
 	user.CreatedAt = now
 	user.UpdatedAt = now
 
-	// Generate UUIDv7 for the user ID
-	user.ID = uuid.Must(uuid.NewV7())
+	// Generate UUIDv7 for the user ID unless the caller already picked one
+	if user.ID == uuid.Nil {
+		user.ID = uuid.Must(uuid.NewV7())
+	}
 
-	_, err := r.db.ExecContext(ctx, query, user.ID, user.Name, user.Email, user.Password, now, now)
-	if err != nil {
-		r.log.Error("Failed to create user",
-			slog.String("error", err.Error()),
-			slog.String("email", user.Email),
-		)
-		return fmt.Errorf("%w: %w", ErrFailedToCreateUser, err)
+	if err := r.insertUser(ctx, r.db, user); err != nil {
+		return err
 	}
 
 	// No need to get last insert ID since we're using UUIDs
@@ -40,3 +34,35 @@ func (r *userRepository) Create(ctx context.Context, user User) error {
 
 	return nil
 }
+
+// insertUser inserts the user with the ID, verified_at and timestamps it already has
+func (r *userRepository) insertUser(ctx context.Context, db execer, user User) error {
+	query := `
+		INSERT INTO users (id, name, email, password, role, verified_at, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+	`
+
+	_, err := db.ExecContext(ctx, query, user.ID, user.Name, user.Email, user.Password, user.Role, user.VerifiedAt, user.CreatedAt, user.UpdatedAt)
+	if err != nil {
+		// Deleted users keep their email until they are erased, so the address can be
+		// taken even though GetByEmail found no user
+		if isDuplicateEntry(err) {
+			return ErrEmailAlreadyTaken
+		}
+		r.log.Error("Failed to create user",
+			slog.String("error", err.Error()),
+			slog.String("email", user.Email),
+		)
+		return fmt.Errorf("%w: %w", ErrFailedToCreateUser, err)
+	}
+
+	return nil
+}
+
+// mysqlErrDuplicateEntry is the MySQL error number of a unique key violation
+const mysqlErrDuplicateEntry = 1062
+
+func isDuplicateEntry(err error) bool {
+	var mysqlErr *mysql.MySQLError
+	return errors.As(err, &mysqlErr) && mysqlErr.Number == mysqlErrDuplicateEntry
+}
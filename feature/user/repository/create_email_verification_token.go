@@ -0,0 +1,53 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+)
+
+// CreateEmailVerificationToken stores a verification token for the user with the given
+// email. The user is looked up by email in the same statement because Create does not
+// hand back the generated user ID. ErrUserNotFound is returned when no user matches.
+func (r *userRepository) CreateEmailVerificationToken(ctx context.Context, email string, verificationToken EmailVerificationToken) error {
+	query := `
+		INSERT INTO email_verification_tokens (id, user_id, token_hash, expires_at, created_at)
+		SELECT ?, id, ?, ?, ?
+		FROM users
+		WHERE email = ? AND deleted_at IS NULL
+	`
+
+	result, err := r.db.ExecContext(ctx, query,
+		verificationToken.ID,
+		verificationToken.TokenHash,
+		verificationToken.ExpiresAt,
+		time.Now(),
+		email,
+	)
+	if err != nil {
+		r.log.Error("Failed to create email verification token",
+			slog.String("error", err.Error()),
+			slog.String("email", email),
+		)
+		return fmt.Errorf("%w: %w", ErrFailedToCreateEmailVerificationToken, err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		r.log.Error("Failed to get rows affected",
+			slog.String("error", err.Error()),
+		)
+		return fmt.Errorf("%w: %w", ErrFailedToGetRowsAffected, err)
+	}
+
+	if rowsAffected == 0 {
+		return ErrUserNotFound
+	}
+
+	r.log.Info("Email verification token created successfully",
+		slog.String("email", email),
+	)
+
+	return nil
+}
@@ -0,0 +1,44 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/fikryfahrezy/let-it-go/feature/user/repository"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateEmailVerificationToken(t *testing.T) {
+	setupTest(t)
+
+	user := createTestUser(t, "verify@example.com")
+	verificationToken := repository.EmailVerificationToken{
+		ID:        uuid.Must(uuid.NewV7()),
+		TokenHash: "hash-verify",
+		ExpiresAt: time.Now().Add(time.Hour),
+	}
+
+	err := testRepository.CreateEmailVerificationToken(context.Background(), user.Email, verificationToken)
+	require.NoError(t, err)
+
+	// The token is linked to the user found by email
+	result, err := testRepository.GetEmailVerificationTokenByHash(context.Background(), verificationToken.TokenHash)
+	require.NoError(t, err)
+	assert.Equal(t, verificationToken.ID, result.ID)
+	assert.Equal(t, user.ID, result.UserID)
+	assert.Nil(t, result.UsedAt)
+}
+
+func TestCreateEmailVerificationTokenUnknownEmail(t *testing.T) {
+	setupTest(t)
+
+	err := testRepository.CreateEmailVerificationToken(context.Background(), "nobody@example.com", repository.EmailVerificationToken{
+		ID:        uuid.Must(uuid.NewV7()),
+		TokenHash: "hash-verify",
+		ExpiresAt: time.Now().Add(time.Hour),
+	})
+	assert.Equal(t, repository.ErrUserNotFound, err)
+}
@@ -0,0 +1,84 @@
+package repository_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/fikryfahrezy/let-it-go/feature/user/repository"
+	"github.com/fikryfahrezy/let-it-go/pkg/database"
+	"github.com/fikryfahrezy/let-it-go/pkg/logger"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateEmailVerificationTokenUnit(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	// nolint:errcheck
+	defer sqlDB.Close()
+
+	db := &database.DB{DB: sqlDB}
+	repo := repository.NewUserRepository(logger.NewDiscardLogger(), db)
+	ctx := context.Background()
+
+	verificationToken := repository.EmailVerificationToken{
+		ID:        uuid.New(),
+		TokenHash: "token-hash",
+		ExpiresAt: time.Now().Add(time.Hour),
+	}
+
+	// Mock the INSERT ... SELECT query
+	mock.ExpectExec("INSERT INTO email_verification_tokens (.+) SELECT (.+) FROM users WHERE email = ?").
+		WithArgs(verificationToken.ID, verificationToken.TokenHash, verificationToken.ExpiresAt, sqlmock.AnyArg(), "john@example.com").
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	err = repo.CreateEmailVerificationToken(ctx, "john@example.com", verificationToken)
+	assert.NoError(t, err)
+
+	// Verify all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCreateEmailVerificationTokenUserNotFoundUnit(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	// nolint:errcheck
+	defer sqlDB.Close()
+
+	db := &database.DB{DB: sqlDB}
+	repo := repository.NewUserRepository(logger.NewDiscardLogger(), db)
+	ctx := context.Background()
+
+	mock.ExpectExec("INSERT INTO email_verification_tokens").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	err = repo.CreateEmailVerificationToken(ctx, "nobody@example.com", repository.EmailVerificationToken{ID: uuid.New()})
+	assert.Equal(t, repository.ErrUserNotFound, err)
+
+	// Verify all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCreateEmailVerificationTokenErrorUnit(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	// nolint:errcheck
+	defer sqlDB.Close()
+
+	db := &database.DB{DB: sqlDB}
+	repo := repository.NewUserRepository(logger.NewDiscardLogger(), db)
+	ctx := context.Background()
+
+	mock.ExpectExec("INSERT INTO email_verification_tokens").
+		WillReturnError(sql.ErrConnDone)
+
+	err = repo.CreateEmailVerificationToken(ctx, "john@example.com", repository.EmailVerificationToken{ID: uuid.New()})
+	assert.ErrorIs(t, err, repository.ErrFailedToCreateEmailVerificationToken)
+
+	// Verify all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
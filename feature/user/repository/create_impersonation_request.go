@@ -0,0 +1,32 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+)
+
+func (r *userRepository) CreateImpersonationRequest(ctx context.Context, request ImpersonationRequest) error {
+	query := `
+		INSERT INTO impersonation_requests (session_id, method, path, status, created_at)
+		VALUES (?, ?, ?, ?, ?)
+	`
+
+	_, err := r.db.ExecContext(ctx, query,
+		request.SessionID,
+		request.Method,
+		request.Path,
+		request.Status,
+		time.Now(),
+	)
+	if err != nil {
+		r.log.Error("Failed to record impersonated request",
+			slog.String("error", err.Error()),
+			slog.String("impersonation_id", request.SessionID.String()),
+		)
+		return fmt.Errorf("%w: %w", ErrFailedToRecordImpersonatedRequest, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,51 @@
+package repository_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/fikryfahrezy/let-it-go/feature/user/repository"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateImpersonationRequest(t *testing.T) {
+	setupTest(t)
+
+	ctx := context.Background()
+	admin := createTestUser(t, "admin@example.com")
+	user := createTestUser(t, "author@example.com")
+	session := newTestImpersonationSession(admin.ID, user.ID)
+	require.NoError(t, testRepository.CreateImpersonationSession(ctx, session))
+
+	err := testRepository.CreateImpersonationRequest(ctx, repository.ImpersonationRequest{
+		SessionID: session.ID,
+		Method:    http.MethodGet,
+		Path:      "/v1/blogs",
+		Status:    http.StatusOK,
+	})
+	require.NoError(t, err)
+
+	var method, path string
+	var status int
+	err = db.QueryRow("SELECT method, path, status FROM impersonation_requests WHERE session_id = ?", session.ID).
+		Scan(&method, &path, &status)
+	require.NoError(t, err)
+	assert.Equal(t, http.MethodGet, method)
+	assert.Equal(t, "/v1/blogs", path)
+	assert.Equal(t, http.StatusOK, status)
+}
+
+func TestCreateImpersonationRequestUnknownSession(t *testing.T) {
+	setupTest(t)
+
+	err := testRepository.CreateImpersonationRequest(context.Background(), repository.ImpersonationRequest{
+		SessionID: uuid.New(),
+		Method:    http.MethodGet,
+		Path:      "/v1/blogs",
+		Status:    http.StatusOK,
+	})
+	assert.ErrorIs(t, err, repository.ErrFailedToRecordImpersonatedRequest)
+}
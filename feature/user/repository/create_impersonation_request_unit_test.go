@@ -0,0 +1,65 @@
+package repository_test
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/fikryfahrezy/let-it-go/feature/user/repository"
+	"github.com/fikryfahrezy/let-it-go/pkg/database"
+	"github.com/fikryfahrezy/let-it-go/pkg/logger"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateImpersonationRequestUnit(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	// nolint:errcheck
+	defer sqlDB.Close()
+
+	db := &database.DB{DB: sqlDB}
+	repo := repository.NewUserRepository(logger.NewDiscardLogger(), db)
+	ctx := context.Background()
+
+	request := repository.ImpersonationRequest{
+		SessionID: uuid.New(),
+		Method:    http.MethodPut,
+		Path:      "/v1/blogs/1",
+		Status:    http.StatusOK,
+	}
+
+	// Mock the INSERT query
+	mock.ExpectExec("INSERT INTO impersonation_requests").
+		WithArgs(request.SessionID, request.Method, request.Path, request.Status, sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	err = repo.CreateImpersonationRequest(ctx, request)
+	assert.NoError(t, err)
+
+	// Verify all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCreateImpersonationRequestErrorUnit(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	// nolint:errcheck
+	defer sqlDB.Close()
+
+	db := &database.DB{DB: sqlDB}
+	repo := repository.NewUserRepository(logger.NewDiscardLogger(), db)
+	ctx := context.Background()
+
+	mock.ExpectExec("INSERT INTO impersonation_requests").
+		WillReturnError(sql.ErrConnDone)
+
+	err = repo.CreateImpersonationRequest(ctx, repository.ImpersonationRequest{SessionID: uuid.New()})
+	assert.ErrorIs(t, err, repository.ErrFailedToRecordImpersonatedRequest)
+
+	// Verify all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
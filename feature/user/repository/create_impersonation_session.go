@@ -0,0 +1,42 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+)
+
+func (r *userRepository) CreateImpersonationSession(ctx context.Context, session ImpersonationSession) error {
+	query := `
+		INSERT INTO impersonation_sessions (id, admin_id, user_id, reason, user_agent, ip_address, expires_at, created_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+	`
+
+	_, err := r.db.ExecContext(ctx, query,
+		session.ID,
+		session.AdminID,
+		session.UserID,
+		session.Reason,
+		session.UserAgent,
+		session.IPAddress,
+		session.ExpiresAt,
+		time.Now(),
+	)
+	if err != nil {
+		r.log.Error("Failed to create impersonation session",
+			slog.String("error", err.Error()),
+			slog.String("admin_id", session.AdminID.String()),
+			slog.String("user_id", session.UserID.String()),
+		)
+		return fmt.Errorf("%w: %w", ErrFailedToCreateImpersonationSession, err)
+	}
+
+	r.log.Info("Impersonation session created successfully",
+		slog.String("impersonation_id", session.ID.String()),
+		slog.String("admin_id", session.AdminID.String()),
+		slog.String("user_id", session.UserID.String()),
+	)
+
+	return nil
+}
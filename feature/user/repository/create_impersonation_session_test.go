@@ -0,0 +1,39 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/fikryfahrezy/let-it-go/feature/user/repository"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateImpersonationSession(t *testing.T) {
+	setupTest(t)
+
+	admin := createTestUser(t, "admin@example.com")
+	user := createTestUser(t, "author@example.com")
+	session := newTestImpersonationSession(admin.ID, user.ID)
+
+	err := testRepository.CreateImpersonationSession(context.Background(), session)
+	require.NoError(t, err)
+
+	var adminID, userID, reason string
+	err = db.QueryRow("SELECT admin_id, user_id, reason FROM impersonation_sessions WHERE id = ?", session.ID).
+		Scan(&adminID, &userID, &reason)
+	require.NoError(t, err)
+	assert.Equal(t, admin.ID.String(), adminID)
+	assert.Equal(t, user.ID.String(), userID)
+	assert.Equal(t, session.Reason, reason)
+}
+
+func TestCreateImpersonationSessionUnknownUser(t *testing.T) {
+	setupTest(t)
+
+	admin := createTestUser(t, "admin@example.com")
+
+	err := testRepository.CreateImpersonationSession(context.Background(), newTestImpersonationSession(admin.ID, uuid.New()))
+	assert.ErrorIs(t, err, repository.ErrFailedToCreateImpersonationSession)
+}
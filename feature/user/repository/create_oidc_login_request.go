@@ -0,0 +1,32 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+)
+
+func (r *userRepository) CreateOIDCLoginRequest(ctx context.Context, loginRequest OIDCLoginRequest) error {
+	query := `
+		INSERT INTO oidc_login_requests (id, state_hash, nonce, code_verifier, expires_at, created_at)
+		VALUES (?, ?, ?, ?, ?, ?)
+	`
+
+	_, err := r.db.ExecContext(ctx, query,
+		loginRequest.ID,
+		loginRequest.StateHash,
+		loginRequest.Nonce,
+		loginRequest.CodeVerifier,
+		loginRequest.ExpiresAt,
+		time.Now(),
+	)
+	if err != nil {
+		r.log.Error("Failed to create OIDC login request",
+			slog.String("error", err.Error()),
+		)
+		return fmt.Errorf("%w: %w", ErrFailedToCreateOIDCLoginRequest, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,32 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/fikryfahrezy/let-it-go/feature/user/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateOIDCLoginRequest(t *testing.T) {
+	setupTest(t)
+
+	ctx := context.Background()
+	loginRequest := newTestOIDCLoginRequest("create-state-hash", 10*time.Minute)
+
+	err := testRepository.CreateOIDCLoginRequest(ctx, loginRequest)
+	require.NoError(t, err)
+
+	stored, err := testRepository.ConsumeOIDCLoginRequest(ctx, "create-state-hash")
+	require.NoError(t, err)
+	assert.Equal(t, loginRequest.ID, stored.ID)
+	assert.Equal(t, loginRequest.Nonce, stored.Nonce)
+	assert.Equal(t, loginRequest.CodeVerifier, stored.CodeVerifier)
+
+	// The state hash is unique
+	require.NoError(t, testRepository.CreateOIDCLoginRequest(ctx, newTestOIDCLoginRequest("unique-state-hash", 10*time.Minute)))
+	err = testRepository.CreateOIDCLoginRequest(ctx, newTestOIDCLoginRequest("unique-state-hash", 10*time.Minute))
+	assert.ErrorIs(t, err, repository.ErrFailedToCreateOIDCLoginRequest)
+}
@@ -0,0 +1,65 @@
+package repository_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/fikryfahrezy/let-it-go/feature/user/repository"
+	"github.com/fikryfahrezy/let-it-go/pkg/database"
+	"github.com/fikryfahrezy/let-it-go/pkg/logger"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateOIDCLoginRequestUnit(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	// nolint:errcheck
+	defer sqlDB.Close()
+
+	db := &database.DB{DB: sqlDB}
+	repo := repository.NewUserRepository(logger.NewDiscardLogger(), db)
+	ctx := context.Background()
+
+	loginRequest := repository.OIDCLoginRequest{
+		ID:           uuid.New(),
+		StateHash:    "state-hash",
+		Nonce:        "nonce",
+		CodeVerifier: "code-verifier",
+		ExpiresAt:    time.Now().Add(10 * time.Minute),
+	}
+
+	mock.ExpectExec("INSERT INTO oidc_login_requests").
+		WithArgs(loginRequest.ID, "state-hash", "nonce", "code-verifier", loginRequest.ExpiresAt, sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	err = repo.CreateOIDCLoginRequest(ctx, loginRequest)
+	assert.NoError(t, err)
+
+	// Verify all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCreateOIDCLoginRequestErrorUnit(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	// nolint:errcheck
+	defer sqlDB.Close()
+
+	db := &database.DB{DB: sqlDB}
+	repo := repository.NewUserRepository(logger.NewDiscardLogger(), db)
+	ctx := context.Background()
+
+	mock.ExpectExec("INSERT INTO oidc_login_requests").
+		WillReturnError(sql.ErrConnDone)
+
+	err = repo.CreateOIDCLoginRequest(ctx, repository.OIDCLoginRequest{ID: uuid.New()})
+	assert.ErrorIs(t, err, repository.ErrFailedToCreateOIDCLoginRequest)
+
+	// Verify all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,36 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+)
+
+func (r *userRepository) CreatePasswordResetToken(ctx context.Context, resetToken PasswordResetToken) error {
+	query := `
+		INSERT INTO password_reset_tokens (id, user_id, token_hash, expires_at, created_at)
+		VALUES (?, ?, ?, ?, ?)
+	`
+
+	_, err := r.db.ExecContext(ctx, query,
+		resetToken.ID,
+		resetToken.UserID,
+		resetToken.TokenHash,
+		resetToken.ExpiresAt,
+		time.Now(),
+	)
+	if err != nil {
+		r.log.Error("Failed to create password reset token",
+			slog.String("error", err.Error()),
+			slog.String("user_id", resetToken.UserID.String()),
+		)
+		return fmt.Errorf("%w: %w", ErrFailedToCreatePasswordResetToken, err)
+	}
+
+	r.log.Info("Password reset token created successfully",
+		slog.String("user_id", resetToken.UserID.String()),
+	)
+
+	return nil
+}
@@ -0,0 +1,34 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/fikryfahrezy/let-it-go/feature/user/repository"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreatePasswordResetToken(t *testing.T) {
+	setupTest(t)
+
+	user := createTestUser(t, "reset@example.com")
+	resetToken := repository.PasswordResetToken{
+		ID:        uuid.Must(uuid.NewV7()),
+		UserID:    user.ID,
+		TokenHash: "hash-create-reset-token",
+		ExpiresAt: time.Now().Add(time.Hour).Truncate(time.Second),
+	}
+
+	err := testRepository.CreatePasswordResetToken(context.Background(), resetToken)
+	require.NoError(t, err)
+
+	result, err := testRepository.GetPasswordResetTokenByHash(context.Background(), resetToken.TokenHash)
+	require.NoError(t, err)
+	assert.Equal(t, resetToken.ID, result.ID)
+	assert.Equal(t, user.ID, result.UserID)
+	assert.Nil(t, result.UsedAt)
+	assert.False(t, result.CreatedAt.IsZero())
+}
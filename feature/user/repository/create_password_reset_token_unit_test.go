@@ -0,0 +1,65 @@
+package repository_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/fikryfahrezy/let-it-go/feature/user/repository"
+	"github.com/fikryfahrezy/let-it-go/pkg/database"
+	"github.com/fikryfahrezy/let-it-go/pkg/logger"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreatePasswordResetTokenUnit(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	// nolint:errcheck
+	defer sqlDB.Close()
+
+	db := &database.DB{DB: sqlDB}
+	repo := repository.NewUserRepository(logger.NewDiscardLogger(), db)
+	ctx := context.Background()
+
+	resetToken := repository.PasswordResetToken{
+		ID:        uuid.New(),
+		UserID:    uuid.New(),
+		TokenHash: "token-hash",
+		ExpiresAt: time.Now().Add(time.Hour),
+	}
+
+	// Mock the INSERT query
+	mock.ExpectExec("INSERT INTO password_reset_tokens").
+		WithArgs(resetToken.ID, resetToken.UserID, resetToken.TokenHash, resetToken.ExpiresAt, sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	err = repo.CreatePasswordResetToken(ctx, resetToken)
+	assert.NoError(t, err)
+
+	// Verify all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCreatePasswordResetTokenErrorUnit(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	// nolint:errcheck
+	defer sqlDB.Close()
+
+	db := &database.DB{DB: sqlDB}
+	repo := repository.NewUserRepository(logger.NewDiscardLogger(), db)
+	ctx := context.Background()
+
+	mock.ExpectExec("INSERT INTO password_reset_tokens").
+		WillReturnError(sql.ErrConnDone)
+
+	err = repo.CreatePasswordResetToken(ctx, repository.PasswordResetToken{ID: uuid.New(), UserID: uuid.New()})
+	assert.ErrorIs(t, err, repository.ErrFailedToCreatePasswordResetToken)
+
+	// Verify all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,40 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+)
+
+func (r *userRepository) CreatePersonalAccessToken(ctx context.Context, accessToken PersonalAccessToken) error {
+	query := `
+		INSERT INTO personal_access_tokens (id, user_id, name, token_hash, scopes, expires_at, created_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?)
+	`
+
+	_, err := r.db.ExecContext(ctx, query,
+		accessToken.ID,
+		accessToken.UserID,
+		accessToken.Name,
+		accessToken.TokenHash,
+		strings.Join(accessToken.Scopes, ","),
+		accessToken.ExpiresAt,
+		time.Now(),
+	)
+	if err != nil {
+		r.log.Error("Failed to create personal access token",
+			slog.String("error", err.Error()),
+			slog.String("user_id", accessToken.UserID.String()),
+		)
+		return fmt.Errorf("%w: %w", ErrFailedToCreatePersonalAccessToken, err)
+	}
+
+	r.log.Info("Personal access token created successfully",
+		slog.String("token_id", accessToken.ID.String()),
+		slog.String("user_id", accessToken.UserID.String()),
+	)
+
+	return nil
+}
@@ -0,0 +1,47 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreatePersonalAccessToken(t *testing.T) {
+	setupTest(t)
+
+	ctx := context.Background()
+	user := createTestUser(t, "create-token@example.com")
+
+	accessToken := newTestPersonalAccessToken(user.ID, "hash-create", "blogs:write", "users:read")
+	expiresAt := time.Now().Add(24 * time.Hour).Truncate(time.Second)
+	accessToken.ExpiresAt = &expiresAt
+
+	err := testRepository.CreatePersonalAccessToken(ctx, accessToken)
+	require.NoError(t, err)
+
+	stored, err := testRepository.GetPersonalAccessTokenByHash(ctx, "hash-create")
+	require.NoError(t, err)
+	assert.Equal(t, accessToken.ID, stored.ID)
+	assert.Equal(t, user.ID, stored.UserID)
+	assert.Equal(t, "ci", stored.Name)
+	assert.Equal(t, []string{"blogs:write", "users:read"}, stored.Scopes)
+	require.NotNil(t, stored.ExpiresAt)
+	assert.True(t, expiresAt.Equal(*stored.ExpiresAt))
+	assert.Nil(t, stored.LastUsedAt)
+	assert.Nil(t, stored.RevokedAt)
+}
+
+func TestCreatePersonalAccessTokenDuplicateHash(t *testing.T) {
+	setupTest(t)
+
+	ctx := context.Background()
+	user := createTestUser(t, "duplicate-token@example.com")
+
+	require.NoError(t, testRepository.CreatePersonalAccessToken(ctx, newTestPersonalAccessToken(user.ID, "hash-duplicate", "blogs:write")))
+
+	err := testRepository.CreatePersonalAccessToken(ctx, newTestPersonalAccessToken(user.ID, "hash-duplicate", "blogs:write"))
+	assert.Error(t, err)
+}
@@ -0,0 +1,59 @@
+package repository_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/fikryfahrezy/let-it-go/feature/user/repository"
+	"github.com/fikryfahrezy/let-it-go/pkg/database"
+	"github.com/fikryfahrezy/let-it-go/pkg/logger"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreatePersonalAccessTokenUnit(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	// nolint:errcheck
+	defer sqlDB.Close()
+
+	db := &database.DB{DB: sqlDB}
+	repo := repository.NewUserRepository(logger.NewDiscardLogger(), db)
+	ctx := context.Background()
+
+	accessToken := newTestPersonalAccessToken(uuid.New(), "token-hash", "blogs:write", "users:read")
+
+	// Scopes are stored comma separated
+	mock.ExpectExec("INSERT INTO personal_access_tokens").
+		WithArgs(accessToken.ID, accessToken.UserID, accessToken.Name, accessToken.TokenHash, "blogs:write,users:read", accessToken.ExpiresAt, sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	err = repo.CreatePersonalAccessToken(ctx, accessToken)
+	assert.NoError(t, err)
+
+	// Verify all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCreatePersonalAccessTokenErrorUnit(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	// nolint:errcheck
+	defer sqlDB.Close()
+
+	db := &database.DB{DB: sqlDB}
+	repo := repository.NewUserRepository(logger.NewDiscardLogger(), db)
+	ctx := context.Background()
+
+	mock.ExpectExec("INSERT INTO personal_access_tokens").
+		WillReturnError(sql.ErrConnDone)
+
+	err = repo.CreatePersonalAccessToken(ctx, newTestPersonalAccessToken(uuid.New(), "token-hash", "blogs:write"))
+	assert.ErrorIs(t, err, repository.ErrFailedToCreatePersonalAccessToken)
+
+	// Verify all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,43 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+)
+
+func (r *userRepository) CreateSession(ctx context.Context, session Session) error {
+	query := `
+		INSERT INTO sessions (id, user_id, family_id, refresh_token_hash, user_agent, ip_address, expires_at, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)
+	`
+
+	now := time.Now()
+
+	_, err := r.db.ExecContext(ctx, query,
+		session.ID,
+		session.UserID,
+		session.FamilyID,
+		session.RefreshTokenHash,
+		session.UserAgent,
+		session.IPAddress,
+		session.ExpiresAt,
+		now,
+		now,
+	)
+	if err != nil {
+		r.log.Error("Failed to create session",
+			slog.String("error", err.Error()),
+			slog.String("user_id", session.UserID.String()),
+		)
+		return fmt.Errorf("%w: %w", ErrFailedToCreateSession, err)
+	}
+
+	r.log.Info("Session created successfully",
+		slog.String("session_id", session.ID.String()),
+		slog.String("user_id", session.UserID.String()),
+	)
+
+	return nil
+}
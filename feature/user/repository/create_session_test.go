@@ -0,0 +1,43 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateSession(t *testing.T) {
+	setupTest(t)
+
+	user := createTestUser(t, "session@example.com")
+	session := newTestSession(user.ID, "hash-create-session", time.Hour)
+
+	err := testRepository.CreateSession(context.Background(), session)
+	require.NoError(t, err)
+
+	result, err := testRepository.GetSessionByRefreshTokenHash(context.Background(), session.RefreshTokenHash)
+	require.NoError(t, err)
+	assert.Equal(t, session.ID, result.ID)
+	assert.Equal(t, user.ID, result.UserID)
+	assert.Equal(t, session.FamilyID, result.FamilyID)
+	assert.Equal(t, session.UserAgent, result.UserAgent)
+	assert.Equal(t, session.IPAddress, result.IPAddress)
+	assert.Nil(t, result.RotatedAt)
+	assert.Nil(t, result.RevokedAt)
+	assert.False(t, result.CreatedAt.IsZero())
+}
+
+func TestCreateSessionDuplicateHash(t *testing.T) {
+	setupTest(t)
+
+	user := createTestUser(t, "session@example.com")
+
+	err := testRepository.CreateSession(context.Background(), newTestSession(user.ID, "duplicate-hash", time.Hour))
+	require.NoError(t, err)
+
+	err = testRepository.CreateSession(context.Background(), newTestSession(user.ID, "duplicate-hash", time.Hour))
+	assert.Error(t, err)
+}
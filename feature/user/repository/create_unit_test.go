@@ -4,11 +4,14 @@ import (
 	"context"
 	"database/sql"
 	"testing"
+	"time"
 
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/fikryfahrezy/let-it-go/feature/user/repository"
 	"github.com/fikryfahrezy/let-it-go/pkg/database"
 	"github.com/fikryfahrezy/let-it-go/pkg/logger"
+	"github.com/go-sql-driver/mysql"
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -27,11 +30,12 @@ func TestCreateUnit(t *testing.T) {
 		Name:     "John Doe",
 		Email:    "john@example.com",
 		Password: "hashedpassword",
+		Role:     "author",
 	}
 
 	// Mock the INSERT query
 	mock.ExpectExec("INSERT INTO users").
-		WithArgs(sqlmock.AnyArg(), user.Name, user.Email, user.Password, sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WithArgs(sqlmock.AnyArg(), user.Name, user.Email, user.Password, user.Role, user.VerifiedAt, sqlmock.AnyArg(), sqlmock.AnyArg()).
 		WillReturnResult(sqlmock.NewResult(1, 1))
 
 	err = repo.Create(ctx, user)
@@ -55,11 +59,12 @@ func TestCreateDuplicateEmailUnit(t *testing.T) {
 		Name:     "John Doe",
 		Email:    "john@example.com",
 		Password: "hashedpassword",
+		Role:     "author",
 	}
 
 	// Mock the INSERT query to return a duplicate entry error
 	mock.ExpectExec("INSERT INTO users").
-		WithArgs(sqlmock.AnyArg(), user.Name, user.Email, user.Password, sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WithArgs(sqlmock.AnyArg(), user.Name, user.Email, user.Password, user.Role, user.VerifiedAt, sqlmock.AnyArg(), sqlmock.AnyArg()).
 		WillReturnError(sql.ErrConnDone) // Simulate a database error
 
 	err = repo.Create(ctx, user)
@@ -68,3 +73,56 @@ func TestCreateDuplicateEmailUnit(t *testing.T) {
 	// Verify all expectations were met
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
+
+func TestCreateEmailTakenUnit(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	// nolint:errcheck
+	defer sqlDB.Close()
+
+	db := &database.DB{DB: sqlDB}
+	repo := repository.NewUserRepository(logger.NewDiscardLogger(), db)
+	ctx := context.Background()
+
+	// A deleted user still holds the email
+	mock.ExpectExec("INSERT INTO users").
+		WillReturnError(&mysql.MySQLError{Number: 1062, Message: "Duplicate entry 'john@example.com' for key 'users.email'"})
+
+	err = repo.Create(ctx, repository.User{Email: "john@example.com"})
+	assert.Equal(t, repository.ErrEmailAlreadyTaken, err)
+
+	// Verify all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCreateWithIDUnit(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	// nolint:errcheck
+	defer sqlDB.Close()
+
+	db := &database.DB{DB: sqlDB}
+	repo := repository.NewUserRepository(logger.NewDiscardLogger(), db)
+	ctx := context.Background()
+
+	verifiedAt := time.Now()
+	user := repository.User{
+		ID:         uuid.Must(uuid.NewV7()),
+		Name:       "John Doe",
+		Email:      "john@example.com",
+		Password:   "hashedpassword",
+		Role:       "editor",
+		VerifiedAt: &verifiedAt,
+	}
+
+	// The ID and verified_at picked by the caller are kept
+	mock.ExpectExec("INSERT INTO users").
+		WithArgs(user.ID, user.Name, user.Email, user.Password, user.Role, user.VerifiedAt, sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	err = repo.Create(ctx, user)
+	assert.NoError(t, err)
+
+	// Verify all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,40 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+)
+
+// CreateUserIdentity links an external identity to an existing user
+func (r *userRepository) CreateUserIdentity(ctx context.Context, identity UserIdentity) error {
+	query := `
+		INSERT INTO user_identities (id, user_id, provider, subject, email, created_at)
+		VALUES (?, ?, ?, ?, ?, ?)
+	`
+
+	_, err := r.db.ExecContext(ctx, query,
+		identity.ID,
+		identity.UserID,
+		identity.Provider,
+		identity.Subject,
+		identity.Email,
+		time.Now(),
+	)
+	if err != nil {
+		r.log.Error("Failed to create user identity",
+			slog.String("error", err.Error()),
+			slog.String("user_id", identity.UserID.String()),
+			slog.String("provider", identity.Provider),
+		)
+		return fmt.Errorf("%w: %w", ErrFailedToCreateUserIdentity, err)
+	}
+
+	r.log.Info("User identity linked successfully",
+		slog.String("user_id", identity.UserID.String()),
+		slog.String("provider", identity.Provider),
+	)
+
+	return nil
+}
@@ -0,0 +1,40 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/fikryfahrezy/let-it-go/feature/user/repository"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateUserIdentity(t *testing.T) {
+	setupTest(t)
+
+	ctx := context.Background()
+	user := createTestUser(t, "link-identity@example.com")
+	identity := repository.UserIdentity{
+		ID:       uuid.Must(uuid.NewV7()),
+		UserID:   user.ID,
+		Provider: "https://idp.example.com",
+		Subject:  "subject-link",
+		Email:    "link-identity@example.com",
+	}
+
+	err := testRepository.CreateUserIdentity(ctx, identity)
+	require.NoError(t, err)
+
+	stored, err := testRepository.GetUserIdentity(ctx, identity.Provider, identity.Subject)
+	require.NoError(t, err)
+	assert.Equal(t, identity.ID, stored.ID)
+	assert.Equal(t, user.ID, stored.UserID)
+
+	// An identity links to at most one user
+	other := createTestUser(t, "link-identity-other@example.com")
+	identity.ID = uuid.Must(uuid.NewV7())
+	identity.UserID = other.ID
+	err = testRepository.CreateUserIdentity(ctx, identity)
+	assert.ErrorIs(t, err, repository.ErrFailedToCreateUserIdentity)
+}
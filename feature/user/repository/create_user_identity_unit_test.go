@@ -0,0 +1,64 @@
+package repository_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/fikryfahrezy/let-it-go/feature/user/repository"
+	"github.com/fikryfahrezy/let-it-go/pkg/database"
+	"github.com/fikryfahrezy/let-it-go/pkg/logger"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateUserIdentityUnit(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	// nolint:errcheck
+	defer sqlDB.Close()
+
+	db := &database.DB{DB: sqlDB}
+	repo := repository.NewUserRepository(logger.NewDiscardLogger(), db)
+	ctx := context.Background()
+
+	identity := repository.UserIdentity{
+		ID:       uuid.New(),
+		UserID:   uuid.New(),
+		Provider: "https://idp.example.com",
+		Subject:  "subject-1",
+		Email:    "user@example.com",
+	}
+
+	mock.ExpectExec("INSERT INTO user_identities").
+		WithArgs(identity.ID, identity.UserID, identity.Provider, identity.Subject, identity.Email, sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+
+	err = repo.CreateUserIdentity(ctx, identity)
+	assert.NoError(t, err)
+
+	// Verify all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCreateUserIdentityErrorUnit(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	// nolint:errcheck
+	defer sqlDB.Close()
+
+	db := &database.DB{DB: sqlDB}
+	repo := repository.NewUserRepository(logger.NewDiscardLogger(), db)
+	ctx := context.Background()
+
+	mock.ExpectExec("INSERT INTO user_identities").
+		WillReturnError(sql.ErrConnDone)
+
+	err = repo.CreateUserIdentity(ctx, repository.UserIdentity{ID: uuid.New(), UserID: uuid.New()})
+	assert.ErrorIs(t, err, repository.ErrFailedToCreateUserIdentity)
+
+	// Verify all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,54 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"time"
+)
+
+// CreateUserWithIdentity creates a user signing in with an external identity for the
+// first time, together with the link to that identity.
+func (r *userRepository) CreateUserWithIdentity(ctx context.Context, user User, identity UserIdentity) error {
+	err := r.withTx(ctx, "create user with identity", func(tx *sql.Tx) error {
+		now := time.Now()
+
+		_, err := tx.ExecContext(ctx, `
+			INSERT INTO users (id, name, email, password, role, verified_at, created_at, updated_at)
+			VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+		`, user.ID, user.Name, user.Email, user.Password, user.Role, user.VerifiedAt, now, now)
+		if err != nil {
+			r.log.Error("Failed to create user",
+				slog.String("error", err.Error()),
+				slog.String("email", user.Email),
+			)
+			return fmt.Errorf("%w: %w", ErrFailedToCreateUser, err)
+		}
+
+		_, err = tx.ExecContext(ctx, `
+			INSERT INTO user_identities (id, user_id, provider, subject, email, created_at)
+			VALUES (?, ?, ?, ?, ?, ?)
+		`, identity.ID, user.ID, identity.Provider, identity.Subject, identity.Email, now)
+		if err != nil {
+			r.log.Error("Failed to create user identity",
+				slog.String("error", err.Error()),
+				slog.String("user_id", user.ID.String()),
+				slog.String("provider", identity.Provider),
+			)
+			return fmt.Errorf("%w: %w", ErrFailedToCreateUserIdentity, err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	r.log.Info("User created with external identity",
+		slog.String("user_id", user.ID.String()),
+		slog.String("provider", identity.Provider),
+	)
+
+	return nil
+}
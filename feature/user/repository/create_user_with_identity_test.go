@@ -0,0 +1,56 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/fikryfahrezy/let-it-go/feature/user/repository"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateUserWithIdentity(t *testing.T) {
+	setupTest(t)
+
+	ctx := context.Background()
+	verifiedAt := time.Now().Truncate(time.Second)
+	user := repository.User{
+		ID:         uuid.Must(uuid.NewV7()),
+		Name:       "External User",
+		Email:      "external@example.com",
+		Role:       "author",
+		VerifiedAt: &verifiedAt,
+	}
+	identity := repository.UserIdentity{
+		ID:       uuid.Must(uuid.NewV7()),
+		Provider: "https://idp.example.com",
+		Subject:  "subject-new",
+		Email:    "external@example.com",
+	}
+
+	err := testRepository.CreateUserWithIdentity(ctx, user, identity)
+	require.NoError(t, err)
+
+	stored, err := testRepository.GetByID(ctx, user.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "external@example.com", stored.Email)
+	assert.Empty(t, stored.Password)
+	assert.NotNil(t, stored.VerifiedAt)
+
+	linked, err := testRepository.GetUserIdentity(ctx, identity.Provider, identity.Subject)
+	require.NoError(t, err)
+	assert.Equal(t, user.ID, linked.UserID)
+
+	// A failing identity insert leaves no user behind
+	orphan := user
+	orphan.ID = uuid.Must(uuid.NewV7())
+	orphan.Email = "orphan@example.com"
+	identity.ID = uuid.Must(uuid.NewV7())
+	err = testRepository.CreateUserWithIdentity(ctx, orphan, identity)
+	assert.ErrorIs(t, err, repository.ErrFailedToCreateUserIdentity)
+
+	_, err = testRepository.GetByEmail(ctx, "orphan@example.com")
+	assert.Equal(t, repository.ErrUserNotFound, err)
+}
@@ -2,16 +2,40 @@ package repository
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 	"log/slog"
+	"time"
 
 	"github.com/google/uuid"
 )
 
+// Delete soft deletes a user and revokes its sessions and personal access tokens in one
+// transaction. The row and the user's blogs are kept so the user can be restored during
+// the grace period; EraseDeletedUsers removes the personal data afterwards.
 func (r *userRepository) Delete(ctx context.Context, id uuid.UUID) error {
-	query := `DELETE FROM users WHERE id = ?`
+	err := r.withTx(ctx, "delete user", func(tx *sql.Tx) error {
+		return r.softDeleteUser(ctx, tx, id, time.Now())
+	})
+	if err != nil {
+		return err
+	}
+
+	r.log.Info("User deleted successfully",
+		slog.String("user_id", id.String()),
+	)
+
+	return nil
+}
 
-	result, err := r.db.ExecContext(ctx, query, id)
+// softDeleteUser marks the user deleted and revokes its credentials. It must run in a
+// transaction so a user is never left deleted with working credentials.
+func (r *userRepository) softDeleteUser(ctx context.Context, tx execer, id uuid.UUID, now time.Time) error {
+	result, err := tx.ExecContext(ctx, `
+		UPDATE users
+		SET deleted_at = ?, updated_at = ?
+		WHERE id = ? AND deleted_at IS NULL
+	`, now, now, id)
 	if err != nil {
 		r.log.Error("Failed to delete user",
 			slog.String("error", err.Error()),
@@ -32,9 +56,29 @@ func (r *userRepository) Delete(ctx context.Context, id uuid.UUID) error {
 		return ErrUserNotFound
 	}
 
-	r.log.Info("User deleted successfully",
-		slog.String("user_id", id.String()),
-	)
+	// Signed in devices and automation stop working right away
+	statements := []struct {
+		query string
+		args  []any
+	}{
+		{
+			query: `UPDATE sessions SET revoked_at = ?, updated_at = ? WHERE user_id = ? AND revoked_at IS NULL`,
+			args:  []any{now, now, id},
+		},
+		{
+			query: `UPDATE personal_access_tokens SET revoked_at = ? WHERE user_id = ? AND revoked_at IS NULL`,
+			args:  []any{now, id},
+		},
+	}
+	for _, statement := range statements {
+		if _, err := tx.ExecContext(ctx, statement.query, statement.args...); err != nil {
+			r.log.Error("Failed to revoke credentials of deleted user",
+				slog.String("error", err.Error()),
+				slog.String("user_id", id.String()),
+			)
+			return fmt.Errorf("%w: %w", ErrFailedToDeleteUser, err)
+		}
+	}
 
 	return nil
 }
@@ -0,0 +1,34 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+)
+
+func (r *userRepository) DeleteExpiredOIDCLoginRequests(ctx context.Context, before time.Time) (int64, error) {
+	query := `DELETE FROM oidc_login_requests WHERE expires_at < ?`
+
+	result, err := r.db.ExecContext(ctx, query, before)
+	if err != nil {
+		r.log.Error("Failed to delete expired OIDC login requests",
+			slog.String("error", err.Error()),
+		)
+		return 0, fmt.Errorf("%w: %w", ErrFailedToDeleteOIDCLoginRequests, err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		r.log.Error("Failed to get rows affected",
+			slog.String("error", err.Error()),
+		)
+		return 0, fmt.Errorf("%w: %w", ErrFailedToGetRowsAffected, err)
+	}
+
+	r.log.Info("Expired OIDC login requests deleted",
+		slog.Int64("count", rowsAffected),
+	)
+
+	return rowsAffected, nil
+}
@@ -0,0 +1,29 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/fikryfahrezy/let-it-go/feature/user/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeleteExpiredOIDCLoginRequests(t *testing.T) {
+	setupTest(t)
+
+	ctx := context.Background()
+	require.NoError(t, testRepository.CreateOIDCLoginRequest(ctx, newTestOIDCLoginRequest("expired-state-hash", -time.Hour)))
+	require.NoError(t, testRepository.CreateOIDCLoginRequest(ctx, newTestOIDCLoginRequest("active-state-hash", time.Hour)))
+
+	deleted, err := testRepository.DeleteExpiredOIDCLoginRequests(ctx, time.Now())
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), deleted)
+
+	_, err = testRepository.ConsumeOIDCLoginRequest(ctx, "expired-state-hash")
+	assert.Equal(t, repository.ErrOIDCLoginRequestNotFound, err)
+
+	_, err = testRepository.ConsumeOIDCLoginRequest(ctx, "active-state-hash")
+	assert.NoError(t, err)
+}
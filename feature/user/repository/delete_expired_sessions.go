@@ -0,0 +1,34 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+)
+
+func (r *userRepository) DeleteExpiredSessions(ctx context.Context, before time.Time) (int64, error) {
+	query := `DELETE FROM sessions WHERE expires_at < ?`
+
+	result, err := r.db.ExecContext(ctx, query, before)
+	if err != nil {
+		r.log.Error("Failed to delete expired sessions",
+			slog.String("error", err.Error()),
+		)
+		return 0, fmt.Errorf("%w: %w", ErrFailedToDeleteExpiredSessions, err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		r.log.Error("Failed to get rows affected",
+			slog.String("error", err.Error()),
+		)
+		return 0, fmt.Errorf("%w: %w", ErrFailedToGetRowsAffected, err)
+	}
+
+	r.log.Info("Expired sessions deleted",
+		slog.Int64("count", rowsAffected),
+	)
+
+	return rowsAffected, nil
+}
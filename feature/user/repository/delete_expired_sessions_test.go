@@ -0,0 +1,33 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/fikryfahrezy/let-it-go/feature/user/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeleteExpiredSessions(t *testing.T) {
+	setupTest(t)
+
+	user := createTestUser(t, "purge@example.com")
+	ctx := context.Background()
+
+	expired := newTestSession(user.ID, "hash-expired", -time.Hour)
+	active := newTestSession(user.ID, "hash-active", time.Hour)
+	require.NoError(t, testRepository.CreateSession(ctx, expired))
+	require.NoError(t, testRepository.CreateSession(ctx, active))
+
+	deleted, err := testRepository.DeleteExpiredSessions(ctx, time.Now())
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), deleted)
+
+	_, err = testRepository.GetSessionByRefreshTokenHash(ctx, expired.RefreshTokenHash)
+	assert.Equal(t, repository.ErrSessionNotFound, err)
+
+	_, err = testRepository.GetSessionByRefreshTokenHash(ctx, active.RefreshTokenHash)
+	assert.NoError(t, err)
+}
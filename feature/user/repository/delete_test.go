@@ -3,6 +3,7 @@ package repository_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/fikryfahrezy/let-it-go/feature/user/repository"
 	"github.com/google/uuid"
@@ -34,6 +35,44 @@ func TestDelete(t *testing.T) {
 	_, err = testRepository.GetByID(ctx, createdUser.ID)
 	assert.Error(t, err)
 	assert.Equal(t, repository.ErrUserNotFound, err)
+
+	// Deleted users are hidden from lookups, lists and counts
+	_, err = testRepository.GetByEmail(ctx, user.Email)
+	assert.Equal(t, repository.ErrUserNotFound, err)
+	users, err := testRepository.List(ctx, repository.ListFilter{}, 10, 0)
+	require.NoError(t, err)
+	assert.Empty(t, users)
+	count, err := testRepository.Count(ctx, repository.ListFilter{})
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), count)
+
+	// The row is kept, so the email stays taken until the user is erased
+	err = testRepository.Create(ctx, user)
+	assert.Equal(t, repository.ErrEmailAlreadyTaken, err)
+
+	// Deleting twice finds no user
+	err = testRepository.Delete(ctx, createdUser.ID)
+	assert.Equal(t, repository.ErrUserNotFound, err)
+}
+
+func TestDeleteRevokesCredentials(t *testing.T) {
+	setupTest(t)
+	ctx := context.Background()
+
+	user := createTestUser(t, "delete-credentials@example.com")
+	require.NoError(t, testRepository.CreateSession(ctx, newTestSession(user.ID, "delete-refresh-hash", time.Hour)))
+	require.NoError(t, testRepository.CreatePersonalAccessToken(ctx, newTestPersonalAccessToken(user.ID, "delete-token-hash", "blogs:write")))
+
+	err := testRepository.Delete(ctx, user.ID)
+	require.NoError(t, err)
+
+	session, err := testRepository.GetSessionByRefreshTokenHash(ctx, "delete-refresh-hash")
+	require.NoError(t, err)
+	assert.NotNil(t, session.RevokedAt)
+
+	accessToken, err := testRepository.GetPersonalAccessTokenByHash(ctx, "delete-token-hash")
+	require.NoError(t, err)
+	assert.NotNil(t, accessToken.RevokedAt)
 }
 
 func TestDeleteNotFound(t *testing.T) {
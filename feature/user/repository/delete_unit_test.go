@@ -2,6 +2,7 @@ package repository_test
 
 import (
 	"context"
+	"database/sql"
 	"testing"
 
 	"github.com/DATA-DOG/go-sqlmock"
@@ -25,10 +26,18 @@ func TestDeleteUnit(t *testing.T) {
 
 	userID := uuid.New()
 
-	// Mock the DELETE query
-	mock.ExpectExec("DELETE FROM users WHERE id = ?").
-		WithArgs(userID).
+	// The user is only marked as deleted, and its credentials are revoked
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE users SET deleted_at = \\?, updated_at = \\? WHERE id = \\? AND deleted_at IS NULL").
+		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), userID).
 		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("UPDATE sessions SET revoked_at").
+		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), userID).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectExec("UPDATE personal_access_tokens SET revoked_at").
+		WithArgs(sqlmock.AnyArg(), userID).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
 
 	err = repo.Delete(ctx, userID)
 	assert.NoError(t, err)
@@ -49,10 +58,12 @@ func TestDeleteNotFoundUnit(t *testing.T) {
 
 	userID := uuid.New()
 
-	// Mock the DELETE query to return 0 affected rows
-	mock.ExpectExec("DELETE FROM users WHERE id = ?").
-		WithArgs(userID).
+	// Mock the UPDATE query to return 0 affected rows
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE users SET deleted_at").
+		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), userID).
 		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectRollback()
 
 	err = repo.Delete(ctx, userID)
 	assert.Error(t, err)
@@ -61,3 +72,27 @@ func TestDeleteNotFoundUnit(t *testing.T) {
 	// Verify all expectations were met
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
+
+func TestDeleteErrorUnit(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	// nolint:errcheck
+	defer sqlDB.Close()
+
+	db := &database.DB{DB: sqlDB}
+	repo := repository.NewUserRepository(logger.NewDiscardLogger(), db)
+	ctx := context.Background()
+
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE users SET deleted_at").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("UPDATE sessions SET revoked_at").
+		WillReturnError(sql.ErrConnDone)
+	mock.ExpectRollback()
+
+	err = repo.Delete(ctx, uuid.New())
+	assert.ErrorIs(t, err, repository.ErrFailedToDeleteUser)
+
+	// Verify all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
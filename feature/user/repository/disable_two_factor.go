@@ -0,0 +1,56 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+
+	"github.com/google/uuid"
+)
+
+// DisableTwoFactor deletes the credential of the user, pending or confirmed, together
+// with its recovery codes
+func (r *userRepository) DisableTwoFactor(ctx context.Context, userID uuid.UUID) error {
+	err := r.withTx(ctx, "disable two factor", func(tx *sql.Tx) error {
+		if _, err := tx.ExecContext(ctx, `DELETE FROM two_factor_recovery_codes WHERE user_id = ?`, userID); err != nil {
+			r.log.Error("Failed to delete recovery codes",
+				slog.String("error", err.Error()),
+				slog.String("user_id", userID.String()),
+			)
+			return fmt.Errorf("%w: %w", ErrFailedToDisableTwoFactor, err)
+		}
+
+		result, err := tx.ExecContext(ctx, `DELETE FROM two_factor_credentials WHERE user_id = ?`, userID)
+		if err != nil {
+			r.log.Error("Failed to delete two factor credential",
+				slog.String("error", err.Error()),
+				slog.String("user_id", userID.String()),
+			)
+			return fmt.Errorf("%w: %w", ErrFailedToDisableTwoFactor, err)
+		}
+
+		rowsAffected, err := result.RowsAffected()
+		if err != nil {
+			r.log.Error("Failed to get rows affected",
+				slog.String("error", err.Error()),
+			)
+			return fmt.Errorf("%w: %w", ErrFailedToGetRowsAffected, err)
+		}
+
+		if rowsAffected == 0 {
+			return ErrTwoFactorCredentialNotFound
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	r.log.Info("Two factor authentication disabled successfully",
+		slog.String("user_id", userID.String()),
+	)
+
+	return nil
+}
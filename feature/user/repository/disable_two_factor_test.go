@@ -0,0 +1,32 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/fikryfahrezy/let-it-go/feature/user/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDisableTwoFactor(t *testing.T) {
+	setupTest(t)
+
+	ctx := context.Background()
+	user := createTestUser(t, "disable-2fa@example.com")
+	enableTestTwoFactor(t, user.ID, "code-hash")
+
+	err := testRepository.DisableTwoFactor(ctx, user.ID)
+	require.NoError(t, err)
+
+	_, err = testRepository.GetTwoFactorCredential(ctx, user.ID)
+	assert.Equal(t, repository.ErrTwoFactorCredentialNotFound, err)
+
+	// The recovery codes went with the credential
+	err = testRepository.UseRecoveryCode(ctx, user.ID, "code-hash")
+	assert.Equal(t, repository.ErrRecoveryCodeNotFound, err)
+
+	// Disabling twice reports the credential as gone
+	err = testRepository.DisableTwoFactor(ctx, user.ID)
+	assert.Equal(t, repository.ErrTwoFactorCredentialNotFound, err)
+}
@@ -0,0 +1,80 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// EnableTwoFactor confirms the pending credential of the user and stores its recovery
+// codes in one transaction. step is the time step of the code that confirmed the
+// enrollment, so that code cannot be used again to log in. A credential that is
+// already confirmed is reported as ErrTwoFactorAlreadyEnabled.
+func (r *userRepository) EnableTwoFactor(ctx context.Context, userID uuid.UUID, step int64, recoveryCodes []TwoFactorRecoveryCode) error {
+	err := r.withTx(ctx, "enable two factor", func(tx *sql.Tx) error {
+		now := time.Now()
+
+		result, err := tx.ExecContext(ctx, `
+			UPDATE two_factor_credentials
+			SET confirmed_at = ?, last_used_step = ?, updated_at = ?
+			WHERE user_id = ? AND confirmed_at IS NULL
+		`, now, step, now, userID)
+		if err != nil {
+			r.log.Error("Failed to confirm two factor credential",
+				slog.String("error", err.Error()),
+				slog.String("user_id", userID.String()),
+			)
+			return fmt.Errorf("%w: %w", ErrFailedToEnableTwoFactor, err)
+		}
+
+		rowsAffected, err := result.RowsAffected()
+		if err != nil {
+			r.log.Error("Failed to get rows affected",
+				slog.String("error", err.Error()),
+			)
+			return fmt.Errorf("%w: %w", ErrFailedToGetRowsAffected, err)
+		}
+
+		if rowsAffected == 0 {
+			return ErrTwoFactorAlreadyEnabled
+		}
+
+		// Codes left over from an earlier enrollment must not keep working
+		if _, err := tx.ExecContext(ctx, `DELETE FROM two_factor_recovery_codes WHERE user_id = ?`, userID); err != nil {
+			r.log.Error("Failed to delete old recovery codes",
+				slog.String("error", err.Error()),
+				slog.String("user_id", userID.String()),
+			)
+			return fmt.Errorf("%w: %w", ErrFailedToEnableTwoFactor, err)
+		}
+
+		for _, recoveryCode := range recoveryCodes {
+			_, err := tx.ExecContext(ctx, `
+				INSERT INTO two_factor_recovery_codes (id, user_id, code_hash, created_at)
+				VALUES (?, ?, ?, ?)
+			`, recoveryCode.ID, userID, recoveryCode.CodeHash, now)
+			if err != nil {
+				r.log.Error("Failed to create recovery code",
+					slog.String("error", err.Error()),
+					slog.String("user_id", userID.String()),
+				)
+				return fmt.Errorf("%w: %w", ErrFailedToEnableTwoFactor, err)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	r.log.Info("Two factor authentication enabled successfully",
+		slog.String("user_id", userID.String()),
+	)
+
+	return nil
+}
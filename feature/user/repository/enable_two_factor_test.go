@@ -0,0 +1,42 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/fikryfahrezy/let-it-go/feature/user/repository"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEnableTwoFactor(t *testing.T) {
+	setupTest(t)
+
+	ctx := context.Background()
+	user := createTestUser(t, "enable-2fa@example.com")
+	require.NoError(t, testRepository.SaveTwoFactorCredential(ctx, repository.TwoFactorCredential{
+		UserID:           user.ID,
+		SecretCiphertext: "ciphertext",
+	}))
+
+	recoveryCodes := []repository.TwoFactorRecoveryCode{
+		{ID: uuid.Must(uuid.NewV7()), CodeHash: "code-hash-1"},
+		{ID: uuid.Must(uuid.NewV7()), CodeHash: "code-hash-2"},
+	}
+	err := testRepository.EnableTwoFactor(ctx, user.ID, 55, recoveryCodes)
+	require.NoError(t, err)
+
+	credential, err := testRepository.GetTwoFactorCredential(ctx, user.ID)
+	require.NoError(t, err)
+	assert.NotNil(t, credential.ConfirmedAt)
+	require.NotNil(t, credential.LastUsedStep)
+	assert.Equal(t, int64(55), *credential.LastUsedStep)
+
+	// The recovery codes were stored for the user
+	assert.NoError(t, testRepository.UseRecoveryCode(ctx, user.ID, "code-hash-2"))
+
+	// Confirming twice is rejected
+	err = testRepository.EnableTwoFactor(ctx, user.ID, 56, nil)
+	assert.Equal(t, repository.ErrTwoFactorAlreadyEnabled, err)
+}
@@ -0,0 +1,96 @@
+package repository_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/fikryfahrezy/let-it-go/feature/user/repository"
+	"github.com/fikryfahrezy/let-it-go/pkg/database"
+	"github.com/fikryfahrezy/let-it-go/pkg/logger"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEnableTwoFactorUnit(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	// nolint:errcheck
+	defer sqlDB.Close()
+
+	db := &database.DB{DB: sqlDB}
+	repo := repository.NewUserRepository(logger.NewDiscardLogger(), db)
+	ctx := context.Background()
+
+	userID := uuid.New()
+	recoveryCode := repository.TwoFactorRecoveryCode{ID: uuid.New(), CodeHash: "code-hash"}
+
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE two_factor_credentials SET confirmed_at").
+		WithArgs(sqlmock.AnyArg(), int64(7), sqlmock.AnyArg(), userID).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("DELETE FROM two_factor_recovery_codes").
+		WithArgs(userID).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec("INSERT INTO two_factor_recovery_codes").
+		WithArgs(recoveryCode.ID, userID, recoveryCode.CodeHash, sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	err = repo.EnableTwoFactor(ctx, userID, 7, []repository.TwoFactorRecoveryCode{recoveryCode})
+	assert.NoError(t, err)
+
+	// Verify all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestEnableTwoFactorAlreadyEnabledUnit(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	// nolint:errcheck
+	defer sqlDB.Close()
+
+	db := &database.DB{DB: sqlDB}
+	repo := repository.NewUserRepository(logger.NewDiscardLogger(), db)
+	ctx := context.Background()
+
+	// No pending credential matched, so no recovery codes are written
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE two_factor_credentials SET confirmed_at").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectRollback()
+
+	err = repo.EnableTwoFactor(ctx, uuid.New(), 7, []repository.TwoFactorRecoveryCode{{ID: uuid.New(), CodeHash: "code-hash"}})
+	assert.Equal(t, repository.ErrTwoFactorAlreadyEnabled, err)
+
+	// Verify all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestEnableTwoFactorErrorUnit(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	// nolint:errcheck
+	defer sqlDB.Close()
+
+	db := &database.DB{DB: sqlDB}
+	repo := repository.NewUserRepository(logger.NewDiscardLogger(), db)
+	ctx := context.Background()
+
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE two_factor_credentials SET confirmed_at").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("DELETE FROM two_factor_recovery_codes").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec("INSERT INTO two_factor_recovery_codes").
+		WillReturnError(sql.ErrConnDone)
+	mock.ExpectRollback()
+
+	err = repo.EnableTwoFactor(ctx, uuid.New(), 7, []repository.TwoFactorRecoveryCode{{ID: uuid.New(), CodeHash: "code-hash"}})
+	assert.ErrorIs(t, err, repository.ErrFailedToEnableTwoFactor)
+
+	// Verify all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -7,11 +7,149 @@ import (
 )
 
 type User struct {
-	ID        uuid.UUID `db:"id"` // UUIDv7
-	Name      string    `db:"name"`
-	Email     string    `db:"email"`
-	Password  string    `db:"password"`
+	ID         uuid.UUID  `db:"id"` // UUIDv7
+	Name       string     `db:"name"`
+	Email      string     `db:"email"`
+	Password   string     `db:"password"`
+	Role       string     `db:"role"`        // One of the rbac roles
+	VerifiedAt *time.Time `db:"verified_at"` // Nil until the email address is confirmed
+	CreatedAt  time.Time  `db:"created_at"`
+	UpdatedAt  time.Time  `db:"updated_at"`
+}
+
+// AuthorProfile is the public page of a user, found by its handle. The email address
+// is never part of it.
+type AuthorProfile struct {
+	UserID      uuid.UUID         `db:"user_id"` // UUIDv7
+	Handle      string            `db:"handle"`  // Unique and URL safe
+	Bio         string            `db:"bio"`
+	AvatarURL   string            `db:"avatar_url"`
+	WebsiteURL  string            `db:"website_url"`
+	SocialLinks map[string]string `db:"social_links"` // Handles keyed by network, stored as JSON
+	CreatedAt   time.Time         `db:"created_at"`
+	UpdatedAt   time.Time         `db:"updated_at"`
+}
+
+// UserPreferences are the settings of a user as a JSON document. The service owns the
+// layout of Document; SchemaVersion records which layout it was written with.
+type UserPreferences struct {
+	UserID        uuid.UUID `db:"user_id"` // UUIDv7
+	SchemaVersion int       `db:"schema_version"`
+	Document      []byte    `db:"preferences"` // JSON
+	CreatedAt     time.Time `db:"created_at"`
+	UpdatedAt     time.Time `db:"updated_at"`
+}
+
+// Session is one refresh token of a login session. Every rotation inserts a new
+// row in the same family so reuse of an already rotated token can be detected.
+type Session struct {
+	ID               uuid.UUID  `db:"id"`        // UUIDv7
+	UserID           uuid.UUID  `db:"user_id"`   // UUIDv7
+	FamilyID         uuid.UUID  `db:"family_id"` // ID of the first session of the login
+	RefreshTokenHash string     `db:"refresh_token_hash"`
+	UserAgent        string     `db:"user_agent"`
+	IPAddress        string     `db:"ip_address"`
+	ExpiresAt        time.Time  `db:"expires_at"`
+	RotatedAt        *time.Time `db:"rotated_at"`
+	RevokedAt        *time.Time `db:"revoked_at"`
+	CreatedAt        time.Time  `db:"created_at"`
+	UpdatedAt        time.Time  `db:"updated_at"`
+}
+
+// ImpersonationSession records an admin being issued a token to act as another user
+type ImpersonationSession struct {
+	ID        uuid.UUID `db:"id"`       // UUIDv7
+	AdminID   uuid.UUID `db:"admin_id"` // UUIDv7
+	UserID    uuid.UUID `db:"user_id"`  // UUIDv7, the impersonated user
+	Reason    string    `db:"reason"`
+	UserAgent string    `db:"user_agent"`
+	IPAddress string    `db:"ip_address"`
+	ExpiresAt time.Time `db:"expires_at"`
+	CreatedAt time.Time `db:"created_at"`
+}
+
+// ImpersonationRequest records one request made with an impersonation token
+type ImpersonationRequest struct {
+	ID        int64     `db:"id"`
+	SessionID uuid.UUID `db:"session_id"`
+	Method    string    `db:"method"`
+	Path      string    `db:"path"`
+	Status    int       `db:"status"`
 	CreatedAt time.Time `db:"created_at"`
-	UpdatedAt time.Time `db:"updated_at"`
 }
 
+// PasswordResetToken is a single-use token emailed to a user who forgot their password
+type PasswordResetToken struct {
+	ID        uuid.UUID  `db:"id"`      // UUIDv7
+	UserID    uuid.UUID  `db:"user_id"` // UUIDv7
+	TokenHash string     `db:"token_hash"`
+	ExpiresAt time.Time  `db:"expires_at"`
+	UsedAt    *time.Time `db:"used_at"`
+	CreatedAt time.Time  `db:"created_at"`
+}
+
+// EmailVerificationToken is a single-use token emailed on signup to confirm the address
+type EmailVerificationToken struct {
+	ID        uuid.UUID  `db:"id"`      // UUIDv7
+	UserID    uuid.UUID  `db:"user_id"` // UUIDv7
+	TokenHash string     `db:"token_hash"`
+	ExpiresAt time.Time  `db:"expires_at"`
+	UsedAt    *time.Time `db:"used_at"`
+	CreatedAt time.Time  `db:"created_at"`
+}
+
+// PersonalAccessToken is a long-lived named token a user creates for automation.
+// It authenticates as its owner but only on routes accepting one of its scopes.
+type PersonalAccessToken struct {
+	ID         uuid.UUID  `db:"id"`      // UUIDv7
+	UserID     uuid.UUID  `db:"user_id"` // UUIDv7
+	Name       string     `db:"name"`
+	TokenHash  string     `db:"token_hash"`
+	Scopes     []string   `db:"scopes"`     // Stored comma separated
+	ExpiresAt  *time.Time `db:"expires_at"` // Nil when the token never expires
+	LastUsedAt *time.Time `db:"last_used_at"`
+	RevokedAt  *time.Time `db:"revoked_at"`
+	CreatedAt  time.Time  `db:"created_at"`
+}
+
+// TwoFactorCredential is the TOTP secret of a user. The service encrypts the secret
+// before it reaches the repository, so only ciphertext is ever stored.
+type TwoFactorCredential struct {
+	UserID           uuid.UUID  `db:"user_id"` // UUIDv7
+	SecretCiphertext string     `db:"secret_ciphertext"`
+	ConfirmedAt      *time.Time `db:"confirmed_at"`   // Nil while the enrollment is pending
+	LastUsedStep     *int64     `db:"last_used_step"` // Time step of the last accepted code, so a code cannot be replayed
+	CreatedAt        time.Time  `db:"created_at"`
+	UpdatedAt        time.Time  `db:"updated_at"`
+}
+
+// TwoFactorRecoveryCode is a single-use code that stands in for a TOTP code when the
+// user has lost their device
+type TwoFactorRecoveryCode struct {
+	ID        uuid.UUID  `db:"id"`      // UUIDv7
+	UserID    uuid.UUID  `db:"user_id"` // UUIDv7
+	CodeHash  string     `db:"code_hash"`
+	UsedAt    *time.Time `db:"used_at"`
+	CreatedAt time.Time  `db:"created_at"`
+}
+
+// UserIdentity links an account at an OpenID Connect provider to a user
+type UserIdentity struct {
+	ID        uuid.UUID `db:"id"`       // UUIDv7
+	UserID    uuid.UUID `db:"user_id"`  // UUIDv7
+	Provider  string    `db:"provider"` // Issuer URL of the provider
+	Subject   string    `db:"subject"`  // Account ID at the provider, stable unlike the email
+	Email     string    `db:"email"`    // Email reported by the provider when the identity was linked
+	CreatedAt time.Time `db:"created_at"`
+}
+
+// OIDCLoginRequest is an OpenID Connect login in progress. It is found again by the
+// hash of the state echoed back by the provider and can be consumed only once.
+type OIDCLoginRequest struct {
+	ID           uuid.UUID `db:"id"` // UUIDv7
+	StateHash    string    `db:"state_hash"`
+	Nonce        string    `db:"nonce"`
+	CodeVerifier string    `db:"code_verifier"` // PKCE verifier, never sent to the browser
+	ExpiresAt    time.Time `db:"expires_at"`
+	CreatedAt    time.Time `db:"created_at"`
+}
@@ -0,0 +1,104 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"time"
+)
+
+// erasedUserName replaces the name of erased users, which stays visible as the author of their blogs
+const erasedUserName = "Deleted user"
+
+// EraseDeletedUsers anonymizes users deleted before deletedBefore in one transaction.
+// Their credentials, sessions, linked identities, two factor data, author profile,
+// preferences, the invitations sent to their email and the failed login attempts counted
+// against it are deleted and the users row keeps no personal data: the email becomes a
+// unique placeholder so the address can sign up again. The row itself stays because blogs
+// still reference it.
+func (r *userRepository) EraseDeletedUsers(ctx context.Context, deletedBefore time.Time) (int64, error) {
+	var rowsAffected int64
+	err := r.withTx(ctx, "erase deleted users", func(tx *sql.Tx) error {
+		// deletedBefore lies in the past, so users deleted meanwhile never join the set
+		const toErase = `SELECT id FROM users WHERE deleted_at < ? AND erased_at IS NULL`
+
+		tables := []string{
+			"sessions",
+			"password_reset_tokens",
+			"email_verification_tokens",
+			"personal_access_tokens",
+			"two_factor_recovery_codes",
+			"two_factor_credentials",
+			"user_identities",
+			"author_profiles",
+			"user_preferences",
+		}
+		for _, table := range tables {
+			query := `DELETE FROM ` + table + ` WHERE user_id IN (` + toErase + `)`
+			if _, err := tx.ExecContext(ctx, query, deletedBefore); err != nil {
+				r.log.Error("Failed to delete personal data of deleted users",
+					slog.String("error", err.Error()),
+					slog.String("table", table),
+				)
+				return fmt.Errorf("%w: %w", ErrFailedToEraseUsers, err)
+			}
+		}
+
+		// Rows keyed by the email go before the email itself is replaced
+		emailKeyed := []struct {
+			table string
+			query string
+		}{
+			{
+				table: "login_attempts",
+				query: `DELETE FROM login_attempts WHERE attempt_key IN (SELECT CONCAT('account:', LOWER(email)) FROM users WHERE deleted_at < ? AND erased_at IS NULL)`,
+			},
+			{
+				table: "invitations",
+				query: `DELETE FROM invitations WHERE email IN (SELECT email FROM users WHERE deleted_at < ? AND erased_at IS NULL)`,
+			},
+		}
+		for _, statement := range emailKeyed {
+			if _, err := tx.ExecContext(ctx, statement.query, deletedBefore); err != nil {
+				r.log.Error("Failed to delete personal data of deleted users",
+					slog.String("error", err.Error()),
+					slog.String("table", statement.table),
+				)
+				return fmt.Errorf("%w: %w", ErrFailedToEraseUsers, err)
+			}
+		}
+
+		now := time.Now()
+		result, err := tx.ExecContext(ctx, `
+			UPDATE users
+			SET name = ?, email = CONCAT('erased-', id, '@invalid'), password = '', verified_at = NULL, erased_at = ?, updated_at = ?
+			WHERE deleted_at < ? AND erased_at IS NULL
+		`, erasedUserName, now, now, deletedBefore)
+		if err != nil {
+			r.log.Error("Failed to anonymize deleted users",
+				slog.String("error", err.Error()),
+			)
+			return fmt.Errorf("%w: %w", ErrFailedToEraseUsers, err)
+		}
+
+		rowsAffected, err = result.RowsAffected()
+		if err != nil {
+			r.log.Error("Failed to get rows affected",
+				slog.String("error", err.Error()),
+			)
+			return fmt.Errorf("%w: %w", ErrFailedToGetRowsAffected, err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	r.log.Info("Deleted users erased",
+		slog.Int64("count", rowsAffected),
+	)
+
+	return rowsAffected, nil
+}
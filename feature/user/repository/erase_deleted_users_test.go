@@ -0,0 +1,109 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/fikryfahrezy/let-it-go/feature/user/repository"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEraseDeletedUsers(t *testing.T) {
+	setupTest(t)
+	ctx := context.Background()
+
+	deleted := createTestUser(t, "erase@example.com")
+	require.NoError(t, testRepository.CreateSession(ctx, newTestSession(deleted.ID, "erase-refresh-hash", time.Hour)))
+	require.NoError(t, testRepository.CreateUserIdentity(ctx, repository.UserIdentity{
+		ID:       uuid.Must(uuid.NewV7()),
+		UserID:   deleted.ID,
+		Provider: "https://idp.example.com",
+		Subject:  "erase-subject",
+		Email:    "erase@example.com",
+	}))
+	enableTestTwoFactor(t, deleted.ID, "erase-code-hash")
+	require.NoError(t, testRepository.SaveAuthorProfile(ctx, repository.AuthorProfile{UserID: deleted.ID, Handle: "erase"}))
+	require.NoError(t, testRepository.SavePreferences(ctx, repository.UserPreferences{UserID: deleted.ID, SchemaVersion: 1, Document: []byte(`{}`)}))
+	require.NoError(t, testRepository.Delete(ctx, deleted.ID))
+
+	active := createTestUser(t, "erase-active@example.com")
+
+	// Failed logins are counted per lowercased email
+	for _, key := range []string{"account:erase@example.com", "account:erase-active@example.com"} {
+		_, err := db.ExecContext(ctx, `INSERT INTO login_attempts (attempt_key, failures, last_failed_at) VALUES (?, 1, ?)`, key, time.Now())
+		require.NoError(t, err)
+	}
+	// The deleted user was invited once, and the active user invited someone else
+	for _, email := range []string{"erase@example.com", "erase-invitee@example.com"} {
+		_, err := db.ExecContext(ctx, `INSERT INTO invitations (id, email, role, token_hash, invited_by, expires_at) VALUES (?, ?, 'author', ?, ?, ?)`,
+			uuid.NewString(), email, email, active.ID, time.Now().Add(time.Hour))
+		require.NoError(t, err)
+	}
+
+	erased, err := testRepository.EraseDeletedUsers(ctx, time.Now().Add(time.Minute))
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), erased)
+
+	// The personal data linked to the user is gone
+	_, err = testRepository.GetSessionByRefreshTokenHash(ctx, "erase-refresh-hash")
+	assert.Equal(t, repository.ErrSessionNotFound, err)
+	_, err = testRepository.GetUserIdentity(ctx, "https://idp.example.com", "erase-subject")
+	assert.Equal(t, repository.ErrUserIdentityNotFound, err)
+	_, err = testRepository.GetTwoFactorCredential(ctx, deleted.ID)
+	assert.Equal(t, repository.ErrTwoFactorCredentialNotFound, err)
+	_, err = testRepository.GetAuthorProfile(ctx, deleted.ID)
+	assert.Equal(t, repository.ErrAuthorProfileNotFound, err)
+	_, err = testRepository.GetPreferences(ctx, deleted.ID)
+	assert.Equal(t, repository.ErrPreferencesNotFound, err)
+	assert.Equal(t, []string{"account:erase-active@example.com"}, queryTestStrings(t, `SELECT attempt_key FROM login_attempts WHERE attempt_key LIKE 'account:erase%'`))
+	assert.Equal(t, []string{"erase-invitee@example.com"}, queryTestStrings(t, `SELECT email FROM invitations`))
+
+	// The email can sign up again
+	err = testRepository.Create(ctx, repository.User{Name: "Again", Email: "erase@example.com", Password: "hashedpassword", Role: "author"})
+	assert.NoError(t, err)
+
+	// Users that are not deleted are untouched, and erasing is done once
+	_, err = testRepository.GetByID(ctx, active.ID)
+	assert.NoError(t, err)
+	erased, err = testRepository.EraseDeletedUsers(ctx, time.Now().Add(time.Minute))
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), erased)
+}
+
+func TestEraseDeletedUsersWithinGracePeriod(t *testing.T) {
+	setupTest(t)
+	ctx := context.Background()
+
+	user := createTestUser(t, "erase-recent@example.com")
+	require.NoError(t, testRepository.Delete(ctx, user.ID))
+
+	erased, err := testRepository.EraseDeletedUsers(ctx, time.Now().Add(-time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), erased)
+
+	// Still restorable
+	assert.NoError(t, testRepository.Restore(ctx, user.ID, time.Now().Add(-time.Hour)))
+}
+
+// queryTestStrings returns the single string column of every row the query returns
+func queryTestStrings(t *testing.T, query string) []string {
+	t.Helper()
+
+	rows, err := db.Query(query)
+	require.NoError(t, err)
+	// nolint:errcheck
+	defer rows.Close()
+
+	var values []string
+	for rows.Next() {
+		var value string
+		require.NoError(t, rows.Scan(&value))
+		values = append(values, value)
+	}
+	require.NoError(t, rows.Err())
+
+	return values
+}
@@ -0,0 +1,84 @@
+package repository_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/fikryfahrezy/let-it-go/feature/user/repository"
+	"github.com/fikryfahrezy/let-it-go/pkg/database"
+	"github.com/fikryfahrezy/let-it-go/pkg/logger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEraseDeletedUsersUnit(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	// nolint:errcheck
+	defer sqlDB.Close()
+
+	db := &database.DB{DB: sqlDB}
+	repo := repository.NewUserRepository(logger.NewDiscardLogger(), db)
+	ctx := context.Background()
+
+	deletedBefore := time.Now().Add(-30 * 24 * time.Hour)
+
+	mock.ExpectBegin()
+	for _, table := range []string{
+		"sessions",
+		"password_reset_tokens",
+		"email_verification_tokens",
+		"personal_access_tokens",
+		"two_factor_recovery_codes",
+		"two_factor_credentials",
+		"user_identities",
+		"author_profiles",
+		"user_preferences",
+	} {
+		mock.ExpectExec("DELETE FROM " + table + " WHERE user_id IN \\(SELECT id FROM users WHERE deleted_at < \\? AND erased_at IS NULL\\)").
+			WithArgs(deletedBefore).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+	}
+	mock.ExpectExec("DELETE FROM login_attempts WHERE attempt_key IN \\(SELECT CONCAT\\('account:', LOWER\\(email\\)\\) FROM users WHERE deleted_at < \\? AND erased_at IS NULL\\)").
+		WithArgs(deletedBefore).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("DELETE FROM invitations WHERE email IN \\(SELECT email FROM users WHERE deleted_at < \\? AND erased_at IS NULL\\)").
+		WithArgs(deletedBefore).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("UPDATE users SET name = \\?, email = CONCAT").
+		WithArgs("Deleted user", sqlmock.AnyArg(), sqlmock.AnyArg(), deletedBefore).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectCommit()
+
+	erased, err := repo.EraseDeletedUsers(ctx, deletedBefore)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), erased)
+
+	// Verify all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestEraseDeletedUsersErrorUnit(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	// nolint:errcheck
+	defer sqlDB.Close()
+
+	db := &database.DB{DB: sqlDB}
+	repo := repository.NewUserRepository(logger.NewDiscardLogger(), db)
+	ctx := context.Background()
+
+	mock.ExpectBegin()
+	mock.ExpectExec("DELETE FROM sessions").
+		WillReturnError(sql.ErrConnDone)
+	mock.ExpectRollback()
+
+	_, err = repo.EraseDeletedUsers(ctx, time.Now())
+	assert.ErrorIs(t, err, repository.ErrFailedToEraseUsers)
+
+	// Verify all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -15,12 +15,94 @@ var (
 	ErrFailedToDeleteUser     = app_error.New("USER-FAILED_TO_DELETE_USER", "failed to delete user")
 	ErrFailedToListUsers      = app_error.New("USER-FAILED_TO_LIST_USERS", "failed to list users")
 	ErrFailedToCountUsers     = app_error.New("USER-FAILED_TO_COUNT_USERS", "failed to count users")
+	ErrFailedToUpdateRole     = app_error.New("USER-FAILED_TO_UPDATE_ROLE", "failed to update role")
+	ErrEmailAlreadyTaken      = app_error.New("USER-EMAIL_ALREADY_TAKEN", "email address is already taken")
+	ErrInvalidSortColumn      = app_error.New("USER-INVALID_SORT_COLUMN", "users cannot be sorted by this column")
+	ErrInvalidUserChange      = app_error.New("USER-INVALID_USER_CHANGE", "user change has an unknown action")
+
+	// Soft delete errors
+	ErrDeletedUserNotFound = app_error.New("USER-DELETED_USER_NOT_FOUND", "deleted user not found or its grace period has passed")
+	ErrFailedToRestoreUser = app_error.New("USER-FAILED_TO_RESTORE_USER", "failed to restore user")
+	ErrFailedToEraseUsers  = app_error.New("USER-FAILED_TO_ERASE_USERS", "failed to erase deleted users")
+
+	// Author profile errors
+	ErrAuthorProfileNotFound     = app_error.New("USER-AUTHOR_PROFILE_NOT_FOUND", "author profile not found")
+	ErrHandleAlreadyTaken        = app_error.New("USER-HANDLE_ALREADY_TAKEN", "handle is already taken")
+	ErrFailedToGetAuthorProfile  = app_error.New("USER-FAILED_TO_GET_AUTHOR_PROFILE", "failed to get author profile")
+	ErrFailedToSaveAuthorProfile = app_error.New("USER-FAILED_TO_SAVE_AUTHOR_PROFILE", "failed to save author profile")
+
+	// Preferences errors
+	ErrPreferencesNotFound     = app_error.New("USER-PREFERENCES_NOT_FOUND", "user preferences not found")
+	ErrFailedToGetPreferences  = app_error.New("USER-FAILED_TO_GET_PREFERENCES", "failed to get user preferences")
+	ErrFailedToSavePreferences = app_error.New("USER-FAILED_TO_SAVE_PREFERENCES", "failed to save user preferences")
+
+	// Session errors
+	ErrSessionNotFound               = app_error.New("USER-SESSION_NOT_FOUND", "session not found")
+	ErrSessionAlreadyRotated         = app_error.New("USER-SESSION_ALREADY_ROTATED", "session was already rotated or revoked")
+	ErrFailedToCreateSession         = app_error.New("USER-FAILED_TO_CREATE_SESSION", "failed to create session")
+	ErrFailedToGetSession            = app_error.New("USER-FAILED_TO_GET_SESSION", "failed to get session")
+	ErrFailedToRotateSession         = app_error.New("USER-FAILED_TO_ROTATE_SESSION", "failed to rotate session")
+	ErrFailedToRevokeSession         = app_error.New("USER-FAILED_TO_REVOKE_SESSION", "failed to revoke session")
+	ErrFailedToListSessions          = app_error.New("USER-FAILED_TO_LIST_SESSIONS", "failed to list sessions")
+	ErrFailedToDeleteExpiredSessions = app_error.New("USER-FAILED_TO_DELETE_EXPIRED_SESSIONS", "failed to delete expired sessions")
+
+	// Impersonation errors
+	ErrFailedToCreateImpersonationSession = app_error.New("USER-FAILED_TO_CREATE_IMPERSONATION_SESSION", "failed to create impersonation session")
+	ErrFailedToRecordImpersonatedRequest  = app_error.New("USER-FAILED_TO_RECORD_IMPERSONATED_REQUEST", "failed to record impersonated request")
+
+	// Password reset errors
+	ErrPasswordResetTokenNotFound       = app_error.New("USER-PASSWORD_RESET_TOKEN_NOT_FOUND", "password reset token not found")
+	ErrPasswordResetTokenAlreadyUsed    = app_error.New("USER-PASSWORD_RESET_TOKEN_ALREADY_USED", "password reset token was already used or has expired")
+	ErrFailedToCreatePasswordResetToken = app_error.New("USER-FAILED_TO_CREATE_PASSWORD_RESET_TOKEN", "failed to create password reset token")
+	ErrFailedToGetPasswordResetToken    = app_error.New("USER-FAILED_TO_GET_PASSWORD_RESET_TOKEN", "failed to get password reset token")
+	ErrFailedToResetPassword            = app_error.New("USER-FAILED_TO_RESET_PASSWORD", "failed to reset password")
+	ErrFailedToUpdatePassword           = app_error.New("USER-FAILED_TO_UPDATE_PASSWORD", "failed to update password")
+
+	// Email verification errors
+	ErrEmailVerificationTokenNotFound       = app_error.New("USER-EMAIL_VERIFICATION_TOKEN_NOT_FOUND", "email verification token not found")
+	ErrEmailVerificationTokenAlreadyUsed    = app_error.New("USER-EMAIL_VERIFICATION_TOKEN_ALREADY_USED", "email verification token was already used or has expired")
+	ErrFailedToCreateEmailVerificationToken = app_error.New("USER-FAILED_TO_CREATE_EMAIL_VERIFICATION_TOKEN", "failed to create email verification token")
+	ErrFailedToGetEmailVerificationToken    = app_error.New("USER-FAILED_TO_GET_EMAIL_VERIFICATION_TOKEN", "failed to get email verification token")
+	ErrFailedToVerifyEmail                  = app_error.New("USER-FAILED_TO_VERIFY_EMAIL", "failed to verify email")
+
+	// Personal access token errors
+	ErrPersonalAccessTokenNotFound       = app_error.New("USER-PERSONAL_ACCESS_TOKEN_NOT_FOUND", "personal access token not found")
+	ErrFailedToCreatePersonalAccessToken = app_error.New("USER-FAILED_TO_CREATE_PERSONAL_ACCESS_TOKEN", "failed to create personal access token")
+	ErrFailedToGetPersonalAccessToken    = app_error.New("USER-FAILED_TO_GET_PERSONAL_ACCESS_TOKEN", "failed to get personal access token")
+	ErrFailedToListPersonalAccessTokens  = app_error.New("USER-FAILED_TO_LIST_PERSONAL_ACCESS_TOKENS", "failed to list personal access tokens")
+	ErrFailedToRevokePersonalAccessToken = app_error.New("USER-FAILED_TO_REVOKE_PERSONAL_ACCESS_TOKEN", "failed to revoke personal access token")
+	ErrFailedToUpdatePersonalAccessToken = app_error.New("USER-FAILED_TO_UPDATE_PERSONAL_ACCESS_TOKEN", "failed to update personal access token")
+
+	// Two factor authentication errors
+	ErrTwoFactorCredentialNotFound     = app_error.New("USER-TWO_FACTOR_CREDENTIAL_NOT_FOUND", "two factor authentication is not set up")
+	ErrTwoFactorAlreadyEnabled         = app_error.New("USER-TWO_FACTOR_ALREADY_ENABLED", "two factor authentication is already enabled")
+	ErrTwoFactorCodeAlreadyUsed        = app_error.New("USER-TWO_FACTOR_CODE_ALREADY_USED", "two factor code was already used")
+	ErrRecoveryCodeNotFound            = app_error.New("USER-RECOVERY_CODE_NOT_FOUND", "recovery code not found or already used")
+	ErrFailedToSaveTwoFactorCredential = app_error.New("USER-FAILED_TO_SAVE_TWO_FACTOR_CREDENTIAL", "failed to save two factor credential")
+	ErrFailedToGetTwoFactorCredential  = app_error.New("USER-FAILED_TO_GET_TWO_FACTOR_CREDENTIAL", "failed to get two factor credential")
+	ErrFailedToEnableTwoFactor         = app_error.New("USER-FAILED_TO_ENABLE_TWO_FACTOR", "failed to enable two factor authentication")
+	ErrFailedToDisableTwoFactor        = app_error.New("USER-FAILED_TO_DISABLE_TWO_FACTOR", "failed to disable two factor authentication")
+	ErrFailedToUseTwoFactorCode        = app_error.New("USER-FAILED_TO_USE_TWO_FACTOR_CODE", "failed to record two factor code use")
+	ErrFailedToUseRecoveryCode         = app_error.New("USER-FAILED_TO_USE_RECOVERY_CODE", "failed to use recovery code")
+
+	// OpenID Connect errors
+	ErrUserIdentityNotFound            = app_error.New("USER-USER_IDENTITY_NOT_FOUND", "user identity not found")
+	ErrOIDCLoginRequestNotFound        = app_error.New("USER-OIDC_LOGIN_REQUEST_NOT_FOUND", "openid connect login request not found")
+	ErrFailedToCreateUserIdentity      = app_error.New("USER-FAILED_TO_CREATE_USER_IDENTITY", "failed to create user identity")
+	ErrFailedToGetUserIdentity         = app_error.New("USER-FAILED_TO_GET_USER_IDENTITY", "failed to get user identity")
+	ErrFailedToCreateOIDCLoginRequest  = app_error.New("USER-FAILED_TO_CREATE_OIDC_LOGIN_REQUEST", "failed to create openid connect login request")
+	ErrFailedToConsumeOIDCLoginRequest = app_error.New("USER-FAILED_TO_CONSUME_OIDC_LOGIN_REQUEST", "failed to consume openid connect login request")
+	ErrFailedToDeleteOIDCLoginRequests = app_error.New("USER-FAILED_TO_DELETE_OIDC_LOGIN_REQUESTS", "failed to delete expired openid connect login requests")
 
 	// Row scanning errors
-	ErrFailedToScanUserRow = app_error.New("USER-FAILED_TO_SCAN_USER_ROW", "failed to scan user row")
+	ErrFailedToScanUserRow    = app_error.New("USER-FAILED_TO_SCAN_USER_ROW", "failed to scan user row")
+	ErrFailedToScanSessionRow = app_error.New("USER-FAILED_TO_SCAN_SESSION_ROW", "failed to scan session row")
+	ErrFailedToScanTokenRow   = app_error.New("USER-FAILED_TO_SCAN_TOKEN_ROW", "failed to scan personal access token row")
 
 	// Database result errors
 	ErrFailedToGetLastInsertID = app_error.New("USER-FAILED_TO_GET_LAST_INSERT_ID", "failed to get last insert id")
 	ErrFailedToGetRowsAffected = app_error.New("USER-FAILED_TO_GET_ROWS_AFFECTED", "failed to get rows affected")
 	ErrFailedToIterateRows     = app_error.New("USER-FAILED_TO_ITERATE_ROWS", "error iterating rows")
-)
\ No newline at end of file
+	ErrFailedToBeginTx         = app_error.New("USER-FAILED_TO_BEGIN_TX", "failed to begin transaction")
+	ErrFailedToCommitTx        = app_error.New("USER-FAILED_TO_COMMIT_TX", "failed to commit transaction")
+)
@@ -0,0 +1,60 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+
+	"github.com/google/uuid"
+)
+
+func (r *userRepository) GetAuthorProfile(ctx context.Context, userID uuid.UUID) (AuthorProfile, error) {
+	query := `
+		SELECT user_id, handle, bio, avatar_url, website_url, social_links, created_at, updated_at
+		FROM author_profiles
+		WHERE user_id = ?
+	`
+
+	profile, err := scanAuthorProfile(r.db.QueryRowContext(ctx, query, userID))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return AuthorProfile{}, ErrAuthorProfileNotFound
+		}
+		r.log.Error("Failed to get author profile",
+			slog.String("error", err.Error()),
+			slog.String("user_id", userID.String()),
+		)
+		return AuthorProfile{}, fmt.Errorf("%w: %w", ErrFailedToGetAuthorProfile, err)
+	}
+
+	return profile, nil
+}
+
+// scanAuthorProfile reads an author_profiles row, decoding the social links from JSON
+func scanAuthorProfile(row *sql.Row) (AuthorProfile, error) {
+	var profile AuthorProfile
+	var socialLinks []byte
+	err := row.Scan(
+		&profile.UserID,
+		&profile.Handle,
+		&profile.Bio,
+		&profile.AvatarURL,
+		&profile.WebsiteURL,
+		&socialLinks,
+		&profile.CreatedAt,
+		&profile.UpdatedAt,
+	)
+	if err != nil {
+		return AuthorProfile{}, err
+	}
+
+	if len(socialLinks) > 0 {
+		if err := json.Unmarshal(socialLinks, &profile.SocialLinks); err != nil {
+			return AuthorProfile{}, err
+		}
+	}
+
+	return profile, nil
+}
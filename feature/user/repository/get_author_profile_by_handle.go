@@ -0,0 +1,33 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+)
+
+// GetAuthorProfileByHandle finds the profile with the handle. Profiles of deleted users
+// are not found.
+func (r *userRepository) GetAuthorProfileByHandle(ctx context.Context, handle string) (AuthorProfile, error) {
+	query := `
+		SELECT p.user_id, p.handle, p.bio, p.avatar_url, p.website_url, p.social_links, p.created_at, p.updated_at
+		FROM author_profiles p
+		JOIN users u ON u.id = p.user_id
+		WHERE p.handle = ? AND u.deleted_at IS NULL
+	`
+
+	profile, err := scanAuthorProfile(r.db.QueryRowContext(ctx, query, handle))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return AuthorProfile{}, ErrAuthorProfileNotFound
+		}
+		r.log.Error("Failed to get author profile by handle",
+			slog.String("error", err.Error()),
+			slog.String("handle", handle),
+		)
+		return AuthorProfile{}, fmt.Errorf("%w: %w", ErrFailedToGetAuthorProfile, err)
+	}
+
+	return profile, nil
+}
@@ -0,0 +1,38 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/fikryfahrezy/let-it-go/feature/user/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetAuthorProfileByHandle(t *testing.T) {
+	setupTest(t)
+
+	ctx := context.Background()
+	user := createTestUser(t, "author-handle@example.com")
+	require.NoError(t, testRepository.SaveAuthorProfile(ctx, repository.AuthorProfile{UserID: user.ID, Handle: "author-handle"}))
+
+	profile, err := testRepository.GetAuthorProfileByHandle(ctx, "author-handle")
+	require.NoError(t, err)
+	assert.Equal(t, user.ID, profile.UserID)
+	assert.Nil(t, profile.SocialLinks)
+
+	_, err = testRepository.GetAuthorProfileByHandle(ctx, "someone-else")
+	assert.Equal(t, repository.ErrAuthorProfileNotFound, err)
+}
+
+func TestGetAuthorProfileByHandleDeletedUser(t *testing.T) {
+	setupTest(t)
+
+	ctx := context.Background()
+	user := createTestUser(t, "author-handle-deleted@example.com")
+	require.NoError(t, testRepository.SaveAuthorProfile(ctx, repository.AuthorProfile{UserID: user.ID, Handle: "deleted-author"}))
+	require.NoError(t, testRepository.Delete(ctx, user.ID))
+
+	_, err := testRepository.GetAuthorProfileByHandle(ctx, "deleted-author")
+	assert.Equal(t, repository.ErrAuthorProfileNotFound, err)
+}
@@ -0,0 +1,65 @@
+package repository_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/fikryfahrezy/let-it-go/feature/user/repository"
+	"github.com/fikryfahrezy/let-it-go/pkg/database"
+	"github.com/fikryfahrezy/let-it-go/pkg/logger"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetAuthorProfileByHandleUnit(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	// nolint:errcheck
+	defer sqlDB.Close()
+
+	db := &database.DB{DB: sqlDB}
+	repo := repository.NewUserRepository(logger.NewDiscardLogger(), db)
+	ctx := context.Background()
+
+	userID := uuid.New()
+	now := time.Now()
+	rows := sqlmock.NewRows(authorProfileColumns).
+		AddRow(userID, "jane", "", "", "", nil, now, now)
+
+	mock.ExpectQuery("SELECT (.+) FROM author_profiles p JOIN users u ON u.id = p.user_id WHERE p.handle = \\? AND u.deleted_at IS NULL").
+		WithArgs("jane").
+		WillReturnRows(rows)
+
+	profile, err := repo.GetAuthorProfileByHandle(ctx, "jane")
+	assert.NoError(t, err)
+	assert.Equal(t, userID, profile.UserID)
+	assert.Nil(t, profile.SocialLinks)
+
+	// Verify all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetAuthorProfileByHandleNotFoundUnit(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	// nolint:errcheck
+	defer sqlDB.Close()
+
+	db := &database.DB{DB: sqlDB}
+	repo := repository.NewUserRepository(logger.NewDiscardLogger(), db)
+	ctx := context.Background()
+
+	mock.ExpectQuery("SELECT (.+) FROM author_profiles").
+		WithArgs("nobody").
+		WillReturnError(sql.ErrNoRows)
+
+	_, err = repo.GetAuthorProfileByHandle(ctx, "nobody")
+	assert.Equal(t, repository.ErrAuthorProfileNotFound, err)
+
+	// Verify all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
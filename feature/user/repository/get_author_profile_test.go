@@ -0,0 +1,44 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/fikryfahrezy/let-it-go/feature/user/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetAuthorProfile(t *testing.T) {
+	setupTest(t)
+
+	ctx := context.Background()
+	user := createTestUser(t, "get-author@example.com")
+	require.NoError(t, testRepository.SaveAuthorProfile(ctx, repository.AuthorProfile{
+		UserID:      user.ID,
+		Handle:      "get-author",
+		Bio:         "Writes about Go",
+		AvatarURL:   "https://cdn.example.com/avatar.png",
+		WebsiteURL:  "https://example.com",
+		SocialLinks: map[string]string{"github": "get-author"},
+	}))
+
+	profile, err := testRepository.GetAuthorProfile(ctx, user.ID)
+	require.NoError(t, err)
+	assert.Equal(t, user.ID, profile.UserID)
+	assert.Equal(t, "get-author", profile.Handle)
+	assert.Equal(t, "Writes about Go", profile.Bio)
+	assert.Equal(t, "https://cdn.example.com/avatar.png", profile.AvatarURL)
+	assert.Equal(t, "https://example.com", profile.WebsiteURL)
+	assert.Equal(t, map[string]string{"github": "get-author"}, profile.SocialLinks)
+	assert.False(t, profile.CreatedAt.IsZero())
+}
+
+func TestGetAuthorProfileNotFound(t *testing.T) {
+	setupTest(t)
+
+	user := createTestUser(t, "get-author-missing@example.com")
+
+	_, err := testRepository.GetAuthorProfile(context.Background(), user.ID)
+	assert.Equal(t, repository.ErrAuthorProfileNotFound, err)
+}
@@ -0,0 +1,88 @@
+package repository_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/fikryfahrezy/let-it-go/feature/user/repository"
+	"github.com/fikryfahrezy/let-it-go/pkg/database"
+	"github.com/fikryfahrezy/let-it-go/pkg/logger"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var authorProfileColumns = []string{"user_id", "handle", "bio", "avatar_url", "website_url", "social_links", "created_at", "updated_at"}
+
+func TestGetAuthorProfileUnit(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	// nolint:errcheck
+	defer sqlDB.Close()
+
+	db := &database.DB{DB: sqlDB}
+	repo := repository.NewUserRepository(logger.NewDiscardLogger(), db)
+	ctx := context.Background()
+
+	userID := uuid.New()
+	now := time.Now()
+	rows := sqlmock.NewRows(authorProfileColumns).
+		AddRow(userID, "jane", "Writes about Go", "https://cdn.example.com/jane.png", "https://jane.example.com", []byte(`{"github":"jane"}`), now, now)
+
+	mock.ExpectQuery("SELECT (.+) FROM author_profiles WHERE user_id = ?").
+		WithArgs(userID).
+		WillReturnRows(rows)
+
+	profile, err := repo.GetAuthorProfile(ctx, userID)
+	assert.NoError(t, err)
+	assert.Equal(t, userID, profile.UserID)
+	assert.Equal(t, "jane", profile.Handle)
+	assert.Equal(t, "Writes about Go", profile.Bio)
+	assert.Equal(t, map[string]string{"github": "jane"}, profile.SocialLinks)
+
+	// Verify all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetAuthorProfileNotFoundUnit(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	// nolint:errcheck
+	defer sqlDB.Close()
+
+	db := &database.DB{DB: sqlDB}
+	repo := repository.NewUserRepository(logger.NewDiscardLogger(), db)
+	ctx := context.Background()
+
+	mock.ExpectQuery("SELECT (.+) FROM author_profiles WHERE user_id = ?").
+		WillReturnError(sql.ErrNoRows)
+
+	_, err = repo.GetAuthorProfile(ctx, uuid.New())
+	assert.Equal(t, repository.ErrAuthorProfileNotFound, err)
+
+	// Verify all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetAuthorProfileErrorUnit(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	// nolint:errcheck
+	defer sqlDB.Close()
+
+	db := &database.DB{DB: sqlDB}
+	repo := repository.NewUserRepository(logger.NewDiscardLogger(), db)
+	ctx := context.Background()
+
+	mock.ExpectQuery("SELECT (.+) FROM author_profiles WHERE user_id = ?").
+		WillReturnError(sql.ErrConnDone)
+
+	_, err = repo.GetAuthorProfile(ctx, uuid.New())
+	assert.ErrorIs(t, err, repository.ErrFailedToGetAuthorProfile)
+
+	// Verify all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
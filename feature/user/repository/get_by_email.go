@@ -9,9 +9,9 @@ import (
 
 func (r *userRepository) GetByEmail(ctx context.Context, email string) (User, error) {
 	query := `
-		SELECT id, name, email, password, created_at, updated_at
+		SELECT id, name, email, password, role, verified_at, created_at, updated_at
 		FROM users
-		WHERE email = ?
+		WHERE email = ? AND deleted_at IS NULL
 	`
 
 	var user User
@@ -20,6 +20,8 @@ func (r *userRepository) GetByEmail(ctx context.Context, email string) (User, er
 		&user.Name,
 		&user.Email,
 		&user.Password,
+		&user.Role,
+		&user.VerifiedAt,
 		&user.CreatedAt,
 		&user.UpdatedAt,
 	)
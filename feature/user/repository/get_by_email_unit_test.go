@@ -36,10 +36,10 @@ func TestGetByEmailUnit(t *testing.T) {
 	}
 
 	// Mock the SELECT query
-	rows := sqlmock.NewRows([]string{"id", "name", "email", "password", "created_at", "updated_at"}).
-		AddRow(expectedUser.ID, expectedUser.Name, expectedUser.Email, expectedUser.Password, expectedUser.CreatedAt, expectedUser.UpdatedAt)
+	rows := sqlmock.NewRows([]string{"id", "name", "email", "password", "role", "verified_at", "created_at", "updated_at"}).
+		AddRow(expectedUser.ID, expectedUser.Name, expectedUser.Email, expectedUser.Password, "author", nil, expectedUser.CreatedAt, expectedUser.UpdatedAt)
 
-	mock.ExpectQuery("SELECT (.+) FROM users WHERE email = ?").
+	mock.ExpectQuery("SELECT (.+) FROM users WHERE email = \\? AND deleted_at IS NULL").
 		WithArgs(email).
 		WillReturnRows(rows)
 
@@ -49,6 +49,7 @@ func TestGetByEmailUnit(t *testing.T) {
 	assert.Equal(t, expectedUser.Name, result.Name)
 	assert.Equal(t, expectedUser.Email, result.Email)
 	assert.Equal(t, expectedUser.Password, result.Password)
+	assert.Equal(t, "author", result.Role)
 
 	// Verify all expectations were met
 	assert.NoError(t, mock.ExpectationsWereMet())
@@ -67,7 +68,7 @@ func TestGetByEmailNotFoundUnit(t *testing.T) {
 	email := "nonexistent@example.com"
 
 	// Mock the SELECT query to return no rows
-	mock.ExpectQuery("SELECT (.+) FROM users WHERE email = ?").
+	mock.ExpectQuery("SELECT (.+) FROM users WHERE email = \\? AND deleted_at IS NULL").
 		WithArgs(email).
 		WillReturnError(sql.ErrNoRows)
 
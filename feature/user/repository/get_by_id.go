@@ -11,9 +11,9 @@ import (
 
 func (r *userRepository) GetByID(ctx context.Context, id uuid.UUID) (User, error) {
 	query := `
-		SELECT id, name, email, password, created_at, updated_at
+		SELECT id, name, email, password, role, verified_at, created_at, updated_at
 		FROM users
-		WHERE id = ?
+		WHERE id = ? AND deleted_at IS NULL
 	`
 
 	var user User
@@ -22,6 +22,8 @@ func (r *userRepository) GetByID(ctx context.Context, id uuid.UUID) (User, error
 		&user.Name,
 		&user.Email,
 		&user.Password,
+		&user.Role,
+		&user.VerifiedAt,
 		&user.CreatedAt,
 		&user.UpdatedAt,
 	)
@@ -36,8 +36,8 @@ func TestGetByIDUnit(t *testing.T) {
 	}
 
 	// Mock the SELECT query
-	rows := sqlmock.NewRows([]string{"id", "name", "email", "password", "created_at", "updated_at"}).
-		AddRow(expectedUser.ID, expectedUser.Name, expectedUser.Email, expectedUser.Password, expectedUser.CreatedAt, expectedUser.UpdatedAt)
+	rows := sqlmock.NewRows([]string{"id", "name", "email", "password", "role", "verified_at", "created_at", "updated_at"}).
+		AddRow(expectedUser.ID, expectedUser.Name, expectedUser.Email, expectedUser.Password, "author", nil, expectedUser.CreatedAt, expectedUser.UpdatedAt)
 
 	mock.ExpectQuery("SELECT (.+) FROM users WHERE id = ?").
 		WithArgs(userID).
@@ -49,6 +49,7 @@ func TestGetByIDUnit(t *testing.T) {
 	assert.Equal(t, expectedUser.Name, result.Name)
 	assert.Equal(t, expectedUser.Email, result.Email)
 	assert.Equal(t, expectedUser.Password, result.Password)
+	assert.Equal(t, "author", result.Role)
 
 	// Verify all expectations were met
 	assert.NoError(t, mock.ExpectationsWereMet())
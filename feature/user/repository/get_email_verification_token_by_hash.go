@@ -0,0 +1,37 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+)
+
+func (r *userRepository) GetEmailVerificationTokenByHash(ctx context.Context, tokenHash string) (EmailVerificationToken, error) {
+	query := `
+		SELECT id, user_id, token_hash, expires_at, used_at, created_at
+		FROM email_verification_tokens
+		WHERE token_hash = ?
+	`
+
+	var verificationToken EmailVerificationToken
+	err := r.db.QueryRowContext(ctx, query, tokenHash).Scan(
+		&verificationToken.ID,
+		&verificationToken.UserID,
+		&verificationToken.TokenHash,
+		&verificationToken.ExpiresAt,
+		&verificationToken.UsedAt,
+		&verificationToken.CreatedAt,
+	)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return EmailVerificationToken{}, ErrEmailVerificationTokenNotFound
+		}
+		r.log.Error("Failed to get email verification token by hash",
+			slog.String("error", err.Error()),
+		)
+		return EmailVerificationToken{}, fmt.Errorf("%w: %w", ErrFailedToGetEmailVerificationToken, err)
+	}
+
+	return verificationToken, nil
+}
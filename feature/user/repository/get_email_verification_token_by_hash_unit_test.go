@@ -0,0 +1,68 @@
+package repository_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/fikryfahrezy/let-it-go/feature/user/repository"
+	"github.com/fikryfahrezy/let-it-go/pkg/database"
+	"github.com/fikryfahrezy/let-it-go/pkg/logger"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetEmailVerificationTokenByHashUnit(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	// nolint:errcheck
+	defer sqlDB.Close()
+
+	db := &database.DB{DB: sqlDB}
+	repo := repository.NewUserRepository(logger.NewDiscardLogger(), db)
+	ctx := context.Background()
+
+	id := uuid.New()
+	userID := uuid.New()
+	usedAt := time.Now()
+
+	rows := sqlmock.NewRows([]string{"id", "user_id", "token_hash", "expires_at", "used_at", "created_at"}).
+		AddRow(id, userID, "token-hash", time.Now().Add(time.Hour), usedAt, time.Now())
+
+	mock.ExpectQuery("SELECT (.+) FROM email_verification_tokens WHERE token_hash = ?").
+		WithArgs("token-hash").
+		WillReturnRows(rows)
+
+	result, err := repo.GetEmailVerificationTokenByHash(ctx, "token-hash")
+	assert.NoError(t, err)
+	assert.Equal(t, id, result.ID)
+	assert.Equal(t, userID, result.UserID)
+	assert.NotNil(t, result.UsedAt)
+
+	// Verify all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetEmailVerificationTokenByHashNotFoundUnit(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	// nolint:errcheck
+	defer sqlDB.Close()
+
+	db := &database.DB{DB: sqlDB}
+	repo := repository.NewUserRepository(logger.NewDiscardLogger(), db)
+	ctx := context.Background()
+
+	mock.ExpectQuery("SELECT (.+) FROM email_verification_tokens WHERE token_hash = ?").
+		WithArgs("unknown-hash").
+		WillReturnError(sql.ErrNoRows)
+
+	_, err = repo.GetEmailVerificationTokenByHash(ctx, "unknown-hash")
+	assert.Equal(t, repository.ErrEmailVerificationTokenNotFound, err)
+
+	// Verify all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
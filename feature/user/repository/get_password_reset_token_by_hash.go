@@ -0,0 +1,37 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+)
+
+func (r *userRepository) GetPasswordResetTokenByHash(ctx context.Context, tokenHash string) (PasswordResetToken, error) {
+	query := `
+		SELECT id, user_id, token_hash, expires_at, used_at, created_at
+		FROM password_reset_tokens
+		WHERE token_hash = ?
+	`
+
+	var resetToken PasswordResetToken
+	err := r.db.QueryRowContext(ctx, query, tokenHash).Scan(
+		&resetToken.ID,
+		&resetToken.UserID,
+		&resetToken.TokenHash,
+		&resetToken.ExpiresAt,
+		&resetToken.UsedAt,
+		&resetToken.CreatedAt,
+	)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return PasswordResetToken{}, ErrPasswordResetTokenNotFound
+		}
+		r.log.Error("Failed to get password reset token by hash",
+			slog.String("error", err.Error()),
+		)
+		return PasswordResetToken{}, fmt.Errorf("%w: %w", ErrFailedToGetPasswordResetToken, err)
+	}
+
+	return resetToken, nil
+}
@@ -0,0 +1,16 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/fikryfahrezy/let-it-go/feature/user/repository"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetPasswordResetTokenByHashNotFound(t *testing.T) {
+	setupTest(t)
+
+	_, err := testRepository.GetPasswordResetTokenByHash(context.Background(), "unknown-hash")
+	assert.Equal(t, repository.ErrPasswordResetTokenNotFound, err)
+}
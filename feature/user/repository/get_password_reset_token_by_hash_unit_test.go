@@ -0,0 +1,68 @@
+package repository_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/fikryfahrezy/let-it-go/feature/user/repository"
+	"github.com/fikryfahrezy/let-it-go/pkg/database"
+	"github.com/fikryfahrezy/let-it-go/pkg/logger"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetPasswordResetTokenByHashUnit(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	// nolint:errcheck
+	defer sqlDB.Close()
+
+	db := &database.DB{DB: sqlDB}
+	repo := repository.NewUserRepository(logger.NewDiscardLogger(), db)
+	ctx := context.Background()
+
+	id := uuid.New()
+	userID := uuid.New()
+	expiresAt := time.Now().Add(time.Hour)
+
+	rows := sqlmock.NewRows([]string{"id", "user_id", "token_hash", "expires_at", "used_at", "created_at"}).
+		AddRow(id, userID, "token-hash", expiresAt, nil, time.Now())
+
+	mock.ExpectQuery("SELECT (.+) FROM password_reset_tokens WHERE token_hash = ?").
+		WithArgs("token-hash").
+		WillReturnRows(rows)
+
+	result, err := repo.GetPasswordResetTokenByHash(ctx, "token-hash")
+	assert.NoError(t, err)
+	assert.Equal(t, id, result.ID)
+	assert.Equal(t, userID, result.UserID)
+	assert.Nil(t, result.UsedAt)
+
+	// Verify all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetPasswordResetTokenByHashNotFoundUnit(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	// nolint:errcheck
+	defer sqlDB.Close()
+
+	db := &database.DB{DB: sqlDB}
+	repo := repository.NewUserRepository(logger.NewDiscardLogger(), db)
+	ctx := context.Background()
+
+	mock.ExpectQuery("SELECT (.+) FROM password_reset_tokens WHERE token_hash = ?").
+		WithArgs("unknown-hash").
+		WillReturnError(sql.ErrNoRows)
+
+	_, err = repo.GetPasswordResetTokenByHash(ctx, "unknown-hash")
+	assert.Equal(t, repository.ErrPasswordResetTokenNotFound, err)
+
+	// Verify all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
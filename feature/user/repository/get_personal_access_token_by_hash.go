@@ -0,0 +1,29 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+)
+
+func (r *userRepository) GetPersonalAccessTokenByHash(ctx context.Context, tokenHash string) (PersonalAccessToken, error) {
+	query := `
+		SELECT ` + personalAccessTokenColumns + `
+		FROM personal_access_tokens
+		WHERE token_hash = ?
+	`
+
+	accessToken, err := scanPersonalAccessToken(r.db.QueryRowContext(ctx, query, tokenHash))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return PersonalAccessToken{}, ErrPersonalAccessTokenNotFound
+		}
+		r.log.Error("Failed to get personal access token by hash",
+			slog.String("error", err.Error()),
+		)
+		return PersonalAccessToken{}, fmt.Errorf("%w: %w", ErrFailedToGetPersonalAccessToken, err)
+	}
+
+	return accessToken, nil
+}
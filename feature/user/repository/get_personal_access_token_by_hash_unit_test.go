@@ -0,0 +1,89 @@
+package repository_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/fikryfahrezy/let-it-go/feature/user/repository"
+	"github.com/fikryfahrezy/let-it-go/pkg/database"
+	"github.com/fikryfahrezy/let-it-go/pkg/logger"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var personalAccessTokenColumns = []string{"id", "user_id", "name", "token_hash", "scopes", "expires_at", "last_used_at", "revoked_at", "created_at"}
+
+func TestGetPersonalAccessTokenByHashUnit(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	// nolint:errcheck
+	defer sqlDB.Close()
+
+	db := &database.DB{DB: sqlDB}
+	repo := repository.NewUserRepository(logger.NewDiscardLogger(), db)
+	ctx := context.Background()
+
+	tokenID := uuid.New()
+	userID := uuid.New()
+	rows := sqlmock.NewRows(personalAccessTokenColumns).
+		AddRow(tokenID, userID, "ci", "token-hash", "blogs:write,users:read", nil, nil, nil, time.Now())
+
+	mock.ExpectQuery("SELECT (.+) FROM personal_access_tokens WHERE token_hash = ?").
+		WithArgs("token-hash").
+		WillReturnRows(rows)
+
+	accessToken, err := repo.GetPersonalAccessTokenByHash(ctx, "token-hash")
+	assert.NoError(t, err)
+	assert.Equal(t, tokenID, accessToken.ID)
+	assert.Equal(t, userID, accessToken.UserID)
+	assert.Equal(t, []string{"blogs:write", "users:read"}, accessToken.Scopes)
+	assert.Nil(t, accessToken.ExpiresAt)
+
+	// Verify all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetPersonalAccessTokenByHashNotFoundUnit(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	// nolint:errcheck
+	defer sqlDB.Close()
+
+	db := &database.DB{DB: sqlDB}
+	repo := repository.NewUserRepository(logger.NewDiscardLogger(), db)
+	ctx := context.Background()
+
+	mock.ExpectQuery("SELECT (.+) FROM personal_access_tokens WHERE token_hash = ?").
+		WithArgs("unknown-hash").
+		WillReturnError(sql.ErrNoRows)
+
+	_, err = repo.GetPersonalAccessTokenByHash(ctx, "unknown-hash")
+	assert.Equal(t, repository.ErrPersonalAccessTokenNotFound, err)
+
+	// Verify all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetPersonalAccessTokenByHashErrorUnit(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	// nolint:errcheck
+	defer sqlDB.Close()
+
+	db := &database.DB{DB: sqlDB}
+	repo := repository.NewUserRepository(logger.NewDiscardLogger(), db)
+	ctx := context.Background()
+
+	mock.ExpectQuery("SELECT (.+) FROM personal_access_tokens WHERE token_hash = ?").
+		WillReturnError(sql.ErrConnDone)
+
+	_, err = repo.GetPersonalAccessTokenByHash(ctx, "token-hash")
+	assert.ErrorIs(t, err, repository.ErrFailedToGetPersonalAccessToken)
+
+	// Verify all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,39 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+
+	"github.com/google/uuid"
+)
+
+func (r *userRepository) GetPreferences(ctx context.Context, userID uuid.UUID) (UserPreferences, error) {
+	query := `
+		SELECT user_id, schema_version, preferences, created_at, updated_at
+		FROM user_preferences
+		WHERE user_id = ?
+	`
+
+	var preferences UserPreferences
+	err := r.db.QueryRowContext(ctx, query, userID).Scan(
+		&preferences.UserID,
+		&preferences.SchemaVersion,
+		&preferences.Document,
+		&preferences.CreatedAt,
+		&preferences.UpdatedAt,
+	)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return UserPreferences{}, ErrPreferencesNotFound
+		}
+		r.log.Error("Failed to get user preferences",
+			slog.String("error", err.Error()),
+			slog.String("user_id", userID.String()),
+		)
+		return UserPreferences{}, fmt.Errorf("%w: %w", ErrFailedToGetPreferences, err)
+	}
+
+	return preferences, nil
+}
@@ -0,0 +1,38 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/fikryfahrezy/let-it-go/feature/user/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetPreferences(t *testing.T) {
+	setupTest(t)
+
+	ctx := context.Background()
+	user := createTestUser(t, "get-preferences@example.com")
+	require.NoError(t, testRepository.SavePreferences(ctx, repository.UserPreferences{
+		UserID:        user.ID,
+		SchemaVersion: 1,
+		Document:      []byte(`{"timezone": "Asia/Jakarta"}`),
+	}))
+
+	preferences, err := testRepository.GetPreferences(ctx, user.ID)
+	require.NoError(t, err)
+	assert.Equal(t, user.ID, preferences.UserID)
+	assert.Equal(t, 1, preferences.SchemaVersion)
+	assert.JSONEq(t, `{"timezone": "Asia/Jakarta"}`, string(preferences.Document))
+	assert.False(t, preferences.UpdatedAt.IsZero())
+}
+
+func TestGetPreferencesNotFound(t *testing.T) {
+	setupTest(t)
+
+	user := createTestUser(t, "get-preferences-missing@example.com")
+
+	_, err := testRepository.GetPreferences(context.Background(), user.ID)
+	assert.Equal(t, repository.ErrPreferencesNotFound, err)
+}
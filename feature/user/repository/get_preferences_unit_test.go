@@ -0,0 +1,85 @@
+package repository_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/fikryfahrezy/let-it-go/feature/user/repository"
+	"github.com/fikryfahrezy/let-it-go/pkg/database"
+	"github.com/fikryfahrezy/let-it-go/pkg/logger"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetPreferencesUnit(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	// nolint:errcheck
+	defer sqlDB.Close()
+
+	db := &database.DB{DB: sqlDB}
+	repo := repository.NewUserRepository(logger.NewDiscardLogger(), db)
+	ctx := context.Background()
+
+	userID := uuid.New()
+	now := time.Now()
+	rows := sqlmock.NewRows([]string{"user_id", "schema_version", "preferences", "created_at", "updated_at"}).
+		AddRow(userID, 1, []byte(`{"locale":"en"}`), now, now)
+
+	mock.ExpectQuery("SELECT (.+) FROM user_preferences WHERE user_id = ?").
+		WithArgs(userID).
+		WillReturnRows(rows)
+
+	preferences, err := repo.GetPreferences(ctx, userID)
+	assert.NoError(t, err)
+	assert.Equal(t, userID, preferences.UserID)
+	assert.Equal(t, 1, preferences.SchemaVersion)
+	assert.Equal(t, `{"locale":"en"}`, string(preferences.Document))
+
+	// Verify all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetPreferencesNotFoundUnit(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	// nolint:errcheck
+	defer sqlDB.Close()
+
+	db := &database.DB{DB: sqlDB}
+	repo := repository.NewUserRepository(logger.NewDiscardLogger(), db)
+	ctx := context.Background()
+
+	mock.ExpectQuery("SELECT (.+) FROM user_preferences WHERE user_id = ?").
+		WillReturnError(sql.ErrNoRows)
+
+	_, err = repo.GetPreferences(ctx, uuid.New())
+	assert.Equal(t, repository.ErrPreferencesNotFound, err)
+
+	// Verify all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetPreferencesErrorUnit(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	// nolint:errcheck
+	defer sqlDB.Close()
+
+	db := &database.DB{DB: sqlDB}
+	repo := repository.NewUserRepository(logger.NewDiscardLogger(), db)
+	ctx := context.Background()
+
+	mock.ExpectQuery("SELECT (.+) FROM user_preferences WHERE user_id = ?").
+		WillReturnError(sql.ErrConnDone)
+
+	_, err = repo.GetPreferences(ctx, uuid.New())
+	assert.ErrorIs(t, err, repository.ErrFailedToGetPreferences)
+
+	// Verify all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,42 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+)
+
+func (r *userRepository) GetSessionByRefreshTokenHash(ctx context.Context, refreshTokenHash string) (Session, error) {
+	query := `
+		SELECT id, user_id, family_id, refresh_token_hash, user_agent, ip_address, expires_at, rotated_at, revoked_at, created_at, updated_at
+		FROM sessions
+		WHERE refresh_token_hash = ?
+	`
+
+	var session Session
+	err := r.db.QueryRowContext(ctx, query, refreshTokenHash).Scan(
+		&session.ID,
+		&session.UserID,
+		&session.FamilyID,
+		&session.RefreshTokenHash,
+		&session.UserAgent,
+		&session.IPAddress,
+		&session.ExpiresAt,
+		&session.RotatedAt,
+		&session.RevokedAt,
+		&session.CreatedAt,
+		&session.UpdatedAt,
+	)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return Session{}, ErrSessionNotFound
+		}
+		r.log.Error("Failed to get session by refresh token hash",
+			slog.String("error", err.Error()),
+		)
+		return Session{}, fmt.Errorf("%w: %w", ErrFailedToGetSession, err)
+	}
+
+	return session, nil
+}
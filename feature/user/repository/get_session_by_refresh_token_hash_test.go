@@ -0,0 +1,16 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/fikryfahrezy/let-it-go/feature/user/repository"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetSessionByRefreshTokenHashNotFound(t *testing.T) {
+	setupTest(t)
+
+	_, err := testRepository.GetSessionByRefreshTokenHash(context.Background(), "unknown-hash")
+	assert.Equal(t, repository.ErrSessionNotFound, err)
+}
@@ -0,0 +1,70 @@
+package repository_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/fikryfahrezy/let-it-go/feature/user/repository"
+	"github.com/fikryfahrezy/let-it-go/pkg/database"
+	"github.com/fikryfahrezy/let-it-go/pkg/logger"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var sessionColumns = []string{"id", "user_id", "family_id", "refresh_token_hash", "user_agent", "ip_address", "expires_at", "rotated_at", "revoked_at", "created_at", "updated_at"}
+
+func TestGetSessionByRefreshTokenHashUnit(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	// nolint:errcheck
+	defer sqlDB.Close()
+
+	db := &database.DB{DB: sqlDB}
+	repo := repository.NewUserRepository(logger.NewDiscardLogger(), db)
+	ctx := context.Background()
+
+	session := newTestSession(uuid.New(), "refresh-token-hash", time.Hour)
+	rotatedAt := time.Now()
+
+	rows := sqlmock.NewRows(sessionColumns).
+		AddRow(session.ID, session.UserID, session.FamilyID, session.RefreshTokenHash, session.UserAgent, session.IPAddress, session.ExpiresAt, rotatedAt, nil, time.Now(), time.Now())
+
+	mock.ExpectQuery("SELECT (.+) FROM sessions WHERE refresh_token_hash = ?").
+		WithArgs(session.RefreshTokenHash).
+		WillReturnRows(rows)
+
+	result, err := repo.GetSessionByRefreshTokenHash(ctx, session.RefreshTokenHash)
+	assert.NoError(t, err)
+	assert.Equal(t, session.ID, result.ID)
+	assert.Equal(t, session.FamilyID, result.FamilyID)
+	assert.NotNil(t, result.RotatedAt)
+	assert.Nil(t, result.RevokedAt)
+
+	// Verify all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetSessionByRefreshTokenHashNotFoundUnit(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	// nolint:errcheck
+	defer sqlDB.Close()
+
+	db := &database.DB{DB: sqlDB}
+	repo := repository.NewUserRepository(logger.NewDiscardLogger(), db)
+	ctx := context.Background()
+
+	mock.ExpectQuery("SELECT (.+) FROM sessions WHERE refresh_token_hash = ?").
+		WithArgs("unknown-hash").
+		WillReturnError(sql.ErrNoRows)
+
+	_, err = repo.GetSessionByRefreshTokenHash(ctx, "unknown-hash")
+	assert.Equal(t, repository.ErrSessionNotFound, err)
+
+	// Verify all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,40 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+
+	"github.com/google/uuid"
+)
+
+func (r *userRepository) GetTwoFactorCredential(ctx context.Context, userID uuid.UUID) (TwoFactorCredential, error) {
+	query := `
+		SELECT user_id, secret_ciphertext, confirmed_at, last_used_step, created_at, updated_at
+		FROM two_factor_credentials
+		WHERE user_id = ?
+	`
+
+	var credential TwoFactorCredential
+	err := r.db.QueryRowContext(ctx, query, userID).Scan(
+		&credential.UserID,
+		&credential.SecretCiphertext,
+		&credential.ConfirmedAt,
+		&credential.LastUsedStep,
+		&credential.CreatedAt,
+		&credential.UpdatedAt,
+	)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return TwoFactorCredential{}, ErrTwoFactorCredentialNotFound
+		}
+		r.log.Error("Failed to get two factor credential",
+			slog.String("error", err.Error()),
+			slog.String("user_id", userID.String()),
+		)
+		return TwoFactorCredential{}, fmt.Errorf("%w: %w", ErrFailedToGetTwoFactorCredential, err)
+	}
+
+	return credential, nil
+}
@@ -0,0 +1,35 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/fikryfahrezy/let-it-go/feature/user/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetTwoFactorCredential(t *testing.T) {
+	setupTest(t)
+
+	ctx := context.Background()
+	user := createTestUser(t, "get-2fa@example.com")
+	enableTestTwoFactor(t, user.ID)
+
+	credential, err := testRepository.GetTwoFactorCredential(ctx, user.ID)
+	require.NoError(t, err)
+	assert.Equal(t, user.ID, credential.UserID)
+	assert.Equal(t, "ciphertext", credential.SecretCiphertext)
+	assert.NotNil(t, credential.ConfirmedAt)
+	require.NotNil(t, credential.LastUsedStep)
+	assert.Equal(t, int64(100), *credential.LastUsedStep)
+}
+
+func TestGetTwoFactorCredentialNotFound(t *testing.T) {
+	setupTest(t)
+
+	user := createTestUser(t, "get-2fa-missing@example.com")
+
+	_, err := testRepository.GetTwoFactorCredential(context.Background(), user.ID)
+	assert.Equal(t, repository.ErrTwoFactorCredentialNotFound, err)
+}
@@ -0,0 +1,68 @@
+package repository_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/fikryfahrezy/let-it-go/feature/user/repository"
+	"github.com/fikryfahrezy/let-it-go/pkg/database"
+	"github.com/fikryfahrezy/let-it-go/pkg/logger"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetTwoFactorCredentialUnit(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	// nolint:errcheck
+	defer sqlDB.Close()
+
+	db := &database.DB{DB: sqlDB}
+	repo := repository.NewUserRepository(logger.NewDiscardLogger(), db)
+	ctx := context.Background()
+
+	userID := uuid.New()
+	confirmedAt := time.Now()
+
+	rows := sqlmock.NewRows([]string{"user_id", "secret_ciphertext", "confirmed_at", "last_used_step", "created_at", "updated_at"}).
+		AddRow(userID, "ciphertext", confirmedAt, int64(42), time.Now(), time.Now())
+
+	mock.ExpectQuery("SELECT (.+) FROM two_factor_credentials WHERE user_id = ?").
+		WithArgs(userID).
+		WillReturnRows(rows)
+
+	result, err := repo.GetTwoFactorCredential(ctx, userID)
+	assert.NoError(t, err)
+	assert.Equal(t, userID, result.UserID)
+	assert.Equal(t, "ciphertext", result.SecretCiphertext)
+	assert.NotNil(t, result.ConfirmedAt)
+	require.NotNil(t, result.LastUsedStep)
+	assert.Equal(t, int64(42), *result.LastUsedStep)
+
+	// Verify all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetTwoFactorCredentialNotFoundUnit(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	// nolint:errcheck
+	defer sqlDB.Close()
+
+	db := &database.DB{DB: sqlDB}
+	repo := repository.NewUserRepository(logger.NewDiscardLogger(), db)
+	ctx := context.Background()
+
+	mock.ExpectQuery("SELECT (.+) FROM two_factor_credentials WHERE user_id = ?").
+		WillReturnError(sql.ErrNoRows)
+
+	_, err = repo.GetTwoFactorCredential(ctx, uuid.New())
+	assert.Equal(t, repository.ErrTwoFactorCredentialNotFound, err)
+
+	// Verify all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
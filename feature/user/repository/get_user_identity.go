@@ -0,0 +1,38 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+)
+
+func (r *userRepository) GetUserIdentity(ctx context.Context, provider, subject string) (UserIdentity, error) {
+	query := `
+		SELECT id, user_id, provider, subject, email, created_at
+		FROM user_identities
+		WHERE provider = ? AND subject = ?
+	`
+
+	var identity UserIdentity
+	err := r.db.QueryRowContext(ctx, query, provider, subject).Scan(
+		&identity.ID,
+		&identity.UserID,
+		&identity.Provider,
+		&identity.Subject,
+		&identity.Email,
+		&identity.CreatedAt,
+	)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return UserIdentity{}, ErrUserIdentityNotFound
+		}
+		r.log.Error("Failed to get user identity",
+			slog.String("error", err.Error()),
+			slog.String("provider", provider),
+		)
+		return UserIdentity{}, fmt.Errorf("%w: %w", ErrFailedToGetUserIdentity, err)
+	}
+
+	return identity, nil
+}
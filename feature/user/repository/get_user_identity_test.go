@@ -0,0 +1,34 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/fikryfahrezy/let-it-go/feature/user/repository"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetUserIdentity(t *testing.T) {
+	setupTest(t)
+
+	ctx := context.Background()
+	user := createTestUser(t, "get-identity@example.com")
+	require.NoError(t, testRepository.CreateUserIdentity(ctx, repository.UserIdentity{
+		ID:       uuid.Must(uuid.NewV7()),
+		UserID:   user.ID,
+		Provider: "https://idp.example.com",
+		Subject:  "subject-1",
+		Email:    "get-identity@example.com",
+	}))
+
+	identity, err := testRepository.GetUserIdentity(ctx, "https://idp.example.com", "subject-1")
+	require.NoError(t, err)
+	assert.Equal(t, user.ID, identity.UserID)
+	assert.Equal(t, "get-identity@example.com", identity.Email)
+
+	// The same subject at another provider is another identity
+	_, err = testRepository.GetUserIdentity(ctx, "https://other.example.com", "subject-1")
+	assert.Equal(t, repository.ErrUserIdentityNotFound, err)
+}
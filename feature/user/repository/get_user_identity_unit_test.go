@@ -0,0 +1,85 @@
+package repository_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/fikryfahrezy/let-it-go/feature/user/repository"
+	"github.com/fikryfahrezy/let-it-go/pkg/database"
+	"github.com/fikryfahrezy/let-it-go/pkg/logger"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetUserIdentityUnit(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	// nolint:errcheck
+	defer sqlDB.Close()
+
+	db := &database.DB{DB: sqlDB}
+	repo := repository.NewUserRepository(logger.NewDiscardLogger(), db)
+	ctx := context.Background()
+
+	identityID := uuid.New()
+	userID := uuid.New()
+	rows := sqlmock.NewRows([]string{"id", "user_id", "provider", "subject", "email", "created_at"}).
+		AddRow(identityID, userID, "https://idp.example.com", "subject-1", "user@example.com", time.Now())
+
+	mock.ExpectQuery("SELECT (.+) FROM user_identities WHERE provider = \\? AND subject = \\?").
+		WithArgs("https://idp.example.com", "subject-1").
+		WillReturnRows(rows)
+
+	result, err := repo.GetUserIdentity(ctx, "https://idp.example.com", "subject-1")
+	assert.NoError(t, err)
+	assert.Equal(t, identityID, result.ID)
+	assert.Equal(t, userID, result.UserID)
+	assert.Equal(t, "user@example.com", result.Email)
+
+	// Verify all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetUserIdentityNotFoundUnit(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	// nolint:errcheck
+	defer sqlDB.Close()
+
+	db := &database.DB{DB: sqlDB}
+	repo := repository.NewUserRepository(logger.NewDiscardLogger(), db)
+	ctx := context.Background()
+
+	mock.ExpectQuery("SELECT (.+) FROM user_identities").
+		WillReturnError(sql.ErrNoRows)
+
+	_, err = repo.GetUserIdentity(ctx, "https://idp.example.com", "subject-1")
+	assert.Equal(t, repository.ErrUserIdentityNotFound, err)
+
+	// Verify all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetUserIdentityErrorUnit(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	// nolint:errcheck
+	defer sqlDB.Close()
+
+	db := &database.DB{DB: sqlDB}
+	repo := repository.NewUserRepository(logger.NewDiscardLogger(), db)
+	ctx := context.Background()
+
+	mock.ExpectQuery("SELECT (.+) FROM user_identities").
+		WillReturnError(sql.ErrConnDone)
+
+	_, err = repo.GetUserIdentity(ctx, "https://idp.example.com", "subject-1")
+	assert.ErrorIs(t, err, repository.ErrFailedToGetUserIdentity)
+
+	// Verify all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -6,15 +6,23 @@ import (
 	"log/slog"
 )
 
-func (r *userRepository) List(ctx context.Context, limit, offset int) ([]User, error) {
+// List returns a page of the users matching the filter in the order it asks for
+func (r *userRepository) List(ctx context.Context, filter ListFilter, limit, offset int) ([]User, error) {
+	where, args := filter.where()
+	orderBy, err := filter.orderBy()
+	if err != nil {
+		return nil, err
+	}
+
 	query := `
-		SELECT id, name, email, password, created_at, updated_at
+		SELECT id, name, email, password, role, verified_at, created_at, updated_at
 		FROM users
-		ORDER BY created_at DESC
+		` + where + `
+		` + orderBy + `
 		LIMIT ? OFFSET ?
 	`
 
-	rows, err := r.db.QueryContext(ctx, query, limit, offset)
+	rows, err := r.db.QueryContext(ctx, query, append(args, limit, offset)...)
 	if err != nil {
 		r.log.Error("Failed to list users",
 			slog.String("error", err.Error()),
@@ -35,6 +43,8 @@ func (r *userRepository) List(ctx context.Context, limit, offset int) ([]User, e
 			&user.Name,
 			&user.Email,
 			&user.Password,
+			&user.Role,
+			&user.VerifiedAt,
 			&user.CreatedAt,
 			&user.UpdatedAt,
 		)
@@ -0,0 +1,68 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ListActiveSessionsByUserID returns the current (latest, unrevoked, unexpired) session of every login
+func (r *userRepository) ListActiveSessionsByUserID(ctx context.Context, userID uuid.UUID) ([]Session, error) {
+	query := `
+		SELECT id, user_id, family_id, refresh_token_hash, user_agent, ip_address, expires_at, rotated_at, revoked_at, created_at, updated_at
+		FROM sessions
+		WHERE user_id = ? AND rotated_at IS NULL AND revoked_at IS NULL AND expires_at > ?
+		ORDER BY created_at DESC
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, userID, time.Now())
+	if err != nil {
+		r.log.Error("Failed to list active sessions",
+			slog.String("error", err.Error()),
+			slog.String("user_id", userID.String()),
+		)
+		return nil, fmt.Errorf("%w: %w", ErrFailedToListSessions, err)
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			r.log.Error("Failed to close list active sessions rows", slog.String("error", err.Error()))
+		}
+	}()
+
+	var sessions []Session
+	for rows.Next() {
+		var session Session
+		err := rows.Scan(
+			&session.ID,
+			&session.UserID,
+			&session.FamilyID,
+			&session.RefreshTokenHash,
+			&session.UserAgent,
+			&session.IPAddress,
+			&session.ExpiresAt,
+			&session.RotatedAt,
+			&session.RevokedAt,
+			&session.CreatedAt,
+			&session.UpdatedAt,
+		)
+		if err != nil {
+			r.log.Error("Failed to scan session row",
+				slog.String("error", err.Error()),
+			)
+			return nil, fmt.Errorf("%w: %w", ErrFailedToScanSessionRow, err)
+		}
+		sessions = append(sessions, session)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.log.Error("Error iterating session rows",
+			slog.String("error", err.Error()),
+		)
+		return nil, fmt.Errorf("%w: %w", ErrFailedToIterateRows, err)
+	}
+
+	return sessions, nil
+}
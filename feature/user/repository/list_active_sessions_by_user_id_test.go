@@ -0,0 +1,39 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListActiveSessionsByUserID(t *testing.T) {
+	setupTest(t)
+
+	user := createTestUser(t, "list-sessions@example.com")
+	ctx := context.Background()
+
+	// Rotated session: only its successor is active
+	rotated := newTestSession(user.ID, "hash-rotated", time.Hour)
+	require.NoError(t, testRepository.CreateSession(ctx, rotated))
+	successor := newTestSession(user.ID, "hash-successor", time.Hour)
+	successor.FamilyID = rotated.FamilyID
+	require.NoError(t, testRepository.RotateSession(ctx, rotated.ID, successor))
+
+	// Revoked and expired sessions are not active
+	revoked := newTestSession(user.ID, "hash-revoked", time.Hour)
+	require.NoError(t, testRepository.CreateSession(ctx, revoked))
+	require.NoError(t, testRepository.RevokeSessionFamily(ctx, revoked.FamilyID))
+	require.NoError(t, testRepository.CreateSession(ctx, newTestSession(user.ID, "hash-expired", -time.Hour)))
+
+	// Sessions of another user are not listed
+	otherUser := createTestUser(t, "other-sessions@example.com")
+	require.NoError(t, testRepository.CreateSession(ctx, newTestSession(otherUser.ID, "hash-other", time.Hour)))
+
+	sessions, err := testRepository.ListActiveSessionsByUserID(ctx, user.ID)
+	require.NoError(t, err)
+	require.Len(t, sessions, 1)
+	assert.Equal(t, successor.ID, sessions[0].ID)
+}
@@ -0,0 +1,68 @@
+package repository_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/fikryfahrezy/let-it-go/feature/user/repository"
+	"github.com/fikryfahrezy/let-it-go/pkg/database"
+	"github.com/fikryfahrezy/let-it-go/pkg/logger"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListActiveSessionsByUserIDUnit(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	// nolint:errcheck
+	defer sqlDB.Close()
+
+	db := &database.DB{DB: sqlDB}
+	repo := repository.NewUserRepository(logger.NewDiscardLogger(), db)
+	ctx := context.Background()
+
+	userID := uuid.New()
+	first := newTestSession(userID, "hash-1", time.Hour)
+	second := newTestSession(userID, "hash-2", time.Hour)
+
+	rows := sqlmock.NewRows(sessionColumns).
+		AddRow(first.ID, first.UserID, first.FamilyID, first.RefreshTokenHash, first.UserAgent, first.IPAddress, first.ExpiresAt, nil, nil, time.Now(), time.Now()).
+		AddRow(second.ID, second.UserID, second.FamilyID, second.RefreshTokenHash, second.UserAgent, second.IPAddress, second.ExpiresAt, nil, nil, time.Now(), time.Now())
+
+	mock.ExpectQuery("SELECT (.+) FROM sessions WHERE user_id = ?").
+		WithArgs(userID, sqlmock.AnyArg()).
+		WillReturnRows(rows)
+
+	sessions, err := repo.ListActiveSessionsByUserID(ctx, userID)
+	assert.NoError(t, err)
+	require.Len(t, sessions, 2)
+	assert.Equal(t, first.ID, sessions[0].ID)
+	assert.Equal(t, second.ID, sessions[1].ID)
+
+	// Verify all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestListActiveSessionsByUserIDErrorUnit(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	// nolint:errcheck
+	defer sqlDB.Close()
+
+	db := &database.DB{DB: sqlDB}
+	repo := repository.NewUserRepository(logger.NewDiscardLogger(), db)
+	ctx := context.Background()
+
+	mock.ExpectQuery("SELECT (.+) FROM sessions WHERE user_id = ?").
+		WillReturnError(sql.ErrConnDone)
+
+	_, err = repo.ListActiveSessionsByUserID(ctx, uuid.New())
+	assert.ErrorIs(t, err, repository.ErrFailedToListSessions)
+
+	// Verify all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,78 @@
+package repository
+
+import (
+	"strings"
+	"time"
+)
+
+// Columns users can be sorted by
+const (
+	SortByName      = "name"
+	SortByEmail     = "email"
+	SortByCreatedAt = "created_at"
+	SortByUpdatedAt = "updated_at"
+)
+
+// sortColumns whitelists the columns interpolated into ORDER BY
+var sortColumns = map[string]string{
+	SortByName:      "name",
+	SortByEmail:     "email",
+	SortByCreatedAt: "created_at",
+	SortByUpdatedAt: "updated_at",
+}
+
+// ListFilter narrows down and orders the users returned by List and counted by Count.
+// The zero value matches every user, newest first.
+type ListFilter struct {
+	Search        string     // Prefix of the name or the email
+	CreatedFrom   *time.Time // Inclusive lower bound of created_at
+	CreatedTo     *time.Time // Exclusive upper bound of created_at
+	SortBy        string     // One of the SortBy constants; SortByCreatedAt when empty
+	SortAscending bool
+}
+
+// likePrefixEscaper escapes the LIKE wildcards so the search only ever matches a prefix
+var likePrefixEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// where returns the WHERE clause shared by List and Count and its arguments
+func (f ListFilter) where() (string, []any) {
+	conditions := []string{"deleted_at IS NULL"}
+	var args []any
+
+	if f.Search != "" {
+		prefix := likePrefixEscaper.Replace(f.Search) + "%"
+		conditions = append(conditions, "(name LIKE ? OR email LIKE ?)")
+		args = append(args, prefix, prefix)
+	}
+	if f.CreatedFrom != nil {
+		conditions = append(conditions, "created_at >= ?")
+		args = append(args, *f.CreatedFrom)
+	}
+	if f.CreatedTo != nil {
+		conditions = append(conditions, "created_at < ?")
+		args = append(args, *f.CreatedTo)
+	}
+
+	return "WHERE " + strings.Join(conditions, " AND "), args
+}
+
+// orderBy returns the ORDER BY clause, or ErrInvalidSortColumn for a column that is not whitelisted.
+// The ID breaks ties so pages never overlap.
+func (f ListFilter) orderBy() (string, error) {
+	sortBy := f.SortBy
+	if sortBy == "" {
+		sortBy = SortByCreatedAt
+	}
+
+	column, ok := sortColumns[sortBy]
+	if !ok {
+		return "", ErrInvalidSortColumn
+	}
+
+	direction := "DESC"
+	if f.SortAscending {
+		direction = "ASC"
+	}
+
+	return "ORDER BY " + column + " " + direction + ", id " + direction, nil
+}
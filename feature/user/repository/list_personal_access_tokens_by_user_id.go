@@ -0,0 +1,54 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/google/uuid"
+)
+
+// ListPersonalAccessTokensByUserID returns the unrevoked tokens of the user, expired ones included, newest first
+func (r *userRepository) ListPersonalAccessTokensByUserID(ctx context.Context, userID uuid.UUID) ([]PersonalAccessToken, error) {
+	query := `
+		SELECT ` + personalAccessTokenColumns + `
+		FROM personal_access_tokens
+		WHERE user_id = ? AND revoked_at IS NULL
+		ORDER BY created_at DESC
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, userID)
+	if err != nil {
+		r.log.Error("Failed to list personal access tokens",
+			slog.String("error", err.Error()),
+			slog.String("user_id", userID.String()),
+		)
+		return nil, fmt.Errorf("%w: %w", ErrFailedToListPersonalAccessTokens, err)
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			r.log.Error("Failed to close list personal access tokens rows", slog.String("error", err.Error()))
+		}
+	}()
+
+	var accessTokens []PersonalAccessToken
+	for rows.Next() {
+		accessToken, err := scanPersonalAccessToken(rows)
+		if err != nil {
+			r.log.Error("Failed to scan personal access token row",
+				slog.String("error", err.Error()),
+			)
+			return nil, fmt.Errorf("%w: %w", ErrFailedToScanTokenRow, err)
+		}
+		accessTokens = append(accessTokens, accessToken)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.log.Error("Error iterating personal access token rows",
+			slog.String("error", err.Error()),
+		)
+		return nil, fmt.Errorf("%w: %w", ErrFailedToIterateRows, err)
+	}
+
+	return accessTokens, nil
+}
@@ -0,0 +1,34 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListPersonalAccessTokensByUserID(t *testing.T) {
+	setupTest(t)
+
+	ctx := context.Background()
+	user := createTestUser(t, "list-tokens@example.com")
+
+	active := newTestPersonalAccessToken(user.ID, "hash-active", "blogs:write")
+	require.NoError(t, testRepository.CreatePersonalAccessToken(ctx, active))
+
+	// Revoked tokens are not listed
+	revoked := newTestPersonalAccessToken(user.ID, "hash-revoked", "blogs:write")
+	require.NoError(t, testRepository.CreatePersonalAccessToken(ctx, revoked))
+	require.NoError(t, testRepository.RevokePersonalAccessToken(ctx, user.ID, revoked.ID))
+
+	// Tokens of another user are not listed
+	otherUser := createTestUser(t, "other-tokens@example.com")
+	require.NoError(t, testRepository.CreatePersonalAccessToken(ctx, newTestPersonalAccessToken(otherUser.ID, "hash-other", "users:read")))
+
+	accessTokens, err := testRepository.ListPersonalAccessTokensByUserID(ctx, user.ID)
+	require.NoError(t, err)
+	require.Len(t, accessTokens, 1)
+	assert.Equal(t, active.ID, accessTokens[0].ID)
+	assert.Equal(t, []string{"blogs:write"}, accessTokens[0].Scopes)
+}
@@ -0,0 +1,71 @@
+package repository_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/fikryfahrezy/let-it-go/feature/user/repository"
+	"github.com/fikryfahrezy/let-it-go/pkg/database"
+	"github.com/fikryfahrezy/let-it-go/pkg/logger"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListPersonalAccessTokensByUserIDUnit(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	// nolint:errcheck
+	defer sqlDB.Close()
+
+	db := &database.DB{DB: sqlDB}
+	repo := repository.NewUserRepository(logger.NewDiscardLogger(), db)
+	ctx := context.Background()
+
+	userID := uuid.New()
+	firstID := uuid.New()
+	secondID := uuid.New()
+	lastUsedAt := time.Now()
+
+	rows := sqlmock.NewRows(personalAccessTokenColumns).
+		AddRow(firstID, userID, "ci", "hash-1", "blogs:write", nil, lastUsedAt, nil, time.Now()).
+		AddRow(secondID, userID, "reporting", "hash-2", "users:read", nil, nil, nil, time.Now())
+
+	mock.ExpectQuery("SELECT (.+) FROM personal_access_tokens WHERE user_id = ?").
+		WithArgs(userID).
+		WillReturnRows(rows)
+
+	accessTokens, err := repo.ListPersonalAccessTokensByUserID(ctx, userID)
+	assert.NoError(t, err)
+	require.Len(t, accessTokens, 2)
+	assert.Equal(t, firstID, accessTokens[0].ID)
+	assert.NotNil(t, accessTokens[0].LastUsedAt)
+	assert.Equal(t, secondID, accessTokens[1].ID)
+	assert.Equal(t, []string{"users:read"}, accessTokens[1].Scopes)
+
+	// Verify all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestListPersonalAccessTokensByUserIDErrorUnit(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	// nolint:errcheck
+	defer sqlDB.Close()
+
+	db := &database.DB{DB: sqlDB}
+	repo := repository.NewUserRepository(logger.NewDiscardLogger(), db)
+	ctx := context.Background()
+
+	mock.ExpectQuery("SELECT (.+) FROM personal_access_tokens WHERE user_id = ?").
+		WillReturnError(sql.ErrConnDone)
+
+	_, err = repo.ListPersonalAccessTokensByUserID(ctx, uuid.New())
+	assert.ErrorIs(t, err, repository.ErrFailedToListPersonalAccessTokens)
+
+	// Verify all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -3,6 +3,7 @@ package repository_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/fikryfahrezy/let-it-go/feature/user/repository"
 	"github.com/stretchr/testify/assert"
@@ -37,15 +38,15 @@ func TestList(t *testing.T) {
 	}
 
 	// Test pagination
-	result, err := testRepository.List(context.Background(), 2, 0)
+	result, err := testRepository.List(context.Background(), repository.ListFilter{}, 2, 0)
 	assert.NoError(t, err)
 	assert.Len(t, result, 2)
 
-	result, err = testRepository.List(context.Background(), 2, 1)
+	result, err = testRepository.List(context.Background(), repository.ListFilter{}, 2, 1)
 	assert.NoError(t, err)
 	assert.Len(t, result, 2)
 
-	result, err = testRepository.List(context.Background(), 10, 0)
+	result, err = testRepository.List(context.Background(), repository.ListFilter{}, 10, 0)
 	assert.NoError(t, err)
 	assert.Len(t, result, 3)
 }
@@ -53,7 +54,59 @@ func TestList(t *testing.T) {
 func TestListEmpty(t *testing.T) {
 	setupTest(t)
 
-	result, err := testRepository.List(context.Background(), 10, 0)
+	result, err := testRepository.List(context.Background(), repository.ListFilter{}, 10, 0)
 	assert.NoError(t, err)
 	assert.Empty(t, result)
 }
+
+func TestListWithFilter(t *testing.T) {
+	setupTest(t)
+	ctx := context.Background()
+
+	for _, user := range []repository.User{
+		{Name: "Alice Smith", Email: "alice@example.com", Password: "password1"},
+		{Name: "Bob Stone", Email: "bob@example.com", Password: "password2"},
+		{Name: "Carol", Email: "alina@example.com", Password: "password3"},
+		{Name: "Dave", Email: "d_ve@example.com", Password: "password4"},
+	} {
+		require.NoError(t, testRepository.Create(ctx, user))
+	}
+
+	// Name or email prefix
+	result, err := testRepository.List(ctx, repository.ListFilter{Search: "ali", SortBy: repository.SortByEmail, SortAscending: true}, 10, 0)
+	require.NoError(t, err)
+	require.Len(t, result, 2)
+	assert.Equal(t, "alice@example.com", result[0].Email)
+	assert.Equal(t, "alina@example.com", result[1].Email)
+
+	// Only a prefix matches, not a substring
+	result, err = testRepository.List(ctx, repository.ListFilter{Search: "Smith"}, 10, 0)
+	require.NoError(t, err)
+	assert.Empty(t, result)
+
+	// Wildcards are matched literally
+	result, err = testRepository.List(ctx, repository.ListFilter{Search: "%"}, 10, 0)
+	require.NoError(t, err)
+	assert.Empty(t, result)
+	result, err = testRepository.List(ctx, repository.ListFilter{Search: "d_"}, 10, 0)
+	require.NoError(t, err)
+	require.Len(t, result, 1)
+	assert.Equal(t, "Dave", result[0].Name)
+
+	// Sort direction
+	result, err = testRepository.List(ctx, repository.ListFilter{SortBy: repository.SortByName}, 10, 0)
+	require.NoError(t, err)
+	require.Len(t, result, 4)
+	assert.Equal(t, "Dave", result[0].Name)
+	assert.Equal(t, "Alice Smith", result[3].Name)
+
+	// Created range
+	from := time.Now().Add(-time.Hour)
+	to := time.Now().Add(time.Hour)
+	result, err = testRepository.List(ctx, repository.ListFilter{CreatedFrom: &from, CreatedTo: &to}, 10, 0)
+	require.NoError(t, err)
+	assert.Len(t, result, 4)
+	result, err = testRepository.List(ctx, repository.ListFilter{CreatedFrom: &to}, 10, 0)
+	require.NoError(t, err)
+	assert.Empty(t, result)
+}
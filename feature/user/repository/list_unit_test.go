@@ -44,16 +44,16 @@ func TestListUnit(t *testing.T) {
 	}
 
 	// Mock the SELECT query
-	rows := sqlmock.NewRows([]string{"id", "name", "email", "password", "created_at", "updated_at"})
+	rows := sqlmock.NewRows([]string{"id", "name", "email", "password", "role", "verified_at", "created_at", "updated_at"})
 	for _, user := range users {
-		rows.AddRow(user.ID, user.Name, user.Email, user.Password, user.CreatedAt, user.UpdatedAt)
+		rows.AddRow(user.ID, user.Name, user.Email, user.Password, "author", nil, user.CreatedAt, user.UpdatedAt)
 	}
 
-	mock.ExpectQuery("SELECT (.+) FROM users ORDER BY created_at DESC LIMIT (.+) OFFSET (.+)").
+	mock.ExpectQuery("SELECT (.+) FROM users WHERE deleted_at IS NULL ORDER BY created_at DESC, id DESC LIMIT (.+) OFFSET (.+)").
 		WithArgs(2, 0).
 		WillReturnRows(rows)
 
-	result, err := repo.List(ctx, 2, 0)
+	result, err := repo.List(ctx, repository.ListFilter{}, 2, 0)
 	assert.NoError(t, err)
 	assert.Len(t, result, 2)
 	assert.Equal(t, users[0].ID, result[0].ID)
@@ -76,15 +76,67 @@ func TestListEmptyUnit(t *testing.T) {
 	ctx := context.Background()
 
 	// Mock the SELECT query returning empty result
-	rows := sqlmock.NewRows([]string{"id", "name", "email", "password", "created_at", "updated_at"})
-	mock.ExpectQuery("SELECT (.+) FROM users ORDER BY created_at DESC LIMIT (.+) OFFSET (.+)").
+	rows := sqlmock.NewRows([]string{"id", "name", "email", "password", "role", "verified_at", "created_at", "updated_at"})
+	mock.ExpectQuery("SELECT (.+) FROM users WHERE deleted_at IS NULL ORDER BY created_at DESC, id DESC LIMIT (.+) OFFSET (.+)").
 		WithArgs(10, 0).
 		WillReturnRows(rows)
 
-	result, err := repo.List(ctx, 10, 0)
+	result, err := repo.List(ctx, repository.ListFilter{}, 10, 0)
 	assert.NoError(t, err)
 	assert.Empty(t, result)
 
 	// Verify all expectations were met
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
+
+func TestListWithFilterUnit(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	// nolint:errcheck
+	defer sqlDB.Close()
+
+	db := &database.DB{DB: sqlDB}
+	repo := repository.NewUserRepository(logger.NewDiscardLogger(), db)
+	ctx := context.Background()
+
+	createdFrom := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	createdTo := time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC)
+	filter := repository.ListFilter{
+		Search:        "jo_n%",
+		CreatedFrom:   &createdFrom,
+		CreatedTo:     &createdTo,
+		SortBy:        repository.SortByName,
+		SortAscending: true,
+	}
+
+	// LIKE wildcards in the search are escaped so it stays a prefix match
+	rows := sqlmock.NewRows([]string{"id", "name", "email", "password", "role", "verified_at", "created_at", "updated_at"})
+	mock.ExpectQuery("SELECT (.+) FROM users WHERE deleted_at IS NULL AND \\(name LIKE \\? OR email LIKE \\?\\) AND created_at >= \\? AND created_at < \\? ORDER BY name ASC, id ASC LIMIT (.+) OFFSET (.+)").
+		WithArgs(`jo\_n\%%`, `jo\_n\%%`, createdFrom, createdTo, 20, 40).
+		WillReturnRows(rows)
+
+	result, err := repo.List(ctx, filter, 20, 40)
+	assert.NoError(t, err)
+	assert.Empty(t, result)
+
+	// Verify all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestListInvalidSortUnit(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	// nolint:errcheck
+	defer sqlDB.Close()
+
+	db := &database.DB{DB: sqlDB}
+	repo := repository.NewUserRepository(logger.NewDiscardLogger(), db)
+	ctx := context.Background()
+
+	// Sort columns are interpolated into the query, so anything else never reaches the database
+	_, err = repo.List(ctx, repository.ListFilter{SortBy: "password; DROP TABLE users"}, 10, 0)
+	assert.Equal(t, repository.ErrInvalidSortColumn, err)
+
+	// Verify all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -1,11 +1,13 @@
 package repository_test
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"log"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/fikryfahrezy/let-it-go/feature/user/repository"
 	"github.com/fikryfahrezy/let-it-go/pkg/database"
@@ -13,6 +15,7 @@ import (
 	"github.com/golang-migrate/migrate/v4"
 	"github.com/golang-migrate/migrate/v4/database/mysql"
 	_ "github.com/golang-migrate/migrate/v4/source/file"
+	"github.com/google/uuid"
 	"github.com/ory/dockertest/v3"
 )
 
@@ -92,6 +95,106 @@ func setupTest(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
+	_, err = db.Exec("DELETE FROM oidc_login_requests")
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+// createTestUser inserts a user and returns it with the generated ID
+func createTestUser(t *testing.T, email string) repository.User {
+	t.Helper()
+
+	err := testRepository.Create(context.Background(), repository.User{
+		Name:     "Test User",
+		Email:    email,
+		Password: "hashedpassword",
+		Role:     "author",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	user, err := testRepository.GetByEmail(context.Background(), email)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return user
+}
+
+// newTestSession builds a session for the user that expires after ttl
+func newTestSession(userID uuid.UUID, refreshTokenHash string, ttl time.Duration) repository.Session {
+	id := uuid.Must(uuid.NewV7())
+	return repository.Session{
+		ID:               id,
+		UserID:           userID,
+		FamilyID:         id,
+		RefreshTokenHash: refreshTokenHash,
+		UserAgent:        "test-agent",
+		IPAddress:        "127.0.0.1",
+		ExpiresAt:        time.Now().Add(ttl).Truncate(time.Second),
+	}
+}
+
+// newTestImpersonationSession builds an impersonation of the user by the admin that expires after an hour
+func newTestImpersonationSession(adminID, userID uuid.UUID) repository.ImpersonationSession {
+	return repository.ImpersonationSession{
+		ID:        uuid.Must(uuid.NewV7()),
+		AdminID:   adminID,
+		UserID:    userID,
+		Reason:    "Ticket #42: cannot publish",
+		UserAgent: "test-agent",
+		IPAddress: "127.0.0.1",
+		ExpiresAt: time.Now().Add(time.Hour).Truncate(time.Second),
+	}
+}
+
+// newTestPersonalAccessToken builds a personal access token for the user limited to scopes
+func newTestPersonalAccessToken(userID uuid.UUID, tokenHash string, scopes ...string) repository.PersonalAccessToken {
+	return repository.PersonalAccessToken{
+		ID:        uuid.Must(uuid.NewV7()),
+		UserID:    userID,
+		Name:      "ci",
+		TokenHash: tokenHash,
+		Scopes:    scopes,
+	}
+}
+
+// enableTestTwoFactor stores a confirmed two factor credential for the user with the given recovery code hashes
+func enableTestTwoFactor(t *testing.T, userID uuid.UUID, recoveryCodeHashes ...string) {
+	t.Helper()
+
+	ctx := context.Background()
+	err := testRepository.SaveTwoFactorCredential(ctx, repository.TwoFactorCredential{
+		UserID:           userID,
+		SecretCiphertext: "ciphertext",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	recoveryCodes := make([]repository.TwoFactorRecoveryCode, 0, len(recoveryCodeHashes))
+	for _, codeHash := range recoveryCodeHashes {
+		recoveryCodes = append(recoveryCodes, repository.TwoFactorRecoveryCode{
+			ID:       uuid.Must(uuid.NewV7()),
+			CodeHash: codeHash,
+		})
+	}
+	if err := testRepository.EnableTwoFactor(ctx, userID, 100, recoveryCodes); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// newTestOIDCLoginRequest builds an OIDC login request for the state hash that expires after ttl
+func newTestOIDCLoginRequest(stateHash string, ttl time.Duration) repository.OIDCLoginRequest {
+	return repository.OIDCLoginRequest{
+		ID:           uuid.Must(uuid.NewV7()),
+		StateHash:    stateHash,
+		Nonce:        "nonce",
+		CodeVerifier: "code-verifier",
+		ExpiresAt:    time.Now().Add(ttl).Truncate(time.Second),
+	}
 }
 
 func runMigrations(dsn string) {
@@ -0,0 +1,37 @@
+package repository
+
+import "strings"
+
+const personalAccessTokenColumns = "id, user_id, name, token_hash, scopes, expires_at, last_used_at, revoked_at, created_at"
+
+// rowScanner is implemented by both *sql.Row and *sql.Rows
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+// scanPersonalAccessToken reads a row selected with personalAccessTokenColumns
+func scanPersonalAccessToken(row rowScanner) (PersonalAccessToken, error) {
+	var accessToken PersonalAccessToken
+	var scopes string
+	err := row.Scan(
+		&accessToken.ID,
+		&accessToken.UserID,
+		&accessToken.Name,
+		&accessToken.TokenHash,
+		&scopes,
+		&accessToken.ExpiresAt,
+		&accessToken.LastUsedAt,
+		&accessToken.RevokedAt,
+		&accessToken.CreatedAt,
+	)
+	if err != nil {
+		return PersonalAccessToken{}, err
+	}
+
+	accessToken.Scopes = []string{}
+	if scopes != "" {
+		accessToken.Scopes = strings.Split(scopes, ",")
+	}
+
+	return accessToken, nil
+}
@@ -1,6 +1,9 @@
 package repository
 
 import (
+	"context"
+	"database/sql"
+	"fmt"
 	"log/slog"
 
 	"github.com/fikryfahrezy/let-it-go/pkg/database"
@@ -17,3 +20,38 @@ func NewUserRepository(log *slog.Logger, db *database.DB) *userRepository {
 		log: log,
 	}
 }
+
+// execer runs a statement on either the database or a transaction, so writes can be
+// shared between single operations and batches
+type execer interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+}
+
+// withTx runs fn in a transaction and commits it when fn succeeds; otherwise the
+// transaction is rolled back and fn's error returned. name describes the transaction in logs.
+func (r *userRepository) withTx(ctx context.Context, name string, fn func(tx *sql.Tx) error) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		r.log.Error("Failed to begin "+name+" transaction",
+			slog.String("error", err.Error()),
+		)
+		return fmt.Errorf("%w: %w", ErrFailedToBeginTx, err)
+	}
+	defer func() {
+		// Rollback is a no-op once the transaction is committed
+		_ = tx.Rollback()
+	}()
+
+	if err := fn(tx); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		r.log.Error("Failed to commit "+name+" transaction",
+			slog.String("error", err.Error()),
+		)
+		return fmt.Errorf("%w: %w", ErrFailedToCommitTx, err)
+	}
+
+	return nil
+}
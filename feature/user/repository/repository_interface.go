@@ -4,6 +4,7 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 )
@@ -14,6 +15,57 @@ type UserRepository interface {
 	GetByEmail(ctx context.Context, email string) (User, error)
 	Update(ctx context.Context, user User) error
 	Delete(ctx context.Context, id uuid.UUID) error
-	List(ctx context.Context, limit, offset int) ([]User, error)
-	Count(ctx context.Context) (int64, error)
+	ApplyBatch(ctx context.Context, changes []UserChange) (int, error)
+	Restore(ctx context.Context, id uuid.UUID, deletedAfter time.Time) error
+	EraseDeletedUsers(ctx context.Context, deletedBefore time.Time) (int64, error)
+	List(ctx context.Context, filter ListFilter, limit, offset int) ([]User, error)
+	Count(ctx context.Context, filter ListFilter) (int64, error)
+	UpdateRole(ctx context.Context, userID uuid.UUID, role string) error
+
+	GetAuthorProfile(ctx context.Context, userID uuid.UUID) (AuthorProfile, error)
+	GetAuthorProfileByHandle(ctx context.Context, handle string) (AuthorProfile, error)
+	SaveAuthorProfile(ctx context.Context, profile AuthorProfile) error
+
+	GetPreferences(ctx context.Context, userID uuid.UUID) (UserPreferences, error)
+	SavePreferences(ctx context.Context, preferences UserPreferences) error
+
+	CreateSession(ctx context.Context, session Session) error
+	GetSessionByRefreshTokenHash(ctx context.Context, refreshTokenHash string) (Session, error)
+	RotateSession(ctx context.Context, currentID uuid.UUID, next Session) error
+	RevokeSessionFamily(ctx context.Context, familyID uuid.UUID) error
+	ListActiveSessionsByUserID(ctx context.Context, userID uuid.UUID) ([]Session, error)
+	DeleteExpiredSessions(ctx context.Context, before time.Time) (int64, error)
+
+	CreateImpersonationSession(ctx context.Context, session ImpersonationSession) error
+	CreateImpersonationRequest(ctx context.Context, request ImpersonationRequest) error
+
+	CreatePasswordResetToken(ctx context.Context, resetToken PasswordResetToken) error
+	GetPasswordResetTokenByHash(ctx context.Context, tokenHash string) (PasswordResetToken, error)
+	ResetPassword(ctx context.Context, resetTokenID uuid.UUID, userID uuid.UUID, passwordHash string) error
+	UpdatePassword(ctx context.Context, userID uuid.UUID, passwordHash string) error
+	ChangePassword(ctx context.Context, userID uuid.UUID, passwordHash string, keepFamilyID uuid.UUID) error
+
+	CreateEmailVerificationToken(ctx context.Context, email string, verificationToken EmailVerificationToken) error
+	GetEmailVerificationTokenByHash(ctx context.Context, tokenHash string) (EmailVerificationToken, error)
+	VerifyEmail(ctx context.Context, verificationTokenID uuid.UUID, userID uuid.UUID) error
+
+	CreatePersonalAccessToken(ctx context.Context, accessToken PersonalAccessToken) error
+	GetPersonalAccessTokenByHash(ctx context.Context, tokenHash string) (PersonalAccessToken, error)
+	ListPersonalAccessTokensByUserID(ctx context.Context, userID uuid.UUID) ([]PersonalAccessToken, error)
+	RevokePersonalAccessToken(ctx context.Context, userID uuid.UUID, tokenID uuid.UUID) error
+	UpdatePersonalAccessTokenLastUsed(ctx context.Context, tokenID uuid.UUID, usedAt time.Time) error
+
+	SaveTwoFactorCredential(ctx context.Context, credential TwoFactorCredential) error
+	GetTwoFactorCredential(ctx context.Context, userID uuid.UUID) (TwoFactorCredential, error)
+	EnableTwoFactor(ctx context.Context, userID uuid.UUID, step int64, recoveryCodes []TwoFactorRecoveryCode) error
+	DisableTwoFactor(ctx context.Context, userID uuid.UUID) error
+	UseTwoFactorStep(ctx context.Context, userID uuid.UUID, step int64) error
+	UseRecoveryCode(ctx context.Context, userID uuid.UUID, codeHash string) error
+
+	GetUserIdentity(ctx context.Context, provider, subject string) (UserIdentity, error)
+	CreateUserIdentity(ctx context.Context, identity UserIdentity) error
+	CreateUserWithIdentity(ctx context.Context, user User, identity UserIdentity) error
+	CreateOIDCLoginRequest(ctx context.Context, loginRequest OIDCLoginRequest) error
+	ConsumeOIDCLoginRequest(ctx context.Context, stateHash string) (OIDCLoginRequest, error)
+	DeleteExpiredOIDCLoginRequests(ctx context.Context, before time.Time) (int64, error)
 }
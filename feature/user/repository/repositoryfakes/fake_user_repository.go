@@ -4,16 +4,60 @@ package repositoryfakes
 import (
 	"context"
 	"sync"
+	"time"
 
 	"github.com/fikryfahrezy/let-it-go/feature/user/repository"
 	"github.com/google/uuid"
 )
 
 type FakeUserRepository struct {
-	CountStub        func(context.Context) (int64, error)
+	ApplyBatchStub        func(context.Context, []repository.UserChange) (int, error)
+	applyBatchMutex       sync.RWMutex
+	applyBatchArgsForCall []struct {
+		arg1 context.Context
+		arg2 []repository.UserChange
+	}
+	applyBatchReturns struct {
+		result1 int
+		result2 error
+	}
+	applyBatchReturnsOnCall map[int]struct {
+		result1 int
+		result2 error
+	}
+	ChangePasswordStub        func(context.Context, uuid.UUID, string, uuid.UUID) error
+	changePasswordMutex       sync.RWMutex
+	changePasswordArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 string
+		arg4 uuid.UUID
+	}
+	changePasswordReturns struct {
+		result1 error
+	}
+	changePasswordReturnsOnCall map[int]struct {
+		result1 error
+	}
+	ConsumeOIDCLoginRequestStub        func(context.Context, string) (repository.OIDCLoginRequest, error)
+	consumeOIDCLoginRequestMutex       sync.RWMutex
+	consumeOIDCLoginRequestArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	consumeOIDCLoginRequestReturns struct {
+		result1 repository.OIDCLoginRequest
+		result2 error
+	}
+	consumeOIDCLoginRequestReturnsOnCall map[int]struct {
+		result1 repository.OIDCLoginRequest
+		result2 error
+	}
+	CountStub        func(context.Context, repository.ListFilter) (int64, error)
 	countMutex       sync.RWMutex
 	countArgsForCall []struct {
 		arg1 context.Context
+		arg2 repository.ListFilter
 	}
 	countReturns struct {
 		result1 int64
@@ -35,6 +79,116 @@ type FakeUserRepository struct {
 	createReturnsOnCall map[int]struct {
 		result1 error
 	}
+	CreateEmailVerificationTokenStub        func(context.Context, string, repository.EmailVerificationToken) error
+	createEmailVerificationTokenMutex       sync.RWMutex
+	createEmailVerificationTokenArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 repository.EmailVerificationToken
+	}
+	createEmailVerificationTokenReturns struct {
+		result1 error
+	}
+	createEmailVerificationTokenReturnsOnCall map[int]struct {
+		result1 error
+	}
+	CreateImpersonationRequestStub        func(context.Context, repository.ImpersonationRequest) error
+	createImpersonationRequestMutex       sync.RWMutex
+	createImpersonationRequestArgsForCall []struct {
+		arg1 context.Context
+		arg2 repository.ImpersonationRequest
+	}
+	createImpersonationRequestReturns struct {
+		result1 error
+	}
+	createImpersonationRequestReturnsOnCall map[int]struct {
+		result1 error
+	}
+	CreateImpersonationSessionStub        func(context.Context, repository.ImpersonationSession) error
+	createImpersonationSessionMutex       sync.RWMutex
+	createImpersonationSessionArgsForCall []struct {
+		arg1 context.Context
+		arg2 repository.ImpersonationSession
+	}
+	createImpersonationSessionReturns struct {
+		result1 error
+	}
+	createImpersonationSessionReturnsOnCall map[int]struct {
+		result1 error
+	}
+	CreateOIDCLoginRequestStub        func(context.Context, repository.OIDCLoginRequest) error
+	createOIDCLoginRequestMutex       sync.RWMutex
+	createOIDCLoginRequestArgsForCall []struct {
+		arg1 context.Context
+		arg2 repository.OIDCLoginRequest
+	}
+	createOIDCLoginRequestReturns struct {
+		result1 error
+	}
+	createOIDCLoginRequestReturnsOnCall map[int]struct {
+		result1 error
+	}
+	CreatePasswordResetTokenStub        func(context.Context, repository.PasswordResetToken) error
+	createPasswordResetTokenMutex       sync.RWMutex
+	createPasswordResetTokenArgsForCall []struct {
+		arg1 context.Context
+		arg2 repository.PasswordResetToken
+	}
+	createPasswordResetTokenReturns struct {
+		result1 error
+	}
+	createPasswordResetTokenReturnsOnCall map[int]struct {
+		result1 error
+	}
+	CreatePersonalAccessTokenStub        func(context.Context, repository.PersonalAccessToken) error
+	createPersonalAccessTokenMutex       sync.RWMutex
+	createPersonalAccessTokenArgsForCall []struct {
+		arg1 context.Context
+		arg2 repository.PersonalAccessToken
+	}
+	createPersonalAccessTokenReturns struct {
+		result1 error
+	}
+	createPersonalAccessTokenReturnsOnCall map[int]struct {
+		result1 error
+	}
+	CreateSessionStub        func(context.Context, repository.Session) error
+	createSessionMutex       sync.RWMutex
+	createSessionArgsForCall []struct {
+		arg1 context.Context
+		arg2 repository.Session
+	}
+	createSessionReturns struct {
+		result1 error
+	}
+	createSessionReturnsOnCall map[int]struct {
+		result1 error
+	}
+	CreateUserIdentityStub        func(context.Context, repository.UserIdentity) error
+	createUserIdentityMutex       sync.RWMutex
+	createUserIdentityArgsForCall []struct {
+		arg1 context.Context
+		arg2 repository.UserIdentity
+	}
+	createUserIdentityReturns struct {
+		result1 error
+	}
+	createUserIdentityReturnsOnCall map[int]struct {
+		result1 error
+	}
+	CreateUserWithIdentityStub        func(context.Context, repository.User, repository.UserIdentity) error
+	createUserWithIdentityMutex       sync.RWMutex
+	createUserWithIdentityArgsForCall []struct {
+		arg1 context.Context
+		arg2 repository.User
+		arg3 repository.UserIdentity
+	}
+	createUserWithIdentityReturns struct {
+		result1 error
+	}
+	createUserWithIdentityReturnsOnCall map[int]struct {
+		result1 error
+	}
 	DeleteStub        func(context.Context, uuid.UUID) error
 	deleteMutex       sync.RWMutex
 	deleteArgsForCall []struct {
@@ -47,6 +201,102 @@ type FakeUserRepository struct {
 	deleteReturnsOnCall map[int]struct {
 		result1 error
 	}
+	DeleteExpiredOIDCLoginRequestsStub        func(context.Context, time.Time) (int64, error)
+	deleteExpiredOIDCLoginRequestsMutex       sync.RWMutex
+	deleteExpiredOIDCLoginRequestsArgsForCall []struct {
+		arg1 context.Context
+		arg2 time.Time
+	}
+	deleteExpiredOIDCLoginRequestsReturns struct {
+		result1 int64
+		result2 error
+	}
+	deleteExpiredOIDCLoginRequestsReturnsOnCall map[int]struct {
+		result1 int64
+		result2 error
+	}
+	DeleteExpiredSessionsStub        func(context.Context, time.Time) (int64, error)
+	deleteExpiredSessionsMutex       sync.RWMutex
+	deleteExpiredSessionsArgsForCall []struct {
+		arg1 context.Context
+		arg2 time.Time
+	}
+	deleteExpiredSessionsReturns struct {
+		result1 int64
+		result2 error
+	}
+	deleteExpiredSessionsReturnsOnCall map[int]struct {
+		result1 int64
+		result2 error
+	}
+	DisableTwoFactorStub        func(context.Context, uuid.UUID) error
+	disableTwoFactorMutex       sync.RWMutex
+	disableTwoFactorArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}
+	disableTwoFactorReturns struct {
+		result1 error
+	}
+	disableTwoFactorReturnsOnCall map[int]struct {
+		result1 error
+	}
+	EnableTwoFactorStub        func(context.Context, uuid.UUID, int64, []repository.TwoFactorRecoveryCode) error
+	enableTwoFactorMutex       sync.RWMutex
+	enableTwoFactorArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 int64
+		arg4 []repository.TwoFactorRecoveryCode
+	}
+	enableTwoFactorReturns struct {
+		result1 error
+	}
+	enableTwoFactorReturnsOnCall map[int]struct {
+		result1 error
+	}
+	EraseDeletedUsersStub        func(context.Context, time.Time) (int64, error)
+	eraseDeletedUsersMutex       sync.RWMutex
+	eraseDeletedUsersArgsForCall []struct {
+		arg1 context.Context
+		arg2 time.Time
+	}
+	eraseDeletedUsersReturns struct {
+		result1 int64
+		result2 error
+	}
+	eraseDeletedUsersReturnsOnCall map[int]struct {
+		result1 int64
+		result2 error
+	}
+	GetAuthorProfileStub        func(context.Context, uuid.UUID) (repository.AuthorProfile, error)
+	getAuthorProfileMutex       sync.RWMutex
+	getAuthorProfileArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}
+	getAuthorProfileReturns struct {
+		result1 repository.AuthorProfile
+		result2 error
+	}
+	getAuthorProfileReturnsOnCall map[int]struct {
+		result1 repository.AuthorProfile
+		result2 error
+	}
+	GetAuthorProfileByHandleStub        func(context.Context, string) (repository.AuthorProfile, error)
+	getAuthorProfileByHandleMutex       sync.RWMutex
+	getAuthorProfileByHandleArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	getAuthorProfileByHandleReturns struct {
+		result1 repository.AuthorProfile
+		result2 error
+	}
+	getAuthorProfileByHandleReturnsOnCall map[int]struct {
+		result1 repository.AuthorProfile
+		result2 error
+	}
 	GetByEmailStub        func(context.Context, string) (repository.User, error)
 	getByEmailMutex       sync.RWMutex
 	getByEmailArgsForCall []struct {
@@ -75,12 +325,112 @@ type FakeUserRepository struct {
 		result1 repository.User
 		result2 error
 	}
-	ListStub        func(context.Context, int, int) ([]repository.User, error)
+	GetEmailVerificationTokenByHashStub        func(context.Context, string) (repository.EmailVerificationToken, error)
+	getEmailVerificationTokenByHashMutex       sync.RWMutex
+	getEmailVerificationTokenByHashArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	getEmailVerificationTokenByHashReturns struct {
+		result1 repository.EmailVerificationToken
+		result2 error
+	}
+	getEmailVerificationTokenByHashReturnsOnCall map[int]struct {
+		result1 repository.EmailVerificationToken
+		result2 error
+	}
+	GetPasswordResetTokenByHashStub        func(context.Context, string) (repository.PasswordResetToken, error)
+	getPasswordResetTokenByHashMutex       sync.RWMutex
+	getPasswordResetTokenByHashArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	getPasswordResetTokenByHashReturns struct {
+		result1 repository.PasswordResetToken
+		result2 error
+	}
+	getPasswordResetTokenByHashReturnsOnCall map[int]struct {
+		result1 repository.PasswordResetToken
+		result2 error
+	}
+	GetPersonalAccessTokenByHashStub        func(context.Context, string) (repository.PersonalAccessToken, error)
+	getPersonalAccessTokenByHashMutex       sync.RWMutex
+	getPersonalAccessTokenByHashArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	getPersonalAccessTokenByHashReturns struct {
+		result1 repository.PersonalAccessToken
+		result2 error
+	}
+	getPersonalAccessTokenByHashReturnsOnCall map[int]struct {
+		result1 repository.PersonalAccessToken
+		result2 error
+	}
+	GetPreferencesStub        func(context.Context, uuid.UUID) (repository.UserPreferences, error)
+	getPreferencesMutex       sync.RWMutex
+	getPreferencesArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}
+	getPreferencesReturns struct {
+		result1 repository.UserPreferences
+		result2 error
+	}
+	getPreferencesReturnsOnCall map[int]struct {
+		result1 repository.UserPreferences
+		result2 error
+	}
+	GetSessionByRefreshTokenHashStub        func(context.Context, string) (repository.Session, error)
+	getSessionByRefreshTokenHashMutex       sync.RWMutex
+	getSessionByRefreshTokenHashArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	getSessionByRefreshTokenHashReturns struct {
+		result1 repository.Session
+		result2 error
+	}
+	getSessionByRefreshTokenHashReturnsOnCall map[int]struct {
+		result1 repository.Session
+		result2 error
+	}
+	GetTwoFactorCredentialStub        func(context.Context, uuid.UUID) (repository.TwoFactorCredential, error)
+	getTwoFactorCredentialMutex       sync.RWMutex
+	getTwoFactorCredentialArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}
+	getTwoFactorCredentialReturns struct {
+		result1 repository.TwoFactorCredential
+		result2 error
+	}
+	getTwoFactorCredentialReturnsOnCall map[int]struct {
+		result1 repository.TwoFactorCredential
+		result2 error
+	}
+	GetUserIdentityStub        func(context.Context, string, string) (repository.UserIdentity, error)
+	getUserIdentityMutex       sync.RWMutex
+	getUserIdentityArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}
+	getUserIdentityReturns struct {
+		result1 repository.UserIdentity
+		result2 error
+	}
+	getUserIdentityReturnsOnCall map[int]struct {
+		result1 repository.UserIdentity
+		result2 error
+	}
+	ListStub        func(context.Context, repository.ListFilter, int, int) ([]repository.User, error)
 	listMutex       sync.RWMutex
 	listArgsForCall []struct {
 		arg1 context.Context
-		arg2 int
+		arg2 repository.ListFilter
 		arg3 int
+		arg4 int
 	}
 	listReturns struct {
 		result1 []repository.User
@@ -90,6 +440,135 @@ type FakeUserRepository struct {
 		result1 []repository.User
 		result2 error
 	}
+	ListActiveSessionsByUserIDStub        func(context.Context, uuid.UUID) ([]repository.Session, error)
+	listActiveSessionsByUserIDMutex       sync.RWMutex
+	listActiveSessionsByUserIDArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}
+	listActiveSessionsByUserIDReturns struct {
+		result1 []repository.Session
+		result2 error
+	}
+	listActiveSessionsByUserIDReturnsOnCall map[int]struct {
+		result1 []repository.Session
+		result2 error
+	}
+	ListPersonalAccessTokensByUserIDStub        func(context.Context, uuid.UUID) ([]repository.PersonalAccessToken, error)
+	listPersonalAccessTokensByUserIDMutex       sync.RWMutex
+	listPersonalAccessTokensByUserIDArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}
+	listPersonalAccessTokensByUserIDReturns struct {
+		result1 []repository.PersonalAccessToken
+		result2 error
+	}
+	listPersonalAccessTokensByUserIDReturnsOnCall map[int]struct {
+		result1 []repository.PersonalAccessToken
+		result2 error
+	}
+	ResetPasswordStub        func(context.Context, uuid.UUID, uuid.UUID, string) error
+	resetPasswordMutex       sync.RWMutex
+	resetPasswordArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 string
+	}
+	resetPasswordReturns struct {
+		result1 error
+	}
+	resetPasswordReturnsOnCall map[int]struct {
+		result1 error
+	}
+	RestoreStub        func(context.Context, uuid.UUID, time.Time) error
+	restoreMutex       sync.RWMutex
+	restoreArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 time.Time
+	}
+	restoreReturns struct {
+		result1 error
+	}
+	restoreReturnsOnCall map[int]struct {
+		result1 error
+	}
+	RevokePersonalAccessTokenStub        func(context.Context, uuid.UUID, uuid.UUID) error
+	revokePersonalAccessTokenMutex       sync.RWMutex
+	revokePersonalAccessTokenArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+	}
+	revokePersonalAccessTokenReturns struct {
+		result1 error
+	}
+	revokePersonalAccessTokenReturnsOnCall map[int]struct {
+		result1 error
+	}
+	RevokeSessionFamilyStub        func(context.Context, uuid.UUID) error
+	revokeSessionFamilyMutex       sync.RWMutex
+	revokeSessionFamilyArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}
+	revokeSessionFamilyReturns struct {
+		result1 error
+	}
+	revokeSessionFamilyReturnsOnCall map[int]struct {
+		result1 error
+	}
+	RotateSessionStub        func(context.Context, uuid.UUID, repository.Session) error
+	rotateSessionMutex       sync.RWMutex
+	rotateSessionArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 repository.Session
+	}
+	rotateSessionReturns struct {
+		result1 error
+	}
+	rotateSessionReturnsOnCall map[int]struct {
+		result1 error
+	}
+	SaveAuthorProfileStub        func(context.Context, repository.AuthorProfile) error
+	saveAuthorProfileMutex       sync.RWMutex
+	saveAuthorProfileArgsForCall []struct {
+		arg1 context.Context
+		arg2 repository.AuthorProfile
+	}
+	saveAuthorProfileReturns struct {
+		result1 error
+	}
+	saveAuthorProfileReturnsOnCall map[int]struct {
+		result1 error
+	}
+	SavePreferencesStub        func(context.Context, repository.UserPreferences) error
+	savePreferencesMutex       sync.RWMutex
+	savePreferencesArgsForCall []struct {
+		arg1 context.Context
+		arg2 repository.UserPreferences
+	}
+	savePreferencesReturns struct {
+		result1 error
+	}
+	savePreferencesReturnsOnCall map[int]struct {
+		result1 error
+	}
+	SaveTwoFactorCredentialStub        func(context.Context, repository.TwoFactorCredential) error
+	saveTwoFactorCredentialMutex       sync.RWMutex
+	saveTwoFactorCredentialArgsForCall []struct {
+		arg1 context.Context
+		arg2 repository.TwoFactorCredential
+	}
+	saveTwoFactorCredentialReturns struct {
+		result1 error
+	}
+	saveTwoFactorCredentialReturnsOnCall map[int]struct {
+		result1 error
+	}
 	UpdateStub        func(context.Context, repository.User) error
 	updateMutex       sync.RWMutex
 	updateArgsForCall []struct {
@@ -102,22 +581,300 @@ type FakeUserRepository struct {
 	updateReturnsOnCall map[int]struct {
 		result1 error
 	}
-	invocations      map[string][][]interface{}
-	invocationsMutex sync.RWMutex
-}
-
-func (fake *FakeUserRepository) Count(arg1 context.Context) (int64, error) {
-	fake.countMutex.Lock()
-	ret, specificReturn := fake.countReturnsOnCall[len(fake.countArgsForCall)]
-	fake.countArgsForCall = append(fake.countArgsForCall, struct {
+	UpdatePasswordStub        func(context.Context, uuid.UUID, string) error
+	updatePasswordMutex       sync.RWMutex
+	updatePasswordArgsForCall []struct {
 		arg1 context.Context
-	}{arg1})
-	stub := fake.CountStub
-	fakeReturns := fake.countReturns
-	fake.recordInvocation("Count", []interface{}{arg1})
-	fake.countMutex.Unlock()
-	if stub != nil {
-		return stub(arg1)
+		arg2 uuid.UUID
+		arg3 string
+	}
+	updatePasswordReturns struct {
+		result1 error
+	}
+	updatePasswordReturnsOnCall map[int]struct {
+		result1 error
+	}
+	UpdatePersonalAccessTokenLastUsedStub        func(context.Context, uuid.UUID, time.Time) error
+	updatePersonalAccessTokenLastUsedMutex       sync.RWMutex
+	updatePersonalAccessTokenLastUsedArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 time.Time
+	}
+	updatePersonalAccessTokenLastUsedReturns struct {
+		result1 error
+	}
+	updatePersonalAccessTokenLastUsedReturnsOnCall map[int]struct {
+		result1 error
+	}
+	UpdateRoleStub        func(context.Context, uuid.UUID, string) error
+	updateRoleMutex       sync.RWMutex
+	updateRoleArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 string
+	}
+	updateRoleReturns struct {
+		result1 error
+	}
+	updateRoleReturnsOnCall map[int]struct {
+		result1 error
+	}
+	UseRecoveryCodeStub        func(context.Context, uuid.UUID, string) error
+	useRecoveryCodeMutex       sync.RWMutex
+	useRecoveryCodeArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 string
+	}
+	useRecoveryCodeReturns struct {
+		result1 error
+	}
+	useRecoveryCodeReturnsOnCall map[int]struct {
+		result1 error
+	}
+	UseTwoFactorStepStub        func(context.Context, uuid.UUID, int64) error
+	useTwoFactorStepMutex       sync.RWMutex
+	useTwoFactorStepArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 int64
+	}
+	useTwoFactorStepReturns struct {
+		result1 error
+	}
+	useTwoFactorStepReturnsOnCall map[int]struct {
+		result1 error
+	}
+	VerifyEmailStub        func(context.Context, uuid.UUID, uuid.UUID) error
+	verifyEmailMutex       sync.RWMutex
+	verifyEmailArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+	}
+	verifyEmailReturns struct {
+		result1 error
+	}
+	verifyEmailReturnsOnCall map[int]struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeUserRepository) ApplyBatch(arg1 context.Context, arg2 []repository.UserChange) (int, error) {
+	var arg2Copy []repository.UserChange
+	if arg2 != nil {
+		arg2Copy = make([]repository.UserChange, len(arg2))
+		copy(arg2Copy, arg2)
+	}
+	fake.applyBatchMutex.Lock()
+	ret, specificReturn := fake.applyBatchReturnsOnCall[len(fake.applyBatchArgsForCall)]
+	fake.applyBatchArgsForCall = append(fake.applyBatchArgsForCall, struct {
+		arg1 context.Context
+		arg2 []repository.UserChange
+	}{arg1, arg2Copy})
+	stub := fake.ApplyBatchStub
+	fakeReturns := fake.applyBatchReturns
+	fake.recordInvocation("ApplyBatch", []interface{}{arg1, arg2Copy})
+	fake.applyBatchMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeUserRepository) ApplyBatchCallCount() int {
+	fake.applyBatchMutex.RLock()
+	defer fake.applyBatchMutex.RUnlock()
+	return len(fake.applyBatchArgsForCall)
+}
+
+func (fake *FakeUserRepository) ApplyBatchCalls(stub func(context.Context, []repository.UserChange) (int, error)) {
+	fake.applyBatchMutex.Lock()
+	defer fake.applyBatchMutex.Unlock()
+	fake.ApplyBatchStub = stub
+}
+
+func (fake *FakeUserRepository) ApplyBatchArgsForCall(i int) (context.Context, []repository.UserChange) {
+	fake.applyBatchMutex.RLock()
+	defer fake.applyBatchMutex.RUnlock()
+	argsForCall := fake.applyBatchArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeUserRepository) ApplyBatchReturns(result1 int, result2 error) {
+	fake.applyBatchMutex.Lock()
+	defer fake.applyBatchMutex.Unlock()
+	fake.ApplyBatchStub = nil
+	fake.applyBatchReturns = struct {
+		result1 int
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUserRepository) ApplyBatchReturnsOnCall(i int, result1 int, result2 error) {
+	fake.applyBatchMutex.Lock()
+	defer fake.applyBatchMutex.Unlock()
+	fake.ApplyBatchStub = nil
+	if fake.applyBatchReturnsOnCall == nil {
+		fake.applyBatchReturnsOnCall = make(map[int]struct {
+			result1 int
+			result2 error
+		})
+	}
+	fake.applyBatchReturnsOnCall[i] = struct {
+		result1 int
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUserRepository) ChangePassword(arg1 context.Context, arg2 uuid.UUID, arg3 string, arg4 uuid.UUID) error {
+	fake.changePasswordMutex.Lock()
+	ret, specificReturn := fake.changePasswordReturnsOnCall[len(fake.changePasswordArgsForCall)]
+	fake.changePasswordArgsForCall = append(fake.changePasswordArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 string
+		arg4 uuid.UUID
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.ChangePasswordStub
+	fakeReturns := fake.changePasswordReturns
+	fake.recordInvocation("ChangePassword", []interface{}{arg1, arg2, arg3, arg4})
+	fake.changePasswordMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeUserRepository) ChangePasswordCallCount() int {
+	fake.changePasswordMutex.RLock()
+	defer fake.changePasswordMutex.RUnlock()
+	return len(fake.changePasswordArgsForCall)
+}
+
+func (fake *FakeUserRepository) ChangePasswordCalls(stub func(context.Context, uuid.UUID, string, uuid.UUID) error) {
+	fake.changePasswordMutex.Lock()
+	defer fake.changePasswordMutex.Unlock()
+	fake.ChangePasswordStub = stub
+}
+
+func (fake *FakeUserRepository) ChangePasswordArgsForCall(i int) (context.Context, uuid.UUID, string, uuid.UUID) {
+	fake.changePasswordMutex.RLock()
+	defer fake.changePasswordMutex.RUnlock()
+	argsForCall := fake.changePasswordArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeUserRepository) ChangePasswordReturns(result1 error) {
+	fake.changePasswordMutex.Lock()
+	defer fake.changePasswordMutex.Unlock()
+	fake.ChangePasswordStub = nil
+	fake.changePasswordReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeUserRepository) ChangePasswordReturnsOnCall(i int, result1 error) {
+	fake.changePasswordMutex.Lock()
+	defer fake.changePasswordMutex.Unlock()
+	fake.ChangePasswordStub = nil
+	if fake.changePasswordReturnsOnCall == nil {
+		fake.changePasswordReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.changePasswordReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeUserRepository) ConsumeOIDCLoginRequest(arg1 context.Context, arg2 string) (repository.OIDCLoginRequest, error) {
+	fake.consumeOIDCLoginRequestMutex.Lock()
+	ret, specificReturn := fake.consumeOIDCLoginRequestReturnsOnCall[len(fake.consumeOIDCLoginRequestArgsForCall)]
+	fake.consumeOIDCLoginRequestArgsForCall = append(fake.consumeOIDCLoginRequestArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.ConsumeOIDCLoginRequestStub
+	fakeReturns := fake.consumeOIDCLoginRequestReturns
+	fake.recordInvocation("ConsumeOIDCLoginRequest", []interface{}{arg1, arg2})
+	fake.consumeOIDCLoginRequestMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeUserRepository) ConsumeOIDCLoginRequestCallCount() int {
+	fake.consumeOIDCLoginRequestMutex.RLock()
+	defer fake.consumeOIDCLoginRequestMutex.RUnlock()
+	return len(fake.consumeOIDCLoginRequestArgsForCall)
+}
+
+func (fake *FakeUserRepository) ConsumeOIDCLoginRequestCalls(stub func(context.Context, string) (repository.OIDCLoginRequest, error)) {
+	fake.consumeOIDCLoginRequestMutex.Lock()
+	defer fake.consumeOIDCLoginRequestMutex.Unlock()
+	fake.ConsumeOIDCLoginRequestStub = stub
+}
+
+func (fake *FakeUserRepository) ConsumeOIDCLoginRequestArgsForCall(i int) (context.Context, string) {
+	fake.consumeOIDCLoginRequestMutex.RLock()
+	defer fake.consumeOIDCLoginRequestMutex.RUnlock()
+	argsForCall := fake.consumeOIDCLoginRequestArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeUserRepository) ConsumeOIDCLoginRequestReturns(result1 repository.OIDCLoginRequest, result2 error) {
+	fake.consumeOIDCLoginRequestMutex.Lock()
+	defer fake.consumeOIDCLoginRequestMutex.Unlock()
+	fake.ConsumeOIDCLoginRequestStub = nil
+	fake.consumeOIDCLoginRequestReturns = struct {
+		result1 repository.OIDCLoginRequest
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUserRepository) ConsumeOIDCLoginRequestReturnsOnCall(i int, result1 repository.OIDCLoginRequest, result2 error) {
+	fake.consumeOIDCLoginRequestMutex.Lock()
+	defer fake.consumeOIDCLoginRequestMutex.Unlock()
+	fake.ConsumeOIDCLoginRequestStub = nil
+	if fake.consumeOIDCLoginRequestReturnsOnCall == nil {
+		fake.consumeOIDCLoginRequestReturnsOnCall = make(map[int]struct {
+			result1 repository.OIDCLoginRequest
+			result2 error
+		})
+	}
+	fake.consumeOIDCLoginRequestReturnsOnCall[i] = struct {
+		result1 repository.OIDCLoginRequest
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUserRepository) Count(arg1 context.Context, arg2 repository.ListFilter) (int64, error) {
+	fake.countMutex.Lock()
+	ret, specificReturn := fake.countReturnsOnCall[len(fake.countArgsForCall)]
+	fake.countArgsForCall = append(fake.countArgsForCall, struct {
+		arg1 context.Context
+		arg2 repository.ListFilter
+	}{arg1, arg2})
+	stub := fake.CountStub
+	fakeReturns := fake.countReturns
+	fake.recordInvocation("Count", []interface{}{arg1, arg2})
+	fake.countMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -131,17 +888,17 @@ func (fake *FakeUserRepository) CountCallCount() int {
 	return len(fake.countArgsForCall)
 }
 
-func (fake *FakeUserRepository) CountCalls(stub func(context.Context) (int64, error)) {
+func (fake *FakeUserRepository) CountCalls(stub func(context.Context, repository.ListFilter) (int64, error)) {
 	fake.countMutex.Lock()
 	defer fake.countMutex.Unlock()
 	fake.CountStub = stub
 }
 
-func (fake *FakeUserRepository) CountArgsForCall(i int) context.Context {
+func (fake *FakeUserRepository) CountArgsForCall(i int) (context.Context, repository.ListFilter) {
 	fake.countMutex.RLock()
 	defer fake.countMutex.RUnlock()
 	argsForCall := fake.countArgsForCall[i]
-	return argsForCall.arg1
+	return argsForCall.arg1, argsForCall.arg2
 }
 
 func (fake *FakeUserRepository) CountReturns(result1 int64, result2 error) {
@@ -202,49 +959,2537 @@ func (fake *FakeUserRepository) CreateCalls(stub func(context.Context, repositor
 	fake.CreateStub = stub
 }
 
-func (fake *FakeUserRepository) CreateArgsForCall(i int) (context.Context, repository.User) {
-	fake.createMutex.RLock()
-	defer fake.createMutex.RUnlock()
-	argsForCall := fake.createArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2
+func (fake *FakeUserRepository) CreateArgsForCall(i int) (context.Context, repository.User) {
+	fake.createMutex.RLock()
+	defer fake.createMutex.RUnlock()
+	argsForCall := fake.createArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeUserRepository) CreateReturns(result1 error) {
+	fake.createMutex.Lock()
+	defer fake.createMutex.Unlock()
+	fake.CreateStub = nil
+	fake.createReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeUserRepository) CreateReturnsOnCall(i int, result1 error) {
+	fake.createMutex.Lock()
+	defer fake.createMutex.Unlock()
+	fake.CreateStub = nil
+	if fake.createReturnsOnCall == nil {
+		fake.createReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.createReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeUserRepository) CreateEmailVerificationToken(arg1 context.Context, arg2 string, arg3 repository.EmailVerificationToken) error {
+	fake.createEmailVerificationTokenMutex.Lock()
+	ret, specificReturn := fake.createEmailVerificationTokenReturnsOnCall[len(fake.createEmailVerificationTokenArgsForCall)]
+	fake.createEmailVerificationTokenArgsForCall = append(fake.createEmailVerificationTokenArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 repository.EmailVerificationToken
+	}{arg1, arg2, arg3})
+	stub := fake.CreateEmailVerificationTokenStub
+	fakeReturns := fake.createEmailVerificationTokenReturns
+	fake.recordInvocation("CreateEmailVerificationToken", []interface{}{arg1, arg2, arg3})
+	fake.createEmailVerificationTokenMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeUserRepository) CreateEmailVerificationTokenCallCount() int {
+	fake.createEmailVerificationTokenMutex.RLock()
+	defer fake.createEmailVerificationTokenMutex.RUnlock()
+	return len(fake.createEmailVerificationTokenArgsForCall)
+}
+
+func (fake *FakeUserRepository) CreateEmailVerificationTokenCalls(stub func(context.Context, string, repository.EmailVerificationToken) error) {
+	fake.createEmailVerificationTokenMutex.Lock()
+	defer fake.createEmailVerificationTokenMutex.Unlock()
+	fake.CreateEmailVerificationTokenStub = stub
+}
+
+func (fake *FakeUserRepository) CreateEmailVerificationTokenArgsForCall(i int) (context.Context, string, repository.EmailVerificationToken) {
+	fake.createEmailVerificationTokenMutex.RLock()
+	defer fake.createEmailVerificationTokenMutex.RUnlock()
+	argsForCall := fake.createEmailVerificationTokenArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeUserRepository) CreateEmailVerificationTokenReturns(result1 error) {
+	fake.createEmailVerificationTokenMutex.Lock()
+	defer fake.createEmailVerificationTokenMutex.Unlock()
+	fake.CreateEmailVerificationTokenStub = nil
+	fake.createEmailVerificationTokenReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeUserRepository) CreateEmailVerificationTokenReturnsOnCall(i int, result1 error) {
+	fake.createEmailVerificationTokenMutex.Lock()
+	defer fake.createEmailVerificationTokenMutex.Unlock()
+	fake.CreateEmailVerificationTokenStub = nil
+	if fake.createEmailVerificationTokenReturnsOnCall == nil {
+		fake.createEmailVerificationTokenReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.createEmailVerificationTokenReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeUserRepository) CreateImpersonationRequest(arg1 context.Context, arg2 repository.ImpersonationRequest) error {
+	fake.createImpersonationRequestMutex.Lock()
+	ret, specificReturn := fake.createImpersonationRequestReturnsOnCall[len(fake.createImpersonationRequestArgsForCall)]
+	fake.createImpersonationRequestArgsForCall = append(fake.createImpersonationRequestArgsForCall, struct {
+		arg1 context.Context
+		arg2 repository.ImpersonationRequest
+	}{arg1, arg2})
+	stub := fake.CreateImpersonationRequestStub
+	fakeReturns := fake.createImpersonationRequestReturns
+	fake.recordInvocation("CreateImpersonationRequest", []interface{}{arg1, arg2})
+	fake.createImpersonationRequestMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeUserRepository) CreateImpersonationRequestCallCount() int {
+	fake.createImpersonationRequestMutex.RLock()
+	defer fake.createImpersonationRequestMutex.RUnlock()
+	return len(fake.createImpersonationRequestArgsForCall)
+}
+
+func (fake *FakeUserRepository) CreateImpersonationRequestCalls(stub func(context.Context, repository.ImpersonationRequest) error) {
+	fake.createImpersonationRequestMutex.Lock()
+	defer fake.createImpersonationRequestMutex.Unlock()
+	fake.CreateImpersonationRequestStub = stub
+}
+
+func (fake *FakeUserRepository) CreateImpersonationRequestArgsForCall(i int) (context.Context, repository.ImpersonationRequest) {
+	fake.createImpersonationRequestMutex.RLock()
+	defer fake.createImpersonationRequestMutex.RUnlock()
+	argsForCall := fake.createImpersonationRequestArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeUserRepository) CreateImpersonationRequestReturns(result1 error) {
+	fake.createImpersonationRequestMutex.Lock()
+	defer fake.createImpersonationRequestMutex.Unlock()
+	fake.CreateImpersonationRequestStub = nil
+	fake.createImpersonationRequestReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeUserRepository) CreateImpersonationRequestReturnsOnCall(i int, result1 error) {
+	fake.createImpersonationRequestMutex.Lock()
+	defer fake.createImpersonationRequestMutex.Unlock()
+	fake.CreateImpersonationRequestStub = nil
+	if fake.createImpersonationRequestReturnsOnCall == nil {
+		fake.createImpersonationRequestReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.createImpersonationRequestReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeUserRepository) CreateImpersonationSession(arg1 context.Context, arg2 repository.ImpersonationSession) error {
+	fake.createImpersonationSessionMutex.Lock()
+	ret, specificReturn := fake.createImpersonationSessionReturnsOnCall[len(fake.createImpersonationSessionArgsForCall)]
+	fake.createImpersonationSessionArgsForCall = append(fake.createImpersonationSessionArgsForCall, struct {
+		arg1 context.Context
+		arg2 repository.ImpersonationSession
+	}{arg1, arg2})
+	stub := fake.CreateImpersonationSessionStub
+	fakeReturns := fake.createImpersonationSessionReturns
+	fake.recordInvocation("CreateImpersonationSession", []interface{}{arg1, arg2})
+	fake.createImpersonationSessionMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeUserRepository) CreateImpersonationSessionCallCount() int {
+	fake.createImpersonationSessionMutex.RLock()
+	defer fake.createImpersonationSessionMutex.RUnlock()
+	return len(fake.createImpersonationSessionArgsForCall)
+}
+
+func (fake *FakeUserRepository) CreateImpersonationSessionCalls(stub func(context.Context, repository.ImpersonationSession) error) {
+	fake.createImpersonationSessionMutex.Lock()
+	defer fake.createImpersonationSessionMutex.Unlock()
+	fake.CreateImpersonationSessionStub = stub
+}
+
+func (fake *FakeUserRepository) CreateImpersonationSessionArgsForCall(i int) (context.Context, repository.ImpersonationSession) {
+	fake.createImpersonationSessionMutex.RLock()
+	defer fake.createImpersonationSessionMutex.RUnlock()
+	argsForCall := fake.createImpersonationSessionArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeUserRepository) CreateImpersonationSessionReturns(result1 error) {
+	fake.createImpersonationSessionMutex.Lock()
+	defer fake.createImpersonationSessionMutex.Unlock()
+	fake.CreateImpersonationSessionStub = nil
+	fake.createImpersonationSessionReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeUserRepository) CreateImpersonationSessionReturnsOnCall(i int, result1 error) {
+	fake.createImpersonationSessionMutex.Lock()
+	defer fake.createImpersonationSessionMutex.Unlock()
+	fake.CreateImpersonationSessionStub = nil
+	if fake.createImpersonationSessionReturnsOnCall == nil {
+		fake.createImpersonationSessionReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.createImpersonationSessionReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeUserRepository) CreateOIDCLoginRequest(arg1 context.Context, arg2 repository.OIDCLoginRequest) error {
+	fake.createOIDCLoginRequestMutex.Lock()
+	ret, specificReturn := fake.createOIDCLoginRequestReturnsOnCall[len(fake.createOIDCLoginRequestArgsForCall)]
+	fake.createOIDCLoginRequestArgsForCall = append(fake.createOIDCLoginRequestArgsForCall, struct {
+		arg1 context.Context
+		arg2 repository.OIDCLoginRequest
+	}{arg1, arg2})
+	stub := fake.CreateOIDCLoginRequestStub
+	fakeReturns := fake.createOIDCLoginRequestReturns
+	fake.recordInvocation("CreateOIDCLoginRequest", []interface{}{arg1, arg2})
+	fake.createOIDCLoginRequestMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeUserRepository) CreateOIDCLoginRequestCallCount() int {
+	fake.createOIDCLoginRequestMutex.RLock()
+	defer fake.createOIDCLoginRequestMutex.RUnlock()
+	return len(fake.createOIDCLoginRequestArgsForCall)
+}
+
+func (fake *FakeUserRepository) CreateOIDCLoginRequestCalls(stub func(context.Context, repository.OIDCLoginRequest) error) {
+	fake.createOIDCLoginRequestMutex.Lock()
+	defer fake.createOIDCLoginRequestMutex.Unlock()
+	fake.CreateOIDCLoginRequestStub = stub
+}
+
+func (fake *FakeUserRepository) CreateOIDCLoginRequestArgsForCall(i int) (context.Context, repository.OIDCLoginRequest) {
+	fake.createOIDCLoginRequestMutex.RLock()
+	defer fake.createOIDCLoginRequestMutex.RUnlock()
+	argsForCall := fake.createOIDCLoginRequestArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeUserRepository) CreateOIDCLoginRequestReturns(result1 error) {
+	fake.createOIDCLoginRequestMutex.Lock()
+	defer fake.createOIDCLoginRequestMutex.Unlock()
+	fake.CreateOIDCLoginRequestStub = nil
+	fake.createOIDCLoginRequestReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeUserRepository) CreateOIDCLoginRequestReturnsOnCall(i int, result1 error) {
+	fake.createOIDCLoginRequestMutex.Lock()
+	defer fake.createOIDCLoginRequestMutex.Unlock()
+	fake.CreateOIDCLoginRequestStub = nil
+	if fake.createOIDCLoginRequestReturnsOnCall == nil {
+		fake.createOIDCLoginRequestReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.createOIDCLoginRequestReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeUserRepository) CreatePasswordResetToken(arg1 context.Context, arg2 repository.PasswordResetToken) error {
+	fake.createPasswordResetTokenMutex.Lock()
+	ret, specificReturn := fake.createPasswordResetTokenReturnsOnCall[len(fake.createPasswordResetTokenArgsForCall)]
+	fake.createPasswordResetTokenArgsForCall = append(fake.createPasswordResetTokenArgsForCall, struct {
+		arg1 context.Context
+		arg2 repository.PasswordResetToken
+	}{arg1, arg2})
+	stub := fake.CreatePasswordResetTokenStub
+	fakeReturns := fake.createPasswordResetTokenReturns
+	fake.recordInvocation("CreatePasswordResetToken", []interface{}{arg1, arg2})
+	fake.createPasswordResetTokenMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeUserRepository) CreatePasswordResetTokenCallCount() int {
+	fake.createPasswordResetTokenMutex.RLock()
+	defer fake.createPasswordResetTokenMutex.RUnlock()
+	return len(fake.createPasswordResetTokenArgsForCall)
+}
+
+func (fake *FakeUserRepository) CreatePasswordResetTokenCalls(stub func(context.Context, repository.PasswordResetToken) error) {
+	fake.createPasswordResetTokenMutex.Lock()
+	defer fake.createPasswordResetTokenMutex.Unlock()
+	fake.CreatePasswordResetTokenStub = stub
+}
+
+func (fake *FakeUserRepository) CreatePasswordResetTokenArgsForCall(i int) (context.Context, repository.PasswordResetToken) {
+	fake.createPasswordResetTokenMutex.RLock()
+	defer fake.createPasswordResetTokenMutex.RUnlock()
+	argsForCall := fake.createPasswordResetTokenArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeUserRepository) CreatePasswordResetTokenReturns(result1 error) {
+	fake.createPasswordResetTokenMutex.Lock()
+	defer fake.createPasswordResetTokenMutex.Unlock()
+	fake.CreatePasswordResetTokenStub = nil
+	fake.createPasswordResetTokenReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeUserRepository) CreatePasswordResetTokenReturnsOnCall(i int, result1 error) {
+	fake.createPasswordResetTokenMutex.Lock()
+	defer fake.createPasswordResetTokenMutex.Unlock()
+	fake.CreatePasswordResetTokenStub = nil
+	if fake.createPasswordResetTokenReturnsOnCall == nil {
+		fake.createPasswordResetTokenReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.createPasswordResetTokenReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeUserRepository) CreatePersonalAccessToken(arg1 context.Context, arg2 repository.PersonalAccessToken) error {
+	fake.createPersonalAccessTokenMutex.Lock()
+	ret, specificReturn := fake.createPersonalAccessTokenReturnsOnCall[len(fake.createPersonalAccessTokenArgsForCall)]
+	fake.createPersonalAccessTokenArgsForCall = append(fake.createPersonalAccessTokenArgsForCall, struct {
+		arg1 context.Context
+		arg2 repository.PersonalAccessToken
+	}{arg1, arg2})
+	stub := fake.CreatePersonalAccessTokenStub
+	fakeReturns := fake.createPersonalAccessTokenReturns
+	fake.recordInvocation("CreatePersonalAccessToken", []interface{}{arg1, arg2})
+	fake.createPersonalAccessTokenMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeUserRepository) CreatePersonalAccessTokenCallCount() int {
+	fake.createPersonalAccessTokenMutex.RLock()
+	defer fake.createPersonalAccessTokenMutex.RUnlock()
+	return len(fake.createPersonalAccessTokenArgsForCall)
+}
+
+func (fake *FakeUserRepository) CreatePersonalAccessTokenCalls(stub func(context.Context, repository.PersonalAccessToken) error) {
+	fake.createPersonalAccessTokenMutex.Lock()
+	defer fake.createPersonalAccessTokenMutex.Unlock()
+	fake.CreatePersonalAccessTokenStub = stub
+}
+
+func (fake *FakeUserRepository) CreatePersonalAccessTokenArgsForCall(i int) (context.Context, repository.PersonalAccessToken) {
+	fake.createPersonalAccessTokenMutex.RLock()
+	defer fake.createPersonalAccessTokenMutex.RUnlock()
+	argsForCall := fake.createPersonalAccessTokenArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeUserRepository) CreatePersonalAccessTokenReturns(result1 error) {
+	fake.createPersonalAccessTokenMutex.Lock()
+	defer fake.createPersonalAccessTokenMutex.Unlock()
+	fake.CreatePersonalAccessTokenStub = nil
+	fake.createPersonalAccessTokenReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeUserRepository) CreatePersonalAccessTokenReturnsOnCall(i int, result1 error) {
+	fake.createPersonalAccessTokenMutex.Lock()
+	defer fake.createPersonalAccessTokenMutex.Unlock()
+	fake.CreatePersonalAccessTokenStub = nil
+	if fake.createPersonalAccessTokenReturnsOnCall == nil {
+		fake.createPersonalAccessTokenReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.createPersonalAccessTokenReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeUserRepository) CreateSession(arg1 context.Context, arg2 repository.Session) error {
+	fake.createSessionMutex.Lock()
+	ret, specificReturn := fake.createSessionReturnsOnCall[len(fake.createSessionArgsForCall)]
+	fake.createSessionArgsForCall = append(fake.createSessionArgsForCall, struct {
+		arg1 context.Context
+		arg2 repository.Session
+	}{arg1, arg2})
+	stub := fake.CreateSessionStub
+	fakeReturns := fake.createSessionReturns
+	fake.recordInvocation("CreateSession", []interface{}{arg1, arg2})
+	fake.createSessionMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeUserRepository) CreateSessionCallCount() int {
+	fake.createSessionMutex.RLock()
+	defer fake.createSessionMutex.RUnlock()
+	return len(fake.createSessionArgsForCall)
+}
+
+func (fake *FakeUserRepository) CreateSessionCalls(stub func(context.Context, repository.Session) error) {
+	fake.createSessionMutex.Lock()
+	defer fake.createSessionMutex.Unlock()
+	fake.CreateSessionStub = stub
+}
+
+func (fake *FakeUserRepository) CreateSessionArgsForCall(i int) (context.Context, repository.Session) {
+	fake.createSessionMutex.RLock()
+	defer fake.createSessionMutex.RUnlock()
+	argsForCall := fake.createSessionArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeUserRepository) CreateSessionReturns(result1 error) {
+	fake.createSessionMutex.Lock()
+	defer fake.createSessionMutex.Unlock()
+	fake.CreateSessionStub = nil
+	fake.createSessionReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeUserRepository) CreateSessionReturnsOnCall(i int, result1 error) {
+	fake.createSessionMutex.Lock()
+	defer fake.createSessionMutex.Unlock()
+	fake.CreateSessionStub = nil
+	if fake.createSessionReturnsOnCall == nil {
+		fake.createSessionReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.createSessionReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeUserRepository) CreateUserIdentity(arg1 context.Context, arg2 repository.UserIdentity) error {
+	fake.createUserIdentityMutex.Lock()
+	ret, specificReturn := fake.createUserIdentityReturnsOnCall[len(fake.createUserIdentityArgsForCall)]
+	fake.createUserIdentityArgsForCall = append(fake.createUserIdentityArgsForCall, struct {
+		arg1 context.Context
+		arg2 repository.UserIdentity
+	}{arg1, arg2})
+	stub := fake.CreateUserIdentityStub
+	fakeReturns := fake.createUserIdentityReturns
+	fake.recordInvocation("CreateUserIdentity", []interface{}{arg1, arg2})
+	fake.createUserIdentityMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeUserRepository) CreateUserIdentityCallCount() int {
+	fake.createUserIdentityMutex.RLock()
+	defer fake.createUserIdentityMutex.RUnlock()
+	return len(fake.createUserIdentityArgsForCall)
+}
+
+func (fake *FakeUserRepository) CreateUserIdentityCalls(stub func(context.Context, repository.UserIdentity) error) {
+	fake.createUserIdentityMutex.Lock()
+	defer fake.createUserIdentityMutex.Unlock()
+	fake.CreateUserIdentityStub = stub
+}
+
+func (fake *FakeUserRepository) CreateUserIdentityArgsForCall(i int) (context.Context, repository.UserIdentity) {
+	fake.createUserIdentityMutex.RLock()
+	defer fake.createUserIdentityMutex.RUnlock()
+	argsForCall := fake.createUserIdentityArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeUserRepository) CreateUserIdentityReturns(result1 error) {
+	fake.createUserIdentityMutex.Lock()
+	defer fake.createUserIdentityMutex.Unlock()
+	fake.CreateUserIdentityStub = nil
+	fake.createUserIdentityReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeUserRepository) CreateUserIdentityReturnsOnCall(i int, result1 error) {
+	fake.createUserIdentityMutex.Lock()
+	defer fake.createUserIdentityMutex.Unlock()
+	fake.CreateUserIdentityStub = nil
+	if fake.createUserIdentityReturnsOnCall == nil {
+		fake.createUserIdentityReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.createUserIdentityReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeUserRepository) CreateUserWithIdentity(arg1 context.Context, arg2 repository.User, arg3 repository.UserIdentity) error {
+	fake.createUserWithIdentityMutex.Lock()
+	ret, specificReturn := fake.createUserWithIdentityReturnsOnCall[len(fake.createUserWithIdentityArgsForCall)]
+	fake.createUserWithIdentityArgsForCall = append(fake.createUserWithIdentityArgsForCall, struct {
+		arg1 context.Context
+		arg2 repository.User
+		arg3 repository.UserIdentity
+	}{arg1, arg2, arg3})
+	stub := fake.CreateUserWithIdentityStub
+	fakeReturns := fake.createUserWithIdentityReturns
+	fake.recordInvocation("CreateUserWithIdentity", []interface{}{arg1, arg2, arg3})
+	fake.createUserWithIdentityMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeUserRepository) CreateUserWithIdentityCallCount() int {
+	fake.createUserWithIdentityMutex.RLock()
+	defer fake.createUserWithIdentityMutex.RUnlock()
+	return len(fake.createUserWithIdentityArgsForCall)
+}
+
+func (fake *FakeUserRepository) CreateUserWithIdentityCalls(stub func(context.Context, repository.User, repository.UserIdentity) error) {
+	fake.createUserWithIdentityMutex.Lock()
+	defer fake.createUserWithIdentityMutex.Unlock()
+	fake.CreateUserWithIdentityStub = stub
+}
+
+func (fake *FakeUserRepository) CreateUserWithIdentityArgsForCall(i int) (context.Context, repository.User, repository.UserIdentity) {
+	fake.createUserWithIdentityMutex.RLock()
+	defer fake.createUserWithIdentityMutex.RUnlock()
+	argsForCall := fake.createUserWithIdentityArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeUserRepository) CreateUserWithIdentityReturns(result1 error) {
+	fake.createUserWithIdentityMutex.Lock()
+	defer fake.createUserWithIdentityMutex.Unlock()
+	fake.CreateUserWithIdentityStub = nil
+	fake.createUserWithIdentityReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeUserRepository) CreateUserWithIdentityReturnsOnCall(i int, result1 error) {
+	fake.createUserWithIdentityMutex.Lock()
+	defer fake.createUserWithIdentityMutex.Unlock()
+	fake.CreateUserWithIdentityStub = nil
+	if fake.createUserWithIdentityReturnsOnCall == nil {
+		fake.createUserWithIdentityReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.createUserWithIdentityReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeUserRepository) Delete(arg1 context.Context, arg2 uuid.UUID) error {
+	fake.deleteMutex.Lock()
+	ret, specificReturn := fake.deleteReturnsOnCall[len(fake.deleteArgsForCall)]
+	fake.deleteArgsForCall = append(fake.deleteArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}{arg1, arg2})
+	stub := fake.DeleteStub
+	fakeReturns := fake.deleteReturns
+	fake.recordInvocation("Delete", []interface{}{arg1, arg2})
+	fake.deleteMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeUserRepository) DeleteCallCount() int {
+	fake.deleteMutex.RLock()
+	defer fake.deleteMutex.RUnlock()
+	return len(fake.deleteArgsForCall)
+}
+
+func (fake *FakeUserRepository) DeleteCalls(stub func(context.Context, uuid.UUID) error) {
+	fake.deleteMutex.Lock()
+	defer fake.deleteMutex.Unlock()
+	fake.DeleteStub = stub
+}
+
+func (fake *FakeUserRepository) DeleteArgsForCall(i int) (context.Context, uuid.UUID) {
+	fake.deleteMutex.RLock()
+	defer fake.deleteMutex.RUnlock()
+	argsForCall := fake.deleteArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeUserRepository) DeleteReturns(result1 error) {
+	fake.deleteMutex.Lock()
+	defer fake.deleteMutex.Unlock()
+	fake.DeleteStub = nil
+	fake.deleteReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeUserRepository) DeleteReturnsOnCall(i int, result1 error) {
+	fake.deleteMutex.Lock()
+	defer fake.deleteMutex.Unlock()
+	fake.DeleteStub = nil
+	if fake.deleteReturnsOnCall == nil {
+		fake.deleteReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeUserRepository) DeleteExpiredOIDCLoginRequests(arg1 context.Context, arg2 time.Time) (int64, error) {
+	fake.deleteExpiredOIDCLoginRequestsMutex.Lock()
+	ret, specificReturn := fake.deleteExpiredOIDCLoginRequestsReturnsOnCall[len(fake.deleteExpiredOIDCLoginRequestsArgsForCall)]
+	fake.deleteExpiredOIDCLoginRequestsArgsForCall = append(fake.deleteExpiredOIDCLoginRequestsArgsForCall, struct {
+		arg1 context.Context
+		arg2 time.Time
+	}{arg1, arg2})
+	stub := fake.DeleteExpiredOIDCLoginRequestsStub
+	fakeReturns := fake.deleteExpiredOIDCLoginRequestsReturns
+	fake.recordInvocation("DeleteExpiredOIDCLoginRequests", []interface{}{arg1, arg2})
+	fake.deleteExpiredOIDCLoginRequestsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeUserRepository) DeleteExpiredOIDCLoginRequestsCallCount() int {
+	fake.deleteExpiredOIDCLoginRequestsMutex.RLock()
+	defer fake.deleteExpiredOIDCLoginRequestsMutex.RUnlock()
+	return len(fake.deleteExpiredOIDCLoginRequestsArgsForCall)
+}
+
+func (fake *FakeUserRepository) DeleteExpiredOIDCLoginRequestsCalls(stub func(context.Context, time.Time) (int64, error)) {
+	fake.deleteExpiredOIDCLoginRequestsMutex.Lock()
+	defer fake.deleteExpiredOIDCLoginRequestsMutex.Unlock()
+	fake.DeleteExpiredOIDCLoginRequestsStub = stub
+}
+
+func (fake *FakeUserRepository) DeleteExpiredOIDCLoginRequestsArgsForCall(i int) (context.Context, time.Time) {
+	fake.deleteExpiredOIDCLoginRequestsMutex.RLock()
+	defer fake.deleteExpiredOIDCLoginRequestsMutex.RUnlock()
+	argsForCall := fake.deleteExpiredOIDCLoginRequestsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeUserRepository) DeleteExpiredOIDCLoginRequestsReturns(result1 int64, result2 error) {
+	fake.deleteExpiredOIDCLoginRequestsMutex.Lock()
+	defer fake.deleteExpiredOIDCLoginRequestsMutex.Unlock()
+	fake.DeleteExpiredOIDCLoginRequestsStub = nil
+	fake.deleteExpiredOIDCLoginRequestsReturns = struct {
+		result1 int64
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUserRepository) DeleteExpiredOIDCLoginRequestsReturnsOnCall(i int, result1 int64, result2 error) {
+	fake.deleteExpiredOIDCLoginRequestsMutex.Lock()
+	defer fake.deleteExpiredOIDCLoginRequestsMutex.Unlock()
+	fake.DeleteExpiredOIDCLoginRequestsStub = nil
+	if fake.deleteExpiredOIDCLoginRequestsReturnsOnCall == nil {
+		fake.deleteExpiredOIDCLoginRequestsReturnsOnCall = make(map[int]struct {
+			result1 int64
+			result2 error
+		})
+	}
+	fake.deleteExpiredOIDCLoginRequestsReturnsOnCall[i] = struct {
+		result1 int64
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUserRepository) DeleteExpiredSessions(arg1 context.Context, arg2 time.Time) (int64, error) {
+	fake.deleteExpiredSessionsMutex.Lock()
+	ret, specificReturn := fake.deleteExpiredSessionsReturnsOnCall[len(fake.deleteExpiredSessionsArgsForCall)]
+	fake.deleteExpiredSessionsArgsForCall = append(fake.deleteExpiredSessionsArgsForCall, struct {
+		arg1 context.Context
+		arg2 time.Time
+	}{arg1, arg2})
+	stub := fake.DeleteExpiredSessionsStub
+	fakeReturns := fake.deleteExpiredSessionsReturns
+	fake.recordInvocation("DeleteExpiredSessions", []interface{}{arg1, arg2})
+	fake.deleteExpiredSessionsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeUserRepository) DeleteExpiredSessionsCallCount() int {
+	fake.deleteExpiredSessionsMutex.RLock()
+	defer fake.deleteExpiredSessionsMutex.RUnlock()
+	return len(fake.deleteExpiredSessionsArgsForCall)
+}
+
+func (fake *FakeUserRepository) DeleteExpiredSessionsCalls(stub func(context.Context, time.Time) (int64, error)) {
+	fake.deleteExpiredSessionsMutex.Lock()
+	defer fake.deleteExpiredSessionsMutex.Unlock()
+	fake.DeleteExpiredSessionsStub = stub
+}
+
+func (fake *FakeUserRepository) DeleteExpiredSessionsArgsForCall(i int) (context.Context, time.Time) {
+	fake.deleteExpiredSessionsMutex.RLock()
+	defer fake.deleteExpiredSessionsMutex.RUnlock()
+	argsForCall := fake.deleteExpiredSessionsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeUserRepository) DeleteExpiredSessionsReturns(result1 int64, result2 error) {
+	fake.deleteExpiredSessionsMutex.Lock()
+	defer fake.deleteExpiredSessionsMutex.Unlock()
+	fake.DeleteExpiredSessionsStub = nil
+	fake.deleteExpiredSessionsReturns = struct {
+		result1 int64
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUserRepository) DeleteExpiredSessionsReturnsOnCall(i int, result1 int64, result2 error) {
+	fake.deleteExpiredSessionsMutex.Lock()
+	defer fake.deleteExpiredSessionsMutex.Unlock()
+	fake.DeleteExpiredSessionsStub = nil
+	if fake.deleteExpiredSessionsReturnsOnCall == nil {
+		fake.deleteExpiredSessionsReturnsOnCall = make(map[int]struct {
+			result1 int64
+			result2 error
+		})
+	}
+	fake.deleteExpiredSessionsReturnsOnCall[i] = struct {
+		result1 int64
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUserRepository) DisableTwoFactor(arg1 context.Context, arg2 uuid.UUID) error {
+	fake.disableTwoFactorMutex.Lock()
+	ret, specificReturn := fake.disableTwoFactorReturnsOnCall[len(fake.disableTwoFactorArgsForCall)]
+	fake.disableTwoFactorArgsForCall = append(fake.disableTwoFactorArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}{arg1, arg2})
+	stub := fake.DisableTwoFactorStub
+	fakeReturns := fake.disableTwoFactorReturns
+	fake.recordInvocation("DisableTwoFactor", []interface{}{arg1, arg2})
+	fake.disableTwoFactorMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeUserRepository) DisableTwoFactorCallCount() int {
+	fake.disableTwoFactorMutex.RLock()
+	defer fake.disableTwoFactorMutex.RUnlock()
+	return len(fake.disableTwoFactorArgsForCall)
+}
+
+func (fake *FakeUserRepository) DisableTwoFactorCalls(stub func(context.Context, uuid.UUID) error) {
+	fake.disableTwoFactorMutex.Lock()
+	defer fake.disableTwoFactorMutex.Unlock()
+	fake.DisableTwoFactorStub = stub
+}
+
+func (fake *FakeUserRepository) DisableTwoFactorArgsForCall(i int) (context.Context, uuid.UUID) {
+	fake.disableTwoFactorMutex.RLock()
+	defer fake.disableTwoFactorMutex.RUnlock()
+	argsForCall := fake.disableTwoFactorArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeUserRepository) DisableTwoFactorReturns(result1 error) {
+	fake.disableTwoFactorMutex.Lock()
+	defer fake.disableTwoFactorMutex.Unlock()
+	fake.DisableTwoFactorStub = nil
+	fake.disableTwoFactorReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeUserRepository) DisableTwoFactorReturnsOnCall(i int, result1 error) {
+	fake.disableTwoFactorMutex.Lock()
+	defer fake.disableTwoFactorMutex.Unlock()
+	fake.DisableTwoFactorStub = nil
+	if fake.disableTwoFactorReturnsOnCall == nil {
+		fake.disableTwoFactorReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.disableTwoFactorReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeUserRepository) EnableTwoFactor(arg1 context.Context, arg2 uuid.UUID, arg3 int64, arg4 []repository.TwoFactorRecoveryCode) error {
+	var arg4Copy []repository.TwoFactorRecoveryCode
+	if arg4 != nil {
+		arg4Copy = make([]repository.TwoFactorRecoveryCode, len(arg4))
+		copy(arg4Copy, arg4)
+	}
+	fake.enableTwoFactorMutex.Lock()
+	ret, specificReturn := fake.enableTwoFactorReturnsOnCall[len(fake.enableTwoFactorArgsForCall)]
+	fake.enableTwoFactorArgsForCall = append(fake.enableTwoFactorArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 int64
+		arg4 []repository.TwoFactorRecoveryCode
+	}{arg1, arg2, arg3, arg4Copy})
+	stub := fake.EnableTwoFactorStub
+	fakeReturns := fake.enableTwoFactorReturns
+	fake.recordInvocation("EnableTwoFactor", []interface{}{arg1, arg2, arg3, arg4Copy})
+	fake.enableTwoFactorMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeUserRepository) EnableTwoFactorCallCount() int {
+	fake.enableTwoFactorMutex.RLock()
+	defer fake.enableTwoFactorMutex.RUnlock()
+	return len(fake.enableTwoFactorArgsForCall)
+}
+
+func (fake *FakeUserRepository) EnableTwoFactorCalls(stub func(context.Context, uuid.UUID, int64, []repository.TwoFactorRecoveryCode) error) {
+	fake.enableTwoFactorMutex.Lock()
+	defer fake.enableTwoFactorMutex.Unlock()
+	fake.EnableTwoFactorStub = stub
+}
+
+func (fake *FakeUserRepository) EnableTwoFactorArgsForCall(i int) (context.Context, uuid.UUID, int64, []repository.TwoFactorRecoveryCode) {
+	fake.enableTwoFactorMutex.RLock()
+	defer fake.enableTwoFactorMutex.RUnlock()
+	argsForCall := fake.enableTwoFactorArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeUserRepository) EnableTwoFactorReturns(result1 error) {
+	fake.enableTwoFactorMutex.Lock()
+	defer fake.enableTwoFactorMutex.Unlock()
+	fake.EnableTwoFactorStub = nil
+	fake.enableTwoFactorReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeUserRepository) EnableTwoFactorReturnsOnCall(i int, result1 error) {
+	fake.enableTwoFactorMutex.Lock()
+	defer fake.enableTwoFactorMutex.Unlock()
+	fake.EnableTwoFactorStub = nil
+	if fake.enableTwoFactorReturnsOnCall == nil {
+		fake.enableTwoFactorReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.enableTwoFactorReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeUserRepository) EraseDeletedUsers(arg1 context.Context, arg2 time.Time) (int64, error) {
+	fake.eraseDeletedUsersMutex.Lock()
+	ret, specificReturn := fake.eraseDeletedUsersReturnsOnCall[len(fake.eraseDeletedUsersArgsForCall)]
+	fake.eraseDeletedUsersArgsForCall = append(fake.eraseDeletedUsersArgsForCall, struct {
+		arg1 context.Context
+		arg2 time.Time
+	}{arg1, arg2})
+	stub := fake.EraseDeletedUsersStub
+	fakeReturns := fake.eraseDeletedUsersReturns
+	fake.recordInvocation("EraseDeletedUsers", []interface{}{arg1, arg2})
+	fake.eraseDeletedUsersMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeUserRepository) EraseDeletedUsersCallCount() int {
+	fake.eraseDeletedUsersMutex.RLock()
+	defer fake.eraseDeletedUsersMutex.RUnlock()
+	return len(fake.eraseDeletedUsersArgsForCall)
+}
+
+func (fake *FakeUserRepository) EraseDeletedUsersCalls(stub func(context.Context, time.Time) (int64, error)) {
+	fake.eraseDeletedUsersMutex.Lock()
+	defer fake.eraseDeletedUsersMutex.Unlock()
+	fake.EraseDeletedUsersStub = stub
+}
+
+func (fake *FakeUserRepository) EraseDeletedUsersArgsForCall(i int) (context.Context, time.Time) {
+	fake.eraseDeletedUsersMutex.RLock()
+	defer fake.eraseDeletedUsersMutex.RUnlock()
+	argsForCall := fake.eraseDeletedUsersArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeUserRepository) EraseDeletedUsersReturns(result1 int64, result2 error) {
+	fake.eraseDeletedUsersMutex.Lock()
+	defer fake.eraseDeletedUsersMutex.Unlock()
+	fake.EraseDeletedUsersStub = nil
+	fake.eraseDeletedUsersReturns = struct {
+		result1 int64
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUserRepository) EraseDeletedUsersReturnsOnCall(i int, result1 int64, result2 error) {
+	fake.eraseDeletedUsersMutex.Lock()
+	defer fake.eraseDeletedUsersMutex.Unlock()
+	fake.EraseDeletedUsersStub = nil
+	if fake.eraseDeletedUsersReturnsOnCall == nil {
+		fake.eraseDeletedUsersReturnsOnCall = make(map[int]struct {
+			result1 int64
+			result2 error
+		})
+	}
+	fake.eraseDeletedUsersReturnsOnCall[i] = struct {
+		result1 int64
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUserRepository) GetAuthorProfile(arg1 context.Context, arg2 uuid.UUID) (repository.AuthorProfile, error) {
+	fake.getAuthorProfileMutex.Lock()
+	ret, specificReturn := fake.getAuthorProfileReturnsOnCall[len(fake.getAuthorProfileArgsForCall)]
+	fake.getAuthorProfileArgsForCall = append(fake.getAuthorProfileArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}{arg1, arg2})
+	stub := fake.GetAuthorProfileStub
+	fakeReturns := fake.getAuthorProfileReturns
+	fake.recordInvocation("GetAuthorProfile", []interface{}{arg1, arg2})
+	fake.getAuthorProfileMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeUserRepository) GetAuthorProfileCallCount() int {
+	fake.getAuthorProfileMutex.RLock()
+	defer fake.getAuthorProfileMutex.RUnlock()
+	return len(fake.getAuthorProfileArgsForCall)
+}
+
+func (fake *FakeUserRepository) GetAuthorProfileCalls(stub func(context.Context, uuid.UUID) (repository.AuthorProfile, error)) {
+	fake.getAuthorProfileMutex.Lock()
+	defer fake.getAuthorProfileMutex.Unlock()
+	fake.GetAuthorProfileStub = stub
+}
+
+func (fake *FakeUserRepository) GetAuthorProfileArgsForCall(i int) (context.Context, uuid.UUID) {
+	fake.getAuthorProfileMutex.RLock()
+	defer fake.getAuthorProfileMutex.RUnlock()
+	argsForCall := fake.getAuthorProfileArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeUserRepository) GetAuthorProfileReturns(result1 repository.AuthorProfile, result2 error) {
+	fake.getAuthorProfileMutex.Lock()
+	defer fake.getAuthorProfileMutex.Unlock()
+	fake.GetAuthorProfileStub = nil
+	fake.getAuthorProfileReturns = struct {
+		result1 repository.AuthorProfile
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUserRepository) GetAuthorProfileReturnsOnCall(i int, result1 repository.AuthorProfile, result2 error) {
+	fake.getAuthorProfileMutex.Lock()
+	defer fake.getAuthorProfileMutex.Unlock()
+	fake.GetAuthorProfileStub = nil
+	if fake.getAuthorProfileReturnsOnCall == nil {
+		fake.getAuthorProfileReturnsOnCall = make(map[int]struct {
+			result1 repository.AuthorProfile
+			result2 error
+		})
+	}
+	fake.getAuthorProfileReturnsOnCall[i] = struct {
+		result1 repository.AuthorProfile
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUserRepository) GetAuthorProfileByHandle(arg1 context.Context, arg2 string) (repository.AuthorProfile, error) {
+	fake.getAuthorProfileByHandleMutex.Lock()
+	ret, specificReturn := fake.getAuthorProfileByHandleReturnsOnCall[len(fake.getAuthorProfileByHandleArgsForCall)]
+	fake.getAuthorProfileByHandleArgsForCall = append(fake.getAuthorProfileByHandleArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.GetAuthorProfileByHandleStub
+	fakeReturns := fake.getAuthorProfileByHandleReturns
+	fake.recordInvocation("GetAuthorProfileByHandle", []interface{}{arg1, arg2})
+	fake.getAuthorProfileByHandleMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeUserRepository) GetAuthorProfileByHandleCallCount() int {
+	fake.getAuthorProfileByHandleMutex.RLock()
+	defer fake.getAuthorProfileByHandleMutex.RUnlock()
+	return len(fake.getAuthorProfileByHandleArgsForCall)
+}
+
+func (fake *FakeUserRepository) GetAuthorProfileByHandleCalls(stub func(context.Context, string) (repository.AuthorProfile, error)) {
+	fake.getAuthorProfileByHandleMutex.Lock()
+	defer fake.getAuthorProfileByHandleMutex.Unlock()
+	fake.GetAuthorProfileByHandleStub = stub
+}
+
+func (fake *FakeUserRepository) GetAuthorProfileByHandleArgsForCall(i int) (context.Context, string) {
+	fake.getAuthorProfileByHandleMutex.RLock()
+	defer fake.getAuthorProfileByHandleMutex.RUnlock()
+	argsForCall := fake.getAuthorProfileByHandleArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeUserRepository) GetAuthorProfileByHandleReturns(result1 repository.AuthorProfile, result2 error) {
+	fake.getAuthorProfileByHandleMutex.Lock()
+	defer fake.getAuthorProfileByHandleMutex.Unlock()
+	fake.GetAuthorProfileByHandleStub = nil
+	fake.getAuthorProfileByHandleReturns = struct {
+		result1 repository.AuthorProfile
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUserRepository) GetAuthorProfileByHandleReturnsOnCall(i int, result1 repository.AuthorProfile, result2 error) {
+	fake.getAuthorProfileByHandleMutex.Lock()
+	defer fake.getAuthorProfileByHandleMutex.Unlock()
+	fake.GetAuthorProfileByHandleStub = nil
+	if fake.getAuthorProfileByHandleReturnsOnCall == nil {
+		fake.getAuthorProfileByHandleReturnsOnCall = make(map[int]struct {
+			result1 repository.AuthorProfile
+			result2 error
+		})
+	}
+	fake.getAuthorProfileByHandleReturnsOnCall[i] = struct {
+		result1 repository.AuthorProfile
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUserRepository) GetByEmail(arg1 context.Context, arg2 string) (repository.User, error) {
+	fake.getByEmailMutex.Lock()
+	ret, specificReturn := fake.getByEmailReturnsOnCall[len(fake.getByEmailArgsForCall)]
+	fake.getByEmailArgsForCall = append(fake.getByEmailArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.GetByEmailStub
+	fakeReturns := fake.getByEmailReturns
+	fake.recordInvocation("GetByEmail", []interface{}{arg1, arg2})
+	fake.getByEmailMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeUserRepository) GetByEmailCallCount() int {
+	fake.getByEmailMutex.RLock()
+	defer fake.getByEmailMutex.RUnlock()
+	return len(fake.getByEmailArgsForCall)
+}
+
+func (fake *FakeUserRepository) GetByEmailCalls(stub func(context.Context, string) (repository.User, error)) {
+	fake.getByEmailMutex.Lock()
+	defer fake.getByEmailMutex.Unlock()
+	fake.GetByEmailStub = stub
+}
+
+func (fake *FakeUserRepository) GetByEmailArgsForCall(i int) (context.Context, string) {
+	fake.getByEmailMutex.RLock()
+	defer fake.getByEmailMutex.RUnlock()
+	argsForCall := fake.getByEmailArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeUserRepository) GetByEmailReturns(result1 repository.User, result2 error) {
+	fake.getByEmailMutex.Lock()
+	defer fake.getByEmailMutex.Unlock()
+	fake.GetByEmailStub = nil
+	fake.getByEmailReturns = struct {
+		result1 repository.User
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUserRepository) GetByEmailReturnsOnCall(i int, result1 repository.User, result2 error) {
+	fake.getByEmailMutex.Lock()
+	defer fake.getByEmailMutex.Unlock()
+	fake.GetByEmailStub = nil
+	if fake.getByEmailReturnsOnCall == nil {
+		fake.getByEmailReturnsOnCall = make(map[int]struct {
+			result1 repository.User
+			result2 error
+		})
+	}
+	fake.getByEmailReturnsOnCall[i] = struct {
+		result1 repository.User
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUserRepository) GetByID(arg1 context.Context, arg2 uuid.UUID) (repository.User, error) {
+	fake.getByIDMutex.Lock()
+	ret, specificReturn := fake.getByIDReturnsOnCall[len(fake.getByIDArgsForCall)]
+	fake.getByIDArgsForCall = append(fake.getByIDArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}{arg1, arg2})
+	stub := fake.GetByIDStub
+	fakeReturns := fake.getByIDReturns
+	fake.recordInvocation("GetByID", []interface{}{arg1, arg2})
+	fake.getByIDMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeUserRepository) GetByIDCallCount() int {
+	fake.getByIDMutex.RLock()
+	defer fake.getByIDMutex.RUnlock()
+	return len(fake.getByIDArgsForCall)
+}
+
+func (fake *FakeUserRepository) GetByIDCalls(stub func(context.Context, uuid.UUID) (repository.User, error)) {
+	fake.getByIDMutex.Lock()
+	defer fake.getByIDMutex.Unlock()
+	fake.GetByIDStub = stub
+}
+
+func (fake *FakeUserRepository) GetByIDArgsForCall(i int) (context.Context, uuid.UUID) {
+	fake.getByIDMutex.RLock()
+	defer fake.getByIDMutex.RUnlock()
+	argsForCall := fake.getByIDArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeUserRepository) GetByIDReturns(result1 repository.User, result2 error) {
+	fake.getByIDMutex.Lock()
+	defer fake.getByIDMutex.Unlock()
+	fake.GetByIDStub = nil
+	fake.getByIDReturns = struct {
+		result1 repository.User
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUserRepository) GetByIDReturnsOnCall(i int, result1 repository.User, result2 error) {
+	fake.getByIDMutex.Lock()
+	defer fake.getByIDMutex.Unlock()
+	fake.GetByIDStub = nil
+	if fake.getByIDReturnsOnCall == nil {
+		fake.getByIDReturnsOnCall = make(map[int]struct {
+			result1 repository.User
+			result2 error
+		})
+	}
+	fake.getByIDReturnsOnCall[i] = struct {
+		result1 repository.User
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUserRepository) GetEmailVerificationTokenByHash(arg1 context.Context, arg2 string) (repository.EmailVerificationToken, error) {
+	fake.getEmailVerificationTokenByHashMutex.Lock()
+	ret, specificReturn := fake.getEmailVerificationTokenByHashReturnsOnCall[len(fake.getEmailVerificationTokenByHashArgsForCall)]
+	fake.getEmailVerificationTokenByHashArgsForCall = append(fake.getEmailVerificationTokenByHashArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.GetEmailVerificationTokenByHashStub
+	fakeReturns := fake.getEmailVerificationTokenByHashReturns
+	fake.recordInvocation("GetEmailVerificationTokenByHash", []interface{}{arg1, arg2})
+	fake.getEmailVerificationTokenByHashMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeUserRepository) GetEmailVerificationTokenByHashCallCount() int {
+	fake.getEmailVerificationTokenByHashMutex.RLock()
+	defer fake.getEmailVerificationTokenByHashMutex.RUnlock()
+	return len(fake.getEmailVerificationTokenByHashArgsForCall)
+}
+
+func (fake *FakeUserRepository) GetEmailVerificationTokenByHashCalls(stub func(context.Context, string) (repository.EmailVerificationToken, error)) {
+	fake.getEmailVerificationTokenByHashMutex.Lock()
+	defer fake.getEmailVerificationTokenByHashMutex.Unlock()
+	fake.GetEmailVerificationTokenByHashStub = stub
+}
+
+func (fake *FakeUserRepository) GetEmailVerificationTokenByHashArgsForCall(i int) (context.Context, string) {
+	fake.getEmailVerificationTokenByHashMutex.RLock()
+	defer fake.getEmailVerificationTokenByHashMutex.RUnlock()
+	argsForCall := fake.getEmailVerificationTokenByHashArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeUserRepository) GetEmailVerificationTokenByHashReturns(result1 repository.EmailVerificationToken, result2 error) {
+	fake.getEmailVerificationTokenByHashMutex.Lock()
+	defer fake.getEmailVerificationTokenByHashMutex.Unlock()
+	fake.GetEmailVerificationTokenByHashStub = nil
+	fake.getEmailVerificationTokenByHashReturns = struct {
+		result1 repository.EmailVerificationToken
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUserRepository) GetEmailVerificationTokenByHashReturnsOnCall(i int, result1 repository.EmailVerificationToken, result2 error) {
+	fake.getEmailVerificationTokenByHashMutex.Lock()
+	defer fake.getEmailVerificationTokenByHashMutex.Unlock()
+	fake.GetEmailVerificationTokenByHashStub = nil
+	if fake.getEmailVerificationTokenByHashReturnsOnCall == nil {
+		fake.getEmailVerificationTokenByHashReturnsOnCall = make(map[int]struct {
+			result1 repository.EmailVerificationToken
+			result2 error
+		})
+	}
+	fake.getEmailVerificationTokenByHashReturnsOnCall[i] = struct {
+		result1 repository.EmailVerificationToken
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUserRepository) GetPasswordResetTokenByHash(arg1 context.Context, arg2 string) (repository.PasswordResetToken, error) {
+	fake.getPasswordResetTokenByHashMutex.Lock()
+	ret, specificReturn := fake.getPasswordResetTokenByHashReturnsOnCall[len(fake.getPasswordResetTokenByHashArgsForCall)]
+	fake.getPasswordResetTokenByHashArgsForCall = append(fake.getPasswordResetTokenByHashArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.GetPasswordResetTokenByHashStub
+	fakeReturns := fake.getPasswordResetTokenByHashReturns
+	fake.recordInvocation("GetPasswordResetTokenByHash", []interface{}{arg1, arg2})
+	fake.getPasswordResetTokenByHashMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeUserRepository) GetPasswordResetTokenByHashCallCount() int {
+	fake.getPasswordResetTokenByHashMutex.RLock()
+	defer fake.getPasswordResetTokenByHashMutex.RUnlock()
+	return len(fake.getPasswordResetTokenByHashArgsForCall)
+}
+
+func (fake *FakeUserRepository) GetPasswordResetTokenByHashCalls(stub func(context.Context, string) (repository.PasswordResetToken, error)) {
+	fake.getPasswordResetTokenByHashMutex.Lock()
+	defer fake.getPasswordResetTokenByHashMutex.Unlock()
+	fake.GetPasswordResetTokenByHashStub = stub
+}
+
+func (fake *FakeUserRepository) GetPasswordResetTokenByHashArgsForCall(i int) (context.Context, string) {
+	fake.getPasswordResetTokenByHashMutex.RLock()
+	defer fake.getPasswordResetTokenByHashMutex.RUnlock()
+	argsForCall := fake.getPasswordResetTokenByHashArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeUserRepository) GetPasswordResetTokenByHashReturns(result1 repository.PasswordResetToken, result2 error) {
+	fake.getPasswordResetTokenByHashMutex.Lock()
+	defer fake.getPasswordResetTokenByHashMutex.Unlock()
+	fake.GetPasswordResetTokenByHashStub = nil
+	fake.getPasswordResetTokenByHashReturns = struct {
+		result1 repository.PasswordResetToken
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUserRepository) GetPasswordResetTokenByHashReturnsOnCall(i int, result1 repository.PasswordResetToken, result2 error) {
+	fake.getPasswordResetTokenByHashMutex.Lock()
+	defer fake.getPasswordResetTokenByHashMutex.Unlock()
+	fake.GetPasswordResetTokenByHashStub = nil
+	if fake.getPasswordResetTokenByHashReturnsOnCall == nil {
+		fake.getPasswordResetTokenByHashReturnsOnCall = make(map[int]struct {
+			result1 repository.PasswordResetToken
+			result2 error
+		})
+	}
+	fake.getPasswordResetTokenByHashReturnsOnCall[i] = struct {
+		result1 repository.PasswordResetToken
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUserRepository) GetPersonalAccessTokenByHash(arg1 context.Context, arg2 string) (repository.PersonalAccessToken, error) {
+	fake.getPersonalAccessTokenByHashMutex.Lock()
+	ret, specificReturn := fake.getPersonalAccessTokenByHashReturnsOnCall[len(fake.getPersonalAccessTokenByHashArgsForCall)]
+	fake.getPersonalAccessTokenByHashArgsForCall = append(fake.getPersonalAccessTokenByHashArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.GetPersonalAccessTokenByHashStub
+	fakeReturns := fake.getPersonalAccessTokenByHashReturns
+	fake.recordInvocation("GetPersonalAccessTokenByHash", []interface{}{arg1, arg2})
+	fake.getPersonalAccessTokenByHashMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeUserRepository) GetPersonalAccessTokenByHashCallCount() int {
+	fake.getPersonalAccessTokenByHashMutex.RLock()
+	defer fake.getPersonalAccessTokenByHashMutex.RUnlock()
+	return len(fake.getPersonalAccessTokenByHashArgsForCall)
+}
+
+func (fake *FakeUserRepository) GetPersonalAccessTokenByHashCalls(stub func(context.Context, string) (repository.PersonalAccessToken, error)) {
+	fake.getPersonalAccessTokenByHashMutex.Lock()
+	defer fake.getPersonalAccessTokenByHashMutex.Unlock()
+	fake.GetPersonalAccessTokenByHashStub = stub
+}
+
+func (fake *FakeUserRepository) GetPersonalAccessTokenByHashArgsForCall(i int) (context.Context, string) {
+	fake.getPersonalAccessTokenByHashMutex.RLock()
+	defer fake.getPersonalAccessTokenByHashMutex.RUnlock()
+	argsForCall := fake.getPersonalAccessTokenByHashArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeUserRepository) GetPersonalAccessTokenByHashReturns(result1 repository.PersonalAccessToken, result2 error) {
+	fake.getPersonalAccessTokenByHashMutex.Lock()
+	defer fake.getPersonalAccessTokenByHashMutex.Unlock()
+	fake.GetPersonalAccessTokenByHashStub = nil
+	fake.getPersonalAccessTokenByHashReturns = struct {
+		result1 repository.PersonalAccessToken
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUserRepository) GetPersonalAccessTokenByHashReturnsOnCall(i int, result1 repository.PersonalAccessToken, result2 error) {
+	fake.getPersonalAccessTokenByHashMutex.Lock()
+	defer fake.getPersonalAccessTokenByHashMutex.Unlock()
+	fake.GetPersonalAccessTokenByHashStub = nil
+	if fake.getPersonalAccessTokenByHashReturnsOnCall == nil {
+		fake.getPersonalAccessTokenByHashReturnsOnCall = make(map[int]struct {
+			result1 repository.PersonalAccessToken
+			result2 error
+		})
+	}
+	fake.getPersonalAccessTokenByHashReturnsOnCall[i] = struct {
+		result1 repository.PersonalAccessToken
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUserRepository) GetPreferences(arg1 context.Context, arg2 uuid.UUID) (repository.UserPreferences, error) {
+	fake.getPreferencesMutex.Lock()
+	ret, specificReturn := fake.getPreferencesReturnsOnCall[len(fake.getPreferencesArgsForCall)]
+	fake.getPreferencesArgsForCall = append(fake.getPreferencesArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}{arg1, arg2})
+	stub := fake.GetPreferencesStub
+	fakeReturns := fake.getPreferencesReturns
+	fake.recordInvocation("GetPreferences", []interface{}{arg1, arg2})
+	fake.getPreferencesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeUserRepository) GetPreferencesCallCount() int {
+	fake.getPreferencesMutex.RLock()
+	defer fake.getPreferencesMutex.RUnlock()
+	return len(fake.getPreferencesArgsForCall)
+}
+
+func (fake *FakeUserRepository) GetPreferencesCalls(stub func(context.Context, uuid.UUID) (repository.UserPreferences, error)) {
+	fake.getPreferencesMutex.Lock()
+	defer fake.getPreferencesMutex.Unlock()
+	fake.GetPreferencesStub = stub
+}
+
+func (fake *FakeUserRepository) GetPreferencesArgsForCall(i int) (context.Context, uuid.UUID) {
+	fake.getPreferencesMutex.RLock()
+	defer fake.getPreferencesMutex.RUnlock()
+	argsForCall := fake.getPreferencesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeUserRepository) GetPreferencesReturns(result1 repository.UserPreferences, result2 error) {
+	fake.getPreferencesMutex.Lock()
+	defer fake.getPreferencesMutex.Unlock()
+	fake.GetPreferencesStub = nil
+	fake.getPreferencesReturns = struct {
+		result1 repository.UserPreferences
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUserRepository) GetPreferencesReturnsOnCall(i int, result1 repository.UserPreferences, result2 error) {
+	fake.getPreferencesMutex.Lock()
+	defer fake.getPreferencesMutex.Unlock()
+	fake.GetPreferencesStub = nil
+	if fake.getPreferencesReturnsOnCall == nil {
+		fake.getPreferencesReturnsOnCall = make(map[int]struct {
+			result1 repository.UserPreferences
+			result2 error
+		})
+	}
+	fake.getPreferencesReturnsOnCall[i] = struct {
+		result1 repository.UserPreferences
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUserRepository) GetSessionByRefreshTokenHash(arg1 context.Context, arg2 string) (repository.Session, error) {
+	fake.getSessionByRefreshTokenHashMutex.Lock()
+	ret, specificReturn := fake.getSessionByRefreshTokenHashReturnsOnCall[len(fake.getSessionByRefreshTokenHashArgsForCall)]
+	fake.getSessionByRefreshTokenHashArgsForCall = append(fake.getSessionByRefreshTokenHashArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.GetSessionByRefreshTokenHashStub
+	fakeReturns := fake.getSessionByRefreshTokenHashReturns
+	fake.recordInvocation("GetSessionByRefreshTokenHash", []interface{}{arg1, arg2})
+	fake.getSessionByRefreshTokenHashMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeUserRepository) GetSessionByRefreshTokenHashCallCount() int {
+	fake.getSessionByRefreshTokenHashMutex.RLock()
+	defer fake.getSessionByRefreshTokenHashMutex.RUnlock()
+	return len(fake.getSessionByRefreshTokenHashArgsForCall)
+}
+
+func (fake *FakeUserRepository) GetSessionByRefreshTokenHashCalls(stub func(context.Context, string) (repository.Session, error)) {
+	fake.getSessionByRefreshTokenHashMutex.Lock()
+	defer fake.getSessionByRefreshTokenHashMutex.Unlock()
+	fake.GetSessionByRefreshTokenHashStub = stub
+}
+
+func (fake *FakeUserRepository) GetSessionByRefreshTokenHashArgsForCall(i int) (context.Context, string) {
+	fake.getSessionByRefreshTokenHashMutex.RLock()
+	defer fake.getSessionByRefreshTokenHashMutex.RUnlock()
+	argsForCall := fake.getSessionByRefreshTokenHashArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeUserRepository) GetSessionByRefreshTokenHashReturns(result1 repository.Session, result2 error) {
+	fake.getSessionByRefreshTokenHashMutex.Lock()
+	defer fake.getSessionByRefreshTokenHashMutex.Unlock()
+	fake.GetSessionByRefreshTokenHashStub = nil
+	fake.getSessionByRefreshTokenHashReturns = struct {
+		result1 repository.Session
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUserRepository) GetSessionByRefreshTokenHashReturnsOnCall(i int, result1 repository.Session, result2 error) {
+	fake.getSessionByRefreshTokenHashMutex.Lock()
+	defer fake.getSessionByRefreshTokenHashMutex.Unlock()
+	fake.GetSessionByRefreshTokenHashStub = nil
+	if fake.getSessionByRefreshTokenHashReturnsOnCall == nil {
+		fake.getSessionByRefreshTokenHashReturnsOnCall = make(map[int]struct {
+			result1 repository.Session
+			result2 error
+		})
+	}
+	fake.getSessionByRefreshTokenHashReturnsOnCall[i] = struct {
+		result1 repository.Session
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUserRepository) GetTwoFactorCredential(arg1 context.Context, arg2 uuid.UUID) (repository.TwoFactorCredential, error) {
+	fake.getTwoFactorCredentialMutex.Lock()
+	ret, specificReturn := fake.getTwoFactorCredentialReturnsOnCall[len(fake.getTwoFactorCredentialArgsForCall)]
+	fake.getTwoFactorCredentialArgsForCall = append(fake.getTwoFactorCredentialArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}{arg1, arg2})
+	stub := fake.GetTwoFactorCredentialStub
+	fakeReturns := fake.getTwoFactorCredentialReturns
+	fake.recordInvocation("GetTwoFactorCredential", []interface{}{arg1, arg2})
+	fake.getTwoFactorCredentialMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeUserRepository) GetTwoFactorCredentialCallCount() int {
+	fake.getTwoFactorCredentialMutex.RLock()
+	defer fake.getTwoFactorCredentialMutex.RUnlock()
+	return len(fake.getTwoFactorCredentialArgsForCall)
+}
+
+func (fake *FakeUserRepository) GetTwoFactorCredentialCalls(stub func(context.Context, uuid.UUID) (repository.TwoFactorCredential, error)) {
+	fake.getTwoFactorCredentialMutex.Lock()
+	defer fake.getTwoFactorCredentialMutex.Unlock()
+	fake.GetTwoFactorCredentialStub = stub
+}
+
+func (fake *FakeUserRepository) GetTwoFactorCredentialArgsForCall(i int) (context.Context, uuid.UUID) {
+	fake.getTwoFactorCredentialMutex.RLock()
+	defer fake.getTwoFactorCredentialMutex.RUnlock()
+	argsForCall := fake.getTwoFactorCredentialArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeUserRepository) GetTwoFactorCredentialReturns(result1 repository.TwoFactorCredential, result2 error) {
+	fake.getTwoFactorCredentialMutex.Lock()
+	defer fake.getTwoFactorCredentialMutex.Unlock()
+	fake.GetTwoFactorCredentialStub = nil
+	fake.getTwoFactorCredentialReturns = struct {
+		result1 repository.TwoFactorCredential
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUserRepository) GetTwoFactorCredentialReturnsOnCall(i int, result1 repository.TwoFactorCredential, result2 error) {
+	fake.getTwoFactorCredentialMutex.Lock()
+	defer fake.getTwoFactorCredentialMutex.Unlock()
+	fake.GetTwoFactorCredentialStub = nil
+	if fake.getTwoFactorCredentialReturnsOnCall == nil {
+		fake.getTwoFactorCredentialReturnsOnCall = make(map[int]struct {
+			result1 repository.TwoFactorCredential
+			result2 error
+		})
+	}
+	fake.getTwoFactorCredentialReturnsOnCall[i] = struct {
+		result1 repository.TwoFactorCredential
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUserRepository) GetUserIdentity(arg1 context.Context, arg2 string, arg3 string) (repository.UserIdentity, error) {
+	fake.getUserIdentityMutex.Lock()
+	ret, specificReturn := fake.getUserIdentityReturnsOnCall[len(fake.getUserIdentityArgsForCall)]
+	fake.getUserIdentityArgsForCall = append(fake.getUserIdentityArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.GetUserIdentityStub
+	fakeReturns := fake.getUserIdentityReturns
+	fake.recordInvocation("GetUserIdentity", []interface{}{arg1, arg2, arg3})
+	fake.getUserIdentityMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeUserRepository) GetUserIdentityCallCount() int {
+	fake.getUserIdentityMutex.RLock()
+	defer fake.getUserIdentityMutex.RUnlock()
+	return len(fake.getUserIdentityArgsForCall)
+}
+
+func (fake *FakeUserRepository) GetUserIdentityCalls(stub func(context.Context, string, string) (repository.UserIdentity, error)) {
+	fake.getUserIdentityMutex.Lock()
+	defer fake.getUserIdentityMutex.Unlock()
+	fake.GetUserIdentityStub = stub
+}
+
+func (fake *FakeUserRepository) GetUserIdentityArgsForCall(i int) (context.Context, string, string) {
+	fake.getUserIdentityMutex.RLock()
+	defer fake.getUserIdentityMutex.RUnlock()
+	argsForCall := fake.getUserIdentityArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeUserRepository) GetUserIdentityReturns(result1 repository.UserIdentity, result2 error) {
+	fake.getUserIdentityMutex.Lock()
+	defer fake.getUserIdentityMutex.Unlock()
+	fake.GetUserIdentityStub = nil
+	fake.getUserIdentityReturns = struct {
+		result1 repository.UserIdentity
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUserRepository) GetUserIdentityReturnsOnCall(i int, result1 repository.UserIdentity, result2 error) {
+	fake.getUserIdentityMutex.Lock()
+	defer fake.getUserIdentityMutex.Unlock()
+	fake.GetUserIdentityStub = nil
+	if fake.getUserIdentityReturnsOnCall == nil {
+		fake.getUserIdentityReturnsOnCall = make(map[int]struct {
+			result1 repository.UserIdentity
+			result2 error
+		})
+	}
+	fake.getUserIdentityReturnsOnCall[i] = struct {
+		result1 repository.UserIdentity
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUserRepository) List(arg1 context.Context, arg2 repository.ListFilter, arg3 int, arg4 int) ([]repository.User, error) {
+	fake.listMutex.Lock()
+	ret, specificReturn := fake.listReturnsOnCall[len(fake.listArgsForCall)]
+	fake.listArgsForCall = append(fake.listArgsForCall, struct {
+		arg1 context.Context
+		arg2 repository.ListFilter
+		arg3 int
+		arg4 int
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.ListStub
+	fakeReturns := fake.listReturns
+	fake.recordInvocation("List", []interface{}{arg1, arg2, arg3, arg4})
+	fake.listMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeUserRepository) ListCallCount() int {
+	fake.listMutex.RLock()
+	defer fake.listMutex.RUnlock()
+	return len(fake.listArgsForCall)
+}
+
+func (fake *FakeUserRepository) ListCalls(stub func(context.Context, repository.ListFilter, int, int) ([]repository.User, error)) {
+	fake.listMutex.Lock()
+	defer fake.listMutex.Unlock()
+	fake.ListStub = stub
+}
+
+func (fake *FakeUserRepository) ListArgsForCall(i int) (context.Context, repository.ListFilter, int, int) {
+	fake.listMutex.RLock()
+	defer fake.listMutex.RUnlock()
+	argsForCall := fake.listArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeUserRepository) ListReturns(result1 []repository.User, result2 error) {
+	fake.listMutex.Lock()
+	defer fake.listMutex.Unlock()
+	fake.ListStub = nil
+	fake.listReturns = struct {
+		result1 []repository.User
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUserRepository) ListReturnsOnCall(i int, result1 []repository.User, result2 error) {
+	fake.listMutex.Lock()
+	defer fake.listMutex.Unlock()
+	fake.ListStub = nil
+	if fake.listReturnsOnCall == nil {
+		fake.listReturnsOnCall = make(map[int]struct {
+			result1 []repository.User
+			result2 error
+		})
+	}
+	fake.listReturnsOnCall[i] = struct {
+		result1 []repository.User
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUserRepository) ListActiveSessionsByUserID(arg1 context.Context, arg2 uuid.UUID) ([]repository.Session, error) {
+	fake.listActiveSessionsByUserIDMutex.Lock()
+	ret, specificReturn := fake.listActiveSessionsByUserIDReturnsOnCall[len(fake.listActiveSessionsByUserIDArgsForCall)]
+	fake.listActiveSessionsByUserIDArgsForCall = append(fake.listActiveSessionsByUserIDArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}{arg1, arg2})
+	stub := fake.ListActiveSessionsByUserIDStub
+	fakeReturns := fake.listActiveSessionsByUserIDReturns
+	fake.recordInvocation("ListActiveSessionsByUserID", []interface{}{arg1, arg2})
+	fake.listActiveSessionsByUserIDMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeUserRepository) ListActiveSessionsByUserIDCallCount() int {
+	fake.listActiveSessionsByUserIDMutex.RLock()
+	defer fake.listActiveSessionsByUserIDMutex.RUnlock()
+	return len(fake.listActiveSessionsByUserIDArgsForCall)
+}
+
+func (fake *FakeUserRepository) ListActiveSessionsByUserIDCalls(stub func(context.Context, uuid.UUID) ([]repository.Session, error)) {
+	fake.listActiveSessionsByUserIDMutex.Lock()
+	defer fake.listActiveSessionsByUserIDMutex.Unlock()
+	fake.ListActiveSessionsByUserIDStub = stub
+}
+
+func (fake *FakeUserRepository) ListActiveSessionsByUserIDArgsForCall(i int) (context.Context, uuid.UUID) {
+	fake.listActiveSessionsByUserIDMutex.RLock()
+	defer fake.listActiveSessionsByUserIDMutex.RUnlock()
+	argsForCall := fake.listActiveSessionsByUserIDArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeUserRepository) ListActiveSessionsByUserIDReturns(result1 []repository.Session, result2 error) {
+	fake.listActiveSessionsByUserIDMutex.Lock()
+	defer fake.listActiveSessionsByUserIDMutex.Unlock()
+	fake.ListActiveSessionsByUserIDStub = nil
+	fake.listActiveSessionsByUserIDReturns = struct {
+		result1 []repository.Session
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUserRepository) ListActiveSessionsByUserIDReturnsOnCall(i int, result1 []repository.Session, result2 error) {
+	fake.listActiveSessionsByUserIDMutex.Lock()
+	defer fake.listActiveSessionsByUserIDMutex.Unlock()
+	fake.ListActiveSessionsByUserIDStub = nil
+	if fake.listActiveSessionsByUserIDReturnsOnCall == nil {
+		fake.listActiveSessionsByUserIDReturnsOnCall = make(map[int]struct {
+			result1 []repository.Session
+			result2 error
+		})
+	}
+	fake.listActiveSessionsByUserIDReturnsOnCall[i] = struct {
+		result1 []repository.Session
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUserRepository) ListPersonalAccessTokensByUserID(arg1 context.Context, arg2 uuid.UUID) ([]repository.PersonalAccessToken, error) {
+	fake.listPersonalAccessTokensByUserIDMutex.Lock()
+	ret, specificReturn := fake.listPersonalAccessTokensByUserIDReturnsOnCall[len(fake.listPersonalAccessTokensByUserIDArgsForCall)]
+	fake.listPersonalAccessTokensByUserIDArgsForCall = append(fake.listPersonalAccessTokensByUserIDArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}{arg1, arg2})
+	stub := fake.ListPersonalAccessTokensByUserIDStub
+	fakeReturns := fake.listPersonalAccessTokensByUserIDReturns
+	fake.recordInvocation("ListPersonalAccessTokensByUserID", []interface{}{arg1, arg2})
+	fake.listPersonalAccessTokensByUserIDMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeUserRepository) ListPersonalAccessTokensByUserIDCallCount() int {
+	fake.listPersonalAccessTokensByUserIDMutex.RLock()
+	defer fake.listPersonalAccessTokensByUserIDMutex.RUnlock()
+	return len(fake.listPersonalAccessTokensByUserIDArgsForCall)
+}
+
+func (fake *FakeUserRepository) ListPersonalAccessTokensByUserIDCalls(stub func(context.Context, uuid.UUID) ([]repository.PersonalAccessToken, error)) {
+	fake.listPersonalAccessTokensByUserIDMutex.Lock()
+	defer fake.listPersonalAccessTokensByUserIDMutex.Unlock()
+	fake.ListPersonalAccessTokensByUserIDStub = stub
+}
+
+func (fake *FakeUserRepository) ListPersonalAccessTokensByUserIDArgsForCall(i int) (context.Context, uuid.UUID) {
+	fake.listPersonalAccessTokensByUserIDMutex.RLock()
+	defer fake.listPersonalAccessTokensByUserIDMutex.RUnlock()
+	argsForCall := fake.listPersonalAccessTokensByUserIDArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeUserRepository) ListPersonalAccessTokensByUserIDReturns(result1 []repository.PersonalAccessToken, result2 error) {
+	fake.listPersonalAccessTokensByUserIDMutex.Lock()
+	defer fake.listPersonalAccessTokensByUserIDMutex.Unlock()
+	fake.ListPersonalAccessTokensByUserIDStub = nil
+	fake.listPersonalAccessTokensByUserIDReturns = struct {
+		result1 []repository.PersonalAccessToken
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUserRepository) ListPersonalAccessTokensByUserIDReturnsOnCall(i int, result1 []repository.PersonalAccessToken, result2 error) {
+	fake.listPersonalAccessTokensByUserIDMutex.Lock()
+	defer fake.listPersonalAccessTokensByUserIDMutex.Unlock()
+	fake.ListPersonalAccessTokensByUserIDStub = nil
+	if fake.listPersonalAccessTokensByUserIDReturnsOnCall == nil {
+		fake.listPersonalAccessTokensByUserIDReturnsOnCall = make(map[int]struct {
+			result1 []repository.PersonalAccessToken
+			result2 error
+		})
+	}
+	fake.listPersonalAccessTokensByUserIDReturnsOnCall[i] = struct {
+		result1 []repository.PersonalAccessToken
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUserRepository) ResetPassword(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID, arg4 string) error {
+	fake.resetPasswordMutex.Lock()
+	ret, specificReturn := fake.resetPasswordReturnsOnCall[len(fake.resetPasswordArgsForCall)]
+	fake.resetPasswordArgsForCall = append(fake.resetPasswordArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+		arg4 string
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.ResetPasswordStub
+	fakeReturns := fake.resetPasswordReturns
+	fake.recordInvocation("ResetPassword", []interface{}{arg1, arg2, arg3, arg4})
+	fake.resetPasswordMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeUserRepository) ResetPasswordCallCount() int {
+	fake.resetPasswordMutex.RLock()
+	defer fake.resetPasswordMutex.RUnlock()
+	return len(fake.resetPasswordArgsForCall)
+}
+
+func (fake *FakeUserRepository) ResetPasswordCalls(stub func(context.Context, uuid.UUID, uuid.UUID, string) error) {
+	fake.resetPasswordMutex.Lock()
+	defer fake.resetPasswordMutex.Unlock()
+	fake.ResetPasswordStub = stub
+}
+
+func (fake *FakeUserRepository) ResetPasswordArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID, string) {
+	fake.resetPasswordMutex.RLock()
+	defer fake.resetPasswordMutex.RUnlock()
+	argsForCall := fake.resetPasswordArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeUserRepository) ResetPasswordReturns(result1 error) {
+	fake.resetPasswordMutex.Lock()
+	defer fake.resetPasswordMutex.Unlock()
+	fake.ResetPasswordStub = nil
+	fake.resetPasswordReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeUserRepository) ResetPasswordReturnsOnCall(i int, result1 error) {
+	fake.resetPasswordMutex.Lock()
+	defer fake.resetPasswordMutex.Unlock()
+	fake.ResetPasswordStub = nil
+	if fake.resetPasswordReturnsOnCall == nil {
+		fake.resetPasswordReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.resetPasswordReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeUserRepository) Restore(arg1 context.Context, arg2 uuid.UUID, arg3 time.Time) error {
+	fake.restoreMutex.Lock()
+	ret, specificReturn := fake.restoreReturnsOnCall[len(fake.restoreArgsForCall)]
+	fake.restoreArgsForCall = append(fake.restoreArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 time.Time
+	}{arg1, arg2, arg3})
+	stub := fake.RestoreStub
+	fakeReturns := fake.restoreReturns
+	fake.recordInvocation("Restore", []interface{}{arg1, arg2, arg3})
+	fake.restoreMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeUserRepository) RestoreCallCount() int {
+	fake.restoreMutex.RLock()
+	defer fake.restoreMutex.RUnlock()
+	return len(fake.restoreArgsForCall)
+}
+
+func (fake *FakeUserRepository) RestoreCalls(stub func(context.Context, uuid.UUID, time.Time) error) {
+	fake.restoreMutex.Lock()
+	defer fake.restoreMutex.Unlock()
+	fake.RestoreStub = stub
+}
+
+func (fake *FakeUserRepository) RestoreArgsForCall(i int) (context.Context, uuid.UUID, time.Time) {
+	fake.restoreMutex.RLock()
+	defer fake.restoreMutex.RUnlock()
+	argsForCall := fake.restoreArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeUserRepository) RestoreReturns(result1 error) {
+	fake.restoreMutex.Lock()
+	defer fake.restoreMutex.Unlock()
+	fake.RestoreStub = nil
+	fake.restoreReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeUserRepository) RestoreReturnsOnCall(i int, result1 error) {
+	fake.restoreMutex.Lock()
+	defer fake.restoreMutex.Unlock()
+	fake.RestoreStub = nil
+	if fake.restoreReturnsOnCall == nil {
+		fake.restoreReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.restoreReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeUserRepository) RevokePersonalAccessToken(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID) error {
+	fake.revokePersonalAccessTokenMutex.Lock()
+	ret, specificReturn := fake.revokePersonalAccessTokenReturnsOnCall[len(fake.revokePersonalAccessTokenArgsForCall)]
+	fake.revokePersonalAccessTokenArgsForCall = append(fake.revokePersonalAccessTokenArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+	}{arg1, arg2, arg3})
+	stub := fake.RevokePersonalAccessTokenStub
+	fakeReturns := fake.revokePersonalAccessTokenReturns
+	fake.recordInvocation("RevokePersonalAccessToken", []interface{}{arg1, arg2, arg3})
+	fake.revokePersonalAccessTokenMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeUserRepository) RevokePersonalAccessTokenCallCount() int {
+	fake.revokePersonalAccessTokenMutex.RLock()
+	defer fake.revokePersonalAccessTokenMutex.RUnlock()
+	return len(fake.revokePersonalAccessTokenArgsForCall)
+}
+
+func (fake *FakeUserRepository) RevokePersonalAccessTokenCalls(stub func(context.Context, uuid.UUID, uuid.UUID) error) {
+	fake.revokePersonalAccessTokenMutex.Lock()
+	defer fake.revokePersonalAccessTokenMutex.Unlock()
+	fake.RevokePersonalAccessTokenStub = stub
+}
+
+func (fake *FakeUserRepository) RevokePersonalAccessTokenArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID) {
+	fake.revokePersonalAccessTokenMutex.RLock()
+	defer fake.revokePersonalAccessTokenMutex.RUnlock()
+	argsForCall := fake.revokePersonalAccessTokenArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeUserRepository) RevokePersonalAccessTokenReturns(result1 error) {
+	fake.revokePersonalAccessTokenMutex.Lock()
+	defer fake.revokePersonalAccessTokenMutex.Unlock()
+	fake.RevokePersonalAccessTokenStub = nil
+	fake.revokePersonalAccessTokenReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeUserRepository) RevokePersonalAccessTokenReturnsOnCall(i int, result1 error) {
+	fake.revokePersonalAccessTokenMutex.Lock()
+	defer fake.revokePersonalAccessTokenMutex.Unlock()
+	fake.RevokePersonalAccessTokenStub = nil
+	if fake.revokePersonalAccessTokenReturnsOnCall == nil {
+		fake.revokePersonalAccessTokenReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.revokePersonalAccessTokenReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeUserRepository) RevokeSessionFamily(arg1 context.Context, arg2 uuid.UUID) error {
+	fake.revokeSessionFamilyMutex.Lock()
+	ret, specificReturn := fake.revokeSessionFamilyReturnsOnCall[len(fake.revokeSessionFamilyArgsForCall)]
+	fake.revokeSessionFamilyArgsForCall = append(fake.revokeSessionFamilyArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}{arg1, arg2})
+	stub := fake.RevokeSessionFamilyStub
+	fakeReturns := fake.revokeSessionFamilyReturns
+	fake.recordInvocation("RevokeSessionFamily", []interface{}{arg1, arg2})
+	fake.revokeSessionFamilyMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeUserRepository) RevokeSessionFamilyCallCount() int {
+	fake.revokeSessionFamilyMutex.RLock()
+	defer fake.revokeSessionFamilyMutex.RUnlock()
+	return len(fake.revokeSessionFamilyArgsForCall)
+}
+
+func (fake *FakeUserRepository) RevokeSessionFamilyCalls(stub func(context.Context, uuid.UUID) error) {
+	fake.revokeSessionFamilyMutex.Lock()
+	defer fake.revokeSessionFamilyMutex.Unlock()
+	fake.RevokeSessionFamilyStub = stub
+}
+
+func (fake *FakeUserRepository) RevokeSessionFamilyArgsForCall(i int) (context.Context, uuid.UUID) {
+	fake.revokeSessionFamilyMutex.RLock()
+	defer fake.revokeSessionFamilyMutex.RUnlock()
+	argsForCall := fake.revokeSessionFamilyArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeUserRepository) RevokeSessionFamilyReturns(result1 error) {
+	fake.revokeSessionFamilyMutex.Lock()
+	defer fake.revokeSessionFamilyMutex.Unlock()
+	fake.RevokeSessionFamilyStub = nil
+	fake.revokeSessionFamilyReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeUserRepository) RevokeSessionFamilyReturnsOnCall(i int, result1 error) {
+	fake.revokeSessionFamilyMutex.Lock()
+	defer fake.revokeSessionFamilyMutex.Unlock()
+	fake.RevokeSessionFamilyStub = nil
+	if fake.revokeSessionFamilyReturnsOnCall == nil {
+		fake.revokeSessionFamilyReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.revokeSessionFamilyReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeUserRepository) RotateSession(arg1 context.Context, arg2 uuid.UUID, arg3 repository.Session) error {
+	fake.rotateSessionMutex.Lock()
+	ret, specificReturn := fake.rotateSessionReturnsOnCall[len(fake.rotateSessionArgsForCall)]
+	fake.rotateSessionArgsForCall = append(fake.rotateSessionArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 repository.Session
+	}{arg1, arg2, arg3})
+	stub := fake.RotateSessionStub
+	fakeReturns := fake.rotateSessionReturns
+	fake.recordInvocation("RotateSession", []interface{}{arg1, arg2, arg3})
+	fake.rotateSessionMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeUserRepository) RotateSessionCallCount() int {
+	fake.rotateSessionMutex.RLock()
+	defer fake.rotateSessionMutex.RUnlock()
+	return len(fake.rotateSessionArgsForCall)
+}
+
+func (fake *FakeUserRepository) RotateSessionCalls(stub func(context.Context, uuid.UUID, repository.Session) error) {
+	fake.rotateSessionMutex.Lock()
+	defer fake.rotateSessionMutex.Unlock()
+	fake.RotateSessionStub = stub
+}
+
+func (fake *FakeUserRepository) RotateSessionArgsForCall(i int) (context.Context, uuid.UUID, repository.Session) {
+	fake.rotateSessionMutex.RLock()
+	defer fake.rotateSessionMutex.RUnlock()
+	argsForCall := fake.rotateSessionArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeUserRepository) RotateSessionReturns(result1 error) {
+	fake.rotateSessionMutex.Lock()
+	defer fake.rotateSessionMutex.Unlock()
+	fake.RotateSessionStub = nil
+	fake.rotateSessionReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeUserRepository) RotateSessionReturnsOnCall(i int, result1 error) {
+	fake.rotateSessionMutex.Lock()
+	defer fake.rotateSessionMutex.Unlock()
+	fake.RotateSessionStub = nil
+	if fake.rotateSessionReturnsOnCall == nil {
+		fake.rotateSessionReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.rotateSessionReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeUserRepository) SaveAuthorProfile(arg1 context.Context, arg2 repository.AuthorProfile) error {
+	fake.saveAuthorProfileMutex.Lock()
+	ret, specificReturn := fake.saveAuthorProfileReturnsOnCall[len(fake.saveAuthorProfileArgsForCall)]
+	fake.saveAuthorProfileArgsForCall = append(fake.saveAuthorProfileArgsForCall, struct {
+		arg1 context.Context
+		arg2 repository.AuthorProfile
+	}{arg1, arg2})
+	stub := fake.SaveAuthorProfileStub
+	fakeReturns := fake.saveAuthorProfileReturns
+	fake.recordInvocation("SaveAuthorProfile", []interface{}{arg1, arg2})
+	fake.saveAuthorProfileMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeUserRepository) SaveAuthorProfileCallCount() int {
+	fake.saveAuthorProfileMutex.RLock()
+	defer fake.saveAuthorProfileMutex.RUnlock()
+	return len(fake.saveAuthorProfileArgsForCall)
+}
+
+func (fake *FakeUserRepository) SaveAuthorProfileCalls(stub func(context.Context, repository.AuthorProfile) error) {
+	fake.saveAuthorProfileMutex.Lock()
+	defer fake.saveAuthorProfileMutex.Unlock()
+	fake.SaveAuthorProfileStub = stub
+}
+
+func (fake *FakeUserRepository) SaveAuthorProfileArgsForCall(i int) (context.Context, repository.AuthorProfile) {
+	fake.saveAuthorProfileMutex.RLock()
+	defer fake.saveAuthorProfileMutex.RUnlock()
+	argsForCall := fake.saveAuthorProfileArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeUserRepository) SaveAuthorProfileReturns(result1 error) {
+	fake.saveAuthorProfileMutex.Lock()
+	defer fake.saveAuthorProfileMutex.Unlock()
+	fake.SaveAuthorProfileStub = nil
+	fake.saveAuthorProfileReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeUserRepository) SaveAuthorProfileReturnsOnCall(i int, result1 error) {
+	fake.saveAuthorProfileMutex.Lock()
+	defer fake.saveAuthorProfileMutex.Unlock()
+	fake.SaveAuthorProfileStub = nil
+	if fake.saveAuthorProfileReturnsOnCall == nil {
+		fake.saveAuthorProfileReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.saveAuthorProfileReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeUserRepository) SavePreferences(arg1 context.Context, arg2 repository.UserPreferences) error {
+	fake.savePreferencesMutex.Lock()
+	ret, specificReturn := fake.savePreferencesReturnsOnCall[len(fake.savePreferencesArgsForCall)]
+	fake.savePreferencesArgsForCall = append(fake.savePreferencesArgsForCall, struct {
+		arg1 context.Context
+		arg2 repository.UserPreferences
+	}{arg1, arg2})
+	stub := fake.SavePreferencesStub
+	fakeReturns := fake.savePreferencesReturns
+	fake.recordInvocation("SavePreferences", []interface{}{arg1, arg2})
+	fake.savePreferencesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeUserRepository) SavePreferencesCallCount() int {
+	fake.savePreferencesMutex.RLock()
+	defer fake.savePreferencesMutex.RUnlock()
+	return len(fake.savePreferencesArgsForCall)
+}
+
+func (fake *FakeUserRepository) SavePreferencesCalls(stub func(context.Context, repository.UserPreferences) error) {
+	fake.savePreferencesMutex.Lock()
+	defer fake.savePreferencesMutex.Unlock()
+	fake.SavePreferencesStub = stub
+}
+
+func (fake *FakeUserRepository) SavePreferencesArgsForCall(i int) (context.Context, repository.UserPreferences) {
+	fake.savePreferencesMutex.RLock()
+	defer fake.savePreferencesMutex.RUnlock()
+	argsForCall := fake.savePreferencesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeUserRepository) SavePreferencesReturns(result1 error) {
+	fake.savePreferencesMutex.Lock()
+	defer fake.savePreferencesMutex.Unlock()
+	fake.SavePreferencesStub = nil
+	fake.savePreferencesReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeUserRepository) SavePreferencesReturnsOnCall(i int, result1 error) {
+	fake.savePreferencesMutex.Lock()
+	defer fake.savePreferencesMutex.Unlock()
+	fake.SavePreferencesStub = nil
+	if fake.savePreferencesReturnsOnCall == nil {
+		fake.savePreferencesReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.savePreferencesReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeUserRepository) SaveTwoFactorCredential(arg1 context.Context, arg2 repository.TwoFactorCredential) error {
+	fake.saveTwoFactorCredentialMutex.Lock()
+	ret, specificReturn := fake.saveTwoFactorCredentialReturnsOnCall[len(fake.saveTwoFactorCredentialArgsForCall)]
+	fake.saveTwoFactorCredentialArgsForCall = append(fake.saveTwoFactorCredentialArgsForCall, struct {
+		arg1 context.Context
+		arg2 repository.TwoFactorCredential
+	}{arg1, arg2})
+	stub := fake.SaveTwoFactorCredentialStub
+	fakeReturns := fake.saveTwoFactorCredentialReturns
+	fake.recordInvocation("SaveTwoFactorCredential", []interface{}{arg1, arg2})
+	fake.saveTwoFactorCredentialMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeUserRepository) SaveTwoFactorCredentialCallCount() int {
+	fake.saveTwoFactorCredentialMutex.RLock()
+	defer fake.saveTwoFactorCredentialMutex.RUnlock()
+	return len(fake.saveTwoFactorCredentialArgsForCall)
+}
+
+func (fake *FakeUserRepository) SaveTwoFactorCredentialCalls(stub func(context.Context, repository.TwoFactorCredential) error) {
+	fake.saveTwoFactorCredentialMutex.Lock()
+	defer fake.saveTwoFactorCredentialMutex.Unlock()
+	fake.SaveTwoFactorCredentialStub = stub
+}
+
+func (fake *FakeUserRepository) SaveTwoFactorCredentialArgsForCall(i int) (context.Context, repository.TwoFactorCredential) {
+	fake.saveTwoFactorCredentialMutex.RLock()
+	defer fake.saveTwoFactorCredentialMutex.RUnlock()
+	argsForCall := fake.saveTwoFactorCredentialArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeUserRepository) SaveTwoFactorCredentialReturns(result1 error) {
+	fake.saveTwoFactorCredentialMutex.Lock()
+	defer fake.saveTwoFactorCredentialMutex.Unlock()
+	fake.SaveTwoFactorCredentialStub = nil
+	fake.saveTwoFactorCredentialReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeUserRepository) SaveTwoFactorCredentialReturnsOnCall(i int, result1 error) {
+	fake.saveTwoFactorCredentialMutex.Lock()
+	defer fake.saveTwoFactorCredentialMutex.Unlock()
+	fake.SaveTwoFactorCredentialStub = nil
+	if fake.saveTwoFactorCredentialReturnsOnCall == nil {
+		fake.saveTwoFactorCredentialReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.saveTwoFactorCredentialReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeUserRepository) Update(arg1 context.Context, arg2 repository.User) error {
+	fake.updateMutex.Lock()
+	ret, specificReturn := fake.updateReturnsOnCall[len(fake.updateArgsForCall)]
+	fake.updateArgsForCall = append(fake.updateArgsForCall, struct {
+		arg1 context.Context
+		arg2 repository.User
+	}{arg1, arg2})
+	stub := fake.UpdateStub
+	fakeReturns := fake.updateReturns
+	fake.recordInvocation("Update", []interface{}{arg1, arg2})
+	fake.updateMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeUserRepository) UpdateCallCount() int {
+	fake.updateMutex.RLock()
+	defer fake.updateMutex.RUnlock()
+	return len(fake.updateArgsForCall)
+}
+
+func (fake *FakeUserRepository) UpdateCalls(stub func(context.Context, repository.User) error) {
+	fake.updateMutex.Lock()
+	defer fake.updateMutex.Unlock()
+	fake.UpdateStub = stub
+}
+
+func (fake *FakeUserRepository) UpdateArgsForCall(i int) (context.Context, repository.User) {
+	fake.updateMutex.RLock()
+	defer fake.updateMutex.RUnlock()
+	argsForCall := fake.updateArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeUserRepository) UpdateReturns(result1 error) {
+	fake.updateMutex.Lock()
+	defer fake.updateMutex.Unlock()
+	fake.UpdateStub = nil
+	fake.updateReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeUserRepository) UpdateReturnsOnCall(i int, result1 error) {
+	fake.updateMutex.Lock()
+	defer fake.updateMutex.Unlock()
+	fake.UpdateStub = nil
+	if fake.updateReturnsOnCall == nil {
+		fake.updateReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.updateReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeUserRepository) UpdatePassword(arg1 context.Context, arg2 uuid.UUID, arg3 string) error {
+	fake.updatePasswordMutex.Lock()
+	ret, specificReturn := fake.updatePasswordReturnsOnCall[len(fake.updatePasswordArgsForCall)]
+	fake.updatePasswordArgsForCall = append(fake.updatePasswordArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.UpdatePasswordStub
+	fakeReturns := fake.updatePasswordReturns
+	fake.recordInvocation("UpdatePassword", []interface{}{arg1, arg2, arg3})
+	fake.updatePasswordMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeUserRepository) UpdatePasswordCallCount() int {
+	fake.updatePasswordMutex.RLock()
+	defer fake.updatePasswordMutex.RUnlock()
+	return len(fake.updatePasswordArgsForCall)
+}
+
+func (fake *FakeUserRepository) UpdatePasswordCalls(stub func(context.Context, uuid.UUID, string) error) {
+	fake.updatePasswordMutex.Lock()
+	defer fake.updatePasswordMutex.Unlock()
+	fake.UpdatePasswordStub = stub
+}
+
+func (fake *FakeUserRepository) UpdatePasswordArgsForCall(i int) (context.Context, uuid.UUID, string) {
+	fake.updatePasswordMutex.RLock()
+	defer fake.updatePasswordMutex.RUnlock()
+	argsForCall := fake.updatePasswordArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
-func (fake *FakeUserRepository) CreateReturns(result1 error) {
-	fake.createMutex.Lock()
-	defer fake.createMutex.Unlock()
-	fake.CreateStub = nil
-	fake.createReturns = struct {
+func (fake *FakeUserRepository) UpdatePasswordReturns(result1 error) {
+	fake.updatePasswordMutex.Lock()
+	defer fake.updatePasswordMutex.Unlock()
+	fake.UpdatePasswordStub = nil
+	fake.updatePasswordReturns = struct {
 		result1 error
 	}{result1}
 }
 
-func (fake *FakeUserRepository) CreateReturnsOnCall(i int, result1 error) {
-	fake.createMutex.Lock()
-	defer fake.createMutex.Unlock()
-	fake.CreateStub = nil
-	if fake.createReturnsOnCall == nil {
-		fake.createReturnsOnCall = make(map[int]struct {
+func (fake *FakeUserRepository) UpdatePasswordReturnsOnCall(i int, result1 error) {
+	fake.updatePasswordMutex.Lock()
+	defer fake.updatePasswordMutex.Unlock()
+	fake.UpdatePasswordStub = nil
+	if fake.updatePasswordReturnsOnCall == nil {
+		fake.updatePasswordReturnsOnCall = make(map[int]struct {
 			result1 error
 		})
 	}
-	fake.createReturnsOnCall[i] = struct {
+	fake.updatePasswordReturnsOnCall[i] = struct {
 		result1 error
 	}{result1}
 }
 
-func (fake *FakeUserRepository) Delete(arg1 context.Context, arg2 uuid.UUID) error {
-	fake.deleteMutex.Lock()
-	ret, specificReturn := fake.deleteReturnsOnCall[len(fake.deleteArgsForCall)]
-	fake.deleteArgsForCall = append(fake.deleteArgsForCall, struct {
+func (fake *FakeUserRepository) UpdatePersonalAccessTokenLastUsed(arg1 context.Context, arg2 uuid.UUID, arg3 time.Time) error {
+	fake.updatePersonalAccessTokenLastUsedMutex.Lock()
+	ret, specificReturn := fake.updatePersonalAccessTokenLastUsedReturnsOnCall[len(fake.updatePersonalAccessTokenLastUsedArgsForCall)]
+	fake.updatePersonalAccessTokenLastUsedArgsForCall = append(fake.updatePersonalAccessTokenLastUsedArgsForCall, struct {
 		arg1 context.Context
 		arg2 uuid.UUID
-	}{arg1, arg2})
-	stub := fake.DeleteStub
-	fakeReturns := fake.deleteReturns
-	fake.recordInvocation("Delete", []interface{}{arg1, arg2})
-	fake.deleteMutex.Unlock()
+		arg3 time.Time
+	}{arg1, arg2, arg3})
+	stub := fake.UpdatePersonalAccessTokenLastUsedStub
+	fakeReturns := fake.updatePersonalAccessTokenLastUsedReturns
+	fake.recordInvocation("UpdatePersonalAccessTokenLastUsed", []interface{}{arg1, arg2, arg3})
+	fake.updatePersonalAccessTokenLastUsedMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2)
+		return stub(arg1, arg2, arg3)
 	}
 	if specificReturn {
 		return ret.result1
@@ -252,257 +3497,251 @@ func (fake *FakeUserRepository) Delete(arg1 context.Context, arg2 uuid.UUID) err
 	return fakeReturns.result1
 }
 
-func (fake *FakeUserRepository) DeleteCallCount() int {
-	fake.deleteMutex.RLock()
-	defer fake.deleteMutex.RUnlock()
-	return len(fake.deleteArgsForCall)
+func (fake *FakeUserRepository) UpdatePersonalAccessTokenLastUsedCallCount() int {
+	fake.updatePersonalAccessTokenLastUsedMutex.RLock()
+	defer fake.updatePersonalAccessTokenLastUsedMutex.RUnlock()
+	return len(fake.updatePersonalAccessTokenLastUsedArgsForCall)
 }
 
-func (fake *FakeUserRepository) DeleteCalls(stub func(context.Context, uuid.UUID) error) {
-	fake.deleteMutex.Lock()
-	defer fake.deleteMutex.Unlock()
-	fake.DeleteStub = stub
+func (fake *FakeUserRepository) UpdatePersonalAccessTokenLastUsedCalls(stub func(context.Context, uuid.UUID, time.Time) error) {
+	fake.updatePersonalAccessTokenLastUsedMutex.Lock()
+	defer fake.updatePersonalAccessTokenLastUsedMutex.Unlock()
+	fake.UpdatePersonalAccessTokenLastUsedStub = stub
 }
 
-func (fake *FakeUserRepository) DeleteArgsForCall(i int) (context.Context, uuid.UUID) {
-	fake.deleteMutex.RLock()
-	defer fake.deleteMutex.RUnlock()
-	argsForCall := fake.deleteArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2
+func (fake *FakeUserRepository) UpdatePersonalAccessTokenLastUsedArgsForCall(i int) (context.Context, uuid.UUID, time.Time) {
+	fake.updatePersonalAccessTokenLastUsedMutex.RLock()
+	defer fake.updatePersonalAccessTokenLastUsedMutex.RUnlock()
+	argsForCall := fake.updatePersonalAccessTokenLastUsedArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
-func (fake *FakeUserRepository) DeleteReturns(result1 error) {
-	fake.deleteMutex.Lock()
-	defer fake.deleteMutex.Unlock()
-	fake.DeleteStub = nil
-	fake.deleteReturns = struct {
+func (fake *FakeUserRepository) UpdatePersonalAccessTokenLastUsedReturns(result1 error) {
+	fake.updatePersonalAccessTokenLastUsedMutex.Lock()
+	defer fake.updatePersonalAccessTokenLastUsedMutex.Unlock()
+	fake.UpdatePersonalAccessTokenLastUsedStub = nil
+	fake.updatePersonalAccessTokenLastUsedReturns = struct {
 		result1 error
 	}{result1}
 }
 
-func (fake *FakeUserRepository) DeleteReturnsOnCall(i int, result1 error) {
-	fake.deleteMutex.Lock()
-	defer fake.deleteMutex.Unlock()
-	fake.DeleteStub = nil
-	if fake.deleteReturnsOnCall == nil {
-		fake.deleteReturnsOnCall = make(map[int]struct {
+func (fake *FakeUserRepository) UpdatePersonalAccessTokenLastUsedReturnsOnCall(i int, result1 error) {
+	fake.updatePersonalAccessTokenLastUsedMutex.Lock()
+	defer fake.updatePersonalAccessTokenLastUsedMutex.Unlock()
+	fake.UpdatePersonalAccessTokenLastUsedStub = nil
+	if fake.updatePersonalAccessTokenLastUsedReturnsOnCall == nil {
+		fake.updatePersonalAccessTokenLastUsedReturnsOnCall = make(map[int]struct {
 			result1 error
 		})
 	}
-	fake.deleteReturnsOnCall[i] = struct {
+	fake.updatePersonalAccessTokenLastUsedReturnsOnCall[i] = struct {
 		result1 error
 	}{result1}
 }
 
-func (fake *FakeUserRepository) GetByEmail(arg1 context.Context, arg2 string) (repository.User, error) {
-	fake.getByEmailMutex.Lock()
-	ret, specificReturn := fake.getByEmailReturnsOnCall[len(fake.getByEmailArgsForCall)]
-	fake.getByEmailArgsForCall = append(fake.getByEmailArgsForCall, struct {
+func (fake *FakeUserRepository) UpdateRole(arg1 context.Context, arg2 uuid.UUID, arg3 string) error {
+	fake.updateRoleMutex.Lock()
+	ret, specificReturn := fake.updateRoleReturnsOnCall[len(fake.updateRoleArgsForCall)]
+	fake.updateRoleArgsForCall = append(fake.updateRoleArgsForCall, struct {
 		arg1 context.Context
-		arg2 string
-	}{arg1, arg2})
-	stub := fake.GetByEmailStub
-	fakeReturns := fake.getByEmailReturns
-	fake.recordInvocation("GetByEmail", []interface{}{arg1, arg2})
-	fake.getByEmailMutex.Unlock()
+		arg2 uuid.UUID
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.UpdateRoleStub
+	fakeReturns := fake.updateRoleReturns
+	fake.recordInvocation("UpdateRole", []interface{}{arg1, arg2, arg3})
+	fake.updateRoleMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2)
+		return stub(arg1, arg2, arg3)
 	}
 	if specificReturn {
-		return ret.result1, ret.result2
+		return ret.result1
 	}
-	return fakeReturns.result1, fakeReturns.result2
+	return fakeReturns.result1
 }
 
-func (fake *FakeUserRepository) GetByEmailCallCount() int {
-	fake.getByEmailMutex.RLock()
-	defer fake.getByEmailMutex.RUnlock()
-	return len(fake.getByEmailArgsForCall)
+func (fake *FakeUserRepository) UpdateRoleCallCount() int {
+	fake.updateRoleMutex.RLock()
+	defer fake.updateRoleMutex.RUnlock()
+	return len(fake.updateRoleArgsForCall)
 }
 
-func (fake *FakeUserRepository) GetByEmailCalls(stub func(context.Context, string) (repository.User, error)) {
-	fake.getByEmailMutex.Lock()
-	defer fake.getByEmailMutex.Unlock()
-	fake.GetByEmailStub = stub
+func (fake *FakeUserRepository) UpdateRoleCalls(stub func(context.Context, uuid.UUID, string) error) {
+	fake.updateRoleMutex.Lock()
+	defer fake.updateRoleMutex.Unlock()
+	fake.UpdateRoleStub = stub
 }
 
-func (fake *FakeUserRepository) GetByEmailArgsForCall(i int) (context.Context, string) {
-	fake.getByEmailMutex.RLock()
-	defer fake.getByEmailMutex.RUnlock()
-	argsForCall := fake.getByEmailArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2
+func (fake *FakeUserRepository) UpdateRoleArgsForCall(i int) (context.Context, uuid.UUID, string) {
+	fake.updateRoleMutex.RLock()
+	defer fake.updateRoleMutex.RUnlock()
+	argsForCall := fake.updateRoleArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
-func (fake *FakeUserRepository) GetByEmailReturns(result1 repository.User, result2 error) {
-	fake.getByEmailMutex.Lock()
-	defer fake.getByEmailMutex.Unlock()
-	fake.GetByEmailStub = nil
-	fake.getByEmailReturns = struct {
-		result1 repository.User
-		result2 error
-	}{result1, result2}
+func (fake *FakeUserRepository) UpdateRoleReturns(result1 error) {
+	fake.updateRoleMutex.Lock()
+	defer fake.updateRoleMutex.Unlock()
+	fake.UpdateRoleStub = nil
+	fake.updateRoleReturns = struct {
+		result1 error
+	}{result1}
 }
 
-func (fake *FakeUserRepository) GetByEmailReturnsOnCall(i int, result1 repository.User, result2 error) {
-	fake.getByEmailMutex.Lock()
-	defer fake.getByEmailMutex.Unlock()
-	fake.GetByEmailStub = nil
-	if fake.getByEmailReturnsOnCall == nil {
-		fake.getByEmailReturnsOnCall = make(map[int]struct {
-			result1 repository.User
-			result2 error
+func (fake *FakeUserRepository) UpdateRoleReturnsOnCall(i int, result1 error) {
+	fake.updateRoleMutex.Lock()
+	defer fake.updateRoleMutex.Unlock()
+	fake.UpdateRoleStub = nil
+	if fake.updateRoleReturnsOnCall == nil {
+		fake.updateRoleReturnsOnCall = make(map[int]struct {
+			result1 error
 		})
 	}
-	fake.getByEmailReturnsOnCall[i] = struct {
-		result1 repository.User
-		result2 error
-	}{result1, result2}
+	fake.updateRoleReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
 }
 
-func (fake *FakeUserRepository) GetByID(arg1 context.Context, arg2 uuid.UUID) (repository.User, error) {
-	fake.getByIDMutex.Lock()
-	ret, specificReturn := fake.getByIDReturnsOnCall[len(fake.getByIDArgsForCall)]
-	fake.getByIDArgsForCall = append(fake.getByIDArgsForCall, struct {
+func (fake *FakeUserRepository) UseRecoveryCode(arg1 context.Context, arg2 uuid.UUID, arg3 string) error {
+	fake.useRecoveryCodeMutex.Lock()
+	ret, specificReturn := fake.useRecoveryCodeReturnsOnCall[len(fake.useRecoveryCodeArgsForCall)]
+	fake.useRecoveryCodeArgsForCall = append(fake.useRecoveryCodeArgsForCall, struct {
 		arg1 context.Context
 		arg2 uuid.UUID
-	}{arg1, arg2})
-	stub := fake.GetByIDStub
-	fakeReturns := fake.getByIDReturns
-	fake.recordInvocation("GetByID", []interface{}{arg1, arg2})
-	fake.getByIDMutex.Unlock()
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.UseRecoveryCodeStub
+	fakeReturns := fake.useRecoveryCodeReturns
+	fake.recordInvocation("UseRecoveryCode", []interface{}{arg1, arg2, arg3})
+	fake.useRecoveryCodeMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2)
+		return stub(arg1, arg2, arg3)
 	}
 	if specificReturn {
-		return ret.result1, ret.result2
+		return ret.result1
 	}
-	return fakeReturns.result1, fakeReturns.result2
+	return fakeReturns.result1
 }
 
-func (fake *FakeUserRepository) GetByIDCallCount() int {
-	fake.getByIDMutex.RLock()
-	defer fake.getByIDMutex.RUnlock()
-	return len(fake.getByIDArgsForCall)
+func (fake *FakeUserRepository) UseRecoveryCodeCallCount() int {
+	fake.useRecoveryCodeMutex.RLock()
+	defer fake.useRecoveryCodeMutex.RUnlock()
+	return len(fake.useRecoveryCodeArgsForCall)
 }
 
-func (fake *FakeUserRepository) GetByIDCalls(stub func(context.Context, uuid.UUID) (repository.User, error)) {
-	fake.getByIDMutex.Lock()
-	defer fake.getByIDMutex.Unlock()
-	fake.GetByIDStub = stub
+func (fake *FakeUserRepository) UseRecoveryCodeCalls(stub func(context.Context, uuid.UUID, string) error) {
+	fake.useRecoveryCodeMutex.Lock()
+	defer fake.useRecoveryCodeMutex.Unlock()
+	fake.UseRecoveryCodeStub = stub
 }
 
-func (fake *FakeUserRepository) GetByIDArgsForCall(i int) (context.Context, uuid.UUID) {
-	fake.getByIDMutex.RLock()
-	defer fake.getByIDMutex.RUnlock()
-	argsForCall := fake.getByIDArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2
+func (fake *FakeUserRepository) UseRecoveryCodeArgsForCall(i int) (context.Context, uuid.UUID, string) {
+	fake.useRecoveryCodeMutex.RLock()
+	defer fake.useRecoveryCodeMutex.RUnlock()
+	argsForCall := fake.useRecoveryCodeArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
-func (fake *FakeUserRepository) GetByIDReturns(result1 repository.User, result2 error) {
-	fake.getByIDMutex.Lock()
-	defer fake.getByIDMutex.Unlock()
-	fake.GetByIDStub = nil
-	fake.getByIDReturns = struct {
-		result1 repository.User
-		result2 error
-	}{result1, result2}
+func (fake *FakeUserRepository) UseRecoveryCodeReturns(result1 error) {
+	fake.useRecoveryCodeMutex.Lock()
+	defer fake.useRecoveryCodeMutex.Unlock()
+	fake.UseRecoveryCodeStub = nil
+	fake.useRecoveryCodeReturns = struct {
+		result1 error
+	}{result1}
 }
 
-func (fake *FakeUserRepository) GetByIDReturnsOnCall(i int, result1 repository.User, result2 error) {
-	fake.getByIDMutex.Lock()
-	defer fake.getByIDMutex.Unlock()
-	fake.GetByIDStub = nil
-	if fake.getByIDReturnsOnCall == nil {
-		fake.getByIDReturnsOnCall = make(map[int]struct {
-			result1 repository.User
-			result2 error
+func (fake *FakeUserRepository) UseRecoveryCodeReturnsOnCall(i int, result1 error) {
+	fake.useRecoveryCodeMutex.Lock()
+	defer fake.useRecoveryCodeMutex.Unlock()
+	fake.UseRecoveryCodeStub = nil
+	if fake.useRecoveryCodeReturnsOnCall == nil {
+		fake.useRecoveryCodeReturnsOnCall = make(map[int]struct {
+			result1 error
 		})
 	}
-	fake.getByIDReturnsOnCall[i] = struct {
-		result1 repository.User
-		result2 error
-	}{result1, result2}
+	fake.useRecoveryCodeReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
 }
 
-func (fake *FakeUserRepository) List(arg1 context.Context, arg2 int, arg3 int) ([]repository.User, error) {
-	fake.listMutex.Lock()
-	ret, specificReturn := fake.listReturnsOnCall[len(fake.listArgsForCall)]
-	fake.listArgsForCall = append(fake.listArgsForCall, struct {
+func (fake *FakeUserRepository) UseTwoFactorStep(arg1 context.Context, arg2 uuid.UUID, arg3 int64) error {
+	fake.useTwoFactorStepMutex.Lock()
+	ret, specificReturn := fake.useTwoFactorStepReturnsOnCall[len(fake.useTwoFactorStepArgsForCall)]
+	fake.useTwoFactorStepArgsForCall = append(fake.useTwoFactorStepArgsForCall, struct {
 		arg1 context.Context
-		arg2 int
-		arg3 int
+		arg2 uuid.UUID
+		arg3 int64
 	}{arg1, arg2, arg3})
-	stub := fake.ListStub
-	fakeReturns := fake.listReturns
-	fake.recordInvocation("List", []interface{}{arg1, arg2, arg3})
-	fake.listMutex.Unlock()
+	stub := fake.UseTwoFactorStepStub
+	fakeReturns := fake.useTwoFactorStepReturns
+	fake.recordInvocation("UseTwoFactorStep", []interface{}{arg1, arg2, arg3})
+	fake.useTwoFactorStepMutex.Unlock()
 	if stub != nil {
 		return stub(arg1, arg2, arg3)
 	}
 	if specificReturn {
-		return ret.result1, ret.result2
+		return ret.result1
 	}
-	return fakeReturns.result1, fakeReturns.result2
+	return fakeReturns.result1
 }
 
-func (fake *FakeUserRepository) ListCallCount() int {
-	fake.listMutex.RLock()
-	defer fake.listMutex.RUnlock()
-	return len(fake.listArgsForCall)
+func (fake *FakeUserRepository) UseTwoFactorStepCallCount() int {
+	fake.useTwoFactorStepMutex.RLock()
+	defer fake.useTwoFactorStepMutex.RUnlock()
+	return len(fake.useTwoFactorStepArgsForCall)
 }
 
-func (fake *FakeUserRepository) ListCalls(stub func(context.Context, int, int) ([]repository.User, error)) {
-	fake.listMutex.Lock()
-	defer fake.listMutex.Unlock()
-	fake.ListStub = stub
+func (fake *FakeUserRepository) UseTwoFactorStepCalls(stub func(context.Context, uuid.UUID, int64) error) {
+	fake.useTwoFactorStepMutex.Lock()
+	defer fake.useTwoFactorStepMutex.Unlock()
+	fake.UseTwoFactorStepStub = stub
 }
 
-func (fake *FakeUserRepository) ListArgsForCall(i int) (context.Context, int, int) {
-	fake.listMutex.RLock()
-	defer fake.listMutex.RUnlock()
-	argsForCall := fake.listArgsForCall[i]
+func (fake *FakeUserRepository) UseTwoFactorStepArgsForCall(i int) (context.Context, uuid.UUID, int64) {
+	fake.useTwoFactorStepMutex.RLock()
+	defer fake.useTwoFactorStepMutex.RUnlock()
+	argsForCall := fake.useTwoFactorStepArgsForCall[i]
 	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
-func (fake *FakeUserRepository) ListReturns(result1 []repository.User, result2 error) {
-	fake.listMutex.Lock()
-	defer fake.listMutex.Unlock()
-	fake.ListStub = nil
-	fake.listReturns = struct {
-		result1 []repository.User
-		result2 error
-	}{result1, result2}
+func (fake *FakeUserRepository) UseTwoFactorStepReturns(result1 error) {
+	fake.useTwoFactorStepMutex.Lock()
+	defer fake.useTwoFactorStepMutex.Unlock()
+	fake.UseTwoFactorStepStub = nil
+	fake.useTwoFactorStepReturns = struct {
+		result1 error
+	}{result1}
 }
 
-func (fake *FakeUserRepository) ListReturnsOnCall(i int, result1 []repository.User, result2 error) {
-	fake.listMutex.Lock()
-	defer fake.listMutex.Unlock()
-	fake.ListStub = nil
-	if fake.listReturnsOnCall == nil {
-		fake.listReturnsOnCall = make(map[int]struct {
-			result1 []repository.User
-			result2 error
+func (fake *FakeUserRepository) UseTwoFactorStepReturnsOnCall(i int, result1 error) {
+	fake.useTwoFactorStepMutex.Lock()
+	defer fake.useTwoFactorStepMutex.Unlock()
+	fake.UseTwoFactorStepStub = nil
+	if fake.useTwoFactorStepReturnsOnCall == nil {
+		fake.useTwoFactorStepReturnsOnCall = make(map[int]struct {
+			result1 error
 		})
 	}
-	fake.listReturnsOnCall[i] = struct {
-		result1 []repository.User
-		result2 error
-	}{result1, result2}
+	fake.useTwoFactorStepReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
 }
 
-func (fake *FakeUserRepository) Update(arg1 context.Context, arg2 repository.User) error {
-	fake.updateMutex.Lock()
-	ret, specificReturn := fake.updateReturnsOnCall[len(fake.updateArgsForCall)]
-	fake.updateArgsForCall = append(fake.updateArgsForCall, struct {
+func (fake *FakeUserRepository) VerifyEmail(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID) error {
+	fake.verifyEmailMutex.Lock()
+	ret, specificReturn := fake.verifyEmailReturnsOnCall[len(fake.verifyEmailArgsForCall)]
+	fake.verifyEmailArgsForCall = append(fake.verifyEmailArgsForCall, struct {
 		arg1 context.Context
-		arg2 repository.User
-	}{arg1, arg2})
-	stub := fake.UpdateStub
-	fakeReturns := fake.updateReturns
-	fake.recordInvocation("Update", []interface{}{arg1, arg2})
-	fake.updateMutex.Unlock()
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+	}{arg1, arg2, arg3})
+	stub := fake.VerifyEmailStub
+	fakeReturns := fake.verifyEmailReturns
+	fake.recordInvocation("VerifyEmail", []interface{}{arg1, arg2, arg3})
+	fake.verifyEmailMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2)
+		return stub(arg1, arg2, arg3)
 	}
 	if specificReturn {
 		return ret.result1
@@ -510,44 +3749,44 @@ func (fake *FakeUserRepository) Update(arg1 context.Context, arg2 repository.Use
 	return fakeReturns.result1
 }
 
-func (fake *FakeUserRepository) UpdateCallCount() int {
-	fake.updateMutex.RLock()
-	defer fake.updateMutex.RUnlock()
-	return len(fake.updateArgsForCall)
+func (fake *FakeUserRepository) VerifyEmailCallCount() int {
+	fake.verifyEmailMutex.RLock()
+	defer fake.verifyEmailMutex.RUnlock()
+	return len(fake.verifyEmailArgsForCall)
 }
 
-func (fake *FakeUserRepository) UpdateCalls(stub func(context.Context, repository.User) error) {
-	fake.updateMutex.Lock()
-	defer fake.updateMutex.Unlock()
-	fake.UpdateStub = stub
+func (fake *FakeUserRepository) VerifyEmailCalls(stub func(context.Context, uuid.UUID, uuid.UUID) error) {
+	fake.verifyEmailMutex.Lock()
+	defer fake.verifyEmailMutex.Unlock()
+	fake.VerifyEmailStub = stub
 }
 
-func (fake *FakeUserRepository) UpdateArgsForCall(i int) (context.Context, repository.User) {
-	fake.updateMutex.RLock()
-	defer fake.updateMutex.RUnlock()
-	argsForCall := fake.updateArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2
+func (fake *FakeUserRepository) VerifyEmailArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID) {
+	fake.verifyEmailMutex.RLock()
+	defer fake.verifyEmailMutex.RUnlock()
+	argsForCall := fake.verifyEmailArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
-func (fake *FakeUserRepository) UpdateReturns(result1 error) {
-	fake.updateMutex.Lock()
-	defer fake.updateMutex.Unlock()
-	fake.UpdateStub = nil
-	fake.updateReturns = struct {
+func (fake *FakeUserRepository) VerifyEmailReturns(result1 error) {
+	fake.verifyEmailMutex.Lock()
+	defer fake.verifyEmailMutex.Unlock()
+	fake.VerifyEmailStub = nil
+	fake.verifyEmailReturns = struct {
 		result1 error
 	}{result1}
 }
 
-func (fake *FakeUserRepository) UpdateReturnsOnCall(i int, result1 error) {
-	fake.updateMutex.Lock()
-	defer fake.updateMutex.Unlock()
-	fake.UpdateStub = nil
-	if fake.updateReturnsOnCall == nil {
-		fake.updateReturnsOnCall = make(map[int]struct {
+func (fake *FakeUserRepository) VerifyEmailReturnsOnCall(i int, result1 error) {
+	fake.verifyEmailMutex.Lock()
+	defer fake.verifyEmailMutex.Unlock()
+	fake.VerifyEmailStub = nil
+	if fake.verifyEmailReturnsOnCall == nil {
+		fake.verifyEmailReturnsOnCall = make(map[int]struct {
 			result1 error
 		})
 	}
-	fake.updateReturnsOnCall[i] = struct {
+	fake.verifyEmailReturnsOnCall[i] = struct {
 		result1 error
 	}{result1}
 }
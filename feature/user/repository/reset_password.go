@@ -0,0 +1,84 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ResetPassword consumes the reset token and sets the new password hash in one
+// transaction. The token is only consumed when it is unused and unexpired, so it
+// can never be redeemed twice. Every other outstanding reset token of the user is
+// invalidated and all sessions are revoked, signing the user out everywhere.
+func (r *userRepository) ResetPassword(ctx context.Context, resetTokenID uuid.UUID, userID uuid.UUID, passwordHash string) error {
+	err := r.withTx(ctx, "reset password", func(tx *sql.Tx) error {
+		now := time.Now()
+
+		result, err := tx.ExecContext(ctx, `
+			UPDATE password_reset_tokens
+			SET used_at = ?
+			WHERE id = ? AND user_id = ? AND used_at IS NULL AND expires_at > ?
+		`, now, resetTokenID, userID, now)
+		if err != nil {
+			r.log.Error("Failed to consume password reset token",
+				slog.String("error", err.Error()),
+				slog.String("user_id", userID.String()),
+			)
+			return fmt.Errorf("%w: %w", ErrFailedToResetPassword, err)
+		}
+
+		rowsAffected, err := result.RowsAffected()
+		if err != nil {
+			r.log.Error("Failed to get rows affected",
+				slog.String("error", err.Error()),
+			)
+			return fmt.Errorf("%w: %w", ErrFailedToGetRowsAffected, err)
+		}
+
+		if rowsAffected == 0 {
+			return ErrPasswordResetTokenAlreadyUsed
+		}
+
+		statements := []struct {
+			query string
+			args  []any
+		}{
+			{
+				query: `UPDATE password_reset_tokens SET used_at = ? WHERE user_id = ? AND used_at IS NULL`,
+				args:  []any{now, userID},
+			},
+			{
+				query: `UPDATE users SET password = ?, updated_at = ? WHERE id = ?`,
+				args:  []any{passwordHash, now, userID},
+			},
+			{
+				query: `UPDATE sessions SET revoked_at = ?, updated_at = ? WHERE user_id = ? AND revoked_at IS NULL`,
+				args:  []any{now, now, userID},
+			},
+		}
+		for _, statement := range statements {
+			if _, err := tx.ExecContext(ctx, statement.query, statement.args...); err != nil {
+				r.log.Error("Failed to reset password",
+					slog.String("error", err.Error()),
+					slog.String("user_id", userID.String()),
+				)
+				return fmt.Errorf("%w: %w", ErrFailedToResetPassword, err)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	r.log.Info("Password reset successfully",
+		slog.String("user_id", userID.String()),
+	)
+
+	return nil
+}
@@ -0,0 +1,88 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/fikryfahrezy/let-it-go/feature/user/repository"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createTestPasswordResetToken(t *testing.T, userID uuid.UUID, tokenHash string, ttl time.Duration) repository.PasswordResetToken {
+	t.Helper()
+
+	resetToken := repository.PasswordResetToken{
+		ID:        uuid.Must(uuid.NewV7()),
+		UserID:    userID,
+		TokenHash: tokenHash,
+		ExpiresAt: time.Now().Add(ttl),
+	}
+	require.NoError(t, testRepository.CreatePasswordResetToken(context.Background(), resetToken))
+
+	return resetToken
+}
+
+func TestResetPassword(t *testing.T) {
+	setupTest(t)
+
+	ctx := context.Background()
+	user := createTestUser(t, "reset@example.com")
+	resetToken := createTestPasswordResetToken(t, user.ID, "hash-reset", time.Hour)
+	otherToken := createTestPasswordResetToken(t, user.ID, "hash-other", time.Hour)
+	session := newTestSession(user.ID, "hash-session", time.Hour)
+	require.NoError(t, testRepository.CreateSession(ctx, session))
+
+	err := testRepository.ResetPassword(ctx, resetToken.ID, user.ID, "new-password-hash")
+	require.NoError(t, err)
+
+	updated, err := testRepository.GetByID(ctx, user.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "new-password-hash", updated.Password)
+
+	// Both the used and the other outstanding token can no longer be redeemed
+	for _, hash := range []string{resetToken.TokenHash, otherToken.TokenHash} {
+		result, err := testRepository.GetPasswordResetTokenByHash(ctx, hash)
+		require.NoError(t, err)
+		assert.NotNil(t, result.UsedAt)
+	}
+
+	// Existing sessions are revoked
+	revoked, err := testRepository.GetSessionByRefreshTokenHash(ctx, session.RefreshTokenHash)
+	require.NoError(t, err)
+	assert.NotNil(t, revoked.RevokedAt)
+}
+
+func TestResetPasswordTokenUsedTwice(t *testing.T) {
+	setupTest(t)
+
+	ctx := context.Background()
+	user := createTestUser(t, "reset@example.com")
+	resetToken := createTestPasswordResetToken(t, user.ID, "hash-reset", time.Hour)
+
+	require.NoError(t, testRepository.ResetPassword(ctx, resetToken.ID, user.ID, "first-hash"))
+
+	err := testRepository.ResetPassword(ctx, resetToken.ID, user.ID, "second-hash")
+	assert.Equal(t, repository.ErrPasswordResetTokenAlreadyUsed, err)
+
+	updated, err := testRepository.GetByID(ctx, user.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "first-hash", updated.Password)
+}
+
+func TestResetPasswordTokenExpired(t *testing.T) {
+	setupTest(t)
+
+	ctx := context.Background()
+	user := createTestUser(t, "reset@example.com")
+	resetToken := createTestPasswordResetToken(t, user.ID, "hash-reset", -time.Minute)
+
+	err := testRepository.ResetPassword(ctx, resetToken.ID, user.ID, "new-hash")
+	assert.Equal(t, repository.ErrPasswordResetTokenAlreadyUsed, err)
+
+	updated, err := testRepository.GetByID(ctx, user.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "hashedpassword", updated.Password)
+}
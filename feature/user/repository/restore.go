@@ -0,0 +1,48 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Restore undoes the soft delete of a user deleted at or after deletedAfter whose
+// personal data has not been erased yet. Anything else is reported as ErrDeletedUserNotFound.
+// Sessions and personal access tokens revoked by the delete stay revoked.
+func (r *userRepository) Restore(ctx context.Context, id uuid.UUID, deletedAfter time.Time) error {
+	query := `
+		UPDATE users
+		SET deleted_at = NULL, updated_at = ?
+		WHERE id = ? AND deleted_at >= ? AND erased_at IS NULL
+	`
+
+	result, err := r.db.ExecContext(ctx, query, time.Now(), id, deletedAfter)
+	if err != nil {
+		r.log.Error("Failed to restore user",
+			slog.String("error", err.Error()),
+			slog.String("user_id", id.String()),
+		)
+		return fmt.Errorf("%w: %w", ErrFailedToRestoreUser, err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		r.log.Error("Failed to get rows affected",
+			slog.String("error", err.Error()),
+		)
+		return fmt.Errorf("%w: %w", ErrFailedToGetRowsAffected, err)
+	}
+
+	if rowsAffected == 0 {
+		return ErrDeletedUserNotFound
+	}
+
+	r.log.Info("User restored successfully",
+		slog.String("user_id", id.String()),
+	)
+
+	return nil
+}
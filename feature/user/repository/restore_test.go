@@ -0,0 +1,59 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/fikryfahrezy/let-it-go/feature/user/repository"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRestore(t *testing.T) {
+	setupTest(t)
+	ctx := context.Background()
+
+	user := createTestUser(t, "restore@example.com")
+	require.NoError(t, testRepository.Delete(ctx, user.ID))
+
+	err := testRepository.Restore(ctx, user.ID, time.Now().Add(-time.Hour))
+	require.NoError(t, err)
+
+	restored, err := testRepository.GetByID(ctx, user.ID)
+	require.NoError(t, err)
+	assert.Equal(t, user.Email, restored.Email)
+
+	// A user that is not deleted cannot be restored
+	err = testRepository.Restore(ctx, user.ID, time.Now().Add(-time.Hour))
+	assert.Equal(t, repository.ErrDeletedUserNotFound, err)
+
+	err = testRepository.Restore(ctx, uuid.New(), time.Now().Add(-time.Hour))
+	assert.Equal(t, repository.ErrDeletedUserNotFound, err)
+}
+
+func TestRestoreAfterGracePeriod(t *testing.T) {
+	setupTest(t)
+	ctx := context.Background()
+
+	user := createTestUser(t, "restore-late@example.com")
+	require.NoError(t, testRepository.Delete(ctx, user.ID))
+
+	// The deletion happened before the start of the grace period
+	err := testRepository.Restore(ctx, user.ID, time.Now().Add(time.Hour))
+	assert.Equal(t, repository.ErrDeletedUserNotFound, err)
+}
+
+func TestRestoreErasedUser(t *testing.T) {
+	setupTest(t)
+	ctx := context.Background()
+
+	user := createTestUser(t, "restore-erased@example.com")
+	require.NoError(t, testRepository.Delete(ctx, user.ID))
+	_, err := testRepository.EraseDeletedUsers(ctx, time.Now().Add(time.Minute))
+	require.NoError(t, err)
+
+	err = testRepository.Restore(ctx, user.ID, time.Now().Add(-time.Hour))
+	assert.Equal(t, repository.ErrDeletedUserNotFound, err)
+}
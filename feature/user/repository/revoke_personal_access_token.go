@@ -0,0 +1,48 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// RevokePersonalAccessToken revokes a token of the user. Tokens of other users and
+// already revoked ones are reported as not found.
+func (r *userRepository) RevokePersonalAccessToken(ctx context.Context, userID uuid.UUID, tokenID uuid.UUID) error {
+	query := `
+		UPDATE personal_access_tokens
+		SET revoked_at = ?
+		WHERE id = ? AND user_id = ? AND revoked_at IS NULL
+	`
+
+	result, err := r.db.ExecContext(ctx, query, time.Now(), tokenID, userID)
+	if err != nil {
+		r.log.Error("Failed to revoke personal access token",
+			slog.String("error", err.Error()),
+			slog.String("token_id", tokenID.String()),
+		)
+		return fmt.Errorf("%w: %w", ErrFailedToRevokePersonalAccessToken, err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		r.log.Error("Failed to get rows affected",
+			slog.String("error", err.Error()),
+		)
+		return fmt.Errorf("%w: %w", ErrFailedToGetRowsAffected, err)
+	}
+
+	if rowsAffected == 0 {
+		return ErrPersonalAccessTokenNotFound
+	}
+
+	r.log.Info("Personal access token revoked successfully",
+		slog.String("token_id", tokenID.String()),
+		slog.String("user_id", userID.String()),
+	)
+
+	return nil
+}
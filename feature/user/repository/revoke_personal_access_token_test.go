@@ -0,0 +1,47 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/fikryfahrezy/let-it-go/feature/user/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRevokePersonalAccessToken(t *testing.T) {
+	setupTest(t)
+
+	ctx := context.Background()
+	user := createTestUser(t, "revoke-token@example.com")
+	accessToken := newTestPersonalAccessToken(user.ID, "hash-revoke", "blogs:write")
+	require.NoError(t, testRepository.CreatePersonalAccessToken(ctx, accessToken))
+
+	err := testRepository.RevokePersonalAccessToken(ctx, user.ID, accessToken.ID)
+	require.NoError(t, err)
+
+	stored, err := testRepository.GetPersonalAccessTokenByHash(ctx, "hash-revoke")
+	require.NoError(t, err)
+	assert.NotNil(t, stored.RevokedAt)
+
+	// Revoking twice reports the token as gone
+	err = testRepository.RevokePersonalAccessToken(ctx, user.ID, accessToken.ID)
+	assert.Equal(t, repository.ErrPersonalAccessTokenNotFound, err)
+}
+
+func TestRevokePersonalAccessTokenOfOtherUser(t *testing.T) {
+	setupTest(t)
+
+	ctx := context.Background()
+	owner := createTestUser(t, "token-owner@example.com")
+	otherUser := createTestUser(t, "token-other@example.com")
+	accessToken := newTestPersonalAccessToken(owner.ID, "hash-owner", "blogs:write")
+	require.NoError(t, testRepository.CreatePersonalAccessToken(ctx, accessToken))
+
+	err := testRepository.RevokePersonalAccessToken(ctx, otherUser.ID, accessToken.ID)
+	assert.Equal(t, repository.ErrPersonalAccessTokenNotFound, err)
+
+	stored, err := testRepository.GetPersonalAccessTokenByHash(ctx, "hash-owner")
+	require.NoError(t, err)
+	assert.Nil(t, stored.RevokedAt)
+}
@@ -0,0 +1,79 @@
+package repository_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/fikryfahrezy/let-it-go/feature/user/repository"
+	"github.com/fikryfahrezy/let-it-go/pkg/database"
+	"github.com/fikryfahrezy/let-it-go/pkg/logger"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRevokePersonalAccessTokenUnit(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	// nolint:errcheck
+	defer sqlDB.Close()
+
+	db := &database.DB{DB: sqlDB}
+	repo := repository.NewUserRepository(logger.NewDiscardLogger(), db)
+	ctx := context.Background()
+
+	userID := uuid.New()
+	tokenID := uuid.New()
+
+	mock.ExpectExec("UPDATE personal_access_tokens SET revoked_at").
+		WithArgs(sqlmock.AnyArg(), tokenID, userID).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	err = repo.RevokePersonalAccessToken(ctx, userID, tokenID)
+	assert.NoError(t, err)
+
+	// Verify all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRevokePersonalAccessTokenNotFoundUnit(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	// nolint:errcheck
+	defer sqlDB.Close()
+
+	db := &database.DB{DB: sqlDB}
+	repo := repository.NewUserRepository(logger.NewDiscardLogger(), db)
+	ctx := context.Background()
+
+	mock.ExpectExec("UPDATE personal_access_tokens SET revoked_at").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	err = repo.RevokePersonalAccessToken(ctx, uuid.New(), uuid.New())
+	assert.Equal(t, repository.ErrPersonalAccessTokenNotFound, err)
+
+	// Verify all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRevokePersonalAccessTokenErrorUnit(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	// nolint:errcheck
+	defer sqlDB.Close()
+
+	db := &database.DB{DB: sqlDB}
+	repo := repository.NewUserRepository(logger.NewDiscardLogger(), db)
+	ctx := context.Background()
+
+	mock.ExpectExec("UPDATE personal_access_tokens SET revoked_at").
+		WillReturnError(sql.ErrConnDone)
+
+	err = repo.RevokePersonalAccessToken(ctx, uuid.New(), uuid.New())
+	assert.ErrorIs(t, err, repository.ErrFailedToRevokePersonalAccessToken)
+
+	// Verify all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
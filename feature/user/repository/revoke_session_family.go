@@ -0,0 +1,35 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func (r *userRepository) RevokeSessionFamily(ctx context.Context, familyID uuid.UUID) error {
+	query := `
+		UPDATE sessions
+		SET revoked_at = ?, updated_at = ?
+		WHERE family_id = ? AND revoked_at IS NULL
+	`
+
+	now := time.Now()
+
+	_, err := r.db.ExecContext(ctx, query, now, now, familyID)
+	if err != nil {
+		r.log.Error("Failed to revoke session family",
+			slog.String("error", err.Error()),
+			slog.String("family_id", familyID.String()),
+		)
+		return fmt.Errorf("%w: %w", ErrFailedToRevokeSession, err)
+	}
+
+	r.log.Info("Session family revoked successfully",
+		slog.String("family_id", familyID.String()),
+	)
+
+	return nil
+}
@@ -0,0 +1,40 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRevokeSessionFamily(t *testing.T) {
+	setupTest(t)
+
+	user := createTestUser(t, "revoke@example.com")
+	first := newTestSession(user.ID, "hash-first", time.Hour)
+	require.NoError(t, testRepository.CreateSession(context.Background(), first))
+
+	second := newTestSession(user.ID, "hash-second", time.Hour)
+	second.FamilyID = first.FamilyID
+	require.NoError(t, testRepository.RotateSession(context.Background(), first.ID, second))
+
+	other := newTestSession(user.ID, "hash-other", time.Hour)
+	require.NoError(t, testRepository.CreateSession(context.Background(), other))
+
+	err := testRepository.RevokeSessionFamily(context.Background(), first.FamilyID)
+	require.NoError(t, err)
+
+	// Every session of the family is revoked
+	for _, hash := range []string{first.RefreshTokenHash, second.RefreshTokenHash} {
+		session, err := testRepository.GetSessionByRefreshTokenHash(context.Background(), hash)
+		require.NoError(t, err)
+		assert.NotNil(t, session.RevokedAt)
+	}
+
+	// Sessions of other logins are untouched
+	session, err := testRepository.GetSessionByRefreshTokenHash(context.Background(), other.RefreshTokenHash)
+	require.NoError(t, err)
+	assert.Nil(t, session.RevokedAt)
+}
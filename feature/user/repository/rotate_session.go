@@ -0,0 +1,70 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// RotateSession marks the current session as rotated and inserts its successor in one
+// transaction. The update only matches a session that is neither rotated nor revoked,
+// so when two requests race with the same refresh token only one of them wins and the
+// other gets ErrSessionAlreadyRotated.
+func (r *userRepository) RotateSession(ctx context.Context, currentID uuid.UUID, next Session) error {
+	err := r.withTx(ctx, "rotate session", func(tx *sql.Tx) error {
+		now := time.Now()
+
+		result, err := tx.ExecContext(ctx, `
+			UPDATE sessions
+			SET rotated_at = ?, updated_at = ?
+			WHERE id = ? AND rotated_at IS NULL AND revoked_at IS NULL
+		`, now, now, currentID)
+		if err != nil {
+			r.log.Error("Failed to mark session as rotated",
+				slog.String("error", err.Error()),
+				slog.String("session_id", currentID.String()),
+			)
+			return fmt.Errorf("%w: %w", ErrFailedToRotateSession, err)
+		}
+
+		rowsAffected, err := result.RowsAffected()
+		if err != nil {
+			r.log.Error("Failed to get rows affected",
+				slog.String("error", err.Error()),
+			)
+			return fmt.Errorf("%w: %w", ErrFailedToGetRowsAffected, err)
+		}
+
+		if rowsAffected == 0 {
+			return ErrSessionAlreadyRotated
+		}
+
+		_, err = tx.ExecContext(ctx, `
+			INSERT INTO sessions (id, user_id, family_id, refresh_token_hash, user_agent, ip_address, expires_at, created_at, updated_at)
+			VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)
+		`, next.ID, next.UserID, next.FamilyID, next.RefreshTokenHash, next.UserAgent, next.IPAddress, next.ExpiresAt, now, now)
+		if err != nil {
+			r.log.Error("Failed to insert rotated session",
+				slog.String("error", err.Error()),
+				slog.String("family_id", next.FamilyID.String()),
+			)
+			return fmt.Errorf("%w: %w", ErrFailedToRotateSession, err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	r.log.Info("Session rotated successfully",
+		slog.String("session_id", currentID.String()),
+		slog.String("next_session_id", next.ID.String()),
+	)
+
+	return nil
+}
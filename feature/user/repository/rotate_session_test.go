@@ -0,0 +1,68 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/fikryfahrezy/let-it-go/feature/user/repository"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRotateSession(t *testing.T) {
+	setupTest(t)
+
+	user := createTestUser(t, "rotate@example.com")
+	current := newTestSession(user.ID, "hash-current", time.Hour)
+	require.NoError(t, testRepository.CreateSession(context.Background(), current))
+
+	next := newTestSession(user.ID, "hash-next", time.Hour)
+	next.FamilyID = current.FamilyID
+
+	err := testRepository.RotateSession(context.Background(), current.ID, next)
+	require.NoError(t, err)
+
+	// The current session is marked as rotated
+	rotated, err := testRepository.GetSessionByRefreshTokenHash(context.Background(), current.RefreshTokenHash)
+	require.NoError(t, err)
+	assert.NotNil(t, rotated.RotatedAt)
+
+	// The successor belongs to the same family
+	successor, err := testRepository.GetSessionByRefreshTokenHash(context.Background(), next.RefreshTokenHash)
+	require.NoError(t, err)
+	assert.Equal(t, current.FamilyID, successor.FamilyID)
+	assert.Nil(t, successor.RotatedAt)
+}
+
+func TestRotateSessionAlreadyRotated(t *testing.T) {
+	setupTest(t)
+
+	user := createTestUser(t, "rotate@example.com")
+	current := newTestSession(user.ID, "hash-current", time.Hour)
+	require.NoError(t, testRepository.CreateSession(context.Background(), current))
+
+	next := newTestSession(user.ID, "hash-next", time.Hour)
+	next.FamilyID = current.FamilyID
+	require.NoError(t, testRepository.RotateSession(context.Background(), current.ID, next))
+
+	// Rotating the same session a second time must fail and insert nothing
+	replay := newTestSession(user.ID, "hash-replay", time.Hour)
+	replay.FamilyID = current.FamilyID
+	err := testRepository.RotateSession(context.Background(), current.ID, replay)
+	assert.Equal(t, repository.ErrSessionAlreadyRotated, err)
+
+	_, err = testRepository.GetSessionByRefreshTokenHash(context.Background(), replay.RefreshTokenHash)
+	assert.Equal(t, repository.ErrSessionNotFound, err)
+}
+
+func TestRotateSessionNotFound(t *testing.T) {
+	setupTest(t)
+
+	user := createTestUser(t, "rotate@example.com")
+	next := newTestSession(user.ID, "hash-next", time.Hour)
+
+	err := testRepository.RotateSession(context.Background(), uuid.New(), next)
+	assert.Equal(t, repository.ErrSessionAlreadyRotated, err)
+}
@@ -0,0 +1,99 @@
+package repository_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/fikryfahrezy/let-it-go/feature/user/repository"
+	"github.com/fikryfahrezy/let-it-go/pkg/database"
+	"github.com/fikryfahrezy/let-it-go/pkg/logger"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRotateSessionUnit(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	// nolint:errcheck
+	defer sqlDB.Close()
+
+	db := &database.DB{DB: sqlDB}
+	repo := repository.NewUserRepository(logger.NewDiscardLogger(), db)
+	ctx := context.Background()
+
+	currentID := uuid.New()
+	next := newTestSession(uuid.New(), "next-hash", time.Hour)
+
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE sessions SET rotated_at").
+		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), currentID).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("INSERT INTO sessions").
+		WithArgs(next.ID, next.UserID, next.FamilyID, next.RefreshTokenHash, next.UserAgent, next.IPAddress, next.ExpiresAt, sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	err = repo.RotateSession(ctx, currentID, next)
+	assert.NoError(t, err)
+
+	// Verify all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRotateSessionAlreadyRotatedUnit(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	// nolint:errcheck
+	defer sqlDB.Close()
+
+	db := &database.DB{DB: sqlDB}
+	repo := repository.NewUserRepository(logger.NewDiscardLogger(), db)
+	ctx := context.Background()
+
+	currentID := uuid.New()
+	next := newTestSession(uuid.New(), "next-hash", time.Hour)
+
+	// No row matched, so the successor must not be inserted
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE sessions SET rotated_at").
+		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), currentID).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectRollback()
+
+	err = repo.RotateSession(ctx, currentID, next)
+	assert.Equal(t, repository.ErrSessionAlreadyRotated, err)
+
+	// Verify all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRotateSessionInsertErrorUnit(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	// nolint:errcheck
+	defer sqlDB.Close()
+
+	db := &database.DB{DB: sqlDB}
+	repo := repository.NewUserRepository(logger.NewDiscardLogger(), db)
+	ctx := context.Background()
+
+	currentID := uuid.New()
+	next := newTestSession(uuid.New(), "next-hash", time.Hour)
+
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE sessions SET rotated_at").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("INSERT INTO sessions").
+		WillReturnError(sql.ErrConnDone)
+	mock.ExpectRollback()
+
+	err = repo.RotateSession(ctx, currentID, next)
+	assert.ErrorIs(t, err, repository.ErrFailedToRotateSession)
+
+	// Verify all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
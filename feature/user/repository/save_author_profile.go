@@ -0,0 +1,72 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"time"
+)
+
+// SaveAuthorProfile creates the profile of a user or replaces every field of their
+// existing one. A handle used by another user is reported as ErrHandleAlreadyTaken.
+func (r *userRepository) SaveAuthorProfile(ctx context.Context, profile AuthorProfile) error {
+	var socialLinks []byte
+	if len(profile.SocialLinks) > 0 {
+		encoded, err := json.Marshal(profile.SocialLinks)
+		if err != nil {
+			return fmt.Errorf("%w: %w", ErrFailedToSaveAuthorProfile, err)
+		}
+		socialLinks = encoded
+	}
+
+	err := r.withTx(ctx, "save author profile", func(tx *sql.Tx) error {
+		// An upsert is not used because a conflicting handle would update the other user's row
+		var existing int
+		err := tx.QueryRowContext(ctx, `SELECT COUNT(*) FROM author_profiles WHERE user_id = ? FOR UPDATE`, profile.UserID).Scan(&existing)
+		if err != nil {
+			r.log.Error("Failed to lock author profile",
+				slog.String("error", err.Error()),
+				slog.String("user_id", profile.UserID.String()),
+			)
+			return fmt.Errorf("%w: %w", ErrFailedToSaveAuthorProfile, err)
+		}
+
+		now := time.Now()
+		if existing == 0 {
+			_, err = tx.ExecContext(ctx, `
+				INSERT INTO author_profiles (user_id, handle, bio, avatar_url, website_url, social_links, created_at, updated_at)
+				VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+			`, profile.UserID, profile.Handle, profile.Bio, profile.AvatarURL, profile.WebsiteURL, socialLinks, now, now)
+		} else {
+			_, err = tx.ExecContext(ctx, `
+				UPDATE author_profiles
+				SET handle = ?, bio = ?, avatar_url = ?, website_url = ?, social_links = ?, updated_at = ?
+				WHERE user_id = ?
+			`, profile.Handle, profile.Bio, profile.AvatarURL, profile.WebsiteURL, socialLinks, now, profile.UserID)
+		}
+		if err != nil {
+			if isDuplicateEntry(err) {
+				return ErrHandleAlreadyTaken
+			}
+			r.log.Error("Failed to save author profile",
+				slog.String("error", err.Error()),
+				slog.String("user_id", profile.UserID.String()),
+			)
+			return fmt.Errorf("%w: %w", ErrFailedToSaveAuthorProfile, err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	r.log.Info("Author profile saved successfully",
+		slog.String("user_id", profile.UserID.String()),
+		slog.String("handle", profile.Handle),
+	)
+
+	return nil
+}
@@ -0,0 +1,61 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/fikryfahrezy/let-it-go/feature/user/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSaveAuthorProfile(t *testing.T) {
+	setupTest(t)
+
+	ctx := context.Background()
+	user := createTestUser(t, "save-author@example.com")
+
+	err := testRepository.SaveAuthorProfile(ctx, repository.AuthorProfile{
+		UserID:      user.ID,
+		Handle:      "save-author",
+		Bio:         "First bio",
+		SocialLinks: map[string]string{"github": "save-author"},
+	})
+	require.NoError(t, err)
+
+	// Saving again replaces every field, including the handle
+	err = testRepository.SaveAuthorProfile(ctx, repository.AuthorProfile{
+		UserID: user.ID,
+		Handle: "renamed-author",
+		Bio:    "Second bio",
+	})
+	require.NoError(t, err)
+
+	stored, err := testRepository.GetAuthorProfile(ctx, user.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "renamed-author", stored.Handle)
+	assert.Equal(t, "Second bio", stored.Bio)
+	assert.Nil(t, stored.SocialLinks)
+
+	// The old handle is free again
+	_, err = testRepository.GetAuthorProfileByHandle(ctx, "save-author")
+	assert.Equal(t, repository.ErrAuthorProfileNotFound, err)
+}
+
+func TestSaveAuthorProfileHandleTaken(t *testing.T) {
+	setupTest(t)
+
+	ctx := context.Background()
+	owner := createTestUser(t, "save-author-owner@example.com")
+	other := createTestUser(t, "save-author-other@example.com")
+	require.NoError(t, testRepository.SaveAuthorProfile(ctx, repository.AuthorProfile{UserID: owner.ID, Handle: "taken", Bio: "Owner"}))
+
+	err := testRepository.SaveAuthorProfile(ctx, repository.AuthorProfile{UserID: other.ID, Handle: "taken", Bio: "Other"})
+	assert.Equal(t, repository.ErrHandleAlreadyTaken, err)
+
+	// The owner's profile is untouched
+	stored, err := testRepository.GetAuthorProfileByHandle(ctx, "taken")
+	require.NoError(t, err)
+	assert.Equal(t, owner.ID, stored.UserID)
+	assert.Equal(t, "Owner", stored.Bio)
+}
@@ -0,0 +1,37 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+)
+
+// SavePreferences stores the preferences of a user, replacing the document and schema
+// version of earlier ones
+func (r *userRepository) SavePreferences(ctx context.Context, preferences UserPreferences) error {
+	query := `
+		INSERT INTO user_preferences (user_id, schema_version, preferences, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?)
+		ON DUPLICATE KEY UPDATE
+			schema_version = VALUES(schema_version),
+			preferences = VALUES(preferences),
+			updated_at = VALUES(updated_at)
+	`
+
+	now := time.Now()
+	_, err := r.db.ExecContext(ctx, query, preferences.UserID, preferences.SchemaVersion, preferences.Document, now, now)
+	if err != nil {
+		r.log.Error("Failed to save user preferences",
+			slog.String("error", err.Error()),
+			slog.String("user_id", preferences.UserID.String()),
+		)
+		return fmt.Errorf("%w: %w", ErrFailedToSavePreferences, err)
+	}
+
+	r.log.Info("User preferences saved successfully",
+		slog.String("user_id", preferences.UserID.String()),
+	)
+
+	return nil
+}
@@ -0,0 +1,37 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/fikryfahrezy/let-it-go/feature/user/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSavePreferences(t *testing.T) {
+	setupTest(t)
+
+	ctx := context.Background()
+	user := createTestUser(t, "save-preferences@example.com")
+
+	err := testRepository.SavePreferences(ctx, repository.UserPreferences{
+		UserID:        user.ID,
+		SchemaVersion: 1,
+		Document:      []byte(`{"locale": "en"}`),
+	})
+	require.NoError(t, err)
+
+	// Saving again replaces the document and its schema version
+	err = testRepository.SavePreferences(ctx, repository.UserPreferences{
+		UserID:        user.ID,
+		SchemaVersion: 2,
+		Document:      []byte(`{"locale": "id"}`),
+	})
+	require.NoError(t, err)
+
+	stored, err := testRepository.GetPreferences(ctx, user.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 2, stored.SchemaVersion)
+	assert.JSONEq(t, `{"locale": "id"}`, string(stored.Document))
+}
@@ -0,0 +1,62 @@
+package repository_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/fikryfahrezy/let-it-go/feature/user/repository"
+	"github.com/fikryfahrezy/let-it-go/pkg/database"
+	"github.com/fikryfahrezy/let-it-go/pkg/logger"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSavePreferencesUnit(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	// nolint:errcheck
+	defer sqlDB.Close()
+
+	db := &database.DB{DB: sqlDB}
+	repo := repository.NewUserRepository(logger.NewDiscardLogger(), db)
+	ctx := context.Background()
+
+	preferences := repository.UserPreferences{
+		UserID:        uuid.New(),
+		SchemaVersion: 1,
+		Document:      []byte(`{"locale":"en"}`),
+	}
+
+	mock.ExpectExec("INSERT INTO user_preferences (.+) ON DUPLICATE KEY UPDATE").
+		WithArgs(preferences.UserID, preferences.SchemaVersion, preferences.Document, sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	err = repo.SavePreferences(ctx, preferences)
+	assert.NoError(t, err)
+
+	// Verify all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSavePreferencesErrorUnit(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	// nolint:errcheck
+	defer sqlDB.Close()
+
+	db := &database.DB{DB: sqlDB}
+	repo := repository.NewUserRepository(logger.NewDiscardLogger(), db)
+	ctx := context.Background()
+
+	mock.ExpectExec("INSERT INTO user_preferences").
+		WillReturnError(sql.ErrConnDone)
+
+	err = repo.SavePreferences(ctx, repository.UserPreferences{UserID: uuid.New(), SchemaVersion: 1, Document: []byte(`{}`)})
+	assert.ErrorIs(t, err, repository.ErrFailedToSavePreferences)
+
+	// Verify all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,50 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+)
+
+// SaveTwoFactorCredential stores a pending enrollment, replacing the secret of an
+// earlier unconfirmed one. A confirmed credential is never replaced; that is reported
+// as ErrTwoFactorAlreadyEnabled.
+func (r *userRepository) SaveTwoFactorCredential(ctx context.Context, credential TwoFactorCredential) error {
+	query := `
+		INSERT INTO two_factor_credentials (user_id, secret_ciphertext, created_at, updated_at)
+		VALUES (?, ?, ?, ?)
+		ON DUPLICATE KEY UPDATE
+			secret_ciphertext = IF(confirmed_at IS NULL, VALUES(secret_ciphertext), secret_ciphertext),
+			updated_at = IF(confirmed_at IS NULL, VALUES(updated_at), updated_at)
+	`
+
+	now := time.Now()
+	result, err := r.db.ExecContext(ctx, query, credential.UserID, credential.SecretCiphertext, now, now)
+	if err != nil {
+		r.log.Error("Failed to save two factor credential",
+			slog.String("error", err.Error()),
+			slog.String("user_id", credential.UserID.String()),
+		)
+		return fmt.Errorf("%w: %w", ErrFailedToSaveTwoFactorCredential, err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		r.log.Error("Failed to get rows affected",
+			slog.String("error", err.Error()),
+		)
+		return fmt.Errorf("%w: %w", ErrFailedToGetRowsAffected, err)
+	}
+
+	// An insert affects one row and a replaced secret two; nothing changes for a confirmed credential
+	if rowsAffected == 0 {
+		return ErrTwoFactorAlreadyEnabled
+	}
+
+	r.log.Info("Two factor credential saved successfully",
+		slog.String("user_id", credential.UserID.String()),
+	)
+
+	return nil
+}
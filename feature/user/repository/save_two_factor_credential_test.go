@@ -0,0 +1,54 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/fikryfahrezy/let-it-go/feature/user/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSaveTwoFactorCredential(t *testing.T) {
+	setupTest(t)
+
+	ctx := context.Background()
+	user := createTestUser(t, "save-2fa@example.com")
+
+	err := testRepository.SaveTwoFactorCredential(ctx, repository.TwoFactorCredential{
+		UserID:           user.ID,
+		SecretCiphertext: "first",
+	})
+	require.NoError(t, err)
+
+	// Enrolling again before confirming replaces the pending secret
+	err = testRepository.SaveTwoFactorCredential(ctx, repository.TwoFactorCredential{
+		UserID:           user.ID,
+		SecretCiphertext: "second",
+	})
+	require.NoError(t, err)
+
+	stored, err := testRepository.GetTwoFactorCredential(ctx, user.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "second", stored.SecretCiphertext)
+	assert.Nil(t, stored.ConfirmedAt)
+}
+
+func TestSaveTwoFactorCredentialAlreadyEnabled(t *testing.T) {
+	setupTest(t)
+
+	ctx := context.Background()
+	user := createTestUser(t, "save-2fa-enabled@example.com")
+	enableTestTwoFactor(t, user.ID)
+
+	err := testRepository.SaveTwoFactorCredential(ctx, repository.TwoFactorCredential{
+		UserID:           user.ID,
+		SecretCiphertext: "replacement",
+	})
+	assert.Equal(t, repository.ErrTwoFactorAlreadyEnabled, err)
+
+	stored, err := testRepository.GetTwoFactorCredential(ctx, user.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "ciphertext", stored.SecretCiphertext)
+	assert.NotNil(t, stored.ConfirmedAt)
+}
@@ -0,0 +1,82 @@
+package repository_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/fikryfahrezy/let-it-go/feature/user/repository"
+	"github.com/fikryfahrezy/let-it-go/pkg/database"
+	"github.com/fikryfahrezy/let-it-go/pkg/logger"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSaveTwoFactorCredentialUnit(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	// nolint:errcheck
+	defer sqlDB.Close()
+
+	db := &database.DB{DB: sqlDB}
+	repo := repository.NewUserRepository(logger.NewDiscardLogger(), db)
+	ctx := context.Background()
+
+	credential := repository.TwoFactorCredential{
+		UserID:           uuid.New(),
+		SecretCiphertext: "ciphertext",
+	}
+
+	mock.ExpectExec("INSERT INTO two_factor_credentials").
+		WithArgs(credential.UserID, credential.SecretCiphertext, sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	err = repo.SaveTwoFactorCredential(ctx, credential)
+	assert.NoError(t, err)
+
+	// Verify all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSaveTwoFactorCredentialAlreadyEnabledUnit(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	// nolint:errcheck
+	defer sqlDB.Close()
+
+	db := &database.DB{DB: sqlDB}
+	repo := repository.NewUserRepository(logger.NewDiscardLogger(), db)
+	ctx := context.Background()
+
+	// The confirmed row is left unchanged
+	mock.ExpectExec("INSERT INTO two_factor_credentials").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	err = repo.SaveTwoFactorCredential(ctx, repository.TwoFactorCredential{UserID: uuid.New(), SecretCiphertext: "ciphertext"})
+	assert.Equal(t, repository.ErrTwoFactorAlreadyEnabled, err)
+
+	// Verify all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSaveTwoFactorCredentialErrorUnit(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	// nolint:errcheck
+	defer sqlDB.Close()
+
+	db := &database.DB{DB: sqlDB}
+	repo := repository.NewUserRepository(logger.NewDiscardLogger(), db)
+	ctx := context.Background()
+
+	mock.ExpectExec("INSERT INTO two_factor_credentials").
+		WillReturnError(sql.ErrConnDone)
+
+	err = repo.SaveTwoFactorCredential(ctx, repository.TwoFactorCredential{UserID: uuid.New(), SecretCiphertext: "ciphertext"})
+	assert.ErrorIs(t, err, repository.ErrFailedToSaveTwoFactorCredential)
+
+	// Verify all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
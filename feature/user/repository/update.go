@@ -2,23 +2,58 @@ package repository
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 	"log/slog"
 	"time"
 )
 
 func (r *userRepository) Update(ctx context.Context, user User) error {
+	user.UpdatedAt = time.Now()
+
+	err := r.withTx(ctx, "update user", func(tx *sql.Tx) error {
+		return r.updateUser(ctx, tx, user)
+	})
+	if err != nil {
+		return err
+	}
+
+	r.log.Info("User updated successfully",
+		slog.String("user_id", user.ID.String()),
+	)
+
+	return nil
+}
+
+// updateUser sets the name, email and verification time of a user that is not deleted.
+// When the email changes the pending verification tokens are used up, so a link sent to
+// the old address cannot verify the new one. It must run in a transaction.
+func (r *userRepository) updateUser(ctx context.Context, db execer, user User) error {
+	_, err := db.ExecContext(ctx, `
+		UPDATE email_verification_tokens t
+		JOIN users u ON u.id = t.user_id
+		SET t.used_at = ?
+		WHERE u.id = ? AND u.email <> ? AND t.used_at IS NULL
+	`, user.UpdatedAt, user.ID, user.Email)
+	if err != nil {
+		r.log.Error("Failed to expire email verification tokens",
+			slog.String("error", err.Error()),
+			slog.String("user_id", user.ID.String()),
+		)
+		return fmt.Errorf("%w: %w", ErrFailedToUpdateUser, err)
+	}
+
 	query := `
 		UPDATE users
-		SET name = ?, email = ?, updated_at = ?
-		WHERE id = ?
+		SET name = ?, email = ?, verified_at = ?, updated_at = ?
+		WHERE id = ? AND deleted_at IS NULL
 	`
 
-	now := time.Now()
-	user.UpdatedAt = now
-
-	result, err := r.db.ExecContext(ctx, query, user.Name, user.Email, now, user.ID)
+	result, err := db.ExecContext(ctx, query, user.Name, user.Email, user.VerifiedAt, user.UpdatedAt, user.ID)
 	if err != nil {
+		if isDuplicateEntry(err) {
+			return ErrEmailAlreadyTaken
+		}
 		r.log.Error("Failed to update user",
 			slog.String("error", err.Error()),
 			slog.String("user_id", user.ID.String()),
@@ -38,9 +73,5 @@ func (r *userRepository) Update(ctx context.Context, user User) error {
 		return ErrUserNotFound
 	}
 
-	r.log.Info("User updated successfully",
-		slog.String("user_id", user.ID.String()),
-	)
-
 	return nil
 }
@@ -0,0 +1,47 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// UpdatePassword replaces the stored password hash without touching sessions,
+// used to re-hash a password with a stronger bcrypt cost after a login
+func (r *userRepository) UpdatePassword(ctx context.Context, userID uuid.UUID, passwordHash string) error {
+	query := `
+		UPDATE users
+		SET password = ?, updated_at = ?
+		WHERE id = ?
+	`
+
+	result, err := r.db.ExecContext(ctx, query, passwordHash, time.Now(), userID)
+	if err != nil {
+		r.log.Error("Failed to update password",
+			slog.String("error", err.Error()),
+			slog.String("user_id", userID.String()),
+		)
+		return fmt.Errorf("%w: %w", ErrFailedToUpdatePassword, err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		r.log.Error("Failed to get rows affected",
+			slog.String("error", err.Error()),
+		)
+		return fmt.Errorf("%w: %w", ErrFailedToGetRowsAffected, err)
+	}
+
+	if rowsAffected == 0 {
+		return ErrUserNotFound
+	}
+
+	r.log.Info("Password updated successfully",
+		slog.String("user_id", userID.String()),
+	)
+
+	return nil
+}
@@ -0,0 +1,40 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/fikryfahrezy/let-it-go/feature/user/repository"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpdatePassword(t *testing.T) {
+	setupTest(t)
+
+	ctx := context.Background()
+	user := createTestUser(t, "rehash@example.com")
+	session := newTestSession(user.ID, "hash-session", time.Hour)
+	require.NoError(t, testRepository.CreateSession(ctx, session))
+
+	err := testRepository.UpdatePassword(ctx, user.ID, "rehashed-password")
+	require.NoError(t, err)
+
+	updated, err := testRepository.GetByID(ctx, user.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "rehashed-password", updated.Password)
+
+	// Re-hashing must not sign the user out
+	result, err := testRepository.GetSessionByRefreshTokenHash(ctx, session.RefreshTokenHash)
+	require.NoError(t, err)
+	assert.Nil(t, result.RevokedAt)
+}
+
+func TestUpdatePasswordNotFound(t *testing.T) {
+	setupTest(t)
+
+	err := testRepository.UpdatePassword(context.Background(), uuid.New(), "rehashed-password")
+	assert.Equal(t, repository.ErrUserNotFound, err)
+}
@@ -0,0 +1,29 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func (r *userRepository) UpdatePersonalAccessTokenLastUsed(ctx context.Context, tokenID uuid.UUID, usedAt time.Time) error {
+	query := `
+		UPDATE personal_access_tokens
+		SET last_used_at = ?
+		WHERE id = ?
+	`
+
+	_, err := r.db.ExecContext(ctx, query, usedAt, tokenID)
+	if err != nil {
+		r.log.Error("Failed to update personal access token last used time",
+			slog.String("error", err.Error()),
+			slog.String("token_id", tokenID.String()),
+		)
+		return fmt.Errorf("%w: %w", ErrFailedToUpdatePersonalAccessToken, err)
+	}
+
+	return nil
+}
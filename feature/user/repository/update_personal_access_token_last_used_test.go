@@ -0,0 +1,28 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpdatePersonalAccessTokenLastUsed(t *testing.T) {
+	setupTest(t)
+
+	ctx := context.Background()
+	user := createTestUser(t, "last-used@example.com")
+	accessToken := newTestPersonalAccessToken(user.ID, "hash-last-used", "users:read")
+	require.NoError(t, testRepository.CreatePersonalAccessToken(ctx, accessToken))
+
+	usedAt := time.Now().Truncate(time.Second)
+	err := testRepository.UpdatePersonalAccessTokenLastUsed(ctx, accessToken.ID, usedAt)
+	require.NoError(t, err)
+
+	stored, err := testRepository.GetPersonalAccessTokenByHash(ctx, "hash-last-used")
+	require.NoError(t, err)
+	require.NotNil(t, stored.LastUsedAt)
+	assert.True(t, usedAt.Equal(*stored.LastUsedAt))
+}
@@ -0,0 +1,60 @@
+package repository_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/fikryfahrezy/let-it-go/feature/user/repository"
+	"github.com/fikryfahrezy/let-it-go/pkg/database"
+	"github.com/fikryfahrezy/let-it-go/pkg/logger"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpdatePersonalAccessTokenLastUsedUnit(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	// nolint:errcheck
+	defer sqlDB.Close()
+
+	db := &database.DB{DB: sqlDB}
+	repo := repository.NewUserRepository(logger.NewDiscardLogger(), db)
+	ctx := context.Background()
+
+	tokenID := uuid.New()
+	usedAt := time.Now()
+
+	mock.ExpectExec("UPDATE personal_access_tokens SET last_used_at").
+		WithArgs(usedAt, tokenID).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	err = repo.UpdatePersonalAccessTokenLastUsed(ctx, tokenID, usedAt)
+	assert.NoError(t, err)
+
+	// Verify all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUpdatePersonalAccessTokenLastUsedErrorUnit(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	// nolint:errcheck
+	defer sqlDB.Close()
+
+	db := &database.DB{DB: sqlDB}
+	repo := repository.NewUserRepository(logger.NewDiscardLogger(), db)
+	ctx := context.Background()
+
+	mock.ExpectExec("UPDATE personal_access_tokens SET last_used_at").
+		WillReturnError(sql.ErrConnDone)
+
+	err = repo.UpdatePersonalAccessTokenLastUsed(ctx, uuid.New(), time.Now())
+	assert.ErrorIs(t, err, repository.ErrFailedToUpdatePersonalAccessToken)
+
+	// Verify all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,46 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func (r *userRepository) UpdateRole(ctx context.Context, userID uuid.UUID, role string) error {
+	query := `
+		UPDATE users
+		SET role = ?, updated_at = ?
+		WHERE id = ?
+	`
+
+	result, err := r.db.ExecContext(ctx, query, role, time.Now(), userID)
+	if err != nil {
+		r.log.Error("Failed to update role",
+			slog.String("error", err.Error()),
+			slog.String("user_id", userID.String()),
+		)
+		return fmt.Errorf("%w: %w", ErrFailedToUpdateRole, err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		r.log.Error("Failed to get rows affected",
+			slog.String("error", err.Error()),
+		)
+		return fmt.Errorf("%w: %w", ErrFailedToGetRowsAffected, err)
+	}
+
+	if rowsAffected == 0 {
+		return ErrUserNotFound
+	}
+
+	r.log.Info("Role updated successfully",
+		slog.String("user_id", userID.String()),
+		slog.String("role", role),
+	)
+
+	return nil
+}
@@ -0,0 +1,33 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/fikryfahrezy/let-it-go/feature/user/repository"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpdateRole(t *testing.T) {
+	setupTest(t)
+
+	ctx := context.Background()
+	user := createTestUser(t, "role@example.com")
+	assert.Equal(t, "author", user.Role)
+
+	err := testRepository.UpdateRole(ctx, user.ID, "editor")
+	require.NoError(t, err)
+
+	updated, err := testRepository.GetByID(ctx, user.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "editor", updated.Role)
+}
+
+func TestUpdateRoleNotFound(t *testing.T) {
+	setupTest(t)
+
+	err := testRepository.UpdateRole(context.Background(), uuid.New(), "editor")
+	assert.Equal(t, repository.ErrUserNotFound, err)
+}
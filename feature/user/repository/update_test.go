@@ -3,6 +3,7 @@ package repository_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/fikryfahrezy/let-it-go/feature/user/repository"
 	"github.com/google/uuid"
@@ -53,3 +54,40 @@ func TestUpdateNotFound(t *testing.T) {
 	assert.Error(t, err)
 	assert.Equal(t, repository.ErrUserNotFound, err)
 }
+
+func TestUpdateEmailChangeExpiresVerificationTokens(t *testing.T) {
+	setupTest(t)
+
+	ctx := context.Background()
+	user := createTestUser(t, "old@example.com")
+	verificationToken := createTestEmailVerificationToken(t, user.Email, "hash-old-address", time.Hour)
+
+	user.Email = "new@example.com"
+	require.NoError(t, testRepository.Update(ctx, user))
+
+	// A link sent to the old address cannot verify the new one
+	err := testRepository.VerifyEmail(ctx, verificationToken.ID, user.ID)
+	assert.Equal(t, repository.ErrEmailVerificationTokenAlreadyUsed, err)
+
+	updated, err := testRepository.GetByID(ctx, user.ID)
+	require.NoError(t, err)
+	assert.Nil(t, updated.VerifiedAt)
+}
+
+func TestUpdateClearsVerification(t *testing.T) {
+	setupTest(t)
+
+	ctx := context.Background()
+	user := createTestUser(t, "verified@example.com")
+	verificationToken := createTestEmailVerificationToken(t, user.Email, "hash-verified", time.Hour)
+	require.NoError(t, testRepository.VerifyEmail(ctx, verificationToken.ID, user.ID))
+
+	user.Email = "changed@example.com"
+	user.VerifiedAt = nil
+	require.NoError(t, testRepository.Update(ctx, user))
+
+	updated, err := testRepository.GetByID(ctx, user.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "changed@example.com", updated.Email)
+	assert.Nil(t, updated.VerifiedAt)
+}
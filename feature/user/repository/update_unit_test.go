@@ -34,9 +34,14 @@ func TestUpdateUnit(t *testing.T) {
 	}
 
 	// Mock the UPDATE query
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE email_verification_tokens t JOIN users u ON u.id = t.user_id SET t.used_at").
+		WithArgs(sqlmock.AnyArg(), user.ID, user.Email).
+		WillReturnResult(sqlmock.NewResult(0, 0))
 	mock.ExpectExec("UPDATE users SET").
-		WithArgs(user.Name, user.Email, sqlmock.AnyArg(), user.ID).
+		WithArgs(user.Name, user.Email, user.VerifiedAt, sqlmock.AnyArg(), user.ID).
 		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
 
 	err = repo.Update(ctx, user)
 	assert.NoError(t, err)
@@ -63,9 +68,14 @@ func TestUpdateNotFoundUnit(t *testing.T) {
 	}
 
 	// Mock the UPDATE query to return 0 affected rows
+	mock.ExpectBegin()
+	mock.ExpectExec("UPDATE email_verification_tokens").
+		WithArgs(sqlmock.AnyArg(), user.ID, user.Email).
+		WillReturnResult(sqlmock.NewResult(0, 0))
 	mock.ExpectExec("UPDATE users SET").
-		WithArgs(user.Name, user.Email, sqlmock.AnyArg(), user.ID).
+		WithArgs(user.Name, user.Email, user.VerifiedAt, sqlmock.AnyArg(), user.ID).
 		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectRollback()
 
 	err = repo.Update(ctx, user)
 	assert.Error(t, err)
@@ -0,0 +1,47 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// UseRecoveryCode marks an unused recovery code of the user as used. Unknown codes,
+// codes of other users and already used ones are reported as ErrRecoveryCodeNotFound.
+func (r *userRepository) UseRecoveryCode(ctx context.Context, userID uuid.UUID, codeHash string) error {
+	query := `
+		UPDATE two_factor_recovery_codes
+		SET used_at = ?
+		WHERE user_id = ? AND code_hash = ? AND used_at IS NULL
+	`
+
+	result, err := r.db.ExecContext(ctx, query, time.Now(), userID, codeHash)
+	if err != nil {
+		r.log.Error("Failed to use recovery code",
+			slog.String("error", err.Error()),
+			slog.String("user_id", userID.String()),
+		)
+		return fmt.Errorf("%w: %w", ErrFailedToUseRecoveryCode, err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		r.log.Error("Failed to get rows affected",
+			slog.String("error", err.Error()),
+		)
+		return fmt.Errorf("%w: %w", ErrFailedToGetRowsAffected, err)
+	}
+
+	if rowsAffected == 0 {
+		return ErrRecoveryCodeNotFound
+	}
+
+	r.log.Info("Recovery code used",
+		slog.String("user_id", userID.String()),
+	)
+
+	return nil
+}
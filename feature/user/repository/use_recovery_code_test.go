@@ -0,0 +1,43 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/fikryfahrezy/let-it-go/feature/user/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUseRecoveryCode(t *testing.T) {
+	setupTest(t)
+
+	ctx := context.Background()
+	user := createTestUser(t, "use-recovery@example.com")
+	enableTestTwoFactor(t, user.ID, "code-hash-1", "code-hash-2")
+
+	err := testRepository.UseRecoveryCode(ctx, user.ID, "code-hash-1")
+	require.NoError(t, err)
+
+	// Each code works once
+	err = testRepository.UseRecoveryCode(ctx, user.ID, "code-hash-1")
+	assert.Equal(t, repository.ErrRecoveryCodeNotFound, err)
+
+	err = testRepository.UseRecoveryCode(ctx, user.ID, "code-hash-2")
+	assert.NoError(t, err)
+}
+
+func TestUseRecoveryCodeOfOtherUser(t *testing.T) {
+	setupTest(t)
+
+	ctx := context.Background()
+	owner := createTestUser(t, "recovery-owner@example.com")
+	otherUser := createTestUser(t, "recovery-other@example.com")
+	enableTestTwoFactor(t, owner.ID, "owner-code-hash")
+
+	err := testRepository.UseRecoveryCode(ctx, otherUser.ID, "owner-code-hash")
+	assert.Equal(t, repository.ErrRecoveryCodeNotFound, err)
+
+	// The owner can still use it
+	assert.NoError(t, testRepository.UseRecoveryCode(ctx, owner.ID, "owner-code-hash"))
+}
@@ -0,0 +1,78 @@
+package repository_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/fikryfahrezy/let-it-go/feature/user/repository"
+	"github.com/fikryfahrezy/let-it-go/pkg/database"
+	"github.com/fikryfahrezy/let-it-go/pkg/logger"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUseRecoveryCodeUnit(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	// nolint:errcheck
+	defer sqlDB.Close()
+
+	db := &database.DB{DB: sqlDB}
+	repo := repository.NewUserRepository(logger.NewDiscardLogger(), db)
+	ctx := context.Background()
+
+	userID := uuid.New()
+
+	mock.ExpectExec("UPDATE two_factor_recovery_codes SET used_at").
+		WithArgs(sqlmock.AnyArg(), userID, "code-hash").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	err = repo.UseRecoveryCode(ctx, userID, "code-hash")
+	assert.NoError(t, err)
+
+	// Verify all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUseRecoveryCodeNotFoundUnit(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	// nolint:errcheck
+	defer sqlDB.Close()
+
+	db := &database.DB{DB: sqlDB}
+	repo := repository.NewUserRepository(logger.NewDiscardLogger(), db)
+	ctx := context.Background()
+
+	mock.ExpectExec("UPDATE two_factor_recovery_codes SET used_at").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	err = repo.UseRecoveryCode(ctx, uuid.New(), "code-hash")
+	assert.Equal(t, repository.ErrRecoveryCodeNotFound, err)
+
+	// Verify all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUseRecoveryCodeErrorUnit(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	// nolint:errcheck
+	defer sqlDB.Close()
+
+	db := &database.DB{DB: sqlDB}
+	repo := repository.NewUserRepository(logger.NewDiscardLogger(), db)
+	ctx := context.Background()
+
+	mock.ExpectExec("UPDATE two_factor_recovery_codes SET used_at").
+		WillReturnError(sql.ErrConnDone)
+
+	err = repo.UseRecoveryCode(ctx, uuid.New(), "code-hash")
+	assert.ErrorIs(t, err, repository.ErrFailedToUseRecoveryCode)
+
+	// Verify all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
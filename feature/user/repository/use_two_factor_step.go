@@ -0,0 +1,45 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// UseTwoFactorStep records that a code of the given time step was accepted for the
+// user. Only steps after the last accepted one are recorded, so each code works once
+// even when two requests present it at the same time; a used or older step is reported
+// as ErrTwoFactorCodeAlreadyUsed.
+func (r *userRepository) UseTwoFactorStep(ctx context.Context, userID uuid.UUID, step int64) error {
+	query := `
+		UPDATE two_factor_credentials
+		SET last_used_step = ?, updated_at = ?
+		WHERE user_id = ? AND confirmed_at IS NOT NULL AND (last_used_step IS NULL OR last_used_step < ?)
+	`
+
+	result, err := r.db.ExecContext(ctx, query, step, time.Now(), userID, step)
+	if err != nil {
+		r.log.Error("Failed to record two factor code use",
+			slog.String("error", err.Error()),
+			slog.String("user_id", userID.String()),
+		)
+		return fmt.Errorf("%w: %w", ErrFailedToUseTwoFactorCode, err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		r.log.Error("Failed to get rows affected",
+			slog.String("error", err.Error()),
+		)
+		return fmt.Errorf("%w: %w", ErrFailedToGetRowsAffected, err)
+	}
+
+	if rowsAffected == 0 {
+		return ErrTwoFactorCodeAlreadyUsed
+	}
+
+	return nil
+}
@@ -0,0 +1,43 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/fikryfahrezy/let-it-go/feature/user/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUseTwoFactorStep(t *testing.T) {
+	setupTest(t)
+
+	ctx := context.Background()
+	user := createTestUser(t, "use-2fa-step@example.com")
+	// Enabling records step 100 as used
+	enableTestTwoFactor(t, user.ID)
+
+	err := testRepository.UseTwoFactorStep(ctx, user.ID, 101)
+	require.NoError(t, err)
+
+	// The same step and earlier ones cannot be used again
+	err = testRepository.UseTwoFactorStep(ctx, user.ID, 101)
+	assert.Equal(t, repository.ErrTwoFactorCodeAlreadyUsed, err)
+	err = testRepository.UseTwoFactorStep(ctx, user.ID, 100)
+	assert.Equal(t, repository.ErrTwoFactorCodeAlreadyUsed, err)
+}
+
+func TestUseTwoFactorStepPendingCredential(t *testing.T) {
+	setupTest(t)
+
+	ctx := context.Background()
+	user := createTestUser(t, "use-2fa-step-pending@example.com")
+	require.NoError(t, testRepository.SaveTwoFactorCredential(ctx, repository.TwoFactorCredential{
+		UserID:           user.ID,
+		SecretCiphertext: "ciphertext",
+	}))
+
+	// Codes only count once the enrollment is confirmed
+	err := testRepository.UseTwoFactorStep(ctx, user.ID, 1)
+	assert.Equal(t, repository.ErrTwoFactorCodeAlreadyUsed, err)
+}
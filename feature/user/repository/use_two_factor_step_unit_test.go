@@ -0,0 +1,78 @@
+package repository_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/fikryfahrezy/let-it-go/feature/user/repository"
+	"github.com/fikryfahrezy/let-it-go/pkg/database"
+	"github.com/fikryfahrezy/let-it-go/pkg/logger"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUseTwoFactorStepUnit(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	// nolint:errcheck
+	defer sqlDB.Close()
+
+	db := &database.DB{DB: sqlDB}
+	repo := repository.NewUserRepository(logger.NewDiscardLogger(), db)
+	ctx := context.Background()
+
+	userID := uuid.New()
+
+	mock.ExpectExec("UPDATE two_factor_credentials SET last_used_step").
+		WithArgs(int64(9), sqlmock.AnyArg(), userID, int64(9)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	err = repo.UseTwoFactorStep(ctx, userID, 9)
+	assert.NoError(t, err)
+
+	// Verify all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUseTwoFactorStepAlreadyUsedUnit(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	// nolint:errcheck
+	defer sqlDB.Close()
+
+	db := &database.DB{DB: sqlDB}
+	repo := repository.NewUserRepository(logger.NewDiscardLogger(), db)
+	ctx := context.Background()
+
+	mock.ExpectExec("UPDATE two_factor_credentials SET last_used_step").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	err = repo.UseTwoFactorStep(ctx, uuid.New(), 9)
+	assert.Equal(t, repository.ErrTwoFactorCodeAlreadyUsed, err)
+
+	// Verify all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUseTwoFactorStepErrorUnit(t *testing.T) {
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	// nolint:errcheck
+	defer sqlDB.Close()
+
+	db := &database.DB{DB: sqlDB}
+	repo := repository.NewUserRepository(logger.NewDiscardLogger(), db)
+	ctx := context.Background()
+
+	mock.ExpectExec("UPDATE two_factor_credentials SET last_used_step").
+		WillReturnError(sql.ErrConnDone)
+
+	err = repo.UseTwoFactorStep(ctx, uuid.New(), 9)
+	assert.ErrorIs(t, err, repository.ErrFailedToUseTwoFactorCode)
+
+	// Verify all expectations were met
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
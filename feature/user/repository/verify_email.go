@@ -0,0 +1,69 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// VerifyEmail consumes the verification token and marks the user's email as verified
+// in one transaction. The token is only consumed when it is unused and unexpired.
+func (r *userRepository) VerifyEmail(ctx context.Context, verificationTokenID uuid.UUID, userID uuid.UUID) error {
+	err := r.withTx(ctx, "verify email", func(tx *sql.Tx) error {
+		now := time.Now()
+
+		result, err := tx.ExecContext(ctx, `
+			UPDATE email_verification_tokens
+			SET used_at = ?
+			WHERE id = ? AND user_id = ? AND used_at IS NULL AND expires_at > ?
+		`, now, verificationTokenID, userID, now)
+		if err != nil {
+			r.log.Error("Failed to consume email verification token",
+				slog.String("error", err.Error()),
+				slog.String("user_id", userID.String()),
+			)
+			return fmt.Errorf("%w: %w", ErrFailedToVerifyEmail, err)
+		}
+
+		rowsAffected, err := result.RowsAffected()
+		if err != nil {
+			r.log.Error("Failed to get rows affected",
+				slog.String("error", err.Error()),
+			)
+			return fmt.Errorf("%w: %w", ErrFailedToGetRowsAffected, err)
+		}
+
+		if rowsAffected == 0 {
+			return ErrEmailVerificationTokenAlreadyUsed
+		}
+
+		// Keep the original verification time when the address was already confirmed
+		_, err = tx.ExecContext(ctx, `
+			UPDATE users
+			SET verified_at = ?, updated_at = ?
+			WHERE id = ? AND verified_at IS NULL
+		`, now, now, userID)
+		if err != nil {
+			r.log.Error("Failed to mark email as verified",
+				slog.String("error", err.Error()),
+				slog.String("user_id", userID.String()),
+			)
+			return fmt.Errorf("%w: %w", ErrFailedToVerifyEmail, err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	r.log.Info("Email verified successfully",
+		slog.String("user_id", userID.String()),
+	)
+
+	return nil
+}
@@ -0,0 +1,60 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/fikryfahrezy/let-it-go/feature/user/repository"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createTestEmailVerificationToken(t *testing.T, email string, tokenHash string, ttl time.Duration) repository.EmailVerificationToken {
+	t.Helper()
+
+	verificationToken := repository.EmailVerificationToken{
+		ID:        uuid.Must(uuid.NewV7()),
+		TokenHash: tokenHash,
+		ExpiresAt: time.Now().Add(ttl),
+	}
+	require.NoError(t, testRepository.CreateEmailVerificationToken(context.Background(), email, verificationToken))
+
+	return verificationToken
+}
+
+func TestVerifyEmail(t *testing.T) {
+	setupTest(t)
+
+	ctx := context.Background()
+	user := createTestUser(t, "verify@example.com")
+	assert.Nil(t, user.VerifiedAt)
+	verificationToken := createTestEmailVerificationToken(t, user.Email, "hash-verify", time.Hour)
+
+	err := testRepository.VerifyEmail(ctx, verificationToken.ID, user.ID)
+	require.NoError(t, err)
+
+	verified, err := testRepository.GetByID(ctx, user.ID)
+	require.NoError(t, err)
+	assert.NotNil(t, verified.VerifiedAt)
+
+	// The token cannot be used a second time
+	err = testRepository.VerifyEmail(ctx, verificationToken.ID, user.ID)
+	assert.Equal(t, repository.ErrEmailVerificationTokenAlreadyUsed, err)
+}
+
+func TestVerifyEmailTokenExpired(t *testing.T) {
+	setupTest(t)
+
+	ctx := context.Background()
+	user := createTestUser(t, "verify@example.com")
+	verificationToken := createTestEmailVerificationToken(t, user.Email, "hash-verify", -time.Minute)
+
+	err := testRepository.VerifyEmail(ctx, verificationToken.ID, user.ID)
+	assert.Equal(t, repository.ErrEmailVerificationTokenAlreadyUsed, err)
+
+	unverified, err := testRepository.GetByID(ctx, user.ID)
+	require.NoError(t, err)
+	assert.Nil(t, unverified.VerifiedAt)
+}
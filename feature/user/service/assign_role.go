@@ -0,0 +1,63 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/fikryfahrezy/let-it-go/pkg/http_server"
+	"github.com/fikryfahrezy/let-it-go/pkg/rbac"
+	"github.com/google/uuid"
+)
+
+// AssignRole changes the role of a user. The new role is carried by the user's access
+// tokens from their next login or token refresh on.
+func (s *userService) AssignRole(ctx context.Context, userID uuid.UUID, req AssignRoleRequest) (GetUserResponse, error) {
+	principal, ok := http_server.PrincipalFromContext(ctx)
+	if !ok || !rbac.Can(principal, rbac.PermissionUsersManage) {
+		s.log.Warn("Role assignment denied",
+			slog.String("user_id", userID.String()),
+			slog.String("caller_id", principal.UserID.String()),
+		)
+		return GetUserResponse{}, ErrUserForbidden
+	}
+
+	// Stops the last admin from locking everyone out by demoting themselves
+	if principal.UserID == userID {
+		return GetUserResponse{}, ErrCannotChangeOwnRole
+	}
+
+	if !rbac.IsValidRole(req.Role) {
+		return GetUserResponse{}, ErrInvalidRole
+	}
+
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return GetUserResponse{}, err
+	}
+
+	if err := s.userRepo.UpdateRole(ctx, userID, req.Role); err != nil {
+		return GetUserResponse{}, err
+	}
+	user.Role = req.Role
+
+	s.log.Info("Role assigned successfully",
+		slog.String("user_id", userID.String()),
+		slog.String("role", req.Role),
+		slog.String("caller_id", principal.UserID.String()),
+	)
+
+	return ToGetUserResponse(user), nil
+}
+
+// ListRoles describes every role and the permissions it grants
+func (s *userService) ListRoles(ctx context.Context) []RoleResponse {
+	roles := rbac.Roles()
+	response := make([]RoleResponse, 0, len(roles))
+	for _, role := range roles {
+		response = append(response, RoleResponse{
+			Name:        role,
+			Permissions: rbac.Permissions(role),
+		})
+	}
+	return response
+}
@@ -0,0 +1,107 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/fikryfahrezy/let-it-go/feature/user/repository"
+	"github.com/fikryfahrezy/let-it-go/feature/user/repository/repositoryfakes"
+	"github.com/fikryfahrezy/let-it-go/feature/user/service"
+	"github.com/fikryfahrezy/let-it-go/pkg/http_server"
+	"github.com/fikryfahrezy/let-it-go/pkg/logger"
+	"github.com/fikryfahrezy/let-it-go/pkg/rbac"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func adminContext() context.Context {
+	return http_server.ContextWithPrincipal(context.Background(), http_server.Principal{
+		UserID: uuid.New(),
+		Roles:  []string{rbac.RoleAdmin},
+	})
+}
+
+func TestUserService_AssignRole_Success(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeUserRepository{}
+	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{}, service.Config{})
+
+	userID := uuid.New()
+	mockRepo.GetByIDReturns(repository.User{ID: userID, Name: "John Doe", Role: rbac.RoleAuthor}, nil)
+
+	result, err := userService.AssignRole(adminContext(), userID, service.AssignRoleRequest{Role: rbac.RoleEditor})
+
+	require.NoError(t, err)
+	assert.Equal(t, userID, result.ID)
+	assert.Equal(t, rbac.RoleEditor, result.Role)
+
+	require.Equal(t, 1, mockRepo.UpdateRoleCallCount())
+	_, actualID, actualRole := mockRepo.UpdateRoleArgsForCall(0)
+	assert.Equal(t, userID, actualID)
+	assert.Equal(t, rbac.RoleEditor, actualRole)
+}
+
+func TestUserService_AssignRole_NotAdmin(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeUserRepository{}
+	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{}, service.Config{})
+
+	ctx := http_server.ContextWithPrincipal(context.Background(), http_server.Principal{
+		UserID: uuid.New(),
+		Roles:  []string{rbac.RoleEditor},
+	})
+
+	_, err := userService.AssignRole(ctx, uuid.New(), service.AssignRoleRequest{Role: rbac.RoleAdmin})
+
+	assert.Equal(t, service.ErrUserForbidden, err)
+	assert.Equal(t, 0, mockRepo.UpdateRoleCallCount())
+}
+
+func TestUserService_AssignRole_OwnRole(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeUserRepository{}
+	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{}, service.Config{})
+
+	adminID := uuid.New()
+	ctx := http_server.ContextWithPrincipal(context.Background(), http_server.Principal{
+		UserID: adminID,
+		Roles:  []string{rbac.RoleAdmin},
+	})
+
+	_, err := userService.AssignRole(ctx, adminID, service.AssignRoleRequest{Role: rbac.RoleReader})
+
+	assert.Equal(t, service.ErrCannotChangeOwnRole, err)
+	assert.Equal(t, 0, mockRepo.UpdateRoleCallCount())
+}
+
+func TestUserService_AssignRole_InvalidRole(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeUserRepository{}
+	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{}, service.Config{})
+
+	_, err := userService.AssignRole(adminContext(), uuid.New(), service.AssignRoleRequest{Role: "superuser"})
+
+	assert.Equal(t, service.ErrInvalidRole, err)
+	assert.Equal(t, 0, mockRepo.UpdateRoleCallCount())
+}
+
+func TestUserService_AssignRole_UserNotFound(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeUserRepository{}
+	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{}, service.Config{})
+
+	mockRepo.GetByIDReturns(repository.User{}, repository.ErrUserNotFound)
+
+	_, err := userService.AssignRole(adminContext(), uuid.New(), service.AssignRoleRequest{Role: rbac.RoleEditor})
+
+	assert.Equal(t, repository.ErrUserNotFound, err)
+	assert.Equal(t, 0, mockRepo.UpdateRoleCallCount())
+}
+
+func TestUserService_ListRoles(t *testing.T) {
+	userService := service.NewUserService(logger.NewDiscardLogger(), &repositoryfakes.FakeUserRepository{}, service.Dependencies{}, service.Config{})
+
+	roles := userService.ListRoles(context.Background())
+
+	require.Len(t, roles, 4)
+	assert.Equal(t, rbac.RoleAdmin, roles[0].Name)
+	assert.Contains(t, roles[0].Permissions, rbac.PermissionUsersManage)
+	assert.Equal(t, rbac.RoleReader, roles[3].Name)
+	assert.Empty(t, roles[3].Permissions)
+}
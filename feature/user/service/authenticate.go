@@ -0,0 +1,69 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/fikryfahrezy/let-it-go/feature/user/repository"
+	"golang.org/x/crypto/bcrypt"
+)
+
+func (s *userService) Authenticate(ctx context.Context, req AuthenticateRequest) (AuthenticateResponse, error) {
+	s.log.Info("Authenticating user",
+		slog.String("email", req.Email),
+	)
+
+	// Unknown emails are tracked and locked out too, so lockouts do not reveal which accounts exist
+	if err := s.checkLockout(ctx, req.Email, req.IPAddress); err != nil {
+		return AuthenticateResponse{}, err
+	}
+
+	user, err := s.userRepo.GetByEmail(ctx, req.Email)
+	if err != nil {
+		if !errors.Is(err, repository.ErrUserNotFound) {
+			return AuthenticateResponse{}, err
+		}
+		_ = bcrypt.CompareHashAndPassword(s.dummyPasswordHash(), []byte(req.Password))
+		s.log.Warn("Authentication failed, unknown email",
+			slog.String("email", req.Email),
+		)
+		s.recordFailedAttempt(ctx, req.Email, req.IPAddress)
+		return AuthenticateResponse{}, ErrInvalidCredentials
+	}
+
+	if err := bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(req.Password)); err != nil {
+		s.log.Warn("Authentication failed, wrong password",
+			slog.String("user_id", user.ID.String()),
+		)
+		s.recordFailedAttempt(ctx, req.Email, req.IPAddress)
+		return AuthenticateResponse{}, ErrInvalidCredentials
+	}
+
+	s.rehashPasswordIfNeeded(ctx, user, req.Password)
+
+	challenge, setupRequired, err := s.twoFactorChallengeFor(ctx, user)
+	if err != nil {
+		return AuthenticateResponse{}, err
+	}
+	if challenge != nil {
+		s.log.Info("Password accepted, two factor verification required",
+			slog.String("user_id", user.ID.String()),
+		)
+		return AuthenticateResponse{TwoFactorChallenge: challenge}, nil
+	}
+	// Failed attempts of an account with a second factor are only forgotten by
+	// VerifyTwoFactor, so logging in again between wrong codes keeps them counted
+	s.recordSuccessfulAttempt(ctx, req.Email)
+
+	response, err := s.startSession(ctx, user, setupRequired, req.UserAgent, req.IPAddress)
+	if err != nil {
+		return AuthenticateResponse{}, err
+	}
+
+	s.log.Info("User authenticated successfully",
+		slog.String("user_id", user.ID.String()),
+	)
+
+	return response, nil
+}
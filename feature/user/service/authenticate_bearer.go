@@ -0,0 +1,74 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/fikryfahrezy/let-it-go/feature/user/repository"
+	"github.com/fikryfahrezy/let-it-go/pkg/http_server"
+	"github.com/fikryfahrezy/let-it-go/pkg/token"
+)
+
+// personalAccessTokenLastUsedInterval limits how often using a token writes its last used time
+const personalAccessTokenLastUsedInterval = time.Minute
+
+// AuthenticateBearer implements http_server.Authenticator. Personal access tokens
+// authenticate as their owner with the owner's current role, limited to the token's
+// scopes; any other bearer token is verified as an access token.
+func (s *userService) AuthenticateBearer(ctx context.Context, bearerToken string) (http_server.Principal, error) {
+	if !strings.HasPrefix(bearerToken, PersonalAccessTokenPrefix) {
+		return s.tokenManager.Authenticate(ctx, bearerToken)
+	}
+
+	accessToken, err := s.userRepo.GetPersonalAccessTokenByHash(ctx, token.Hash(bearerToken))
+	if err != nil {
+		if errors.Is(err, repository.ErrPersonalAccessTokenNotFound) {
+			return http_server.Principal{}, ErrInvalidPersonalAccessToken
+		}
+		return http_server.Principal{}, err
+	}
+
+	now := time.Now()
+	if accessToken.RevokedAt != nil || (accessToken.ExpiresAt != nil && !accessToken.ExpiresAt.After(now)) {
+		return http_server.Principal{}, ErrInvalidPersonalAccessToken
+	}
+
+	user, err := s.userRepo.GetByID(ctx, accessToken.UserID)
+	if err != nil {
+		if errors.Is(err, repository.ErrUserNotFound) {
+			return http_server.Principal{}, ErrInvalidPersonalAccessToken
+		}
+		return http_server.Principal{}, err
+	}
+
+	if accessToken.LastUsedAt == nil || now.Sub(*accessToken.LastUsedAt) >= personalAccessTokenLastUsedInterval {
+		// Recording usage is best effort and never fails the request
+		if err := s.userRepo.UpdatePersonalAccessTokenLastUsed(ctx, accessToken.ID, now); err != nil {
+			s.log.Warn("Failed to record personal access token usage",
+				slog.String("error", err.Error()),
+				slog.String("token_id", accessToken.ID.String()),
+			)
+		}
+	}
+
+	scopes := accessToken.Scopes
+	if scopes == nil {
+		scopes = []string{}
+	}
+
+	// A token created before the role required a second factor must not get around it
+	setupRequired, err := s.twoFactorSetupRequired(ctx, user)
+	if err != nil {
+		return http_server.Principal{}, err
+	}
+
+	return http_server.Principal{
+		UserID:                 user.ID,
+		Roles:                  []string{user.Role},
+		Scopes:                 scopes,
+		TwoFactorSetupRequired: setupRequired,
+	}, nil
+}
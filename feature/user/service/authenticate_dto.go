@@ -0,0 +1,30 @@
+package service
+
+import "time"
+
+const TokenTypeBearer = "Bearer"
+
+type AuthenticateRequest struct {
+	Email    string `json:"email" validate:"required,email"`
+	Password string `json:"password" validate:"required"`
+
+	// Filled by the handler from the HTTP request to describe the new session
+	UserAgent string `json:"-"`
+	IPAddress string `json:"-"`
+}
+
+type AuthenticateResponse struct {
+	AccessToken      string    `json:"access_token"`
+	TokenType        string    `json:"token_type"`
+	ExpiresAt        time.Time `json:"expires_at"`
+	RefreshToken     string    `json:"refresh_token"`
+	RefreshExpiresAt time.Time `json:"refresh_expires_at"`
+	// TwoFactorSetupRequired is set when the user's role is expected to use two factor
+	// authentication but the user has not enrolled yet. The access token is then granted no
+	// permission until the user enrolls and refreshes it.
+	TwoFactorSetupRequired bool `json:"two_factor_setup_required,omitempty"`
+
+	// TwoFactorChallenge is set instead of the tokens when the password was right but a
+	// second factor is still needed; the handler responds with it alone
+	TwoFactorChallenge *TwoFactorChallengeResponse `json:"-"`
+}
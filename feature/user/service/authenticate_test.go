@@ -0,0 +1,242 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/fikryfahrezy/let-it-go/feature/user/repository"
+	"github.com/fikryfahrezy/let-it-go/feature/user/repository/repositoryfakes"
+	"github.com/fikryfahrezy/let-it-go/feature/user/service"
+	"github.com/fikryfahrezy/let-it-go/pkg/logger"
+	"github.com/fikryfahrezy/let-it-go/pkg/rbac"
+	"github.com/fikryfahrezy/let-it-go/pkg/token"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+)
+
+func newTestTokenManager(t *testing.T) *token.Manager {
+	t.Helper()
+
+	tokenManager, err := token.NewManager(token.Config{
+		Issuer:          "let-it-go-test",
+		AccessTokenTTL:  15 * time.Minute,
+		RefreshTokenTTL: 24 * time.Hour,
+		Algorithm:       token.AlgorithmHS256,
+		HMACSecret:      "test-secret",
+	})
+	require.NoError(t, err)
+
+	return tokenManager
+}
+
+func TestUserService_Authenticate_Success(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeUserRepository{}
+	tokenManager := newTestTokenManager(t)
+	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{TokenManager: tokenManager}, service.Config{})
+	ctx := context.Background()
+
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte("password123"), bcrypt.MinCost)
+	require.NoError(t, err)
+
+	existingUser := repository.User{
+		ID:       uuid.New(),
+		Name:     "John Doe",
+		Email:    "john@example.com",
+		Password: string(hashedPassword),
+		Role:     rbac.RoleEditor,
+	}
+	mockRepo.GetByEmailReturns(existingUser, nil)
+
+	result, err := userService.Authenticate(ctx, service.AuthenticateRequest{
+		Email:     "john@example.com",
+		Password:  "password123",
+		UserAgent: "test-agent",
+		IPAddress: "127.0.0.1",
+	})
+
+	assert.NoError(t, err)
+	assert.NotEmpty(t, result.AccessToken)
+	assert.Equal(t, service.TokenTypeBearer, result.TokenType)
+	assert.True(t, result.ExpiresAt.After(time.Now()))
+	assert.NotEmpty(t, result.RefreshToken)
+	assert.True(t, result.RefreshExpiresAt.After(result.ExpiresAt))
+
+	// Verify repository calls
+	assert.Equal(t, 1, mockRepo.GetByEmailCallCount())
+	_, actualEmail := mockRepo.GetByEmailArgsForCall(0)
+	assert.Equal(t, "john@example.com", actualEmail)
+
+	// A new session family is stored with the hashed refresh token
+	require.Equal(t, 1, mockRepo.CreateSessionCallCount())
+	_, session := mockRepo.CreateSessionArgsForCall(0)
+	assert.Equal(t, existingUser.ID, session.UserID)
+	assert.Equal(t, session.ID, session.FamilyID)
+	assert.Equal(t, token.Hash(result.RefreshToken), session.RefreshTokenHash)
+	assert.Equal(t, "test-agent", session.UserAgent)
+	assert.Equal(t, "127.0.0.1", session.IPAddress)
+
+	// The issued token must verify and carry the user and session
+	claims, err := tokenManager.Verify(result.AccessToken)
+	require.NoError(t, err)
+	assert.Equal(t, existingUser.ID.String(), claims.Subject)
+	assert.Equal(t, existingUser.Email, claims.Email)
+	assert.Equal(t, []string{rbac.RoleEditor}, claims.Roles)
+	assert.Equal(t, session.FamilyID.String(), claims.SessionID)
+}
+
+func TestUserService_Authenticate_WrongPassword(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeUserRepository{}
+	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{TokenManager: newTestTokenManager(t)}, service.Config{})
+	ctx := context.Background()
+
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte("password123"), bcrypt.MinCost)
+	require.NoError(t, err)
+
+	mockRepo.GetByEmailReturns(repository.User{
+		ID:       uuid.New(),
+		Email:    "john@example.com",
+		Password: string(hashedPassword),
+	}, nil)
+
+	result, err := userService.Authenticate(ctx, service.AuthenticateRequest{
+		Email:    "john@example.com",
+		Password: "wrong-password",
+	})
+
+	assert.Error(t, err)
+	assert.Equal(t, service.ErrInvalidCredentials, err)
+	assert.Equal(t, service.AuthenticateResponse{}, result)
+
+	// No session is created for a failed login
+	assert.Equal(t, 0, mockRepo.CreateSessionCallCount())
+}
+
+func TestUserService_Authenticate_UnknownEmail(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeUserRepository{}
+	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{TokenManager: newTestTokenManager(t)}, service.Config{})
+	ctx := context.Background()
+
+	mockRepo.GetByEmailReturns(repository.User{}, repository.ErrUserNotFound)
+
+	result, err := userService.Authenticate(ctx, service.AuthenticateRequest{
+		Email:    "nobody@example.com",
+		Password: "password123",
+	})
+
+	// Unknown emails must be indistinguishable from wrong passwords
+	assert.Error(t, err)
+	assert.Equal(t, service.ErrInvalidCredentials, err)
+	assert.Equal(t, service.AuthenticateResponse{}, result)
+}
+
+func TestUserService_Authenticate_RepositoryError(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeUserRepository{}
+	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{TokenManager: newTestTokenManager(t)}, service.Config{})
+	ctx := context.Background()
+
+	dbError := errors.New("database connection error")
+	mockRepo.GetByEmailReturns(repository.User{}, dbError)
+
+	result, err := userService.Authenticate(ctx, service.AuthenticateRequest{
+		Email:    "john@example.com",
+		Password: "password123",
+	})
+
+	assert.Error(t, err)
+	assert.Equal(t, dbError, err)
+	assert.Equal(t, service.AuthenticateResponse{}, result)
+}
+
+func TestUserService_Authenticate_CreateSessionError(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeUserRepository{}
+	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{TokenManager: newTestTokenManager(t)}, service.Config{})
+	ctx := context.Background()
+
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte("password123"), bcrypt.MinCost)
+	require.NoError(t, err)
+
+	mockRepo.GetByEmailReturns(repository.User{
+		ID:       uuid.New(),
+		Email:    "john@example.com",
+		Password: string(hashedPassword),
+	}, nil)
+	mockRepo.CreateSessionReturns(repository.ErrFailedToCreateSession)
+
+	result, err := userService.Authenticate(ctx, service.AuthenticateRequest{
+		Email:    "john@example.com",
+		Password: "password123",
+	})
+
+	assert.ErrorIs(t, err, repository.ErrFailedToCreateSession)
+	assert.Equal(t, service.AuthenticateResponse{}, result)
+}
+
+func TestUserService_Authenticate_UpgradesPasswordHash(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeUserRepository{}
+	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{TokenManager: newTestTokenManager(t)}, service.Config{
+		BcryptCost: bcrypt.MinCost + 1,
+	})
+	ctx := context.Background()
+
+	user := newTestUserWithPassword(t, "password123", bcrypt.MinCost)
+	mockRepo.GetByEmailReturns(user, nil)
+
+	_, err := userService.Authenticate(ctx, service.AuthenticateRequest{
+		Email:    user.Email,
+		Password: "password123",
+	})
+	require.NoError(t, err)
+
+	// The stored hash had a lower cost than configured, so it is replaced
+	require.Equal(t, 1, mockRepo.UpdatePasswordCallCount())
+	_, actualUserID, passwordHash := mockRepo.UpdatePasswordArgsForCall(0)
+	assert.Equal(t, user.ID, actualUserID)
+	assert.NoError(t, bcrypt.CompareHashAndPassword([]byte(passwordHash), []byte("password123")))
+	cost, err := bcrypt.Cost([]byte(passwordHash))
+	require.NoError(t, err)
+	assert.Equal(t, bcrypt.MinCost+1, cost)
+}
+
+func TestUserService_Authenticate_KeepsCurrentPasswordHash(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeUserRepository{}
+	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{TokenManager: newTestTokenManager(t)}, service.Config{
+		BcryptCost: bcrypt.MinCost,
+	})
+	ctx := context.Background()
+
+	user := newTestUserWithPassword(t, "password123", bcrypt.MinCost)
+	mockRepo.GetByEmailReturns(user, nil)
+
+	_, err := userService.Authenticate(ctx, service.AuthenticateRequest{
+		Email:    user.Email,
+		Password: "password123",
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, 0, mockRepo.UpdatePasswordCallCount())
+}
+
+func TestUserService_Authenticate_UpgradeFailureDoesNotFailLogin(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeUserRepository{}
+	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{TokenManager: newTestTokenManager(t)}, service.Config{
+		BcryptCost: bcrypt.MinCost + 1,
+	})
+	ctx := context.Background()
+
+	user := newTestUserWithPassword(t, "password123", bcrypt.MinCost)
+	mockRepo.GetByEmailReturns(user, nil)
+	mockRepo.UpdatePasswordReturns(repository.ErrFailedToUpdatePassword)
+
+	result, err := userService.Authenticate(ctx, service.AuthenticateRequest{
+		Email:    user.Email,
+		Password: "password123",
+	})
+
+	require.NoError(t, err)
+	assert.NotEmpty(t, result.AccessToken)
+	assert.Equal(t, 1, mockRepo.CreateSessionCallCount())
+}
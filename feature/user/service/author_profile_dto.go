@@ -0,0 +1,48 @@
+package service
+
+import (
+	"time"
+
+	"github.com/fikryfahrezy/let-it-go/feature/user/repository"
+	"github.com/google/uuid"
+)
+
+// UpdateAuthorProfileRequest replaces the whole author profile of a user
+type UpdateAuthorProfileRequest struct {
+	// Handle is the URL safe name of the author page, /v1/authors/{handle}
+	Handle     string `json:"handle" validate:"required,min=3,max=30,slug"`
+	Bio        string `json:"bio" validate:"max=500"`
+	AvatarURL  string `json:"avatar_url" validate:"omitempty,max=2048,http_url"`
+	WebsiteURL string `json:"website_url" validate:"omitempty,max=2048,http_url"`
+	// SocialLinks are the author's handles keyed by network
+	SocialLinks map[string]string `json:"social_links" validate:"max=10,dive,keys,oneof=github x linkedin mastodon bluesky instagram youtube,endkeys,required,max=100"`
+}
+
+// AuthorResponse is the public profile of an author. It never includes the email address.
+type AuthorResponse struct {
+	ID          uuid.UUID         `json:"id"`
+	Handle      string            `json:"handle"`
+	Name        string            `json:"name"`
+	Bio         string            `json:"bio"`
+	AvatarURL   string            `json:"avatar_url"`
+	WebsiteURL  string            `json:"website_url"`
+	SocialLinks map[string]string `json:"social_links"`
+	JoinedAt    time.Time         `json:"joined_at"`
+}
+
+func ToAuthorResponse(u repository.User, p repository.AuthorProfile) AuthorResponse {
+	socialLinks := p.SocialLinks
+	if socialLinks == nil {
+		socialLinks = map[string]string{}
+	}
+	return AuthorResponse{
+		ID:          u.ID,
+		Handle:      p.Handle,
+		Name:        u.Name,
+		Bio:         p.Bio,
+		AvatarURL:   p.AvatarURL,
+		WebsiteURL:  p.WebsiteURL,
+		SocialLinks: socialLinks,
+		JoinedAt:    u.CreatedAt,
+	}
+}
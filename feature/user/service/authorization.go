@@ -0,0 +1,32 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/fikryfahrezy/let-it-go/pkg/http_server"
+	"github.com/fikryfahrezy/let-it-go/pkg/rbac"
+	"github.com/google/uuid"
+)
+
+// authorizeSelf makes sure the authenticated caller is the user being accessed
+func (s *userService) authorizeSelf(ctx context.Context, userID uuid.UUID) (http_server.Principal, error) {
+	principal, ok := http_server.PrincipalFromContext(ctx)
+	if !ok || principal.UserID != userID {
+		s.log.Warn("User access denied",
+			slog.String("user_id", userID.String()),
+			slog.String("caller_id", principal.UserID.String()),
+		)
+		return http_server.Principal{}, ErrUserForbidden
+	}
+	return principal, nil
+}
+
+// authorizeSelfOrManager is authorizeSelf that also lets through callers allowed to manage any user
+func (s *userService) authorizeSelfOrManager(ctx context.Context, userID uuid.UUID) (http_server.Principal, error) {
+	principal, ok := http_server.PrincipalFromContext(ctx)
+	if ok && rbac.Can(principal, rbac.PermissionUsersManage) {
+		return principal, nil
+	}
+	return s.authorizeSelf(ctx, userID)
+}
@@ -0,0 +1,262 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/fikryfahrezy/let-it-go/feature/user/repository"
+	"github.com/fikryfahrezy/let-it-go/pkg/app_error"
+	"github.com/fikryfahrezy/let-it-go/pkg/http_server"
+	"github.com/fikryfahrezy/let-it-go/pkg/rbac"
+	"github.com/google/uuid"
+)
+
+// defaultMaxBatchSize is used when Config.MaxBatchSize is zero
+const defaultMaxBatchSize = 100
+
+// BatchUserOperations creates, updates and deletes users in one request. Every operation is
+// checked before anything is written. In all_or_nothing mode the operations are applied in
+// one transaction, so a single failure leaves every user untouched; in best_effort mode each
+// operation is applied on its own and the failures are reported next to the successes.
+func (s *userService) BatchUserOperations(ctx context.Context, req BatchUserOperationsRequest) (BatchUserOperationsResponse, error) {
+	principal, ok := http_server.PrincipalFromContext(ctx)
+	if !ok || !rbac.Can(principal, rbac.PermissionUsersManage) {
+		s.log.Warn("Batch user operations denied",
+			slog.String("caller_id", principal.UserID.String()),
+		)
+		return BatchUserOperationsResponse{}, ErrUserForbidden
+	}
+
+	if len(req.Operations) > s.maxBatchSize() {
+		return BatchUserOperationsResponse{}, ErrBatchTooLarge
+	}
+
+	s.log.Info("Applying batch user operations",
+		slog.String("mode", req.Mode),
+		slog.Int("operations", len(req.Operations)),
+	)
+
+	response := BatchUserOperationsResponse{
+		Mode:    req.Mode,
+		Results: make([]BatchUserOperationResult, len(req.Operations)),
+	}
+	changes := make([]repository.UserChange, len(req.Operations))
+	prepared := make([]bool, len(req.Operations))
+	unverified := make([]bool, len(req.Operations))
+	for i, op := range req.Operations {
+		response.Results[i] = BatchUserOperationResult{Index: i, Action: op.Action}
+
+		change, needsVerification, err := s.prepareBatchChange(ctx, op)
+		if err != nil {
+			failBatchResult(&response.Results[i], err, op.ValidationErrors)
+			continue
+		}
+		changes[i] = change
+		prepared[i] = true
+		unverified[i] = needsVerification
+	}
+
+	if req.Mode == BatchModeAllOrNothing {
+		s.applyAllOrNothing(ctx, changes, prepared, response.Results)
+	} else {
+		s.applyBestEffort(ctx, changes, prepared, response.Results)
+	}
+
+	for i, result := range response.Results {
+		if result.Status != BatchStatusSucceeded {
+			response.Failed++
+			continue
+		}
+		response.Succeeded++
+
+		// The users exist at this point, so a failed email must not fail the batch
+		if unverified[i] {
+			if err := s.sendVerificationEmail(ctx, changes[i].User); err != nil {
+				s.log.Error("Failed to send verification email",
+					slog.String("error", err.Error()),
+					slog.String("email", changes[i].User.Email),
+				)
+			}
+		}
+	}
+
+	s.log.Info("Batch user operations applied",
+		slog.String("mode", req.Mode),
+		slog.Int("succeeded", response.Succeeded),
+		slog.Int("failed", response.Failed),
+	)
+
+	return response, nil
+}
+
+// applyAllOrNothing applies every change in one transaction, unless one of them already
+// failed its checks
+func (s *userService) applyAllOrNothing(ctx context.Context, changes []repository.UserChange, prepared []bool, results []BatchUserOperationResult) {
+	for _, ok := range prepared {
+		if !ok {
+			skipBatchResults(results)
+			return
+		}
+	}
+
+	failedIndex, err := s.userRepo.ApplyBatch(ctx, changes)
+	if err != nil {
+		if failedIndex >= 0 {
+			failBatchResult(&results[failedIndex], batchChangeError(err), nil)
+		} else {
+			// The transaction itself failed, so no operation is to blame
+			for i := range results {
+				failBatchResult(&results[i], err, nil)
+			}
+		}
+		skipBatchResults(results)
+		return
+	}
+
+	for i := range results {
+		succeedBatchResult(&results[i], changes[i].User.ID)
+	}
+}
+
+// applyBestEffort applies each checked change in its own transaction
+func (s *userService) applyBestEffort(ctx context.Context, changes []repository.UserChange, prepared []bool, results []BatchUserOperationResult) {
+	for i, change := range changes {
+		if !prepared[i] {
+			continue
+		}
+
+		if _, err := s.userRepo.ApplyBatch(ctx, []repository.UserChange{change}); err != nil {
+			failBatchResult(&results[i], batchChangeError(err), nil)
+			continue
+		}
+		succeedBatchResult(&results[i], change.User.ID)
+	}
+}
+
+// prepareBatchChange runs the checks the single user endpoints run and turns the operation
+// into the change to write. It also reports whether the change leaves the user with an
+// email address to verify, which is the case for new users and changed addresses.
+func (s *userService) prepareBatchChange(ctx context.Context, op BatchUserOperation) (repository.UserChange, bool, error) {
+	if len(op.ValidationErrors) > 0 {
+		return repository.UserChange{}, false, ErrInvalidBatchOperation
+	}
+
+	switch op.Action {
+	case repository.UserChangeCreate:
+		if err := s.checkEmailAvailable(ctx, op.Create.Email, uuid.Nil); err != nil {
+			return repository.UserChange{}, false, err
+		}
+		if err := s.checkPasswordPolicy(op.Create.Password); err != nil {
+			return repository.UserChange{}, false, err
+		}
+		hashedPassword, err := s.hashPassword(op.Create.Password)
+		if err != nil {
+			return repository.UserChange{}, false, err
+		}
+
+		now := time.Now()
+		return repository.UserChange{
+			Action: repository.UserChangeCreate,
+			User: repository.User{
+				ID:        uuid.Must(uuid.NewV7()),
+				Name:      op.Create.Name,
+				Email:     op.Create.Email,
+				Password:  hashedPassword,
+				Role:      rbac.DefaultRole,
+				CreatedAt: now,
+				UpdatedAt: now,
+			},
+		}, true, nil
+
+	case repository.UserChangeUpdate:
+		user, err := s.userRepo.GetByID(ctx, op.ID)
+		if err != nil {
+			return repository.UserChange{}, false, err
+		}
+		emailChanged := op.Update.Email != "" && op.Update.Email != user.Email
+		if emailChanged {
+			if err := s.checkEmailAvailable(ctx, op.Update.Email, user.ID); err != nil {
+				return repository.UserChange{}, false, err
+			}
+			changeEmail(&user, op.Update.Email)
+		}
+		if op.Update.Name != "" {
+			user.Name = op.Update.Name
+		}
+		return repository.UserChange{Action: repository.UserChangeUpdate, User: user}, emailChanged, nil
+
+	case repository.UserChangeDelete:
+		user, err := s.userRepo.GetByID(ctx, op.ID)
+		if err != nil {
+			return repository.UserChange{}, false, err
+		}
+		return repository.UserChange{Action: repository.UserChangeDelete, User: user}, false, nil
+	}
+
+	return repository.UserChange{}, false, ErrInvalidBatchOperation
+}
+
+// checkEmailAvailable fails when another user than userID already has the email
+func (s *userService) checkEmailAvailable(ctx context.Context, email string, userID uuid.UUID) error {
+	existingUser, err := s.userRepo.GetByEmail(ctx, email)
+	if err != nil {
+		if errors.Is(err, repository.ErrUserNotFound) {
+			return nil
+		}
+		return err
+	}
+	if existingUser.ID != uuid.Nil && existingUser.ID != userID {
+		return ErrUserAlreadyExists
+	}
+	return nil
+}
+
+func (s *userService) maxBatchSize() int {
+	if s.config.MaxBatchSize > 0 {
+		return s.config.MaxBatchSize
+	}
+	return defaultMaxBatchSize
+}
+
+// batchChangeError reports an email taken by an earlier change of the same batch, or by a
+// deleted user, the same way as an email that was already taken
+func batchChangeError(err error) error {
+	if errors.Is(err, repository.ErrEmailAlreadyTaken) {
+		return ErrUserAlreadyExists
+	}
+	return err
+}
+
+func succeedBatchResult(result *BatchUserOperationResult, userID uuid.UUID) {
+	result.Status = BatchStatusSucceeded
+	result.UserID = &userID
+}
+
+func failBatchResult(result *BatchUserOperationResult, err error, errorFields map[string]any) {
+	result.Status = BatchStatusFailed
+	result.Error = http.StatusText(http.StatusInternalServerError)
+	result.Message = "failed to apply operation"
+	result.ErrorFields = errorFields
+
+	// Errors from the repository wrap the cause in an AppError, so look through the chain
+	var appErr *app_error.AppError
+	if errors.As(err, &appErr) {
+		result.Error = appErr.Code
+		result.Message = appErr.Message
+	}
+}
+
+// skipBatchResults marks every operation that has not failed as not applied
+func skipBatchResults(results []BatchUserOperationResult) {
+	for i := range results {
+		if results[i].Status == BatchStatusFailed {
+			continue
+		}
+		results[i].Status = BatchStatusSkipped
+		results[i].Error = ErrBatchAborted.Code
+		results[i].Message = ErrBatchAborted.Message
+	}
+}
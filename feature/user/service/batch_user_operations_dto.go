@@ -0,0 +1,58 @@
+package service
+
+import (
+	"github.com/google/uuid"
+)
+
+// Modes of a batch of user operations
+const (
+	// BatchModeAllOrNothing applies every operation in one transaction or none of them
+	BatchModeAllOrNothing = "all_or_nothing"
+	// BatchModeBestEffort applies each operation on its own and keeps going after a failure
+	BatchModeBestEffort = "best_effort"
+)
+
+// Statuses of a BatchUserOperationResult
+const (
+	BatchStatusSucceeded = "succeeded"
+	BatchStatusFailed    = "failed"
+	// BatchStatusSkipped marks an operation that was not applied because another one of an
+	// all_or_nothing batch failed
+	BatchStatusSkipped = "skipped"
+)
+
+type BatchUserOperationsRequest struct {
+	Mode       string               `json:"mode" validate:"required,oneof=all_or_nothing best_effort"`
+	Operations []BatchUserOperation `json:"operations" validate:"required,min=1"`
+}
+
+// BatchUserOperation is one create, update or delete of a batch. Create carries the new
+// user, update carries the ID and the changes, delete only carries the ID.
+type BatchUserOperation struct {
+	Action string             `json:"action" validate:"required,oneof=create update delete"`
+	ID     uuid.UUID          `json:"id" validate:"required_unless=Action create"`
+	Create *CreateUserRequest `json:"create,omitempty" validate:"required_if=Action create,excluded_unless=Action create"`
+	Update *UpdateUserRequest `json:"update,omitempty" validate:"required_if=Action update,excluded_unless=Action update"`
+
+	// Filled by the handler, an operation that failed validation is reported without being applied
+	ValidationErrors map[string]any `json:"-"`
+}
+
+type BatchUserOperationsResponse struct {
+	Mode      string                     `json:"mode"`
+	Succeeded int                        `json:"succeeded"`
+	Failed    int                        `json:"failed"`
+	Results   []BatchUserOperationResult `json:"results"`
+}
+
+// BatchUserOperationResult is the outcome of the operation at Index of the request.
+// Error holds the error code and ErrorFields the validation errors of a failed operation.
+type BatchUserOperationResult struct {
+	Index       int            `json:"index"`
+	Action      string         `json:"action"`
+	Status      string         `json:"status"`
+	UserID      *uuid.UUID     `json:"user_id,omitempty"`
+	Error       string         `json:"error,omitempty"`
+	Message     string         `json:"message,omitempty"`
+	ErrorFields map[string]any `json:"error_fields,omitempty"`
+}
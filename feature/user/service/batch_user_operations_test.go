@@ -0,0 +1,245 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/fikryfahrezy/let-it-go/feature/user/repository"
+	"github.com/fikryfahrezy/let-it-go/feature/user/repository/repositoryfakes"
+	"github.com/fikryfahrezy/let-it-go/feature/user/service"
+	"github.com/fikryfahrezy/let-it-go/pkg/http_server"
+	"github.com/fikryfahrezy/let-it-go/pkg/logger"
+	"github.com/fikryfahrezy/let-it-go/pkg/mailer"
+	"github.com/fikryfahrezy/let-it-go/pkg/rbac"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUserService_BatchUserOperations_AllOrNothingSuccess(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeUserRepository{}
+	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{Mailer: mailer.NewWriterMailer("", io.Discard)}, service.Config{})
+	existingUser := repository.User{ID: uuid.New(), Name: "Old Name", Email: "old@example.com"}
+	mockRepo.GetByEmailReturns(repository.User{}, repository.ErrUserNotFound)
+	mockRepo.GetByIDReturns(existingUser, nil)
+	mockRepo.ApplyBatchReturns(-1, nil)
+
+	result, err := userService.BatchUserOperations(adminContext(), service.BatchUserOperationsRequest{
+		Mode: service.BatchModeAllOrNothing,
+		Operations: []service.BatchUserOperation{
+			{Action: "create", Create: &service.CreateUserRequest{Name: "John Doe", Email: "john@example.com", Password: "password123"}},
+			{Action: "update", ID: existingUser.ID, Update: &service.UpdateUserRequest{Name: "New Name"}},
+			{Action: "delete", ID: existingUser.ID},
+		},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, 3, result.Succeeded)
+	assert.Equal(t, 0, result.Failed)
+	require.Len(t, result.Results, 3)
+	for i, r := range result.Results {
+		assert.Equal(t, i, r.Index)
+		assert.Equal(t, service.BatchStatusSucceeded, r.Status)
+		require.NotNil(t, r.UserID)
+	}
+	assert.Equal(t, existingUser.ID, *result.Results[1].UserID)
+
+	// Every change is written in a single transaction
+	require.Equal(t, 1, mockRepo.ApplyBatchCallCount())
+	_, changes := mockRepo.ApplyBatchArgsForCall(0)
+	require.Len(t, changes, 3)
+	assert.Equal(t, repository.UserChangeCreate, changes[0].Action)
+	assert.Equal(t, *result.Results[0].UserID, changes[0].User.ID)
+	assert.Equal(t, rbac.DefaultRole, changes[0].User.Role)
+	assert.NotEqual(t, "password123", changes[0].User.Password)
+	assert.Equal(t, "New Name", changes[1].User.Name)
+	assert.Equal(t, existingUser.Email, changes[1].User.Email)
+	assert.Equal(t, repository.UserChangeDelete, changes[2].Action)
+
+	// Created users are asked to verify their email
+	assert.Equal(t, 1, mockRepo.CreateEmailVerificationTokenCallCount())
+}
+
+func TestUserService_BatchUserOperations_AllOrNothingCheckFailure(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeUserRepository{}
+	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{}, service.Config{})
+	mockRepo.GetByEmailReturns(repository.User{}, repository.ErrUserNotFound)
+	mockRepo.GetByIDReturns(repository.User{}, repository.ErrUserNotFound)
+
+	result, err := userService.BatchUserOperations(adminContext(), service.BatchUserOperationsRequest{
+		Mode: service.BatchModeAllOrNothing,
+		Operations: []service.BatchUserOperation{
+			{Action: "create", Create: &service.CreateUserRequest{Name: "John Doe", Email: "john@example.com", Password: "password123"}},
+			{Action: "delete", ID: uuid.New()},
+			{Action: "create", ValidationErrors: map[string]any{"create.email": []string{"email must be a valid email address"}}},
+		},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, 0, result.Succeeded)
+	assert.Equal(t, 3, result.Failed)
+	assert.Equal(t, service.BatchStatusSkipped, result.Results[0].Status)
+	assert.Equal(t, service.ErrBatchAborted.Code, result.Results[0].Error)
+	assert.Equal(t, service.BatchStatusFailed, result.Results[1].Status)
+	assert.Equal(t, repository.ErrUserNotFound.Code, result.Results[1].Error)
+	assert.Equal(t, service.BatchStatusFailed, result.Results[2].Status)
+	assert.Equal(t, service.ErrInvalidBatchOperation.Code, result.Results[2].Error)
+	assert.Contains(t, result.Results[2].ErrorFields, "create.email")
+
+	// Nothing is written when an operation fails its checks
+	assert.Equal(t, 0, mockRepo.ApplyBatchCallCount())
+}
+
+func TestUserService_BatchUserOperations_AllOrNothingWriteFailure(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeUserRepository{}
+	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{}, service.Config{})
+	mockRepo.GetByEmailReturns(repository.User{}, repository.ErrUserNotFound)
+	// Two creates with the same email only collide once written
+	mockRepo.ApplyBatchReturns(1, repository.ErrEmailAlreadyTaken)
+
+	create := &service.CreateUserRequest{Name: "John Doe", Email: "john@example.com", Password: "password123"}
+	result, err := userService.BatchUserOperations(adminContext(), service.BatchUserOperationsRequest{
+		Mode: service.BatchModeAllOrNothing,
+		Operations: []service.BatchUserOperation{
+			{Action: "create", Create: create},
+			{Action: "create", Create: create},
+		},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, 0, result.Succeeded)
+	assert.Equal(t, service.BatchStatusSkipped, result.Results[0].Status)
+	assert.Nil(t, result.Results[0].UserID)
+	assert.Equal(t, service.BatchStatusFailed, result.Results[1].Status)
+	assert.Equal(t, service.ErrUserAlreadyExists.Code, result.Results[1].Error)
+	assert.Equal(t, 0, mockRepo.CreateEmailVerificationTokenCallCount())
+}
+
+func TestUserService_BatchUserOperations_AllOrNothingTransactionFailure(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeUserRepository{}
+	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{}, service.Config{})
+	mockRepo.GetByIDReturns(repository.User{ID: uuid.New()}, nil)
+	mockRepo.ApplyBatchReturns(-1, errors.Join(repository.ErrFailedToCommitTx, errors.New("connection lost")))
+
+	result, err := userService.BatchUserOperations(adminContext(), service.BatchUserOperationsRequest{
+		Mode: service.BatchModeAllOrNothing,
+		Operations: []service.BatchUserOperation{
+			{Action: "delete", ID: uuid.New()},
+			{Action: "delete", ID: uuid.New()},
+		},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, 2, result.Failed)
+	for _, r := range result.Results {
+		assert.Equal(t, service.BatchStatusFailed, r.Status)
+		assert.Equal(t, repository.ErrFailedToCommitTx.Code, r.Error)
+	}
+}
+
+func TestUserService_BatchUserOperations_BestEffort(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeUserRepository{}
+	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{Mailer: mailer.NewWriterMailer("", io.Discard)}, service.Config{})
+	existingUser := repository.User{ID: uuid.New(), Name: "Old Name", Email: "old@example.com"}
+	mockRepo.GetByEmailStub = func(_ context.Context, email string) (repository.User, error) {
+		if email == "taken@example.com" {
+			return repository.User{ID: uuid.New(), Email: email}, nil
+		}
+		return repository.User{}, repository.ErrUserNotFound
+	}
+	mockRepo.GetByIDReturns(existingUser, nil)
+	mockRepo.ApplyBatchReturnsOnCall(0, -1, nil)
+	mockRepo.ApplyBatchReturnsOnCall(1, 0, repository.ErrUserNotFound)
+
+	result, err := userService.BatchUserOperations(adminContext(), service.BatchUserOperationsRequest{
+		Mode: service.BatchModeBestEffort,
+		Operations: []service.BatchUserOperation{
+			{Action: "create", Create: &service.CreateUserRequest{Name: "John Doe", Email: "john@example.com", Password: "password123"}},
+			{Action: "update", ID: existingUser.ID, Update: &service.UpdateUserRequest{Email: "taken@example.com"}},
+			{Action: "delete", ID: existingUser.ID},
+		},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, result.Succeeded)
+	assert.Equal(t, 2, result.Failed)
+	assert.Equal(t, service.BatchStatusSucceeded, result.Results[0].Status)
+	assert.Equal(t, service.BatchStatusFailed, result.Results[1].Status)
+	assert.Equal(t, service.ErrUserAlreadyExists.Code, result.Results[1].Error)
+	assert.Equal(t, service.BatchStatusFailed, result.Results[2].Status)
+	assert.Equal(t, repository.ErrUserNotFound.Code, result.Results[2].Error)
+
+	// Each checked operation is written on its own
+	require.Equal(t, 2, mockRepo.ApplyBatchCallCount())
+	_, changes := mockRepo.ApplyBatchArgsForCall(0)
+	require.Len(t, changes, 1)
+	assert.Equal(t, repository.UserChangeCreate, changes[0].Action)
+	_, changes = mockRepo.ApplyBatchArgsForCall(1)
+	require.Len(t, changes, 1)
+	assert.Equal(t, repository.UserChangeDelete, changes[0].Action)
+}
+
+func TestUserService_BatchUserOperations_EmailChangeNeedsVerification(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeUserRepository{}
+	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{Mailer: mailer.NewWriterMailer("", io.Discard)}, service.Config{})
+	verifiedAt := time.Now().Add(-time.Hour)
+	existingUser := repository.User{ID: uuid.New(), Name: "John Doe", Email: "old@example.com", VerifiedAt: &verifiedAt}
+	mockRepo.GetByIDReturns(existingUser, nil)
+	mockRepo.GetByEmailReturns(repository.User{}, repository.ErrUserNotFound)
+	mockRepo.ApplyBatchReturns(-1, nil)
+
+	result, err := userService.BatchUserOperations(adminContext(), service.BatchUserOperationsRequest{
+		Mode: service.BatchModeAllOrNothing,
+		Operations: []service.BatchUserOperation{
+			{Action: "update", ID: existingUser.ID, Update: &service.UpdateUserRequest{Email: "new@example.com"}},
+			{Action: "update", ID: existingUser.ID, Update: &service.UpdateUserRequest{Name: "John Updated"}},
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 2, result.Succeeded)
+
+	_, changes := mockRepo.ApplyBatchArgsForCall(0)
+	require.Len(t, changes, 2)
+	assert.Nil(t, changes[0].User.VerifiedAt)
+	assert.Equal(t, &verifiedAt, changes[1].User.VerifiedAt)
+
+	// Only the changed address is sent a verification email
+	require.Equal(t, 1, mockRepo.CreateEmailVerificationTokenCallCount())
+	_, actualEmail, _ := mockRepo.CreateEmailVerificationTokenArgsForCall(0)
+	assert.Equal(t, "new@example.com", actualEmail)
+}
+
+func TestUserService_BatchUserOperations_TooLarge(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeUserRepository{}
+	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{}, service.Config{
+		MaxBatchSize: 1,
+	})
+
+	_, err := userService.BatchUserOperations(adminContext(), service.BatchUserOperationsRequest{
+		Mode: service.BatchModeBestEffort,
+		Operations: []service.BatchUserOperation{
+			{Action: "delete", ID: uuid.New()},
+			{Action: "delete", ID: uuid.New()},
+		},
+	})
+
+	assert.ErrorIs(t, err, service.ErrBatchTooLarge)
+	assert.Equal(t, 0, mockRepo.GetByIDCallCount())
+}
+
+func TestUserService_BatchUserOperations_Forbidden(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeUserRepository{}
+	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{}, service.Config{})
+	ctx := http_server.ContextWithPrincipal(context.Background(), http_server.Principal{UserID: uuid.New()})
+
+	_, err := userService.BatchUserOperations(ctx, service.BatchUserOperationsRequest{
+		Mode:       service.BatchModeBestEffort,
+		Operations: []service.BatchUserOperation{{Action: "delete", ID: uuid.New()}},
+	})
+
+	assert.ErrorIs(t, err, service.ErrUserForbidden)
+	assert.Equal(t, 0, mockRepo.ApplyBatchCallCount())
+}
@@ -0,0 +1,18 @@
+package service
+
+//counterfeiter:generate -o servicefakes/fake_blog_reader.go . BlogReader
+
+import (
+	"context"
+
+	blogRepository "github.com/fikryfahrezy/let-it-go/feature/blog/repository"
+	"github.com/google/uuid"
+)
+
+// BlogReader reads the blogs of an author for data exports and profiles.
+// It is implemented by the blog repository.
+type BlogReader interface {
+	GetByAuthorID(ctx context.Context, authorID uuid.UUID, limit, offset int) ([]blogRepository.Blog, error)
+	GetPublishedByAuthorID(ctx context.Context, authorID uuid.UUID, limit int) ([]blogRepository.Blog, error)
+	CountByAuthorIDAndStatus(ctx context.Context, authorID uuid.UUID, status string) (int64, error)
+}
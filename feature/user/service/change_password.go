@@ -0,0 +1,56 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/google/uuid"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// ChangePassword replaces the caller's password after checking the current one. Every
+// other session of the user is revoked; the session making the request stays signed in.
+func (s *userService) ChangePassword(ctx context.Context, userID uuid.UUID, req ChangePasswordRequest) error {
+	principal, err := s.authorizeSelf(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	// A stolen access token must not allow guessing the current password without limit
+	if err := s.checkLockout(ctx, user.Email, req.IPAddress); err != nil {
+		return err
+	}
+
+	if err := bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(req.CurrentPassword)); err != nil {
+		s.log.Warn("Password change failed, wrong current password",
+			slog.String("user_id", userID.String()),
+		)
+		s.recordFailedAttempt(ctx, user.Email, req.IPAddress)
+		return ErrIncorrectCurrentPassword
+	}
+	s.recordSuccessfulAttempt(ctx, user.Email)
+
+	if err := s.checkPasswordPolicy(req.NewPassword); err != nil {
+		return err
+	}
+
+	hashedPassword, err := s.hashPassword(req.NewPassword)
+	if err != nil {
+		return err
+	}
+
+	if err := s.userRepo.ChangePassword(ctx, userID, hashedPassword, principal.SessionID); err != nil {
+		return err
+	}
+
+	s.log.Info("Password changed successfully",
+		slog.String("user_id", userID.String()),
+	)
+
+	return nil
+}
@@ -0,0 +1,185 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/fikryfahrezy/let-it-go/feature/user/repository"
+	"github.com/fikryfahrezy/let-it-go/feature/user/repository/repositoryfakes"
+	"github.com/fikryfahrezy/let-it-go/feature/user/service"
+	"github.com/fikryfahrezy/let-it-go/pkg/app_error"
+	"github.com/fikryfahrezy/let-it-go/pkg/http_server"
+	"github.com/fikryfahrezy/let-it-go/pkg/logger"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// newTestUserWithPassword returns a user whose stored hash matches password
+func newTestUserWithPassword(t *testing.T, password string, cost int) repository.User {
+	t.Helper()
+
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(password), cost)
+	require.NoError(t, err)
+
+	return repository.User{
+		ID:       uuid.New(),
+		Name:     "John Doe",
+		Email:    "john@example.com",
+		Password: string(hashedPassword),
+	}
+}
+
+func TestUserService_ChangePassword_Success(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeUserRepository{}
+	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{}, service.Config{
+		BcryptCost: bcrypt.MinCost + 1,
+	})
+
+	user := newTestUserWithPassword(t, "old-password", bcrypt.MinCost)
+	mockRepo.GetByIDReturns(user, nil)
+
+	sessionID := uuid.New()
+	ctx := http_server.ContextWithPrincipal(context.Background(), http_server.Principal{
+		UserID:    user.ID,
+		SessionID: sessionID,
+	})
+
+	err := userService.ChangePassword(ctx, user.ID, service.ChangePasswordRequest{
+		CurrentPassword: "old-password",
+		NewPassword:     "new-password",
+	})
+	require.NoError(t, err)
+
+	// The new hash uses the configured cost and the current session is kept
+	require.Equal(t, 1, mockRepo.ChangePasswordCallCount())
+	_, actualUserID, passwordHash, keepFamilyID := mockRepo.ChangePasswordArgsForCall(0)
+	assert.Equal(t, user.ID, actualUserID)
+	assert.Equal(t, sessionID, keepFamilyID)
+	assert.NoError(t, bcrypt.CompareHashAndPassword([]byte(passwordHash), []byte("new-password")))
+	cost, err := bcrypt.Cost([]byte(passwordHash))
+	require.NoError(t, err)
+	assert.Equal(t, bcrypt.MinCost+1, cost)
+}
+
+func TestUserService_ChangePassword_OtherUser(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeUserRepository{}
+	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{}, service.Config{})
+
+	ctx := http_server.ContextWithPrincipal(context.Background(), http_server.Principal{
+		UserID: uuid.New(),
+	})
+
+	err := userService.ChangePassword(ctx, uuid.New(), service.ChangePasswordRequest{
+		CurrentPassword: "old-password",
+		NewPassword:     "new-password",
+	})
+
+	assert.Equal(t, service.ErrUserForbidden, err)
+	assert.Equal(t, 0, mockRepo.GetByIDCallCount())
+	assert.Equal(t, 0, mockRepo.ChangePasswordCallCount())
+}
+
+func TestUserService_ChangePassword_IncorrectCurrentPassword(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeUserRepository{}
+	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{}, service.Config{})
+
+	user := newTestUserWithPassword(t, "old-password", bcrypt.MinCost)
+	mockRepo.GetByIDReturns(user, nil)
+	ctx := http_server.ContextWithPrincipal(context.Background(), http_server.Principal{UserID: user.ID})
+
+	err := userService.ChangePassword(ctx, user.ID, service.ChangePasswordRequest{
+		CurrentPassword: "wrong-password",
+		NewPassword:     "new-password",
+	})
+
+	assert.Equal(t, service.ErrIncorrectCurrentPassword, err)
+	assert.Equal(t, 0, mockRepo.ChangePasswordCallCount())
+}
+
+func TestUserService_ChangePassword_PolicyViolation(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeUserRepository{}
+	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{}, service.Config{
+		PasswordPolicy: service.PasswordPolicy{MinLength: 8, RequireDigit: true},
+	})
+
+	user := newTestUserWithPassword(t, "old-password", bcrypt.MinCost)
+	mockRepo.GetByIDReturns(user, nil)
+	ctx := http_server.ContextWithPrincipal(context.Background(), http_server.Principal{UserID: user.ID})
+
+	err := userService.ChangePassword(ctx, user.ID, service.ChangePasswordRequest{
+		CurrentPassword: "old-password",
+		NewPassword:     "no-digits-here",
+	})
+
+	assert.ErrorIs(t, err, service.ErrPasswordPolicyViolation)
+	assert.Equal(t, "password must contain a digit", app_error.GetMessage(err))
+	assert.Equal(t, 0, mockRepo.ChangePasswordCallCount())
+}
+
+func TestUserService_ChangePassword_RepositoryError(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeUserRepository{}
+	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{}, service.Config{})
+
+	user := newTestUserWithPassword(t, "old-password", bcrypt.MinCost)
+	mockRepo.GetByIDReturns(user, nil)
+	mockRepo.ChangePasswordReturns(errors.New("database error"))
+	ctx := http_server.ContextWithPrincipal(context.Background(), http_server.Principal{UserID: user.ID})
+
+	err := userService.ChangePassword(ctx, user.ID, service.ChangePasswordRequest{
+		CurrentPassword: "old-password",
+		NewPassword:     "new-password",
+	})
+
+	assert.EqualError(t, err, "database error")
+}
+
+func TestUserService_PasswordPolicy(t *testing.T) {
+	policy := service.PasswordPolicy{
+		MinLength:     10,
+		RequireUpper:  true,
+		RequireLower:  true,
+		RequireDigit:  true,
+		RequireSymbol: true,
+	}
+
+	tests := []struct {
+		name     string
+		password string
+		message  string
+	}{
+		{name: "too short", password: "Ab1!", message: "password must be at least 10 characters long"},
+		{name: "missing uppercase", password: "abcdefgh1!", message: "password must contain an uppercase letter"},
+		{name: "missing lowercase", password: "ABCDEFGH1!", message: "password must contain a lowercase letter"},
+		{name: "missing digit", password: "Abcdefghi!", message: "password must contain a digit"},
+		{name: "missing symbol", password: "Abcdefghi1", message: "password must contain a symbol"},
+		{name: "valid", password: "Abcdefgh1!"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := &repositoryfakes.FakeUserRepository{}
+			userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{}, service.Config{
+				BcryptCost:     bcrypt.MinCost,
+				PasswordPolicy: policy,
+			})
+			mockRepo.GetPasswordResetTokenByHashReturns(newTestPasswordResetToken("reset-token"), nil)
+
+			err := userService.ResetPassword(context.Background(), service.ResetPasswordRequest{
+				Token:       "reset-token",
+				NewPassword: tt.password,
+			})
+
+			if tt.message == "" {
+				assert.NoError(t, err)
+				assert.Equal(t, 1, mockRepo.ResetPasswordCallCount())
+				return
+			}
+			assert.ErrorIs(t, err, service.ErrPasswordPolicyViolation)
+			assert.Equal(t, tt.message, app_error.GetMessage(err))
+			assert.Equal(t, 0, mockRepo.ResetPasswordCallCount())
+		})
+	}
+}
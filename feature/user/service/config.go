@@ -0,0 +1,50 @@
+package service
+
+import "time"
+
+// Config holds the settings of the user service
+type Config struct {
+	// PasswordResetTTL is how long a password reset token stays valid
+	PasswordResetTTL time.Duration
+	// PasswordResetURL is the page the emailed reset link points to; the token is appended as the token query parameter
+	PasswordResetURL string
+	// EmailVerificationTTL is how long an email verification token stays valid
+	EmailVerificationTTL time.Duration
+	// EmailVerificationURL is the page the emailed verification link points to; the token is appended as the token query parameter
+	EmailVerificationURL string
+	// BcryptCost is the cost new password hashes are generated with; bcrypt.DefaultCost when zero.
+	// Hashes stored with a lower cost are upgraded on the next successful login.
+	BcryptCost int
+	// PasswordPolicy is what every new password must satisfy
+	PasswordPolicy PasswordPolicy
+	// TwoFactor configures TOTP two factor authentication
+	TwoFactor TwoFactorConfig
+	// OIDCLoginTTL is how long a user has to sign in at the OpenID Connect provider
+	OIDCLoginTTL time.Duration
+	// DeletionGracePeriod is how long a deleted user can be restored before the erasure job
+	// anonymizes them; 30 days when zero
+	DeletionGracePeriod time.Duration
+	// MaxBatchSize is the most operations a batch of user operations may have; 100 when zero
+	MaxBatchSize int
+	// ImpersonationTTL is how long an impersonation token stays valid; 15 minutes when zero
+	ImpersonationTTL time.Duration
+}
+
+// TwoFactorConfig holds the settings of TOTP two factor authentication
+type TwoFactorConfig struct {
+	// Issuer is the account issuer shown by authenticator apps
+	Issuer string
+	// ChallengeTTL is how long a login has to complete the second factor after the password was checked
+	ChallengeTTL time.Duration
+	// RequiredRoles are the roles expected to enroll; their logins are flagged until they do
+	RequiredRoles []string
+}
+
+// PasswordPolicy describes the rules a new password must satisfy. The zero value accepts any password.
+type PasswordPolicy struct {
+	MinLength     int
+	RequireUpper  bool
+	RequireLower  bool
+	RequireDigit  bool
+	RequireSymbol bool
+}
@@ -0,0 +1,66 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/fikryfahrezy/let-it-go/feature/user/repository"
+	"github.com/fikryfahrezy/let-it-go/pkg/rbac"
+	"github.com/google/uuid"
+)
+
+// CreateInvitedUser creates the user of an accepted invitation with the role they were
+// invited with. The invitation link was emailed to them, so their address counts as
+// verified and no verification email is sent.
+func (s *userService) CreateInvitedUser(ctx context.Context, req CreateInvitedUserRequest) (CreateUserResponse, error) {
+	s.log.Info("Creating invited user",
+		slog.String("email", req.Email),
+		slog.String("role", req.Role),
+	)
+
+	if !rbac.IsValidRole(req.Role) {
+		return CreateUserResponse{}, ErrInvalidRole
+	}
+
+	if err := s.checkEmailAvailable(ctx, req.Email, uuid.Nil); err != nil {
+		return CreateUserResponse{}, err
+	}
+
+	if err := s.checkPasswordPolicy(req.Password); err != nil {
+		return CreateUserResponse{}, err
+	}
+
+	hashedPassword, err := s.hashPassword(req.Password)
+	if err != nil {
+		return CreateUserResponse{}, err
+	}
+
+	now := time.Now()
+	user := repository.User{
+		ID:         uuid.Must(uuid.NewV7()),
+		Name:       req.Name,
+		Email:      req.Email,
+		Password:   hashedPassword,
+		Role:       req.Role,
+		VerifiedAt: &now,
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}
+
+	if err := s.userRepo.Create(ctx, user); err != nil {
+		// Deleted users keep their email until they are erased
+		if errors.Is(err, repository.ErrEmailAlreadyTaken) {
+			return CreateUserResponse{}, ErrUserAlreadyExists
+		}
+		return CreateUserResponse{}, err
+	}
+
+	s.log.Info("Invited user created successfully",
+		slog.String("user_id", user.ID.String()),
+		slog.String("email", user.Email),
+	)
+
+	return ToCreateUserResponse(user), nil
+}
@@ -0,0 +1,91 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/fikryfahrezy/let-it-go/feature/user/repository"
+	"github.com/fikryfahrezy/let-it-go/feature/user/repository/repositoryfakes"
+	"github.com/fikryfahrezy/let-it-go/feature/user/service"
+	"github.com/fikryfahrezy/let-it-go/pkg/logger"
+	"github.com/fikryfahrezy/let-it-go/pkg/rbac"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+)
+
+func TestUserService_CreateInvitedUser_Success(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeUserRepository{}
+	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{}, service.Config{})
+	mockRepo.GetByEmailReturns(repository.User{}, repository.ErrUserNotFound)
+
+	req := service.CreateInvitedUserRequest{
+		Name:     "Jane Doe",
+		Email:    "jane@example.com",
+		Password: "password123",
+		Role:     rbac.RoleEditor,
+	}
+
+	result, err := userService.CreateInvitedUser(context.Background(), req)
+
+	require.NoError(t, err)
+	assert.NotEqual(t, uuid.Nil, result.ID)
+	assert.Equal(t, req.Email, result.Email)
+
+	require.Equal(t, 1, mockRepo.CreateCallCount())
+	_, actualUser := mockRepo.CreateArgsForCall(0)
+	assert.Equal(t, result.ID, actualUser.ID)
+	assert.Equal(t, rbac.RoleEditor, actualUser.Role)
+	// The invitation was emailed, so the address counts as verified
+	assert.NotNil(t, actualUser.VerifiedAt)
+	assert.NoError(t, bcrypt.CompareHashAndPassword([]byte(actualUser.Password), []byte(req.Password)))
+	assert.Equal(t, 0, mockRepo.CreateEmailVerificationTokenCallCount())
+}
+
+func TestUserService_CreateInvitedUser_InvalidRole(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeUserRepository{}
+	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{}, service.Config{})
+
+	_, err := userService.CreateInvitedUser(context.Background(), service.CreateInvitedUserRequest{
+		Name:     "Jane Doe",
+		Email:    "jane@example.com",
+		Password: "password123",
+		Role:     "owner",
+	})
+
+	assert.Equal(t, service.ErrInvalidRole, err)
+	assert.Equal(t, 0, mockRepo.CreateCallCount())
+}
+
+func TestUserService_CreateInvitedUser_UserAlreadyExists(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeUserRepository{}
+	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{}, service.Config{})
+	mockRepo.GetByEmailReturns(repository.User{ID: uuid.New(), Email: "jane@example.com"}, nil)
+
+	_, err := userService.CreateInvitedUser(context.Background(), service.CreateInvitedUserRequest{
+		Name:     "Jane Doe",
+		Email:    "jane@example.com",
+		Password: "password123",
+		Role:     rbac.RoleAuthor,
+	})
+
+	assert.Equal(t, service.ErrUserAlreadyExists, err)
+	assert.Equal(t, 0, mockRepo.CreateCallCount())
+}
+
+func TestUserService_CreateInvitedUser_EmailOfDeletedUser(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeUserRepository{}
+	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{}, service.Config{})
+	mockRepo.GetByEmailReturns(repository.User{}, repository.ErrUserNotFound)
+	mockRepo.CreateReturns(repository.ErrEmailAlreadyTaken)
+
+	_, err := userService.CreateInvitedUser(context.Background(), service.CreateInvitedUserRequest{
+		Name:     "Jane Doe",
+		Email:    "jane@example.com",
+		Password: "password123",
+		Role:     rbac.RoleAuthor,
+	})
+
+	assert.Equal(t, service.ErrUserAlreadyExists, err)
+}
@@ -0,0 +1,83 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+	"time"
+
+	"github.com/fikryfahrezy/let-it-go/feature/user/repository"
+	"github.com/fikryfahrezy/let-it-go/pkg/rbac"
+	"github.com/fikryfahrezy/let-it-go/pkg/token"
+	"github.com/google/uuid"
+)
+
+const (
+	// PersonalAccessTokenPrefix starts every personal access token so it can be told
+	// apart from access tokens and recognized by secret scanners
+	PersonalAccessTokenPrefix = "lig_pat_"
+
+	personalAccessTokenBytes = 32
+)
+
+// CreatePersonalAccessToken creates a named token restricted to the requested scopes.
+// Only its hash is stored, so the returned token cannot be retrieved again.
+func (s *userService) CreatePersonalAccessToken(ctx context.Context, userID uuid.UUID, req CreatePersonalAccessTokenRequest) (CreatePersonalAccessTokenResponse, error) {
+	principal, err := s.authorizeSelf(ctx, userID)
+	if err != nil {
+		return CreatePersonalAccessTokenResponse{}, err
+	}
+	// A leaked token must not be able to mint new ones
+	if principal.Scoped() {
+		return CreatePersonalAccessTokenResponse{}, ErrUserForbidden
+	}
+
+	for _, scope := range req.Scopes {
+		if !rbac.IsValidScope(scope) {
+			return CreatePersonalAccessTokenResponse{}, ErrInvalidScope
+		}
+	}
+
+	now := time.Now()
+	if req.ExpiresAt != nil && !req.ExpiresAt.After(now) {
+		return CreatePersonalAccessTokenResponse{}, ErrInvalidTokenExpiry
+	}
+
+	rawToken, err := token.GenerateOpaque(personalAccessTokenBytes)
+	if err != nil {
+		s.log.Error("Failed to generate personal access token",
+			slog.String("error", err.Error()),
+			slog.String("user_id", userID.String()),
+		)
+		return CreatePersonalAccessTokenResponse{}, fmt.Errorf("%w: %w", ErrFailedToIssueToken, err)
+	}
+	rawToken = PersonalAccessTokenPrefix + rawToken
+
+	scopes := slices.Clone(req.Scopes)
+	slices.Sort(scopes)
+
+	accessToken := repository.PersonalAccessToken{
+		ID:        uuid.Must(uuid.NewV7()),
+		UserID:    userID,
+		Name:      req.Name,
+		TokenHash: token.Hash(rawToken),
+		Scopes:    slices.Compact(scopes),
+		ExpiresAt: req.ExpiresAt,
+		CreatedAt: now,
+	}
+
+	if err := s.userRepo.CreatePersonalAccessToken(ctx, accessToken); err != nil {
+		return CreatePersonalAccessTokenResponse{}, err
+	}
+
+	s.log.Info("Personal access token created successfully",
+		slog.String("user_id", userID.String()),
+		slog.String("token_id", accessToken.ID.String()),
+	)
+
+	return CreatePersonalAccessTokenResponse{
+		PersonalAccessTokenResponse: ToPersonalAccessTokenResponse(accessToken),
+		Token:                       rawToken,
+	}, nil
+}
@@ -3,12 +3,11 @@ package service
 import (
 	"context"
 	"errors"
-	"fmt"
 	"log/slog"
 
 	"github.com/fikryfahrezy/let-it-go/feature/user/repository"
+	"github.com/fikryfahrezy/let-it-go/pkg/rbac"
 	"github.com/google/uuid"
-	"golang.org/x/crypto/bcrypt"
 )
 
 func (s *userService) CreateUser(ctx context.Context, req CreateUserRequest) (CreateUserResponse, error) {
@@ -32,21 +31,30 @@ func (s *userService) CreateUser(ctx context.Context, req CreateUserRequest) (Cr
 		return CreateUserResponse{}, ErrUserAlreadyExists
 	}
 
-	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(req.Password), bcrypt.DefaultCost)
+	if err := s.checkPasswordPolicy(req.Password); err != nil {
+		return CreateUserResponse{}, err
+	}
+
+	hashedPassword, err := s.hashPassword(req.Password)
 	if err != nil {
-		s.log.Error("Failed to hash password",
-			slog.String("error", err.Error()),
-		)
-		return CreateUserResponse{}, fmt.Errorf("%w: %w", ErrFailedToHashPassword, err)
+		return CreateUserResponse{}, err
 	}
 
 	user := repository.User{
 		Name:     req.Name,
 		Email:    req.Email,
-		Password: string(hashedPassword),
+		Password: hashedPassword,
+		Role:     rbac.DefaultRole,
 	}
 
 	if err := s.userRepo.Create(ctx, user); err != nil {
+		// Deleted users keep their email until they are erased
+		if errors.Is(err, repository.ErrEmailAlreadyTaken) {
+			s.log.Warn("User already exists",
+				slog.String("email", req.Email),
+			)
+			return CreateUserResponse{}, ErrUserAlreadyExists
+		}
 		return CreateUserResponse{}, err
 	}
 
@@ -56,5 +64,13 @@ func (s *userService) CreateUser(ctx context.Context, req CreateUserRequest) (Cr
 		slog.String("email", user.Email),
 	)
 
+	// The account exists at this point, so a failed email must not fail the signup
+	if err := s.sendVerificationEmail(ctx, user); err != nil {
+		s.log.Error("Failed to send verification email",
+			slog.String("error", err.Error()),
+			slog.String("email", user.Email),
+		)
+	}
+
 	return response, nil
 }
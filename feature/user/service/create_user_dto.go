@@ -10,7 +10,16 @@ import (
 type CreateUserRequest struct {
 	Name     string `json:"name" validate:"required,min=2,max=100"`
 	Email    string `json:"email" validate:"required,email"`
-	Password string `json:"password" validate:"required,min=6"`
+	Password string `json:"password" validate:"required"`
+}
+
+// CreateInvitedUserRequest is filled from an accepted invitation, whose email and role
+// were picked by the admin who sent it
+type CreateInvitedUserRequest struct {
+	Name     string
+	Email    string
+	Password string
+	Role     string
 }
 
 type CreateUserResponse struct {
@@ -1,23 +1,32 @@
 package service_test
 
 import (
+	"bytes"
 	"context"
 	"errors"
+	"io"
+	"net/url"
+	"regexp"
 	"testing"
+	"time"
 
 	"github.com/fikryfahrezy/let-it-go/feature/user/repository"
 	"github.com/fikryfahrezy/let-it-go/feature/user/repository/repositoryfakes"
 	"github.com/fikryfahrezy/let-it-go/feature/user/service"
 	"github.com/fikryfahrezy/let-it-go/pkg/logger"
+	"github.com/fikryfahrezy/let-it-go/pkg/mailer"
+	"github.com/fikryfahrezy/let-it-go/pkg/rbac"
+	"github.com/fikryfahrezy/let-it-go/pkg/token"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"golang.org/x/crypto/bcrypt"
 )
 
 func TestUserService_CreateUser_Success(t *testing.T) {
 	// Setup
 	mockRepo := &repositoryfakes.FakeUserRepository{}
-	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo)
+	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{Mailer: mailer.NewWriterMailer("", io.Discard)}, service.Config{})
 	ctx := context.Background()
 
 	// Mock repository to return "user not found" (expected for new user)
@@ -50,6 +59,7 @@ func TestUserService_CreateUser_Success(t *testing.T) {
 	_, actualUser := mockRepo.CreateArgsForCall(0)
 	assert.Equal(t, req.Name, actualUser.Name)
 	assert.Equal(t, req.Email, actualUser.Email)
+	assert.Equal(t, rbac.DefaultRole, actualUser.Role)
 	// Verify password was hashed
 	assert.NotEqual(t, req.Password, actualUser.Password)
 	err = bcrypt.CompareHashAndPassword([]byte(actualUser.Password), []byte(req.Password))
@@ -58,7 +68,7 @@ func TestUserService_CreateUser_Success(t *testing.T) {
 
 func TestUserService_CreateUser_UserAlreadyExists(t *testing.T) {
 	mockRepo := &repositoryfakes.FakeUserRepository{}
-	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo)
+	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{}, service.Config{})
 	ctx := context.Background()
 
 	existingUser := repository.User{
@@ -88,9 +98,30 @@ func TestUserService_CreateUser_UserAlreadyExists(t *testing.T) {
 	assert.Equal(t, 0, mockRepo.CreateCallCount()) // Create should not be called
 }
 
+func TestUserService_CreateUser_EmailOfDeletedUser(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeUserRepository{}
+	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{}, service.Config{})
+	ctx := context.Background()
+
+	// Deleted users are hidden from lookups but keep their email until they are erased
+	mockRepo.GetByEmailReturns(repository.User{}, repository.ErrUserNotFound)
+	mockRepo.CreateReturns(repository.ErrEmailAlreadyTaken)
+
+	req := service.CreateUserRequest{
+		Name:     "John Doe",
+		Email:    "john@example.com",
+		Password: "password123",
+	}
+
+	result, err := userService.CreateUser(ctx, req)
+
+	assert.Equal(t, service.ErrUserAlreadyExists, err)
+	assert.Equal(t, service.CreateUserResponse{}, result)
+}
+
 func TestUserService_CreateUser_CheckExistingUserError(t *testing.T) {
 	mockRepo := &repositoryfakes.FakeUserRepository{}
-	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo)
+	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{}, service.Config{})
 	ctx := context.Background()
 
 	// Mock repository to return database error
@@ -114,9 +145,31 @@ func TestUserService_CreateUser_CheckExistingUserError(t *testing.T) {
 	assert.Equal(t, 0, mockRepo.CreateCallCount()) // Create should not be called
 }
 
+func TestUserService_CreateUser_PasswordPolicyViolation(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeUserRepository{}
+	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{}, service.Config{
+		PasswordPolicy: service.PasswordPolicy{MinLength: 12},
+	})
+	ctx := context.Background()
+
+	mockRepo.GetByEmailReturns(repository.User{}, repository.ErrUserNotFound)
+
+	req := service.CreateUserRequest{
+		Name:     "John Doe",
+		Email:    "john@example.com",
+		Password: "password123",
+	}
+
+	result, err := userService.CreateUser(ctx, req)
+
+	assert.ErrorIs(t, err, service.ErrPasswordPolicyViolation)
+	assert.Equal(t, service.CreateUserResponse{}, result)
+	assert.Equal(t, 0, mockRepo.CreateCallCount()) // Create should not be called
+}
+
 func TestUserService_CreateUser_CreateError(t *testing.T) {
 	mockRepo := &repositoryfakes.FakeUserRepository{}
-	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo)
+	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{}, service.Config{})
 	ctx := context.Background()
 
 	// Mock repository to return "user not found" then fail on create
@@ -140,3 +193,56 @@ func TestUserService_CreateUser_CreateError(t *testing.T) {
 	assert.Equal(t, 1, mockRepo.GetByEmailCallCount())
 	assert.Equal(t, 1, mockRepo.CreateCallCount())
 }
+
+func TestUserService_CreateUser_SendsVerificationEmail(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeUserRepository{}
+	var outbox bytes.Buffer
+	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{Mailer: mailer.NewWriterMailer("no-reply@example.com", &outbox)}, service.Config{
+		EmailVerificationTTL: 48 * time.Hour,
+		EmailVerificationURL: "https://api.example.com/v1/auth/verify",
+	})
+	ctx := context.Background()
+
+	mockRepo.GetByEmailReturns(repository.User{}, repository.ErrUserNotFound)
+
+	_, err := userService.CreateUser(ctx, service.CreateUserRequest{
+		Name:     "John Doe",
+		Email:    "john@example.com",
+		Password: "password123",
+	})
+	require.NoError(t, err)
+
+	// The verification token is stored hashed for the new user's email
+	require.Equal(t, 1, mockRepo.CreateEmailVerificationTokenCallCount())
+	_, actualEmail, verificationToken := mockRepo.CreateEmailVerificationTokenArgsForCall(0)
+	assert.Equal(t, "john@example.com", actualEmail)
+	assert.WithinDuration(t, time.Now().Add(48*time.Hour), verificationToken.ExpiresAt, time.Minute)
+
+	// The email links to the verify endpoint with the raw token
+	mail := outbox.String()
+	assert.Contains(t, mail, "To: john@example.com")
+	link := regexp.MustCompile(`https://api\.example\.com/v1/auth/verify\?token=\S+`).FindString(mail)
+	require.NotEmpty(t, link)
+	parsed, err := url.Parse(link)
+	require.NoError(t, err)
+	assert.Equal(t, verificationToken.TokenHash, token.Hash(parsed.Query().Get("token")))
+}
+
+func TestUserService_CreateUser_VerificationEmailError(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeUserRepository{}
+	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{Mailer: failingMailer{}}, service.Config{})
+	ctx := context.Background()
+
+	mockRepo.GetByEmailReturns(repository.User{}, repository.ErrUserNotFound)
+
+	result, err := userService.CreateUser(ctx, service.CreateUserRequest{
+		Name:     "John Doe",
+		Email:    "john@example.com",
+		Password: "password123",
+	})
+
+	// The account is created even when the verification email cannot be sent
+	assert.NoError(t, err)
+	assert.Equal(t, "john@example.com", result.Email)
+	assert.Equal(t, 1, mockRepo.CreateCallCount())
+}
@@ -12,6 +12,10 @@ func (s *userService) DeleteUser(ctx context.Context, id uuid.UUID) error {
 		slog.String("user_id", id.String()),
 	)
 
+	if _, err := s.authorizeSelfOrManager(ctx, id); err != nil {
+		return err
+	}
+
 	_, err := s.userRepo.GetByID(ctx, id)
 	if err != nil {
 		return err
@@ -7,17 +7,18 @@ import (
 	"github.com/fikryfahrezy/let-it-go/feature/user/repository"
 	"github.com/fikryfahrezy/let-it-go/feature/user/repository/repositoryfakes"
 	"github.com/fikryfahrezy/let-it-go/feature/user/service"
+	"github.com/fikryfahrezy/let-it-go/pkg/http_server"
 	"github.com/fikryfahrezy/let-it-go/pkg/logger"
+	"github.com/fikryfahrezy/let-it-go/pkg/rbac"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 )
 
 func TestUserService_DeleteUser_Success(t *testing.T) {
 	mockRepo := &repositoryfakes.FakeUserRepository{}
-	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo)
-	ctx := context.Background()
-
+	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{}, service.Config{})
 	userID := uuid.New()
+	ctx := http_server.ContextWithPrincipal(context.Background(), http_server.Principal{UserID: userID})
 	mockRepo.DeleteReturns(nil)
 
 	err := userService.DeleteUser(ctx, userID)
@@ -32,10 +33,13 @@ func TestUserService_DeleteUser_Success(t *testing.T) {
 
 func TestUserService_DeleteUser_NotFound(t *testing.T) {
 	mockRepo := &repositoryfakes.FakeUserRepository{}
-	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo)
-	ctx := context.Background()
-
+	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{}, service.Config{})
 	userID := uuid.New()
+	// Admins may change any user
+	ctx := http_server.ContextWithPrincipal(context.Background(), http_server.Principal{
+		UserID: uuid.New(),
+		Roles:  []string{rbac.RoleAdmin},
+	})
 	mockRepo.DeleteReturns(repository.ErrUserNotFound)
 
 	err := userService.DeleteUser(ctx, userID)
@@ -46,3 +50,19 @@ func TestUserService_DeleteUser_NotFound(t *testing.T) {
 	// Verify repository calls
 	assert.Equal(t, 1, mockRepo.DeleteCallCount())
 }
+
+func TestUserService_DeleteUser_OtherUser(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeUserRepository{}
+	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{}, service.Config{})
+
+	// Editors can publish any blog but cannot manage other users
+	ctx := http_server.ContextWithPrincipal(context.Background(), http_server.Principal{
+		UserID: uuid.New(),
+		Roles:  []string{rbac.RoleEditor},
+	})
+
+	err := userService.DeleteUser(ctx, uuid.New())
+
+	assert.Equal(t, service.ErrUserForbidden, err)
+	assert.Equal(t, 0, mockRepo.DeleteCallCount())
+}
@@ -0,0 +1,21 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+	"time"
+)
+
+// EraseDeletedUsers anonymizes the users whose deletion grace period is over
+func (s *userService) EraseDeletedUsers(ctx context.Context) (int64, error) {
+	erased, err := s.userRepo.EraseDeletedUsers(ctx, time.Now().Add(-s.deletionGracePeriod()))
+	if err != nil {
+		return 0, err
+	}
+
+	s.log.Info("Deleted users erased",
+		slog.Int64("count", erased),
+	)
+
+	return erased, nil
+}
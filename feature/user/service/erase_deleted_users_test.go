@@ -0,0 +1,44 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/fikryfahrezy/let-it-go/feature/user/repository"
+	"github.com/fikryfahrezy/let-it-go/feature/user/repository/repositoryfakes"
+	"github.com/fikryfahrezy/let-it-go/feature/user/service"
+	"github.com/fikryfahrezy/let-it-go/pkg/logger"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUserService_EraseDeletedUsers_Success(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeUserRepository{}
+	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{}, service.Config{
+		DeletionGracePeriod: 24 * time.Hour,
+	})
+	ctx := context.Background()
+
+	mockRepo.EraseDeletedUsersReturns(2, nil)
+
+	erased, err := userService.EraseDeletedUsers(ctx)
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), erased)
+
+	_, deletedBefore := mockRepo.EraseDeletedUsersArgsForCall(0)
+	assert.WithinDuration(t, time.Now().Add(-24*time.Hour), deletedBefore, time.Minute)
+}
+
+func TestUserService_EraseDeletedUsers_RepositoryError(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeUserRepository{}
+	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{}, service.Config{})
+	ctx := context.Background()
+
+	mockRepo.EraseDeletedUsersReturns(0, repository.ErrFailedToEraseUsers)
+
+	erased, err := userService.EraseDeletedUsers(ctx)
+
+	assert.ErrorIs(t, err, repository.ErrFailedToEraseUsers)
+	assert.Equal(t, int64(0), erased)
+}
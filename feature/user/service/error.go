@@ -10,7 +10,68 @@ var (
 	// Authentication errors
 	ErrInvalidCredentials   = app_error.New("USER-INVALID_CREDENTIALS", "invalid credentials")
 	ErrFailedToHashPassword = app_error.New("USER-FAILED_TO_HASH_PASSWORD", "failed to hash password")
+	ErrFailedToIssueToken   = app_error.New("USER-FAILED_TO_ISSUE_TOKEN", "failed to issue access token")
+	ErrAccountLocked        = app_error.New("USER-ACCOUNT_LOCKED", "too many failed attempts, account is temporarily locked")
+
+	// Password errors
+	ErrIncorrectCurrentPassword = app_error.New("USER-INCORRECT_CURRENT_PASSWORD", "current password is incorrect")
+	ErrPasswordPolicyViolation  = app_error.New("USER-PASSWORD_POLICY_VIOLATION", "password does not meet the password policy")
+
+	// Session errors
+	ErrInvalidRefreshToken       = app_error.New("USER-INVALID_REFRESH_TOKEN", "invalid or expired refresh token")
+	ErrRefreshTokenReused        = app_error.New("USER-REFRESH_TOKEN_REUSED", "refresh token was already used, session revoked")
+	ErrFailedToIssueRefreshToken = app_error.New("USER-FAILED_TO_ISSUE_REFRESH_TOKEN", "failed to issue refresh token")
+
+	// Personal access token errors
+	ErrInvalidPersonalAccessToken = app_error.New("USER-INVALID_PERSONAL_ACCESS_TOKEN", "invalid, expired or revoked personal access token")
+	ErrInvalidScope               = app_error.New("USER-INVALID_SCOPE", "scope does not exist")
+	ErrInvalidTokenExpiry         = app_error.New("USER-INVALID_TOKEN_EXPIRY", "token expiry must be in the future")
+
+	// Two factor authentication errors
+	ErrTwoFactorUnavailable      = app_error.New("USER-TWO_FACTOR_UNAVAILABLE", "two factor authentication is not configured")
+	ErrTwoFactorNotEnabled       = app_error.New("USER-TWO_FACTOR_NOT_ENABLED", "two factor authentication is not enabled")
+	ErrInvalidTwoFactorCode      = app_error.New("USER-INVALID_TWO_FACTOR_CODE", "invalid two factor code")
+	ErrInvalidTwoFactorChallenge = app_error.New("USER-INVALID_TWO_FACTOR_CHALLENGE", "invalid or expired two factor challenge")
+	ErrFailedToSetUpTwoFactor    = app_error.New("USER-FAILED_TO_SET_UP_TWO_FACTOR", "failed to set up two factor authentication")
+
+	// OpenID Connect errors
+	ErrOIDCUnavailable     = app_error.New("USER-OIDC_UNAVAILABLE", "openid connect login is not configured")
+	ErrInvalidOIDCState    = app_error.New("USER-INVALID_OIDC_STATE", "invalid or expired openid connect login state")
+	ErrOIDCLoginFailed     = app_error.New("USER-OIDC_LOGIN_FAILED", "openid connect login failed")
+	ErrOIDCEmailMissing    = app_error.New("USER-OIDC_EMAIL_MISSING", "identity provider did not share an email address")
+	ErrOIDCEmailUnverified = app_error.New("USER-OIDC_EMAIL_UNVERIFIED", "an account with this email already exists and the identity provider has not verified the email")
+	ErrOIDCUserUnverified  = app_error.New("USER-OIDC_USER_UNVERIFIED", "an account with this email already exists and its owner has not verified the email")
+	ErrFailedToStartOIDC   = app_error.New("USER-FAILED_TO_START_OIDC", "failed to start openid connect login")
+
+	// Password reset errors
+	ErrInvalidPasswordResetToken = app_error.New("USER-INVALID_PASSWORD_RESET_TOKEN", "invalid or expired password reset token")
+	ErrFailedToSendResetEmail    = app_error.New("USER-FAILED_TO_SEND_RESET_EMAIL", "failed to send password reset email")
+
+	// Email verification errors
+	ErrInvalidEmailVerificationToken = app_error.New("USER-INVALID_EMAIL_VERIFICATION_TOKEN", "invalid or expired email verification token")
+
+	// Authorization errors
+	ErrUserForbidden       = app_error.New("USER-FORBIDDEN", "you are not allowed to access this user")
+	ErrInvalidRole         = app_error.New("USER-INVALID_ROLE", "role does not exist")
+	ErrCannotChangeOwnRole = app_error.New("USER-CANNOT_CHANGE_OWN_ROLE", "you cannot change your own role")
+
+	// Impersonation errors
+	ErrCannotImpersonate = app_error.New("USER-CANNOT_IMPERSONATE", "you cannot impersonate yourself or a user who can manage users")
+
+	// Data export and profile errors
+	ErrExportUnavailable  = app_error.New("USER-EXPORT_UNAVAILABLE", "user data export is not configured")
+	ErrProfileUnavailable = app_error.New("USER-PROFILE_UNAVAILABLE", "user profiles are not configured")
+
+	// Preferences errors
+	ErrInvalidPreferences            = app_error.New("USER-INVALID_PREFERENCES", "user preferences could not be read or written")
+	ErrUnsupportedPreferencesVersion = app_error.New("USER-UNSUPPORTED_PREFERENCES_VERSION", "user preferences were saved by a newer version")
+
+	// Batch operation errors
+	ErrBatchTooLarge         = app_error.New("USER-BATCH_TOO_LARGE", "batch has more operations than allowed")
+	ErrInvalidBatchOperation = app_error.New("USER-INVALID_BATCH_OPERATION", "batch operation is invalid")
+	ErrBatchAborted          = app_error.New("USER-BATCH_ABORTED", "operation was not applied because another operation of the batch failed")
 
 	// Validation errors (service-specific)
+	ErrInvalidDateRange          = app_error.New("USER-INVALID_DATE_RANGE", "created_from must be before created_to")
 	ErrFailedToCheckExistingUser = app_error.New("USER-FAILED_TO_CHECK_EXISTING_USER", "failed to check existing user")
 )
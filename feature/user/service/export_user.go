@@ -0,0 +1,90 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// exportBlogPageSize is how many blogs are read at a time while exporting
+const exportBlogPageSize = 100
+
+// ExportUser returns the user's profile, preferences, blogs of every status, signed-in
+// devices and unrevoked personal access tokens. Secrets such as hashes are never included.
+func (s *userService) ExportUser(ctx context.Context, userID uuid.UUID) (ExportUserResponse, error) {
+	s.log.Info("Exporting user data",
+		slog.String("user_id", userID.String()),
+	)
+
+	principal, err := s.authorizeSelfOrManager(ctx, userID)
+	if err != nil {
+		return ExportUserResponse{}, err
+	}
+
+	if s.blogReader == nil {
+		return ExportUserResponse{}, ErrExportUnavailable
+	}
+
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return ExportUserResponse{}, err
+	}
+
+	blogs := []ExportedBlogResponse{}
+	for offset := 0; ; offset += exportBlogPageSize {
+		page, err := s.blogReader.GetByAuthorID(ctx, userID, exportBlogPageSize, offset)
+		if err != nil {
+			return ExportUserResponse{}, err
+		}
+		for _, blog := range page {
+			blogs = append(blogs, ToExportedBlogResponse(blog))
+		}
+		if len(page) < exportBlogPageSize {
+			break
+		}
+	}
+
+	sessions, err := s.userRepo.ListActiveSessionsByUserID(ctx, userID)
+	if err != nil {
+		return ExportUserResponse{}, err
+	}
+	sessionResponses := make([]SessionResponse, len(sessions))
+	for i, session := range sessions {
+		sessionResponses[i] = ToSessionResponse(session, principal.SessionID)
+	}
+
+	accessTokens, err := s.userRepo.ListPersonalAccessTokensByUserID(ctx, userID)
+	if err != nil {
+		return ExportUserResponse{}, err
+	}
+	accessTokenResponses := make([]PersonalAccessTokenResponse, len(accessTokens))
+	for i, accessToken := range accessTokens {
+		accessTokenResponses[i] = ToPersonalAccessTokenResponse(accessToken)
+	}
+
+	profile, err := s.toGetUserResponse(ctx, user)
+	if err != nil {
+		return ExportUserResponse{}, err
+	}
+
+	preferences, err := s.loadPreferences(ctx, userID)
+	if err != nil {
+		return ExportUserResponse{}, err
+	}
+
+	s.log.Info("User data exported successfully",
+		slog.String("user_id", userID.String()),
+		slog.Int("blogs", len(blogs)),
+	)
+
+	return ExportUserResponse{
+		Profile:              profile,
+		Preferences:          preferences,
+		Blogs:                blogs,
+		Sessions:             sessionResponses,
+		PersonalAccessTokens: accessTokenResponses,
+		ExportedAt:           time.Now(),
+	}, nil
+}
@@ -0,0 +1,40 @@
+package service
+
+import (
+	"time"
+
+	blogRepository "github.com/fikryfahrezy/let-it-go/feature/blog/repository"
+	"github.com/google/uuid"
+)
+
+// ExportUserResponse is the archive of everything stored about a user
+type ExportUserResponse struct {
+	Profile              GetUserResponse               `json:"profile"`
+	Preferences          PreferencesResponse           `json:"preferences"`
+	Blogs                []ExportedBlogResponse        `json:"blogs"`
+	Sessions             []SessionResponse             `json:"sessions"`
+	PersonalAccessTokens []PersonalAccessTokenResponse `json:"personal_access_tokens"`
+	ExportedAt           time.Time                     `json:"exported_at"`
+}
+
+type ExportedBlogResponse struct {
+	ID          uuid.UUID  `json:"id"`
+	Title       string     `json:"title"`
+	Content     string     `json:"content"`
+	Status      string     `json:"status"`
+	PublishedAt *time.Time `json:"published_at"`
+	CreatedAt   time.Time  `json:"created_at"`
+	UpdatedAt   time.Time  `json:"updated_at"`
+}
+
+func ToExportedBlogResponse(b blogRepository.Blog) ExportedBlogResponse {
+	return ExportedBlogResponse{
+		ID:          b.ID,
+		Title:       b.Title,
+		Content:     b.Content,
+		Status:      b.Status,
+		PublishedAt: b.PublishedAt,
+		CreatedAt:   b.CreatedAt,
+		UpdatedAt:   b.UpdatedAt,
+	}
+}
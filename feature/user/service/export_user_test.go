@@ -0,0 +1,142 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	blogRepository "github.com/fikryfahrezy/let-it-go/feature/blog/repository"
+	"github.com/fikryfahrezy/let-it-go/feature/user/repository"
+	"github.com/fikryfahrezy/let-it-go/feature/user/repository/repositoryfakes"
+	"github.com/fikryfahrezy/let-it-go/feature/user/service"
+	"github.com/fikryfahrezy/let-it-go/feature/user/service/servicefakes"
+	"github.com/fikryfahrezy/let-it-go/pkg/http_server"
+	"github.com/fikryfahrezy/let-it-go/pkg/logger"
+	"github.com/fikryfahrezy/let-it-go/pkg/rbac"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUserService_ExportUser_Success(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeUserRepository{}
+	mockBlogs := &servicefakes.FakeBlogReader{}
+	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{BlogReader: mockBlogs}, service.Config{})
+	userID := uuid.New()
+	sessionID := uuid.New()
+	ctx := http_server.ContextWithPrincipal(context.Background(), http_server.Principal{
+		UserID:    userID,
+		SessionID: sessionID,
+	})
+
+	mockRepo.GetByIDReturns(repository.User{
+		ID:       userID,
+		Name:     "John Doe",
+		Email:    "john@example.com",
+		Password: "hashedpassword",
+		Role:     rbac.RoleAuthor,
+	}, nil)
+	mockRepo.ListActiveSessionsByUserIDReturns([]repository.Session{
+		{ID: uuid.New(), FamilyID: sessionID, UserID: userID, RefreshTokenHash: "hash", ExpiresAt: time.Now().Add(time.Hour)},
+	}, nil)
+	mockRepo.ListPersonalAccessTokensByUserIDReturns([]repository.PersonalAccessToken{
+		{ID: uuid.New(), UserID: userID, Name: "CI", TokenHash: "hash", Scopes: []string{rbac.ScopeBlogsWrite}},
+	}, nil)
+	mockRepo.GetPreferencesReturns(repository.UserPreferences{
+		UserID:        userID,
+		SchemaVersion: 1,
+		Document:      []byte(`{"locale":"id"}`),
+	}, nil)
+
+	// A full page is followed by a shorter one
+	firstPage := make([]blogRepository.Blog, 100)
+	for i := range firstPage {
+		firstPage[i] = blogRepository.Blog{ID: uuid.New(), AuthorID: userID, Status: blogRepository.StatusPublished}
+	}
+	mockBlogs.GetByAuthorIDReturnsOnCall(0, firstPage, nil)
+	mockBlogs.GetByAuthorIDReturnsOnCall(1, []blogRepository.Blog{
+		{ID: uuid.New(), Title: "Draft", AuthorID: userID, Status: blogRepository.StatusDraft},
+	}, nil)
+
+	result, err := userService.ExportUser(ctx, userID)
+
+	require.NoError(t, err)
+	assert.Equal(t, userID, result.Profile.ID)
+	assert.Equal(t, "john@example.com", result.Profile.Email)
+	assert.Len(t, result.Blogs, 101)
+	assert.Equal(t, "Draft", result.Blogs[100].Title)
+	require.Len(t, result.Sessions, 1)
+	assert.True(t, result.Sessions[0].Current)
+	require.Len(t, result.PersonalAccessTokens, 1)
+	assert.Equal(t, "CI", result.PersonalAccessTokens[0].Name)
+	assert.Equal(t, "id", result.Preferences.Locale)
+	assert.WithinDuration(t, time.Now(), result.ExportedAt, time.Minute)
+
+	assert.Equal(t, 2, mockBlogs.GetByAuthorIDCallCount())
+	_, authorID, limit, offset := mockBlogs.GetByAuthorIDArgsForCall(1)
+	assert.Equal(t, userID, authorID)
+	assert.Equal(t, 100, limit)
+	assert.Equal(t, 100, offset)
+}
+
+func TestUserService_ExportUser_Manager(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeUserRepository{}
+	mockBlogs := &servicefakes.FakeBlogReader{}
+	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{BlogReader: mockBlogs}, service.Config{})
+	ctx := http_server.ContextWithPrincipal(context.Background(), http_server.Principal{
+		UserID: uuid.New(),
+		Roles:  []string{rbac.RoleAdmin},
+	})
+	mockRepo.GetPreferencesReturns(repository.UserPreferences{}, repository.ErrPreferencesNotFound)
+
+	result, err := userService.ExportUser(ctx, uuid.New())
+
+	require.NoError(t, err)
+	// Empty collections are exported as empty lists
+	assert.NotNil(t, result.Blogs)
+	assert.NotNil(t, result.Sessions)
+	assert.NotNil(t, result.PersonalAccessTokens)
+}
+
+func TestUserService_ExportUser_OtherUser(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeUserRepository{}
+	mockBlogs := &servicefakes.FakeBlogReader{}
+	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{BlogReader: mockBlogs}, service.Config{})
+	ctx := http_server.ContextWithPrincipal(context.Background(), http_server.Principal{
+		UserID: uuid.New(),
+		Roles:  []string{rbac.RoleEditor},
+	})
+
+	_, err := userService.ExportUser(ctx, uuid.New())
+
+	assert.Equal(t, service.ErrUserForbidden, err)
+	assert.Equal(t, 0, mockRepo.GetByIDCallCount())
+	assert.Equal(t, 0, mockBlogs.GetByAuthorIDCallCount())
+}
+
+func TestUserService_ExportUser_NotFound(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeUserRepository{}
+	mockBlogs := &servicefakes.FakeBlogReader{}
+	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{BlogReader: mockBlogs}, service.Config{})
+	userID := uuid.New()
+	ctx := http_server.ContextWithPrincipal(context.Background(), http_server.Principal{UserID: userID})
+
+	// Deleted users are not found
+	mockRepo.GetByIDReturns(repository.User{}, repository.ErrUserNotFound)
+
+	_, err := userService.ExportUser(ctx, userID)
+
+	assert.Equal(t, repository.ErrUserNotFound, err)
+	assert.Equal(t, 0, mockBlogs.GetByAuthorIDCallCount())
+}
+
+func TestUserService_ExportUser_Unavailable(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeUserRepository{}
+	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{}, service.Config{})
+	userID := uuid.New()
+	ctx := http_server.ContextWithPrincipal(context.Background(), http_server.Principal{UserID: userID})
+
+	_, err := userService.ExportUser(ctx, userID)
+
+	assert.Equal(t, service.ErrExportUnavailable, err)
+}
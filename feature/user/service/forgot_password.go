@@ -0,0 +1,76 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/fikryfahrezy/let-it-go/feature/user/repository"
+	"github.com/fikryfahrezy/let-it-go/pkg/mailer"
+	"github.com/fikryfahrezy/let-it-go/pkg/token"
+	"github.com/google/uuid"
+)
+
+const emailedTokenBytes = 32
+
+// ForgotPassword emails a single-use password reset link. It succeeds for unknown
+// emails too, so the endpoint cannot be used to find out which accounts exist.
+func (s *userService) ForgotPassword(ctx context.Context, req ForgotPasswordRequest) error {
+	s.log.Info("Password reset requested",
+		slog.String("email", req.Email),
+	)
+
+	user, err := s.userRepo.GetByEmail(ctx, req.Email)
+	if err != nil {
+		if errors.Is(err, repository.ErrUserNotFound) {
+			s.log.Warn("Password reset requested for unknown email",
+				slog.String("email", req.Email),
+			)
+			return nil
+		}
+		return err
+	}
+
+	rawToken, err := token.GenerateOpaque(emailedTokenBytes)
+	if err != nil {
+		s.log.Error("Failed to generate password reset token",
+			slog.String("error", err.Error()),
+			slog.String("user_id", user.ID.String()),
+		)
+		return fmt.Errorf("%w: %w", ErrFailedToSendResetEmail, err)
+	}
+
+	resetToken := repository.PasswordResetToken{
+		ID:        uuid.Must(uuid.NewV7()),
+		UserID:    user.ID,
+		TokenHash: token.Hash(rawToken),
+		ExpiresAt: time.Now().Add(s.config.PasswordResetTTL),
+	}
+	if err := s.userRepo.CreatePasswordResetToken(ctx, resetToken); err != nil {
+		return err
+	}
+
+	message := mailer.Message{
+		To:      user.Email,
+		Subject: "Reset your password",
+		Body: fmt.Sprintf(
+			"Hi %s,\n\nUse the link below to choose a new password. It expires in %s and can be used once.\n\n%s\n\nIf you did not ask to reset your password, you can ignore this email.\n",
+			user.Name, s.config.PasswordResetTTL, token.Link(s.config.PasswordResetURL, rawToken),
+		),
+	}
+	if err := s.mailer.Send(ctx, message); err != nil {
+		s.log.Error("Failed to send password reset email",
+			slog.String("error", err.Error()),
+			slog.String("user_id", user.ID.String()),
+		)
+		return fmt.Errorf("%w: %w", ErrFailedToSendResetEmail, err)
+	}
+
+	s.log.Info("Password reset email sent",
+		slog.String("user_id", user.ID.String()),
+	)
+
+	return nil
+}
@@ -0,0 +1,103 @@
+package service_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/url"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/fikryfahrezy/let-it-go/feature/user/repository"
+	"github.com/fikryfahrezy/let-it-go/feature/user/repository/repositoryfakes"
+	"github.com/fikryfahrezy/let-it-go/feature/user/service"
+	"github.com/fikryfahrezy/let-it-go/pkg/logger"
+	"github.com/fikryfahrezy/let-it-go/pkg/mailer"
+	"github.com/fikryfahrezy/let-it-go/pkg/token"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testServiceConfig = service.Config{
+	PasswordResetTTL: time.Hour,
+	PasswordResetURL: "https://app.example.com/reset-password",
+}
+
+type failingMailer struct{}
+
+func (failingMailer) Send(context.Context, mailer.Message) error {
+	return mailer.ErrFailedToSendMail
+}
+
+func TestUserService_ForgotPassword_Success(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeUserRepository{}
+	var outbox bytes.Buffer
+	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{Mailer: mailer.NewWriterMailer("no-reply@example.com", &outbox)}, testServiceConfig)
+	ctx := context.Background()
+
+	user := repository.User{ID: uuid.New(), Name: "John Doe", Email: "john@example.com"}
+	mockRepo.GetByEmailReturns(user, nil)
+
+	err := userService.ForgotPassword(ctx, service.ForgotPasswordRequest{Email: "john@example.com"})
+	require.NoError(t, err)
+
+	// The stored token is hashed and expires after the configured TTL
+	require.Equal(t, 1, mockRepo.CreatePasswordResetTokenCallCount())
+	_, resetToken := mockRepo.CreatePasswordResetTokenArgsForCall(0)
+	assert.Equal(t, user.ID, resetToken.UserID)
+	assert.WithinDuration(t, time.Now().Add(time.Hour), resetToken.ExpiresAt, time.Minute)
+
+	// The email carries the raw token in the reset link
+	mail := outbox.String()
+	assert.Contains(t, mail, "To: john@example.com")
+	link := regexp.MustCompile(`https://app\.example\.com/reset-password\?token=\S+`).FindString(mail)
+	require.NotEmpty(t, link)
+	parsed, err := url.Parse(link)
+	require.NoError(t, err)
+	rawToken := parsed.Query().Get("token")
+	assert.Equal(t, resetToken.TokenHash, token.Hash(rawToken))
+	assert.NotContains(t, mail, resetToken.TokenHash)
+}
+
+func TestUserService_ForgotPassword_UnknownEmail(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeUserRepository{}
+	var outbox bytes.Buffer
+	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{Mailer: mailer.NewWriterMailer("no-reply@example.com", &outbox)}, testServiceConfig)
+	ctx := context.Background()
+
+	mockRepo.GetByEmailReturns(repository.User{}, repository.ErrUserNotFound)
+
+	err := userService.ForgotPassword(ctx, service.ForgotPasswordRequest{Email: "nobody@example.com"})
+
+	// Unknown emails look like a success and send nothing
+	assert.NoError(t, err)
+	assert.Equal(t, 0, mockRepo.CreatePasswordResetTokenCallCount())
+	assert.Empty(t, outbox.String())
+}
+
+func TestUserService_ForgotPassword_RepositoryError(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeUserRepository{}
+	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{Mailer: failingMailer{}}, testServiceConfig)
+	ctx := context.Background()
+
+	dbError := errors.New("database connection error")
+	mockRepo.GetByEmailReturns(repository.User{}, dbError)
+
+	err := userService.ForgotPassword(ctx, service.ForgotPasswordRequest{Email: "john@example.com"})
+
+	assert.Equal(t, dbError, err)
+}
+
+func TestUserService_ForgotPassword_MailError(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeUserRepository{}
+	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{Mailer: failingMailer{}}, testServiceConfig)
+	ctx := context.Background()
+
+	mockRepo.GetByEmailReturns(repository.User{ID: uuid.New(), Email: "john@example.com"}, nil)
+
+	err := userService.ForgotPassword(ctx, service.ForgotPasswordRequest{Email: "john@example.com"})
+
+	assert.ErrorIs(t, err, service.ErrFailedToSendResetEmail)
+}
@@ -0,0 +1,34 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"strings"
+
+	"github.com/fikryfahrezy/let-it-go/feature/user/repository"
+)
+
+// GetAuthor returns the public profile of the author with the handle
+func (s *userService) GetAuthor(ctx context.Context, handle string) (AuthorResponse, error) {
+	s.log.Info("Getting author",
+		slog.String("handle", handle),
+	)
+
+	// Handles are stored in lowercase
+	profile, err := s.userRepo.GetAuthorProfileByHandle(ctx, strings.ToLower(handle))
+	if err != nil {
+		return AuthorResponse{}, err
+	}
+
+	user, err := s.userRepo.GetByID(ctx, profile.UserID)
+	if err != nil {
+		// The user was deleted after the profile was read
+		if errors.Is(err, repository.ErrUserNotFound) {
+			return AuthorResponse{}, repository.ErrAuthorProfileNotFound
+		}
+		return AuthorResponse{}, err
+	}
+
+	return ToAuthorResponse(user, profile), nil
+}
@@ -0,0 +1,62 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/fikryfahrezy/let-it-go/feature/user/repository"
+	"github.com/fikryfahrezy/let-it-go/feature/user/repository/repositoryfakes"
+	"github.com/fikryfahrezy/let-it-go/feature/user/service"
+	"github.com/fikryfahrezy/let-it-go/pkg/logger"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUserService_GetAuthor_Success(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeUserRepository{}
+	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{}, service.Config{})
+
+	userID := uuid.New()
+	joinedAt := time.Now().Add(-24 * time.Hour)
+	mockRepo.GetAuthorProfileByHandleReturns(repository.AuthorProfile{UserID: userID, Handle: "jane", Bio: "Writes about Go"}, nil)
+	mockRepo.GetByIDReturns(repository.User{ID: userID, Name: "Jane Doe", Email: "jane@example.com", CreatedAt: joinedAt}, nil)
+
+	result, err := userService.GetAuthor(context.Background(), "Jane")
+
+	require.NoError(t, err)
+	assert.Equal(t, userID, result.ID)
+	assert.Equal(t, "Jane Doe", result.Name)
+	assert.Equal(t, "Writes about Go", result.Bio)
+	assert.Equal(t, joinedAt, result.JoinedAt)
+	assert.NotNil(t, result.SocialLinks)
+
+	// Handles are looked up in lowercase
+	_, actualHandle := mockRepo.GetAuthorProfileByHandleArgsForCall(0)
+	assert.Equal(t, "jane", actualHandle)
+}
+
+func TestUserService_GetAuthor_NotFound(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeUserRepository{}
+	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{}, service.Config{})
+
+	mockRepo.GetAuthorProfileByHandleReturns(repository.AuthorProfile{}, repository.ErrAuthorProfileNotFound)
+
+	_, err := userService.GetAuthor(context.Background(), "nobody")
+
+	assert.Equal(t, repository.ErrAuthorProfileNotFound, err)
+	assert.Equal(t, 0, mockRepo.GetByIDCallCount())
+}
+
+func TestUserService_GetAuthor_DeletedMeanwhile(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeUserRepository{}
+	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{}, service.Config{})
+
+	mockRepo.GetAuthorProfileByHandleReturns(repository.AuthorProfile{UserID: uuid.New(), Handle: "jane"}, nil)
+	mockRepo.GetByIDReturns(repository.User{}, repository.ErrUserNotFound)
+
+	_, err := userService.GetAuthor(context.Background(), "jane")
+
+	assert.Equal(t, repository.ErrAuthorProfileNotFound, err)
+}
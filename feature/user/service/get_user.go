@@ -2,21 +2,37 @@ package service
 
 import (
 	"context"
+	"errors"
 	"log/slog"
 
+	"github.com/fikryfahrezy/let-it-go/feature/user/repository"
 	"github.com/google/uuid"
 )
 
+// GetUserByID returns a user to themselves or to callers allowed to manage users
 func (s *userService) GetUserByID(ctx context.Context, id uuid.UUID) (GetUserResponse, error) {
 	s.log.Info("Getting user by ID",
 		slog.String("user_id", id.String()),
 	)
 
+	if _, err := s.authorizeSelfOrManager(ctx, id); err != nil {
+		return GetUserResponse{}, err
+	}
+
 	user, err := s.userRepo.GetByID(ctx, id)
 	if err != nil {
 		return GetUserResponse{}, err
 	}
 
-	response := ToGetUserResponse(user)
-	return response, nil
+	return s.toGetUserResponse(ctx, user)
+}
+
+// toGetUserResponse converts the user together with their author profile, if they set one up
+func (s *userService) toGetUserResponse(ctx context.Context, user repository.User) (GetUserResponse, error) {
+	profile, err := s.userRepo.GetAuthorProfile(ctx, user.ID)
+	if err != nil && !errors.Is(err, repository.ErrAuthorProfileNotFound) {
+		return GetUserResponse{}, err
+	}
+
+	return ToGetUserResponseWithAuthorProfile(user, profile), nil
 }
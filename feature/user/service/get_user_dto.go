@@ -8,19 +8,43 @@ import (
 )
 
 type GetUserResponse struct {
-	ID        uuid.UUID `json:"id"`
-	Name      string    `json:"name"`
-	Email     string    `json:"email"`
-	CreatedAt time.Time `json:"created_at"`
-	UpdatedAt time.Time `json:"updated_at"`
+	ID         uuid.UUID  `json:"id"`
+	Name       string     `json:"name"`
+	Email      string     `json:"email"`
+	Role       string     `json:"role"`
+	VerifiedAt *time.Time `json:"verified_at"`
+	// Author profile fields, empty until the user sets up a profile
+	Handle      string            `json:"handle"`
+	Bio         string            `json:"bio"`
+	AvatarURL   string            `json:"avatar_url"`
+	WebsiteURL  string            `json:"website_url"`
+	SocialLinks map[string]string `json:"social_links"`
+	CreatedAt   time.Time         `json:"created_at"`
+	UpdatedAt   time.Time         `json:"updated_at"`
 }
 
 func ToGetUserResponse(u repository.User) GetUserResponse {
 	return GetUserResponse{
-		ID:        u.ID,
-		Name:      u.Name,
-		Email:     u.Email,
-		CreatedAt: u.CreatedAt,
-		UpdatedAt: u.UpdatedAt,
+		ID:          u.ID,
+		Name:        u.Name,
+		Email:       u.Email,
+		Role:        u.Role,
+		VerifiedAt:  u.VerifiedAt,
+		SocialLinks: map[string]string{},
+		CreatedAt:   u.CreatedAt,
+		UpdatedAt:   u.UpdatedAt,
 	}
 }
+
+// ToGetUserResponseWithAuthorProfile is ToGetUserResponse including the user's author profile
+func ToGetUserResponseWithAuthorProfile(u repository.User, p repository.AuthorProfile) GetUserResponse {
+	response := ToGetUserResponse(u)
+	response.Handle = p.Handle
+	response.Bio = p.Bio
+	response.AvatarURL = p.AvatarURL
+	response.WebsiteURL = p.WebsiteURL
+	if p.SocialLinks != nil {
+		response.SocialLinks = p.SocialLinks
+	}
+	return response
+}
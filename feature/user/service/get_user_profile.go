@@ -0,0 +1,118 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	blogRepository "github.com/fikryfahrezy/let-it-go/feature/blog/repository"
+	"github.com/google/uuid"
+)
+
+// profileRecentPostCount is how many published posts a profile shows
+const profileRecentPostCount = 5
+
+// profileBlogStatuses are the statuses counted on a profile
+var profileBlogStatuses = []string{
+	blogRepository.StatusDraft,
+	blogRepository.StatusScheduled,
+	blogRepository.StatusPublished,
+	blogRepository.StatusArchived,
+}
+
+// GetUserProfile returns the user with their blog counts per status, most recently
+// published posts and when they were last active. Like GetUserByID it is only shown to
+// the user themselves and to callers allowed to manage users.
+func (s *userService) GetUserProfile(ctx context.Context, id uuid.UUID) (UserProfileResponse, error) {
+	s.log.Info("Getting user profile",
+		slog.String("user_id", id.String()),
+	)
+
+	if _, err := s.authorizeSelfOrManager(ctx, id); err != nil {
+		return UserProfileResponse{}, err
+	}
+
+	if s.blogReader == nil {
+		return UserProfileResponse{}, ErrProfileUnavailable
+	}
+
+	user, err := s.userRepo.GetByID(ctx, id)
+	if err != nil {
+		return UserProfileResponse{}, err
+	}
+
+	blogCounts := make(map[string]int64, len(profileBlogStatuses))
+	for _, status := range profileBlogStatuses {
+		count, err := s.blogReader.CountByAuthorIDAndStatus(ctx, id, status)
+		if err != nil {
+			return UserProfileResponse{}, err
+		}
+		blogCounts[status] = count
+	}
+
+	published, err := s.blogReader.GetPublishedByAuthorID(ctx, id, profileRecentPostCount)
+	if err != nil {
+		return UserProfileResponse{}, err
+	}
+	recentPosts := make([]ProfilePostResponse, len(published))
+	for i, blog := range published {
+		recentPosts[i] = ToProfilePostResponse(blog)
+	}
+
+	lastActiveAt, err := s.lastActiveAt(ctx, user.ID, user.UpdatedAt)
+	if err != nil {
+		return UserProfileResponse{}, err
+	}
+
+	userResponse, err := s.toGetUserResponse(ctx, user)
+	if err != nil {
+		return UserProfileResponse{}, err
+	}
+
+	return UserProfileResponse{
+		User:         userResponse,
+		BlogCounts:   blogCounts,
+		RecentPosts:  recentPosts,
+		LastActiveAt: lastActiveAt,
+	}, nil
+}
+
+// lastActiveAt is the latest of since, the last refresh of an active session, the last
+// use of a personal access token and the last change to the newest blog
+func (s *userService) lastActiveAt(ctx context.Context, userID uuid.UUID, since time.Time) (time.Time, error) {
+	latest := since
+	observe := func(t time.Time) {
+		if t.After(latest) {
+			latest = t
+		}
+	}
+
+	sessions, err := s.userRepo.ListActiveSessionsByUserID(ctx, userID)
+	if err != nil {
+		return time.Time{}, err
+	}
+	for _, session := range sessions {
+		// Every refresh creates a new row, so created_at is when the session was last used
+		observe(session.CreatedAt)
+	}
+
+	accessTokens, err := s.userRepo.ListPersonalAccessTokensByUserID(ctx, userID)
+	if err != nil {
+		return time.Time{}, err
+	}
+	for _, accessToken := range accessTokens {
+		if accessToken.LastUsedAt != nil {
+			observe(*accessToken.LastUsedAt)
+		}
+	}
+
+	newest, err := s.blogReader.GetByAuthorID(ctx, userID, 1, 0)
+	if err != nil {
+		return time.Time{}, err
+	}
+	for _, blog := range newest {
+		observe(blog.UpdatedAt)
+	}
+
+	return latest, nil
+}
@@ -0,0 +1,148 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	blogRepository "github.com/fikryfahrezy/let-it-go/feature/blog/repository"
+	"github.com/fikryfahrezy/let-it-go/feature/user/repository"
+	"github.com/fikryfahrezy/let-it-go/feature/user/repository/repositoryfakes"
+	"github.com/fikryfahrezy/let-it-go/feature/user/service"
+	"github.com/fikryfahrezy/let-it-go/feature/user/service/servicefakes"
+	"github.com/fikryfahrezy/let-it-go/pkg/http_server"
+	"github.com/fikryfahrezy/let-it-go/pkg/logger"
+	"github.com/fikryfahrezy/let-it-go/pkg/rbac"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUserService_GetUserProfile_Success(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeUserRepository{}
+	mockBlogs := &servicefakes.FakeBlogReader{}
+	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{BlogReader: mockBlogs}, service.Config{})
+	ctx := adminContext()
+
+	userID := uuid.New()
+	updatedAt := time.Now().Add(-72 * time.Hour)
+	tokenUsedAt := time.Now().Add(-time.Hour)
+	publishedAt := time.Now().Add(-48 * time.Hour)
+
+	mockRepo.GetByIDReturns(repository.User{
+		ID:        userID,
+		Name:      "John Doe",
+		Email:     "john@example.com",
+		CreatedAt: updatedAt,
+		UpdatedAt: updatedAt,
+	}, nil)
+	mockRepo.ListActiveSessionsByUserIDReturns([]repository.Session{
+		{ID: uuid.New(), UserID: userID, CreatedAt: time.Now().Add(-3 * time.Hour)},
+	}, nil)
+	mockRepo.ListPersonalAccessTokensByUserIDReturns([]repository.PersonalAccessToken{
+		{ID: uuid.New(), UserID: userID, LastUsedAt: &tokenUsedAt},
+		{ID: uuid.New(), UserID: userID},
+	}, nil)
+	mockBlogs.CountByAuthorIDAndStatusCalls(func(_ context.Context, _ uuid.UUID, status string) (int64, error) {
+		return map[string]int64{
+			blogRepository.StatusDraft:     2,
+			blogRepository.StatusScheduled: 1,
+			blogRepository.StatusPublished: 3,
+		}[status], nil
+	})
+	mockBlogs.GetPublishedByAuthorIDReturns([]blogRepository.Blog{
+		{ID: uuid.New(), Title: "Latest", Content: "Content", AuthorID: userID, Status: blogRepository.StatusPublished, PublishedAt: &publishedAt},
+	}, nil)
+	mockBlogs.GetByAuthorIDReturns([]blogRepository.Blog{
+		{ID: uuid.New(), AuthorID: userID, UpdatedAt: time.Now().Add(-2 * time.Hour)},
+	}, nil)
+
+	result, err := userService.GetUserProfile(ctx, userID)
+
+	require.NoError(t, err)
+	assert.Equal(t, userID, result.User.ID)
+	assert.Equal(t, map[string]int64{
+		blogRepository.StatusDraft:     2,
+		blogRepository.StatusScheduled: 1,
+		blogRepository.StatusPublished: 3,
+		blogRepository.StatusArchived:  0,
+	}, result.BlogCounts)
+	require.Len(t, result.RecentPosts, 1)
+	assert.Equal(t, "Latest", result.RecentPosts[0].Title)
+	// The personal access token was used last
+	assert.True(t, tokenUsedAt.Equal(result.LastActiveAt))
+
+	// Every count is scoped to the user
+	for i := 0; i < mockBlogs.CountByAuthorIDAndStatusCallCount(); i++ {
+		_, authorID, _ := mockBlogs.CountByAuthorIDAndStatusArgsForCall(i)
+		assert.Equal(t, userID, authorID)
+	}
+	_, authorID, limit := mockBlogs.GetPublishedByAuthorIDArgsForCall(0)
+	assert.Equal(t, userID, authorID)
+	assert.Equal(t, 5, limit)
+}
+
+func TestUserService_GetUserProfile_NoActivity(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeUserRepository{}
+	mockBlogs := &servicefakes.FakeBlogReader{}
+	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{BlogReader: mockBlogs}, service.Config{})
+	ctx := adminContext()
+
+	updatedAt := time.Now().Add(-time.Hour)
+	mockRepo.GetByIDReturns(repository.User{ID: uuid.New(), UpdatedAt: updatedAt}, nil)
+
+	result, err := userService.GetUserProfile(ctx, uuid.New())
+
+	require.NoError(t, err)
+	assert.NotNil(t, result.RecentPosts)
+	assert.True(t, updatedAt.Equal(result.LastActiveAt))
+}
+
+func TestUserService_GetUserProfile_NotFound(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeUserRepository{}
+	mockBlogs := &servicefakes.FakeBlogReader{}
+	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{BlogReader: mockBlogs}, service.Config{})
+	ctx := adminContext()
+
+	mockRepo.GetByIDReturns(repository.User{}, repository.ErrUserNotFound)
+
+	_, err := userService.GetUserProfile(ctx, uuid.New())
+
+	assert.Equal(t, repository.ErrUserNotFound, err)
+	assert.Equal(t, 0, mockBlogs.CountByAuthorIDAndStatusCallCount())
+}
+
+func TestUserService_GetUserProfile_BlogError(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeUserRepository{}
+	mockBlogs := &servicefakes.FakeBlogReader{}
+	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{BlogReader: mockBlogs}, service.Config{})
+	ctx := adminContext()
+
+	mockRepo.GetByIDReturns(repository.User{ID: uuid.New()}, nil)
+	mockBlogs.CountByAuthorIDAndStatusReturns(0, blogRepository.ErrFailedToCountBlogsByStatus)
+
+	_, err := userService.GetUserProfile(ctx, uuid.New())
+
+	assert.ErrorIs(t, err, blogRepository.ErrFailedToCountBlogsByStatus)
+}
+
+func TestUserService_GetUserProfile_Unavailable(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeUserRepository{}
+	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{}, service.Config{})
+
+	_, err := userService.GetUserProfile(adminContext(), uuid.New())
+
+	assert.Equal(t, service.ErrProfileUnavailable, err)
+}
+
+func TestUserService_GetUserProfile_OtherUser(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeUserRepository{}
+	mockBlogs := &servicefakes.FakeBlogReader{}
+	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{BlogReader: mockBlogs}, service.Config{})
+	ctx := http_server.ContextWithPrincipal(context.Background(), http_server.Principal{UserID: uuid.New(), Roles: []string{rbac.RoleEditor}})
+
+	_, err := userService.GetUserProfile(ctx, uuid.New())
+
+	assert.Equal(t, service.ErrUserForbidden, err)
+	assert.Equal(t, 0, mockRepo.GetByIDCallCount())
+}
@@ -8,15 +8,17 @@ import (
 	"github.com/fikryfahrezy/let-it-go/feature/user/repository"
 	"github.com/fikryfahrezy/let-it-go/feature/user/repository/repositoryfakes"
 	"github.com/fikryfahrezy/let-it-go/feature/user/service"
+	"github.com/fikryfahrezy/let-it-go/pkg/http_server"
 	"github.com/fikryfahrezy/let-it-go/pkg/logger"
+	"github.com/fikryfahrezy/let-it-go/pkg/rbac"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 )
 
 func TestUserService_GetUserByID_Success(t *testing.T) {
 	mockRepo := &repositoryfakes.FakeUserRepository{}
-	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo)
-	ctx := context.Background()
+	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{}, service.Config{})
+	ctx := adminContext()
 
 	userID := uuid.New()
 	expectedUser := repository.User{
@@ -47,8 +49,8 @@ func TestUserService_GetUserByID_Success(t *testing.T) {
 
 func TestUserService_GetUserByID_NotFound(t *testing.T) {
 	mockRepo := &repositoryfakes.FakeUserRepository{}
-	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo)
-	ctx := context.Background()
+	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{}, service.Config{})
+	ctx := adminContext()
 
 	userID := uuid.New()
 	mockRepo.GetByIDReturns(repository.User{}, repository.ErrUserNotFound)
@@ -62,3 +64,68 @@ func TestUserService_GetUserByID_NotFound(t *testing.T) {
 	// Verify repository calls
 	assert.Equal(t, 1, mockRepo.GetByIDCallCount())
 }
+
+func TestUserService_GetUserByID_WithAuthorProfile(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeUserRepository{}
+	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{}, service.Config{})
+	ctx := adminContext()
+
+	userID := uuid.New()
+	mockRepo.GetByIDReturns(repository.User{ID: userID, Name: "John Doe", Email: "john@example.com"}, nil)
+	mockRepo.GetAuthorProfileReturns(repository.AuthorProfile{
+		UserID:      userID,
+		Handle:      "john",
+		Bio:         "Writes about Go",
+		SocialLinks: map[string]string{"github": "john"},
+	}, nil)
+
+	result, err := userService.GetUserByID(ctx, userID)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "john", result.Handle)
+	assert.Equal(t, "Writes about Go", result.Bio)
+	assert.Equal(t, map[string]string{"github": "john"}, result.SocialLinks)
+}
+
+func TestUserService_GetUserByID_WithoutAuthorProfile(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeUserRepository{}
+	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{}, service.Config{})
+	ctx := adminContext()
+
+	userID := uuid.New()
+	mockRepo.GetByIDReturns(repository.User{ID: userID, Name: "John Doe"}, nil)
+	mockRepo.GetAuthorProfileReturns(repository.AuthorProfile{}, repository.ErrAuthorProfileNotFound)
+
+	result, err := userService.GetUserByID(ctx, userID)
+
+	assert.NoError(t, err)
+	assert.Empty(t, result.Handle)
+	assert.Empty(t, result.SocialLinks)
+}
+
+func TestUserService_GetUserByID_Self(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeUserRepository{}
+	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{}, service.Config{})
+
+	userID := uuid.New()
+	ctx := http_server.ContextWithPrincipal(context.Background(), http_server.Principal{UserID: userID, Roles: []string{rbac.RoleAuthor}})
+	mockRepo.GetByIDReturns(repository.User{ID: userID, Name: "John Doe", Email: "john@example.com"}, nil)
+
+	result, err := userService.GetUserByID(ctx, userID)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "john@example.com", result.Email)
+}
+
+func TestUserService_GetUserByID_OtherUser(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeUserRepository{}
+	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{}, service.Config{})
+
+	// Reading someone else's email needs the users:manage permission
+	ctx := http_server.ContextWithPrincipal(context.Background(), http_server.Principal{UserID: uuid.New(), Roles: []string{rbac.RoleEditor}})
+
+	_, err := userService.GetUserByID(ctx, uuid.New())
+
+	assert.Equal(t, service.ErrUserForbidden, err)
+	assert.Equal(t, 0, mockRepo.GetByIDCallCount())
+}
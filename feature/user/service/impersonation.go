@@ -0,0 +1,112 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/fikryfahrezy/let-it-go/feature/user/repository"
+	"github.com/fikryfahrezy/let-it-go/pkg/http_server"
+	"github.com/fikryfahrezy/let-it-go/pkg/rbac"
+	"github.com/fikryfahrezy/let-it-go/pkg/token"
+	"github.com/google/uuid"
+)
+
+// defaultImpersonationTTL is used when Config.ImpersonationTTL is zero
+const defaultImpersonationTTL = 15 * time.Minute
+
+// StartImpersonation issues an access token that lets the calling admin act as the user.
+// The token carries both identities, cannot be refreshed and is recorded together with
+// the reason before it is handed out. Users who can manage users cannot be impersonated,
+// so impersonation never grants more than the admin already has.
+func (s *userService) StartImpersonation(ctx context.Context, userID uuid.UUID, req StartImpersonationRequest) (ImpersonationResponse, error) {
+	principal, ok := http_server.PrincipalFromContext(ctx)
+	if !ok || principal.Impersonating() || !rbac.Can(principal, rbac.PermissionUsersManage) {
+		s.log.Warn("Impersonation denied",
+			slog.String("user_id", userID.String()),
+			slog.String("caller_id", principal.UserID.String()),
+		)
+		return ImpersonationResponse{}, ErrUserForbidden
+	}
+
+	if principal.UserID == userID {
+		return ImpersonationResponse{}, ErrCannotImpersonate
+	}
+
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return ImpersonationResponse{}, err
+	}
+
+	if rbac.Can(http_server.Principal{Roles: []string{user.Role}}, rbac.PermissionUsersManage) {
+		s.log.Warn("Impersonation of a user manager denied",
+			slog.String("user_id", userID.String()),
+			slog.String("caller_id", principal.UserID.String()),
+		)
+		return ImpersonationResponse{}, ErrCannotImpersonate
+	}
+
+	ttl := s.impersonationTTL()
+	session := repository.ImpersonationSession{
+		ID:        uuid.Must(uuid.NewV7()),
+		AdminID:   principal.UserID,
+		UserID:    user.ID,
+		Reason:    req.Reason,
+		UserAgent: truncateUserAgent(req.UserAgent),
+		IPAddress: req.IPAddress,
+		ExpiresAt: time.Now().Add(ttl),
+	}
+	if err := s.userRepo.CreateImpersonationSession(ctx, session); err != nil {
+		return ImpersonationResponse{}, err
+	}
+
+	accessToken, expiresAt, err := s.tokenManager.IssueImpersonationToken(token.Subject{
+		UserID: user.ID,
+		Email:  user.Email,
+		Roles:  []string{user.Role},
+	}, token.Impersonation{
+		ID:             session.ID,
+		ImpersonatorID: principal.UserID,
+		TTL:            ttl,
+	})
+	if err != nil {
+		s.log.Error("Failed to issue impersonation token",
+			slog.String("error", err.Error()),
+			slog.String("impersonation_id", session.ID.String()),
+		)
+		return ImpersonationResponse{}, fmt.Errorf("%w: %w", ErrFailedToIssueToken, err)
+	}
+
+	s.log.Info("Impersonation started",
+		slog.String("impersonation_id", session.ID.String()),
+		slog.String("user_id", user.ID.String()),
+		slog.String("impersonator_id", principal.UserID.String()),
+	)
+
+	return ImpersonationResponse{
+		ImpersonationID: session.ID,
+		UserID:          user.ID,
+		AccessToken:     accessToken,
+		TokenType:       TokenTypeBearer,
+		ExpiresAt:       expiresAt,
+	}, nil
+}
+
+// RecordImpersonatedRequest implements http_server.ImpersonationAuditor by adding the
+// request to the audit trail of the principal's impersonation session
+func (s *userService) RecordImpersonatedRequest(ctx context.Context, principal http_server.Principal, request http_server.ImpersonatedRequest) error {
+	return s.userRepo.CreateImpersonationRequest(ctx, repository.ImpersonationRequest{
+		SessionID: principal.ImpersonationID,
+		Method:    request.Method,
+		Path:      request.Path,
+		Status:    request.Status,
+	})
+}
+
+func (s *userService) impersonationTTL() time.Duration {
+	if s.config.ImpersonationTTL > 0 {
+		return s.config.ImpersonationTTL
+	}
+	return defaultImpersonationTTL
+}
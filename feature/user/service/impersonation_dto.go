@@ -0,0 +1,24 @@
+package service
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type StartImpersonationRequest struct {
+	// Reason is kept in the audit trail, such as the support ticket being worked on
+	Reason string `json:"reason" validate:"required,max=500"`
+
+	// Filled by the handler from the HTTP request to describe the admin's client
+	UserAgent string `json:"-"`
+	IPAddress string `json:"-"`
+}
+
+type ImpersonationResponse struct {
+	ImpersonationID uuid.UUID `json:"impersonation_id"`
+	UserID          uuid.UUID `json:"user_id"`
+	AccessToken     string    `json:"access_token"`
+	TokenType       string    `json:"token_type"`
+	ExpiresAt       time.Time `json:"expires_at"`
+}
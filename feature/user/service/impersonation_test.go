@@ -0,0 +1,172 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/fikryfahrezy/let-it-go/feature/user/repository"
+	"github.com/fikryfahrezy/let-it-go/feature/user/repository/repositoryfakes"
+	"github.com/fikryfahrezy/let-it-go/feature/user/service"
+	"github.com/fikryfahrezy/let-it-go/pkg/http_server"
+	"github.com/fikryfahrezy/let-it-go/pkg/logger"
+	"github.com/fikryfahrezy/let-it-go/pkg/rbac"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUserService_StartImpersonation_Success(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeUserRepository{}
+	tokenManager := newTestTokenManager(t)
+	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{TokenManager: tokenManager}, service.Config{
+		ImpersonationTTL: 10 * time.Minute,
+	})
+
+	adminID := uuid.New()
+	ctx := http_server.ContextWithPrincipal(context.Background(), http_server.Principal{
+		UserID: adminID,
+		Roles:  []string{rbac.RoleAdmin},
+	})
+	user := repository.User{ID: uuid.New(), Email: "author@example.com", Role: rbac.RoleAuthor}
+	mockRepo.GetByIDReturns(user, nil)
+
+	result, err := userService.StartImpersonation(ctx, user.ID, service.StartImpersonationRequest{
+		Reason:    "Ticket #42",
+		UserAgent: "support-console",
+		IPAddress: "10.0.0.1",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, user.ID, result.UserID)
+	assert.Equal(t, service.TokenTypeBearer, result.TokenType)
+	assert.WithinDuration(t, time.Now().Add(10*time.Minute), result.ExpiresAt, time.Minute)
+
+	// The session is recorded with the reason before the token is handed out
+	require.Equal(t, 1, mockRepo.CreateImpersonationSessionCallCount())
+	_, session := mockRepo.CreateImpersonationSessionArgsForCall(0)
+	assert.Equal(t, result.ImpersonationID, session.ID)
+	assert.Equal(t, adminID, session.AdminID)
+	assert.Equal(t, user.ID, session.UserID)
+	assert.Equal(t, "Ticket #42", session.Reason)
+	assert.Equal(t, "support-console", session.UserAgent)
+	assert.Equal(t, "10.0.0.1", session.IPAddress)
+
+	// The token authenticates as the user and carries the admin as the impersonator
+	principal, err := tokenManager.Authenticate(context.Background(), result.AccessToken)
+	require.NoError(t, err)
+	assert.Equal(t, user.ID, principal.UserID)
+	assert.Equal(t, []string{rbac.RoleAuthor}, principal.Roles)
+	assert.True(t, principal.Impersonating())
+	assert.Equal(t, adminID, principal.ImpersonatorID)
+	assert.Equal(t, session.ID, principal.ImpersonationID)
+	assert.Equal(t, uuid.Nil, principal.SessionID)
+}
+
+func TestUserService_StartImpersonation_NotAdmin(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeUserRepository{}
+	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{TokenManager: newTestTokenManager(t)}, service.Config{})
+
+	_, err := userService.StartImpersonation(userContext(uuid.New()), uuid.New(), service.StartImpersonationRequest{Reason: "Ticket #42"})
+
+	assert.Equal(t, service.ErrUserForbidden, err)
+	assert.Equal(t, 0, mockRepo.CreateImpersonationSessionCallCount())
+}
+
+func TestUserService_StartImpersonation_WhileImpersonating(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeUserRepository{}
+	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{TokenManager: newTestTokenManager(t)}, service.Config{})
+
+	ctx := http_server.ContextWithPrincipal(context.Background(), http_server.Principal{
+		UserID:          uuid.New(),
+		Roles:           []string{rbac.RoleAdmin},
+		ImpersonatorID:  uuid.New(),
+		ImpersonationID: uuid.New(),
+	})
+
+	_, err := userService.StartImpersonation(ctx, uuid.New(), service.StartImpersonationRequest{Reason: "Ticket #42"})
+
+	assert.Equal(t, service.ErrUserForbidden, err)
+	assert.Equal(t, 0, mockRepo.CreateImpersonationSessionCallCount())
+}
+
+func TestUserService_StartImpersonation_Self(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeUserRepository{}
+	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{TokenManager: newTestTokenManager(t)}, service.Config{})
+
+	adminID := uuid.New()
+	ctx := http_server.ContextWithPrincipal(context.Background(), http_server.Principal{
+		UserID: adminID,
+		Roles:  []string{rbac.RoleAdmin},
+	})
+
+	_, err := userService.StartImpersonation(ctx, adminID, service.StartImpersonationRequest{Reason: "Ticket #42"})
+
+	assert.Equal(t, service.ErrCannotImpersonate, err)
+	assert.Equal(t, 0, mockRepo.CreateImpersonationSessionCallCount())
+}
+
+func TestUserService_StartImpersonation_OtherAdmin(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeUserRepository{}
+	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{TokenManager: newTestTokenManager(t)}, service.Config{})
+
+	mockRepo.GetByIDReturns(repository.User{ID: uuid.New(), Role: rbac.RoleAdmin}, nil)
+
+	_, err := userService.StartImpersonation(adminContext(), uuid.New(), service.StartImpersonationRequest{Reason: "Ticket #42"})
+
+	assert.Equal(t, service.ErrCannotImpersonate, err)
+	assert.Equal(t, 0, mockRepo.CreateImpersonationSessionCallCount())
+}
+
+func TestUserService_StartImpersonation_UserNotFound(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeUserRepository{}
+	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{TokenManager: newTestTokenManager(t)}, service.Config{})
+
+	mockRepo.GetByIDReturns(repository.User{}, repository.ErrUserNotFound)
+
+	_, err := userService.StartImpersonation(adminContext(), uuid.New(), service.StartImpersonationRequest{Reason: "Ticket #42"})
+
+	assert.Equal(t, repository.ErrUserNotFound, err)
+	assert.Equal(t, 0, mockRepo.CreateImpersonationSessionCallCount())
+}
+
+func TestUserService_StartImpersonation_AuditError(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeUserRepository{}
+	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{TokenManager: newTestTokenManager(t)}, service.Config{})
+
+	mockRepo.GetByIDReturns(repository.User{ID: uuid.New(), Role: rbac.RoleAuthor}, nil)
+	dbError := errors.New("database connection error")
+	mockRepo.CreateImpersonationSessionReturns(dbError)
+
+	result, err := userService.StartImpersonation(adminContext(), uuid.New(), service.StartImpersonationRequest{Reason: "Ticket #42"})
+
+	// No token is handed out unless the session was recorded
+	assert.Equal(t, dbError, err)
+	assert.Empty(t, result.AccessToken)
+}
+
+func TestUserService_RecordImpersonatedRequest(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeUserRepository{}
+	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{}, service.Config{})
+
+	principal := http_server.Principal{
+		UserID:          uuid.New(),
+		ImpersonatorID:  uuid.New(),
+		ImpersonationID: uuid.New(),
+	}
+
+	err := userService.RecordImpersonatedRequest(context.Background(), principal, http_server.ImpersonatedRequest{
+		Method: http.MethodPut,
+		Path:   "/v1/blogs/7",
+		Status: http.StatusOK,
+	})
+	require.NoError(t, err)
+
+	require.Equal(t, 1, mockRepo.CreateImpersonationRequestCallCount())
+	_, request := mockRepo.CreateImpersonationRequestArgsForCall(0)
+	assert.Equal(t, principal.ImpersonationID, request.SessionID)
+	assert.Equal(t, http.MethodPut, request.Method)
+	assert.Equal(t, "/v1/blogs/7", request.Path)
+	assert.Equal(t, http.StatusOK, request.Status)
+}
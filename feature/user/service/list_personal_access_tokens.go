@@ -0,0 +1,31 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/google/uuid"
+)
+
+// ListPersonalAccessTokens returns the unrevoked personal access tokens of the user
+func (s *userService) ListPersonalAccessTokens(ctx context.Context, userID uuid.UUID) ([]PersonalAccessTokenResponse, error) {
+	s.log.Info("Listing personal access tokens",
+		slog.String("user_id", userID.String()),
+	)
+
+	if _, err := s.authorizeSelfOrManager(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	accessTokens, err := s.userRepo.ListPersonalAccessTokensByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	responses := make([]PersonalAccessTokenResponse, len(accessTokens))
+	for i, accessToken := range accessTokens {
+		responses[i] = ToPersonalAccessTokenResponse(accessToken)
+	}
+
+	return responses, nil
+}
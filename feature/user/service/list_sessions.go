@@ -0,0 +1,32 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/google/uuid"
+)
+
+// ListSessions returns the devices the user is currently signed in on
+func (s *userService) ListSessions(ctx context.Context, userID uuid.UUID) ([]SessionResponse, error) {
+	s.log.Info("Listing user sessions",
+		slog.String("user_id", userID.String()),
+	)
+
+	principal, err := s.authorizeSelfOrManager(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	sessions, err := s.userRepo.ListActiveSessionsByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	responses := make([]SessionResponse, len(sessions))
+	for i, session := range sessions {
+		responses[i] = ToSessionResponse(session, principal.SessionID)
+	}
+
+	return responses, nil
+}
@@ -0,0 +1,72 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/fikryfahrezy/let-it-go/feature/user/repository"
+	"github.com/fikryfahrezy/let-it-go/feature/user/repository/repositoryfakes"
+	"github.com/fikryfahrezy/let-it-go/feature/user/service"
+	"github.com/fikryfahrezy/let-it-go/pkg/http_server"
+	"github.com/fikryfahrezy/let-it-go/pkg/logger"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUserService_ListSessions_Success(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeUserRepository{}
+	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{}, service.Config{})
+
+	userID := uuid.New()
+	current := newTestSession(userID, "current-token")
+	current.UserAgent = "laptop"
+	other := newTestSession(userID, "other-token")
+	other.UserAgent = "phone"
+	mockRepo.ListActiveSessionsByUserIDReturns([]repository.Session{current, other}, nil)
+
+	ctx := http_server.ContextWithPrincipal(context.Background(), http_server.Principal{
+		UserID:    userID,
+		SessionID: current.FamilyID,
+	})
+
+	result, err := userService.ListSessions(ctx, userID)
+
+	require.NoError(t, err)
+	require.Len(t, result, 2)
+	assert.Equal(t, current.FamilyID, result[0].ID)
+	assert.Equal(t, "laptop", result[0].UserAgent)
+	assert.True(t, result[0].Current)
+	assert.Equal(t, other.FamilyID, result[1].ID)
+	assert.False(t, result[1].Current)
+	assert.WithinDuration(t, other.ExpiresAt, result[1].ExpiresAt, time.Second)
+
+	_, actualUserID := mockRepo.ListActiveSessionsByUserIDArgsForCall(0)
+	assert.Equal(t, userID, actualUserID)
+}
+
+func TestUserService_ListSessions_OtherUser(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeUserRepository{}
+	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{}, service.Config{})
+
+	ctx := http_server.ContextWithPrincipal(context.Background(), http_server.Principal{
+		UserID: uuid.New(),
+	})
+
+	result, err := userService.ListSessions(ctx, uuid.New())
+
+	assert.Equal(t, service.ErrUserForbidden, err)
+	assert.Nil(t, result)
+	assert.Equal(t, 0, mockRepo.ListActiveSessionsByUserIDCallCount())
+}
+
+func TestUserService_ListSessions_Unauthenticated(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeUserRepository{}
+	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{}, service.Config{})
+
+	_, err := userService.ListSessions(context.Background(), uuid.New())
+
+	assert.Equal(t, service.ErrUserForbidden, err)
+	assert.Equal(t, 0, mockRepo.ListActiveSessionsByUserIDCallCount())
+}
@@ -9,16 +9,25 @@ func (s *userService) ListUsers(ctx context.Context, req ListUsersRequest) ([]Li
 	s.log.Info("Listing users",
 		slog.Int("page", req.Page),
 		slog.Int("page_size", req.PageSize),
+		slog.String("search", req.Search),
+		slog.String("sort_by", req.SortBy),
+		slog.String("sort_order", req.SortOrder),
 	)
 
+	if req.CreatedFrom != nil && req.CreatedTo != nil && !req.CreatedFrom.Before(*req.CreatedTo) {
+		return nil, 0, ErrInvalidDateRange
+	}
+
 	offset := (req.Page - 1) * req.PageSize
+	filter := req.ToListFilter()
 
-	users, err := s.userRepo.List(ctx, req.PageSize, offset)
+	users, err := s.userRepo.List(ctx, filter, req.PageSize, offset)
 	if err != nil {
 		return nil, 0, err
 	}
 
-	total, err := s.userRepo.Count(ctx)
+	// Counted with the same filter so the pagination totals match the list
+	total, err := s.userRepo.Count(ctx, filter)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -8,24 +8,46 @@ import (
 	"github.com/google/uuid"
 )
 
+// ListUsersRequest pages through users matching the optional filters.
+// Without a sort the newest users come first.
 type ListUsersRequest struct {
 	http_server.PaginationRequest
+	Search      string     `json:"search" validate:"omitempty,max=100"` // Prefix of the name or email
+	CreatedFrom *time.Time `json:"created_from"`                        // Inclusive
+	CreatedTo   *time.Time `json:"created_to"`                          // Exclusive
+	SortBy      string     `json:"sort_by" validate:"omitempty,oneof=name email created_at updated_at"`
+	SortOrder   string     `json:"sort_order" validate:"omitempty,oneof=asc desc"`
+}
+
+// ToListFilter converts the request to the repository filter
+func (r ListUsersRequest) ToListFilter() repository.ListFilter {
+	return repository.ListFilter{
+		Search:        r.Search,
+		CreatedFrom:   r.CreatedFrom,
+		CreatedTo:     r.CreatedTo,
+		SortBy:        r.SortBy,
+		SortAscending: r.SortOrder == "asc",
+	}
 }
 
 type ListUsersResponse struct {
-	ID        uuid.UUID `json:"id"`
-	Name      string    `json:"name"`
-	Email     string    `json:"email"`
-	CreatedAt time.Time `json:"created_at"`
-	UpdatedAt time.Time `json:"updated_at"`
+	ID         uuid.UUID  `json:"id"`
+	Name       string     `json:"name"`
+	Email      string     `json:"email"`
+	Role       string     `json:"role"`
+	VerifiedAt *time.Time `json:"verified_at"`
+	CreatedAt  time.Time  `json:"created_at"`
+	UpdatedAt  time.Time  `json:"updated_at"`
 }
 
 func ToListUsersResponse(u repository.User) ListUsersResponse {
 	return ListUsersResponse{
-		ID:        u.ID,
-		Name:      u.Name,
-		Email:     u.Email,
-		CreatedAt: u.CreatedAt,
-		UpdatedAt: u.UpdatedAt,
+		ID:         u.ID,
+		Name:       u.Name,
+		Email:      u.Email,
+		Role:       u.Role,
+		VerifiedAt: u.VerifiedAt,
+		CreatedAt:  u.CreatedAt,
+		UpdatedAt:  u.UpdatedAt,
 	}
 }
@@ -16,7 +16,7 @@ import (
 
 func TestUserService_ListUsers_Success(t *testing.T) {
 	mockRepo := &repositoryfakes.FakeUserRepository{}
-	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo)
+	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{}, service.Config{})
 	ctx := context.Background()
 
 	expectedUsers := []repository.User{
@@ -61,7 +61,7 @@ func TestUserService_ListUsers_Success(t *testing.T) {
 
 	// Verify repository calls
 	assert.Equal(t, 1, mockRepo.ListCallCount())
-	_, limit, offset := mockRepo.ListArgsForCall(0)
+	_, _, limit, offset := mockRepo.ListArgsForCall(0)
 	assert.Equal(t, 10, limit)
 	assert.Equal(t, 0, offset) // (page-1) * pageSize = (1-1) * 10 = 0
 
@@ -70,7 +70,7 @@ func TestUserService_ListUsers_Success(t *testing.T) {
 
 func TestUserService_ListUsers_WithCustomPagination(t *testing.T) {
 	mockRepo := &repositoryfakes.FakeUserRepository{}
-	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo)
+	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{}, service.Config{})
 	ctx := context.Background()
 
 	expectedUsers := []repository.User{
@@ -102,14 +102,14 @@ func TestUserService_ListUsers_WithCustomPagination(t *testing.T) {
 
 	// Verify repository calls
 	assert.Equal(t, 1, mockRepo.ListCallCount())
-	_, limit, offset := mockRepo.ListArgsForCall(0)
+	_, _, limit, offset := mockRepo.ListArgsForCall(0)
 	assert.Equal(t, 5, limit)
 	assert.Equal(t, 10, offset) // (page-1) * pageSize = (3-1) * 5 = 10
 }
 
 func TestUserService_ListUsers_EmptyResult(t *testing.T) {
 	mockRepo := &repositoryfakes.FakeUserRepository{}
-	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo)
+	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{}, service.Config{})
 	ctx := context.Background()
 
 	mockRepo.ListReturns([]repository.User{}, nil)
@@ -132,3 +132,68 @@ func TestUserService_ListUsers_EmptyResult(t *testing.T) {
 	assert.Equal(t, 1, mockRepo.ListCallCount())
 	assert.Equal(t, 1, mockRepo.CountCallCount())
 }
+
+func TestUserService_ListUsers_WithFilter(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeUserRepository{}
+	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{}, service.Config{})
+	ctx := context.Background()
+
+	mockRepo.ListReturns([]repository.User{}, nil)
+	mockRepo.CountReturns(0, nil)
+
+	createdFrom := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	createdTo := time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC)
+	req := service.ListUsersRequest{
+		PaginationRequest: http_server.PaginationRequest{
+			Page:     2,
+			PageSize: 20,
+		},
+		Search:      "john",
+		CreatedFrom: &createdFrom,
+		CreatedTo:   &createdTo,
+		SortBy:      repository.SortByName,
+		SortOrder:   "asc",
+	}
+
+	_, _, err := userService.ListUsers(ctx, req)
+
+	assert.NoError(t, err)
+
+	expectedFilter := repository.ListFilter{
+		Search:        "john",
+		CreatedFrom:   &createdFrom,
+		CreatedTo:     &createdTo,
+		SortBy:        repository.SortByName,
+		SortAscending: true,
+	}
+	_, filter, limit, offset := mockRepo.ListArgsForCall(0)
+	assert.Equal(t, expectedFilter, filter)
+	assert.Equal(t, 20, limit)
+	assert.Equal(t, 20, offset)
+
+	// The total is counted with the same filter
+	_, countFilter := mockRepo.CountArgsForCall(0)
+	assert.Equal(t, expectedFilter, countFilter)
+}
+
+func TestUserService_ListUsers_InvalidDateRange(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeUserRepository{}
+	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{}, service.Config{})
+	ctx := context.Background()
+
+	createdFrom := time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC)
+	createdTo := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	req := service.ListUsersRequest{
+		PaginationRequest: http_server.PaginationRequest{
+			Page:     1,
+			PageSize: 10,
+		},
+		CreatedFrom: &createdFrom,
+		CreatedTo:   &createdTo,
+	}
+
+	_, _, err := userService.ListUsers(ctx, req)
+
+	assert.Equal(t, service.ErrInvalidDateRange, err)
+	assert.Equal(t, 0, mockRepo.ListCallCount())
+}
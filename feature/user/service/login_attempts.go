@@ -0,0 +1,74 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/fikryfahrezy/let-it-go/pkg/app_error"
+)
+
+// checkLockout returns ErrAccountLocked while the account or the client IP is locked out
+// after too many failed credential checks
+func (s *userService) checkLockout(ctx context.Context, account, ip string) error {
+	if s.limiter == nil {
+		return nil
+	}
+
+	retryAfter, err := s.limiter.Check(ctx, account, ip)
+	if err != nil {
+		s.log.Error("Failed to check login attempts",
+			slog.String("error", err.Error()),
+		)
+		return err
+	}
+
+	if retryAfter > 0 {
+		s.log.Warn("Credential check refused, locked out",
+			slog.String("account", account),
+			slog.String("ip_address", ip),
+			slog.Duration("retry_after", retryAfter),
+		)
+		return app_error.New(ErrAccountLocked.Code, fmt.Sprintf("too many failed attempts, try again in %s", retryAfter.Round(time.Second)))
+	}
+
+	return nil
+}
+
+// recordFailedAttempt counts a failed credential check. It only logs on failure so the
+// caller still reports the wrong credentials.
+func (s *userService) recordFailedAttempt(ctx context.Context, account, ip string) {
+	if s.limiter == nil {
+		return
+	}
+
+	lockedFor, err := s.limiter.RecordFailure(ctx, account, ip)
+	if err != nil {
+		s.log.Error("Failed to record failed login attempt",
+			slog.String("error", err.Error()),
+		)
+		return
+	}
+
+	if lockedFor > 0 {
+		s.log.Warn("Locked out after too many failed attempts",
+			slog.String("account", account),
+			slog.String("ip_address", ip),
+			slog.Duration("locked_for", lockedFor),
+		)
+	}
+}
+
+// recordSuccessfulAttempt forgets the failed credential checks of the account
+func (s *userService) recordSuccessfulAttempt(ctx context.Context, account string) {
+	if s.limiter == nil {
+		return
+	}
+
+	if err := s.limiter.RecordSuccess(ctx, account); err != nil {
+		s.log.Error("Failed to reset login attempts",
+			slog.String("error", err.Error()),
+		)
+	}
+}
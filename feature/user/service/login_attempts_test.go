@@ -0,0 +1,194 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/fikryfahrezy/let-it-go/feature/user/repository"
+	"github.com/fikryfahrezy/let-it-go/feature/user/repository/repositoryfakes"
+	"github.com/fikryfahrezy/let-it-go/feature/user/service"
+	"github.com/fikryfahrezy/let-it-go/pkg/app_error"
+	"github.com/fikryfahrezy/let-it-go/pkg/http_server"
+	"github.com/fikryfahrezy/let-it-go/pkg/lockout"
+	"github.com/fikryfahrezy/let-it-go/pkg/logger"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// newTestLimiter locks an account after 3 failures and a client IP after 5
+func newTestLimiter() *lockout.Limiter {
+	return lockout.NewLimiter(lockout.Config{
+		AccountMaxAttempts: 3,
+		IPMaxAttempts:      5,
+		Window:             15 * time.Minute,
+		BaseLockout:        time.Minute,
+		MaxLockout:         time.Hour,
+	}, lockout.NewMemoryStore())
+}
+
+func login(userService service.UserService, email, password, ip string) error {
+	_, err := userService.Authenticate(context.Background(), service.AuthenticateRequest{
+		Email:     email,
+		Password:  password,
+		IPAddress: ip,
+	})
+	return err
+}
+
+func TestUserService_Authenticate_LockedAfterFailedAttempts(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeUserRepository{}
+	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{TokenManager: newTestTokenManager(t), Limiter: newTestLimiter()}, service.Config{})
+
+	user := newTestUserWithPassword(t, "password123", bcrypt.MinCost)
+	mockRepo.GetByEmailReturns(user, nil)
+
+	for range 3 {
+		assert.Equal(t, service.ErrInvalidCredentials, login(userService, user.Email, "wrong-password", "10.0.0.1"))
+	}
+
+	// Even the right password is refused while locked, without checking it
+	err := login(userService, user.Email, "password123", "10.0.0.2")
+	assert.True(t, errors.Is(err, service.ErrAccountLocked))
+	assert.Equal(t, service.ErrAccountLocked.Code, app_error.GetCode(err))
+	assert.Contains(t, app_error.GetMessage(err), "try again in 1m0s")
+	assert.Equal(t, 3, mockRepo.GetByEmailCallCount())
+	assert.Equal(t, 0, mockRepo.CreateSessionCallCount())
+
+	// Accounts are tracked case-insensitively
+	err = login(userService, "JOHN@example.com", "password123", "10.0.0.2")
+	assert.True(t, errors.Is(err, service.ErrAccountLocked))
+}
+
+func TestUserService_Authenticate_UnknownEmailLocked(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeUserRepository{}
+	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{TokenManager: newTestTokenManager(t), Limiter: newTestLimiter()}, service.Config{})
+
+	mockRepo.GetByEmailReturns(repository.User{}, repository.ErrUserNotFound)
+
+	for range 3 {
+		assert.Equal(t, service.ErrInvalidCredentials, login(userService, "ghost@example.com", "guess", "10.0.0.1"))
+	}
+
+	err := login(userService, "ghost@example.com", "guess", "10.0.0.1")
+	assert.True(t, errors.Is(err, service.ErrAccountLocked))
+}
+
+func TestUserService_Authenticate_ClientIPLocked(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeUserRepository{}
+	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{TokenManager: newTestTokenManager(t), Limiter: newTestLimiter()}, service.Config{})
+
+	user := newTestUserWithPassword(t, "password123", bcrypt.MinCost)
+	mockRepo.GetByEmailReturns(user, nil)
+
+	// Spraying one password over many accounts locks the client IP
+	for i := range 5 {
+		email := "victim" + string(rune('a'+i)) + "@example.com"
+		assert.Equal(t, service.ErrInvalidCredentials, login(userService, email, "wrong-password", "10.0.0.1"))
+	}
+
+	err := login(userService, user.Email, "password123", "10.0.0.1")
+	assert.True(t, errors.Is(err, service.ErrAccountLocked))
+
+	// Other clients can still log in to the account
+	assert.NoError(t, login(userService, user.Email, "password123", "10.0.0.2"))
+}
+
+func TestUserService_Authenticate_SuccessResetsFailedAttempts(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeUserRepository{}
+	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{TokenManager: newTestTokenManager(t), Limiter: newTestLimiter()}, service.Config{})
+
+	user := newTestUserWithPassword(t, "password123", bcrypt.MinCost)
+	mockRepo.GetByEmailReturns(user, nil)
+
+	for range 2 {
+		for range 2 {
+			assert.Equal(t, service.ErrInvalidCredentials, login(userService, user.Email, "wrong-password", "10.0.0.1"))
+		}
+		assert.NoError(t, login(userService, user.Email, "password123", "10.0.0.1"))
+	}
+}
+
+func TestUserService_ChangePassword_LockedAfterFailedAttempts(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeUserRepository{}
+	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{Limiter: newTestLimiter()}, service.Config{})
+
+	user := newTestUserWithPassword(t, "old-password", bcrypt.MinCost)
+	mockRepo.GetByIDReturns(user, nil)
+	ctx := http_server.ContextWithPrincipal(context.Background(), http_server.Principal{UserID: user.ID})
+
+	changePassword := func(currentPassword string) error {
+		return userService.ChangePassword(ctx, user.ID, service.ChangePasswordRequest{
+			CurrentPassword: currentPassword,
+			NewPassword:     "new-password",
+			IPAddress:       "10.0.0.1",
+		})
+	}
+
+	for range 3 {
+		assert.Equal(t, service.ErrIncorrectCurrentPassword, changePassword("wrong-password"))
+	}
+
+	err := changePassword("old-password")
+	assert.True(t, errors.Is(err, service.ErrAccountLocked))
+	assert.Equal(t, 0, mockRepo.ChangePasswordCallCount())
+}
+
+func TestUserService_UnlockUser_Success(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeUserRepository{}
+	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{TokenManager: newTestTokenManager(t), Limiter: newTestLimiter()}, service.Config{})
+
+	user := newTestUserWithPassword(t, "password123", bcrypt.MinCost)
+	mockRepo.GetByEmailReturns(user, nil)
+	mockRepo.GetByIDReturns(user, nil)
+
+	for range 3 {
+		_ = login(userService, user.Email, "wrong-password", "10.0.0.1")
+	}
+	require.True(t, errors.Is(login(userService, user.Email, "password123", "10.0.0.2"), service.ErrAccountLocked))
+
+	err := userService.UnlockUser(adminContext(), user.ID)
+	require.NoError(t, err)
+
+	_, actualID := mockRepo.GetByIDArgsForCall(0)
+	assert.Equal(t, user.ID, actualID)
+	assert.NoError(t, login(userService, user.Email, "password123", "10.0.0.2"))
+}
+
+func TestUserService_UnlockUser_Forbidden(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeUserRepository{}
+	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{Limiter: newTestLimiter()}, service.Config{})
+
+	userID := uuid.New()
+	err := userService.UnlockUser(userContext(userID), userID)
+
+	assert.Equal(t, service.ErrUserForbidden, err)
+	assert.Equal(t, 0, mockRepo.GetByIDCallCount())
+}
+
+func TestUserService_UnlockUser_NotFound(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeUserRepository{}
+	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{Limiter: newTestLimiter()}, service.Config{})
+
+	mockRepo.GetByIDReturns(repository.User{}, repository.ErrUserNotFound)
+
+	err := userService.UnlockUser(adminContext(), uuid.New())
+
+	assert.Equal(t, repository.ErrUserNotFound, err)
+}
+
+func TestUserService_PurgeLoginAttempts(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeUserRepository{}
+	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{TokenManager: newTestTokenManager(t), Limiter: newTestLimiter()}, service.Config{})
+
+	mockRepo.GetByEmailReturns(repository.User{}, repository.ErrUserNotFound)
+	_ = login(userService, "ghost@example.com", "guess", "10.0.0.1")
+
+	// Recent failures are still remembered
+	purged, err := userService.PurgeLoginAttempts(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), purged)
+}
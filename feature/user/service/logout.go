@@ -0,0 +1,32 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/fikryfahrezy/let-it-go/feature/user/repository"
+	"github.com/fikryfahrezy/let-it-go/pkg/token"
+)
+
+// Logout revokes the session family the refresh token belongs to, signing out the device
+func (s *userService) Logout(ctx context.Context, req LogoutRequest) error {
+	session, err := s.userRepo.GetSessionByRefreshTokenHash(ctx, token.Hash(req.RefreshToken))
+	if err != nil {
+		if errors.Is(err, repository.ErrSessionNotFound) {
+			return ErrInvalidRefreshToken
+		}
+		return err
+	}
+
+	if err := s.userRepo.RevokeSessionFamily(ctx, session.FamilyID); err != nil {
+		return err
+	}
+
+	s.log.Info("User logged out successfully",
+		slog.String("user_id", session.UserID.String()),
+		slog.String("session_id", session.FamilyID.String()),
+	)
+
+	return nil
+}
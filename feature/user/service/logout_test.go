@@ -0,0 +1,43 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/fikryfahrezy/let-it-go/feature/user/repository"
+	"github.com/fikryfahrezy/let-it-go/feature/user/repository/repositoryfakes"
+	"github.com/fikryfahrezy/let-it-go/feature/user/service"
+	"github.com/fikryfahrezy/let-it-go/pkg/logger"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUserService_Logout_Success(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeUserRepository{}
+	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{TokenManager: newTestTokenManager(t)}, service.Config{})
+	ctx := context.Background()
+
+	session := newTestSession(uuid.New(), "refresh-token")
+	mockRepo.GetSessionByRefreshTokenHashReturns(session, nil)
+
+	err := userService.Logout(ctx, service.LogoutRequest{RefreshToken: "refresh-token"})
+
+	assert.NoError(t, err)
+	require.Equal(t, 1, mockRepo.RevokeSessionFamilyCallCount())
+	_, familyID := mockRepo.RevokeSessionFamilyArgsForCall(0)
+	assert.Equal(t, session.FamilyID, familyID)
+}
+
+func TestUserService_Logout_UnknownToken(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeUserRepository{}
+	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{TokenManager: newTestTokenManager(t)}, service.Config{})
+	ctx := context.Background()
+
+	mockRepo.GetSessionByRefreshTokenHashReturns(repository.Session{}, repository.ErrSessionNotFound)
+
+	err := userService.Logout(ctx, service.LogoutRequest{RefreshToken: "unknown"})
+
+	assert.Equal(t, service.ErrInvalidRefreshToken, err)
+	assert.Equal(t, 0, mockRepo.RevokeSessionFamilyCallCount())
+}
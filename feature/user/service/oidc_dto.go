@@ -0,0 +1,20 @@
+package service
+
+import "time"
+
+// OIDCAuthorizeResponse starts an OpenID Connect login. The client sends the user to
+// AuthorizationURL and posts the code and state the provider redirects back with.
+type OIDCAuthorizeResponse struct {
+	AuthorizationURL string    `json:"authorization_url"`
+	State            string    `json:"state"`
+	ExpiresAt        time.Time `json:"expires_at"`
+}
+
+type OIDCCallbackRequest struct {
+	Code  string `json:"code" validate:"required"`
+	State string `json:"state" validate:"required"`
+
+	// Filled by the handler from the HTTP request to describe the new session
+	UserAgent string `json:"-"`
+	IPAddress string `json:"-"`
+}
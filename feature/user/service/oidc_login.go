@@ -0,0 +1,223 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/fikryfahrezy/let-it-go/feature/user/repository"
+	"github.com/fikryfahrezy/let-it-go/pkg/oidc"
+	"github.com/fikryfahrezy/let-it-go/pkg/rbac"
+	"github.com/fikryfahrezy/let-it-go/pkg/token"
+	"github.com/google/uuid"
+)
+
+const (
+	oidcStateBytes         = 32
+	oidcNonceBytes         = 32
+	defaultOIDCLoginTTL    = 10 * time.Minute
+	maxOIDCDisplayNameSize = 255
+)
+
+// OIDCAuthorize starts an OpenID Connect login. The state, nonce and PKCE verifier are
+// kept server side; only the hash of the state is stored so a leaked table cannot be
+// used to complete someone else's login.
+func (s *userService) OIDCAuthorize(ctx context.Context) (OIDCAuthorizeResponse, error) {
+	if s.oidcProvider == nil {
+		return OIDCAuthorizeResponse{}, ErrOIDCUnavailable
+	}
+
+	state, err := token.GenerateOpaque(oidcStateBytes)
+	if err != nil {
+		return OIDCAuthorizeResponse{}, fmt.Errorf("%w: %w", ErrFailedToStartOIDC, err)
+	}
+	nonce, err := token.GenerateOpaque(oidcNonceBytes)
+	if err != nil {
+		return OIDCAuthorizeResponse{}, fmt.Errorf("%w: %w", ErrFailedToStartOIDC, err)
+	}
+	codeVerifier, err := oidc.GenerateCodeVerifier()
+	if err != nil {
+		return OIDCAuthorizeResponse{}, fmt.Errorf("%w: %w", ErrFailedToStartOIDC, err)
+	}
+
+	authorizationURL, err := s.oidcProvider.AuthCodeURL(ctx, state, nonce, oidc.CodeChallengeS256(codeVerifier))
+	if err != nil {
+		s.log.Error("Failed to build OIDC authorization URL",
+			slog.String("error", err.Error()),
+		)
+		return OIDCAuthorizeResponse{}, fmt.Errorf("%w: %w", ErrFailedToStartOIDC, err)
+	}
+
+	ttl := s.config.OIDCLoginTTL
+	if ttl <= 0 {
+		ttl = defaultOIDCLoginTTL
+	}
+
+	loginRequest := repository.OIDCLoginRequest{
+		ID:           uuid.Must(uuid.NewV7()),
+		StateHash:    token.Hash(state),
+		Nonce:        nonce,
+		CodeVerifier: codeVerifier,
+		ExpiresAt:    time.Now().Add(ttl),
+	}
+	if err := s.userRepo.CreateOIDCLoginRequest(ctx, loginRequest); err != nil {
+		return OIDCAuthorizeResponse{}, err
+	}
+
+	return OIDCAuthorizeResponse{
+		AuthorizationURL: authorizationURL,
+		State:            state,
+		ExpiresAt:        loginRequest.ExpiresAt,
+	}, nil
+}
+
+// OIDCCallback completes an OpenID Connect login with the code and state the provider
+// redirected back with. The identity is looked up by issuer and subject; on first login
+// it is linked to the user with the same email when the provider verified that email,
+// or a new user is created. Two factor authentication applies as it does to password logins.
+func (s *userService) OIDCCallback(ctx context.Context, req OIDCCallbackRequest) (AuthenticateResponse, error) {
+	if s.oidcProvider == nil {
+		return AuthenticateResponse{}, ErrOIDCUnavailable
+	}
+
+	loginRequest, err := s.userRepo.ConsumeOIDCLoginRequest(ctx, token.Hash(req.State))
+	if err != nil {
+		if errors.Is(err, repository.ErrOIDCLoginRequestNotFound) {
+			return AuthenticateResponse{}, ErrInvalidOIDCState
+		}
+		return AuthenticateResponse{}, err
+	}
+	if time.Now().After(loginRequest.ExpiresAt) {
+		return AuthenticateResponse{}, ErrInvalidOIDCState
+	}
+
+	claims, err := s.oidcProvider.Exchange(ctx, req.Code, loginRequest.CodeVerifier, loginRequest.Nonce)
+	if err != nil {
+		s.log.Warn("OIDC code exchange failed",
+			slog.String("error", err.Error()),
+		)
+		return AuthenticateResponse{}, fmt.Errorf("%w: %w", ErrOIDCLoginFailed, err)
+	}
+
+	user, err := s.userForOIDCClaims(ctx, claims)
+	if err != nil {
+		return AuthenticateResponse{}, err
+	}
+
+	challenge, setupRequired, err := s.twoFactorChallengeFor(ctx, user)
+	if err != nil {
+		return AuthenticateResponse{}, err
+	}
+	if challenge != nil {
+		s.log.Info("OIDC login accepted, two factor verification required",
+			slog.String("user_id", user.ID.String()),
+		)
+		return AuthenticateResponse{TwoFactorChallenge: challenge}, nil
+	}
+
+	response, err := s.startSession(ctx, user, setupRequired, req.UserAgent, req.IPAddress)
+	if err != nil {
+		return AuthenticateResponse{}, err
+	}
+
+	s.log.Info("User authenticated with OIDC successfully",
+		slog.String("user_id", user.ID.String()),
+	)
+
+	return response, nil
+}
+
+// userForOIDCClaims returns the user an external identity belongs to, linking or creating it on first login
+func (s *userService) userForOIDCClaims(ctx context.Context, claims oidc.Claims) (repository.User, error) {
+	provider := s.oidcProvider.Issuer()
+
+	identity, err := s.userRepo.GetUserIdentity(ctx, provider, claims.Subject)
+	if err == nil {
+		return s.userRepo.GetByID(ctx, identity.UserID)
+	}
+	if !errors.Is(err, repository.ErrUserIdentityNotFound) {
+		return repository.User{}, err
+	}
+
+	if claims.Email == "" {
+		return repository.User{}, ErrOIDCEmailMissing
+	}
+
+	identity = repository.UserIdentity{
+		ID:       uuid.Must(uuid.NewV7()),
+		Provider: provider,
+		Subject:  claims.Subject,
+		Email:    claims.Email,
+	}
+
+	existingUser, err := s.userRepo.GetByEmail(ctx, claims.Email)
+	if err == nil {
+		// Linking on an unverified email would let anyone who registers the address
+		// at the provider take over the account
+		if !claims.EmailVerified {
+			s.log.Warn("OIDC login refused, email of existing user not verified by provider",
+				slog.String("user_id", existingUser.ID.String()),
+			)
+			return repository.User{}, ErrOIDCEmailUnverified
+		}
+		// Anyone can sign up with someone else's address, so only an account that proved
+		// it owns the address may be linked; otherwise the squatter keeps their password
+		if existingUser.VerifiedAt == nil {
+			s.log.Warn("OIDC login refused, email of existing user never verified",
+				slog.String("user_id", existingUser.ID.String()),
+			)
+			return repository.User{}, ErrOIDCUserUnverified
+		}
+
+		identity.UserID = existingUser.ID
+		if err := s.userRepo.CreateUserIdentity(ctx, identity); err != nil {
+			return repository.User{}, err
+		}
+
+		s.log.Info("External identity linked to existing user",
+			slog.String("user_id", existingUser.ID.String()),
+			slog.String("provider", provider),
+		)
+		return existingUser, nil
+	}
+	if !errors.Is(err, repository.ErrUserNotFound) {
+		return repository.User{}, err
+	}
+
+	name := strings.TrimSpace(claims.Name)
+	if name == "" {
+		name, _, _ = strings.Cut(claims.Email, "@")
+	}
+	// The name column counts characters, and cutting bytes could split a multibyte one
+	if utf8.RuneCountInString(name) > maxOIDCDisplayNameSize {
+		name = string([]rune(name)[:maxOIDCDisplayNameSize])
+	}
+
+	// The empty password hash matches no password, so the account can only sign in
+	// through the provider until a password is set with the forgot password flow
+	user := repository.User{
+		ID:    uuid.Must(uuid.NewV7()),
+		Name:  name,
+		Email: claims.Email,
+		Role:  rbac.DefaultRole,
+	}
+	if claims.EmailVerified {
+		verifiedAt := time.Now()
+		user.VerifiedAt = &verifiedAt
+	}
+
+	if err := s.userRepo.CreateUserWithIdentity(ctx, user, identity); err != nil {
+		return repository.User{}, err
+	}
+
+	s.log.Info("User created from external identity",
+		slog.String("user_id", user.ID.String()),
+		slog.String("provider", provider),
+	)
+
+	return user, nil
+}
@@ -0,0 +1,377 @@
+package service_test
+
+import (
+	"context"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	"github.com/fikryfahrezy/let-it-go/feature/user/repository"
+	"github.com/fikryfahrezy/let-it-go/feature/user/repository/repositoryfakes"
+	"github.com/fikryfahrezy/let-it-go/feature/user/service"
+	"github.com/fikryfahrezy/let-it-go/pkg/logger"
+	"github.com/fikryfahrezy/let-it-go/pkg/oidc"
+	"github.com/fikryfahrezy/let-it-go/pkg/oidc/oidctest"
+	"github.com/fikryfahrezy/let-it-go/pkg/rbac"
+	"github.com/fikryfahrezy/let-it-go/pkg/token"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testOIDCRedirectURL = "https://app.example.com/oidc/callback"
+
+// newOIDCFixture returns a service signing in through a fake provider running in process
+func newOIDCFixture(t *testing.T) (*repositoryfakes.FakeUserRepository, service.UserService, *oidctest.Provider) {
+	t.Helper()
+
+	fakeProvider := oidctest.NewProvider("let-it-go", "client-secret")
+	t.Cleanup(fakeProvider.Close)
+
+	provider, err := oidc.NewProvider(oidc.Config{
+		IssuerURL:    fakeProvider.Issuer(),
+		ClientID:     "let-it-go",
+		ClientSecret: "client-secret",
+		RedirectURL:  testOIDCRedirectURL,
+		Scopes:       []string{"email", "profile"},
+	}, fakeProvider.Client())
+	require.NoError(t, err)
+
+	mockRepo := &repositoryfakes.FakeUserRepository{}
+	mockRepo.GetUserIdentityReturns(repository.UserIdentity{}, repository.ErrUserIdentityNotFound)
+	mockRepo.GetByEmailReturns(repository.User{}, repository.ErrUserNotFound)
+	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{TokenManager: newTestTokenManager(t), OIDCProvider: provider}, service.Config{})
+
+	return mockRepo, userService, fakeProvider
+}
+
+// startOIDCLogin starts a login, signs in at the fake provider as identity and returns
+// the callback request together with the login request the service stored
+func startOIDCLogin(t *testing.T, mockRepo *repositoryfakes.FakeUserRepository, userService service.UserService, fakeProvider *oidctest.Provider, identity oidctest.Identity) (service.OIDCCallbackRequest, repository.OIDCLoginRequest) {
+	t.Helper()
+
+	authorization, err := userService.OIDCAuthorize(context.Background())
+	require.NoError(t, err)
+
+	_, loginRequest := mockRepo.CreateOIDCLoginRequestArgsForCall(mockRepo.CreateOIDCLoginRequestCallCount() - 1)
+	mockRepo.ConsumeOIDCLoginRequestReturns(loginRequest, nil)
+
+	code, state, err := fakeProvider.Authorize(authorization.AuthorizationURL, identity)
+	require.NoError(t, err)
+
+	return service.OIDCCallbackRequest{
+		Code:      code,
+		State:     state,
+		UserAgent: "test-agent",
+		IPAddress: "127.0.0.1",
+	}, loginRequest
+}
+
+func TestUserService_OIDCAuthorize_Success(t *testing.T) {
+	mockRepo, userService, fakeProvider := newOIDCFixture(t)
+
+	result, err := userService.OIDCAuthorize(context.Background())
+	require.NoError(t, err)
+	assert.NotEmpty(t, result.State)
+	assert.WithinDuration(t, time.Now().Add(10*time.Minute), result.ExpiresAt, time.Minute)
+
+	authorizationURL, err := url.Parse(result.AuthorizationURL)
+	require.NoError(t, err)
+	assert.Equal(t, fakeProvider.Issuer()+"/authorize", authorizationURL.Scheme+"://"+authorizationURL.Host+authorizationURL.Path)
+	query := authorizationURL.Query()
+	assert.Equal(t, "openid email profile", query.Get("scope"))
+	assert.Equal(t, testOIDCRedirectURL, query.Get("redirect_uri"))
+	assert.Equal(t, result.State, query.Get("state"))
+
+	// Only the state hash is stored, and the verifier never leaves the server
+	require.Equal(t, 1, mockRepo.CreateOIDCLoginRequestCallCount())
+	_, loginRequest := mockRepo.CreateOIDCLoginRequestArgsForCall(0)
+	assert.Equal(t, token.Hash(result.State), loginRequest.StateHash)
+	assert.Equal(t, loginRequest.Nonce, query.Get("nonce"))
+	assert.Equal(t, oidc.CodeChallengeS256(loginRequest.CodeVerifier), query.Get("code_challenge"))
+	assert.Equal(t, "S256", query.Get("code_challenge_method"))
+	assert.NotContains(t, result.AuthorizationURL, loginRequest.CodeVerifier)
+}
+
+func TestUserService_OIDCAuthorize_Unavailable(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeUserRepository{}
+	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{}, service.Config{})
+
+	_, err := userService.OIDCAuthorize(context.Background())
+	assert.Equal(t, service.ErrOIDCUnavailable, err)
+	assert.Equal(t, 0, mockRepo.CreateOIDCLoginRequestCallCount())
+}
+
+func TestUserService_OIDCCallback_CreatesUserOnFirstLogin(t *testing.T) {
+	mockRepo, userService, fakeProvider := newOIDCFixture(t)
+
+	req, _ := startOIDCLogin(t, mockRepo, userService, fakeProvider, oidctest.Identity{
+		Subject:       "subject-1",
+		Email:         "new@example.com",
+		EmailVerified: true,
+		Name:          "New User",
+	})
+
+	result, err := userService.OIDCCallback(context.Background(), req)
+	require.NoError(t, err)
+	assert.NotEmpty(t, result.AccessToken)
+	assert.NotEmpty(t, result.RefreshToken)
+
+	_, provider, subject := mockRepo.GetUserIdentityArgsForCall(0)
+	assert.Equal(t, fakeProvider.Issuer(), provider)
+	assert.Equal(t, "subject-1", subject)
+
+	require.Equal(t, 1, mockRepo.CreateUserWithIdentityCallCount())
+	_, user, identity := mockRepo.CreateUserWithIdentityArgsForCall(0)
+	assert.NotEqual(t, uuid.Nil, user.ID)
+	assert.Equal(t, "New User", user.Name)
+	assert.Equal(t, "new@example.com", user.Email)
+	assert.Equal(t, rbac.DefaultRole, user.Role)
+	assert.Empty(t, user.Password)
+	assert.NotNil(t, user.VerifiedAt)
+	assert.Equal(t, fakeProvider.Issuer(), identity.Provider)
+	assert.Equal(t, "subject-1", identity.Subject)
+
+	// The session belongs to the new user
+	require.Equal(t, 1, mockRepo.CreateSessionCallCount())
+	_, session := mockRepo.CreateSessionArgsForCall(0)
+	assert.Equal(t, user.ID, session.UserID)
+	assert.Equal(t, "test-agent", session.UserAgent)
+}
+
+func TestUserService_OIDCCallback_UnverifiedEmailCreatesUnverifiedUser(t *testing.T) {
+	mockRepo, userService, fakeProvider := newOIDCFixture(t)
+
+	req, _ := startOIDCLogin(t, mockRepo, userService, fakeProvider, oidctest.Identity{
+		Subject: "subject-1",
+		Email:   "new@example.com",
+	})
+
+	_, err := userService.OIDCCallback(context.Background(), req)
+	require.NoError(t, err)
+
+	require.Equal(t, 1, mockRepo.CreateUserWithIdentityCallCount())
+	_, user, _ := mockRepo.CreateUserWithIdentityArgsForCall(0)
+	assert.Nil(t, user.VerifiedAt)
+	// Without a name claim the local part of the email is used
+	assert.Equal(t, "new", user.Name)
+}
+
+func TestUserService_OIDCCallback_LongNameTruncatedByCharacters(t *testing.T) {
+	mockRepo, userService, fakeProvider := newOIDCFixture(t)
+
+	req, _ := startOIDCLogin(t, mockRepo, userService, fakeProvider, oidctest.Identity{
+		Subject:       "subject-1",
+		Email:         "new@example.com",
+		EmailVerified: true,
+		Name:          strings.Repeat("é", 300),
+	})
+
+	_, err := userService.OIDCCallback(context.Background(), req)
+	require.NoError(t, err)
+
+	require.Equal(t, 1, mockRepo.CreateUserWithIdentityCallCount())
+	_, user, _ := mockRepo.CreateUserWithIdentityArgsForCall(0)
+	assert.Equal(t, strings.Repeat("é", 255), user.Name)
+	assert.True(t, utf8.ValidString(user.Name))
+}
+
+func TestUserService_OIDCCallback_LinkedIdentity(t *testing.T) {
+	mockRepo, userService, fakeProvider := newOIDCFixture(t)
+
+	user := repository.User{ID: uuid.New(), Email: "linked@example.com", Role: rbac.RoleEditor}
+	mockRepo.GetUserIdentityReturns(repository.UserIdentity{ID: uuid.New(), UserID: user.ID}, nil)
+	mockRepo.GetByIDReturns(user, nil)
+
+	req, _ := startOIDCLogin(t, mockRepo, userService, fakeProvider, oidctest.Identity{
+		Subject: "subject-1",
+		// The email changed at the provider; the subject still identifies the user
+		Email: "renamed@example.com",
+	})
+
+	result, err := userService.OIDCCallback(context.Background(), req)
+	require.NoError(t, err)
+	assert.NotEmpty(t, result.AccessToken)
+
+	_, id := mockRepo.GetByIDArgsForCall(0)
+	assert.Equal(t, user.ID, id)
+	assert.Equal(t, 0, mockRepo.GetByEmailCallCount())
+	assert.Equal(t, 0, mockRepo.CreateUserIdentityCallCount())
+	assert.Equal(t, 0, mockRepo.CreateUserWithIdentityCallCount())
+}
+
+func TestUserService_OIDCCallback_LinksExistingUserWithVerifiedEmail(t *testing.T) {
+	mockRepo, userService, fakeProvider := newOIDCFixture(t)
+
+	verifiedAt := time.Now().Add(-time.Hour)
+	user := repository.User{ID: uuid.New(), Email: "existing@example.com", Role: rbac.RoleAuthor, VerifiedAt: &verifiedAt}
+	mockRepo.GetByEmailReturns(user, nil)
+
+	req, _ := startOIDCLogin(t, mockRepo, userService, fakeProvider, oidctest.Identity{
+		Subject:       "subject-1",
+		Email:         "existing@example.com",
+		EmailVerified: true,
+	})
+
+	_, err := userService.OIDCCallback(context.Background(), req)
+	require.NoError(t, err)
+
+	require.Equal(t, 1, mockRepo.CreateUserIdentityCallCount())
+	_, identity := mockRepo.CreateUserIdentityArgsForCall(0)
+	assert.Equal(t, user.ID, identity.UserID)
+	assert.Equal(t, "subject-1", identity.Subject)
+	assert.Equal(t, 0, mockRepo.CreateUserWithIdentityCallCount())
+}
+
+func TestUserService_OIDCCallback_RefusesExistingUserWithUnverifiedEmail(t *testing.T) {
+	mockRepo, userService, fakeProvider := newOIDCFixture(t)
+
+	mockRepo.GetByEmailReturns(repository.User{ID: uuid.New(), Email: "existing@example.com"}, nil)
+
+	req, _ := startOIDCLogin(t, mockRepo, userService, fakeProvider, oidctest.Identity{
+		Subject: "subject-1",
+		Email:   "existing@example.com",
+	})
+
+	_, err := userService.OIDCCallback(context.Background(), req)
+	assert.Equal(t, service.ErrOIDCEmailUnverified, err)
+	assert.Equal(t, 0, mockRepo.CreateUserIdentityCallCount())
+	assert.Equal(t, 0, mockRepo.CreateSessionCallCount())
+}
+
+func TestUserService_OIDCCallback_RefusesExistingUserNeverVerified(t *testing.T) {
+	mockRepo, userService, fakeProvider := newOIDCFixture(t)
+
+	// Someone signed up with the address and a password of their choosing
+	mockRepo.GetByEmailReturns(repository.User{ID: uuid.New(), Email: "colleague@example.com", Password: "squatter-hash"}, nil)
+
+	req, _ := startOIDCLogin(t, mockRepo, userService, fakeProvider, oidctest.Identity{
+		Subject:       "subject-1",
+		Email:         "colleague@example.com",
+		EmailVerified: true,
+	})
+
+	_, err := userService.OIDCCallback(context.Background(), req)
+	assert.Equal(t, service.ErrOIDCUserUnverified, err)
+	assert.Equal(t, 0, mockRepo.CreateUserIdentityCallCount())
+	assert.Equal(t, 0, mockRepo.CreateUserWithIdentityCallCount())
+	assert.Equal(t, 0, mockRepo.CreateSessionCallCount())
+}
+
+func TestUserService_OIDCCallback_EmailMissing(t *testing.T) {
+	mockRepo, userService, fakeProvider := newOIDCFixture(t)
+
+	req, _ := startOIDCLogin(t, mockRepo, userService, fakeProvider, oidctest.Identity{Subject: "subject-1"})
+
+	_, err := userService.OIDCCallback(context.Background(), req)
+	assert.Equal(t, service.ErrOIDCEmailMissing, err)
+	assert.Equal(t, 0, mockRepo.CreateUserWithIdentityCallCount())
+}
+
+func TestUserService_OIDCCallback_UnknownState(t *testing.T) {
+	mockRepo, userService, _ := newOIDCFixture(t)
+	mockRepo.ConsumeOIDCLoginRequestReturns(repository.OIDCLoginRequest{}, repository.ErrOIDCLoginRequestNotFound)
+
+	_, err := userService.OIDCCallback(context.Background(), service.OIDCCallbackRequest{Code: "code", State: "state"})
+	assert.Equal(t, service.ErrInvalidOIDCState, err)
+
+	_, stateHash := mockRepo.ConsumeOIDCLoginRequestArgsForCall(0)
+	assert.Equal(t, token.Hash("state"), stateHash)
+}
+
+func TestUserService_OIDCCallback_ExpiredState(t *testing.T) {
+	mockRepo, userService, fakeProvider := newOIDCFixture(t)
+
+	req, loginRequest := startOIDCLogin(t, mockRepo, userService, fakeProvider, oidctest.Identity{Subject: "subject-1", Email: "new@example.com"})
+	loginRequest.ExpiresAt = time.Now().Add(-time.Second)
+	mockRepo.ConsumeOIDCLoginRequestReturns(loginRequest, nil)
+
+	_, err := userService.OIDCCallback(context.Background(), req)
+	assert.Equal(t, service.ErrInvalidOIDCState, err)
+	assert.Equal(t, 0, mockRepo.CreateSessionCallCount())
+}
+
+func TestUserService_OIDCCallback_CodeVerifierMismatch(t *testing.T) {
+	mockRepo, userService, fakeProvider := newOIDCFixture(t)
+
+	// A code intercepted from another login cannot be redeemed without its verifier
+	req, loginRequest := startOIDCLogin(t, mockRepo, userService, fakeProvider, oidctest.Identity{Subject: "subject-1", Email: "new@example.com"})
+	loginRequest.CodeVerifier = "another-verifier-another-verifier-another-ve"
+	mockRepo.ConsumeOIDCLoginRequestReturns(loginRequest, nil)
+
+	_, err := userService.OIDCCallback(context.Background(), req)
+	assert.ErrorIs(t, err, service.ErrOIDCLoginFailed)
+	assert.ErrorIs(t, err, oidc.ErrExchangeFailed)
+	assert.Equal(t, 0, mockRepo.CreateSessionCallCount())
+}
+
+func TestUserService_OIDCCallback_NonceMismatch(t *testing.T) {
+	mockRepo, userService, fakeProvider := newOIDCFixture(t)
+
+	req, loginRequest := startOIDCLogin(t, mockRepo, userService, fakeProvider, oidctest.Identity{Subject: "subject-1", Email: "new@example.com"})
+	loginRequest.Nonce = "another-nonce"
+	mockRepo.ConsumeOIDCLoginRequestReturns(loginRequest, nil)
+
+	_, err := userService.OIDCCallback(context.Background(), req)
+	assert.ErrorIs(t, err, service.ErrOIDCLoginFailed)
+	assert.ErrorIs(t, err, oidc.ErrInvalidIDToken)
+}
+
+func TestUserService_OIDCCallback_CodeUsedTwice(t *testing.T) {
+	mockRepo, userService, fakeProvider := newOIDCFixture(t)
+
+	req, _ := startOIDCLogin(t, mockRepo, userService, fakeProvider, oidctest.Identity{Subject: "subject-1", Email: "new@example.com"})
+
+	_, err := userService.OIDCCallback(context.Background(), req)
+	require.NoError(t, err)
+
+	_, err = userService.OIDCCallback(context.Background(), req)
+	assert.ErrorIs(t, err, service.ErrOIDCLoginFailed)
+}
+
+func TestUserService_OIDCCallback_TwoFactorChallenge(t *testing.T) {
+	twoFactorRepo, _, user, _ := newTwoFactorLoginFixture(t)
+	credential, err := twoFactorRepo.GetTwoFactorCredential(context.Background(), user.ID)
+	require.NoError(t, err)
+
+	fakeProvider := oidctest.NewProvider("let-it-go", "client-secret")
+	defer fakeProvider.Close()
+	provider, err := oidc.NewProvider(oidc.Config{
+		IssuerURL:    fakeProvider.Issuer(),
+		ClientID:     "let-it-go",
+		ClientSecret: "client-secret",
+		RedirectURL:  testOIDCRedirectURL,
+	}, fakeProvider.Client())
+	require.NoError(t, err)
+
+	mockRepo := &repositoryfakes.FakeUserRepository{}
+	mockRepo.GetUserIdentityReturns(repository.UserIdentity{UserID: user.ID}, nil)
+	mockRepo.GetByIDReturns(user, nil)
+	mockRepo.GetTwoFactorCredentialReturns(credential, nil)
+	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{TokenManager: newTestTokenManager(t), Cipher: newTestCipher(t), OIDCProvider: provider}, testTwoFactorConfig)
+
+	req, _ := startOIDCLogin(t, mockRepo, userService, fakeProvider, oidctest.Identity{Subject: "subject-1", Email: user.Email})
+
+	// Signing in through the provider does not skip the second factor
+	result, err := userService.OIDCCallback(context.Background(), req)
+	require.NoError(t, err)
+	require.NotNil(t, result.TwoFactorChallenge)
+	assert.Empty(t, result.AccessToken)
+	assert.Equal(t, 0, mockRepo.CreateSessionCallCount())
+}
+
+func TestUserService_PurgeExpiredOIDCLoginRequests_Success(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeUserRepository{}
+	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{}, service.Config{})
+
+	mockRepo.DeleteExpiredOIDCLoginRequestsReturns(3, nil)
+
+	deleted, err := userService.PurgeExpiredOIDCLoginRequests(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), deleted)
+
+	_, before := mockRepo.DeleteExpiredOIDCLoginRequestsArgsForCall(0)
+	assert.WithinDuration(t, time.Now(), before, time.Minute)
+}
@@ -0,0 +1,103 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/fikryfahrezy/let-it-go/feature/user/repository"
+	"github.com/fikryfahrezy/let-it-go/pkg/app_error"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// bcryptCost is the configured cost, falling back to bcrypt.DefaultCost when unset
+func (s *userService) bcryptCost() int {
+	if s.config.BcryptCost < bcrypt.MinCost {
+		return bcrypt.DefaultCost
+	}
+	return s.config.BcryptCost
+}
+
+func (s *userService) hashPassword(password string) (string, error) {
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(password), s.bcryptCost())
+	if err != nil {
+		s.log.Error("Failed to hash password",
+			slog.String("error", err.Error()),
+		)
+		return "", fmt.Errorf("%w: %w", ErrFailedToHashPassword, err)
+	}
+	return string(hashedPassword), nil
+}
+
+// checkPasswordPolicy reports the first rule of the password policy the password breaks.
+// The returned error matches ErrPasswordPolicyViolation and its message names the rule.
+func (s *userService) checkPasswordPolicy(password string) error {
+	policy := s.config.PasswordPolicy
+
+	if utf8.RuneCountInString(password) < policy.MinLength {
+		return passwordPolicyViolation(fmt.Sprintf("password must be at least %d characters long", policy.MinLength))
+	}
+
+	var hasUpper, hasLower, hasDigit, hasSymbol bool
+	for _, r := range password {
+		switch {
+		case unicode.IsUpper(r):
+			hasUpper = true
+		case unicode.IsLower(r):
+			hasLower = true
+		case unicode.IsDigit(r):
+			hasDigit = true
+		case unicode.IsPunct(r) || unicode.IsSymbol(r):
+			hasSymbol = true
+		}
+	}
+
+	switch {
+	case policy.RequireUpper && !hasUpper:
+		return passwordPolicyViolation("password must contain an uppercase letter")
+	case policy.RequireLower && !hasLower:
+		return passwordPolicyViolation("password must contain a lowercase letter")
+	case policy.RequireDigit && !hasDigit:
+		return passwordPolicyViolation("password must contain a digit")
+	case policy.RequireSymbol && !hasSymbol:
+		return passwordPolicyViolation("password must contain a symbol")
+	}
+
+	return nil
+}
+
+// passwordPolicyViolation keeps the ErrPasswordPolicyViolation code so errors.Is matches
+// while telling the client which rule was broken
+func passwordPolicyViolation(message string) error {
+	return app_error.New(ErrPasswordPolicyViolation.Code, message)
+}
+
+// rehashPasswordIfNeeded upgrades a hash stored with a lower cost than configured. The
+// caller already verified the password, so a failure here is only logged.
+func (s *userService) rehashPasswordIfNeeded(ctx context.Context, user repository.User, password string) {
+	cost, err := bcrypt.Cost([]byte(user.Password))
+	if err != nil || cost >= s.bcryptCost() {
+		return
+	}
+
+	hashedPassword, err := s.hashPassword(password)
+	if err != nil {
+		return
+	}
+
+	if err := s.userRepo.UpdatePassword(ctx, user.ID, hashedPassword); err != nil {
+		s.log.Error("Failed to upgrade password hash",
+			slog.String("error", err.Error()),
+			slog.String("user_id", user.ID.String()),
+		)
+		return
+	}
+
+	s.log.Info("Password hash upgraded",
+		slog.String("user_id", user.ID.String()),
+		slog.Int("from_cost", cost),
+		slog.Int("to_cost", s.bcryptCost()),
+	)
+}
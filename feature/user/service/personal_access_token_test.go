@@ -0,0 +1,333 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/fikryfahrezy/let-it-go/feature/user/repository"
+	"github.com/fikryfahrezy/let-it-go/feature/user/repository/repositoryfakes"
+	"github.com/fikryfahrezy/let-it-go/feature/user/service"
+	"github.com/fikryfahrezy/let-it-go/pkg/http_server"
+	"github.com/fikryfahrezy/let-it-go/pkg/logger"
+	"github.com/fikryfahrezy/let-it-go/pkg/rbac"
+	"github.com/fikryfahrezy/let-it-go/pkg/token"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func userContext(userID uuid.UUID) context.Context {
+	return http_server.ContextWithPrincipal(context.Background(), http_server.Principal{
+		UserID: userID,
+		Roles:  []string{rbac.RoleAuthor},
+	})
+}
+
+func newTestPersonalAccessToken(userID uuid.UUID, rawToken string) repository.PersonalAccessToken {
+	return repository.PersonalAccessToken{
+		ID:        uuid.New(),
+		UserID:    userID,
+		Name:      "ci",
+		TokenHash: token.Hash(rawToken),
+		Scopes:    []string{rbac.ScopeBlogsWrite},
+		CreatedAt: time.Now(),
+	}
+}
+
+func TestUserService_CreatePersonalAccessToken_Success(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeUserRepository{}
+	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{}, service.Config{})
+
+	userID := uuid.New()
+	expiresAt := time.Now().Add(30 * 24 * time.Hour)
+
+	result, err := userService.CreatePersonalAccessToken(userContext(userID), userID, service.CreatePersonalAccessTokenRequest{
+		Name:      "ci",
+		Scopes:    []string{rbac.ScopeUsersRead, rbac.ScopeBlogsWrite, rbac.ScopeUsersRead},
+		ExpiresAt: &expiresAt,
+	})
+
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(result.Token, service.PersonalAccessTokenPrefix))
+	assert.Equal(t, "ci", result.Name)
+	assert.Equal(t, []string{rbac.ScopeBlogsWrite, rbac.ScopeUsersRead}, result.Scopes)
+	assert.Equal(t, &expiresAt, result.ExpiresAt)
+
+	// Only the hash of the token is stored
+	require.Equal(t, 1, mockRepo.CreatePersonalAccessTokenCallCount())
+	_, stored := mockRepo.CreatePersonalAccessTokenArgsForCall(0)
+	assert.Equal(t, userID, stored.UserID)
+	assert.Equal(t, result.ID, stored.ID)
+	assert.Equal(t, token.Hash(result.Token), stored.TokenHash)
+	assert.NotContains(t, stored.TokenHash, result.Token)
+}
+
+func TestUserService_CreatePersonalAccessToken_OtherUser(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeUserRepository{}
+	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{}, service.Config{})
+
+	// Not even admins create tokens for someone else
+	_, err := userService.CreatePersonalAccessToken(adminContext(), uuid.New(), service.CreatePersonalAccessTokenRequest{
+		Name:   "ci",
+		Scopes: []string{rbac.ScopeBlogsWrite},
+	})
+
+	assert.Equal(t, service.ErrUserForbidden, err)
+	assert.Equal(t, 0, mockRepo.CreatePersonalAccessTokenCallCount())
+}
+
+func TestUserService_CreatePersonalAccessToken_ScopedCaller(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeUserRepository{}
+	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{}, service.Config{})
+
+	userID := uuid.New()
+	ctx := http_server.ContextWithPrincipal(context.Background(), http_server.Principal{
+		UserID: userID,
+		Roles:  []string{rbac.RoleAuthor},
+		Scopes: []string{rbac.ScopeBlogsWrite},
+	})
+
+	_, err := userService.CreatePersonalAccessToken(ctx, userID, service.CreatePersonalAccessTokenRequest{
+		Name:   "ci",
+		Scopes: []string{rbac.ScopeBlogsWrite},
+	})
+
+	assert.Equal(t, service.ErrUserForbidden, err)
+	assert.Equal(t, 0, mockRepo.CreatePersonalAccessTokenCallCount())
+}
+
+func TestUserService_CreatePersonalAccessToken_InvalidScope(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeUserRepository{}
+	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{}, service.Config{})
+
+	userID := uuid.New()
+	_, err := userService.CreatePersonalAccessToken(userContext(userID), userID, service.CreatePersonalAccessTokenRequest{
+		Name:   "ci",
+		Scopes: []string{"users:manage"},
+	})
+
+	assert.Equal(t, service.ErrInvalidScope, err)
+	assert.Equal(t, 0, mockRepo.CreatePersonalAccessTokenCallCount())
+}
+
+func TestUserService_CreatePersonalAccessToken_ExpiryInPast(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeUserRepository{}
+	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{}, service.Config{})
+
+	userID := uuid.New()
+	expiresAt := time.Now().Add(-time.Minute)
+	_, err := userService.CreatePersonalAccessToken(userContext(userID), userID, service.CreatePersonalAccessTokenRequest{
+		Name:      "ci",
+		Scopes:    []string{rbac.ScopeBlogsWrite},
+		ExpiresAt: &expiresAt,
+	})
+
+	assert.Equal(t, service.ErrInvalidTokenExpiry, err)
+	assert.Equal(t, 0, mockRepo.CreatePersonalAccessTokenCallCount())
+}
+
+func TestUserService_ListPersonalAccessTokens_Success(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeUserRepository{}
+	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{}, service.Config{})
+
+	userID := uuid.New()
+	accessToken := newTestPersonalAccessToken(userID, "lig_pat_list")
+	mockRepo.ListPersonalAccessTokensByUserIDReturns([]repository.PersonalAccessToken{accessToken}, nil)
+
+	result, err := userService.ListPersonalAccessTokens(userContext(userID), userID)
+
+	require.NoError(t, err)
+	require.Len(t, result, 1)
+	assert.Equal(t, accessToken.ID, result[0].ID)
+	assert.Equal(t, accessToken.Scopes, result[0].Scopes)
+}
+
+func TestUserService_ListPersonalAccessTokens_OtherUser(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeUserRepository{}
+	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{}, service.Config{})
+
+	_, err := userService.ListPersonalAccessTokens(userContext(uuid.New()), uuid.New())
+
+	assert.Equal(t, service.ErrUserForbidden, err)
+	assert.Equal(t, 0, mockRepo.ListPersonalAccessTokensByUserIDCallCount())
+}
+
+func TestUserService_RevokePersonalAccessToken_Success(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeUserRepository{}
+	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{}, service.Config{})
+
+	userID := uuid.New()
+	tokenID := uuid.New()
+
+	err := userService.RevokePersonalAccessToken(userContext(userID), userID, tokenID)
+
+	require.NoError(t, err)
+	require.Equal(t, 1, mockRepo.RevokePersonalAccessTokenCallCount())
+	_, actualUserID, actualTokenID := mockRepo.RevokePersonalAccessTokenArgsForCall(0)
+	assert.Equal(t, userID, actualUserID)
+	assert.Equal(t, tokenID, actualTokenID)
+}
+
+func TestUserService_RevokePersonalAccessToken_AdminOtherUser(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeUserRepository{}
+	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{}, service.Config{})
+
+	err := userService.RevokePersonalAccessToken(adminContext(), uuid.New(), uuid.New())
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, mockRepo.RevokePersonalAccessTokenCallCount())
+}
+
+func TestUserService_RevokePersonalAccessToken_NotFound(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeUserRepository{}
+	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{}, service.Config{})
+
+	mockRepo.RevokePersonalAccessTokenReturns(repository.ErrPersonalAccessTokenNotFound)
+
+	userID := uuid.New()
+	err := userService.RevokePersonalAccessToken(userContext(userID), userID, uuid.New())
+
+	assert.Equal(t, repository.ErrPersonalAccessTokenNotFound, err)
+}
+
+func TestUserService_AuthenticateBearer_PersonalAccessToken(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeUserRepository{}
+	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{TokenManager: newTestTokenManager(t)}, service.Config{})
+
+	userID := uuid.New()
+	rawToken := service.PersonalAccessTokenPrefix + "valid"
+	accessToken := newTestPersonalAccessToken(userID, rawToken)
+	mockRepo.GetPersonalAccessTokenByHashReturns(accessToken, nil)
+	mockRepo.GetByIDReturns(repository.User{ID: userID, Role: rbac.RoleEditor}, nil)
+
+	principal, err := userService.AuthenticateBearer(context.Background(), rawToken)
+
+	require.NoError(t, err)
+	assert.Equal(t, userID, principal.UserID)
+	assert.Equal(t, []string{rbac.RoleEditor}, principal.Roles)
+	assert.Equal(t, []string{rbac.ScopeBlogsWrite}, principal.Scopes)
+	assert.True(t, principal.Scoped())
+
+	_, actualHash := mockRepo.GetPersonalAccessTokenByHashArgsForCall(0)
+	assert.Equal(t, token.Hash(rawToken), actualHash)
+
+	require.Equal(t, 1, mockRepo.UpdatePersonalAccessTokenLastUsedCallCount())
+	_, actualID, usedAt := mockRepo.UpdatePersonalAccessTokenLastUsedArgsForCall(0)
+	assert.Equal(t, accessToken.ID, actualID)
+	assert.WithinDuration(t, time.Now(), usedAt, time.Second)
+}
+
+func TestUserService_AuthenticateBearer_TwoFactorSetupRequired(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeUserRepository{}
+	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{TokenManager: newTestTokenManager(t), Cipher: newTestCipher(t)}, testTwoFactorConfig)
+
+	userID := uuid.New()
+	rawToken := service.PersonalAccessTokenPrefix + "valid"
+	mockRepo.GetPersonalAccessTokenByHashReturns(newTestPersonalAccessToken(userID, rawToken), nil)
+	mockRepo.GetByIDReturns(repository.User{ID: userID, Role: rbac.RoleEditor}, nil)
+	mockRepo.GetTwoFactorCredentialReturns(repository.TwoFactorCredential{}, repository.ErrTwoFactorCredentialNotFound)
+
+	principal, err := userService.AuthenticateBearer(context.Background(), rawToken)
+
+	// A personal access token does not get around enrolling the second factor
+	require.NoError(t, err)
+	assert.True(t, principal.TwoFactorSetupRequired)
+	assert.False(t, rbac.Can(principal, rbac.PermissionBlogsPublish))
+}
+
+func TestUserService_AuthenticateBearer_RecentlyUsed(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeUserRepository{}
+	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{TokenManager: newTestTokenManager(t)}, service.Config{})
+
+	userID := uuid.New()
+	rawToken := service.PersonalAccessTokenPrefix + "recent"
+	accessToken := newTestPersonalAccessToken(userID, rawToken)
+	lastUsedAt := time.Now().Add(-10 * time.Second)
+	accessToken.LastUsedAt = &lastUsedAt
+	mockRepo.GetPersonalAccessTokenByHashReturns(accessToken, nil)
+	mockRepo.GetByIDReturns(repository.User{ID: userID, Role: rbac.RoleAuthor}, nil)
+
+	_, err := userService.AuthenticateBearer(context.Background(), rawToken)
+
+	require.NoError(t, err)
+	assert.Equal(t, 0, mockRepo.UpdatePersonalAccessTokenLastUsedCallCount())
+}
+
+func TestUserService_AuthenticateBearer_LastUsedFailure(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeUserRepository{}
+	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{TokenManager: newTestTokenManager(t)}, service.Config{})
+
+	userID := uuid.New()
+	rawToken := service.PersonalAccessTokenPrefix + "valid"
+	mockRepo.GetPersonalAccessTokenByHashReturns(newTestPersonalAccessToken(userID, rawToken), nil)
+	mockRepo.GetByIDReturns(repository.User{ID: userID, Role: rbac.RoleAuthor}, nil)
+	mockRepo.UpdatePersonalAccessTokenLastUsedReturns(errors.New("database down"))
+
+	principal, err := userService.AuthenticateBearer(context.Background(), rawToken)
+
+	require.NoError(t, err)
+	assert.Equal(t, userID, principal.UserID)
+}
+
+func TestUserService_AuthenticateBearer_InvalidPersonalAccessToken(t *testing.T) {
+	past := time.Now().Add(-time.Minute)
+
+	tests := []struct {
+		name   string
+		modify func(accessToken *repository.PersonalAccessToken)
+		getErr error
+	}{
+		{
+			name:   "Unknown",
+			getErr: repository.ErrPersonalAccessTokenNotFound,
+		},
+		{
+			name:   "Expired",
+			modify: func(accessToken *repository.PersonalAccessToken) { accessToken.ExpiresAt = &past },
+		},
+		{
+			name:   "Revoked",
+			modify: func(accessToken *repository.PersonalAccessToken) { accessToken.RevokedAt = &past },
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := &repositoryfakes.FakeUserRepository{}
+			userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{TokenManager: newTestTokenManager(t)}, service.Config{})
+
+			rawToken := service.PersonalAccessTokenPrefix + "invalid"
+			accessToken := newTestPersonalAccessToken(uuid.New(), rawToken)
+			if tt.modify != nil {
+				tt.modify(&accessToken)
+			}
+			mockRepo.GetPersonalAccessTokenByHashReturns(accessToken, tt.getErr)
+
+			_, err := userService.AuthenticateBearer(context.Background(), rawToken)
+
+			assert.Equal(t, service.ErrInvalidPersonalAccessToken, err)
+			assert.Equal(t, 0, mockRepo.GetByIDCallCount())
+			assert.Equal(t, 0, mockRepo.UpdatePersonalAccessTokenLastUsedCallCount())
+		})
+	}
+}
+
+func TestUserService_AuthenticateBearer_AccessToken(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeUserRepository{}
+	tokenManager := newTestTokenManager(t)
+	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{TokenManager: tokenManager}, service.Config{})
+
+	userID := uuid.New()
+	accessToken, _, err := tokenManager.IssueAccessToken(token.Subject{UserID: userID, Roles: []string{rbac.RoleAuthor}})
+	require.NoError(t, err)
+
+	principal, err := userService.AuthenticateBearer(context.Background(), accessToken)
+
+	require.NoError(t, err)
+	assert.Equal(t, userID, principal.UserID)
+	assert.False(t, principal.Scoped())
+	assert.Equal(t, 0, mockRepo.GetPersonalAccessTokenByHashCallCount())
+}
@@ -0,0 +1,145 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/fikryfahrezy/let-it-go/feature/user/repository"
+	"github.com/google/uuid"
+)
+
+// preferencesSchemaVersion is the layout of stored preferences documents. When a field is
+// renamed or changes meaning, bump it and add the step from the previous version to
+// preferencesUpgrades so documents written earlier keep working.
+const preferencesSchemaVersion = 1
+
+// preferencesUpgrades turn a document of the version they are keyed by into one of the next version
+var preferencesUpgrades = map[int]func(document map[string]any){}
+
+// GetPreferences returns the preferences of a user, or the defaults if they never changed them
+func (s *userService) GetPreferences(ctx context.Context, userID uuid.UUID) (PreferencesResponse, error) {
+	s.log.Info("Getting user preferences",
+		slog.String("user_id", userID.String()),
+	)
+
+	if _, err := s.authorizeSelfOrManager(ctx, userID); err != nil {
+		return PreferencesResponse{}, err
+	}
+
+	if _, err := s.userRepo.GetByID(ctx, userID); err != nil {
+		return PreferencesResponse{}, err
+	}
+
+	return s.loadPreferences(ctx, userID)
+}
+
+// UpdatePreferences replaces every preference of a user
+func (s *userService) UpdatePreferences(ctx context.Context, userID uuid.UUID, req Preferences) (PreferencesResponse, error) {
+	s.log.Info("Updating user preferences",
+		slog.String("user_id", userID.String()),
+	)
+
+	if _, err := s.authorizeSelfOrManager(ctx, userID); err != nil {
+		return PreferencesResponse{}, err
+	}
+
+	if _, err := s.userRepo.GetByID(ctx, userID); err != nil {
+		return PreferencesResponse{}, err
+	}
+
+	document, err := json.Marshal(req)
+	if err != nil {
+		return PreferencesResponse{}, fmt.Errorf("%w: %w", ErrInvalidPreferences, err)
+	}
+
+	err = s.userRepo.SavePreferences(ctx, repository.UserPreferences{
+		UserID:        userID,
+		SchemaVersion: preferencesSchemaVersion,
+		Document:      document,
+	})
+	if err != nil {
+		return PreferencesResponse{}, err
+	}
+
+	s.log.Info("User preferences updated successfully",
+		slog.String("user_id", userID.String()),
+	)
+
+	now := time.Now()
+	return PreferencesResponse{
+		Preferences:   req,
+		SchemaVersion: preferencesSchemaVersion,
+		UpdatedAt:     &now,
+	}, nil
+}
+
+// DefaultBlogStatus returns the status a user wants new blogs to start in
+func (s *userService) DefaultBlogStatus(ctx context.Context, userID uuid.UUID) (string, error) {
+	preferences, err := s.loadPreferences(ctx, userID)
+	if err != nil {
+		return "", err
+	}
+	return preferences.DefaultBlogStatus, nil
+}
+
+// loadPreferences reads the stored preferences of a user, upgrading documents of older
+// schema versions and filling in defaults for fields the document does not have
+func (s *userService) loadPreferences(ctx context.Context, userID uuid.UUID) (PreferencesResponse, error) {
+	stored, err := s.userRepo.GetPreferences(ctx, userID)
+	if err != nil {
+		if errors.Is(err, repository.ErrPreferencesNotFound) {
+			return PreferencesResponse{
+				Preferences:   DefaultPreferences(),
+				SchemaVersion: preferencesSchemaVersion,
+			}, nil
+		}
+		return PreferencesResponse{}, err
+	}
+
+	if stored.SchemaVersion > preferencesSchemaVersion {
+		s.log.Error("User preferences have a newer schema version",
+			slog.String("user_id", userID.String()),
+			slog.Int("schema_version", stored.SchemaVersion),
+		)
+		return PreferencesResponse{}, ErrUnsupportedPreferencesVersion
+	}
+
+	document := stored.Document
+	if stored.SchemaVersion < preferencesSchemaVersion {
+		document, err = upgradePreferences(document, stored.SchemaVersion)
+		if err != nil {
+			return PreferencesResponse{}, err
+		}
+	}
+
+	preferences := DefaultPreferences()
+	if err := json.Unmarshal(document, &preferences); err != nil {
+		return PreferencesResponse{}, fmt.Errorf("%w: %w", ErrInvalidPreferences, err)
+	}
+
+	return PreferencesResponse{
+		Preferences:   preferences,
+		SchemaVersion: preferencesSchemaVersion,
+		UpdatedAt:     &stored.UpdatedAt,
+	}, nil
+}
+
+// upgradePreferences applies the upgrades from version up to preferencesSchemaVersion
+func upgradePreferences(document []byte, version int) ([]byte, error) {
+	fields := map[string]any{}
+	if err := json.Unmarshal(document, &fields); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidPreferences, err)
+	}
+
+	for ; version < preferencesSchemaVersion; version++ {
+		if upgrade, ok := preferencesUpgrades[version]; ok {
+			upgrade(fields)
+		}
+	}
+
+	return json.Marshal(fields)
+}
@@ -0,0 +1,45 @@
+package service
+
+import (
+	"time"
+
+	blogRepository "github.com/fikryfahrezy/let-it-go/feature/blog/repository"
+)
+
+// Preferences are the settings of a user. Fields missing from a stored document keep
+// the value of DefaultPreferences.
+type Preferences struct {
+	// Timezone is an IANA time zone name such as Europe/Berlin
+	Timezone string `json:"timezone" validate:"required,timezone"`
+	// Locale is a BCP 47 language tag such as en-US
+	Locale             string                       `json:"locale" validate:"required,bcp47_language_tag"`
+	EmailNotifications EmailNotificationPreferences `json:"email_notifications"`
+	// DefaultBlogStatus is the status of new blogs created without one
+	DefaultBlogStatus string `json:"default_blog_status" validate:"required,oneof=draft published"`
+}
+
+// EmailNotificationPreferences are the emails a user opted in to
+type EmailNotificationPreferences struct {
+	NewComments    bool `json:"new_comments"`
+	BlogPublished  bool `json:"blog_published"`
+	ProductUpdates bool `json:"product_updates"`
+}
+
+type PreferencesResponse struct {
+	Preferences
+	SchemaVersion int        `json:"schema_version"`
+	UpdatedAt     *time.Time `json:"updated_at"` // Nil while the user keeps the defaults
+}
+
+// DefaultPreferences are the settings of users who have not changed them
+func DefaultPreferences() Preferences {
+	return Preferences{
+		Timezone: "UTC",
+		Locale:   "en",
+		EmailNotifications: EmailNotificationPreferences{
+			NewComments:   true,
+			BlogPublished: true,
+		},
+		DefaultBlogStatus: blogRepository.StatusDraft,
+	}
+}
@@ -0,0 +1,142 @@
+package service_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/fikryfahrezy/let-it-go/feature/user/repository"
+	"github.com/fikryfahrezy/let-it-go/feature/user/repository/repositoryfakes"
+	"github.com/fikryfahrezy/let-it-go/feature/user/service"
+	"github.com/fikryfahrezy/let-it-go/pkg/logger"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUserService_GetPreferences_Defaults(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeUserRepository{}
+	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{}, service.Config{})
+
+	userID := uuid.New()
+	mockRepo.GetPreferencesReturns(repository.UserPreferences{}, repository.ErrPreferencesNotFound)
+
+	result, err := userService.GetPreferences(userContext(userID), userID)
+
+	require.NoError(t, err)
+	assert.Equal(t, service.DefaultPreferences(), result.Preferences)
+	assert.Equal(t, 1, result.SchemaVersion)
+	assert.Nil(t, result.UpdatedAt)
+}
+
+func TestUserService_GetPreferences_Stored(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeUserRepository{}
+	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{}, service.Config{})
+
+	userID := uuid.New()
+	updatedAt := time.Now().Add(-time.Hour)
+	// Fields the document does not have keep their defaults
+	mockRepo.GetPreferencesReturns(repository.UserPreferences{
+		UserID:        userID,
+		SchemaVersion: 1,
+		Document:      []byte(`{"timezone":"Asia/Jakarta","default_blog_status":"published"}`),
+		UpdatedAt:     updatedAt,
+	}, nil)
+
+	result, err := userService.GetPreferences(userContext(userID), userID)
+
+	require.NoError(t, err)
+	assert.Equal(t, "Asia/Jakarta", result.Timezone)
+	assert.Equal(t, "published", result.DefaultBlogStatus)
+	assert.Equal(t, "en", result.Locale)
+	assert.True(t, result.EmailNotifications.NewComments)
+	require.NotNil(t, result.UpdatedAt)
+	assert.Equal(t, updatedAt, *result.UpdatedAt)
+}
+
+func TestUserService_GetPreferences_NewerSchemaVersion(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeUserRepository{}
+	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{}, service.Config{})
+
+	userID := uuid.New()
+	mockRepo.GetPreferencesReturns(repository.UserPreferences{UserID: userID, SchemaVersion: 99, Document: []byte(`{}`)}, nil)
+
+	_, err := userService.GetPreferences(userContext(userID), userID)
+
+	assert.Equal(t, service.ErrUnsupportedPreferencesVersion, err)
+}
+
+func TestUserService_GetPreferences_OtherUser(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeUserRepository{}
+	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{}, service.Config{})
+
+	_, err := userService.GetPreferences(userContext(uuid.New()), uuid.New())
+
+	assert.Equal(t, service.ErrUserForbidden, err)
+	assert.Equal(t, 0, mockRepo.GetPreferencesCallCount())
+}
+
+func TestUserService_GetPreferences_UserNotFound(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeUserRepository{}
+	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{}, service.Config{})
+
+	mockRepo.GetByIDReturns(repository.User{}, repository.ErrUserNotFound)
+
+	_, err := userService.GetPreferences(adminContext(), uuid.New())
+
+	assert.Equal(t, repository.ErrUserNotFound, err)
+}
+
+func TestUserService_UpdatePreferences_Success(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeUserRepository{}
+	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{}, service.Config{})
+
+	userID := uuid.New()
+	req := service.Preferences{
+		Timezone:           "Europe/Berlin",
+		Locale:             "de-DE",
+		EmailNotifications: service.EmailNotificationPreferences{ProductUpdates: true},
+		DefaultBlogStatus:  "published",
+	}
+
+	result, err := userService.UpdatePreferences(userContext(userID), userID, req)
+
+	require.NoError(t, err)
+	assert.Equal(t, req, result.Preferences)
+	assert.NotNil(t, result.UpdatedAt)
+
+	// The document is stored as JSON with the current schema version
+	require.Equal(t, 1, mockRepo.SavePreferencesCallCount())
+	_, saved := mockRepo.SavePreferencesArgsForCall(0)
+	assert.Equal(t, userID, saved.UserID)
+	assert.Equal(t, 1, saved.SchemaVersion)
+	var stored service.Preferences
+	require.NoError(t, json.Unmarshal(saved.Document, &stored))
+	assert.Equal(t, req, stored)
+}
+
+func TestUserService_UpdatePreferences_OtherUser(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeUserRepository{}
+	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{}, service.Config{})
+
+	_, err := userService.UpdatePreferences(userContext(uuid.New()), uuid.New(), service.DefaultPreferences())
+
+	assert.Equal(t, service.ErrUserForbidden, err)
+	assert.Equal(t, 0, mockRepo.SavePreferencesCallCount())
+}
+
+func TestUserService_DefaultBlogStatus(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeUserRepository{}
+	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{}, service.Config{})
+
+	mockRepo.GetPreferencesReturns(repository.UserPreferences{}, repository.ErrPreferencesNotFound)
+	status, err := userService.DefaultBlogStatus(context.Background(), uuid.New())
+	require.NoError(t, err)
+	assert.Equal(t, "draft", status)
+
+	mockRepo.GetPreferencesReturns(repository.UserPreferences{SchemaVersion: 1, Document: []byte(`{"default_blog_status":"published"}`)}, nil)
+	status, err = userService.DefaultBlogStatus(context.Background(), uuid.New())
+	require.NoError(t, err)
+	assert.Equal(t, "published", status)
+}
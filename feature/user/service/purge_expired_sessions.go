@@ -0,0 +1,21 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+	"time"
+)
+
+// PurgeExpiredSessions deletes sessions whose refresh token can no longer be used
+func (s *userService) PurgeExpiredSessions(ctx context.Context) (int64, error) {
+	deleted, err := s.userRepo.DeleteExpiredSessions(ctx, time.Now())
+	if err != nil {
+		return 0, err
+	}
+
+	s.log.Info("Expired sessions purged",
+		slog.Int64("count", deleted),
+	)
+
+	return deleted, nil
+}
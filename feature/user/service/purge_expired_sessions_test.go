@@ -0,0 +1,42 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/fikryfahrezy/let-it-go/feature/user/repository"
+	"github.com/fikryfahrezy/let-it-go/feature/user/repository/repositoryfakes"
+	"github.com/fikryfahrezy/let-it-go/feature/user/service"
+	"github.com/fikryfahrezy/let-it-go/pkg/logger"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUserService_PurgeExpiredSessions_Success(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeUserRepository{}
+	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{}, service.Config{})
+	ctx := context.Background()
+
+	mockRepo.DeleteExpiredSessionsReturns(4, nil)
+
+	deleted, err := userService.PurgeExpiredSessions(ctx)
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(4), deleted)
+
+	_, before := mockRepo.DeleteExpiredSessionsArgsForCall(0)
+	assert.WithinDuration(t, time.Now(), before, time.Minute)
+}
+
+func TestUserService_PurgeExpiredSessions_RepositoryError(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeUserRepository{}
+	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{}, service.Config{})
+	ctx := context.Background()
+
+	mockRepo.DeleteExpiredSessionsReturns(0, repository.ErrFailedToDeleteExpiredSessions)
+
+	deleted, err := userService.PurgeExpiredSessions(ctx)
+
+	assert.ErrorIs(t, err, repository.ErrFailedToDeleteExpiredSessions)
+	assert.Equal(t, int64(0), deleted)
+}
@@ -0,0 +1,27 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+)
+
+// PurgeLoginAttempts deletes failed login attempts that are no longer remembered
+func (s *userService) PurgeLoginAttempts(ctx context.Context) (int64, error) {
+	if s.limiter == nil {
+		return 0, nil
+	}
+
+	deleted, err := s.limiter.Purge(ctx)
+	if err != nil {
+		s.log.Error("Failed to purge login attempts",
+			slog.String("error", err.Error()),
+		)
+		return 0, err
+	}
+
+	s.log.Info("Login attempts purged",
+		slog.Int64("count", deleted),
+	)
+
+	return deleted, nil
+}
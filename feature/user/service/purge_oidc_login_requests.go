@@ -0,0 +1,21 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+	"time"
+)
+
+// PurgeExpiredOIDCLoginRequests deletes OpenID Connect logins that were never completed
+func (s *userService) PurgeExpiredOIDCLoginRequests(ctx context.Context) (int64, error) {
+	deleted, err := s.userRepo.DeleteExpiredOIDCLoginRequests(ctx, time.Now())
+	if err != nil {
+		return 0, err
+	}
+
+	s.log.Info("Expired OIDC login requests purged",
+		slog.Int64("count", deleted),
+	)
+
+	return deleted, nil
+}
@@ -0,0 +1,91 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/fikryfahrezy/let-it-go/feature/user/repository"
+	"github.com/fikryfahrezy/let-it-go/pkg/token"
+)
+
+// RefreshToken exchanges a refresh token for a new token pair. The presented token is
+// rotated so it can be used only once; presenting it again means it leaked, and the
+// whole session family is revoked so neither the thief nor the owner can keep using it.
+func (s *userService) RefreshToken(ctx context.Context, req RefreshTokenRequest) (AuthenticateResponse, error) {
+	current, err := s.userRepo.GetSessionByRefreshTokenHash(ctx, token.Hash(req.RefreshToken))
+	if err != nil {
+		if errors.Is(err, repository.ErrSessionNotFound) {
+			return AuthenticateResponse{}, ErrInvalidRefreshToken
+		}
+		return AuthenticateResponse{}, err
+	}
+
+	if current.RevokedAt != nil {
+		s.log.Warn("Refresh attempted with revoked session",
+			slog.String("session_id", current.FamilyID.String()),
+		)
+		return AuthenticateResponse{}, ErrInvalidRefreshToken
+	}
+
+	if current.RotatedAt != nil {
+		return AuthenticateResponse{}, s.revokeReusedFamily(ctx, current)
+	}
+
+	if !current.ExpiresAt.After(time.Now()) {
+		return AuthenticateResponse{}, ErrInvalidRefreshToken
+	}
+
+	user, err := s.userRepo.GetByID(ctx, current.UserID)
+	if err != nil {
+		if errors.Is(err, repository.ErrUserNotFound) {
+			return AuthenticateResponse{}, ErrInvalidRefreshToken
+		}
+		return AuthenticateResponse{}, err
+	}
+
+	next, refreshToken, err := s.newSession(user.ID, current.FamilyID, req.UserAgent, req.IPAddress)
+	if err != nil {
+		return AuthenticateResponse{}, err
+	}
+
+	if err := s.userRepo.RotateSession(ctx, current.ID, next); err != nil {
+		// Another request rotated the same token first
+		if errors.Is(err, repository.ErrSessionAlreadyRotated) {
+			return AuthenticateResponse{}, s.revokeReusedFamily(ctx, current)
+		}
+		return AuthenticateResponse{}, err
+	}
+
+	// Users who enrolled since the last refresh get an unrestricted access token
+	setupRequired, err := s.twoFactorSetupRequired(ctx, user)
+	if err != nil {
+		return AuthenticateResponse{}, err
+	}
+
+	response, err := s.issueTokens(user, next, refreshToken, setupRequired)
+	if err != nil {
+		return AuthenticateResponse{}, err
+	}
+
+	s.log.Info("Refresh token rotated successfully",
+		slog.String("user_id", user.ID.String()),
+		slog.String("session_id", next.FamilyID.String()),
+	)
+
+	return response, nil
+}
+
+func (s *userService) revokeReusedFamily(ctx context.Context, session repository.Session) error {
+	s.log.Warn("Refresh token reuse detected, revoking session",
+		slog.String("user_id", session.UserID.String()),
+		slog.String("session_id", session.FamilyID.String()),
+	)
+
+	if err := s.userRepo.RevokeSessionFamily(ctx, session.FamilyID); err != nil {
+		return err
+	}
+
+	return ErrRefreshTokenReused
+}
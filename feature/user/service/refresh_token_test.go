@@ -0,0 +1,165 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/fikryfahrezy/let-it-go/feature/user/repository"
+	"github.com/fikryfahrezy/let-it-go/feature/user/repository/repositoryfakes"
+	"github.com/fikryfahrezy/let-it-go/feature/user/service"
+	"github.com/fikryfahrezy/let-it-go/pkg/logger"
+	"github.com/fikryfahrezy/let-it-go/pkg/token"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestSession(userID uuid.UUID, refreshToken string) repository.Session {
+	id := uuid.New()
+	return repository.Session{
+		ID:               id,
+		UserID:           userID,
+		FamilyID:         id,
+		RefreshTokenHash: token.Hash(refreshToken),
+		ExpiresAt:        time.Now().Add(time.Hour),
+	}
+}
+
+func TestUserService_RefreshToken_Success(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeUserRepository{}
+	tokenManager := newTestTokenManager(t)
+	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{TokenManager: tokenManager}, service.Config{})
+	ctx := context.Background()
+
+	user := repository.User{ID: uuid.New(), Email: "john@example.com"}
+	current := newTestSession(user.ID, "refresh-token")
+	mockRepo.GetSessionByRefreshTokenHashReturns(current, nil)
+	mockRepo.GetByIDReturns(user, nil)
+
+	result, err := userService.RefreshToken(ctx, service.RefreshTokenRequest{
+		RefreshToken: "refresh-token",
+		UserAgent:    "test-agent",
+	})
+
+	require.NoError(t, err)
+	assert.NotEmpty(t, result.AccessToken)
+	assert.NotEmpty(t, result.RefreshToken)
+	assert.NotEqual(t, "refresh-token", result.RefreshToken)
+
+	// The lookup uses the hash, never the raw token
+	_, actualHash := mockRepo.GetSessionByRefreshTokenHashArgsForCall(0)
+	assert.Equal(t, token.Hash("refresh-token"), actualHash)
+
+	// The current session is rotated into a new one of the same family
+	require.Equal(t, 1, mockRepo.RotateSessionCallCount())
+	_, currentID, next := mockRepo.RotateSessionArgsForCall(0)
+	assert.Equal(t, current.ID, currentID)
+	assert.NotEqual(t, current.ID, next.ID)
+	assert.Equal(t, current.FamilyID, next.FamilyID)
+	assert.Equal(t, token.Hash(result.RefreshToken), next.RefreshTokenHash)
+	assert.Equal(t, "test-agent", next.UserAgent)
+
+	claims, err := tokenManager.Verify(result.AccessToken)
+	require.NoError(t, err)
+	assert.Equal(t, user.ID.String(), claims.Subject)
+	assert.Equal(t, current.FamilyID.String(), claims.SessionID)
+}
+
+func TestUserService_RefreshToken_UnknownToken(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeUserRepository{}
+	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{TokenManager: newTestTokenManager(t)}, service.Config{})
+	ctx := context.Background()
+
+	mockRepo.GetSessionByRefreshTokenHashReturns(repository.Session{}, repository.ErrSessionNotFound)
+
+	_, err := userService.RefreshToken(ctx, service.RefreshTokenRequest{RefreshToken: "unknown"})
+
+	assert.Equal(t, service.ErrInvalidRefreshToken, err)
+	assert.Equal(t, 0, mockRepo.RotateSessionCallCount())
+}
+
+func TestUserService_RefreshToken_Expired(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeUserRepository{}
+	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{TokenManager: newTestTokenManager(t)}, service.Config{})
+	ctx := context.Background()
+
+	current := newTestSession(uuid.New(), "refresh-token")
+	current.ExpiresAt = time.Now().Add(-time.Minute)
+	mockRepo.GetSessionByRefreshTokenHashReturns(current, nil)
+
+	_, err := userService.RefreshToken(ctx, service.RefreshTokenRequest{RefreshToken: "refresh-token"})
+
+	assert.Equal(t, service.ErrInvalidRefreshToken, err)
+	assert.Equal(t, 0, mockRepo.RotateSessionCallCount())
+}
+
+func TestUserService_RefreshToken_Revoked(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeUserRepository{}
+	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{TokenManager: newTestTokenManager(t)}, service.Config{})
+	ctx := context.Background()
+
+	current := newTestSession(uuid.New(), "refresh-token")
+	revokedAt := time.Now()
+	current.RevokedAt = &revokedAt
+	mockRepo.GetSessionByRefreshTokenHashReturns(current, nil)
+
+	_, err := userService.RefreshToken(ctx, service.RefreshTokenRequest{RefreshToken: "refresh-token"})
+
+	assert.Equal(t, service.ErrInvalidRefreshToken, err)
+	assert.Equal(t, 0, mockRepo.RotateSessionCallCount())
+}
+
+func TestUserService_RefreshToken_ReuseRevokesFamily(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeUserRepository{}
+	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{TokenManager: newTestTokenManager(t)}, service.Config{})
+	ctx := context.Background()
+
+	// The token was already exchanged once
+	current := newTestSession(uuid.New(), "refresh-token")
+	rotatedAt := time.Now()
+	current.RotatedAt = &rotatedAt
+	mockRepo.GetSessionByRefreshTokenHashReturns(current, nil)
+
+	result, err := userService.RefreshToken(ctx, service.RefreshTokenRequest{RefreshToken: "refresh-token"})
+
+	assert.Equal(t, service.ErrRefreshTokenReused, err)
+	assert.Equal(t, service.AuthenticateResponse{}, result)
+	assert.Equal(t, 0, mockRepo.RotateSessionCallCount())
+
+	require.Equal(t, 1, mockRepo.RevokeSessionFamilyCallCount())
+	_, familyID := mockRepo.RevokeSessionFamilyArgsForCall(0)
+	assert.Equal(t, current.FamilyID, familyID)
+}
+
+func TestUserService_RefreshToken_ConcurrentRotationRevokesFamily(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeUserRepository{}
+	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{TokenManager: newTestTokenManager(t)}, service.Config{})
+	ctx := context.Background()
+
+	current := newTestSession(uuid.New(), "refresh-token")
+	mockRepo.GetSessionByRefreshTokenHashReturns(current, nil)
+	mockRepo.GetByIDReturns(repository.User{ID: current.UserID}, nil)
+	mockRepo.RotateSessionReturns(repository.ErrSessionAlreadyRotated)
+
+	_, err := userService.RefreshToken(ctx, service.RefreshTokenRequest{RefreshToken: "refresh-token"})
+
+	assert.Equal(t, service.ErrRefreshTokenReused, err)
+	require.Equal(t, 1, mockRepo.RevokeSessionFamilyCallCount())
+	_, familyID := mockRepo.RevokeSessionFamilyArgsForCall(0)
+	assert.Equal(t, current.FamilyID, familyID)
+}
+
+func TestUserService_RefreshToken_RepositoryError(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeUserRepository{}
+	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{TokenManager: newTestTokenManager(t)}, service.Config{})
+	ctx := context.Background()
+
+	dbError := errors.New("database connection error")
+	mockRepo.GetSessionByRefreshTokenHashReturns(repository.Session{}, dbError)
+
+	_, err := userService.RefreshToken(ctx, service.RefreshTokenRequest{RefreshToken: "refresh-token"})
+
+	assert.Equal(t, dbError, err)
+}
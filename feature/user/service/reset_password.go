@@ -0,0 +1,53 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/fikryfahrezy/let-it-go/feature/user/repository"
+	"github.com/fikryfahrezy/let-it-go/pkg/token"
+)
+
+// ResetPassword sets a new password using a token from ForgotPassword. The token is
+// consumed and every session of the user is revoked.
+func (s *userService) ResetPassword(ctx context.Context, req ResetPasswordRequest) error {
+	resetToken, err := s.userRepo.GetPasswordResetTokenByHash(ctx, token.Hash(req.Token))
+	if err != nil {
+		if errors.Is(err, repository.ErrPasswordResetTokenNotFound) {
+			return ErrInvalidPasswordResetToken
+		}
+		return err
+	}
+
+	if resetToken.UsedAt != nil || !resetToken.ExpiresAt.After(time.Now()) {
+		s.log.Warn("Password reset attempted with used or expired token",
+			slog.String("user_id", resetToken.UserID.String()),
+		)
+		return ErrInvalidPasswordResetToken
+	}
+
+	if err := s.checkPasswordPolicy(req.NewPassword); err != nil {
+		return err
+	}
+
+	hashedPassword, err := s.hashPassword(req.NewPassword)
+	if err != nil {
+		return err
+	}
+
+	if err := s.userRepo.ResetPassword(ctx, resetToken.ID, resetToken.UserID, hashedPassword); err != nil {
+		// Another request redeemed the token first
+		if errors.Is(err, repository.ErrPasswordResetTokenAlreadyUsed) {
+			return ErrInvalidPasswordResetToken
+		}
+		return err
+	}
+
+	s.log.Info("Password reset successfully",
+		slog.String("user_id", resetToken.UserID.String()),
+	)
+
+	return nil
+}
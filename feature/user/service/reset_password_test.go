@@ -0,0 +1,108 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/fikryfahrezy/let-it-go/feature/user/repository"
+	"github.com/fikryfahrezy/let-it-go/feature/user/repository/repositoryfakes"
+	"github.com/fikryfahrezy/let-it-go/feature/user/service"
+	"github.com/fikryfahrezy/let-it-go/pkg/logger"
+	"github.com/fikryfahrezy/let-it-go/pkg/token"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+)
+
+func newTestPasswordResetToken(rawToken string) repository.PasswordResetToken {
+	return repository.PasswordResetToken{
+		ID:        uuid.New(),
+		UserID:    uuid.New(),
+		TokenHash: token.Hash(rawToken),
+		ExpiresAt: time.Now().Add(time.Hour),
+	}
+}
+
+func TestUserService_ResetPassword_Success(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeUserRepository{}
+	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{}, testServiceConfig)
+	ctx := context.Background()
+
+	resetToken := newTestPasswordResetToken("reset-token")
+	mockRepo.GetPasswordResetTokenByHashReturns(resetToken, nil)
+
+	err := userService.ResetPassword(ctx, service.ResetPasswordRequest{
+		Token:       "reset-token",
+		NewPassword: "new-password",
+	})
+	require.NoError(t, err)
+
+	_, actualHash := mockRepo.GetPasswordResetTokenByHashArgsForCall(0)
+	assert.Equal(t, token.Hash("reset-token"), actualHash)
+
+	// The token is consumed together with the new password hash
+	require.Equal(t, 1, mockRepo.ResetPasswordCallCount())
+	_, tokenID, userID, passwordHash := mockRepo.ResetPasswordArgsForCall(0)
+	assert.Equal(t, resetToken.ID, tokenID)
+	assert.Equal(t, resetToken.UserID, userID)
+	assert.NoError(t, bcrypt.CompareHashAndPassword([]byte(passwordHash), []byte("new-password")))
+}
+
+func TestUserService_ResetPassword_UnknownToken(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeUserRepository{}
+	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{}, testServiceConfig)
+	ctx := context.Background()
+
+	mockRepo.GetPasswordResetTokenByHashReturns(repository.PasswordResetToken{}, repository.ErrPasswordResetTokenNotFound)
+
+	err := userService.ResetPassword(ctx, service.ResetPasswordRequest{Token: "unknown", NewPassword: "new-password"})
+
+	assert.Equal(t, service.ErrInvalidPasswordResetToken, err)
+	assert.Equal(t, 0, mockRepo.ResetPasswordCallCount())
+}
+
+func TestUserService_ResetPassword_UsedToken(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeUserRepository{}
+	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{}, testServiceConfig)
+	ctx := context.Background()
+
+	resetToken := newTestPasswordResetToken("reset-token")
+	usedAt := time.Now()
+	resetToken.UsedAt = &usedAt
+	mockRepo.GetPasswordResetTokenByHashReturns(resetToken, nil)
+
+	err := userService.ResetPassword(ctx, service.ResetPasswordRequest{Token: "reset-token", NewPassword: "new-password"})
+
+	assert.Equal(t, service.ErrInvalidPasswordResetToken, err)
+	assert.Equal(t, 0, mockRepo.ResetPasswordCallCount())
+}
+
+func TestUserService_ResetPassword_ExpiredToken(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeUserRepository{}
+	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{}, testServiceConfig)
+	ctx := context.Background()
+
+	resetToken := newTestPasswordResetToken("reset-token")
+	resetToken.ExpiresAt = time.Now().Add(-time.Minute)
+	mockRepo.GetPasswordResetTokenByHashReturns(resetToken, nil)
+
+	err := userService.ResetPassword(ctx, service.ResetPasswordRequest{Token: "reset-token", NewPassword: "new-password"})
+
+	assert.Equal(t, service.ErrInvalidPasswordResetToken, err)
+	assert.Equal(t, 0, mockRepo.ResetPasswordCallCount())
+}
+
+func TestUserService_ResetPassword_ConcurrentRedeem(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeUserRepository{}
+	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{}, testServiceConfig)
+	ctx := context.Background()
+
+	mockRepo.GetPasswordResetTokenByHashReturns(newTestPasswordResetToken("reset-token"), nil)
+	mockRepo.ResetPasswordReturns(repository.ErrPasswordResetTokenAlreadyUsed)
+
+	err := userService.ResetPassword(ctx, service.ResetPasswordRequest{Token: "reset-token", NewPassword: "new-password"})
+
+	assert.Equal(t, service.ErrInvalidPasswordResetToken, err)
+}
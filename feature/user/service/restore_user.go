@@ -0,0 +1,46 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/fikryfahrezy/let-it-go/pkg/http_server"
+	"github.com/fikryfahrezy/let-it-go/pkg/rbac"
+	"github.com/google/uuid"
+)
+
+// defaultDeletionGracePeriod is used when Config.DeletionGracePeriod is zero
+const defaultDeletionGracePeriod = 30 * 24 * time.Hour
+
+// RestoreUser undoes the deletion of a user within the deletion grace period.
+// Sessions and personal access tokens revoked by the deletion stay revoked.
+func (s *userService) RestoreUser(ctx context.Context, userID uuid.UUID) error {
+	principal, ok := http_server.PrincipalFromContext(ctx)
+	if !ok || !rbac.Can(principal, rbac.PermissionUsersManage) {
+		s.log.Warn("User restore denied",
+			slog.String("user_id", userID.String()),
+			slog.String("caller_id", principal.UserID.String()),
+		)
+		return ErrUserForbidden
+	}
+
+	deletedAfter := time.Now().Add(-s.deletionGracePeriod())
+	if err := s.userRepo.Restore(ctx, userID, deletedAfter); err != nil {
+		return err
+	}
+
+	s.log.Info("User restored successfully",
+		slog.String("user_id", userID.String()),
+		slog.String("caller_id", principal.UserID.String()),
+	)
+
+	return nil
+}
+
+func (s *userService) deletionGracePeriod() time.Duration {
+	if s.config.DeletionGracePeriod > 0 {
+		return s.config.DeletionGracePeriod
+	}
+	return defaultDeletionGracePeriod
+}
@@ -0,0 +1,82 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/fikryfahrezy/let-it-go/feature/user/repository"
+	"github.com/fikryfahrezy/let-it-go/feature/user/repository/repositoryfakes"
+	"github.com/fikryfahrezy/let-it-go/feature/user/service"
+	"github.com/fikryfahrezy/let-it-go/pkg/http_server"
+	"github.com/fikryfahrezy/let-it-go/pkg/logger"
+	"github.com/fikryfahrezy/let-it-go/pkg/rbac"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUserService_RestoreUser_Success(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeUserRepository{}
+	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{}, service.Config{
+		DeletionGracePeriod: 7 * 24 * time.Hour,
+	})
+	userID := uuid.New()
+	ctx := http_server.ContextWithPrincipal(context.Background(), http_server.Principal{
+		UserID: uuid.New(),
+		Roles:  []string{rbac.RoleAdmin},
+	})
+
+	err := userService.RestoreUser(ctx, userID)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, mockRepo.RestoreCallCount())
+	_, actualID, deletedAfter := mockRepo.RestoreArgsForCall(0)
+	assert.Equal(t, userID, actualID)
+	assert.WithinDuration(t, time.Now().Add(-7*24*time.Hour), deletedAfter, time.Minute)
+}
+
+func TestUserService_RestoreUser_DefaultGracePeriod(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeUserRepository{}
+	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{}, service.Config{})
+	ctx := http_server.ContextWithPrincipal(context.Background(), http_server.Principal{
+		UserID: uuid.New(),
+		Roles:  []string{rbac.RoleAdmin},
+	})
+
+	err := userService.RestoreUser(ctx, uuid.New())
+
+	assert.NoError(t, err)
+	_, _, deletedAfter := mockRepo.RestoreArgsForCall(0)
+	assert.WithinDuration(t, time.Now().Add(-30*24*time.Hour), deletedAfter, time.Minute)
+}
+
+func TestUserService_RestoreUser_NotRestorable(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeUserRepository{}
+	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{}, service.Config{})
+	ctx := http_server.ContextWithPrincipal(context.Background(), http_server.Principal{
+		UserID: uuid.New(),
+		Roles:  []string{rbac.RoleAdmin},
+	})
+	mockRepo.RestoreReturns(repository.ErrDeletedUserNotFound)
+
+	err := userService.RestoreUser(ctx, uuid.New())
+
+	assert.Equal(t, repository.ErrDeletedUserNotFound, err)
+}
+
+func TestUserService_RestoreUser_Forbidden(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeUserRepository{}
+	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{}, service.Config{})
+	userID := uuid.New()
+
+	// Users cannot undo their own deletion
+	ctx := http_server.ContextWithPrincipal(context.Background(), http_server.Principal{
+		UserID: userID,
+		Roles:  []string{rbac.RoleAuthor},
+	})
+
+	err := userService.RestoreUser(ctx, userID)
+
+	assert.Equal(t, service.ErrUserForbidden, err)
+	assert.Equal(t, 0, mockRepo.RestoreCallCount())
+}
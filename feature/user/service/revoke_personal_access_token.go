@@ -0,0 +1,29 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/google/uuid"
+)
+
+// RevokePersonalAccessToken revokes a personal access token of the user, which stops
+// authenticating immediately
+func (s *userService) RevokePersonalAccessToken(ctx context.Context, userID uuid.UUID, tokenID uuid.UUID) error {
+	principal, err := s.authorizeSelfOrManager(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	if err := s.userRepo.RevokePersonalAccessToken(ctx, userID, tokenID); err != nil {
+		return err
+	}
+
+	s.log.Info("Personal access token revoked successfully",
+		slog.String("user_id", userID.String()),
+		slog.String("token_id", tokenID.String()),
+		slog.String("caller_id", principal.UserID.String()),
+	)
+
+	return nil
+}
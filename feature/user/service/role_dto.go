@@ -0,0 +1,12 @@
+package service
+
+import "github.com/fikryfahrezy/let-it-go/pkg/rbac"
+
+type AssignRoleRequest struct {
+	Role string `json:"role" validate:"required,oneof=admin editor author reader"`
+}
+
+type RoleResponse struct {
+	Name        string            `json:"name"`
+	Permissions []rbac.Permission `json:"permissions"`
+}
@@ -2,18 +2,65 @@ package service
 
 import (
 	"log/slog"
+	"sync"
 
 	"github.com/fikryfahrezy/let-it-go/feature/user/repository"
+	"github.com/fikryfahrezy/let-it-go/pkg/encryption"
+	"github.com/fikryfahrezy/let-it-go/pkg/lockout"
+	"github.com/fikryfahrezy/let-it-go/pkg/mailer"
+	"github.com/fikryfahrezy/let-it-go/pkg/oidc"
+	"github.com/fikryfahrezy/let-it-go/pkg/token"
+	"golang.org/x/crypto/bcrypt"
 )
 
+// Dependencies holds the optional collaborators of the user service
+type Dependencies struct {
+	// TokenManager issues and verifies access tokens
+	TokenManager *token.Manager
+	// Mailer sends password reset and email verification messages
+	Mailer mailer.Mailer
+	// Limiter throttles failed logins; nil disables brute-force protection
+	Limiter *lockout.Limiter
+	// Cipher encrypts TOTP secrets; nil disables two factor authentication
+	Cipher *encryption.Cipher
+	// OIDCProvider signs users in through OpenID Connect; nil disables OpenID Connect login
+	OIDCProvider *oidc.Provider
+	// BlogReader reads the blogs included in data exports and profiles
+	BlogReader BlogReader
+}
+
 type userService struct {
-	userRepo repository.UserRepository
-	log      *slog.Logger
+	userRepo     repository.UserRepository
+	tokenManager *token.Manager
+	mailer       mailer.Mailer
+	limiter      *lockout.Limiter   // Nil disables brute-force protection
+	cipher       *encryption.Cipher // Encrypts TOTP secrets; nil disables two factor authentication
+	oidcProvider *oidc.Provider     // Nil disables OpenID Connect login
+	blogReader   BlogReader         // Reads the blogs included in data exports and profiles
+	config       Config
+	log          *slog.Logger
+
+	// dummyPasswordHash is compared against when the email is unknown so that a
+	// failed login takes roughly the same time whether or not the account exists.
+	// It uses the configured cost and is only generated on first use.
+	dummyPasswordHash func() []byte
 }
 
-func NewUserService(log *slog.Logger, userRepo repository.UserRepository) *userService {
-	return &userService{
-		userRepo: userRepo,
-		log:      log,
+func NewUserService(log *slog.Logger, userRepo repository.UserRepository, deps Dependencies, config Config) *userService {
+	s := &userService{
+		userRepo:     userRepo,
+		tokenManager: deps.TokenManager,
+		mailer:       deps.Mailer,
+		limiter:      deps.Limiter,
+		cipher:       deps.Cipher,
+		oidcProvider: deps.OIDCProvider,
+		blogReader:   deps.BlogReader,
+		config:       config,
+		log:          log,
 	}
+	s.dummyPasswordHash = sync.OnceValue(func() []byte {
+		hash, _ := bcrypt.GenerateFromPassword([]byte("dummy-password"), s.bcryptCost())
+		return hash
+	})
+	return s
 }
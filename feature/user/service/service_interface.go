@@ -5,13 +5,52 @@ package service
 import (
 	"context"
 
+	"github.com/fikryfahrezy/let-it-go/pkg/http_server"
 	"github.com/google/uuid"
 )
 
 type UserService interface {
 	CreateUser(ctx context.Context, req CreateUserRequest) (CreateUserResponse, error)
+	CreateInvitedUser(ctx context.Context, req CreateInvitedUserRequest) (CreateUserResponse, error)
 	GetUserByID(ctx context.Context, id uuid.UUID) (GetUserResponse, error)
+	GetUserProfile(ctx context.Context, id uuid.UUID) (UserProfileResponse, error)
+	UpdateAuthorProfile(ctx context.Context, userID uuid.UUID, req UpdateAuthorProfileRequest) (GetUserResponse, error)
+	GetAuthor(ctx context.Context, handle string) (AuthorResponse, error)
+	GetPreferences(ctx context.Context, userID uuid.UUID) (PreferencesResponse, error)
+	UpdatePreferences(ctx context.Context, userID uuid.UUID, req Preferences) (PreferencesResponse, error)
+	DefaultBlogStatus(ctx context.Context, userID uuid.UUID) (string, error)
 	UpdateUser(ctx context.Context, id uuid.UUID, req UpdateUserRequest) (UpdateUserResponse, error)
 	DeleteUser(ctx context.Context, id uuid.UUID) error
+	RestoreUser(ctx context.Context, userID uuid.UUID) error
+	ExportUser(ctx context.Context, userID uuid.UUID) (ExportUserResponse, error)
+	BatchUserOperations(ctx context.Context, req BatchUserOperationsRequest) (BatchUserOperationsResponse, error)
+	EraseDeletedUsers(ctx context.Context) (int64, error)
 	ListUsers(ctx context.Context, req ListUsersRequest) ([]ListUsersResponse, int64, error)
+	Authenticate(ctx context.Context, req AuthenticateRequest) (AuthenticateResponse, error)
+	RefreshToken(ctx context.Context, req RefreshTokenRequest) (AuthenticateResponse, error)
+	Logout(ctx context.Context, req LogoutRequest) error
+	ListSessions(ctx context.Context, userID uuid.UUID) ([]SessionResponse, error)
+	PurgeExpiredSessions(ctx context.Context) (int64, error)
+	PurgeLoginAttempts(ctx context.Context) (int64, error)
+	ForgotPassword(ctx context.Context, req ForgotPasswordRequest) error
+	ResetPassword(ctx context.Context, req ResetPasswordRequest) error
+	ChangePassword(ctx context.Context, userID uuid.UUID, req ChangePasswordRequest) error
+	VerifyEmail(ctx context.Context, req VerifyEmailRequest) error
+	IsEmailVerified(ctx context.Context, userID uuid.UUID) (bool, error)
+	AssignRole(ctx context.Context, userID uuid.UUID, req AssignRoleRequest) (GetUserResponse, error)
+	ListRoles(ctx context.Context) []RoleResponse
+	UnlockUser(ctx context.Context, userID uuid.UUID) error
+	StartImpersonation(ctx context.Context, userID uuid.UUID, req StartImpersonationRequest) (ImpersonationResponse, error)
+	RecordImpersonatedRequest(ctx context.Context, principal http_server.Principal, request http_server.ImpersonatedRequest) error
+	CreatePersonalAccessToken(ctx context.Context, userID uuid.UUID, req CreatePersonalAccessTokenRequest) (CreatePersonalAccessTokenResponse, error)
+	ListPersonalAccessTokens(ctx context.Context, userID uuid.UUID) ([]PersonalAccessTokenResponse, error)
+	RevokePersonalAccessToken(ctx context.Context, userID uuid.UUID, tokenID uuid.UUID) error
+	EnrollTwoFactor(ctx context.Context, userID uuid.UUID) (EnrollTwoFactorResponse, error)
+	ConfirmTwoFactor(ctx context.Context, userID uuid.UUID, req ConfirmTwoFactorRequest) (ConfirmTwoFactorResponse, error)
+	DisableTwoFactor(ctx context.Context, userID uuid.UUID, req DisableTwoFactorRequest) error
+	VerifyTwoFactor(ctx context.Context, req VerifyTwoFactorRequest) (AuthenticateResponse, error)
+	OIDCAuthorize(ctx context.Context) (OIDCAuthorizeResponse, error)
+	OIDCCallback(ctx context.Context, req OIDCCallbackRequest) (AuthenticateResponse, error)
+	PurgeExpiredOIDCLoginRequests(ctx context.Context) (int64, error)
+	AuthenticateBearer(ctx context.Context, bearerToken string) (http_server.Principal, error)
 }
@@ -0,0 +1,285 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package servicefakes
+
+import (
+	"context"
+	"sync"
+
+	"github.com/fikryfahrezy/let-it-go/feature/blog/repository"
+	"github.com/fikryfahrezy/let-it-go/feature/user/service"
+	"github.com/google/uuid"
+)
+
+type FakeBlogReader struct {
+	CountByAuthorIDAndStatusStub        func(context.Context, uuid.UUID, string) (int64, error)
+	countByAuthorIDAndStatusMutex       sync.RWMutex
+	countByAuthorIDAndStatusArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 string
+	}
+	countByAuthorIDAndStatusReturns struct {
+		result1 int64
+		result2 error
+	}
+	countByAuthorIDAndStatusReturnsOnCall map[int]struct {
+		result1 int64
+		result2 error
+	}
+	GetByAuthorIDStub        func(context.Context, uuid.UUID, int, int) ([]repository.Blog, error)
+	getByAuthorIDMutex       sync.RWMutex
+	getByAuthorIDArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 int
+		arg4 int
+	}
+	getByAuthorIDReturns struct {
+		result1 []repository.Blog
+		result2 error
+	}
+	getByAuthorIDReturnsOnCall map[int]struct {
+		result1 []repository.Blog
+		result2 error
+	}
+	GetPublishedByAuthorIDStub        func(context.Context, uuid.UUID, int) ([]repository.Blog, error)
+	getPublishedByAuthorIDMutex       sync.RWMutex
+	getPublishedByAuthorIDArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 int
+	}
+	getPublishedByAuthorIDReturns struct {
+		result1 []repository.Blog
+		result2 error
+	}
+	getPublishedByAuthorIDReturnsOnCall map[int]struct {
+		result1 []repository.Blog
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeBlogReader) CountByAuthorIDAndStatus(arg1 context.Context, arg2 uuid.UUID, arg3 string) (int64, error) {
+	fake.countByAuthorIDAndStatusMutex.Lock()
+	ret, specificReturn := fake.countByAuthorIDAndStatusReturnsOnCall[len(fake.countByAuthorIDAndStatusArgsForCall)]
+	fake.countByAuthorIDAndStatusArgsForCall = append(fake.countByAuthorIDAndStatusArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.CountByAuthorIDAndStatusStub
+	fakeReturns := fake.countByAuthorIDAndStatusReturns
+	fake.recordInvocation("CountByAuthorIDAndStatus", []interface{}{arg1, arg2, arg3})
+	fake.countByAuthorIDAndStatusMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeBlogReader) CountByAuthorIDAndStatusCallCount() int {
+	fake.countByAuthorIDAndStatusMutex.RLock()
+	defer fake.countByAuthorIDAndStatusMutex.RUnlock()
+	return len(fake.countByAuthorIDAndStatusArgsForCall)
+}
+
+func (fake *FakeBlogReader) CountByAuthorIDAndStatusCalls(stub func(context.Context, uuid.UUID, string) (int64, error)) {
+	fake.countByAuthorIDAndStatusMutex.Lock()
+	defer fake.countByAuthorIDAndStatusMutex.Unlock()
+	fake.CountByAuthorIDAndStatusStub = stub
+}
+
+func (fake *FakeBlogReader) CountByAuthorIDAndStatusArgsForCall(i int) (context.Context, uuid.UUID, string) {
+	fake.countByAuthorIDAndStatusMutex.RLock()
+	defer fake.countByAuthorIDAndStatusMutex.RUnlock()
+	argsForCall := fake.countByAuthorIDAndStatusArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeBlogReader) CountByAuthorIDAndStatusReturns(result1 int64, result2 error) {
+	fake.countByAuthorIDAndStatusMutex.Lock()
+	defer fake.countByAuthorIDAndStatusMutex.Unlock()
+	fake.CountByAuthorIDAndStatusStub = nil
+	fake.countByAuthorIDAndStatusReturns = struct {
+		result1 int64
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBlogReader) CountByAuthorIDAndStatusReturnsOnCall(i int, result1 int64, result2 error) {
+	fake.countByAuthorIDAndStatusMutex.Lock()
+	defer fake.countByAuthorIDAndStatusMutex.Unlock()
+	fake.CountByAuthorIDAndStatusStub = nil
+	if fake.countByAuthorIDAndStatusReturnsOnCall == nil {
+		fake.countByAuthorIDAndStatusReturnsOnCall = make(map[int]struct {
+			result1 int64
+			result2 error
+		})
+	}
+	fake.countByAuthorIDAndStatusReturnsOnCall[i] = struct {
+		result1 int64
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBlogReader) GetByAuthorID(arg1 context.Context, arg2 uuid.UUID, arg3 int, arg4 int) ([]repository.Blog, error) {
+	fake.getByAuthorIDMutex.Lock()
+	ret, specificReturn := fake.getByAuthorIDReturnsOnCall[len(fake.getByAuthorIDArgsForCall)]
+	fake.getByAuthorIDArgsForCall = append(fake.getByAuthorIDArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 int
+		arg4 int
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.GetByAuthorIDStub
+	fakeReturns := fake.getByAuthorIDReturns
+	fake.recordInvocation("GetByAuthorID", []interface{}{arg1, arg2, arg3, arg4})
+	fake.getByAuthorIDMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeBlogReader) GetByAuthorIDCallCount() int {
+	fake.getByAuthorIDMutex.RLock()
+	defer fake.getByAuthorIDMutex.RUnlock()
+	return len(fake.getByAuthorIDArgsForCall)
+}
+
+func (fake *FakeBlogReader) GetByAuthorIDCalls(stub func(context.Context, uuid.UUID, int, int) ([]repository.Blog, error)) {
+	fake.getByAuthorIDMutex.Lock()
+	defer fake.getByAuthorIDMutex.Unlock()
+	fake.GetByAuthorIDStub = stub
+}
+
+func (fake *FakeBlogReader) GetByAuthorIDArgsForCall(i int) (context.Context, uuid.UUID, int, int) {
+	fake.getByAuthorIDMutex.RLock()
+	defer fake.getByAuthorIDMutex.RUnlock()
+	argsForCall := fake.getByAuthorIDArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeBlogReader) GetByAuthorIDReturns(result1 []repository.Blog, result2 error) {
+	fake.getByAuthorIDMutex.Lock()
+	defer fake.getByAuthorIDMutex.Unlock()
+	fake.GetByAuthorIDStub = nil
+	fake.getByAuthorIDReturns = struct {
+		result1 []repository.Blog
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBlogReader) GetByAuthorIDReturnsOnCall(i int, result1 []repository.Blog, result2 error) {
+	fake.getByAuthorIDMutex.Lock()
+	defer fake.getByAuthorIDMutex.Unlock()
+	fake.GetByAuthorIDStub = nil
+	if fake.getByAuthorIDReturnsOnCall == nil {
+		fake.getByAuthorIDReturnsOnCall = make(map[int]struct {
+			result1 []repository.Blog
+			result2 error
+		})
+	}
+	fake.getByAuthorIDReturnsOnCall[i] = struct {
+		result1 []repository.Blog
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBlogReader) GetPublishedByAuthorID(arg1 context.Context, arg2 uuid.UUID, arg3 int) ([]repository.Blog, error) {
+	fake.getPublishedByAuthorIDMutex.Lock()
+	ret, specificReturn := fake.getPublishedByAuthorIDReturnsOnCall[len(fake.getPublishedByAuthorIDArgsForCall)]
+	fake.getPublishedByAuthorIDArgsForCall = append(fake.getPublishedByAuthorIDArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 int
+	}{arg1, arg2, arg3})
+	stub := fake.GetPublishedByAuthorIDStub
+	fakeReturns := fake.getPublishedByAuthorIDReturns
+	fake.recordInvocation("GetPublishedByAuthorID", []interface{}{arg1, arg2, arg3})
+	fake.getPublishedByAuthorIDMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeBlogReader) GetPublishedByAuthorIDCallCount() int {
+	fake.getPublishedByAuthorIDMutex.RLock()
+	defer fake.getPublishedByAuthorIDMutex.RUnlock()
+	return len(fake.getPublishedByAuthorIDArgsForCall)
+}
+
+func (fake *FakeBlogReader) GetPublishedByAuthorIDCalls(stub func(context.Context, uuid.UUID, int) ([]repository.Blog, error)) {
+	fake.getPublishedByAuthorIDMutex.Lock()
+	defer fake.getPublishedByAuthorIDMutex.Unlock()
+	fake.GetPublishedByAuthorIDStub = stub
+}
+
+func (fake *FakeBlogReader) GetPublishedByAuthorIDArgsForCall(i int) (context.Context, uuid.UUID, int) {
+	fake.getPublishedByAuthorIDMutex.RLock()
+	defer fake.getPublishedByAuthorIDMutex.RUnlock()
+	argsForCall := fake.getPublishedByAuthorIDArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeBlogReader) GetPublishedByAuthorIDReturns(result1 []repository.Blog, result2 error) {
+	fake.getPublishedByAuthorIDMutex.Lock()
+	defer fake.getPublishedByAuthorIDMutex.Unlock()
+	fake.GetPublishedByAuthorIDStub = nil
+	fake.getPublishedByAuthorIDReturns = struct {
+		result1 []repository.Blog
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBlogReader) GetPublishedByAuthorIDReturnsOnCall(i int, result1 []repository.Blog, result2 error) {
+	fake.getPublishedByAuthorIDMutex.Lock()
+	defer fake.getPublishedByAuthorIDMutex.Unlock()
+	fake.GetPublishedByAuthorIDStub = nil
+	if fake.getPublishedByAuthorIDReturnsOnCall == nil {
+		fake.getPublishedByAuthorIDReturnsOnCall = make(map[int]struct {
+			result1 []repository.Blog
+			result2 error
+		})
+	}
+	fake.getPublishedByAuthorIDReturnsOnCall[i] = struct {
+		result1 []repository.Blog
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBlogReader) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeBlogReader) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ service.BlogReader = new(FakeBlogReader)
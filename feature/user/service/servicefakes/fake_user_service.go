@@ -6,10 +6,125 @@ import (
 	"sync"
 
 	"github.com/fikryfahrezy/let-it-go/feature/user/service"
+	"github.com/fikryfahrezy/let-it-go/pkg/http_server"
 	"github.com/google/uuid"
 )
 
 type FakeUserService struct {
+	AssignRoleStub        func(context.Context, uuid.UUID, service.AssignRoleRequest) (service.GetUserResponse, error)
+	assignRoleMutex       sync.RWMutex
+	assignRoleArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 service.AssignRoleRequest
+	}
+	assignRoleReturns struct {
+		result1 service.GetUserResponse
+		result2 error
+	}
+	assignRoleReturnsOnCall map[int]struct {
+		result1 service.GetUserResponse
+		result2 error
+	}
+	AuthenticateStub        func(context.Context, service.AuthenticateRequest) (service.AuthenticateResponse, error)
+	authenticateMutex       sync.RWMutex
+	authenticateArgsForCall []struct {
+		arg1 context.Context
+		arg2 service.AuthenticateRequest
+	}
+	authenticateReturns struct {
+		result1 service.AuthenticateResponse
+		result2 error
+	}
+	authenticateReturnsOnCall map[int]struct {
+		result1 service.AuthenticateResponse
+		result2 error
+	}
+	AuthenticateBearerStub        func(context.Context, string) (http_server.Principal, error)
+	authenticateBearerMutex       sync.RWMutex
+	authenticateBearerArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	authenticateBearerReturns struct {
+		result1 http_server.Principal
+		result2 error
+	}
+	authenticateBearerReturnsOnCall map[int]struct {
+		result1 http_server.Principal
+		result2 error
+	}
+	BatchUserOperationsStub        func(context.Context, service.BatchUserOperationsRequest) (service.BatchUserOperationsResponse, error)
+	batchUserOperationsMutex       sync.RWMutex
+	batchUserOperationsArgsForCall []struct {
+		arg1 context.Context
+		arg2 service.BatchUserOperationsRequest
+	}
+	batchUserOperationsReturns struct {
+		result1 service.BatchUserOperationsResponse
+		result2 error
+	}
+	batchUserOperationsReturnsOnCall map[int]struct {
+		result1 service.BatchUserOperationsResponse
+		result2 error
+	}
+	ChangePasswordStub        func(context.Context, uuid.UUID, service.ChangePasswordRequest) error
+	changePasswordMutex       sync.RWMutex
+	changePasswordArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 service.ChangePasswordRequest
+	}
+	changePasswordReturns struct {
+		result1 error
+	}
+	changePasswordReturnsOnCall map[int]struct {
+		result1 error
+	}
+	ConfirmTwoFactorStub        func(context.Context, uuid.UUID, service.ConfirmTwoFactorRequest) (service.ConfirmTwoFactorResponse, error)
+	confirmTwoFactorMutex       sync.RWMutex
+	confirmTwoFactorArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 service.ConfirmTwoFactorRequest
+	}
+	confirmTwoFactorReturns struct {
+		result1 service.ConfirmTwoFactorResponse
+		result2 error
+	}
+	confirmTwoFactorReturnsOnCall map[int]struct {
+		result1 service.ConfirmTwoFactorResponse
+		result2 error
+	}
+	CreateInvitedUserStub        func(context.Context, service.CreateInvitedUserRequest) (service.CreateUserResponse, error)
+	createInvitedUserMutex       sync.RWMutex
+	createInvitedUserArgsForCall []struct {
+		arg1 context.Context
+		arg2 service.CreateInvitedUserRequest
+	}
+	createInvitedUserReturns struct {
+		result1 service.CreateUserResponse
+		result2 error
+	}
+	createInvitedUserReturnsOnCall map[int]struct {
+		result1 service.CreateUserResponse
+		result2 error
+	}
+	CreatePersonalAccessTokenStub        func(context.Context, uuid.UUID, service.CreatePersonalAccessTokenRequest) (service.CreatePersonalAccessTokenResponse, error)
+	createPersonalAccessTokenMutex       sync.RWMutex
+	createPersonalAccessTokenArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 service.CreatePersonalAccessTokenRequest
+	}
+	createPersonalAccessTokenReturns struct {
+		result1 service.CreatePersonalAccessTokenResponse
+		result2 error
+	}
+	createPersonalAccessTokenReturnsOnCall map[int]struct {
+		result1 service.CreatePersonalAccessTokenResponse
+		result2 error
+	}
 	CreateUserStub        func(context.Context, service.CreateUserRequest) (service.CreateUserResponse, error)
 	createUserMutex       sync.RWMutex
 	createUserArgsForCall []struct {
@@ -24,6 +139,20 @@ type FakeUserService struct {
 		result1 service.CreateUserResponse
 		result2 error
 	}
+	DefaultBlogStatusStub        func(context.Context, uuid.UUID) (string, error)
+	defaultBlogStatusMutex       sync.RWMutex
+	defaultBlogStatusArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}
+	defaultBlogStatusReturns struct {
+		result1 string
+		result2 error
+	}
+	defaultBlogStatusReturnsOnCall map[int]struct {
+		result1 string
+		result2 error
+	}
 	DeleteUserStub        func(context.Context, uuid.UUID) error
 	deleteUserMutex       sync.RWMutex
 	deleteUserArgsForCall []struct {
@@ -36,6 +165,100 @@ type FakeUserService struct {
 	deleteUserReturnsOnCall map[int]struct {
 		result1 error
 	}
+	DisableTwoFactorStub        func(context.Context, uuid.UUID, service.DisableTwoFactorRequest) error
+	disableTwoFactorMutex       sync.RWMutex
+	disableTwoFactorArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 service.DisableTwoFactorRequest
+	}
+	disableTwoFactorReturns struct {
+		result1 error
+	}
+	disableTwoFactorReturnsOnCall map[int]struct {
+		result1 error
+	}
+	EnrollTwoFactorStub        func(context.Context, uuid.UUID) (service.EnrollTwoFactorResponse, error)
+	enrollTwoFactorMutex       sync.RWMutex
+	enrollTwoFactorArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}
+	enrollTwoFactorReturns struct {
+		result1 service.EnrollTwoFactorResponse
+		result2 error
+	}
+	enrollTwoFactorReturnsOnCall map[int]struct {
+		result1 service.EnrollTwoFactorResponse
+		result2 error
+	}
+	EraseDeletedUsersStub        func(context.Context) (int64, error)
+	eraseDeletedUsersMutex       sync.RWMutex
+	eraseDeletedUsersArgsForCall []struct {
+		arg1 context.Context
+	}
+	eraseDeletedUsersReturns struct {
+		result1 int64
+		result2 error
+	}
+	eraseDeletedUsersReturnsOnCall map[int]struct {
+		result1 int64
+		result2 error
+	}
+	ExportUserStub        func(context.Context, uuid.UUID) (service.ExportUserResponse, error)
+	exportUserMutex       sync.RWMutex
+	exportUserArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}
+	exportUserReturns struct {
+		result1 service.ExportUserResponse
+		result2 error
+	}
+	exportUserReturnsOnCall map[int]struct {
+		result1 service.ExportUserResponse
+		result2 error
+	}
+	ForgotPasswordStub        func(context.Context, service.ForgotPasswordRequest) error
+	forgotPasswordMutex       sync.RWMutex
+	forgotPasswordArgsForCall []struct {
+		arg1 context.Context
+		arg2 service.ForgotPasswordRequest
+	}
+	forgotPasswordReturns struct {
+		result1 error
+	}
+	forgotPasswordReturnsOnCall map[int]struct {
+		result1 error
+	}
+	GetAuthorStub        func(context.Context, string) (service.AuthorResponse, error)
+	getAuthorMutex       sync.RWMutex
+	getAuthorArgsForCall []struct {
+		arg1 context.Context
+		arg2 string
+	}
+	getAuthorReturns struct {
+		result1 service.AuthorResponse
+		result2 error
+	}
+	getAuthorReturnsOnCall map[int]struct {
+		result1 service.AuthorResponse
+		result2 error
+	}
+	GetPreferencesStub        func(context.Context, uuid.UUID) (service.PreferencesResponse, error)
+	getPreferencesMutex       sync.RWMutex
+	getPreferencesArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}
+	getPreferencesReturns struct {
+		result1 service.PreferencesResponse
+		result2 error
+	}
+	getPreferencesReturnsOnCall map[int]struct {
+		result1 service.PreferencesResponse
+		result2 error
+	}
 	GetUserByIDStub        func(context.Context, uuid.UUID) (service.GetUserResponse, error)
 	getUserByIDMutex       sync.RWMutex
 	getUserByIDArgsForCall []struct {
@@ -50,6 +273,73 @@ type FakeUserService struct {
 		result1 service.GetUserResponse
 		result2 error
 	}
+	GetUserProfileStub        func(context.Context, uuid.UUID) (service.UserProfileResponse, error)
+	getUserProfileMutex       sync.RWMutex
+	getUserProfileArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}
+	getUserProfileReturns struct {
+		result1 service.UserProfileResponse
+		result2 error
+	}
+	getUserProfileReturnsOnCall map[int]struct {
+		result1 service.UserProfileResponse
+		result2 error
+	}
+	IsEmailVerifiedStub        func(context.Context, uuid.UUID) (bool, error)
+	isEmailVerifiedMutex       sync.RWMutex
+	isEmailVerifiedArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}
+	isEmailVerifiedReturns struct {
+		result1 bool
+		result2 error
+	}
+	isEmailVerifiedReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
+	ListPersonalAccessTokensStub        func(context.Context, uuid.UUID) ([]service.PersonalAccessTokenResponse, error)
+	listPersonalAccessTokensMutex       sync.RWMutex
+	listPersonalAccessTokensArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}
+	listPersonalAccessTokensReturns struct {
+		result1 []service.PersonalAccessTokenResponse
+		result2 error
+	}
+	listPersonalAccessTokensReturnsOnCall map[int]struct {
+		result1 []service.PersonalAccessTokenResponse
+		result2 error
+	}
+	ListRolesStub        func(context.Context) []service.RoleResponse
+	listRolesMutex       sync.RWMutex
+	listRolesArgsForCall []struct {
+		arg1 context.Context
+	}
+	listRolesReturns struct {
+		result1 []service.RoleResponse
+	}
+	listRolesReturnsOnCall map[int]struct {
+		result1 []service.RoleResponse
+	}
+	ListSessionsStub        func(context.Context, uuid.UUID) ([]service.SessionResponse, error)
+	listSessionsMutex       sync.RWMutex
+	listSessionsArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}
+	listSessionsReturns struct {
+		result1 []service.SessionResponse
+		result2 error
+	}
+	listSessionsReturnsOnCall map[int]struct {
+		result1 []service.SessionResponse
+		result2 error
+	}
 	ListUsersStub        func(context.Context, service.ListUsersRequest) ([]service.ListUsersResponse, int64, error)
 	listUsersMutex       sync.RWMutex
 	listUsersArgsForCall []struct {
@@ -66,6 +356,205 @@ type FakeUserService struct {
 		result2 int64
 		result3 error
 	}
+	LogoutStub        func(context.Context, service.LogoutRequest) error
+	logoutMutex       sync.RWMutex
+	logoutArgsForCall []struct {
+		arg1 context.Context
+		arg2 service.LogoutRequest
+	}
+	logoutReturns struct {
+		result1 error
+	}
+	logoutReturnsOnCall map[int]struct {
+		result1 error
+	}
+	OIDCAuthorizeStub        func(context.Context) (service.OIDCAuthorizeResponse, error)
+	oIDCAuthorizeMutex       sync.RWMutex
+	oIDCAuthorizeArgsForCall []struct {
+		arg1 context.Context
+	}
+	oIDCAuthorizeReturns struct {
+		result1 service.OIDCAuthorizeResponse
+		result2 error
+	}
+	oIDCAuthorizeReturnsOnCall map[int]struct {
+		result1 service.OIDCAuthorizeResponse
+		result2 error
+	}
+	OIDCCallbackStub        func(context.Context, service.OIDCCallbackRequest) (service.AuthenticateResponse, error)
+	oIDCCallbackMutex       sync.RWMutex
+	oIDCCallbackArgsForCall []struct {
+		arg1 context.Context
+		arg2 service.OIDCCallbackRequest
+	}
+	oIDCCallbackReturns struct {
+		result1 service.AuthenticateResponse
+		result2 error
+	}
+	oIDCCallbackReturnsOnCall map[int]struct {
+		result1 service.AuthenticateResponse
+		result2 error
+	}
+	PurgeExpiredOIDCLoginRequestsStub        func(context.Context) (int64, error)
+	purgeExpiredOIDCLoginRequestsMutex       sync.RWMutex
+	purgeExpiredOIDCLoginRequestsArgsForCall []struct {
+		arg1 context.Context
+	}
+	purgeExpiredOIDCLoginRequestsReturns struct {
+		result1 int64
+		result2 error
+	}
+	purgeExpiredOIDCLoginRequestsReturnsOnCall map[int]struct {
+		result1 int64
+		result2 error
+	}
+	PurgeExpiredSessionsStub        func(context.Context) (int64, error)
+	purgeExpiredSessionsMutex       sync.RWMutex
+	purgeExpiredSessionsArgsForCall []struct {
+		arg1 context.Context
+	}
+	purgeExpiredSessionsReturns struct {
+		result1 int64
+		result2 error
+	}
+	purgeExpiredSessionsReturnsOnCall map[int]struct {
+		result1 int64
+		result2 error
+	}
+	PurgeLoginAttemptsStub        func(context.Context) (int64, error)
+	purgeLoginAttemptsMutex       sync.RWMutex
+	purgeLoginAttemptsArgsForCall []struct {
+		arg1 context.Context
+	}
+	purgeLoginAttemptsReturns struct {
+		result1 int64
+		result2 error
+	}
+	purgeLoginAttemptsReturnsOnCall map[int]struct {
+		result1 int64
+		result2 error
+	}
+	RecordImpersonatedRequestStub        func(context.Context, http_server.Principal, http_server.ImpersonatedRequest) error
+	recordImpersonatedRequestMutex       sync.RWMutex
+	recordImpersonatedRequestArgsForCall []struct {
+		arg1 context.Context
+		arg2 http_server.Principal
+		arg3 http_server.ImpersonatedRequest
+	}
+	recordImpersonatedRequestReturns struct {
+		result1 error
+	}
+	recordImpersonatedRequestReturnsOnCall map[int]struct {
+		result1 error
+	}
+	RefreshTokenStub        func(context.Context, service.RefreshTokenRequest) (service.AuthenticateResponse, error)
+	refreshTokenMutex       sync.RWMutex
+	refreshTokenArgsForCall []struct {
+		arg1 context.Context
+		arg2 service.RefreshTokenRequest
+	}
+	refreshTokenReturns struct {
+		result1 service.AuthenticateResponse
+		result2 error
+	}
+	refreshTokenReturnsOnCall map[int]struct {
+		result1 service.AuthenticateResponse
+		result2 error
+	}
+	ResetPasswordStub        func(context.Context, service.ResetPasswordRequest) error
+	resetPasswordMutex       sync.RWMutex
+	resetPasswordArgsForCall []struct {
+		arg1 context.Context
+		arg2 service.ResetPasswordRequest
+	}
+	resetPasswordReturns struct {
+		result1 error
+	}
+	resetPasswordReturnsOnCall map[int]struct {
+		result1 error
+	}
+	RestoreUserStub        func(context.Context, uuid.UUID) error
+	restoreUserMutex       sync.RWMutex
+	restoreUserArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}
+	restoreUserReturns struct {
+		result1 error
+	}
+	restoreUserReturnsOnCall map[int]struct {
+		result1 error
+	}
+	RevokePersonalAccessTokenStub        func(context.Context, uuid.UUID, uuid.UUID) error
+	revokePersonalAccessTokenMutex       sync.RWMutex
+	revokePersonalAccessTokenArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+	}
+	revokePersonalAccessTokenReturns struct {
+		result1 error
+	}
+	revokePersonalAccessTokenReturnsOnCall map[int]struct {
+		result1 error
+	}
+	StartImpersonationStub        func(context.Context, uuid.UUID, service.StartImpersonationRequest) (service.ImpersonationResponse, error)
+	startImpersonationMutex       sync.RWMutex
+	startImpersonationArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 service.StartImpersonationRequest
+	}
+	startImpersonationReturns struct {
+		result1 service.ImpersonationResponse
+		result2 error
+	}
+	startImpersonationReturnsOnCall map[int]struct {
+		result1 service.ImpersonationResponse
+		result2 error
+	}
+	UnlockUserStub        func(context.Context, uuid.UUID) error
+	unlockUserMutex       sync.RWMutex
+	unlockUserArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}
+	unlockUserReturns struct {
+		result1 error
+	}
+	unlockUserReturnsOnCall map[int]struct {
+		result1 error
+	}
+	UpdateAuthorProfileStub        func(context.Context, uuid.UUID, service.UpdateAuthorProfileRequest) (service.GetUserResponse, error)
+	updateAuthorProfileMutex       sync.RWMutex
+	updateAuthorProfileArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 service.UpdateAuthorProfileRequest
+	}
+	updateAuthorProfileReturns struct {
+		result1 service.GetUserResponse
+		result2 error
+	}
+	updateAuthorProfileReturnsOnCall map[int]struct {
+		result1 service.GetUserResponse
+		result2 error
+	}
+	UpdatePreferencesStub        func(context.Context, uuid.UUID, service.Preferences) (service.PreferencesResponse, error)
+	updatePreferencesMutex       sync.RWMutex
+	updatePreferencesArgsForCall []struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 service.Preferences
+	}
+	updatePreferencesReturns struct {
+		result1 service.PreferencesResponse
+		result2 error
+	}
+	updatePreferencesReturnsOnCall map[int]struct {
+		result1 service.PreferencesResponse
+		result2 error
+	}
 	UpdateUserStub        func(context.Context, uuid.UUID, service.UpdateUserRequest) (service.UpdateUserResponse, error)
 	updateUserMutex       sync.RWMutex
 	updateUserArgsForCall []struct {
@@ -77,27 +566,2368 @@ type FakeUserService struct {
 		result1 service.UpdateUserResponse
 		result2 error
 	}
-	updateUserReturnsOnCall map[int]struct {
-		result1 service.UpdateUserResponse
-		result2 error
+	updateUserReturnsOnCall map[int]struct {
+		result1 service.UpdateUserResponse
+		result2 error
+	}
+	VerifyEmailStub        func(context.Context, service.VerifyEmailRequest) error
+	verifyEmailMutex       sync.RWMutex
+	verifyEmailArgsForCall []struct {
+		arg1 context.Context
+		arg2 service.VerifyEmailRequest
+	}
+	verifyEmailReturns struct {
+		result1 error
+	}
+	verifyEmailReturnsOnCall map[int]struct {
+		result1 error
+	}
+	VerifyTwoFactorStub        func(context.Context, service.VerifyTwoFactorRequest) (service.AuthenticateResponse, error)
+	verifyTwoFactorMutex       sync.RWMutex
+	verifyTwoFactorArgsForCall []struct {
+		arg1 context.Context
+		arg2 service.VerifyTwoFactorRequest
+	}
+	verifyTwoFactorReturns struct {
+		result1 service.AuthenticateResponse
+		result2 error
+	}
+	verifyTwoFactorReturnsOnCall map[int]struct {
+		result1 service.AuthenticateResponse
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeUserService) AssignRole(arg1 context.Context, arg2 uuid.UUID, arg3 service.AssignRoleRequest) (service.GetUserResponse, error) {
+	fake.assignRoleMutex.Lock()
+	ret, specificReturn := fake.assignRoleReturnsOnCall[len(fake.assignRoleArgsForCall)]
+	fake.assignRoleArgsForCall = append(fake.assignRoleArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 service.AssignRoleRequest
+	}{arg1, arg2, arg3})
+	stub := fake.AssignRoleStub
+	fakeReturns := fake.assignRoleReturns
+	fake.recordInvocation("AssignRole", []interface{}{arg1, arg2, arg3})
+	fake.assignRoleMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeUserService) AssignRoleCallCount() int {
+	fake.assignRoleMutex.RLock()
+	defer fake.assignRoleMutex.RUnlock()
+	return len(fake.assignRoleArgsForCall)
+}
+
+func (fake *FakeUserService) AssignRoleCalls(stub func(context.Context, uuid.UUID, service.AssignRoleRequest) (service.GetUserResponse, error)) {
+	fake.assignRoleMutex.Lock()
+	defer fake.assignRoleMutex.Unlock()
+	fake.AssignRoleStub = stub
+}
+
+func (fake *FakeUserService) AssignRoleArgsForCall(i int) (context.Context, uuid.UUID, service.AssignRoleRequest) {
+	fake.assignRoleMutex.RLock()
+	defer fake.assignRoleMutex.RUnlock()
+	argsForCall := fake.assignRoleArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeUserService) AssignRoleReturns(result1 service.GetUserResponse, result2 error) {
+	fake.assignRoleMutex.Lock()
+	defer fake.assignRoleMutex.Unlock()
+	fake.AssignRoleStub = nil
+	fake.assignRoleReturns = struct {
+		result1 service.GetUserResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUserService) AssignRoleReturnsOnCall(i int, result1 service.GetUserResponse, result2 error) {
+	fake.assignRoleMutex.Lock()
+	defer fake.assignRoleMutex.Unlock()
+	fake.AssignRoleStub = nil
+	if fake.assignRoleReturnsOnCall == nil {
+		fake.assignRoleReturnsOnCall = make(map[int]struct {
+			result1 service.GetUserResponse
+			result2 error
+		})
+	}
+	fake.assignRoleReturnsOnCall[i] = struct {
+		result1 service.GetUserResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUserService) Authenticate(arg1 context.Context, arg2 service.AuthenticateRequest) (service.AuthenticateResponse, error) {
+	fake.authenticateMutex.Lock()
+	ret, specificReturn := fake.authenticateReturnsOnCall[len(fake.authenticateArgsForCall)]
+	fake.authenticateArgsForCall = append(fake.authenticateArgsForCall, struct {
+		arg1 context.Context
+		arg2 service.AuthenticateRequest
+	}{arg1, arg2})
+	stub := fake.AuthenticateStub
+	fakeReturns := fake.authenticateReturns
+	fake.recordInvocation("Authenticate", []interface{}{arg1, arg2})
+	fake.authenticateMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeUserService) AuthenticateCallCount() int {
+	fake.authenticateMutex.RLock()
+	defer fake.authenticateMutex.RUnlock()
+	return len(fake.authenticateArgsForCall)
+}
+
+func (fake *FakeUserService) AuthenticateCalls(stub func(context.Context, service.AuthenticateRequest) (service.AuthenticateResponse, error)) {
+	fake.authenticateMutex.Lock()
+	defer fake.authenticateMutex.Unlock()
+	fake.AuthenticateStub = stub
+}
+
+func (fake *FakeUserService) AuthenticateArgsForCall(i int) (context.Context, service.AuthenticateRequest) {
+	fake.authenticateMutex.RLock()
+	defer fake.authenticateMutex.RUnlock()
+	argsForCall := fake.authenticateArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeUserService) AuthenticateReturns(result1 service.AuthenticateResponse, result2 error) {
+	fake.authenticateMutex.Lock()
+	defer fake.authenticateMutex.Unlock()
+	fake.AuthenticateStub = nil
+	fake.authenticateReturns = struct {
+		result1 service.AuthenticateResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUserService) AuthenticateReturnsOnCall(i int, result1 service.AuthenticateResponse, result2 error) {
+	fake.authenticateMutex.Lock()
+	defer fake.authenticateMutex.Unlock()
+	fake.AuthenticateStub = nil
+	if fake.authenticateReturnsOnCall == nil {
+		fake.authenticateReturnsOnCall = make(map[int]struct {
+			result1 service.AuthenticateResponse
+			result2 error
+		})
+	}
+	fake.authenticateReturnsOnCall[i] = struct {
+		result1 service.AuthenticateResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUserService) AuthenticateBearer(arg1 context.Context, arg2 string) (http_server.Principal, error) {
+	fake.authenticateBearerMutex.Lock()
+	ret, specificReturn := fake.authenticateBearerReturnsOnCall[len(fake.authenticateBearerArgsForCall)]
+	fake.authenticateBearerArgsForCall = append(fake.authenticateBearerArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.AuthenticateBearerStub
+	fakeReturns := fake.authenticateBearerReturns
+	fake.recordInvocation("AuthenticateBearer", []interface{}{arg1, arg2})
+	fake.authenticateBearerMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeUserService) AuthenticateBearerCallCount() int {
+	fake.authenticateBearerMutex.RLock()
+	defer fake.authenticateBearerMutex.RUnlock()
+	return len(fake.authenticateBearerArgsForCall)
+}
+
+func (fake *FakeUserService) AuthenticateBearerCalls(stub func(context.Context, string) (http_server.Principal, error)) {
+	fake.authenticateBearerMutex.Lock()
+	defer fake.authenticateBearerMutex.Unlock()
+	fake.AuthenticateBearerStub = stub
+}
+
+func (fake *FakeUserService) AuthenticateBearerArgsForCall(i int) (context.Context, string) {
+	fake.authenticateBearerMutex.RLock()
+	defer fake.authenticateBearerMutex.RUnlock()
+	argsForCall := fake.authenticateBearerArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeUserService) AuthenticateBearerReturns(result1 http_server.Principal, result2 error) {
+	fake.authenticateBearerMutex.Lock()
+	defer fake.authenticateBearerMutex.Unlock()
+	fake.AuthenticateBearerStub = nil
+	fake.authenticateBearerReturns = struct {
+		result1 http_server.Principal
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUserService) AuthenticateBearerReturnsOnCall(i int, result1 http_server.Principal, result2 error) {
+	fake.authenticateBearerMutex.Lock()
+	defer fake.authenticateBearerMutex.Unlock()
+	fake.AuthenticateBearerStub = nil
+	if fake.authenticateBearerReturnsOnCall == nil {
+		fake.authenticateBearerReturnsOnCall = make(map[int]struct {
+			result1 http_server.Principal
+			result2 error
+		})
+	}
+	fake.authenticateBearerReturnsOnCall[i] = struct {
+		result1 http_server.Principal
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUserService) BatchUserOperations(arg1 context.Context, arg2 service.BatchUserOperationsRequest) (service.BatchUserOperationsResponse, error) {
+	fake.batchUserOperationsMutex.Lock()
+	ret, specificReturn := fake.batchUserOperationsReturnsOnCall[len(fake.batchUserOperationsArgsForCall)]
+	fake.batchUserOperationsArgsForCall = append(fake.batchUserOperationsArgsForCall, struct {
+		arg1 context.Context
+		arg2 service.BatchUserOperationsRequest
+	}{arg1, arg2})
+	stub := fake.BatchUserOperationsStub
+	fakeReturns := fake.batchUserOperationsReturns
+	fake.recordInvocation("BatchUserOperations", []interface{}{arg1, arg2})
+	fake.batchUserOperationsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeUserService) BatchUserOperationsCallCount() int {
+	fake.batchUserOperationsMutex.RLock()
+	defer fake.batchUserOperationsMutex.RUnlock()
+	return len(fake.batchUserOperationsArgsForCall)
+}
+
+func (fake *FakeUserService) BatchUserOperationsCalls(stub func(context.Context, service.BatchUserOperationsRequest) (service.BatchUserOperationsResponse, error)) {
+	fake.batchUserOperationsMutex.Lock()
+	defer fake.batchUserOperationsMutex.Unlock()
+	fake.BatchUserOperationsStub = stub
+}
+
+func (fake *FakeUserService) BatchUserOperationsArgsForCall(i int) (context.Context, service.BatchUserOperationsRequest) {
+	fake.batchUserOperationsMutex.RLock()
+	defer fake.batchUserOperationsMutex.RUnlock()
+	argsForCall := fake.batchUserOperationsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeUserService) BatchUserOperationsReturns(result1 service.BatchUserOperationsResponse, result2 error) {
+	fake.batchUserOperationsMutex.Lock()
+	defer fake.batchUserOperationsMutex.Unlock()
+	fake.BatchUserOperationsStub = nil
+	fake.batchUserOperationsReturns = struct {
+		result1 service.BatchUserOperationsResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUserService) BatchUserOperationsReturnsOnCall(i int, result1 service.BatchUserOperationsResponse, result2 error) {
+	fake.batchUserOperationsMutex.Lock()
+	defer fake.batchUserOperationsMutex.Unlock()
+	fake.BatchUserOperationsStub = nil
+	if fake.batchUserOperationsReturnsOnCall == nil {
+		fake.batchUserOperationsReturnsOnCall = make(map[int]struct {
+			result1 service.BatchUserOperationsResponse
+			result2 error
+		})
+	}
+	fake.batchUserOperationsReturnsOnCall[i] = struct {
+		result1 service.BatchUserOperationsResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUserService) ChangePassword(arg1 context.Context, arg2 uuid.UUID, arg3 service.ChangePasswordRequest) error {
+	fake.changePasswordMutex.Lock()
+	ret, specificReturn := fake.changePasswordReturnsOnCall[len(fake.changePasswordArgsForCall)]
+	fake.changePasswordArgsForCall = append(fake.changePasswordArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 service.ChangePasswordRequest
+	}{arg1, arg2, arg3})
+	stub := fake.ChangePasswordStub
+	fakeReturns := fake.changePasswordReturns
+	fake.recordInvocation("ChangePassword", []interface{}{arg1, arg2, arg3})
+	fake.changePasswordMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeUserService) ChangePasswordCallCount() int {
+	fake.changePasswordMutex.RLock()
+	defer fake.changePasswordMutex.RUnlock()
+	return len(fake.changePasswordArgsForCall)
+}
+
+func (fake *FakeUserService) ChangePasswordCalls(stub func(context.Context, uuid.UUID, service.ChangePasswordRequest) error) {
+	fake.changePasswordMutex.Lock()
+	defer fake.changePasswordMutex.Unlock()
+	fake.ChangePasswordStub = stub
+}
+
+func (fake *FakeUserService) ChangePasswordArgsForCall(i int) (context.Context, uuid.UUID, service.ChangePasswordRequest) {
+	fake.changePasswordMutex.RLock()
+	defer fake.changePasswordMutex.RUnlock()
+	argsForCall := fake.changePasswordArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeUserService) ChangePasswordReturns(result1 error) {
+	fake.changePasswordMutex.Lock()
+	defer fake.changePasswordMutex.Unlock()
+	fake.ChangePasswordStub = nil
+	fake.changePasswordReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeUserService) ChangePasswordReturnsOnCall(i int, result1 error) {
+	fake.changePasswordMutex.Lock()
+	defer fake.changePasswordMutex.Unlock()
+	fake.ChangePasswordStub = nil
+	if fake.changePasswordReturnsOnCall == nil {
+		fake.changePasswordReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.changePasswordReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeUserService) ConfirmTwoFactor(arg1 context.Context, arg2 uuid.UUID, arg3 service.ConfirmTwoFactorRequest) (service.ConfirmTwoFactorResponse, error) {
+	fake.confirmTwoFactorMutex.Lock()
+	ret, specificReturn := fake.confirmTwoFactorReturnsOnCall[len(fake.confirmTwoFactorArgsForCall)]
+	fake.confirmTwoFactorArgsForCall = append(fake.confirmTwoFactorArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 service.ConfirmTwoFactorRequest
+	}{arg1, arg2, arg3})
+	stub := fake.ConfirmTwoFactorStub
+	fakeReturns := fake.confirmTwoFactorReturns
+	fake.recordInvocation("ConfirmTwoFactor", []interface{}{arg1, arg2, arg3})
+	fake.confirmTwoFactorMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeUserService) ConfirmTwoFactorCallCount() int {
+	fake.confirmTwoFactorMutex.RLock()
+	defer fake.confirmTwoFactorMutex.RUnlock()
+	return len(fake.confirmTwoFactorArgsForCall)
+}
+
+func (fake *FakeUserService) ConfirmTwoFactorCalls(stub func(context.Context, uuid.UUID, service.ConfirmTwoFactorRequest) (service.ConfirmTwoFactorResponse, error)) {
+	fake.confirmTwoFactorMutex.Lock()
+	defer fake.confirmTwoFactorMutex.Unlock()
+	fake.ConfirmTwoFactorStub = stub
+}
+
+func (fake *FakeUserService) ConfirmTwoFactorArgsForCall(i int) (context.Context, uuid.UUID, service.ConfirmTwoFactorRequest) {
+	fake.confirmTwoFactorMutex.RLock()
+	defer fake.confirmTwoFactorMutex.RUnlock()
+	argsForCall := fake.confirmTwoFactorArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeUserService) ConfirmTwoFactorReturns(result1 service.ConfirmTwoFactorResponse, result2 error) {
+	fake.confirmTwoFactorMutex.Lock()
+	defer fake.confirmTwoFactorMutex.Unlock()
+	fake.ConfirmTwoFactorStub = nil
+	fake.confirmTwoFactorReturns = struct {
+		result1 service.ConfirmTwoFactorResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUserService) ConfirmTwoFactorReturnsOnCall(i int, result1 service.ConfirmTwoFactorResponse, result2 error) {
+	fake.confirmTwoFactorMutex.Lock()
+	defer fake.confirmTwoFactorMutex.Unlock()
+	fake.ConfirmTwoFactorStub = nil
+	if fake.confirmTwoFactorReturnsOnCall == nil {
+		fake.confirmTwoFactorReturnsOnCall = make(map[int]struct {
+			result1 service.ConfirmTwoFactorResponse
+			result2 error
+		})
+	}
+	fake.confirmTwoFactorReturnsOnCall[i] = struct {
+		result1 service.ConfirmTwoFactorResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUserService) CreateInvitedUser(arg1 context.Context, arg2 service.CreateInvitedUserRequest) (service.CreateUserResponse, error) {
+	fake.createInvitedUserMutex.Lock()
+	ret, specificReturn := fake.createInvitedUserReturnsOnCall[len(fake.createInvitedUserArgsForCall)]
+	fake.createInvitedUserArgsForCall = append(fake.createInvitedUserArgsForCall, struct {
+		arg1 context.Context
+		arg2 service.CreateInvitedUserRequest
+	}{arg1, arg2})
+	stub := fake.CreateInvitedUserStub
+	fakeReturns := fake.createInvitedUserReturns
+	fake.recordInvocation("CreateInvitedUser", []interface{}{arg1, arg2})
+	fake.createInvitedUserMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeUserService) CreateInvitedUserCallCount() int {
+	fake.createInvitedUserMutex.RLock()
+	defer fake.createInvitedUserMutex.RUnlock()
+	return len(fake.createInvitedUserArgsForCall)
+}
+
+func (fake *FakeUserService) CreateInvitedUserCalls(stub func(context.Context, service.CreateInvitedUserRequest) (service.CreateUserResponse, error)) {
+	fake.createInvitedUserMutex.Lock()
+	defer fake.createInvitedUserMutex.Unlock()
+	fake.CreateInvitedUserStub = stub
+}
+
+func (fake *FakeUserService) CreateInvitedUserArgsForCall(i int) (context.Context, service.CreateInvitedUserRequest) {
+	fake.createInvitedUserMutex.RLock()
+	defer fake.createInvitedUserMutex.RUnlock()
+	argsForCall := fake.createInvitedUserArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeUserService) CreateInvitedUserReturns(result1 service.CreateUserResponse, result2 error) {
+	fake.createInvitedUserMutex.Lock()
+	defer fake.createInvitedUserMutex.Unlock()
+	fake.CreateInvitedUserStub = nil
+	fake.createInvitedUserReturns = struct {
+		result1 service.CreateUserResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUserService) CreateInvitedUserReturnsOnCall(i int, result1 service.CreateUserResponse, result2 error) {
+	fake.createInvitedUserMutex.Lock()
+	defer fake.createInvitedUserMutex.Unlock()
+	fake.CreateInvitedUserStub = nil
+	if fake.createInvitedUserReturnsOnCall == nil {
+		fake.createInvitedUserReturnsOnCall = make(map[int]struct {
+			result1 service.CreateUserResponse
+			result2 error
+		})
+	}
+	fake.createInvitedUserReturnsOnCall[i] = struct {
+		result1 service.CreateUserResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUserService) CreatePersonalAccessToken(arg1 context.Context, arg2 uuid.UUID, arg3 service.CreatePersonalAccessTokenRequest) (service.CreatePersonalAccessTokenResponse, error) {
+	fake.createPersonalAccessTokenMutex.Lock()
+	ret, specificReturn := fake.createPersonalAccessTokenReturnsOnCall[len(fake.createPersonalAccessTokenArgsForCall)]
+	fake.createPersonalAccessTokenArgsForCall = append(fake.createPersonalAccessTokenArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 service.CreatePersonalAccessTokenRequest
+	}{arg1, arg2, arg3})
+	stub := fake.CreatePersonalAccessTokenStub
+	fakeReturns := fake.createPersonalAccessTokenReturns
+	fake.recordInvocation("CreatePersonalAccessToken", []interface{}{arg1, arg2, arg3})
+	fake.createPersonalAccessTokenMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeUserService) CreatePersonalAccessTokenCallCount() int {
+	fake.createPersonalAccessTokenMutex.RLock()
+	defer fake.createPersonalAccessTokenMutex.RUnlock()
+	return len(fake.createPersonalAccessTokenArgsForCall)
+}
+
+func (fake *FakeUserService) CreatePersonalAccessTokenCalls(stub func(context.Context, uuid.UUID, service.CreatePersonalAccessTokenRequest) (service.CreatePersonalAccessTokenResponse, error)) {
+	fake.createPersonalAccessTokenMutex.Lock()
+	defer fake.createPersonalAccessTokenMutex.Unlock()
+	fake.CreatePersonalAccessTokenStub = stub
+}
+
+func (fake *FakeUserService) CreatePersonalAccessTokenArgsForCall(i int) (context.Context, uuid.UUID, service.CreatePersonalAccessTokenRequest) {
+	fake.createPersonalAccessTokenMutex.RLock()
+	defer fake.createPersonalAccessTokenMutex.RUnlock()
+	argsForCall := fake.createPersonalAccessTokenArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeUserService) CreatePersonalAccessTokenReturns(result1 service.CreatePersonalAccessTokenResponse, result2 error) {
+	fake.createPersonalAccessTokenMutex.Lock()
+	defer fake.createPersonalAccessTokenMutex.Unlock()
+	fake.CreatePersonalAccessTokenStub = nil
+	fake.createPersonalAccessTokenReturns = struct {
+		result1 service.CreatePersonalAccessTokenResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUserService) CreatePersonalAccessTokenReturnsOnCall(i int, result1 service.CreatePersonalAccessTokenResponse, result2 error) {
+	fake.createPersonalAccessTokenMutex.Lock()
+	defer fake.createPersonalAccessTokenMutex.Unlock()
+	fake.CreatePersonalAccessTokenStub = nil
+	if fake.createPersonalAccessTokenReturnsOnCall == nil {
+		fake.createPersonalAccessTokenReturnsOnCall = make(map[int]struct {
+			result1 service.CreatePersonalAccessTokenResponse
+			result2 error
+		})
+	}
+	fake.createPersonalAccessTokenReturnsOnCall[i] = struct {
+		result1 service.CreatePersonalAccessTokenResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUserService) CreateUser(arg1 context.Context, arg2 service.CreateUserRequest) (service.CreateUserResponse, error) {
+	fake.createUserMutex.Lock()
+	ret, specificReturn := fake.createUserReturnsOnCall[len(fake.createUserArgsForCall)]
+	fake.createUserArgsForCall = append(fake.createUserArgsForCall, struct {
+		arg1 context.Context
+		arg2 service.CreateUserRequest
+	}{arg1, arg2})
+	stub := fake.CreateUserStub
+	fakeReturns := fake.createUserReturns
+	fake.recordInvocation("CreateUser", []interface{}{arg1, arg2})
+	fake.createUserMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeUserService) CreateUserCallCount() int {
+	fake.createUserMutex.RLock()
+	defer fake.createUserMutex.RUnlock()
+	return len(fake.createUserArgsForCall)
+}
+
+func (fake *FakeUserService) CreateUserCalls(stub func(context.Context, service.CreateUserRequest) (service.CreateUserResponse, error)) {
+	fake.createUserMutex.Lock()
+	defer fake.createUserMutex.Unlock()
+	fake.CreateUserStub = stub
+}
+
+func (fake *FakeUserService) CreateUserArgsForCall(i int) (context.Context, service.CreateUserRequest) {
+	fake.createUserMutex.RLock()
+	defer fake.createUserMutex.RUnlock()
+	argsForCall := fake.createUserArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeUserService) CreateUserReturns(result1 service.CreateUserResponse, result2 error) {
+	fake.createUserMutex.Lock()
+	defer fake.createUserMutex.Unlock()
+	fake.CreateUserStub = nil
+	fake.createUserReturns = struct {
+		result1 service.CreateUserResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUserService) CreateUserReturnsOnCall(i int, result1 service.CreateUserResponse, result2 error) {
+	fake.createUserMutex.Lock()
+	defer fake.createUserMutex.Unlock()
+	fake.CreateUserStub = nil
+	if fake.createUserReturnsOnCall == nil {
+		fake.createUserReturnsOnCall = make(map[int]struct {
+			result1 service.CreateUserResponse
+			result2 error
+		})
+	}
+	fake.createUserReturnsOnCall[i] = struct {
+		result1 service.CreateUserResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUserService) DefaultBlogStatus(arg1 context.Context, arg2 uuid.UUID) (string, error) {
+	fake.defaultBlogStatusMutex.Lock()
+	ret, specificReturn := fake.defaultBlogStatusReturnsOnCall[len(fake.defaultBlogStatusArgsForCall)]
+	fake.defaultBlogStatusArgsForCall = append(fake.defaultBlogStatusArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}{arg1, arg2})
+	stub := fake.DefaultBlogStatusStub
+	fakeReturns := fake.defaultBlogStatusReturns
+	fake.recordInvocation("DefaultBlogStatus", []interface{}{arg1, arg2})
+	fake.defaultBlogStatusMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeUserService) DefaultBlogStatusCallCount() int {
+	fake.defaultBlogStatusMutex.RLock()
+	defer fake.defaultBlogStatusMutex.RUnlock()
+	return len(fake.defaultBlogStatusArgsForCall)
+}
+
+func (fake *FakeUserService) DefaultBlogStatusCalls(stub func(context.Context, uuid.UUID) (string, error)) {
+	fake.defaultBlogStatusMutex.Lock()
+	defer fake.defaultBlogStatusMutex.Unlock()
+	fake.DefaultBlogStatusStub = stub
+}
+
+func (fake *FakeUserService) DefaultBlogStatusArgsForCall(i int) (context.Context, uuid.UUID) {
+	fake.defaultBlogStatusMutex.RLock()
+	defer fake.defaultBlogStatusMutex.RUnlock()
+	argsForCall := fake.defaultBlogStatusArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeUserService) DefaultBlogStatusReturns(result1 string, result2 error) {
+	fake.defaultBlogStatusMutex.Lock()
+	defer fake.defaultBlogStatusMutex.Unlock()
+	fake.DefaultBlogStatusStub = nil
+	fake.defaultBlogStatusReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUserService) DefaultBlogStatusReturnsOnCall(i int, result1 string, result2 error) {
+	fake.defaultBlogStatusMutex.Lock()
+	defer fake.defaultBlogStatusMutex.Unlock()
+	fake.DefaultBlogStatusStub = nil
+	if fake.defaultBlogStatusReturnsOnCall == nil {
+		fake.defaultBlogStatusReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 error
+		})
+	}
+	fake.defaultBlogStatusReturnsOnCall[i] = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUserService) DeleteUser(arg1 context.Context, arg2 uuid.UUID) error {
+	fake.deleteUserMutex.Lock()
+	ret, specificReturn := fake.deleteUserReturnsOnCall[len(fake.deleteUserArgsForCall)]
+	fake.deleteUserArgsForCall = append(fake.deleteUserArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}{arg1, arg2})
+	stub := fake.DeleteUserStub
+	fakeReturns := fake.deleteUserReturns
+	fake.recordInvocation("DeleteUser", []interface{}{arg1, arg2})
+	fake.deleteUserMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeUserService) DeleteUserCallCount() int {
+	fake.deleteUserMutex.RLock()
+	defer fake.deleteUserMutex.RUnlock()
+	return len(fake.deleteUserArgsForCall)
+}
+
+func (fake *FakeUserService) DeleteUserCalls(stub func(context.Context, uuid.UUID) error) {
+	fake.deleteUserMutex.Lock()
+	defer fake.deleteUserMutex.Unlock()
+	fake.DeleteUserStub = stub
+}
+
+func (fake *FakeUserService) DeleteUserArgsForCall(i int) (context.Context, uuid.UUID) {
+	fake.deleteUserMutex.RLock()
+	defer fake.deleteUserMutex.RUnlock()
+	argsForCall := fake.deleteUserArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeUserService) DeleteUserReturns(result1 error) {
+	fake.deleteUserMutex.Lock()
+	defer fake.deleteUserMutex.Unlock()
+	fake.DeleteUserStub = nil
+	fake.deleteUserReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeUserService) DeleteUserReturnsOnCall(i int, result1 error) {
+	fake.deleteUserMutex.Lock()
+	defer fake.deleteUserMutex.Unlock()
+	fake.DeleteUserStub = nil
+	if fake.deleteUserReturnsOnCall == nil {
+		fake.deleteUserReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteUserReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeUserService) DisableTwoFactor(arg1 context.Context, arg2 uuid.UUID, arg3 service.DisableTwoFactorRequest) error {
+	fake.disableTwoFactorMutex.Lock()
+	ret, specificReturn := fake.disableTwoFactorReturnsOnCall[len(fake.disableTwoFactorArgsForCall)]
+	fake.disableTwoFactorArgsForCall = append(fake.disableTwoFactorArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 service.DisableTwoFactorRequest
+	}{arg1, arg2, arg3})
+	stub := fake.DisableTwoFactorStub
+	fakeReturns := fake.disableTwoFactorReturns
+	fake.recordInvocation("DisableTwoFactor", []interface{}{arg1, arg2, arg3})
+	fake.disableTwoFactorMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeUserService) DisableTwoFactorCallCount() int {
+	fake.disableTwoFactorMutex.RLock()
+	defer fake.disableTwoFactorMutex.RUnlock()
+	return len(fake.disableTwoFactorArgsForCall)
+}
+
+func (fake *FakeUserService) DisableTwoFactorCalls(stub func(context.Context, uuid.UUID, service.DisableTwoFactorRequest) error) {
+	fake.disableTwoFactorMutex.Lock()
+	defer fake.disableTwoFactorMutex.Unlock()
+	fake.DisableTwoFactorStub = stub
+}
+
+func (fake *FakeUserService) DisableTwoFactorArgsForCall(i int) (context.Context, uuid.UUID, service.DisableTwoFactorRequest) {
+	fake.disableTwoFactorMutex.RLock()
+	defer fake.disableTwoFactorMutex.RUnlock()
+	argsForCall := fake.disableTwoFactorArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeUserService) DisableTwoFactorReturns(result1 error) {
+	fake.disableTwoFactorMutex.Lock()
+	defer fake.disableTwoFactorMutex.Unlock()
+	fake.DisableTwoFactorStub = nil
+	fake.disableTwoFactorReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeUserService) DisableTwoFactorReturnsOnCall(i int, result1 error) {
+	fake.disableTwoFactorMutex.Lock()
+	defer fake.disableTwoFactorMutex.Unlock()
+	fake.DisableTwoFactorStub = nil
+	if fake.disableTwoFactorReturnsOnCall == nil {
+		fake.disableTwoFactorReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.disableTwoFactorReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeUserService) EnrollTwoFactor(arg1 context.Context, arg2 uuid.UUID) (service.EnrollTwoFactorResponse, error) {
+	fake.enrollTwoFactorMutex.Lock()
+	ret, specificReturn := fake.enrollTwoFactorReturnsOnCall[len(fake.enrollTwoFactorArgsForCall)]
+	fake.enrollTwoFactorArgsForCall = append(fake.enrollTwoFactorArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}{arg1, arg2})
+	stub := fake.EnrollTwoFactorStub
+	fakeReturns := fake.enrollTwoFactorReturns
+	fake.recordInvocation("EnrollTwoFactor", []interface{}{arg1, arg2})
+	fake.enrollTwoFactorMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeUserService) EnrollTwoFactorCallCount() int {
+	fake.enrollTwoFactorMutex.RLock()
+	defer fake.enrollTwoFactorMutex.RUnlock()
+	return len(fake.enrollTwoFactorArgsForCall)
+}
+
+func (fake *FakeUserService) EnrollTwoFactorCalls(stub func(context.Context, uuid.UUID) (service.EnrollTwoFactorResponse, error)) {
+	fake.enrollTwoFactorMutex.Lock()
+	defer fake.enrollTwoFactorMutex.Unlock()
+	fake.EnrollTwoFactorStub = stub
+}
+
+func (fake *FakeUserService) EnrollTwoFactorArgsForCall(i int) (context.Context, uuid.UUID) {
+	fake.enrollTwoFactorMutex.RLock()
+	defer fake.enrollTwoFactorMutex.RUnlock()
+	argsForCall := fake.enrollTwoFactorArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeUserService) EnrollTwoFactorReturns(result1 service.EnrollTwoFactorResponse, result2 error) {
+	fake.enrollTwoFactorMutex.Lock()
+	defer fake.enrollTwoFactorMutex.Unlock()
+	fake.EnrollTwoFactorStub = nil
+	fake.enrollTwoFactorReturns = struct {
+		result1 service.EnrollTwoFactorResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUserService) EnrollTwoFactorReturnsOnCall(i int, result1 service.EnrollTwoFactorResponse, result2 error) {
+	fake.enrollTwoFactorMutex.Lock()
+	defer fake.enrollTwoFactorMutex.Unlock()
+	fake.EnrollTwoFactorStub = nil
+	if fake.enrollTwoFactorReturnsOnCall == nil {
+		fake.enrollTwoFactorReturnsOnCall = make(map[int]struct {
+			result1 service.EnrollTwoFactorResponse
+			result2 error
+		})
+	}
+	fake.enrollTwoFactorReturnsOnCall[i] = struct {
+		result1 service.EnrollTwoFactorResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUserService) EraseDeletedUsers(arg1 context.Context) (int64, error) {
+	fake.eraseDeletedUsersMutex.Lock()
+	ret, specificReturn := fake.eraseDeletedUsersReturnsOnCall[len(fake.eraseDeletedUsersArgsForCall)]
+	fake.eraseDeletedUsersArgsForCall = append(fake.eraseDeletedUsersArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	stub := fake.EraseDeletedUsersStub
+	fakeReturns := fake.eraseDeletedUsersReturns
+	fake.recordInvocation("EraseDeletedUsers", []interface{}{arg1})
+	fake.eraseDeletedUsersMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeUserService) EraseDeletedUsersCallCount() int {
+	fake.eraseDeletedUsersMutex.RLock()
+	defer fake.eraseDeletedUsersMutex.RUnlock()
+	return len(fake.eraseDeletedUsersArgsForCall)
+}
+
+func (fake *FakeUserService) EraseDeletedUsersCalls(stub func(context.Context) (int64, error)) {
+	fake.eraseDeletedUsersMutex.Lock()
+	defer fake.eraseDeletedUsersMutex.Unlock()
+	fake.EraseDeletedUsersStub = stub
+}
+
+func (fake *FakeUserService) EraseDeletedUsersArgsForCall(i int) context.Context {
+	fake.eraseDeletedUsersMutex.RLock()
+	defer fake.eraseDeletedUsersMutex.RUnlock()
+	argsForCall := fake.eraseDeletedUsersArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeUserService) EraseDeletedUsersReturns(result1 int64, result2 error) {
+	fake.eraseDeletedUsersMutex.Lock()
+	defer fake.eraseDeletedUsersMutex.Unlock()
+	fake.EraseDeletedUsersStub = nil
+	fake.eraseDeletedUsersReturns = struct {
+		result1 int64
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUserService) EraseDeletedUsersReturnsOnCall(i int, result1 int64, result2 error) {
+	fake.eraseDeletedUsersMutex.Lock()
+	defer fake.eraseDeletedUsersMutex.Unlock()
+	fake.EraseDeletedUsersStub = nil
+	if fake.eraseDeletedUsersReturnsOnCall == nil {
+		fake.eraseDeletedUsersReturnsOnCall = make(map[int]struct {
+			result1 int64
+			result2 error
+		})
+	}
+	fake.eraseDeletedUsersReturnsOnCall[i] = struct {
+		result1 int64
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUserService) ExportUser(arg1 context.Context, arg2 uuid.UUID) (service.ExportUserResponse, error) {
+	fake.exportUserMutex.Lock()
+	ret, specificReturn := fake.exportUserReturnsOnCall[len(fake.exportUserArgsForCall)]
+	fake.exportUserArgsForCall = append(fake.exportUserArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}{arg1, arg2})
+	stub := fake.ExportUserStub
+	fakeReturns := fake.exportUserReturns
+	fake.recordInvocation("ExportUser", []interface{}{arg1, arg2})
+	fake.exportUserMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeUserService) ExportUserCallCount() int {
+	fake.exportUserMutex.RLock()
+	defer fake.exportUserMutex.RUnlock()
+	return len(fake.exportUserArgsForCall)
+}
+
+func (fake *FakeUserService) ExportUserCalls(stub func(context.Context, uuid.UUID) (service.ExportUserResponse, error)) {
+	fake.exportUserMutex.Lock()
+	defer fake.exportUserMutex.Unlock()
+	fake.ExportUserStub = stub
+}
+
+func (fake *FakeUserService) ExportUserArgsForCall(i int) (context.Context, uuid.UUID) {
+	fake.exportUserMutex.RLock()
+	defer fake.exportUserMutex.RUnlock()
+	argsForCall := fake.exportUserArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeUserService) ExportUserReturns(result1 service.ExportUserResponse, result2 error) {
+	fake.exportUserMutex.Lock()
+	defer fake.exportUserMutex.Unlock()
+	fake.ExportUserStub = nil
+	fake.exportUserReturns = struct {
+		result1 service.ExportUserResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUserService) ExportUserReturnsOnCall(i int, result1 service.ExportUserResponse, result2 error) {
+	fake.exportUserMutex.Lock()
+	defer fake.exportUserMutex.Unlock()
+	fake.ExportUserStub = nil
+	if fake.exportUserReturnsOnCall == nil {
+		fake.exportUserReturnsOnCall = make(map[int]struct {
+			result1 service.ExportUserResponse
+			result2 error
+		})
+	}
+	fake.exportUserReturnsOnCall[i] = struct {
+		result1 service.ExportUserResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUserService) ForgotPassword(arg1 context.Context, arg2 service.ForgotPasswordRequest) error {
+	fake.forgotPasswordMutex.Lock()
+	ret, specificReturn := fake.forgotPasswordReturnsOnCall[len(fake.forgotPasswordArgsForCall)]
+	fake.forgotPasswordArgsForCall = append(fake.forgotPasswordArgsForCall, struct {
+		arg1 context.Context
+		arg2 service.ForgotPasswordRequest
+	}{arg1, arg2})
+	stub := fake.ForgotPasswordStub
+	fakeReturns := fake.forgotPasswordReturns
+	fake.recordInvocation("ForgotPassword", []interface{}{arg1, arg2})
+	fake.forgotPasswordMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeUserService) ForgotPasswordCallCount() int {
+	fake.forgotPasswordMutex.RLock()
+	defer fake.forgotPasswordMutex.RUnlock()
+	return len(fake.forgotPasswordArgsForCall)
+}
+
+func (fake *FakeUserService) ForgotPasswordCalls(stub func(context.Context, service.ForgotPasswordRequest) error) {
+	fake.forgotPasswordMutex.Lock()
+	defer fake.forgotPasswordMutex.Unlock()
+	fake.ForgotPasswordStub = stub
+}
+
+func (fake *FakeUserService) ForgotPasswordArgsForCall(i int) (context.Context, service.ForgotPasswordRequest) {
+	fake.forgotPasswordMutex.RLock()
+	defer fake.forgotPasswordMutex.RUnlock()
+	argsForCall := fake.forgotPasswordArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeUserService) ForgotPasswordReturns(result1 error) {
+	fake.forgotPasswordMutex.Lock()
+	defer fake.forgotPasswordMutex.Unlock()
+	fake.ForgotPasswordStub = nil
+	fake.forgotPasswordReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeUserService) ForgotPasswordReturnsOnCall(i int, result1 error) {
+	fake.forgotPasswordMutex.Lock()
+	defer fake.forgotPasswordMutex.Unlock()
+	fake.ForgotPasswordStub = nil
+	if fake.forgotPasswordReturnsOnCall == nil {
+		fake.forgotPasswordReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.forgotPasswordReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeUserService) GetAuthor(arg1 context.Context, arg2 string) (service.AuthorResponse, error) {
+	fake.getAuthorMutex.Lock()
+	ret, specificReturn := fake.getAuthorReturnsOnCall[len(fake.getAuthorArgsForCall)]
+	fake.getAuthorArgsForCall = append(fake.getAuthorArgsForCall, struct {
+		arg1 context.Context
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.GetAuthorStub
+	fakeReturns := fake.getAuthorReturns
+	fake.recordInvocation("GetAuthor", []interface{}{arg1, arg2})
+	fake.getAuthorMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeUserService) GetAuthorCallCount() int {
+	fake.getAuthorMutex.RLock()
+	defer fake.getAuthorMutex.RUnlock()
+	return len(fake.getAuthorArgsForCall)
+}
+
+func (fake *FakeUserService) GetAuthorCalls(stub func(context.Context, string) (service.AuthorResponse, error)) {
+	fake.getAuthorMutex.Lock()
+	defer fake.getAuthorMutex.Unlock()
+	fake.GetAuthorStub = stub
+}
+
+func (fake *FakeUserService) GetAuthorArgsForCall(i int) (context.Context, string) {
+	fake.getAuthorMutex.RLock()
+	defer fake.getAuthorMutex.RUnlock()
+	argsForCall := fake.getAuthorArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeUserService) GetAuthorReturns(result1 service.AuthorResponse, result2 error) {
+	fake.getAuthorMutex.Lock()
+	defer fake.getAuthorMutex.Unlock()
+	fake.GetAuthorStub = nil
+	fake.getAuthorReturns = struct {
+		result1 service.AuthorResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUserService) GetAuthorReturnsOnCall(i int, result1 service.AuthorResponse, result2 error) {
+	fake.getAuthorMutex.Lock()
+	defer fake.getAuthorMutex.Unlock()
+	fake.GetAuthorStub = nil
+	if fake.getAuthorReturnsOnCall == nil {
+		fake.getAuthorReturnsOnCall = make(map[int]struct {
+			result1 service.AuthorResponse
+			result2 error
+		})
+	}
+	fake.getAuthorReturnsOnCall[i] = struct {
+		result1 service.AuthorResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUserService) GetPreferences(arg1 context.Context, arg2 uuid.UUID) (service.PreferencesResponse, error) {
+	fake.getPreferencesMutex.Lock()
+	ret, specificReturn := fake.getPreferencesReturnsOnCall[len(fake.getPreferencesArgsForCall)]
+	fake.getPreferencesArgsForCall = append(fake.getPreferencesArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}{arg1, arg2})
+	stub := fake.GetPreferencesStub
+	fakeReturns := fake.getPreferencesReturns
+	fake.recordInvocation("GetPreferences", []interface{}{arg1, arg2})
+	fake.getPreferencesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeUserService) GetPreferencesCallCount() int {
+	fake.getPreferencesMutex.RLock()
+	defer fake.getPreferencesMutex.RUnlock()
+	return len(fake.getPreferencesArgsForCall)
+}
+
+func (fake *FakeUserService) GetPreferencesCalls(stub func(context.Context, uuid.UUID) (service.PreferencesResponse, error)) {
+	fake.getPreferencesMutex.Lock()
+	defer fake.getPreferencesMutex.Unlock()
+	fake.GetPreferencesStub = stub
+}
+
+func (fake *FakeUserService) GetPreferencesArgsForCall(i int) (context.Context, uuid.UUID) {
+	fake.getPreferencesMutex.RLock()
+	defer fake.getPreferencesMutex.RUnlock()
+	argsForCall := fake.getPreferencesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeUserService) GetPreferencesReturns(result1 service.PreferencesResponse, result2 error) {
+	fake.getPreferencesMutex.Lock()
+	defer fake.getPreferencesMutex.Unlock()
+	fake.GetPreferencesStub = nil
+	fake.getPreferencesReturns = struct {
+		result1 service.PreferencesResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUserService) GetPreferencesReturnsOnCall(i int, result1 service.PreferencesResponse, result2 error) {
+	fake.getPreferencesMutex.Lock()
+	defer fake.getPreferencesMutex.Unlock()
+	fake.GetPreferencesStub = nil
+	if fake.getPreferencesReturnsOnCall == nil {
+		fake.getPreferencesReturnsOnCall = make(map[int]struct {
+			result1 service.PreferencesResponse
+			result2 error
+		})
+	}
+	fake.getPreferencesReturnsOnCall[i] = struct {
+		result1 service.PreferencesResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUserService) GetUserByID(arg1 context.Context, arg2 uuid.UUID) (service.GetUserResponse, error) {
+	fake.getUserByIDMutex.Lock()
+	ret, specificReturn := fake.getUserByIDReturnsOnCall[len(fake.getUserByIDArgsForCall)]
+	fake.getUserByIDArgsForCall = append(fake.getUserByIDArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}{arg1, arg2})
+	stub := fake.GetUserByIDStub
+	fakeReturns := fake.getUserByIDReturns
+	fake.recordInvocation("GetUserByID", []interface{}{arg1, arg2})
+	fake.getUserByIDMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeUserService) GetUserByIDCallCount() int {
+	fake.getUserByIDMutex.RLock()
+	defer fake.getUserByIDMutex.RUnlock()
+	return len(fake.getUserByIDArgsForCall)
+}
+
+func (fake *FakeUserService) GetUserByIDCalls(stub func(context.Context, uuid.UUID) (service.GetUserResponse, error)) {
+	fake.getUserByIDMutex.Lock()
+	defer fake.getUserByIDMutex.Unlock()
+	fake.GetUserByIDStub = stub
+}
+
+func (fake *FakeUserService) GetUserByIDArgsForCall(i int) (context.Context, uuid.UUID) {
+	fake.getUserByIDMutex.RLock()
+	defer fake.getUserByIDMutex.RUnlock()
+	argsForCall := fake.getUserByIDArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeUserService) GetUserByIDReturns(result1 service.GetUserResponse, result2 error) {
+	fake.getUserByIDMutex.Lock()
+	defer fake.getUserByIDMutex.Unlock()
+	fake.GetUserByIDStub = nil
+	fake.getUserByIDReturns = struct {
+		result1 service.GetUserResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUserService) GetUserByIDReturnsOnCall(i int, result1 service.GetUserResponse, result2 error) {
+	fake.getUserByIDMutex.Lock()
+	defer fake.getUserByIDMutex.Unlock()
+	fake.GetUserByIDStub = nil
+	if fake.getUserByIDReturnsOnCall == nil {
+		fake.getUserByIDReturnsOnCall = make(map[int]struct {
+			result1 service.GetUserResponse
+			result2 error
+		})
+	}
+	fake.getUserByIDReturnsOnCall[i] = struct {
+		result1 service.GetUserResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUserService) GetUserProfile(arg1 context.Context, arg2 uuid.UUID) (service.UserProfileResponse, error) {
+	fake.getUserProfileMutex.Lock()
+	ret, specificReturn := fake.getUserProfileReturnsOnCall[len(fake.getUserProfileArgsForCall)]
+	fake.getUserProfileArgsForCall = append(fake.getUserProfileArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}{arg1, arg2})
+	stub := fake.GetUserProfileStub
+	fakeReturns := fake.getUserProfileReturns
+	fake.recordInvocation("GetUserProfile", []interface{}{arg1, arg2})
+	fake.getUserProfileMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeUserService) GetUserProfileCallCount() int {
+	fake.getUserProfileMutex.RLock()
+	defer fake.getUserProfileMutex.RUnlock()
+	return len(fake.getUserProfileArgsForCall)
+}
+
+func (fake *FakeUserService) GetUserProfileCalls(stub func(context.Context, uuid.UUID) (service.UserProfileResponse, error)) {
+	fake.getUserProfileMutex.Lock()
+	defer fake.getUserProfileMutex.Unlock()
+	fake.GetUserProfileStub = stub
+}
+
+func (fake *FakeUserService) GetUserProfileArgsForCall(i int) (context.Context, uuid.UUID) {
+	fake.getUserProfileMutex.RLock()
+	defer fake.getUserProfileMutex.RUnlock()
+	argsForCall := fake.getUserProfileArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeUserService) GetUserProfileReturns(result1 service.UserProfileResponse, result2 error) {
+	fake.getUserProfileMutex.Lock()
+	defer fake.getUserProfileMutex.Unlock()
+	fake.GetUserProfileStub = nil
+	fake.getUserProfileReturns = struct {
+		result1 service.UserProfileResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUserService) GetUserProfileReturnsOnCall(i int, result1 service.UserProfileResponse, result2 error) {
+	fake.getUserProfileMutex.Lock()
+	defer fake.getUserProfileMutex.Unlock()
+	fake.GetUserProfileStub = nil
+	if fake.getUserProfileReturnsOnCall == nil {
+		fake.getUserProfileReturnsOnCall = make(map[int]struct {
+			result1 service.UserProfileResponse
+			result2 error
+		})
+	}
+	fake.getUserProfileReturnsOnCall[i] = struct {
+		result1 service.UserProfileResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUserService) IsEmailVerified(arg1 context.Context, arg2 uuid.UUID) (bool, error) {
+	fake.isEmailVerifiedMutex.Lock()
+	ret, specificReturn := fake.isEmailVerifiedReturnsOnCall[len(fake.isEmailVerifiedArgsForCall)]
+	fake.isEmailVerifiedArgsForCall = append(fake.isEmailVerifiedArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}{arg1, arg2})
+	stub := fake.IsEmailVerifiedStub
+	fakeReturns := fake.isEmailVerifiedReturns
+	fake.recordInvocation("IsEmailVerified", []interface{}{arg1, arg2})
+	fake.isEmailVerifiedMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeUserService) IsEmailVerifiedCallCount() int {
+	fake.isEmailVerifiedMutex.RLock()
+	defer fake.isEmailVerifiedMutex.RUnlock()
+	return len(fake.isEmailVerifiedArgsForCall)
+}
+
+func (fake *FakeUserService) IsEmailVerifiedCalls(stub func(context.Context, uuid.UUID) (bool, error)) {
+	fake.isEmailVerifiedMutex.Lock()
+	defer fake.isEmailVerifiedMutex.Unlock()
+	fake.IsEmailVerifiedStub = stub
+}
+
+func (fake *FakeUserService) IsEmailVerifiedArgsForCall(i int) (context.Context, uuid.UUID) {
+	fake.isEmailVerifiedMutex.RLock()
+	defer fake.isEmailVerifiedMutex.RUnlock()
+	argsForCall := fake.isEmailVerifiedArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeUserService) IsEmailVerifiedReturns(result1 bool, result2 error) {
+	fake.isEmailVerifiedMutex.Lock()
+	defer fake.isEmailVerifiedMutex.Unlock()
+	fake.IsEmailVerifiedStub = nil
+	fake.isEmailVerifiedReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUserService) IsEmailVerifiedReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.isEmailVerifiedMutex.Lock()
+	defer fake.isEmailVerifiedMutex.Unlock()
+	fake.IsEmailVerifiedStub = nil
+	if fake.isEmailVerifiedReturnsOnCall == nil {
+		fake.isEmailVerifiedReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.isEmailVerifiedReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUserService) ListPersonalAccessTokens(arg1 context.Context, arg2 uuid.UUID) ([]service.PersonalAccessTokenResponse, error) {
+	fake.listPersonalAccessTokensMutex.Lock()
+	ret, specificReturn := fake.listPersonalAccessTokensReturnsOnCall[len(fake.listPersonalAccessTokensArgsForCall)]
+	fake.listPersonalAccessTokensArgsForCall = append(fake.listPersonalAccessTokensArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}{arg1, arg2})
+	stub := fake.ListPersonalAccessTokensStub
+	fakeReturns := fake.listPersonalAccessTokensReturns
+	fake.recordInvocation("ListPersonalAccessTokens", []interface{}{arg1, arg2})
+	fake.listPersonalAccessTokensMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeUserService) ListPersonalAccessTokensCallCount() int {
+	fake.listPersonalAccessTokensMutex.RLock()
+	defer fake.listPersonalAccessTokensMutex.RUnlock()
+	return len(fake.listPersonalAccessTokensArgsForCall)
+}
+
+func (fake *FakeUserService) ListPersonalAccessTokensCalls(stub func(context.Context, uuid.UUID) ([]service.PersonalAccessTokenResponse, error)) {
+	fake.listPersonalAccessTokensMutex.Lock()
+	defer fake.listPersonalAccessTokensMutex.Unlock()
+	fake.ListPersonalAccessTokensStub = stub
+}
+
+func (fake *FakeUserService) ListPersonalAccessTokensArgsForCall(i int) (context.Context, uuid.UUID) {
+	fake.listPersonalAccessTokensMutex.RLock()
+	defer fake.listPersonalAccessTokensMutex.RUnlock()
+	argsForCall := fake.listPersonalAccessTokensArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeUserService) ListPersonalAccessTokensReturns(result1 []service.PersonalAccessTokenResponse, result2 error) {
+	fake.listPersonalAccessTokensMutex.Lock()
+	defer fake.listPersonalAccessTokensMutex.Unlock()
+	fake.ListPersonalAccessTokensStub = nil
+	fake.listPersonalAccessTokensReturns = struct {
+		result1 []service.PersonalAccessTokenResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUserService) ListPersonalAccessTokensReturnsOnCall(i int, result1 []service.PersonalAccessTokenResponse, result2 error) {
+	fake.listPersonalAccessTokensMutex.Lock()
+	defer fake.listPersonalAccessTokensMutex.Unlock()
+	fake.ListPersonalAccessTokensStub = nil
+	if fake.listPersonalAccessTokensReturnsOnCall == nil {
+		fake.listPersonalAccessTokensReturnsOnCall = make(map[int]struct {
+			result1 []service.PersonalAccessTokenResponse
+			result2 error
+		})
+	}
+	fake.listPersonalAccessTokensReturnsOnCall[i] = struct {
+		result1 []service.PersonalAccessTokenResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUserService) ListRoles(arg1 context.Context) []service.RoleResponse {
+	fake.listRolesMutex.Lock()
+	ret, specificReturn := fake.listRolesReturnsOnCall[len(fake.listRolesArgsForCall)]
+	fake.listRolesArgsForCall = append(fake.listRolesArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	stub := fake.ListRolesStub
+	fakeReturns := fake.listRolesReturns
+	fake.recordInvocation("ListRoles", []interface{}{arg1})
+	fake.listRolesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeUserService) ListRolesCallCount() int {
+	fake.listRolesMutex.RLock()
+	defer fake.listRolesMutex.RUnlock()
+	return len(fake.listRolesArgsForCall)
+}
+
+func (fake *FakeUserService) ListRolesCalls(stub func(context.Context) []service.RoleResponse) {
+	fake.listRolesMutex.Lock()
+	defer fake.listRolesMutex.Unlock()
+	fake.ListRolesStub = stub
+}
+
+func (fake *FakeUserService) ListRolesArgsForCall(i int) context.Context {
+	fake.listRolesMutex.RLock()
+	defer fake.listRolesMutex.RUnlock()
+	argsForCall := fake.listRolesArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeUserService) ListRolesReturns(result1 []service.RoleResponse) {
+	fake.listRolesMutex.Lock()
+	defer fake.listRolesMutex.Unlock()
+	fake.ListRolesStub = nil
+	fake.listRolesReturns = struct {
+		result1 []service.RoleResponse
+	}{result1}
+}
+
+func (fake *FakeUserService) ListRolesReturnsOnCall(i int, result1 []service.RoleResponse) {
+	fake.listRolesMutex.Lock()
+	defer fake.listRolesMutex.Unlock()
+	fake.ListRolesStub = nil
+	if fake.listRolesReturnsOnCall == nil {
+		fake.listRolesReturnsOnCall = make(map[int]struct {
+			result1 []service.RoleResponse
+		})
+	}
+	fake.listRolesReturnsOnCall[i] = struct {
+		result1 []service.RoleResponse
+	}{result1}
+}
+
+func (fake *FakeUserService) ListSessions(arg1 context.Context, arg2 uuid.UUID) ([]service.SessionResponse, error) {
+	fake.listSessionsMutex.Lock()
+	ret, specificReturn := fake.listSessionsReturnsOnCall[len(fake.listSessionsArgsForCall)]
+	fake.listSessionsArgsForCall = append(fake.listSessionsArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}{arg1, arg2})
+	stub := fake.ListSessionsStub
+	fakeReturns := fake.listSessionsReturns
+	fake.recordInvocation("ListSessions", []interface{}{arg1, arg2})
+	fake.listSessionsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeUserService) ListSessionsCallCount() int {
+	fake.listSessionsMutex.RLock()
+	defer fake.listSessionsMutex.RUnlock()
+	return len(fake.listSessionsArgsForCall)
+}
+
+func (fake *FakeUserService) ListSessionsCalls(stub func(context.Context, uuid.UUID) ([]service.SessionResponse, error)) {
+	fake.listSessionsMutex.Lock()
+	defer fake.listSessionsMutex.Unlock()
+	fake.ListSessionsStub = stub
+}
+
+func (fake *FakeUserService) ListSessionsArgsForCall(i int) (context.Context, uuid.UUID) {
+	fake.listSessionsMutex.RLock()
+	defer fake.listSessionsMutex.RUnlock()
+	argsForCall := fake.listSessionsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeUserService) ListSessionsReturns(result1 []service.SessionResponse, result2 error) {
+	fake.listSessionsMutex.Lock()
+	defer fake.listSessionsMutex.Unlock()
+	fake.ListSessionsStub = nil
+	fake.listSessionsReturns = struct {
+		result1 []service.SessionResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUserService) ListSessionsReturnsOnCall(i int, result1 []service.SessionResponse, result2 error) {
+	fake.listSessionsMutex.Lock()
+	defer fake.listSessionsMutex.Unlock()
+	fake.ListSessionsStub = nil
+	if fake.listSessionsReturnsOnCall == nil {
+		fake.listSessionsReturnsOnCall = make(map[int]struct {
+			result1 []service.SessionResponse
+			result2 error
+		})
+	}
+	fake.listSessionsReturnsOnCall[i] = struct {
+		result1 []service.SessionResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUserService) ListUsers(arg1 context.Context, arg2 service.ListUsersRequest) ([]service.ListUsersResponse, int64, error) {
+	fake.listUsersMutex.Lock()
+	ret, specificReturn := fake.listUsersReturnsOnCall[len(fake.listUsersArgsForCall)]
+	fake.listUsersArgsForCall = append(fake.listUsersArgsForCall, struct {
+		arg1 context.Context
+		arg2 service.ListUsersRequest
+	}{arg1, arg2})
+	stub := fake.ListUsersStub
+	fakeReturns := fake.listUsersReturns
+	fake.recordInvocation("ListUsers", []interface{}{arg1, arg2})
+	fake.listUsersMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeUserService) ListUsersCallCount() int {
+	fake.listUsersMutex.RLock()
+	defer fake.listUsersMutex.RUnlock()
+	return len(fake.listUsersArgsForCall)
+}
+
+func (fake *FakeUserService) ListUsersCalls(stub func(context.Context, service.ListUsersRequest) ([]service.ListUsersResponse, int64, error)) {
+	fake.listUsersMutex.Lock()
+	defer fake.listUsersMutex.Unlock()
+	fake.ListUsersStub = stub
+}
+
+func (fake *FakeUserService) ListUsersArgsForCall(i int) (context.Context, service.ListUsersRequest) {
+	fake.listUsersMutex.RLock()
+	defer fake.listUsersMutex.RUnlock()
+	argsForCall := fake.listUsersArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeUserService) ListUsersReturns(result1 []service.ListUsersResponse, result2 int64, result3 error) {
+	fake.listUsersMutex.Lock()
+	defer fake.listUsersMutex.Unlock()
+	fake.ListUsersStub = nil
+	fake.listUsersReturns = struct {
+		result1 []service.ListUsersResponse
+		result2 int64
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeUserService) ListUsersReturnsOnCall(i int, result1 []service.ListUsersResponse, result2 int64, result3 error) {
+	fake.listUsersMutex.Lock()
+	defer fake.listUsersMutex.Unlock()
+	fake.ListUsersStub = nil
+	if fake.listUsersReturnsOnCall == nil {
+		fake.listUsersReturnsOnCall = make(map[int]struct {
+			result1 []service.ListUsersResponse
+			result2 int64
+			result3 error
+		})
+	}
+	fake.listUsersReturnsOnCall[i] = struct {
+		result1 []service.ListUsersResponse
+		result2 int64
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeUserService) Logout(arg1 context.Context, arg2 service.LogoutRequest) error {
+	fake.logoutMutex.Lock()
+	ret, specificReturn := fake.logoutReturnsOnCall[len(fake.logoutArgsForCall)]
+	fake.logoutArgsForCall = append(fake.logoutArgsForCall, struct {
+		arg1 context.Context
+		arg2 service.LogoutRequest
+	}{arg1, arg2})
+	stub := fake.LogoutStub
+	fakeReturns := fake.logoutReturns
+	fake.recordInvocation("Logout", []interface{}{arg1, arg2})
+	fake.logoutMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeUserService) LogoutCallCount() int {
+	fake.logoutMutex.RLock()
+	defer fake.logoutMutex.RUnlock()
+	return len(fake.logoutArgsForCall)
+}
+
+func (fake *FakeUserService) LogoutCalls(stub func(context.Context, service.LogoutRequest) error) {
+	fake.logoutMutex.Lock()
+	defer fake.logoutMutex.Unlock()
+	fake.LogoutStub = stub
+}
+
+func (fake *FakeUserService) LogoutArgsForCall(i int) (context.Context, service.LogoutRequest) {
+	fake.logoutMutex.RLock()
+	defer fake.logoutMutex.RUnlock()
+	argsForCall := fake.logoutArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeUserService) LogoutReturns(result1 error) {
+	fake.logoutMutex.Lock()
+	defer fake.logoutMutex.Unlock()
+	fake.LogoutStub = nil
+	fake.logoutReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeUserService) LogoutReturnsOnCall(i int, result1 error) {
+	fake.logoutMutex.Lock()
+	defer fake.logoutMutex.Unlock()
+	fake.LogoutStub = nil
+	if fake.logoutReturnsOnCall == nil {
+		fake.logoutReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.logoutReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeUserService) OIDCAuthorize(arg1 context.Context) (service.OIDCAuthorizeResponse, error) {
+	fake.oIDCAuthorizeMutex.Lock()
+	ret, specificReturn := fake.oIDCAuthorizeReturnsOnCall[len(fake.oIDCAuthorizeArgsForCall)]
+	fake.oIDCAuthorizeArgsForCall = append(fake.oIDCAuthorizeArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	stub := fake.OIDCAuthorizeStub
+	fakeReturns := fake.oIDCAuthorizeReturns
+	fake.recordInvocation("OIDCAuthorize", []interface{}{arg1})
+	fake.oIDCAuthorizeMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeUserService) OIDCAuthorizeCallCount() int {
+	fake.oIDCAuthorizeMutex.RLock()
+	defer fake.oIDCAuthorizeMutex.RUnlock()
+	return len(fake.oIDCAuthorizeArgsForCall)
+}
+
+func (fake *FakeUserService) OIDCAuthorizeCalls(stub func(context.Context) (service.OIDCAuthorizeResponse, error)) {
+	fake.oIDCAuthorizeMutex.Lock()
+	defer fake.oIDCAuthorizeMutex.Unlock()
+	fake.OIDCAuthorizeStub = stub
+}
+
+func (fake *FakeUserService) OIDCAuthorizeArgsForCall(i int) context.Context {
+	fake.oIDCAuthorizeMutex.RLock()
+	defer fake.oIDCAuthorizeMutex.RUnlock()
+	argsForCall := fake.oIDCAuthorizeArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeUserService) OIDCAuthorizeReturns(result1 service.OIDCAuthorizeResponse, result2 error) {
+	fake.oIDCAuthorizeMutex.Lock()
+	defer fake.oIDCAuthorizeMutex.Unlock()
+	fake.OIDCAuthorizeStub = nil
+	fake.oIDCAuthorizeReturns = struct {
+		result1 service.OIDCAuthorizeResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUserService) OIDCAuthorizeReturnsOnCall(i int, result1 service.OIDCAuthorizeResponse, result2 error) {
+	fake.oIDCAuthorizeMutex.Lock()
+	defer fake.oIDCAuthorizeMutex.Unlock()
+	fake.OIDCAuthorizeStub = nil
+	if fake.oIDCAuthorizeReturnsOnCall == nil {
+		fake.oIDCAuthorizeReturnsOnCall = make(map[int]struct {
+			result1 service.OIDCAuthorizeResponse
+			result2 error
+		})
+	}
+	fake.oIDCAuthorizeReturnsOnCall[i] = struct {
+		result1 service.OIDCAuthorizeResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUserService) OIDCCallback(arg1 context.Context, arg2 service.OIDCCallbackRequest) (service.AuthenticateResponse, error) {
+	fake.oIDCCallbackMutex.Lock()
+	ret, specificReturn := fake.oIDCCallbackReturnsOnCall[len(fake.oIDCCallbackArgsForCall)]
+	fake.oIDCCallbackArgsForCall = append(fake.oIDCCallbackArgsForCall, struct {
+		arg1 context.Context
+		arg2 service.OIDCCallbackRequest
+	}{arg1, arg2})
+	stub := fake.OIDCCallbackStub
+	fakeReturns := fake.oIDCCallbackReturns
+	fake.recordInvocation("OIDCCallback", []interface{}{arg1, arg2})
+	fake.oIDCCallbackMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeUserService) OIDCCallbackCallCount() int {
+	fake.oIDCCallbackMutex.RLock()
+	defer fake.oIDCCallbackMutex.RUnlock()
+	return len(fake.oIDCCallbackArgsForCall)
+}
+
+func (fake *FakeUserService) OIDCCallbackCalls(stub func(context.Context, service.OIDCCallbackRequest) (service.AuthenticateResponse, error)) {
+	fake.oIDCCallbackMutex.Lock()
+	defer fake.oIDCCallbackMutex.Unlock()
+	fake.OIDCCallbackStub = stub
+}
+
+func (fake *FakeUserService) OIDCCallbackArgsForCall(i int) (context.Context, service.OIDCCallbackRequest) {
+	fake.oIDCCallbackMutex.RLock()
+	defer fake.oIDCCallbackMutex.RUnlock()
+	argsForCall := fake.oIDCCallbackArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeUserService) OIDCCallbackReturns(result1 service.AuthenticateResponse, result2 error) {
+	fake.oIDCCallbackMutex.Lock()
+	defer fake.oIDCCallbackMutex.Unlock()
+	fake.OIDCCallbackStub = nil
+	fake.oIDCCallbackReturns = struct {
+		result1 service.AuthenticateResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUserService) OIDCCallbackReturnsOnCall(i int, result1 service.AuthenticateResponse, result2 error) {
+	fake.oIDCCallbackMutex.Lock()
+	defer fake.oIDCCallbackMutex.Unlock()
+	fake.OIDCCallbackStub = nil
+	if fake.oIDCCallbackReturnsOnCall == nil {
+		fake.oIDCCallbackReturnsOnCall = make(map[int]struct {
+			result1 service.AuthenticateResponse
+			result2 error
+		})
+	}
+	fake.oIDCCallbackReturnsOnCall[i] = struct {
+		result1 service.AuthenticateResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUserService) PurgeExpiredOIDCLoginRequests(arg1 context.Context) (int64, error) {
+	fake.purgeExpiredOIDCLoginRequestsMutex.Lock()
+	ret, specificReturn := fake.purgeExpiredOIDCLoginRequestsReturnsOnCall[len(fake.purgeExpiredOIDCLoginRequestsArgsForCall)]
+	fake.purgeExpiredOIDCLoginRequestsArgsForCall = append(fake.purgeExpiredOIDCLoginRequestsArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	stub := fake.PurgeExpiredOIDCLoginRequestsStub
+	fakeReturns := fake.purgeExpiredOIDCLoginRequestsReturns
+	fake.recordInvocation("PurgeExpiredOIDCLoginRequests", []interface{}{arg1})
+	fake.purgeExpiredOIDCLoginRequestsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeUserService) PurgeExpiredOIDCLoginRequestsCallCount() int {
+	fake.purgeExpiredOIDCLoginRequestsMutex.RLock()
+	defer fake.purgeExpiredOIDCLoginRequestsMutex.RUnlock()
+	return len(fake.purgeExpiredOIDCLoginRequestsArgsForCall)
+}
+
+func (fake *FakeUserService) PurgeExpiredOIDCLoginRequestsCalls(stub func(context.Context) (int64, error)) {
+	fake.purgeExpiredOIDCLoginRequestsMutex.Lock()
+	defer fake.purgeExpiredOIDCLoginRequestsMutex.Unlock()
+	fake.PurgeExpiredOIDCLoginRequestsStub = stub
+}
+
+func (fake *FakeUserService) PurgeExpiredOIDCLoginRequestsArgsForCall(i int) context.Context {
+	fake.purgeExpiredOIDCLoginRequestsMutex.RLock()
+	defer fake.purgeExpiredOIDCLoginRequestsMutex.RUnlock()
+	argsForCall := fake.purgeExpiredOIDCLoginRequestsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeUserService) PurgeExpiredOIDCLoginRequestsReturns(result1 int64, result2 error) {
+	fake.purgeExpiredOIDCLoginRequestsMutex.Lock()
+	defer fake.purgeExpiredOIDCLoginRequestsMutex.Unlock()
+	fake.PurgeExpiredOIDCLoginRequestsStub = nil
+	fake.purgeExpiredOIDCLoginRequestsReturns = struct {
+		result1 int64
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUserService) PurgeExpiredOIDCLoginRequestsReturnsOnCall(i int, result1 int64, result2 error) {
+	fake.purgeExpiredOIDCLoginRequestsMutex.Lock()
+	defer fake.purgeExpiredOIDCLoginRequestsMutex.Unlock()
+	fake.PurgeExpiredOIDCLoginRequestsStub = nil
+	if fake.purgeExpiredOIDCLoginRequestsReturnsOnCall == nil {
+		fake.purgeExpiredOIDCLoginRequestsReturnsOnCall = make(map[int]struct {
+			result1 int64
+			result2 error
+		})
+	}
+	fake.purgeExpiredOIDCLoginRequestsReturnsOnCall[i] = struct {
+		result1 int64
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUserService) PurgeExpiredSessions(arg1 context.Context) (int64, error) {
+	fake.purgeExpiredSessionsMutex.Lock()
+	ret, specificReturn := fake.purgeExpiredSessionsReturnsOnCall[len(fake.purgeExpiredSessionsArgsForCall)]
+	fake.purgeExpiredSessionsArgsForCall = append(fake.purgeExpiredSessionsArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	stub := fake.PurgeExpiredSessionsStub
+	fakeReturns := fake.purgeExpiredSessionsReturns
+	fake.recordInvocation("PurgeExpiredSessions", []interface{}{arg1})
+	fake.purgeExpiredSessionsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeUserService) PurgeExpiredSessionsCallCount() int {
+	fake.purgeExpiredSessionsMutex.RLock()
+	defer fake.purgeExpiredSessionsMutex.RUnlock()
+	return len(fake.purgeExpiredSessionsArgsForCall)
+}
+
+func (fake *FakeUserService) PurgeExpiredSessionsCalls(stub func(context.Context) (int64, error)) {
+	fake.purgeExpiredSessionsMutex.Lock()
+	defer fake.purgeExpiredSessionsMutex.Unlock()
+	fake.PurgeExpiredSessionsStub = stub
+}
+
+func (fake *FakeUserService) PurgeExpiredSessionsArgsForCall(i int) context.Context {
+	fake.purgeExpiredSessionsMutex.RLock()
+	defer fake.purgeExpiredSessionsMutex.RUnlock()
+	argsForCall := fake.purgeExpiredSessionsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeUserService) PurgeExpiredSessionsReturns(result1 int64, result2 error) {
+	fake.purgeExpiredSessionsMutex.Lock()
+	defer fake.purgeExpiredSessionsMutex.Unlock()
+	fake.PurgeExpiredSessionsStub = nil
+	fake.purgeExpiredSessionsReturns = struct {
+		result1 int64
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUserService) PurgeExpiredSessionsReturnsOnCall(i int, result1 int64, result2 error) {
+	fake.purgeExpiredSessionsMutex.Lock()
+	defer fake.purgeExpiredSessionsMutex.Unlock()
+	fake.PurgeExpiredSessionsStub = nil
+	if fake.purgeExpiredSessionsReturnsOnCall == nil {
+		fake.purgeExpiredSessionsReturnsOnCall = make(map[int]struct {
+			result1 int64
+			result2 error
+		})
+	}
+	fake.purgeExpiredSessionsReturnsOnCall[i] = struct {
+		result1 int64
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUserService) PurgeLoginAttempts(arg1 context.Context) (int64, error) {
+	fake.purgeLoginAttemptsMutex.Lock()
+	ret, specificReturn := fake.purgeLoginAttemptsReturnsOnCall[len(fake.purgeLoginAttemptsArgsForCall)]
+	fake.purgeLoginAttemptsArgsForCall = append(fake.purgeLoginAttemptsArgsForCall, struct {
+		arg1 context.Context
+	}{arg1})
+	stub := fake.PurgeLoginAttemptsStub
+	fakeReturns := fake.purgeLoginAttemptsReturns
+	fake.recordInvocation("PurgeLoginAttempts", []interface{}{arg1})
+	fake.purgeLoginAttemptsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeUserService) PurgeLoginAttemptsCallCount() int {
+	fake.purgeLoginAttemptsMutex.RLock()
+	defer fake.purgeLoginAttemptsMutex.RUnlock()
+	return len(fake.purgeLoginAttemptsArgsForCall)
+}
+
+func (fake *FakeUserService) PurgeLoginAttemptsCalls(stub func(context.Context) (int64, error)) {
+	fake.purgeLoginAttemptsMutex.Lock()
+	defer fake.purgeLoginAttemptsMutex.Unlock()
+	fake.PurgeLoginAttemptsStub = stub
+}
+
+func (fake *FakeUserService) PurgeLoginAttemptsArgsForCall(i int) context.Context {
+	fake.purgeLoginAttemptsMutex.RLock()
+	defer fake.purgeLoginAttemptsMutex.RUnlock()
+	argsForCall := fake.purgeLoginAttemptsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeUserService) PurgeLoginAttemptsReturns(result1 int64, result2 error) {
+	fake.purgeLoginAttemptsMutex.Lock()
+	defer fake.purgeLoginAttemptsMutex.Unlock()
+	fake.PurgeLoginAttemptsStub = nil
+	fake.purgeLoginAttemptsReturns = struct {
+		result1 int64
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUserService) PurgeLoginAttemptsReturnsOnCall(i int, result1 int64, result2 error) {
+	fake.purgeLoginAttemptsMutex.Lock()
+	defer fake.purgeLoginAttemptsMutex.Unlock()
+	fake.PurgeLoginAttemptsStub = nil
+	if fake.purgeLoginAttemptsReturnsOnCall == nil {
+		fake.purgeLoginAttemptsReturnsOnCall = make(map[int]struct {
+			result1 int64
+			result2 error
+		})
+	}
+	fake.purgeLoginAttemptsReturnsOnCall[i] = struct {
+		result1 int64
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUserService) RecordImpersonatedRequest(arg1 context.Context, arg2 http_server.Principal, arg3 http_server.ImpersonatedRequest) error {
+	fake.recordImpersonatedRequestMutex.Lock()
+	ret, specificReturn := fake.recordImpersonatedRequestReturnsOnCall[len(fake.recordImpersonatedRequestArgsForCall)]
+	fake.recordImpersonatedRequestArgsForCall = append(fake.recordImpersonatedRequestArgsForCall, struct {
+		arg1 context.Context
+		arg2 http_server.Principal
+		arg3 http_server.ImpersonatedRequest
+	}{arg1, arg2, arg3})
+	stub := fake.RecordImpersonatedRequestStub
+	fakeReturns := fake.recordImpersonatedRequestReturns
+	fake.recordInvocation("RecordImpersonatedRequest", []interface{}{arg1, arg2, arg3})
+	fake.recordImpersonatedRequestMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeUserService) RecordImpersonatedRequestCallCount() int {
+	fake.recordImpersonatedRequestMutex.RLock()
+	defer fake.recordImpersonatedRequestMutex.RUnlock()
+	return len(fake.recordImpersonatedRequestArgsForCall)
+}
+
+func (fake *FakeUserService) RecordImpersonatedRequestCalls(stub func(context.Context, http_server.Principal, http_server.ImpersonatedRequest) error) {
+	fake.recordImpersonatedRequestMutex.Lock()
+	defer fake.recordImpersonatedRequestMutex.Unlock()
+	fake.RecordImpersonatedRequestStub = stub
+}
+
+func (fake *FakeUserService) RecordImpersonatedRequestArgsForCall(i int) (context.Context, http_server.Principal, http_server.ImpersonatedRequest) {
+	fake.recordImpersonatedRequestMutex.RLock()
+	defer fake.recordImpersonatedRequestMutex.RUnlock()
+	argsForCall := fake.recordImpersonatedRequestArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeUserService) RecordImpersonatedRequestReturns(result1 error) {
+	fake.recordImpersonatedRequestMutex.Lock()
+	defer fake.recordImpersonatedRequestMutex.Unlock()
+	fake.RecordImpersonatedRequestStub = nil
+	fake.recordImpersonatedRequestReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeUserService) RecordImpersonatedRequestReturnsOnCall(i int, result1 error) {
+	fake.recordImpersonatedRequestMutex.Lock()
+	defer fake.recordImpersonatedRequestMutex.Unlock()
+	fake.RecordImpersonatedRequestStub = nil
+	if fake.recordImpersonatedRequestReturnsOnCall == nil {
+		fake.recordImpersonatedRequestReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.recordImpersonatedRequestReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeUserService) RefreshToken(arg1 context.Context, arg2 service.RefreshTokenRequest) (service.AuthenticateResponse, error) {
+	fake.refreshTokenMutex.Lock()
+	ret, specificReturn := fake.refreshTokenReturnsOnCall[len(fake.refreshTokenArgsForCall)]
+	fake.refreshTokenArgsForCall = append(fake.refreshTokenArgsForCall, struct {
+		arg1 context.Context
+		arg2 service.RefreshTokenRequest
+	}{arg1, arg2})
+	stub := fake.RefreshTokenStub
+	fakeReturns := fake.refreshTokenReturns
+	fake.recordInvocation("RefreshToken", []interface{}{arg1, arg2})
+	fake.refreshTokenMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeUserService) RefreshTokenCallCount() int {
+	fake.refreshTokenMutex.RLock()
+	defer fake.refreshTokenMutex.RUnlock()
+	return len(fake.refreshTokenArgsForCall)
+}
+
+func (fake *FakeUserService) RefreshTokenCalls(stub func(context.Context, service.RefreshTokenRequest) (service.AuthenticateResponse, error)) {
+	fake.refreshTokenMutex.Lock()
+	defer fake.refreshTokenMutex.Unlock()
+	fake.RefreshTokenStub = stub
+}
+
+func (fake *FakeUserService) RefreshTokenArgsForCall(i int) (context.Context, service.RefreshTokenRequest) {
+	fake.refreshTokenMutex.RLock()
+	defer fake.refreshTokenMutex.RUnlock()
+	argsForCall := fake.refreshTokenArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeUserService) RefreshTokenReturns(result1 service.AuthenticateResponse, result2 error) {
+	fake.refreshTokenMutex.Lock()
+	defer fake.refreshTokenMutex.Unlock()
+	fake.RefreshTokenStub = nil
+	fake.refreshTokenReturns = struct {
+		result1 service.AuthenticateResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUserService) RefreshTokenReturnsOnCall(i int, result1 service.AuthenticateResponse, result2 error) {
+	fake.refreshTokenMutex.Lock()
+	defer fake.refreshTokenMutex.Unlock()
+	fake.RefreshTokenStub = nil
+	if fake.refreshTokenReturnsOnCall == nil {
+		fake.refreshTokenReturnsOnCall = make(map[int]struct {
+			result1 service.AuthenticateResponse
+			result2 error
+		})
+	}
+	fake.refreshTokenReturnsOnCall[i] = struct {
+		result1 service.AuthenticateResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUserService) ResetPassword(arg1 context.Context, arg2 service.ResetPasswordRequest) error {
+	fake.resetPasswordMutex.Lock()
+	ret, specificReturn := fake.resetPasswordReturnsOnCall[len(fake.resetPasswordArgsForCall)]
+	fake.resetPasswordArgsForCall = append(fake.resetPasswordArgsForCall, struct {
+		arg1 context.Context
+		arg2 service.ResetPasswordRequest
+	}{arg1, arg2})
+	stub := fake.ResetPasswordStub
+	fakeReturns := fake.resetPasswordReturns
+	fake.recordInvocation("ResetPassword", []interface{}{arg1, arg2})
+	fake.resetPasswordMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeUserService) ResetPasswordCallCount() int {
+	fake.resetPasswordMutex.RLock()
+	defer fake.resetPasswordMutex.RUnlock()
+	return len(fake.resetPasswordArgsForCall)
+}
+
+func (fake *FakeUserService) ResetPasswordCalls(stub func(context.Context, service.ResetPasswordRequest) error) {
+	fake.resetPasswordMutex.Lock()
+	defer fake.resetPasswordMutex.Unlock()
+	fake.ResetPasswordStub = stub
+}
+
+func (fake *FakeUserService) ResetPasswordArgsForCall(i int) (context.Context, service.ResetPasswordRequest) {
+	fake.resetPasswordMutex.RLock()
+	defer fake.resetPasswordMutex.RUnlock()
+	argsForCall := fake.resetPasswordArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeUserService) ResetPasswordReturns(result1 error) {
+	fake.resetPasswordMutex.Lock()
+	defer fake.resetPasswordMutex.Unlock()
+	fake.ResetPasswordStub = nil
+	fake.resetPasswordReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeUserService) ResetPasswordReturnsOnCall(i int, result1 error) {
+	fake.resetPasswordMutex.Lock()
+	defer fake.resetPasswordMutex.Unlock()
+	fake.ResetPasswordStub = nil
+	if fake.resetPasswordReturnsOnCall == nil {
+		fake.resetPasswordReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.resetPasswordReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeUserService) RestoreUser(arg1 context.Context, arg2 uuid.UUID) error {
+	fake.restoreUserMutex.Lock()
+	ret, specificReturn := fake.restoreUserReturnsOnCall[len(fake.restoreUserArgsForCall)]
+	fake.restoreUserArgsForCall = append(fake.restoreUserArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+	}{arg1, arg2})
+	stub := fake.RestoreUserStub
+	fakeReturns := fake.restoreUserReturns
+	fake.recordInvocation("RestoreUser", []interface{}{arg1, arg2})
+	fake.restoreUserMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeUserService) RestoreUserCallCount() int {
+	fake.restoreUserMutex.RLock()
+	defer fake.restoreUserMutex.RUnlock()
+	return len(fake.restoreUserArgsForCall)
+}
+
+func (fake *FakeUserService) RestoreUserCalls(stub func(context.Context, uuid.UUID) error) {
+	fake.restoreUserMutex.Lock()
+	defer fake.restoreUserMutex.Unlock()
+	fake.RestoreUserStub = stub
+}
+
+func (fake *FakeUserService) RestoreUserArgsForCall(i int) (context.Context, uuid.UUID) {
+	fake.restoreUserMutex.RLock()
+	defer fake.restoreUserMutex.RUnlock()
+	argsForCall := fake.restoreUserArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeUserService) RestoreUserReturns(result1 error) {
+	fake.restoreUserMutex.Lock()
+	defer fake.restoreUserMutex.Unlock()
+	fake.RestoreUserStub = nil
+	fake.restoreUserReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeUserService) RestoreUserReturnsOnCall(i int, result1 error) {
+	fake.restoreUserMutex.Lock()
+	defer fake.restoreUserMutex.Unlock()
+	fake.RestoreUserStub = nil
+	if fake.restoreUserReturnsOnCall == nil {
+		fake.restoreUserReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.restoreUserReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeUserService) RevokePersonalAccessToken(arg1 context.Context, arg2 uuid.UUID, arg3 uuid.UUID) error {
+	fake.revokePersonalAccessTokenMutex.Lock()
+	ret, specificReturn := fake.revokePersonalAccessTokenReturnsOnCall[len(fake.revokePersonalAccessTokenArgsForCall)]
+	fake.revokePersonalAccessTokenArgsForCall = append(fake.revokePersonalAccessTokenArgsForCall, struct {
+		arg1 context.Context
+		arg2 uuid.UUID
+		arg3 uuid.UUID
+	}{arg1, arg2, arg3})
+	stub := fake.RevokePersonalAccessTokenStub
+	fakeReturns := fake.revokePersonalAccessTokenReturns
+	fake.recordInvocation("RevokePersonalAccessToken", []interface{}{arg1, arg2, arg3})
+	fake.revokePersonalAccessTokenMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeUserService) RevokePersonalAccessTokenCallCount() int {
+	fake.revokePersonalAccessTokenMutex.RLock()
+	defer fake.revokePersonalAccessTokenMutex.RUnlock()
+	return len(fake.revokePersonalAccessTokenArgsForCall)
+}
+
+func (fake *FakeUserService) RevokePersonalAccessTokenCalls(stub func(context.Context, uuid.UUID, uuid.UUID) error) {
+	fake.revokePersonalAccessTokenMutex.Lock()
+	defer fake.revokePersonalAccessTokenMutex.Unlock()
+	fake.RevokePersonalAccessTokenStub = stub
+}
+
+func (fake *FakeUserService) RevokePersonalAccessTokenArgsForCall(i int) (context.Context, uuid.UUID, uuid.UUID) {
+	fake.revokePersonalAccessTokenMutex.RLock()
+	defer fake.revokePersonalAccessTokenMutex.RUnlock()
+	argsForCall := fake.revokePersonalAccessTokenArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeUserService) RevokePersonalAccessTokenReturns(result1 error) {
+	fake.revokePersonalAccessTokenMutex.Lock()
+	defer fake.revokePersonalAccessTokenMutex.Unlock()
+	fake.RevokePersonalAccessTokenStub = nil
+	fake.revokePersonalAccessTokenReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeUserService) RevokePersonalAccessTokenReturnsOnCall(i int, result1 error) {
+	fake.revokePersonalAccessTokenMutex.Lock()
+	defer fake.revokePersonalAccessTokenMutex.Unlock()
+	fake.RevokePersonalAccessTokenStub = nil
+	if fake.revokePersonalAccessTokenReturnsOnCall == nil {
+		fake.revokePersonalAccessTokenReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
 	}
-	invocations      map[string][][]interface{}
-	invocationsMutex sync.RWMutex
+	fake.revokePersonalAccessTokenReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
 }
 
-func (fake *FakeUserService) CreateUser(arg1 context.Context, arg2 service.CreateUserRequest) (service.CreateUserResponse, error) {
-	fake.createUserMutex.Lock()
-	ret, specificReturn := fake.createUserReturnsOnCall[len(fake.createUserArgsForCall)]
-	fake.createUserArgsForCall = append(fake.createUserArgsForCall, struct {
+func (fake *FakeUserService) StartImpersonation(arg1 context.Context, arg2 uuid.UUID, arg3 service.StartImpersonationRequest) (service.ImpersonationResponse, error) {
+	fake.startImpersonationMutex.Lock()
+	ret, specificReturn := fake.startImpersonationReturnsOnCall[len(fake.startImpersonationArgsForCall)]
+	fake.startImpersonationArgsForCall = append(fake.startImpersonationArgsForCall, struct {
 		arg1 context.Context
-		arg2 service.CreateUserRequest
-	}{arg1, arg2})
-	stub := fake.CreateUserStub
-	fakeReturns := fake.createUserReturns
-	fake.recordInvocation("CreateUser", []interface{}{arg1, arg2})
-	fake.createUserMutex.Unlock()
+		arg2 uuid.UUID
+		arg3 service.StartImpersonationRequest
+	}{arg1, arg2, arg3})
+	stub := fake.StartImpersonationStub
+	fakeReturns := fake.startImpersonationReturns
+	fake.recordInvocation("StartImpersonation", []interface{}{arg1, arg2, arg3})
+	fake.startImpersonationMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2)
+		return stub(arg1, arg2, arg3)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -105,62 +2935,62 @@ func (fake *FakeUserService) CreateUser(arg1 context.Context, arg2 service.Creat
 	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakeUserService) CreateUserCallCount() int {
-	fake.createUserMutex.RLock()
-	defer fake.createUserMutex.RUnlock()
-	return len(fake.createUserArgsForCall)
+func (fake *FakeUserService) StartImpersonationCallCount() int {
+	fake.startImpersonationMutex.RLock()
+	defer fake.startImpersonationMutex.RUnlock()
+	return len(fake.startImpersonationArgsForCall)
 }
 
-func (fake *FakeUserService) CreateUserCalls(stub func(context.Context, service.CreateUserRequest) (service.CreateUserResponse, error)) {
-	fake.createUserMutex.Lock()
-	defer fake.createUserMutex.Unlock()
-	fake.CreateUserStub = stub
+func (fake *FakeUserService) StartImpersonationCalls(stub func(context.Context, uuid.UUID, service.StartImpersonationRequest) (service.ImpersonationResponse, error)) {
+	fake.startImpersonationMutex.Lock()
+	defer fake.startImpersonationMutex.Unlock()
+	fake.StartImpersonationStub = stub
 }
 
-func (fake *FakeUserService) CreateUserArgsForCall(i int) (context.Context, service.CreateUserRequest) {
-	fake.createUserMutex.RLock()
-	defer fake.createUserMutex.RUnlock()
-	argsForCall := fake.createUserArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2
+func (fake *FakeUserService) StartImpersonationArgsForCall(i int) (context.Context, uuid.UUID, service.StartImpersonationRequest) {
+	fake.startImpersonationMutex.RLock()
+	defer fake.startImpersonationMutex.RUnlock()
+	argsForCall := fake.startImpersonationArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
-func (fake *FakeUserService) CreateUserReturns(result1 service.CreateUserResponse, result2 error) {
-	fake.createUserMutex.Lock()
-	defer fake.createUserMutex.Unlock()
-	fake.CreateUserStub = nil
-	fake.createUserReturns = struct {
-		result1 service.CreateUserResponse
+func (fake *FakeUserService) StartImpersonationReturns(result1 service.ImpersonationResponse, result2 error) {
+	fake.startImpersonationMutex.Lock()
+	defer fake.startImpersonationMutex.Unlock()
+	fake.StartImpersonationStub = nil
+	fake.startImpersonationReturns = struct {
+		result1 service.ImpersonationResponse
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeUserService) CreateUserReturnsOnCall(i int, result1 service.CreateUserResponse, result2 error) {
-	fake.createUserMutex.Lock()
-	defer fake.createUserMutex.Unlock()
-	fake.CreateUserStub = nil
-	if fake.createUserReturnsOnCall == nil {
-		fake.createUserReturnsOnCall = make(map[int]struct {
-			result1 service.CreateUserResponse
+func (fake *FakeUserService) StartImpersonationReturnsOnCall(i int, result1 service.ImpersonationResponse, result2 error) {
+	fake.startImpersonationMutex.Lock()
+	defer fake.startImpersonationMutex.Unlock()
+	fake.StartImpersonationStub = nil
+	if fake.startImpersonationReturnsOnCall == nil {
+		fake.startImpersonationReturnsOnCall = make(map[int]struct {
+			result1 service.ImpersonationResponse
 			result2 error
 		})
 	}
-	fake.createUserReturnsOnCall[i] = struct {
-		result1 service.CreateUserResponse
+	fake.startImpersonationReturnsOnCall[i] = struct {
+		result1 service.ImpersonationResponse
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeUserService) DeleteUser(arg1 context.Context, arg2 uuid.UUID) error {
-	fake.deleteUserMutex.Lock()
-	ret, specificReturn := fake.deleteUserReturnsOnCall[len(fake.deleteUserArgsForCall)]
-	fake.deleteUserArgsForCall = append(fake.deleteUserArgsForCall, struct {
+func (fake *FakeUserService) UnlockUser(arg1 context.Context, arg2 uuid.UUID) error {
+	fake.unlockUserMutex.Lock()
+	ret, specificReturn := fake.unlockUserReturnsOnCall[len(fake.unlockUserArgsForCall)]
+	fake.unlockUserArgsForCall = append(fake.unlockUserArgsForCall, struct {
 		arg1 context.Context
 		arg2 uuid.UUID
 	}{arg1, arg2})
-	stub := fake.DeleteUserStub
-	fakeReturns := fake.deleteUserReturns
-	fake.recordInvocation("DeleteUser", []interface{}{arg1, arg2})
-	fake.deleteUserMutex.Unlock()
+	stub := fake.UnlockUserStub
+	fakeReturns := fake.unlockUserReturns
+	fake.recordInvocation("UnlockUser", []interface{}{arg1, arg2})
+	fake.unlockUserMutex.Unlock()
 	if stub != nil {
 		return stub(arg1, arg2)
 	}
@@ -170,61 +3000,62 @@ func (fake *FakeUserService) DeleteUser(arg1 context.Context, arg2 uuid.UUID) er
 	return fakeReturns.result1
 }
 
-func (fake *FakeUserService) DeleteUserCallCount() int {
-	fake.deleteUserMutex.RLock()
-	defer fake.deleteUserMutex.RUnlock()
-	return len(fake.deleteUserArgsForCall)
+func (fake *FakeUserService) UnlockUserCallCount() int {
+	fake.unlockUserMutex.RLock()
+	defer fake.unlockUserMutex.RUnlock()
+	return len(fake.unlockUserArgsForCall)
 }
 
-func (fake *FakeUserService) DeleteUserCalls(stub func(context.Context, uuid.UUID) error) {
-	fake.deleteUserMutex.Lock()
-	defer fake.deleteUserMutex.Unlock()
-	fake.DeleteUserStub = stub
+func (fake *FakeUserService) UnlockUserCalls(stub func(context.Context, uuid.UUID) error) {
+	fake.unlockUserMutex.Lock()
+	defer fake.unlockUserMutex.Unlock()
+	fake.UnlockUserStub = stub
 }
 
-func (fake *FakeUserService) DeleteUserArgsForCall(i int) (context.Context, uuid.UUID) {
-	fake.deleteUserMutex.RLock()
-	defer fake.deleteUserMutex.RUnlock()
-	argsForCall := fake.deleteUserArgsForCall[i]
+func (fake *FakeUserService) UnlockUserArgsForCall(i int) (context.Context, uuid.UUID) {
+	fake.unlockUserMutex.RLock()
+	defer fake.unlockUserMutex.RUnlock()
+	argsForCall := fake.unlockUserArgsForCall[i]
 	return argsForCall.arg1, argsForCall.arg2
 }
 
-func (fake *FakeUserService) DeleteUserReturns(result1 error) {
-	fake.deleteUserMutex.Lock()
-	defer fake.deleteUserMutex.Unlock()
-	fake.DeleteUserStub = nil
-	fake.deleteUserReturns = struct {
+func (fake *FakeUserService) UnlockUserReturns(result1 error) {
+	fake.unlockUserMutex.Lock()
+	defer fake.unlockUserMutex.Unlock()
+	fake.UnlockUserStub = nil
+	fake.unlockUserReturns = struct {
 		result1 error
 	}{result1}
 }
 
-func (fake *FakeUserService) DeleteUserReturnsOnCall(i int, result1 error) {
-	fake.deleteUserMutex.Lock()
-	defer fake.deleteUserMutex.Unlock()
-	fake.DeleteUserStub = nil
-	if fake.deleteUserReturnsOnCall == nil {
-		fake.deleteUserReturnsOnCall = make(map[int]struct {
+func (fake *FakeUserService) UnlockUserReturnsOnCall(i int, result1 error) {
+	fake.unlockUserMutex.Lock()
+	defer fake.unlockUserMutex.Unlock()
+	fake.UnlockUserStub = nil
+	if fake.unlockUserReturnsOnCall == nil {
+		fake.unlockUserReturnsOnCall = make(map[int]struct {
 			result1 error
 		})
 	}
-	fake.deleteUserReturnsOnCall[i] = struct {
+	fake.unlockUserReturnsOnCall[i] = struct {
 		result1 error
 	}{result1}
 }
 
-func (fake *FakeUserService) GetUserByID(arg1 context.Context, arg2 uuid.UUID) (service.GetUserResponse, error) {
-	fake.getUserByIDMutex.Lock()
-	ret, specificReturn := fake.getUserByIDReturnsOnCall[len(fake.getUserByIDArgsForCall)]
-	fake.getUserByIDArgsForCall = append(fake.getUserByIDArgsForCall, struct {
+func (fake *FakeUserService) UpdateAuthorProfile(arg1 context.Context, arg2 uuid.UUID, arg3 service.UpdateAuthorProfileRequest) (service.GetUserResponse, error) {
+	fake.updateAuthorProfileMutex.Lock()
+	ret, specificReturn := fake.updateAuthorProfileReturnsOnCall[len(fake.updateAuthorProfileArgsForCall)]
+	fake.updateAuthorProfileArgsForCall = append(fake.updateAuthorProfileArgsForCall, struct {
 		arg1 context.Context
 		arg2 uuid.UUID
-	}{arg1, arg2})
-	stub := fake.GetUserByIDStub
-	fakeReturns := fake.getUserByIDReturns
-	fake.recordInvocation("GetUserByID", []interface{}{arg1, arg2})
-	fake.getUserByIDMutex.Unlock()
+		arg3 service.UpdateAuthorProfileRequest
+	}{arg1, arg2, arg3})
+	stub := fake.UpdateAuthorProfileStub
+	fakeReturns := fake.updateAuthorProfileReturns
+	fake.recordInvocation("UpdateAuthorProfile", []interface{}{arg1, arg2, arg3})
+	fake.updateAuthorProfileMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2)
+		return stub(arg1, arg2, arg3)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -232,117 +3063,115 @@ func (fake *FakeUserService) GetUserByID(arg1 context.Context, arg2 uuid.UUID) (
 	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakeUserService) GetUserByIDCallCount() int {
-	fake.getUserByIDMutex.RLock()
-	defer fake.getUserByIDMutex.RUnlock()
-	return len(fake.getUserByIDArgsForCall)
+func (fake *FakeUserService) UpdateAuthorProfileCallCount() int {
+	fake.updateAuthorProfileMutex.RLock()
+	defer fake.updateAuthorProfileMutex.RUnlock()
+	return len(fake.updateAuthorProfileArgsForCall)
 }
 
-func (fake *FakeUserService) GetUserByIDCalls(stub func(context.Context, uuid.UUID) (service.GetUserResponse, error)) {
-	fake.getUserByIDMutex.Lock()
-	defer fake.getUserByIDMutex.Unlock()
-	fake.GetUserByIDStub = stub
+func (fake *FakeUserService) UpdateAuthorProfileCalls(stub func(context.Context, uuid.UUID, service.UpdateAuthorProfileRequest) (service.GetUserResponse, error)) {
+	fake.updateAuthorProfileMutex.Lock()
+	defer fake.updateAuthorProfileMutex.Unlock()
+	fake.UpdateAuthorProfileStub = stub
 }
 
-func (fake *FakeUserService) GetUserByIDArgsForCall(i int) (context.Context, uuid.UUID) {
-	fake.getUserByIDMutex.RLock()
-	defer fake.getUserByIDMutex.RUnlock()
-	argsForCall := fake.getUserByIDArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2
+func (fake *FakeUserService) UpdateAuthorProfileArgsForCall(i int) (context.Context, uuid.UUID, service.UpdateAuthorProfileRequest) {
+	fake.updateAuthorProfileMutex.RLock()
+	defer fake.updateAuthorProfileMutex.RUnlock()
+	argsForCall := fake.updateAuthorProfileArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
-func (fake *FakeUserService) GetUserByIDReturns(result1 service.GetUserResponse, result2 error) {
-	fake.getUserByIDMutex.Lock()
-	defer fake.getUserByIDMutex.Unlock()
-	fake.GetUserByIDStub = nil
-	fake.getUserByIDReturns = struct {
+func (fake *FakeUserService) UpdateAuthorProfileReturns(result1 service.GetUserResponse, result2 error) {
+	fake.updateAuthorProfileMutex.Lock()
+	defer fake.updateAuthorProfileMutex.Unlock()
+	fake.UpdateAuthorProfileStub = nil
+	fake.updateAuthorProfileReturns = struct {
 		result1 service.GetUserResponse
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeUserService) GetUserByIDReturnsOnCall(i int, result1 service.GetUserResponse, result2 error) {
-	fake.getUserByIDMutex.Lock()
-	defer fake.getUserByIDMutex.Unlock()
-	fake.GetUserByIDStub = nil
-	if fake.getUserByIDReturnsOnCall == nil {
-		fake.getUserByIDReturnsOnCall = make(map[int]struct {
+func (fake *FakeUserService) UpdateAuthorProfileReturnsOnCall(i int, result1 service.GetUserResponse, result2 error) {
+	fake.updateAuthorProfileMutex.Lock()
+	defer fake.updateAuthorProfileMutex.Unlock()
+	fake.UpdateAuthorProfileStub = nil
+	if fake.updateAuthorProfileReturnsOnCall == nil {
+		fake.updateAuthorProfileReturnsOnCall = make(map[int]struct {
 			result1 service.GetUserResponse
 			result2 error
 		})
 	}
-	fake.getUserByIDReturnsOnCall[i] = struct {
+	fake.updateAuthorProfileReturnsOnCall[i] = struct {
 		result1 service.GetUserResponse
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeUserService) ListUsers(arg1 context.Context, arg2 service.ListUsersRequest) ([]service.ListUsersResponse, int64, error) {
-	fake.listUsersMutex.Lock()
-	ret, specificReturn := fake.listUsersReturnsOnCall[len(fake.listUsersArgsForCall)]
-	fake.listUsersArgsForCall = append(fake.listUsersArgsForCall, struct {
+func (fake *FakeUserService) UpdatePreferences(arg1 context.Context, arg2 uuid.UUID, arg3 service.Preferences) (service.PreferencesResponse, error) {
+	fake.updatePreferencesMutex.Lock()
+	ret, specificReturn := fake.updatePreferencesReturnsOnCall[len(fake.updatePreferencesArgsForCall)]
+	fake.updatePreferencesArgsForCall = append(fake.updatePreferencesArgsForCall, struct {
 		arg1 context.Context
-		arg2 service.ListUsersRequest
-	}{arg1, arg2})
-	stub := fake.ListUsersStub
-	fakeReturns := fake.listUsersReturns
-	fake.recordInvocation("ListUsers", []interface{}{arg1, arg2})
-	fake.listUsersMutex.Unlock()
+		arg2 uuid.UUID
+		arg3 service.Preferences
+	}{arg1, arg2, arg3})
+	stub := fake.UpdatePreferencesStub
+	fakeReturns := fake.updatePreferencesReturns
+	fake.recordInvocation("UpdatePreferences", []interface{}{arg1, arg2, arg3})
+	fake.updatePreferencesMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2)
+		return stub(arg1, arg2, arg3)
 	}
 	if specificReturn {
-		return ret.result1, ret.result2, ret.result3
+		return ret.result1, ret.result2
 	}
-	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakeUserService) ListUsersCallCount() int {
-	fake.listUsersMutex.RLock()
-	defer fake.listUsersMutex.RUnlock()
-	return len(fake.listUsersArgsForCall)
+func (fake *FakeUserService) UpdatePreferencesCallCount() int {
+	fake.updatePreferencesMutex.RLock()
+	defer fake.updatePreferencesMutex.RUnlock()
+	return len(fake.updatePreferencesArgsForCall)
 }
 
-func (fake *FakeUserService) ListUsersCalls(stub func(context.Context, service.ListUsersRequest) ([]service.ListUsersResponse, int64, error)) {
-	fake.listUsersMutex.Lock()
-	defer fake.listUsersMutex.Unlock()
-	fake.ListUsersStub = stub
+func (fake *FakeUserService) UpdatePreferencesCalls(stub func(context.Context, uuid.UUID, service.Preferences) (service.PreferencesResponse, error)) {
+	fake.updatePreferencesMutex.Lock()
+	defer fake.updatePreferencesMutex.Unlock()
+	fake.UpdatePreferencesStub = stub
 }
 
-func (fake *FakeUserService) ListUsersArgsForCall(i int) (context.Context, service.ListUsersRequest) {
-	fake.listUsersMutex.RLock()
-	defer fake.listUsersMutex.RUnlock()
-	argsForCall := fake.listUsersArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2
+func (fake *FakeUserService) UpdatePreferencesArgsForCall(i int) (context.Context, uuid.UUID, service.Preferences) {
+	fake.updatePreferencesMutex.RLock()
+	defer fake.updatePreferencesMutex.RUnlock()
+	argsForCall := fake.updatePreferencesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
-func (fake *FakeUserService) ListUsersReturns(result1 []service.ListUsersResponse, result2 int64, result3 error) {
-	fake.listUsersMutex.Lock()
-	defer fake.listUsersMutex.Unlock()
-	fake.ListUsersStub = nil
-	fake.listUsersReturns = struct {
-		result1 []service.ListUsersResponse
-		result2 int64
-		result3 error
-	}{result1, result2, result3}
+func (fake *FakeUserService) UpdatePreferencesReturns(result1 service.PreferencesResponse, result2 error) {
+	fake.updatePreferencesMutex.Lock()
+	defer fake.updatePreferencesMutex.Unlock()
+	fake.UpdatePreferencesStub = nil
+	fake.updatePreferencesReturns = struct {
+		result1 service.PreferencesResponse
+		result2 error
+	}{result1, result2}
 }
 
-func (fake *FakeUserService) ListUsersReturnsOnCall(i int, result1 []service.ListUsersResponse, result2 int64, result3 error) {
-	fake.listUsersMutex.Lock()
-	defer fake.listUsersMutex.Unlock()
-	fake.ListUsersStub = nil
-	if fake.listUsersReturnsOnCall == nil {
-		fake.listUsersReturnsOnCall = make(map[int]struct {
-			result1 []service.ListUsersResponse
-			result2 int64
-			result3 error
+func (fake *FakeUserService) UpdatePreferencesReturnsOnCall(i int, result1 service.PreferencesResponse, result2 error) {
+	fake.updatePreferencesMutex.Lock()
+	defer fake.updatePreferencesMutex.Unlock()
+	fake.UpdatePreferencesStub = nil
+	if fake.updatePreferencesReturnsOnCall == nil {
+		fake.updatePreferencesReturnsOnCall = make(map[int]struct {
+			result1 service.PreferencesResponse
+			result2 error
 		})
 	}
-	fake.listUsersReturnsOnCall[i] = struct {
-		result1 []service.ListUsersResponse
-		result2 int64
-		result3 error
-	}{result1, result2, result3}
+	fake.updatePreferencesReturnsOnCall[i] = struct {
+		result1 service.PreferencesResponse
+		result2 error
+	}{result1, result2}
 }
 
 func (fake *FakeUserService) UpdateUser(arg1 context.Context, arg2 uuid.UUID, arg3 service.UpdateUserRequest) (service.UpdateUserResponse, error) {
@@ -411,6 +3240,133 @@ func (fake *FakeUserService) UpdateUserReturnsOnCall(i int, result1 service.Upda
 	}{result1, result2}
 }
 
+func (fake *FakeUserService) VerifyEmail(arg1 context.Context, arg2 service.VerifyEmailRequest) error {
+	fake.verifyEmailMutex.Lock()
+	ret, specificReturn := fake.verifyEmailReturnsOnCall[len(fake.verifyEmailArgsForCall)]
+	fake.verifyEmailArgsForCall = append(fake.verifyEmailArgsForCall, struct {
+		arg1 context.Context
+		arg2 service.VerifyEmailRequest
+	}{arg1, arg2})
+	stub := fake.VerifyEmailStub
+	fakeReturns := fake.verifyEmailReturns
+	fake.recordInvocation("VerifyEmail", []interface{}{arg1, arg2})
+	fake.verifyEmailMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeUserService) VerifyEmailCallCount() int {
+	fake.verifyEmailMutex.RLock()
+	defer fake.verifyEmailMutex.RUnlock()
+	return len(fake.verifyEmailArgsForCall)
+}
+
+func (fake *FakeUserService) VerifyEmailCalls(stub func(context.Context, service.VerifyEmailRequest) error) {
+	fake.verifyEmailMutex.Lock()
+	defer fake.verifyEmailMutex.Unlock()
+	fake.VerifyEmailStub = stub
+}
+
+func (fake *FakeUserService) VerifyEmailArgsForCall(i int) (context.Context, service.VerifyEmailRequest) {
+	fake.verifyEmailMutex.RLock()
+	defer fake.verifyEmailMutex.RUnlock()
+	argsForCall := fake.verifyEmailArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeUserService) VerifyEmailReturns(result1 error) {
+	fake.verifyEmailMutex.Lock()
+	defer fake.verifyEmailMutex.Unlock()
+	fake.VerifyEmailStub = nil
+	fake.verifyEmailReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeUserService) VerifyEmailReturnsOnCall(i int, result1 error) {
+	fake.verifyEmailMutex.Lock()
+	defer fake.verifyEmailMutex.Unlock()
+	fake.VerifyEmailStub = nil
+	if fake.verifyEmailReturnsOnCall == nil {
+		fake.verifyEmailReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.verifyEmailReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeUserService) VerifyTwoFactor(arg1 context.Context, arg2 service.VerifyTwoFactorRequest) (service.AuthenticateResponse, error) {
+	fake.verifyTwoFactorMutex.Lock()
+	ret, specificReturn := fake.verifyTwoFactorReturnsOnCall[len(fake.verifyTwoFactorArgsForCall)]
+	fake.verifyTwoFactorArgsForCall = append(fake.verifyTwoFactorArgsForCall, struct {
+		arg1 context.Context
+		arg2 service.VerifyTwoFactorRequest
+	}{arg1, arg2})
+	stub := fake.VerifyTwoFactorStub
+	fakeReturns := fake.verifyTwoFactorReturns
+	fake.recordInvocation("VerifyTwoFactor", []interface{}{arg1, arg2})
+	fake.verifyTwoFactorMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeUserService) VerifyTwoFactorCallCount() int {
+	fake.verifyTwoFactorMutex.RLock()
+	defer fake.verifyTwoFactorMutex.RUnlock()
+	return len(fake.verifyTwoFactorArgsForCall)
+}
+
+func (fake *FakeUserService) VerifyTwoFactorCalls(stub func(context.Context, service.VerifyTwoFactorRequest) (service.AuthenticateResponse, error)) {
+	fake.verifyTwoFactorMutex.Lock()
+	defer fake.verifyTwoFactorMutex.Unlock()
+	fake.VerifyTwoFactorStub = stub
+}
+
+func (fake *FakeUserService) VerifyTwoFactorArgsForCall(i int) (context.Context, service.VerifyTwoFactorRequest) {
+	fake.verifyTwoFactorMutex.RLock()
+	defer fake.verifyTwoFactorMutex.RUnlock()
+	argsForCall := fake.verifyTwoFactorArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeUserService) VerifyTwoFactorReturns(result1 service.AuthenticateResponse, result2 error) {
+	fake.verifyTwoFactorMutex.Lock()
+	defer fake.verifyTwoFactorMutex.Unlock()
+	fake.VerifyTwoFactorStub = nil
+	fake.verifyTwoFactorReturns = struct {
+		result1 service.AuthenticateResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUserService) VerifyTwoFactorReturnsOnCall(i int, result1 service.AuthenticateResponse, result2 error) {
+	fake.verifyTwoFactorMutex.Lock()
+	defer fake.verifyTwoFactorMutex.Unlock()
+	fake.VerifyTwoFactorStub = nil
+	if fake.verifyTwoFactorReturnsOnCall == nil {
+		fake.verifyTwoFactorReturnsOnCall = make(map[int]struct {
+			result1 service.AuthenticateResponse
+			result2 error
+		})
+	}
+	fake.verifyTwoFactorReturnsOnCall[i] = struct {
+		result1 service.AuthenticateResponse
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeUserService) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
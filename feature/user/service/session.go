@@ -0,0 +1,99 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/fikryfahrezy/let-it-go/feature/user/repository"
+	"github.com/fikryfahrezy/let-it-go/pkg/token"
+	"github.com/google/uuid"
+)
+
+const (
+	refreshTokenBytes  = 32
+	maxUserAgentLength = 255
+)
+
+// newSession builds a session together with the raw refresh token handed to the client.
+// Only the hash of the token is persisted. A nil familyID starts a new family whose ID
+// is the ID of its first session.
+func (s *userService) newSession(userID, familyID uuid.UUID, userAgent, ipAddress string) (repository.Session, string, error) {
+	refreshToken, err := token.GenerateOpaque(refreshTokenBytes)
+	if err != nil {
+		s.log.Error("Failed to generate refresh token",
+			slog.String("error", err.Error()),
+			slog.String("user_id", userID.String()),
+		)
+		return repository.Session{}, "", fmt.Errorf("%w: %w", ErrFailedToIssueRefreshToken, err)
+	}
+
+	id := uuid.Must(uuid.NewV7())
+	if familyID == uuid.Nil {
+		familyID = id
+	}
+
+	session := repository.Session{
+		ID:               id,
+		UserID:           userID,
+		FamilyID:         familyID,
+		RefreshTokenHash: token.Hash(refreshToken),
+		UserAgent:        truncateUserAgent(userAgent),
+		IPAddress:        ipAddress,
+		ExpiresAt:        time.Now().Add(s.tokenManager.RefreshTokenTTL()),
+	}
+
+	return session, refreshToken, nil
+}
+
+// truncateUserAgent cuts the user agent down to what the database stores
+func truncateUserAgent(userAgent string) string {
+	if len(userAgent) > maxUserAgentLength {
+		return userAgent[:maxUserAgentLength]
+	}
+	return userAgent
+}
+
+// startSession starts a new session family for the user, as every login does, and
+// issues its first token pair. setupRequired restricts the access token until the user
+// enrolls the second factor their role requires.
+func (s *userService) startSession(ctx context.Context, user repository.User, setupRequired bool, userAgent, ipAddress string) (AuthenticateResponse, error) {
+	session, refreshToken, err := s.newSession(user.ID, uuid.Nil, userAgent, ipAddress)
+	if err != nil {
+		return AuthenticateResponse{}, err
+	}
+
+	if err := s.userRepo.CreateSession(ctx, session); err != nil {
+		return AuthenticateResponse{}, err
+	}
+
+	return s.issueTokens(user, session, refreshToken, setupRequired)
+}
+
+// issueTokens signs an access token bound to the session family and pairs it with the refresh token
+func (s *userService) issueTokens(user repository.User, session repository.Session, refreshToken string, setupRequired bool) (AuthenticateResponse, error) {
+	accessToken, expiresAt, err := s.tokenManager.IssueAccessToken(token.Subject{
+		UserID:                 user.ID,
+		Email:                  user.Email,
+		Roles:                  []string{user.Role},
+		SessionID:              session.FamilyID,
+		TwoFactorSetupRequired: setupRequired,
+	})
+	if err != nil {
+		s.log.Error("Failed to issue access token",
+			slog.String("error", err.Error()),
+			slog.String("user_id", user.ID.String()),
+		)
+		return AuthenticateResponse{}, fmt.Errorf("%w: %w", ErrFailedToIssueToken, err)
+	}
+
+	return AuthenticateResponse{
+		AccessToken:            accessToken,
+		TokenType:              TokenTypeBearer,
+		ExpiresAt:              expiresAt,
+		RefreshToken:           refreshToken,
+		RefreshExpiresAt:       session.ExpiresAt,
+		TwoFactorSetupRequired: setupRequired,
+	}, nil
+}
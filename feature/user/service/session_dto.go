@@ -0,0 +1,42 @@
+package service
+
+import (
+	"time"
+
+	"github.com/fikryfahrezy/let-it-go/feature/user/repository"
+	"github.com/google/uuid"
+)
+
+type RefreshTokenRequest struct {
+	RefreshToken string `json:"refresh_token" validate:"required"`
+
+	// Filled by the handler from the HTTP request to describe the rotated session
+	UserAgent string `json:"-"`
+	IPAddress string `json:"-"`
+}
+
+type LogoutRequest struct {
+	RefreshToken string `json:"refresh_token" validate:"required"`
+}
+
+// SessionResponse describes one signed-in device. Its ID is the session family ID,
+// which stays the same across refresh token rotations.
+type SessionResponse struct {
+	ID         uuid.UUID `json:"id"`
+	UserAgent  string    `json:"user_agent"`
+	IPAddress  string    `json:"ip_address"`
+	Current    bool      `json:"current"`
+	LastUsedAt time.Time `json:"last_used_at"`
+	ExpiresAt  time.Time `json:"expires_at"`
+}
+
+func ToSessionResponse(s repository.Session, currentSessionID uuid.UUID) SessionResponse {
+	return SessionResponse{
+		ID:         s.FamilyID,
+		UserAgent:  s.UserAgent,
+		IPAddress:  s.IPAddress,
+		Current:    currentSessionID != uuid.Nil && s.FamilyID == currentSessionID,
+		LastUsedAt: s.CreatedAt,
+		ExpiresAt:  s.ExpiresAt,
+	}
+}
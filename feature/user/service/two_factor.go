@@ -0,0 +1,331 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base32"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/fikryfahrezy/let-it-go/feature/user/repository"
+	"github.com/fikryfahrezy/let-it-go/pkg/token"
+	"github.com/fikryfahrezy/let-it-go/pkg/totp"
+	"github.com/google/uuid"
+	"golang.org/x/crypto/bcrypt"
+)
+
+const (
+	recoveryCodeCount = 10
+	// totpSkew accepts codes from one step before and after the current one to tolerate clock drift
+	totpSkew                     = 1
+	defaultTwoFactorChallengeTTL = 5 * time.Minute
+	// twoFactorChallengePurpose is authenticated with every challenge so no other
+	// ciphertext made with the same key can pass for one
+	twoFactorChallengePurpose = "two-factor-challenge"
+)
+
+// twoFactorChallenge is the encrypted payload of a challenge token. It is stateless:
+// the token proves the password was checked and the second factor is still needed.
+type twoFactorChallenge struct {
+	UserID    uuid.UUID `json:"uid"`
+	ExpiresAt time.Time `json:"exp"`
+}
+
+// EnrollTwoFactor starts two factor enrollment with a new TOTP secret. The secret is
+// stored encrypted and only takes effect once ConfirmTwoFactor accepts a first code;
+// enrolling again before that replaces it.
+func (s *userService) EnrollTwoFactor(ctx context.Context, userID uuid.UUID) (EnrollTwoFactorResponse, error) {
+	if _, err := s.authorizeSelf(ctx, userID); err != nil {
+		return EnrollTwoFactorResponse{}, err
+	}
+
+	if s.cipher == nil {
+		return EnrollTwoFactorResponse{}, ErrTwoFactorUnavailable
+	}
+
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return EnrollTwoFactorResponse{}, err
+	}
+
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		s.log.Error("Failed to generate two factor secret",
+			slog.String("error", err.Error()),
+			slog.String("user_id", userID.String()),
+		)
+		return EnrollTwoFactorResponse{}, fmt.Errorf("%w: %w", ErrFailedToSetUpTwoFactor, err)
+	}
+
+	ciphertext, err := s.cipher.Encrypt([]byte(secret), twoFactorSecretAdditionalData(userID))
+	if err != nil {
+		s.log.Error("Failed to encrypt two factor secret",
+			slog.String("error", err.Error()),
+			slog.String("user_id", userID.String()),
+		)
+		return EnrollTwoFactorResponse{}, fmt.Errorf("%w: %w", ErrFailedToSetUpTwoFactor, err)
+	}
+
+	err = s.userRepo.SaveTwoFactorCredential(ctx, repository.TwoFactorCredential{
+		UserID:           userID,
+		SecretCiphertext: ciphertext,
+	})
+	if err != nil {
+		return EnrollTwoFactorResponse{}, err
+	}
+
+	s.log.Info("Two factor enrollment started",
+		slog.String("user_id", userID.String()),
+	)
+
+	return EnrollTwoFactorResponse{
+		Secret:     secret,
+		OTPAuthURI: totp.URI(s.config.TwoFactor.Issuer, user.Email, secret),
+	}, nil
+}
+
+// ConfirmTwoFactor enables two factor authentication once the user proves the
+// authenticator app works with a first code, and returns new recovery codes
+func (s *userService) ConfirmTwoFactor(ctx context.Context, userID uuid.UUID, req ConfirmTwoFactorRequest) (ConfirmTwoFactorResponse, error) {
+	if _, err := s.authorizeSelf(ctx, userID); err != nil {
+		return ConfirmTwoFactorResponse{}, err
+	}
+
+	if s.cipher == nil {
+		return ConfirmTwoFactorResponse{}, ErrTwoFactorUnavailable
+	}
+
+	credential, err := s.userRepo.GetTwoFactorCredential(ctx, userID)
+	if err != nil {
+		return ConfirmTwoFactorResponse{}, err
+	}
+
+	if credential.ConfirmedAt != nil {
+		return ConfirmTwoFactorResponse{}, repository.ErrTwoFactorAlreadyEnabled
+	}
+
+	secret, err := s.decryptTwoFactorSecret(credential)
+	if err != nil {
+		return ConfirmTwoFactorResponse{}, err
+	}
+
+	step, ok := totp.Validate(secret, req.Code, time.Now(), totpSkew)
+	if !ok {
+		s.log.Warn("Two factor confirmation failed, wrong code",
+			slog.String("user_id", userID.String()),
+		)
+		return ConfirmTwoFactorResponse{}, ErrInvalidTwoFactorCode
+	}
+
+	rawCodes, recoveryCodes, err := s.newRecoveryCodes(userID)
+	if err != nil {
+		return ConfirmTwoFactorResponse{}, err
+	}
+
+	if err := s.userRepo.EnableTwoFactor(ctx, userID, step, recoveryCodes); err != nil {
+		return ConfirmTwoFactorResponse{}, err
+	}
+
+	s.log.Info("Two factor authentication enabled",
+		slog.String("user_id", userID.String()),
+	)
+
+	return ConfirmTwoFactorResponse{RecoveryCodes: rawCodes}, nil
+}
+
+// DisableTwoFactor turns two factor authentication off and deletes the recovery codes.
+// Users confirm with their password; admins can disable it for another user who lost
+// both their device and their recovery codes.
+func (s *userService) DisableTwoFactor(ctx context.Context, userID uuid.UUID, req DisableTwoFactorRequest) error {
+	principal, err := s.authorizeSelfOrManager(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	if principal.UserID == userID {
+		user, err := s.userRepo.GetByID(ctx, userID)
+		if err != nil {
+			return err
+		}
+
+		if err := s.checkLockout(ctx, user.Email, req.IPAddress); err != nil {
+			return err
+		}
+
+		if err := bcrypt.CompareHashAndPassword([]byte(user.Password), []byte(req.Password)); err != nil {
+			s.log.Warn("Two factor disable failed, wrong password",
+				slog.String("user_id", userID.String()),
+			)
+			s.recordFailedAttempt(ctx, user.Email, req.IPAddress)
+			return ErrIncorrectCurrentPassword
+		}
+		s.recordSuccessfulAttempt(ctx, user.Email)
+	}
+
+	if err := s.userRepo.DisableTwoFactor(ctx, userID); err != nil {
+		if errors.Is(err, repository.ErrTwoFactorCredentialNotFound) {
+			return ErrTwoFactorNotEnabled
+		}
+		return err
+	}
+
+	s.log.Info("Two factor authentication disabled",
+		slog.String("user_id", userID.String()),
+		slog.String("disabled_by", principal.UserID.String()),
+	)
+
+	return nil
+}
+
+// twoFactorChallengeFor decides whether a login that passed the password check needs a
+// second factor. It returns a challenge when the user has two factor authentication
+// enabled, and otherwise whether the user's role is expected to enroll.
+func (s *userService) twoFactorChallengeFor(ctx context.Context, user repository.User) (*TwoFactorChallengeResponse, bool, error) {
+	credential, err := s.userRepo.GetTwoFactorCredential(ctx, user.ID)
+	if err != nil && !errors.Is(err, repository.ErrTwoFactorCredentialNotFound) {
+		return nil, false, err
+	}
+
+	// A pending enrollment does not protect the account yet
+	if err != nil || credential.ConfirmedAt == nil {
+		return nil, s.twoFactorRequiredFor(user), nil
+	}
+
+	// Letting the user in without the second factor would silently weaken the account
+	if s.cipher == nil {
+		s.log.Error("Two factor authentication is enabled for user but not configured",
+			slog.String("user_id", user.ID.String()),
+		)
+		return nil, false, ErrTwoFactorUnavailable
+	}
+
+	ttl := s.config.TwoFactor.ChallengeTTL
+	if ttl <= 0 {
+		ttl = defaultTwoFactorChallengeTTL
+	}
+
+	challenge := twoFactorChallenge{
+		UserID:    user.ID,
+		ExpiresAt: time.Now().Add(ttl).UTC(),
+	}
+	payload, err := json.Marshal(challenge)
+	if err != nil {
+		return nil, false, fmt.Errorf("%w: %w", ErrFailedToIssueToken, err)
+	}
+
+	challengeToken, err := s.cipher.Encrypt(payload, []byte(twoFactorChallengePurpose))
+	if err != nil {
+		s.log.Error("Failed to issue two factor challenge",
+			slog.String("error", err.Error()),
+			slog.String("user_id", user.ID.String()),
+		)
+		return nil, false, fmt.Errorf("%w: %w", ErrFailedToIssueToken, err)
+	}
+
+	return &TwoFactorChallengeResponse{
+		TwoFactorRequired: true,
+		ChallengeToken:    challengeToken,
+		ExpiresAt:         challenge.ExpiresAt,
+	}, false, nil
+}
+
+// twoFactorRequiredFor reports whether the user's role is expected to use two factor
+// authentication, which only applies while it is configured
+func (s *userService) twoFactorRequiredFor(user repository.User) bool {
+	return s.cipher != nil && slices.Contains(s.config.TwoFactor.RequiredRoles, user.Role)
+}
+
+// twoFactorSetupRequired reports whether the user's role is expected to use two factor
+// authentication the user has not enrolled yet. Their tokens are restricted until they do.
+func (s *userService) twoFactorSetupRequired(ctx context.Context, user repository.User) (bool, error) {
+	if !s.twoFactorRequiredFor(user) {
+		return false, nil
+	}
+
+	credential, err := s.userRepo.GetTwoFactorCredential(ctx, user.ID)
+	if errors.Is(err, repository.ErrTwoFactorCredentialNotFound) {
+		return true, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	return credential.ConfirmedAt == nil, nil
+}
+
+// openTwoFactorChallenge decrypts a challenge token and checks it has not expired
+func (s *userService) openTwoFactorChallenge(challengeToken string) (twoFactorChallenge, error) {
+	payload, err := s.cipher.Decrypt(challengeToken, []byte(twoFactorChallengePurpose))
+	if err != nil {
+		return twoFactorChallenge{}, ErrInvalidTwoFactorChallenge
+	}
+
+	var challenge twoFactorChallenge
+	if err := json.Unmarshal(payload, &challenge); err != nil {
+		return twoFactorChallenge{}, ErrInvalidTwoFactorChallenge
+	}
+
+	if !challenge.ExpiresAt.After(time.Now()) {
+		return twoFactorChallenge{}, ErrInvalidTwoFactorChallenge
+	}
+
+	return challenge, nil
+}
+
+func (s *userService) decryptTwoFactorSecret(credential repository.TwoFactorCredential) (string, error) {
+	secret, err := s.cipher.Decrypt(credential.SecretCiphertext, twoFactorSecretAdditionalData(credential.UserID))
+	if err != nil {
+		s.log.Error("Failed to decrypt two factor secret",
+			slog.String("error", err.Error()),
+			slog.String("user_id", credential.UserID.String()),
+		)
+		return "", fmt.Errorf("%w: %w", ErrFailedToSetUpTwoFactor, err)
+	}
+	return string(secret), nil
+}
+
+// twoFactorSecretAdditionalData binds an encrypted secret to its owner, so a
+// ciphertext copied to another user's row does not decrypt
+func twoFactorSecretAdditionalData(userID uuid.UUID) []byte {
+	return []byte("two-factor-secret:" + userID.String())
+}
+
+// newRecoveryCodes generates the raw recovery codes handed to the user together with
+// the hashed rows to store
+func (s *userService) newRecoveryCodes(userID uuid.UUID) ([]string, []repository.TwoFactorRecoveryCode, error) {
+	rawCodes := make([]string, 0, recoveryCodeCount)
+	recoveryCodes := make([]repository.TwoFactorRecoveryCode, 0, recoveryCodeCount)
+
+	for range recoveryCodeCount {
+		b := make([]byte, 8)
+		if _, err := rand.Read(b); err != nil {
+			s.log.Error("Failed to generate recovery codes",
+				slog.String("error", err.Error()),
+				slog.String("user_id", userID.String()),
+			)
+			return nil, nil, fmt.Errorf("%w: %w", ErrFailedToSetUpTwoFactor, err)
+		}
+
+		// Ten base32 characters, 50 random bits, shown as xxxxx-xxxxx
+		code := strings.ToLower(base32.StdEncoding.EncodeToString(b))[:10]
+		rawCodes = append(rawCodes, code[:5]+"-"+code[5:])
+		recoveryCodes = append(recoveryCodes, repository.TwoFactorRecoveryCode{
+			ID:       uuid.Must(uuid.NewV7()),
+			UserID:   userID,
+			CodeHash: token.Hash(code),
+		})
+	}
+
+	return rawCodes, recoveryCodes, nil
+}
+
+// normalizeRecoveryCode accepts a recovery code typed in any case, with or without the separator
+func normalizeRecoveryCode(code string) string {
+	code = strings.ToLower(code)
+	return strings.NewReplacer("-", "", " ", "").Replace(code)
+}
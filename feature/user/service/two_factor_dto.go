@@ -0,0 +1,44 @@
+package service
+
+import "time"
+
+type EnrollTwoFactorResponse struct {
+	Secret     string `json:"secret"`      // Base32 secret for entering the key by hand
+	OTPAuthURI string `json:"otpauth_uri"` // Rendered as a QR code for authenticator apps
+}
+
+type ConfirmTwoFactorRequest struct {
+	Code string `json:"code" validate:"required"`
+}
+
+// ConfirmTwoFactorResponse carries the raw recovery codes, which are only ever shown once
+type ConfirmTwoFactorResponse struct {
+	RecoveryCodes []string `json:"recovery_codes"`
+}
+
+type DisableTwoFactorRequest struct {
+	// Password of the caller; only optional for admins disabling another user's second factor
+	Password string `json:"password"`
+
+	// Filled by the handler from the HTTP request for brute-force protection
+	IPAddress string `json:"-"`
+}
+
+// TwoFactorChallengeResponse is returned by login instead of tokens when the user has
+// two factor authentication enabled
+type TwoFactorChallengeResponse struct {
+	TwoFactorRequired bool      `json:"two_factor_required"`
+	ChallengeToken    string    `json:"challenge_token"`
+	ExpiresAt         time.Time `json:"expires_at"`
+}
+
+type VerifyTwoFactorRequest struct {
+	ChallengeToken string `json:"challenge_token" validate:"required"`
+	// Either a code from the authenticator app or one of the recovery codes
+	Code         string `json:"code,omitempty" validate:"required_without=RecoveryCode"`
+	RecoveryCode string `json:"recovery_code,omitempty" validate:"required_without=Code"`
+
+	// Filled by the handler from the HTTP request to describe the new session
+	UserAgent string `json:"-"`
+	IPAddress string `json:"-"`
+}
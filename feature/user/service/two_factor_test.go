@@ -0,0 +1,552 @@
+package service_test
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/fikryfahrezy/let-it-go/feature/user/repository"
+	"github.com/fikryfahrezy/let-it-go/feature/user/repository/repositoryfakes"
+	"github.com/fikryfahrezy/let-it-go/feature/user/service"
+	"github.com/fikryfahrezy/let-it-go/pkg/encryption"
+	"github.com/fikryfahrezy/let-it-go/pkg/http_server"
+	"github.com/fikryfahrezy/let-it-go/pkg/logger"
+	"github.com/fikryfahrezy/let-it-go/pkg/rbac"
+	"github.com/fikryfahrezy/let-it-go/pkg/token"
+	"github.com/fikryfahrezy/let-it-go/pkg/totp"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+)
+
+var testTwoFactorConfig = service.Config{
+	TwoFactor: service.TwoFactorConfig{
+		Issuer:        "Let It Go",
+		ChallengeTTL:  5 * time.Minute,
+		RequiredRoles: []string{rbac.RoleAdmin, rbac.RoleEditor},
+	},
+}
+
+func newTestCipher(t *testing.T) *encryption.Cipher {
+	t.Helper()
+
+	cipher, err := encryption.NewCipher(base64.StdEncoding.EncodeToString([]byte(strings.Repeat("k", encryption.KeySize))))
+	require.NoError(t, err)
+
+	return cipher
+}
+
+// enrollTestTwoFactor runs the enrollment for user and returns the stored credential together with the raw secret
+func enrollTestTwoFactor(t *testing.T, cipher *encryption.Cipher, user repository.User) (repository.TwoFactorCredential, string) {
+	t.Helper()
+
+	mockRepo := &repositoryfakes.FakeUserRepository{}
+	mockRepo.GetByIDReturns(user, nil)
+	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{Cipher: cipher}, testTwoFactorConfig)
+
+	ctx := http_server.ContextWithPrincipal(context.Background(), http_server.Principal{UserID: user.ID})
+	result, err := userService.EnrollTwoFactor(ctx, user.ID)
+	require.NoError(t, err)
+
+	_, credential := mockRepo.SaveTwoFactorCredentialArgsForCall(0)
+	return credential, result.Secret
+}
+
+func TestUserService_EnrollTwoFactor_Success(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeUserRepository{}
+	cipher := newTestCipher(t)
+	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{Cipher: cipher}, testTwoFactorConfig)
+
+	user := newTestUserWithPassword(t, "password123", bcrypt.MinCost)
+	mockRepo.GetByIDReturns(user, nil)
+	ctx := http_server.ContextWithPrincipal(context.Background(), http_server.Principal{UserID: user.ID})
+
+	result, err := userService.EnrollTwoFactor(ctx, user.ID)
+	require.NoError(t, err)
+	assert.NotEmpty(t, result.Secret)
+	assert.True(t, strings.HasPrefix(result.OTPAuthURI, "otpauth://totp/Let%20It%20Go:john@example.com?"))
+	assert.Contains(t, result.OTPAuthURI, "secret="+result.Secret)
+
+	// Only the encrypted secret is stored
+	require.Equal(t, 1, mockRepo.SaveTwoFactorCredentialCallCount())
+	_, credential := mockRepo.SaveTwoFactorCredentialArgsForCall(0)
+	assert.Equal(t, user.ID, credential.UserID)
+	assert.NotContains(t, credential.SecretCiphertext, result.Secret)
+
+	// The ciphertext is bound to the user and does not decrypt for anyone else
+	_, err = cipher.Decrypt(credential.SecretCiphertext, []byte("two-factor-secret:"+uuid.NewString()))
+	assert.Error(t, err)
+}
+
+func TestUserService_EnrollTwoFactor_OtherUser(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeUserRepository{}
+	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{Cipher: newTestCipher(t)}, testTwoFactorConfig)
+
+	// Not even admins enroll a second factor on behalf of someone else
+	ctx := http_server.ContextWithPrincipal(context.Background(), http_server.Principal{UserID: uuid.New(), Roles: []string{rbac.RoleAdmin}})
+
+	_, err := userService.EnrollTwoFactor(ctx, uuid.New())
+	assert.Equal(t, service.ErrUserForbidden, err)
+	assert.Equal(t, 0, mockRepo.SaveTwoFactorCredentialCallCount())
+}
+
+func TestUserService_EnrollTwoFactor_Unavailable(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeUserRepository{}
+	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{}, testTwoFactorConfig)
+
+	userID := uuid.New()
+	ctx := http_server.ContextWithPrincipal(context.Background(), http_server.Principal{UserID: userID})
+
+	_, err := userService.EnrollTwoFactor(ctx, userID)
+	assert.Equal(t, service.ErrTwoFactorUnavailable, err)
+}
+
+func TestUserService_EnrollTwoFactor_AlreadyEnabled(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeUserRepository{}
+	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{Cipher: newTestCipher(t)}, testTwoFactorConfig)
+
+	user := newTestUserWithPassword(t, "password123", bcrypt.MinCost)
+	mockRepo.GetByIDReturns(user, nil)
+	mockRepo.SaveTwoFactorCredentialReturns(repository.ErrTwoFactorAlreadyEnabled)
+	ctx := http_server.ContextWithPrincipal(context.Background(), http_server.Principal{UserID: user.ID})
+
+	_, err := userService.EnrollTwoFactor(ctx, user.ID)
+	assert.Equal(t, repository.ErrTwoFactorAlreadyEnabled, err)
+}
+
+func TestUserService_ConfirmTwoFactor_Success(t *testing.T) {
+	cipher := newTestCipher(t)
+	user := newTestUserWithPassword(t, "password123", bcrypt.MinCost)
+	credential, secret := enrollTestTwoFactor(t, cipher, user)
+
+	mockRepo := &repositoryfakes.FakeUserRepository{}
+	mockRepo.GetTwoFactorCredentialReturns(credential, nil)
+	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{Cipher: cipher}, testTwoFactorConfig)
+	ctx := http_server.ContextWithPrincipal(context.Background(), http_server.Principal{UserID: user.ID})
+
+	step := totp.Step(time.Now())
+	code, err := totp.Code(secret, step)
+	require.NoError(t, err)
+
+	result, err := userService.ConfirmTwoFactor(ctx, user.ID, service.ConfirmTwoFactorRequest{Code: code})
+	require.NoError(t, err)
+	require.Len(t, result.RecoveryCodes, 10)
+
+	// The code's step is recorded as used and only hashes of the recovery codes are stored
+	require.Equal(t, 1, mockRepo.EnableTwoFactorCallCount())
+	_, actualUserID, actualStep, recoveryCodes := mockRepo.EnableTwoFactorArgsForCall(0)
+	assert.Equal(t, user.ID, actualUserID)
+	assert.Equal(t, step, actualStep)
+	require.Len(t, recoveryCodes, 10)
+	for i, recoveryCode := range recoveryCodes {
+		assert.Regexp(t, `^[a-z2-7]{5}-[a-z2-7]{5}$`, result.RecoveryCodes[i])
+		assert.Equal(t, token.Hash(strings.ReplaceAll(result.RecoveryCodes[i], "-", "")), recoveryCode.CodeHash)
+	}
+}
+
+func TestUserService_ConfirmTwoFactor_WrongCode(t *testing.T) {
+	cipher := newTestCipher(t)
+	user := newTestUserWithPassword(t, "password123", bcrypt.MinCost)
+	credential, secret := enrollTestTwoFactor(t, cipher, user)
+
+	mockRepo := &repositoryfakes.FakeUserRepository{}
+	mockRepo.GetTwoFactorCredentialReturns(credential, nil)
+	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{Cipher: cipher}, testTwoFactorConfig)
+	ctx := http_server.ContextWithPrincipal(context.Background(), http_server.Principal{UserID: user.ID})
+
+	// A code from an hour ago is outside the accepted drift
+	code, err := totp.Code(secret, totp.Step(time.Now().Add(-time.Hour)))
+	require.NoError(t, err)
+
+	_, err = userService.ConfirmTwoFactor(ctx, user.ID, service.ConfirmTwoFactorRequest{Code: code})
+	assert.Equal(t, service.ErrInvalidTwoFactorCode, err)
+	assert.Equal(t, 0, mockRepo.EnableTwoFactorCallCount())
+}
+
+func TestUserService_ConfirmTwoFactor_AlreadyEnabled(t *testing.T) {
+	cipher := newTestCipher(t)
+	user := newTestUserWithPassword(t, "password123", bcrypt.MinCost)
+	credential, _ := enrollTestTwoFactor(t, cipher, user)
+	confirmedAt := time.Now()
+	credential.ConfirmedAt = &confirmedAt
+
+	mockRepo := &repositoryfakes.FakeUserRepository{}
+	mockRepo.GetTwoFactorCredentialReturns(credential, nil)
+	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{Cipher: cipher}, testTwoFactorConfig)
+	ctx := http_server.ContextWithPrincipal(context.Background(), http_server.Principal{UserID: user.ID})
+
+	_, err := userService.ConfirmTwoFactor(ctx, user.ID, service.ConfirmTwoFactorRequest{Code: "123456"})
+	assert.Equal(t, repository.ErrTwoFactorAlreadyEnabled, err)
+}
+
+func TestUserService_ConfirmTwoFactor_NotEnrolled(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeUserRepository{}
+	mockRepo.GetTwoFactorCredentialReturns(repository.TwoFactorCredential{}, repository.ErrTwoFactorCredentialNotFound)
+	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{Cipher: newTestCipher(t)}, testTwoFactorConfig)
+
+	userID := uuid.New()
+	ctx := http_server.ContextWithPrincipal(context.Background(), http_server.Principal{UserID: userID})
+
+	_, err := userService.ConfirmTwoFactor(ctx, userID, service.ConfirmTwoFactorRequest{Code: "123456"})
+	assert.Equal(t, repository.ErrTwoFactorCredentialNotFound, err)
+}
+
+func TestUserService_DisableTwoFactor_Success(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeUserRepository{}
+	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{Cipher: newTestCipher(t)}, testTwoFactorConfig)
+
+	user := newTestUserWithPassword(t, "password123", bcrypt.MinCost)
+	mockRepo.GetByIDReturns(user, nil)
+	ctx := http_server.ContextWithPrincipal(context.Background(), http_server.Principal{UserID: user.ID})
+
+	err := userService.DisableTwoFactor(ctx, user.ID, service.DisableTwoFactorRequest{Password: "password123"})
+	require.NoError(t, err)
+
+	require.Equal(t, 1, mockRepo.DisableTwoFactorCallCount())
+	_, actualUserID := mockRepo.DisableTwoFactorArgsForCall(0)
+	assert.Equal(t, user.ID, actualUserID)
+}
+
+func TestUserService_DisableTwoFactor_WrongPassword(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeUserRepository{}
+	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{Cipher: newTestCipher(t)}, testTwoFactorConfig)
+
+	user := newTestUserWithPassword(t, "password123", bcrypt.MinCost)
+	mockRepo.GetByIDReturns(user, nil)
+	ctx := http_server.ContextWithPrincipal(context.Background(), http_server.Principal{UserID: user.ID})
+
+	err := userService.DisableTwoFactor(ctx, user.ID, service.DisableTwoFactorRequest{Password: "wrong-password"})
+	assert.Equal(t, service.ErrIncorrectCurrentPassword, err)
+	assert.Equal(t, 0, mockRepo.DisableTwoFactorCallCount())
+}
+
+func TestUserService_DisableTwoFactor_ByAdmin(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeUserRepository{}
+	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{Cipher: newTestCipher(t)}, testTwoFactorConfig)
+
+	// Admins disable another user's second factor without knowing their password
+	ctx := http_server.ContextWithPrincipal(context.Background(), http_server.Principal{UserID: uuid.New(), Roles: []string{rbac.RoleAdmin}})
+	userID := uuid.New()
+
+	err := userService.DisableTwoFactor(ctx, userID, service.DisableTwoFactorRequest{})
+	require.NoError(t, err)
+
+	assert.Equal(t, 0, mockRepo.GetByIDCallCount())
+	require.Equal(t, 1, mockRepo.DisableTwoFactorCallCount())
+	_, actualUserID := mockRepo.DisableTwoFactorArgsForCall(0)
+	assert.Equal(t, userID, actualUserID)
+}
+
+func TestUserService_DisableTwoFactor_Forbidden(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeUserRepository{}
+	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{Cipher: newTestCipher(t)}, testTwoFactorConfig)
+
+	ctx := http_server.ContextWithPrincipal(context.Background(), http_server.Principal{UserID: uuid.New(), Roles: []string{rbac.RoleEditor}})
+
+	err := userService.DisableTwoFactor(ctx, uuid.New(), service.DisableTwoFactorRequest{Password: "password123"})
+	assert.Equal(t, service.ErrUserForbidden, err)
+	assert.Equal(t, 0, mockRepo.DisableTwoFactorCallCount())
+}
+
+func TestUserService_DisableTwoFactor_NotEnabled(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeUserRepository{}
+	mockRepo.DisableTwoFactorReturns(repository.ErrTwoFactorCredentialNotFound)
+	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{Cipher: newTestCipher(t)}, testTwoFactorConfig)
+
+	ctx := http_server.ContextWithPrincipal(context.Background(), http_server.Principal{UserID: uuid.New(), Roles: []string{rbac.RoleAdmin}})
+
+	err := userService.DisableTwoFactor(ctx, uuid.New(), service.DisableTwoFactorRequest{})
+	assert.Equal(t, service.ErrTwoFactorNotEnabled, err)
+}
+
+// newTwoFactorLoginFixture returns a service whose user has two factor authentication
+// enabled, along with the user and their raw TOTP secret
+func newTwoFactorLoginFixture(t *testing.T) (*repositoryfakes.FakeUserRepository, service.UserService, repository.User, string) {
+	t.Helper()
+
+	cipher := newTestCipher(t)
+	user := newTestUserWithPassword(t, "password123", bcrypt.MinCost)
+	user.Role = rbac.RoleEditor
+	credential, secret := enrollTestTwoFactor(t, cipher, user)
+	confirmedAt := time.Now()
+	credential.ConfirmedAt = &confirmedAt
+
+	mockRepo := &repositoryfakes.FakeUserRepository{}
+	mockRepo.GetByEmailReturns(user, nil)
+	mockRepo.GetByIDReturns(user, nil)
+	mockRepo.GetTwoFactorCredentialReturns(credential, nil)
+	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{TokenManager: newTestTokenManager(t), Limiter: newTestLimiter(), Cipher: cipher}, testTwoFactorConfig)
+
+	return mockRepo, userService, user, secret
+}
+
+// loginForChallenge logs in with the right password and returns the two factor challenge token
+func loginForChallenge(t *testing.T, userService service.UserService, user repository.User) string {
+	t.Helper()
+
+	result, err := userService.Authenticate(context.Background(), service.AuthenticateRequest{
+		Email:    user.Email,
+		Password: "password123",
+	})
+	require.NoError(t, err)
+	require.NotNil(t, result.TwoFactorChallenge)
+
+	return result.TwoFactorChallenge.ChallengeToken
+}
+
+func TestUserService_Authenticate_TwoFactorChallenge(t *testing.T) {
+	mockRepo, userService, user, _ := newTwoFactorLoginFixture(t)
+
+	result, err := userService.Authenticate(context.Background(), service.AuthenticateRequest{
+		Email:    user.Email,
+		Password: "password123",
+	})
+	require.NoError(t, err)
+
+	// No tokens until the second factor is verified
+	assert.Empty(t, result.AccessToken)
+	assert.Empty(t, result.RefreshToken)
+	require.NotNil(t, result.TwoFactorChallenge)
+	assert.True(t, result.TwoFactorChallenge.TwoFactorRequired)
+	assert.NotEmpty(t, result.TwoFactorChallenge.ChallengeToken)
+	assert.WithinDuration(t, time.Now().Add(5*time.Minute), result.TwoFactorChallenge.ExpiresAt, time.Minute)
+	assert.Equal(t, 0, mockRepo.CreateSessionCallCount())
+}
+
+func TestUserService_Authenticate_TwoFactorSetupRequired(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeUserRepository{}
+	mockRepo.GetTwoFactorCredentialReturns(repository.TwoFactorCredential{}, repository.ErrTwoFactorCredentialNotFound)
+	tokenManager := newTestTokenManager(t)
+	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{TokenManager: tokenManager, Cipher: newTestCipher(t)}, testTwoFactorConfig)
+
+	user := newTestUserWithPassword(t, "password123", bcrypt.MinCost)
+	user.Role = rbac.RoleAdmin
+	mockRepo.GetByEmailReturns(user, nil)
+
+	result, err := userService.Authenticate(context.Background(), service.AuthenticateRequest{
+		Email:    user.Email,
+		Password: "password123",
+	})
+	require.NoError(t, err)
+	assert.NotEmpty(t, result.AccessToken)
+	assert.True(t, result.TwoFactorSetupRequired)
+
+	// Until the admin enrolls, the access token grants none of the admin's permissions
+	principal, err := tokenManager.Authenticate(context.Background(), result.AccessToken)
+	require.NoError(t, err)
+	assert.True(t, principal.TwoFactorSetupRequired)
+	assert.False(t, rbac.Can(principal, rbac.PermissionUsersManage))
+
+	// Authors are not expected to enroll
+	user.Role = rbac.RoleAuthor
+	mockRepo.GetByEmailReturns(user, nil)
+
+	result, err = userService.Authenticate(context.Background(), service.AuthenticateRequest{
+		Email:    user.Email,
+		Password: "password123",
+	})
+	require.NoError(t, err)
+	assert.False(t, result.TwoFactorSetupRequired)
+}
+
+func TestUserService_RefreshToken_TwoFactorSetupRequired(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeUserRepository{}
+	tokenManager := newTestTokenManager(t)
+	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{TokenManager: tokenManager, Cipher: newTestCipher(t)}, testTwoFactorConfig)
+
+	user := repository.User{ID: uuid.New(), Email: "admin@example.com", Role: rbac.RoleAdmin}
+	mockRepo.GetByIDReturns(user, nil)
+	mockRepo.GetSessionByRefreshTokenHashReturns(newTestSession(user.ID, "refresh-token"), nil)
+	mockRepo.GetTwoFactorCredentialReturns(repository.TwoFactorCredential{}, repository.ErrTwoFactorCredentialNotFound)
+
+	result, err := userService.RefreshToken(context.Background(), service.RefreshTokenRequest{RefreshToken: "refresh-token"})
+	require.NoError(t, err)
+	assert.True(t, result.TwoFactorSetupRequired)
+	principal, err := tokenManager.Authenticate(context.Background(), result.AccessToken)
+	require.NoError(t, err)
+	assert.False(t, rbac.Can(principal, rbac.PermissionUsersManage))
+
+	// Once enrolled, refreshing gives back the admin's permissions
+	confirmedAt := time.Now()
+	mockRepo.GetSessionByRefreshTokenHashReturns(newTestSession(user.ID, "refresh-token"), nil)
+	mockRepo.GetTwoFactorCredentialReturns(repository.TwoFactorCredential{UserID: user.ID, ConfirmedAt: &confirmedAt}, nil)
+
+	result, err = userService.RefreshToken(context.Background(), service.RefreshTokenRequest{RefreshToken: "refresh-token"})
+	require.NoError(t, err)
+	assert.False(t, result.TwoFactorSetupRequired)
+	principal, err = tokenManager.Authenticate(context.Background(), result.AccessToken)
+	require.NoError(t, err)
+	assert.True(t, rbac.Can(principal, rbac.PermissionUsersManage))
+}
+
+func TestUserService_Authenticate_TwoFactorEnabledButUnavailable(t *testing.T) {
+	mockRepo, _, user, _ := newTwoFactorLoginFixture(t)
+	// The encryption key was removed from the configuration
+	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{TokenManager: newTestTokenManager(t)}, testTwoFactorConfig)
+
+	_, err := userService.Authenticate(context.Background(), service.AuthenticateRequest{
+		Email:    user.Email,
+		Password: "password123",
+	})
+	assert.Equal(t, service.ErrTwoFactorUnavailable, err)
+	assert.Equal(t, 0, mockRepo.CreateSessionCallCount())
+}
+
+func TestUserService_VerifyTwoFactor_Success(t *testing.T) {
+	mockRepo, userService, user, secret := newTwoFactorLoginFixture(t)
+	challengeToken := loginForChallenge(t, userService, user)
+
+	step := totp.Step(time.Now())
+	code, err := totp.Code(secret, step)
+	require.NoError(t, err)
+
+	result, err := userService.VerifyTwoFactor(context.Background(), service.VerifyTwoFactorRequest{
+		ChallengeToken: challengeToken,
+		Code:           code,
+		UserAgent:      "test-agent",
+		IPAddress:      "127.0.0.1",
+	})
+	require.NoError(t, err)
+	assert.NotEmpty(t, result.AccessToken)
+	assert.NotEmpty(t, result.RefreshToken)
+
+	// The code's step is consumed and a session started
+	require.Equal(t, 1, mockRepo.UseTwoFactorStepCallCount())
+	_, actualUserID, actualStep := mockRepo.UseTwoFactorStepArgsForCall(0)
+	assert.Equal(t, user.ID, actualUserID)
+	assert.Equal(t, step, actualStep)
+	require.Equal(t, 1, mockRepo.CreateSessionCallCount())
+	_, session := mockRepo.CreateSessionArgsForCall(0)
+	assert.Equal(t, user.ID, session.UserID)
+	assert.Equal(t, "test-agent", session.UserAgent)
+}
+
+func TestUserService_VerifyTwoFactor_RecoveryCode(t *testing.T) {
+	mockRepo, userService, user, _ := newTwoFactorLoginFixture(t)
+	challengeToken := loginForChallenge(t, userService, user)
+
+	result, err := userService.VerifyTwoFactor(context.Background(), service.VerifyTwoFactorRequest{
+		ChallengeToken: challengeToken,
+		RecoveryCode:   "ABCDE-FGHIJ",
+	})
+	require.NoError(t, err)
+	assert.NotEmpty(t, result.AccessToken)
+
+	// Recovery codes are matched case-insensitively without the separator
+	require.Equal(t, 1, mockRepo.UseRecoveryCodeCallCount())
+	_, actualUserID, codeHash := mockRepo.UseRecoveryCodeArgsForCall(0)
+	assert.Equal(t, user.ID, actualUserID)
+	assert.Equal(t, token.Hash("abcdefghij"), codeHash)
+	assert.Equal(t, 0, mockRepo.UseTwoFactorStepCallCount())
+}
+
+func TestUserService_VerifyTwoFactor_UsedRecoveryCode(t *testing.T) {
+	mockRepo, userService, user, _ := newTwoFactorLoginFixture(t)
+	mockRepo.UseRecoveryCodeReturns(repository.ErrRecoveryCodeNotFound)
+	challengeToken := loginForChallenge(t, userService, user)
+
+	_, err := userService.VerifyTwoFactor(context.Background(), service.VerifyTwoFactorRequest{
+		ChallengeToken: challengeToken,
+		RecoveryCode:   "abcde-fghij",
+	})
+	assert.Equal(t, service.ErrInvalidTwoFactorCode, err)
+	assert.Equal(t, 0, mockRepo.CreateSessionCallCount())
+}
+
+func TestUserService_VerifyTwoFactor_ReplayedCode(t *testing.T) {
+	mockRepo, userService, user, secret := newTwoFactorLoginFixture(t)
+	mockRepo.UseTwoFactorStepReturns(repository.ErrTwoFactorCodeAlreadyUsed)
+	challengeToken := loginForChallenge(t, userService, user)
+
+	code, err := totp.Code(secret, totp.Step(time.Now()))
+	require.NoError(t, err)
+
+	_, err = userService.VerifyTwoFactor(context.Background(), service.VerifyTwoFactorRequest{
+		ChallengeToken: challengeToken,
+		Code:           code,
+	})
+	assert.Equal(t, service.ErrInvalidTwoFactorCode, err)
+	assert.Equal(t, 0, mockRepo.CreateSessionCallCount())
+}
+
+func TestUserService_VerifyTwoFactor_LockedAfterWrongCodes(t *testing.T) {
+	mockRepo, userService, user, secret := newTwoFactorLoginFixture(t)
+	challengeToken := loginForChallenge(t, userService, user)
+
+	for range 3 {
+		_, err := userService.VerifyTwoFactor(context.Background(), service.VerifyTwoFactorRequest{
+			ChallengeToken: challengeToken,
+			Code:           "000000",
+		})
+		assert.Equal(t, service.ErrInvalidTwoFactorCode, err)
+	}
+
+	// Even the right code is refused while locked
+	code, err := totp.Code(secret, totp.Step(time.Now()))
+	require.NoError(t, err)
+
+	_, err = userService.VerifyTwoFactor(context.Background(), service.VerifyTwoFactorRequest{
+		ChallengeToken: challengeToken,
+		Code:           code,
+	})
+	assert.True(t, errors.Is(err, service.ErrAccountLocked))
+	assert.Equal(t, 0, mockRepo.CreateSessionCallCount())
+}
+
+func TestUserService_VerifyTwoFactor_PasswordLoginKeepsFailedAttempts(t *testing.T) {
+	mockRepo, userService, user, _ := newTwoFactorLoginFixture(t)
+
+	// Each wrong code comes from another client after a fresh password login
+	for i, ip := range []string{"10.0.0.1", "10.0.0.2", "10.0.0.3"} {
+		result, err := userService.Authenticate(context.Background(), service.AuthenticateRequest{
+			Email:     user.Email,
+			Password:  "password123",
+			IPAddress: ip,
+		})
+		require.NoError(t, err, "attempt %d", i)
+		require.NotNil(t, result.TwoFactorChallenge)
+
+		_, err = userService.VerifyTwoFactor(context.Background(), service.VerifyTwoFactorRequest{
+			ChallengeToken: result.TwoFactorChallenge.ChallengeToken,
+			Code:           "000000",
+			IPAddress:      ip,
+		})
+		assert.Equal(t, service.ErrInvalidTwoFactorCode, err)
+	}
+
+	// The wrong codes still count against the account, so even the password is refused
+	_, err := userService.Authenticate(context.Background(), service.AuthenticateRequest{
+		Email:     user.Email,
+		Password:  "password123",
+		IPAddress: "10.0.0.4",
+	})
+	assert.True(t, errors.Is(err, service.ErrAccountLocked))
+	assert.Equal(t, 0, mockRepo.CreateSessionCallCount())
+}
+
+func TestUserService_VerifyTwoFactor_InvalidChallenge(t *testing.T) {
+	mockRepo, userService, _, _ := newTwoFactorLoginFixture(t)
+
+	_, err := userService.VerifyTwoFactor(context.Background(), service.VerifyTwoFactorRequest{
+		ChallengeToken: "not-a-challenge",
+		Code:           "123456",
+	})
+	assert.Equal(t, service.ErrInvalidTwoFactorChallenge, err)
+	assert.Equal(t, 0, mockRepo.GetByIDCallCount())
+}
+
+func TestUserService_VerifyTwoFactor_DisabledAfterChallenge(t *testing.T) {
+	mockRepo, userService, user, _ := newTwoFactorLoginFixture(t)
+	challengeToken := loginForChallenge(t, userService, user)
+	mockRepo.GetTwoFactorCredentialReturns(repository.TwoFactorCredential{}, repository.ErrTwoFactorCredentialNotFound)
+
+	_, err := userService.VerifyTwoFactor(context.Background(), service.VerifyTwoFactorRequest{
+		ChallengeToken: challengeToken,
+		Code:           "123456",
+	})
+	assert.Equal(t, service.ErrInvalidTwoFactorChallenge, err)
+}
@@ -0,0 +1,45 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/fikryfahrezy/let-it-go/pkg/http_server"
+	"github.com/fikryfahrezy/let-it-go/pkg/rbac"
+	"github.com/google/uuid"
+)
+
+// UnlockUser lifts the lockout of a user's account and forgets its failed attempts.
+// Lockouts of client IPs are left to expire.
+func (s *userService) UnlockUser(ctx context.Context, userID uuid.UUID) error {
+	principal, ok := http_server.PrincipalFromContext(ctx)
+	if !ok || !rbac.Can(principal, rbac.PermissionUsersManage) {
+		s.log.Warn("Account unlock denied",
+			slog.String("user_id", userID.String()),
+			slog.String("caller_id", principal.UserID.String()),
+		)
+		return ErrUserForbidden
+	}
+
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	if s.limiter != nil {
+		if err := s.limiter.Unlock(ctx, user.Email); err != nil {
+			s.log.Error("Failed to unlock account",
+				slog.String("error", err.Error()),
+				slog.String("user_id", userID.String()),
+			)
+			return err
+		}
+	}
+
+	s.log.Info("Account unlocked successfully",
+		slog.String("user_id", userID.String()),
+		slog.String("caller_id", principal.UserID.String()),
+	)
+
+	return nil
+}
@@ -0,0 +1,50 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/fikryfahrezy/let-it-go/feature/user/repository"
+	"github.com/google/uuid"
+)
+
+// UpdateAuthorProfile sets up or replaces the public author profile of a user
+func (s *userService) UpdateAuthorProfile(ctx context.Context, userID uuid.UUID, req UpdateAuthorProfileRequest) (GetUserResponse, error) {
+	s.log.Info("Updating author profile",
+		slog.String("user_id", userID.String()),
+	)
+
+	if _, err := s.authorizeSelfOrManager(ctx, userID); err != nil {
+		return GetUserResponse{}, err
+	}
+
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return GetUserResponse{}, err
+	}
+
+	profile := repository.AuthorProfile{
+		UserID:      userID,
+		Handle:      req.Handle,
+		Bio:         req.Bio,
+		AvatarURL:   req.AvatarURL,
+		WebsiteURL:  req.WebsiteURL,
+		SocialLinks: req.SocialLinks,
+	}
+	if err := s.userRepo.SaveAuthorProfile(ctx, profile); err != nil {
+		if errors.Is(err, repository.ErrHandleAlreadyTaken) {
+			s.log.Warn("Handle already taken",
+				slog.String("handle", req.Handle),
+			)
+		}
+		return GetUserResponse{}, err
+	}
+
+	s.log.Info("Author profile updated successfully",
+		slog.String("user_id", userID.String()),
+		slog.String("handle", req.Handle),
+	)
+
+	return ToGetUserResponseWithAuthorProfile(user, profile), nil
+}
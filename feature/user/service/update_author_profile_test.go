@@ -0,0 +1,99 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/fikryfahrezy/let-it-go/feature/user/repository"
+	"github.com/fikryfahrezy/let-it-go/feature/user/repository/repositoryfakes"
+	"github.com/fikryfahrezy/let-it-go/feature/user/service"
+	"github.com/fikryfahrezy/let-it-go/pkg/http_server"
+	"github.com/fikryfahrezy/let-it-go/pkg/logger"
+	"github.com/fikryfahrezy/let-it-go/pkg/rbac"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUserService_UpdateAuthorProfile_Success(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeUserRepository{}
+	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{}, service.Config{})
+
+	userID := uuid.New()
+	ctx := http_server.ContextWithPrincipal(context.Background(), http_server.Principal{
+		UserID: userID,
+		Roles:  []string{rbac.RoleAuthor},
+	})
+	mockRepo.GetByIDReturns(repository.User{ID: userID, Name: "Jane Doe", Email: "jane@example.com"}, nil)
+
+	result, err := userService.UpdateAuthorProfile(ctx, userID, service.UpdateAuthorProfileRequest{
+		Handle:      "jane",
+		Bio:         "Writes about Go",
+		WebsiteURL:  "https://jane.example.com",
+		SocialLinks: map[string]string{"github": "jane"},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "jane", result.Handle)
+	assert.Equal(t, "jane@example.com", result.Email)
+	assert.Equal(t, "https://jane.example.com", result.WebsiteURL)
+
+	require.Equal(t, 1, mockRepo.SaveAuthorProfileCallCount())
+	_, saved := mockRepo.SaveAuthorProfileArgsForCall(0)
+	assert.Equal(t, userID, saved.UserID)
+	assert.Equal(t, "jane", saved.Handle)
+	assert.Equal(t, map[string]string{"github": "jane"}, saved.SocialLinks)
+}
+
+func TestUserService_UpdateAuthorProfile_OtherUser(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeUserRepository{}
+	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{}, service.Config{})
+
+	ctx := http_server.ContextWithPrincipal(context.Background(), http_server.Principal{
+		UserID: uuid.New(),
+		Roles:  []string{rbac.RoleAuthor},
+	})
+
+	_, err := userService.UpdateAuthorProfile(ctx, uuid.New(), service.UpdateAuthorProfileRequest{Handle: "jane"})
+
+	assert.Equal(t, service.ErrUserForbidden, err)
+	assert.Equal(t, 0, mockRepo.SaveAuthorProfileCallCount())
+}
+
+func TestUserService_UpdateAuthorProfile_ByAdmin(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeUserRepository{}
+	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{}, service.Config{})
+
+	userID := uuid.New()
+	mockRepo.GetByIDReturns(repository.User{ID: userID}, nil)
+
+	_, err := userService.UpdateAuthorProfile(adminContext(), userID, service.UpdateAuthorProfileRequest{Handle: "jane"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, mockRepo.SaveAuthorProfileCallCount())
+}
+
+func TestUserService_UpdateAuthorProfile_HandleTaken(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeUserRepository{}
+	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{}, service.Config{})
+
+	userID := uuid.New()
+	mockRepo.GetByIDReturns(repository.User{ID: userID}, nil)
+	mockRepo.SaveAuthorProfileReturns(repository.ErrHandleAlreadyTaken)
+
+	_, err := userService.UpdateAuthorProfile(adminContext(), userID, service.UpdateAuthorProfileRequest{Handle: "taken"})
+
+	assert.Equal(t, repository.ErrHandleAlreadyTaken, err)
+}
+
+func TestUserService_UpdateAuthorProfile_UserNotFound(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeUserRepository{}
+	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{}, service.Config{})
+
+	mockRepo.GetByIDReturns(repository.User{}, repository.ErrUserNotFound)
+
+	_, err := userService.UpdateAuthorProfile(adminContext(), uuid.New(), service.UpdateAuthorProfileRequest{Handle: "jane"})
+
+	assert.Equal(t, repository.ErrUserNotFound, err)
+	assert.Equal(t, 0, mockRepo.SaveAuthorProfileCallCount())
+}
@@ -14,6 +14,10 @@ func (s *userService) UpdateUser(ctx context.Context, id uuid.UUID, req UpdateUs
 		slog.String("user_id", id.String()),
 	)
 
+	if _, err := s.authorizeSelfOrManager(ctx, id); err != nil {
+		return UpdateUserResponse{}, err
+	}
+
 	user, err := s.userRepo.GetByID(ctx, id)
 	if err != nil {
 		if errors.Is(err, repository.ErrUserNotFound) {
@@ -25,7 +29,8 @@ func (s *userService) UpdateUser(ctx context.Context, id uuid.UUID, req UpdateUs
 		return UpdateUserResponse{}, err
 	}
 
-	if req.Email != "" && req.Email != user.Email {
+	emailChanged := req.Email != "" && req.Email != user.Email
+	if emailChanged {
 		existingUser, err := s.userRepo.GetByEmail(ctx, req.Email)
 		if err != nil {
 			// If it's not a "not found" error, it's a real database issue
@@ -41,7 +46,7 @@ func (s *userService) UpdateUser(ctx context.Context, id uuid.UUID, req UpdateUs
 			return UpdateUserResponse{}, ErrUserAlreadyExists
 		}
 
-		user.Email = req.Email
+		changeEmail(&user, req.Email)
 	}
 
 	if req.Name != "" {
@@ -49,9 +54,26 @@ func (s *userService) UpdateUser(ctx context.Context, id uuid.UUID, req UpdateUs
 	}
 
 	if err := s.userRepo.Update(ctx, user); err != nil {
+		// Deleted users keep their email until they are erased
+		if errors.Is(err, repository.ErrEmailAlreadyTaken) {
+			s.log.Warn("Email already exists",
+				slog.String("email", user.Email),
+			)
+			return UpdateUserResponse{}, ErrUserAlreadyExists
+		}
 		return UpdateUserResponse{}, err
 	}
 
+	// The new address needs confirming; the update is saved, so a failed email must not fail it
+	if emailChanged {
+		if err := s.sendVerificationEmail(ctx, user); err != nil {
+			s.log.Error("Failed to send verification email",
+				slog.String("error", err.Error()),
+				slog.String("email", user.Email),
+			)
+		}
+	}
+
 	response := ToUpdateUserResponse(user)
 	s.log.Info("User updated successfully",
 		slog.String("user_id", id.String()),
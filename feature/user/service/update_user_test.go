@@ -1,24 +1,29 @@
 package service_test
 
 import (
+	"bytes"
 	"context"
+	"io"
 	"testing"
 	"time"
 
 	"github.com/fikryfahrezy/let-it-go/feature/user/repository"
 	"github.com/fikryfahrezy/let-it-go/feature/user/repository/repositoryfakes"
 	"github.com/fikryfahrezy/let-it-go/feature/user/service"
+	"github.com/fikryfahrezy/let-it-go/pkg/http_server"
 	"github.com/fikryfahrezy/let-it-go/pkg/logger"
+	"github.com/fikryfahrezy/let-it-go/pkg/mailer"
+	"github.com/fikryfahrezy/let-it-go/pkg/rbac"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestUserService_UpdateUser_Success(t *testing.T) {
 	mockRepo := &repositoryfakes.FakeUserRepository{}
-	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo)
-	ctx := context.Background()
-
+	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{Mailer: mailer.NewWriterMailer("", io.Discard)}, service.Config{})
 	userID := uuid.New()
+	ctx := http_server.ContextWithPrincipal(context.Background(), http_server.Principal{UserID: userID})
 	existingUser := repository.User{
 		ID:        userID,
 		Name:      "Old Name",
@@ -52,12 +57,56 @@ func TestUserService_UpdateUser_Success(t *testing.T) {
 	assert.Equal(t, req.Email, actualUser.Email)
 }
 
-func TestUserService_UpdateUser_NotFound(t *testing.T) {
+func TestUserService_UpdateUser_EmailChangeNeedsVerification(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeUserRepository{}
+	var outbox bytes.Buffer
+	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{Mailer: mailer.NewWriterMailer("no-reply@example.com", &outbox)}, service.Config{})
+	userID := uuid.New()
+	ctx := http_server.ContextWithPrincipal(context.Background(), http_server.Principal{UserID: userID})
+	verifiedAt := time.Now().Add(-time.Hour)
+	mockRepo.GetByIDReturns(repository.User{ID: userID, Name: "John Doe", Email: "old@example.com", VerifiedAt: &verifiedAt}, nil)
+	mockRepo.GetByEmailReturns(repository.User{}, repository.ErrUserNotFound)
+
+	_, err := userService.UpdateUser(ctx, userID, service.UpdateUserRequest{Email: "new@example.com"})
+	require.NoError(t, err)
+
+	// Confirming the old address says nothing about the new one
+	require.Equal(t, 1, mockRepo.UpdateCallCount())
+	_, actualUser := mockRepo.UpdateArgsForCall(0)
+	assert.Equal(t, "new@example.com", actualUser.Email)
+	assert.Nil(t, actualUser.VerifiedAt)
+
+	require.Equal(t, 1, mockRepo.CreateEmailVerificationTokenCallCount())
+	_, actualEmail, _ := mockRepo.CreateEmailVerificationTokenArgsForCall(0)
+	assert.Equal(t, "new@example.com", actualEmail)
+	assert.Contains(t, outbox.String(), "To: new@example.com")
+}
+
+func TestUserService_UpdateUser_NameChangeKeepsVerification(t *testing.T) {
 	mockRepo := &repositoryfakes.FakeUserRepository{}
-	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo)
-	ctx := context.Background()
+	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{}, service.Config{})
+	userID := uuid.New()
+	ctx := http_server.ContextWithPrincipal(context.Background(), http_server.Principal{UserID: userID})
+	verifiedAt := time.Now().Add(-time.Hour)
+	mockRepo.GetByIDReturns(repository.User{ID: userID, Name: "John Doe", Email: "john@example.com", VerifiedAt: &verifiedAt}, nil)
+
+	_, err := userService.UpdateUser(ctx, userID, service.UpdateUserRequest{Name: "John Updated", Email: "john@example.com"})
+	require.NoError(t, err)
 
+	_, actualUser := mockRepo.UpdateArgsForCall(0)
+	assert.Equal(t, &verifiedAt, actualUser.VerifiedAt)
+	assert.Equal(t, 0, mockRepo.CreateEmailVerificationTokenCallCount())
+}
+
+func TestUserService_UpdateUser_NotFound(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeUserRepository{}
+	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{}, service.Config{})
 	userID := uuid.New()
+	// Admins may change any user
+	ctx := http_server.ContextWithPrincipal(context.Background(), http_server.Principal{
+		UserID: uuid.New(),
+		Roles:  []string{rbac.RoleAdmin},
+	})
 	mockRepo.GetByIDReturns(repository.User{}, repository.ErrUserNotFound)
 
 	req := service.UpdateUserRequest{
@@ -75,3 +124,37 @@ func TestUserService_UpdateUser_NotFound(t *testing.T) {
 	assert.Equal(t, 1, mockRepo.GetByIDCallCount())
 	assert.Equal(t, 0, mockRepo.UpdateCallCount()) // Update should not be called
 }
+
+func TestUserService_UpdateUser_OtherUser(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeUserRepository{}
+	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{}, service.Config{})
+
+	// Editors can publish any blog but cannot manage other users
+	ctx := http_server.ContextWithPrincipal(context.Background(), http_server.Principal{
+		UserID: uuid.New(),
+		Roles:  []string{rbac.RoleEditor},
+	})
+
+	result, err := userService.UpdateUser(ctx, uuid.New(), service.UpdateUserRequest{Name: "New Name"})
+
+	assert.Equal(t, service.ErrUserForbidden, err)
+	assert.Equal(t, service.UpdateUserResponse{}, result)
+	assert.Equal(t, 0, mockRepo.UpdateCallCount())
+}
+
+func TestUserService_UpdateUser_EmailOfDeletedUser(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeUserRepository{}
+	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{}, service.Config{})
+	userID := uuid.New()
+	ctx := http_server.ContextWithPrincipal(context.Background(), http_server.Principal{UserID: userID})
+
+	// Deleted users are hidden from lookups but keep their email until they are erased
+	mockRepo.GetByIDReturns(repository.User{ID: userID, Name: "John Doe", Email: "old@example.com"}, nil)
+	mockRepo.GetByEmailReturns(repository.User{}, repository.ErrUserNotFound)
+	mockRepo.UpdateReturns(repository.ErrEmailAlreadyTaken)
+
+	result, err := userService.UpdateUser(ctx, userID, service.UpdateUserRequest{Email: "deleted@example.com"})
+
+	assert.Equal(t, service.ErrUserAlreadyExists, err)
+	assert.Equal(t, service.UpdateUserResponse{}, result)
+}
@@ -0,0 +1,32 @@
+package service
+
+import (
+	"time"
+
+	blogRepository "github.com/fikryfahrezy/let-it-go/feature/blog/repository"
+	"github.com/google/uuid"
+)
+
+// UserProfileResponse is a user together with a summary of their writing and activity
+type UserProfileResponse struct {
+	User GetUserResponse `json:"user"`
+	// BlogCounts is the number of the user's blogs per status
+	BlogCounts  map[string]int64      `json:"blog_counts"`
+	RecentPosts []ProfilePostResponse `json:"recent_posts"`
+	// LastActiveAt is the latest of the profile update, sign-in, token use and blog change
+	LastActiveAt time.Time `json:"last_active_at"`
+}
+
+type ProfilePostResponse struct {
+	ID          uuid.UUID  `json:"id"`
+	Title       string     `json:"title"`
+	PublishedAt *time.Time `json:"published_at"`
+}
+
+func ToProfilePostResponse(b blogRepository.Blog) ProfilePostResponse {
+	return ProfilePostResponse{
+		ID:          b.ID,
+		Title:       b.Title,
+		PublishedAt: b.PublishedAt,
+	}
+}
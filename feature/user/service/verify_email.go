@@ -0,0 +1,95 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/fikryfahrezy/let-it-go/feature/user/repository"
+	"github.com/fikryfahrezy/let-it-go/pkg/mailer"
+	"github.com/fikryfahrezy/let-it-go/pkg/token"
+	"github.com/google/uuid"
+)
+
+// sendVerificationEmail emails a link that confirms the user's address
+func (s *userService) sendVerificationEmail(ctx context.Context, user repository.User) error {
+	rawToken, err := token.GenerateOpaque(emailedTokenBytes)
+	if err != nil {
+		return err
+	}
+
+	verificationToken := repository.EmailVerificationToken{
+		ID:        uuid.Must(uuid.NewV7()),
+		TokenHash: token.Hash(rawToken),
+		ExpiresAt: time.Now().Add(s.config.EmailVerificationTTL),
+	}
+	if err := s.userRepo.CreateEmailVerificationToken(ctx, user.Email, verificationToken); err != nil {
+		return err
+	}
+
+	message := mailer.Message{
+		To:      user.Email,
+		Subject: "Confirm your email address",
+		Body: fmt.Sprintf(
+			"Hi %s,\n\nWelcome! Please confirm your email address with the link below. It expires in %s.\n\n%s\n",
+			user.Name, s.config.EmailVerificationTTL, token.Link(s.config.EmailVerificationURL, rawToken),
+		),
+	}
+
+	return s.mailer.Send(ctx, message)
+}
+
+// changeEmail moves the user to a new address, which is unverified until its owner
+// confirms it
+func changeEmail(user *repository.User, email string) {
+	user.Email = email
+	user.VerifiedAt = nil
+}
+
+// VerifyEmail confirms the email address of the user the token was sent to
+func (s *userService) VerifyEmail(ctx context.Context, req VerifyEmailRequest) error {
+	verificationToken, err := s.userRepo.GetEmailVerificationTokenByHash(ctx, token.Hash(req.Token))
+	if err != nil {
+		if errors.Is(err, repository.ErrEmailVerificationTokenNotFound) {
+			return ErrInvalidEmailVerificationToken
+		}
+		return err
+	}
+
+	if verificationToken.UsedAt != nil || !verificationToken.ExpiresAt.After(time.Now()) {
+		s.log.Warn("Email verification attempted with used or expired token",
+			slog.String("user_id", verificationToken.UserID.String()),
+		)
+		return ErrInvalidEmailVerificationToken
+	}
+
+	if err := s.userRepo.VerifyEmail(ctx, verificationToken.ID, verificationToken.UserID); err != nil {
+		// Another request redeemed the token first
+		if errors.Is(err, repository.ErrEmailVerificationTokenAlreadyUsed) {
+			return ErrInvalidEmailVerificationToken
+		}
+		return err
+	}
+
+	s.log.Info("Email verified successfully",
+		slog.String("user_id", verificationToken.UserID.String()),
+	)
+
+	return nil
+}
+
+// IsEmailVerified reports whether the user confirmed their email address. Deleted users
+// are reported as not verified, so their scheduled blogs are not published either.
+func (s *userService) IsEmailVerified(ctx context.Context, userID uuid.UUID) (bool, error) {
+	user, err := s.userRepo.GetByID(ctx, userID)
+	if errors.Is(err, repository.ErrUserNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	return user.VerifiedAt != nil, nil
+}
@@ -0,0 +1,5 @@
+package service
+
+type VerifyEmailRequest struct {
+	Token string `query:"token" validate:"required"`
+}
@@ -0,0 +1,110 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/fikryfahrezy/let-it-go/feature/user/repository"
+	"github.com/fikryfahrezy/let-it-go/feature/user/repository/repositoryfakes"
+	"github.com/fikryfahrezy/let-it-go/feature/user/service"
+	"github.com/fikryfahrezy/let-it-go/pkg/logger"
+	"github.com/fikryfahrezy/let-it-go/pkg/token"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestEmailVerificationToken(rawToken string) repository.EmailVerificationToken {
+	return repository.EmailVerificationToken{
+		ID:        uuid.New(),
+		UserID:    uuid.New(),
+		TokenHash: token.Hash(rawToken),
+		ExpiresAt: time.Now().Add(time.Hour),
+	}
+}
+
+func TestUserService_VerifyEmail_Success(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeUserRepository{}
+	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{}, service.Config{})
+	ctx := context.Background()
+
+	verificationToken := newTestEmailVerificationToken("verify-token")
+	mockRepo.GetEmailVerificationTokenByHashReturns(verificationToken, nil)
+
+	err := userService.VerifyEmail(ctx, service.VerifyEmailRequest{Token: "verify-token"})
+	require.NoError(t, err)
+
+	_, actualHash := mockRepo.GetEmailVerificationTokenByHashArgsForCall(0)
+	assert.Equal(t, token.Hash("verify-token"), actualHash)
+
+	require.Equal(t, 1, mockRepo.VerifyEmailCallCount())
+	_, tokenID, userID := mockRepo.VerifyEmailArgsForCall(0)
+	assert.Equal(t, verificationToken.ID, tokenID)
+	assert.Equal(t, verificationToken.UserID, userID)
+}
+
+func TestUserService_VerifyEmail_UnknownToken(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeUserRepository{}
+	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{}, service.Config{})
+	ctx := context.Background()
+
+	mockRepo.GetEmailVerificationTokenByHashReturns(repository.EmailVerificationToken{}, repository.ErrEmailVerificationTokenNotFound)
+
+	err := userService.VerifyEmail(ctx, service.VerifyEmailRequest{Token: "unknown"})
+
+	assert.Equal(t, service.ErrInvalidEmailVerificationToken, err)
+	assert.Equal(t, 0, mockRepo.VerifyEmailCallCount())
+}
+
+func TestUserService_VerifyEmail_ExpiredToken(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeUserRepository{}
+	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{}, service.Config{})
+	ctx := context.Background()
+
+	verificationToken := newTestEmailVerificationToken("verify-token")
+	verificationToken.ExpiresAt = time.Now().Add(-time.Minute)
+	mockRepo.GetEmailVerificationTokenByHashReturns(verificationToken, nil)
+
+	err := userService.VerifyEmail(ctx, service.VerifyEmailRequest{Token: "verify-token"})
+
+	assert.Equal(t, service.ErrInvalidEmailVerificationToken, err)
+	assert.Equal(t, 0, mockRepo.VerifyEmailCallCount())
+}
+
+func TestUserService_VerifyEmail_ConcurrentRedeem(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeUserRepository{}
+	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{}, service.Config{})
+	ctx := context.Background()
+
+	mockRepo.GetEmailVerificationTokenByHashReturns(newTestEmailVerificationToken("verify-token"), nil)
+	mockRepo.VerifyEmailReturns(repository.ErrEmailVerificationTokenAlreadyUsed)
+
+	err := userService.VerifyEmail(ctx, service.VerifyEmailRequest{Token: "verify-token"})
+
+	assert.Equal(t, service.ErrInvalidEmailVerificationToken, err)
+}
+
+func TestUserService_IsEmailVerified(t *testing.T) {
+	mockRepo := &repositoryfakes.FakeUserRepository{}
+	userService := service.NewUserService(logger.NewDiscardLogger(), mockRepo, service.Dependencies{}, service.Config{})
+	ctx := context.Background()
+
+	verifiedAt := time.Now()
+	mockRepo.GetByIDReturnsOnCall(0, repository.User{ID: uuid.New(), VerifiedAt: &verifiedAt}, nil)
+	mockRepo.GetByIDReturnsOnCall(1, repository.User{ID: uuid.New()}, nil)
+	mockRepo.GetByIDReturnsOnCall(2, repository.User{}, repository.ErrUserNotFound)
+
+	verified, err := userService.IsEmailVerified(ctx, uuid.New())
+	assert.NoError(t, err)
+	assert.True(t, verified)
+
+	verified, err = userService.IsEmailVerified(ctx, uuid.New())
+	assert.NoError(t, err)
+	assert.False(t, verified)
+
+	// Deleted users are not verified anymore
+	verified, err = userService.IsEmailVerified(ctx, uuid.New())
+	assert.NoError(t, err)
+	assert.False(t, verified)
+}
@@ -0,0 +1,100 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/fikryfahrezy/let-it-go/feature/user/repository"
+	"github.com/fikryfahrezy/let-it-go/pkg/token"
+	"github.com/fikryfahrezy/let-it-go/pkg/totp"
+)
+
+// VerifyTwoFactor completes a login that Authenticate answered with a two factor
+// challenge. Wrong codes count towards the account lockout like wrong passwords.
+func (s *userService) VerifyTwoFactor(ctx context.Context, req VerifyTwoFactorRequest) (AuthenticateResponse, error) {
+	if s.cipher == nil {
+		return AuthenticateResponse{}, ErrTwoFactorUnavailable
+	}
+
+	challenge, err := s.openTwoFactorChallenge(req.ChallengeToken)
+	if err != nil {
+		s.log.Warn("Two factor verification with invalid or expired challenge")
+		return AuthenticateResponse{}, err
+	}
+
+	user, err := s.userRepo.GetByID(ctx, challenge.UserID)
+	if err != nil {
+		if errors.Is(err, repository.ErrUserNotFound) {
+			return AuthenticateResponse{}, ErrInvalidTwoFactorChallenge
+		}
+		return AuthenticateResponse{}, err
+	}
+
+	if err := s.checkLockout(ctx, user.Email, req.IPAddress); err != nil {
+		return AuthenticateResponse{}, err
+	}
+
+	credential, err := s.userRepo.GetTwoFactorCredential(ctx, user.ID)
+	if err != nil {
+		if errors.Is(err, repository.ErrTwoFactorCredentialNotFound) {
+			// Two factor authentication was disabled after the challenge was issued
+			return AuthenticateResponse{}, ErrInvalidTwoFactorChallenge
+		}
+		return AuthenticateResponse{}, err
+	}
+	if credential.ConfirmedAt == nil {
+		return AuthenticateResponse{}, ErrInvalidTwoFactorChallenge
+	}
+
+	if err := s.checkSecondFactor(ctx, credential, req); err != nil {
+		if errors.Is(err, ErrInvalidTwoFactorCode) {
+			s.log.Warn("Two factor verification failed, wrong code",
+				slog.String("user_id", user.ID.String()),
+			)
+			s.recordFailedAttempt(ctx, user.Email, req.IPAddress)
+		}
+		return AuthenticateResponse{}, err
+	}
+	s.recordSuccessfulAttempt(ctx, user.Email)
+
+	response, err := s.startSession(ctx, user, false, req.UserAgent, req.IPAddress)
+	if err != nil {
+		return AuthenticateResponse{}, err
+	}
+
+	s.log.Info("User authenticated with two factor successfully",
+		slog.String("user_id", user.ID.String()),
+	)
+
+	return response, nil
+}
+
+// checkSecondFactor consumes a recovery code or checks a TOTP code, each of which is
+// only accepted once
+func (s *userService) checkSecondFactor(ctx context.Context, credential repository.TwoFactorCredential, req VerifyTwoFactorRequest) error {
+	if req.RecoveryCode != "" {
+		err := s.userRepo.UseRecoveryCode(ctx, credential.UserID, token.Hash(normalizeRecoveryCode(req.RecoveryCode)))
+		if errors.Is(err, repository.ErrRecoveryCodeNotFound) {
+			return ErrInvalidTwoFactorCode
+		}
+		return err
+	}
+
+	secret, err := s.decryptTwoFactorSecret(credential)
+	if err != nil {
+		return err
+	}
+
+	step, ok := totp.Validate(secret, req.Code, time.Now(), totpSkew)
+	if !ok {
+		return ErrInvalidTwoFactorCode
+	}
+
+	err = s.userRepo.UseTwoFactorStep(ctx, credential.UserID, step)
+	if errors.Is(err, repository.ErrTwoFactorCodeAlreadyUsed) {
+		return ErrInvalidTwoFactorCode
+	}
+	return err
+}
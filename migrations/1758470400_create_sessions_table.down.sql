@@ -0,0 +1,5 @@
+-- Migration: create_sessions_table (rollback)
+-- Created: 2025-09-21T16:00:00Z
+
+-- Drop sessions table
+DROP TABLE IF EXISTS sessions;
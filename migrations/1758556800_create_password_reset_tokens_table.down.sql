@@ -0,0 +1,5 @@
+-- Migration: create_password_reset_tokens_table (rollback)
+-- Created: 2025-09-22T16:00:00Z
+
+-- Drop password_reset_tokens table
+DROP TABLE IF EXISTS password_reset_tokens;
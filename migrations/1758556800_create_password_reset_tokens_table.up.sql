@@ -0,0 +1,14 @@
+-- Migration: create_password_reset_tokens_table
+-- Created: 2025-09-22T16:00:00Z
+
+-- Create password_reset_tokens table, tokens are stored hashed and used once
+CREATE TABLE IF NOT EXISTS password_reset_tokens (
+    id CHAR(36) PRIMARY KEY,
+    user_id CHAR(36) NOT NULL,
+    token_hash CHAR(64) NOT NULL UNIQUE,
+    expires_at TIMESTAMP NOT NULL,
+    used_at TIMESTAMP NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    INDEX idx_user_id (user_id),
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
@@ -0,0 +1,8 @@
+-- Migration: add_email_verification (rollback)
+-- Created: 2025-09-23T16:00:00Z
+
+-- Drop email_verification_tokens table
+DROP TABLE IF EXISTS email_verification_tokens;
+
+-- Remove verified_at column from users
+ALTER TABLE users DROP COLUMN verified_at;
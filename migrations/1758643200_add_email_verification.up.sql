@@ -0,0 +1,20 @@
+-- Migration: add_email_verification
+-- Created: 2025-09-23T16:00:00Z
+
+-- Track when a user confirmed their email address
+ALTER TABLE users ADD COLUMN verified_at TIMESTAMP NULL AFTER password;
+
+-- Accounts created before verification existed are considered verified
+UPDATE users SET verified_at = created_at WHERE verified_at IS NULL;
+
+-- Create email_verification_tokens table, tokens are stored hashed and used once
+CREATE TABLE IF NOT EXISTS email_verification_tokens (
+    id CHAR(36) PRIMARY KEY,
+    user_id CHAR(36) NOT NULL,
+    token_hash CHAR(64) NOT NULL UNIQUE,
+    expires_at TIMESTAMP NOT NULL,
+    used_at TIMESTAMP NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    INDEX idx_user_id (user_id),
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
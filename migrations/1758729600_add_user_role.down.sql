@@ -0,0 +1,6 @@
+-- Migration: add_user_role (rollback)
+-- Created: 2025-09-24T16:00:00Z
+
+-- Remove role column from users
+DROP INDEX idx_users_role ON users;
+ALTER TABLE users DROP COLUMN role;
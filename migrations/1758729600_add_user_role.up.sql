@@ -0,0 +1,7 @@
+-- Migration: add_user_role
+-- Created: 2025-09-24T16:00:00Z
+
+-- Every user has exactly one role: admin, editor, author or reader.
+-- Existing users keep writing blogs, so they start as authors.
+ALTER TABLE users ADD COLUMN role VARCHAR(20) NOT NULL DEFAULT 'author' AFTER password;
+CREATE INDEX idx_users_role ON users (role);
@@ -0,0 +1,5 @@
+-- Migration: create_personal_access_tokens_table (rollback)
+-- Created: 2025-09-25T16:00:00Z
+
+-- Drop personal_access_tokens table
+DROP TABLE IF EXISTS personal_access_tokens;
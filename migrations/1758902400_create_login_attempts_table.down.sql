@@ -0,0 +1,5 @@
+-- Migration: create_login_attempts_table (rollback)
+-- Created: 2025-09-26T16:00:00Z
+
+-- Drop login_attempts table
+DROP TABLE IF EXISTS login_attempts;
@@ -0,0 +1,11 @@
+-- Migration: create_login_attempts_table
+-- Created: 2025-09-26T16:00:00Z
+
+-- Create login_attempts table, failed credential checks per account or client IP
+CREATE TABLE IF NOT EXISTS login_attempts (
+    attempt_key VARCHAR(300) PRIMARY KEY,
+    failures INT NOT NULL DEFAULT 0,
+    last_failed_at TIMESTAMP NOT NULL,
+    locked_until TIMESTAMP NULL,
+    INDEX idx_last_failed_at (last_failed_at)
+);
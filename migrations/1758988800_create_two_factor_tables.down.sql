@@ -0,0 +1,6 @@
+-- Migration: create_two_factor_tables (rollback)
+-- Created: 2025-09-27T16:00:00Z
+
+-- Drop two factor tables
+DROP TABLE IF EXISTS two_factor_recovery_codes;
+DROP TABLE IF EXISTS two_factor_credentials;
@@ -0,0 +1,25 @@
+-- Migration: create_two_factor_tables
+-- Created: 2025-09-27T16:00:00Z
+
+-- Create two_factor_credentials table, one TOTP secret per user encrypted at rest.
+-- confirmed_at stays NULL until the user proves the enrollment with a first code.
+CREATE TABLE IF NOT EXISTS two_factor_credentials (
+    user_id CHAR(36) PRIMARY KEY,
+    secret_ciphertext VARCHAR(255) NOT NULL,
+    confirmed_at TIMESTAMP NULL,
+    last_used_step BIGINT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+-- Create two_factor_recovery_codes table, single-use codes stored as SHA-256 hashes
+CREATE TABLE IF NOT EXISTS two_factor_recovery_codes (
+    id CHAR(36) PRIMARY KEY,
+    user_id CHAR(36) NOT NULL,
+    code_hash CHAR(64) NOT NULL,
+    used_at TIMESTAMP NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE KEY uq_user_code_hash (user_id, code_hash),
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
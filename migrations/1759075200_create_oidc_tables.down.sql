@@ -0,0 +1,6 @@
+-- Migration: create_oidc_tables (rollback)
+-- Created: 2025-09-28T16:00:00Z
+
+-- Drop OpenID Connect tables
+DROP TABLE IF EXISTS oidc_login_requests;
+DROP TABLE IF EXISTS user_identities;
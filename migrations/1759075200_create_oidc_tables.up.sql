@@ -0,0 +1,28 @@
+-- Migration: create_oidc_tables
+-- Created: 2025-09-28T16:00:00Z
+
+-- Create user_identities table, external OpenID Connect accounts linked to users.
+-- provider is the issuer URL and subject its stable account ID.
+CREATE TABLE IF NOT EXISTS user_identities (
+    id CHAR(36) PRIMARY KEY,
+    user_id CHAR(36) NOT NULL,
+    provider VARCHAR(255) NOT NULL,
+    subject VARCHAR(255) NOT NULL,
+    email VARCHAR(255) NOT NULL DEFAULT '',
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE KEY uq_provider_subject (provider, subject),
+    INDEX idx_user_id (user_id),
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+-- Create oidc_login_requests table, the state, nonce and PKCE verifier of logins
+-- in progress. A row is consumed by the callback and is otherwise purged once expired.
+CREATE TABLE IF NOT EXISTS oidc_login_requests (
+    id CHAR(36) PRIMARY KEY,
+    state_hash CHAR(64) NOT NULL UNIQUE,
+    nonce VARCHAR(255) NOT NULL,
+    code_verifier VARCHAR(255) NOT NULL,
+    expires_at TIMESTAMP NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    INDEX idx_expires_at (expires_at)
+);
@@ -0,0 +1,11 @@
+-- Migration: add_user_soft_delete (rollback)
+-- Created: 2025-09-29T16:00:00Z
+
+-- Restore the cascading blogs foreign key
+ALTER TABLE blogs DROP FOREIGN KEY fk_blogs_author_id;
+ALTER TABLE blogs ADD CONSTRAINT blogs_ibfk_1 FOREIGN KEY (author_id) REFERENCES users(id) ON DELETE CASCADE;
+
+-- Remove soft delete columns from users
+DROP INDEX idx_users_deleted_at ON users;
+ALTER TABLE users DROP COLUMN erased_at;
+ALTER TABLE users DROP COLUMN deleted_at;
@@ -0,0 +1,12 @@
+-- Migration: add_user_soft_delete
+-- Created: 2025-09-29T16:00:00Z
+
+-- Deleting a user only marks the row; its personal data is erased once the grace period
+-- has passed, and erased_at records when that happened
+ALTER TABLE users ADD COLUMN deleted_at TIMESTAMP NULL AFTER verified_at;
+ALTER TABLE users ADD COLUMN erased_at TIMESTAMP NULL AFTER deleted_at;
+CREATE INDEX idx_users_deleted_at ON users (deleted_at);
+
+-- Users are no longer hard deleted, so a delete must never cascade to their blogs
+ALTER TABLE blogs DROP FOREIGN KEY blogs_ibfk_1;
+ALTER TABLE blogs ADD CONSTRAINT fk_blogs_author_id FOREIGN KEY (author_id) REFERENCES users(id) ON DELETE RESTRICT;
@@ -0,0 +1,5 @@
+-- Migration: create_invitations_table (rollback)
+-- Created: 2025-09-30T16:00:00Z
+
+-- Drop invitations table
+DROP TABLE IF EXISTS invitations;
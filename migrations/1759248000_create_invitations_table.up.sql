@@ -0,0 +1,18 @@
+-- Migration: create_invitations_table
+-- Created: 2025-09-30T16:00:00Z
+
+-- Create invitations table, tokens are stored hashed and accepted once
+CREATE TABLE IF NOT EXISTS invitations (
+    id CHAR(36) PRIMARY KEY,
+    email VARCHAR(255) NOT NULL,
+    role VARCHAR(20) NOT NULL,
+    token_hash CHAR(64) NOT NULL UNIQUE,
+    invited_by CHAR(36) NOT NULL,
+    expires_at TIMESTAMP NOT NULL,
+    accepted_at TIMESTAMP NULL,
+    revoked_at TIMESTAMP NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    INDEX idx_email (email),
+    INDEX idx_expires_at (expires_at),
+    FOREIGN KEY (invited_by) REFERENCES users(id) ON DELETE CASCADE
+);
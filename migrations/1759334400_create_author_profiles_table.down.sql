@@ -0,0 +1,5 @@
+-- Migration: create_author_profiles_table (rollback)
+-- Created: 2025-10-01T16:00:00Z
+
+-- Drop author_profiles table
+DROP TABLE IF EXISTS author_profiles;
@@ -0,0 +1,16 @@
+-- Migration: create_author_profiles_table
+-- Created: 2025-10-01T16:00:00Z
+
+-- Create author_profiles table, the public page of a user at /v1/authors/:handle.
+-- social_links is a JSON object of handles keyed by network.
+CREATE TABLE IF NOT EXISTS author_profiles (
+    user_id CHAR(36) PRIMARY KEY,
+    handle VARCHAR(30) NOT NULL UNIQUE,
+    bio VARCHAR(500) NOT NULL DEFAULT '',
+    avatar_url VARCHAR(2048) NOT NULL DEFAULT '',
+    website_url VARCHAR(2048) NOT NULL DEFAULT '',
+    social_links JSON NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
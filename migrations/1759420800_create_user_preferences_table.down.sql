@@ -0,0 +1,5 @@
+-- Migration: create_user_preferences_table (rollback)
+-- Created: 2025-10-02T16:00:00Z
+
+-- Drop user_preferences table
+DROP TABLE IF EXISTS user_preferences;
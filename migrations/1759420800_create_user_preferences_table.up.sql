@@ -0,0 +1,13 @@
+-- Migration: create_user_preferences_table
+-- Created: 2025-10-02T16:00:00Z
+
+-- Create user_preferences table, the settings of a user as a JSON document.
+-- schema_version is the layout of the document so older ones can be upgraded when read.
+CREATE TABLE IF NOT EXISTS user_preferences (
+    user_id CHAR(36) PRIMARY KEY,
+    schema_version INT NOT NULL,
+    preferences JSON NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
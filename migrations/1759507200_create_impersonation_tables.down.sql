@@ -0,0 +1,6 @@
+-- Migration: create_impersonation_tables (rollback)
+-- Created: 2025-10-03T16:00:00Z
+
+-- Drop impersonation tables
+DROP TABLE IF EXISTS impersonation_requests;
+DROP TABLE IF EXISTS impersonation_sessions;
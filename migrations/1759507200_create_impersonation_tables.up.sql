@@ -0,0 +1,30 @@
+-- Migration: create_impersonation_tables
+-- Created: 2025-10-03T16:00:00Z
+
+-- Create impersonation sessions table, one row per impersonation token an admin was issued
+CREATE TABLE IF NOT EXISTS impersonation_sessions (
+    id CHAR(36) PRIMARY KEY,
+    admin_id CHAR(36) NOT NULL,
+    user_id CHAR(36) NOT NULL,
+    reason VARCHAR(500) NOT NULL,
+    user_agent VARCHAR(255) NOT NULL DEFAULT '',
+    ip_address VARCHAR(45) NOT NULL DEFAULT '',
+    expires_at TIMESTAMP NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    INDEX idx_admin_id (admin_id),
+    INDEX idx_user_id (user_id),
+    FOREIGN KEY (admin_id) REFERENCES users(id) ON DELETE CASCADE,
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+-- Create impersonation requests table, one row per request made with an impersonation token
+CREATE TABLE IF NOT EXISTS impersonation_requests (
+    id BIGINT AUTO_INCREMENT PRIMARY KEY,
+    session_id CHAR(36) NOT NULL,
+    method VARCHAR(10) NOT NULL,
+    path VARCHAR(2048) NOT NULL,
+    status SMALLINT NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    INDEX idx_session_id (session_id),
+    FOREIGN KEY (session_id) REFERENCES impersonation_sessions(id) ON DELETE CASCADE
+);
@@ -0,0 +1,6 @@
+-- Migration: add_blog_slugs (rollback)
+-- Created: 2025-10-04T16:00:00Z
+
+-- Drop blog slug history table and the slug column
+DROP TABLE IF EXISTS blog_slug_history;
+ALTER TABLE blogs DROP INDEX idx_slug, DROP COLUMN slug;
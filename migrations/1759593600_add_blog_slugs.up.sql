@@ -0,0 +1,27 @@
+-- Migration: add_blog_slugs
+-- Created: 2025-10-04T16:00:00Z
+
+-- Add the slug column and give existing blogs a slug from their title, suffixed with the
+-- random end of their ID so that blogs sharing a title do not collide
+ALTER TABLE blogs ADD COLUMN slug VARCHAR(255) NULL AFTER title;
+
+UPDATE blogs
+SET slug = TRIM(BOTH '-' FROM CONCAT(
+    LEFT(TRIM(BOTH '-' FROM LOWER(REGEXP_REPLACE(title, '[^A-Za-z0-9]+', '-'))), 200),
+    '-',
+    RIGHT(id, 12)
+));
+
+ALTER TABLE blogs
+    MODIFY COLUMN slug VARCHAR(255) NOT NULL,
+    ADD UNIQUE INDEX idx_slug (slug);
+
+-- Create blog slug history table, the slugs a blog had before its title changed.
+-- A slug in the history stays reserved for its blog so old links keep redirecting.
+CREATE TABLE IF NOT EXISTS blog_slug_history (
+    slug VARCHAR(255) PRIMARY KEY,
+    blog_id CHAR(36) NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    INDEX idx_blog_id (blog_id),
+    FOREIGN KEY (blog_id) REFERENCES blogs(id) ON DELETE CASCADE
+);
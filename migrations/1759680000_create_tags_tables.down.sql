@@ -0,0 +1,6 @@
+-- Migration: create_tags_tables (rollback)
+-- Created: 2025-10-05T16:00:00Z
+
+-- Drop blog tags and tags tables
+DROP TABLE IF EXISTS blog_tags;
+DROP TABLE IF EXISTS tags;
@@ -0,0 +1,20 @@
+-- Migration: create_tags_tables
+-- Created: 2025-10-05T16:00:00Z
+
+-- Create tags table, shared by every blog using the same name
+CREATE TABLE IF NOT EXISTS tags (
+    id CHAR(36) PRIMARY KEY,
+    name VARCHAR(50) NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE INDEX idx_name (name)
+);
+
+-- Create blog tags table linking blogs to their tags
+CREATE TABLE IF NOT EXISTS blog_tags (
+    blog_id CHAR(36) NOT NULL,
+    tag_id CHAR(36) NOT NULL,
+    PRIMARY KEY (blog_id, tag_id),
+    INDEX idx_tag_id (tag_id),
+    FOREIGN KEY (blog_id) REFERENCES blogs(id) ON DELETE CASCADE,
+    FOREIGN KEY (tag_id) REFERENCES tags(id) ON DELETE CASCADE
+);
@@ -0,0 +1,6 @@
+-- Migration: create_comments_table (rollback)
+-- Created: 2025-10-06T16:00:00Z
+
+-- Drop comments table and the blog comments switch
+DROP TABLE IF EXISTS comments;
+ALTER TABLE blogs DROP COLUMN comments_closed;
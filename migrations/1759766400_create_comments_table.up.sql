@@ -0,0 +1,26 @@
+-- Migration: create_comments_table
+-- Created: 2025-10-06T16:00:00Z
+
+-- Let authors close a blog for new comments
+ALTER TABLE blogs ADD COLUMN comments_closed BOOLEAN NOT NULL DEFAULT FALSE AFTER status;
+
+-- Create comments table. Replies point at their parent comment of the same blog.
+CREATE TABLE IF NOT EXISTS comments (
+    id CHAR(36) PRIMARY KEY,
+    blog_id CHAR(36) NOT NULL,
+    parent_id CHAR(36) NULL,
+    author_id CHAR(36) NOT NULL,
+    content TEXT NOT NULL,
+    status ENUM('pending', 'approved', 'rejected') NOT NULL DEFAULT 'pending',
+    moderated_by CHAR(36) NULL,
+    moderated_at TIMESTAMP NULL,
+    deleted_at TIMESTAMP NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    INDEX idx_blog_id_status (blog_id, status),
+    INDEX idx_status_created_at (status, created_at),
+    FOREIGN KEY (blog_id) REFERENCES blogs(id) ON DELETE CASCADE,
+    FOREIGN KEY (parent_id) REFERENCES comments(id) ON DELETE CASCADE,
+    FOREIGN KEY (author_id) REFERENCES users(id) ON DELETE CASCADE,
+    FOREIGN KEY (moderated_by) REFERENCES users(id) ON DELETE SET NULL
+);
@@ -0,0 +1,5 @@
+-- Migration: add_blogs_fulltext_index (rollback)
+-- Created: 2025-10-07T16:00:00Z
+
+-- Drop blogs full-text index
+ALTER TABLE blogs DROP INDEX ft_blogs_title_content;
@@ -0,0 +1,5 @@
+-- Migration: add_blogs_fulltext_index
+-- Created: 2025-10-07T16:00:00Z
+
+-- Search blogs by the words of their title and content
+ALTER TABLE blogs ADD FULLTEXT INDEX ft_blogs_title_content (title, content);
@@ -0,0 +1,8 @@
+-- Migration: add_blog_scheduling (rollback)
+-- Created: 2025-10-08T16:00:00Z
+
+-- Scheduled blogs go back to drafts before the status is dropped
+UPDATE blogs SET status = 'draft' WHERE status = 'scheduled';
+DROP INDEX idx_blogs_status_scheduled_at ON blogs;
+ALTER TABLE blogs DROP COLUMN scheduled_at;
+ALTER TABLE blogs MODIFY COLUMN status ENUM('draft', 'published', 'archived') NOT NULL DEFAULT 'draft';
@@ -0,0 +1,7 @@
+-- Migration: add_blog_scheduling
+-- Created: 2025-10-08T16:00:00Z
+
+-- Scheduled blogs are published by the cron job once scheduled_at has passed
+ALTER TABLE blogs MODIFY COLUMN status ENUM('draft', 'published', 'archived', 'scheduled') NOT NULL DEFAULT 'draft';
+ALTER TABLE blogs ADD COLUMN scheduled_at TIMESTAMP NULL AFTER published_at;
+CREATE INDEX idx_blogs_status_scheduled_at ON blogs (status, scheduled_at);
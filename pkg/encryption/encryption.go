@@ -0,0 +1,83 @@
+package encryption
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+
+	"github.com/fikryfahrezy/let-it-go/pkg/app_error"
+)
+
+// KeySize is the length in bytes of the AES-256 key
+const KeySize = 32
+
+var (
+	ErrInvalidKey       = app_error.New("ENCRYPTION-INVALID_KEY", "encryption key must be 32 base64 encoded bytes")
+	ErrFailedToEncrypt  = app_error.New("ENCRYPTION-FAILED_TO_ENCRYPT", "failed to encrypt")
+	ErrFailedToDecrypt  = app_error.New("ENCRYPTION-FAILED_TO_DECRYPT", "failed to decrypt")
+	ErrMalformedMessage = app_error.New("ENCRYPTION-MALFORMED_MESSAGE", "encrypted message is malformed")
+)
+
+// Cipher encrypts small secrets for storage with AES-256-GCM. Every message gets a
+// random nonce, which is stored in front of the ciphertext.
+type Cipher struct {
+	aead cipher.AEAD
+}
+
+// NewCipher creates a cipher from a base64 encoded 32 byte key
+func NewCipher(key string) (*Cipher, error) {
+	rawKey, err := base64.StdEncoding.DecodeString(key)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidKey, err)
+	}
+	if len(rawKey) != KeySize {
+		return nil, fmt.Errorf("%w: got %d bytes", ErrInvalidKey, len(rawKey))
+	}
+
+	block, err := aes.NewCipher(rawKey)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidKey, err)
+	}
+
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidKey, err)
+	}
+
+	return &Cipher{aead: aead}, nil
+}
+
+// Encrypt seals plaintext and returns it base64 encoded. additionalData is
+// authenticated but not stored, so the same value must be passed to Decrypt;
+// binding a secret to its owner this way stops it from being copied to another row.
+func (c *Cipher) Encrypt(plaintext, additionalData []byte) (string, error) {
+	nonce := make([]byte, c.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", fmt.Errorf("%w: %w", ErrFailedToEncrypt, err)
+	}
+
+	sealed := c.aead.Seal(nonce, nonce, plaintext, additionalData)
+	return base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+// Decrypt opens a message produced by Encrypt
+func (c *Cipher) Decrypt(message string, additionalData []byte) ([]byte, error) {
+	sealed, err := base64.StdEncoding.DecodeString(message)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrMalformedMessage, err)
+	}
+
+	nonceSize := c.aead.NonceSize()
+	if len(sealed) < nonceSize+c.aead.Overhead() {
+		return nil, ErrMalformedMessage
+	}
+
+	plaintext, err := c.aead.Open(nil, sealed[:nonceSize], sealed[nonceSize:], additionalData)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrFailedToDecrypt, err)
+	}
+
+	return plaintext, nil
+}
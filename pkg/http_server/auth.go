@@ -0,0 +1,197 @@
+package http_server
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/fikryfahrezy/let-it-go/pkg/app_error"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+)
+
+// Authentication errors returned in the standard APIResponse envelope
+var (
+	ErrUnauthenticated            = app_error.New("AUTH-UNAUTHENTICATED", "authentication required")
+	ErrInvalidAuthorizationHeader = app_error.New("AUTH-INVALID_AUTHORIZATION_HEADER", "authorization header must be a bearer token")
+	ErrForbidden                  = app_error.New("AUTH-FORBIDDEN", "insufficient permissions")
+	ErrInsufficientScope          = app_error.New("AUTH-INSUFFICIENT_SCOPE", "token scope does not allow this request")
+)
+
+const (
+	authErrorContextKey    = "auth_error"
+	scopeCheckedContextKey = "auth_scope_checked"
+)
+
+// Principal is the authenticated caller of a request
+type Principal struct {
+	UserID    uuid.UUID
+	Roles     []string
+	SessionID uuid.UUID // Zero when the token is not bound to a login session
+	Scopes    []string  // Nil unless authenticated with a scoped token, which is limited to these scopes
+
+	// TwoFactorSetupRequired is set while the user's role requires a second factor they have
+	// not enrolled yet. Such principals are granted no permission until they enroll.
+	TwoFactorSetupRequired bool
+
+	// ImpersonatorID is the admin acting as UserID and ImpersonationID the impersonation
+	// session the token was issued for; both are zero unless the caller is impersonating
+	ImpersonatorID  uuid.UUID
+	ImpersonationID uuid.UUID
+}
+
+// Impersonating reports whether an admin is acting as the principal's user
+func (p Principal) Impersonating() bool {
+	return p.ImpersonatorID != uuid.Nil
+}
+
+// Scoped reports whether the principal authenticated with a token restricted to scopes
+func (p Principal) Scoped() bool {
+	return p.Scopes != nil
+}
+
+// HasScope reports whether the principal may act within the scope. Principals that are
+// not scoped, such as logged in users, have every scope.
+func (p Principal) HasScope(scope string) bool {
+	return !p.Scoped() || slices.Contains(p.Scopes, scope)
+}
+
+// HasRole reports whether the principal has at least one of the given roles
+func (p Principal) HasRole(roles ...string) bool {
+	for _, role := range roles {
+		if slices.Contains(p.Roles, role) {
+			return true
+		}
+	}
+	return false
+}
+
+// Authenticator verifies a bearer token and resolves the principal it belongs to
+type Authenticator interface {
+	Authenticate(ctx context.Context, token string) (Principal, error)
+}
+
+// AuthenticatorFunc adapts an ordinary function to the Authenticator interface
+type AuthenticatorFunc func(ctx context.Context, token string) (Principal, error)
+
+func (f AuthenticatorFunc) Authenticate(ctx context.Context, token string) (Principal, error) {
+	return f(ctx, token)
+}
+
+type principalContextKey struct{}
+
+// ContextWithPrincipal returns a copy of ctx carrying the principal
+func ContextWithPrincipal(ctx context.Context, principal Principal) context.Context {
+	return context.WithValue(ctx, principalContextKey{}, principal)
+}
+
+// PrincipalFromContext returns the principal stored in ctx, if any
+func PrincipalFromContext(ctx context.Context) (Principal, bool) {
+	principal, ok := ctx.Value(principalContextKey{}).(Principal)
+	return principal, ok
+}
+
+// authenticate resolves the bearer token, when one is sent, into a principal on
+// the request context. It never rejects a request by itself so public routes keep
+// working; protected routes use RequireAuth or RequireRole to enforce it.
+func authenticate(authenticator Authenticator) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			header := c.Request().Header.Get(echo.HeaderAuthorization)
+			if header == "" || authenticator == nil {
+				return next(c)
+			}
+
+			scheme, token, found := strings.Cut(header, " ")
+			if !found || !strings.EqualFold(scheme, "Bearer") || strings.TrimSpace(token) == "" {
+				c.Set(authErrorContextKey, ErrInvalidAuthorizationHeader)
+				return next(c)
+			}
+
+			ctx := c.Request().Context()
+			principal, err := authenticator.Authenticate(ctx, strings.TrimSpace(token))
+			if err != nil {
+				c.Set(authErrorContextKey, err)
+				return next(c)
+			}
+
+			c.SetRequest(c.Request().WithContext(ContextWithPrincipal(ctx, principal)))
+			return next(c)
+		}
+	}
+}
+
+// RequireAuth marks a route as protected: requests without a valid bearer token get 401.
+// Scoped tokens get 403 unless the route accepts one of their scopes with RequireScope.
+func RequireAuth() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			principal, ok := PrincipalFromContext(c.Request().Context())
+			if !ok {
+				return unauthenticatedResponse(c)
+			}
+			if !scopeAllowed(c, principal) {
+				return insufficientScopeResponse(c)
+			}
+			return next(c)
+		}
+	}
+}
+
+// RequireScope marks a route as protected and open to scoped tokens carrying the scope.
+// Unauthenticated requests get 401 and scoped tokens without the scope get 403; callers
+// that are not scoped always pass. Put it before RequireAuth, RequireRole or any middleware
+// built on them so they let the scoped token through.
+func RequireScope(scope string) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			principal, ok := PrincipalFromContext(c.Request().Context())
+			if !ok {
+				return unauthenticatedResponse(c)
+			}
+			if !principal.HasScope(scope) {
+				return insufficientScopeResponse(c)
+			}
+			c.Set(scopeCheckedContextKey, true)
+			return next(c)
+		}
+	}
+}
+
+// RequireRole marks a route as protected and restricted to principals having one of the roles.
+// Unauthenticated requests get 401 and authenticated ones without a matching role get 403.
+func RequireRole(roles ...string) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			principal, ok := PrincipalFromContext(c.Request().Context())
+			if !ok {
+				return unauthenticatedResponse(c)
+			}
+			if !scopeAllowed(c, principal) {
+				return insufficientScopeResponse(c)
+			}
+			if !principal.HasRole(roles...) {
+				return ForbiddenResponse(c, "You do not have permission to access this resource", ErrForbidden)
+			}
+			return next(c)
+		}
+	}
+}
+
+// scopeAllowed reports whether the principal is not scoped or a RequireScope earlier in
+// the chain already accepted its scopes for this route
+func scopeAllowed(c echo.Context, principal Principal) bool {
+	checked, _ := c.Get(scopeCheckedContextKey).(bool)
+	return !principal.Scoped() || checked
+}
+
+func insufficientScopeResponse(c echo.Context) error {
+	return ForbiddenResponse(c, "Token scope does not allow this request", ErrInsufficientScope)
+}
+
+func unauthenticatedResponse(c echo.Context) error {
+	if err, ok := c.Get(authErrorContextKey).(error); ok {
+		return UnauthorizedResponse(c, "Invalid or expired access token", err)
+	}
+	return UnauthorizedResponse(c, "Authentication required", ErrUnauthenticated)
+}
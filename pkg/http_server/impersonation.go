@@ -0,0 +1,38 @@
+package http_server
+
+import (
+	"context"
+
+	"github.com/fikryfahrezy/let-it-go/pkg/app_error"
+	"github.com/labstack/echo/v4"
+)
+
+// ErrImpersonationForbidden is returned for routes that cannot be used while impersonating a user
+var ErrImpersonationForbidden = app_error.New("AUTH-IMPERSONATION_FORBIDDEN", "this action is not allowed while impersonating a user")
+
+// ImpersonatedRequest describes a request made by an admin impersonating a user
+type ImpersonatedRequest struct {
+	Method string
+	Path   string
+	Status int
+}
+
+// ImpersonationAuditor records every request made while impersonating a user
+type ImpersonationAuditor interface {
+	RecordImpersonatedRequest(ctx context.Context, principal Principal, request ImpersonatedRequest) error
+}
+
+// ForbidImpersonation rejects impersonating principals with 403. Use it on routes that are
+// too dangerous to be taken on a user's behalf, such as deleting the account or changing
+// its credentials. Other callers, authenticated or not, pass through untouched.
+func ForbidImpersonation() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			principal, ok := PrincipalFromContext(c.Request().Context())
+			if ok && principal.Impersonating() {
+				return ForbiddenResponse(c, "This action is not allowed while impersonating a user", ErrImpersonationForbidden)
+			}
+			return next(c)
+		}
+	}
+}
@@ -96,10 +96,22 @@ func BadRequestResponse(c echo.Context, message string, err error) error {
 	return ErrorResponse(c, http.StatusBadRequest, message, err)
 }
 
+func UnauthorizedResponse(c echo.Context, message string, err error) error {
+	return ErrorResponse(c, http.StatusUnauthorized, message, err)
+}
+
+func ForbiddenResponse(c echo.Context, message string, err error) error {
+	return ErrorResponse(c, http.StatusForbidden, message, err)
+}
+
 func NotFoundResponse(c echo.Context, message string, err error) error {
 	return ErrorResponse(c, http.StatusNotFound, message, err)
 }
 
+func TooManyRequestsResponse(c echo.Context, message string, err error) error {
+	return ErrorResponse(c, http.StatusTooManyRequests, message, err)
+}
+
 func InternalServerErrorResponse(c echo.Context, message string, err error) error {
 	return ErrorResponse(c, http.StatusInternalServerError, message, err)
 }
@@ -18,11 +18,14 @@ type Config struct {
 
 // Server manages the application server lifecycle
 type Server struct {
-	config Config
-	echo   *echo.Echo
+	config        Config
+	echo          *echo.Echo
+	authenticator Authenticator
+	auditor       ImpersonationAuditor
 }
 
-// RouteHandler defines interface for features to register their routes
+// RouteHandler defines interface for features to register their routes.
+// Routes are public unless registered with RequireAuth or RequireRole.
 type RouteHandler interface {
 	SetupRoutes(server *Server)
 }
@@ -38,6 +41,17 @@ func New(config Config) *Server {
 	}
 }
 
+// SetAuthenticator sets the authenticator used to resolve bearer tokens, call it before Initialize
+func (s *Server) SetAuthenticator(authenticator Authenticator) {
+	s.authenticator = authenticator
+}
+
+// SetImpersonationAuditor sets where requests made while impersonating a user are recorded,
+// call it before Initialize. Without one they are only logged.
+func (s *Server) SetImpersonationAuditor(auditor ImpersonationAuditor) {
+	s.auditor = auditor
+}
+
 // Initialize sets up the server with dependencies
 func (s *Server) Initialize(handlers []RouteHandler) error {
 	slog.Info("Initializing server")
@@ -50,27 +64,67 @@ func (s *Server) Initialize(handlers []RouteHandler) error {
 	s.echo.Use(middleware.Recover())
 	s.echo.Use(middleware.CORS())
 
-	// Request logging with slog
+	// Request logging with slog. The principal is only on the request context once the
+	// authenticate middleware below has run, which is the case when the values are logged.
 	s.echo.Use(middleware.RequestLoggerWithConfig(middleware.RequestLoggerConfig{
+		LogMethod: true,
 		LogURI:    true,
 		LogStatus: true,
 		LogValuesFunc: func(c echo.Context, v middleware.RequestLoggerValues) error {
-			slog.Info("HTTP Request",
+			attrs := []any{
 				slog.String("method", v.Method),
 				slog.String("uri", v.URI),
 				slog.Int("status", v.Status),
 				slog.Duration("latency", v.Latency),
-			)
+			}
+
+			principal, ok := PrincipalFromContext(c.Request().Context())
+			if ok {
+				attrs = append(attrs, slog.String("user_id", principal.UserID.String()))
+			}
+			if ok && principal.Impersonating() {
+				attrs = append(attrs,
+					slog.String("impersonator_id", principal.ImpersonatorID.String()),
+					slog.String("impersonation_id", principal.ImpersonationID.String()),
+				)
+				s.recordImpersonatedRequest(c, principal, v)
+			}
+
+			slog.Info("HTTP Request", attrs...)
 			return nil
 		},
 	}))
 
+	// Resolve bearer tokens into a principal on the request context
+	s.echo.Use(authenticate(s.authenticator))
+
 	// Setup API routes
 	s.setupAPIRoutes(handlers)
 
 	return nil
 }
 
+// recordImpersonatedRequest hands a request made while impersonating to the auditor. Failing
+// to record it is logged and never changes the response, which was already written.
+func (s *Server) recordImpersonatedRequest(c echo.Context, principal Principal, v middleware.RequestLoggerValues) {
+	if s.auditor == nil {
+		return
+	}
+
+	ctx := context.WithoutCancel(c.Request().Context())
+	err := s.auditor.RecordImpersonatedRequest(ctx, principal, ImpersonatedRequest{
+		Method: v.Method,
+		Path:   c.Request().URL.Path,
+		Status: v.Status,
+	})
+	if err != nil {
+		slog.Error("Failed to record impersonated request",
+			slog.String("error", err.Error()),
+			slog.String("impersonation_id", principal.ImpersonationID.String()),
+		)
+	}
+}
+
 // setupAPIRoutes configures all API routes for all versions
 func (s *Server) setupAPIRoutes(handlers []RouteHandler) {
 	// Swagger documentation - accessible at /swagger/index.html
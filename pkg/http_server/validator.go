@@ -3,6 +3,7 @@ package http_server
 import (
 	"log/slog"
 	"reflect"
+	"regexp"
 	"strings"
 
 	"github.com/go-playground/locales/en"
@@ -12,6 +13,9 @@ import (
 	"github.com/labstack/echo/v4"
 )
 
+// slugPattern matches lowercase words of letters and digits joined by single hyphens
+var slugPattern = regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)
+
 // CustomValidator implements echo.Validator interface
 type CustomValidator struct {
 	validator *validator.Validate
@@ -40,12 +44,51 @@ func NewCustomValidator() *CustomValidator {
 		slog.Error("Failed to register translations", slog.String("error", err.Error()))
 	}
 
+	registerCustomValidations(validate, trans)
+
 	return &CustomValidator{
 		validator: validate,
 		trans:     trans,
 	}
 }
 
+// customTranslations are the messages of tags without a default english translation
+var customTranslations = map[string]string{
+	"slug":               "{0} may only contain lowercase letters, numbers and single hyphens",
+	"http_url":           "{0} must be an http or https URL",
+	"timezone":           "{0} must be an IANA time zone such as Europe/Berlin",
+	"bcp47_language_tag": "{0} must be a BCP 47 language tag such as en-US",
+}
+
+// registerCustomValidations adds the "slug" tag for URL safe identifiers such as handles
+// and the messages of customTranslations
+func registerCustomValidations(validate *validator.Validate, trans ut.Translator) {
+	err := validate.RegisterValidation("slug", func(fl validator.FieldLevel) bool {
+		return slugPattern.MatchString(fl.Field().String())
+	})
+	if err != nil {
+		slog.Error("Failed to register slug validation", slog.String("error", err.Error()))
+	}
+
+	for tag, text := range customTranslations {
+		err := validate.RegisterTranslation(tag, trans,
+			func(ut ut.Translator) error {
+				return ut.Add(tag, text, true)
+			},
+			func(ut ut.Translator, fe validator.FieldError) string {
+				message, _ := ut.T(tag, fe.Field())
+				return message
+			},
+		)
+		if err != nil {
+			slog.Error("Failed to register translation",
+				slog.String("tag", tag),
+				slog.String("error", err.Error()),
+			)
+		}
+	}
+}
+
 // Validate implements the echo.Validator interface
 func (cv *CustomValidator) Validate(i any) error {
 	err := cv.validator.Struct(i)
@@ -0,0 +1,178 @@
+package lockout
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/fikryfahrezy/let-it-go/pkg/app_error"
+	"github.com/fikryfahrezy/let-it-go/pkg/database"
+)
+
+const (
+	DriverMemory = "memory"
+	DriverMySQL  = "mysql"
+)
+
+var (
+	ErrFailedToAccessStore = app_error.New("LOCKOUT-FAILED_TO_ACCESS_STORE", "failed to access login attempts")
+	ErrUnsupportedDriver   = app_error.New("LOCKOUT-UNSUPPORTED_DRIVER", "unsupported lockout store driver")
+)
+
+type Config struct {
+	Driver string
+	// Failed attempts allowed before an account or a client IP is locked; zero disables the check
+	AccountMaxAttempts int
+	IPMaxAttempts      int
+	// Window is how long a failed attempt is remembered. A failure after a quiet window starts counting from one again.
+	Window time.Duration
+	// The first lockout lasts BaseLockout and every further failure doubles it, up to MaxLockout
+	BaseLockout time.Duration
+	MaxLockout  time.Duration
+}
+
+// Attempts are the failed attempts recorded for one account or client IP
+type Attempts struct {
+	Failures     int
+	LastFailedAt time.Time
+	LockedUntil  time.Time // Zero when never locked
+}
+
+// Store persists failed attempts by key
+type Store interface {
+	// Get returns the attempts recorded for key, or zero Attempts when there are none
+	Get(ctx context.Context, key string) (Attempts, error)
+	// RecordFailure counts a failed attempt at now and returns the updated attempts. The
+	// count restarts at one when the previous failure is older than window.
+	RecordFailure(ctx context.Context, key string, now time.Time, window time.Duration) (Attempts, error)
+	// Lock locks key until the given time
+	Lock(ctx context.Context, key string, until time.Time) error
+	// Reset forgets the attempts recorded for key, unlocking it
+	Reset(ctx context.Context, key string) error
+	// Purge forgets keys whose last failure is before failedBefore and that are not locked at now
+	Purge(ctx context.Context, failedBefore, now time.Time) (int64, error)
+}
+
+// Limiter tracks failed credential checks per account and per client IP and locks
+// either out with exponential backoff once it reaches its threshold
+type Limiter struct {
+	store  Store
+	config Config
+	now    func() time.Time
+}
+
+// New creates a limiter backed by the store selected by config.Driver. The database
+// is only used by the mysql driver.
+func New(config Config, db *database.DB) (*Limiter, error) {
+	switch config.Driver {
+	case DriverMemory:
+		return NewLimiter(config, NewMemoryStore()), nil
+	case DriverMySQL:
+		return NewLimiter(config, NewMySQLStore(db)), nil
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedDriver, config.Driver)
+	}
+}
+
+func NewLimiter(config Config, store Store) *Limiter {
+	return &Limiter{
+		store:  store,
+		config: config,
+		now:    time.Now,
+	}
+}
+
+type trackedKey struct {
+	name        string
+	maxAttempts int
+}
+
+// keys lists the store keys of the account and the client IP, skipping empty
+// identifiers and checks that are disabled
+func (l *Limiter) keys(account, ip string) []trackedKey {
+	var keys []trackedKey
+	if account = strings.ToLower(strings.TrimSpace(account)); account != "" && l.config.AccountMaxAttempts > 0 {
+		keys = append(keys, trackedKey{name: "account:" + account, maxAttempts: l.config.AccountMaxAttempts})
+	}
+	if ip != "" && l.config.IPMaxAttempts > 0 {
+		keys = append(keys, trackedKey{name: "ip:" + ip, maxAttempts: l.config.IPMaxAttempts})
+	}
+	return keys
+}
+
+// Check returns how long the account or the client IP stays locked, zero when neither is
+func (l *Limiter) Check(ctx context.Context, account, ip string) (time.Duration, error) {
+	now := l.now()
+
+	var retryAfter time.Duration
+	for _, key := range l.keys(account, ip) {
+		attempts, err := l.store.Get(ctx, key.name)
+		if err != nil {
+			return 0, err
+		}
+		retryAfter = max(retryAfter, attempts.LockedUntil.Sub(now))
+	}
+
+	return retryAfter, nil
+}
+
+// RecordFailure counts a failed attempt against the account and the client IP and locks
+// the ones that reached their threshold. It returns how long the account or the client IP
+// is now locked, zero when neither is.
+func (l *Limiter) RecordFailure(ctx context.Context, account, ip string) (time.Duration, error) {
+	now := l.now()
+
+	var lockedFor time.Duration
+	for _, key := range l.keys(account, ip) {
+		attempts, err := l.store.RecordFailure(ctx, key.name, now, l.config.Window)
+		if err != nil {
+			return 0, err
+		}
+		if attempts.Failures < key.maxAttempts {
+			continue
+		}
+
+		duration := l.lockoutDuration(attempts.Failures - key.maxAttempts)
+		if err := l.store.Lock(ctx, key.name, now.Add(duration)); err != nil {
+			return 0, err
+		}
+		lockedFor = max(lockedFor, duration)
+	}
+
+	return lockedFor, nil
+}
+
+// RecordSuccess forgets the failed attempts of the account. Those of the client IP are
+// kept so an attacker cannot clear them by logging in to an account of their own.
+func (l *Limiter) RecordSuccess(ctx context.Context, account string) error {
+	return l.Unlock(ctx, account)
+}
+
+// Unlock forgets the failed attempts of the account, lifting its lockout
+func (l *Limiter) Unlock(ctx context.Context, account string) error {
+	for _, key := range l.keys(account, "") {
+		if err := l.store.Reset(ctx, key.name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Purge forgets accounts and client IPs whose failures are no longer remembered
+func (l *Limiter) Purge(ctx context.Context) (int64, error) {
+	now := l.now()
+	return l.store.Purge(ctx, now.Add(-l.config.Window), now)
+}
+
+// lockoutDuration doubles BaseLockout for every failure past the threshold, up to MaxLockout
+func (l *Limiter) lockoutDuration(excessFailures int) time.Duration {
+	duration := l.config.BaseLockout
+	for range excessFailures {
+		if duration >= l.config.MaxLockout {
+			break
+		}
+		duration *= 2
+	}
+	return min(duration, l.config.MaxLockout)
+}
@@ -0,0 +1,85 @@
+package lockout
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// MemoryStore keeps attempts in process memory. Attempts are lost on restart and not
+// shared between instances, so it suits a single instance or local development.
+type MemoryStore struct {
+	mu        sync.Mutex
+	attempts  map[string]Attempts
+	lastPurge time.Time
+}
+
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{attempts: make(map[string]Attempts)}
+}
+
+func (s *MemoryStore) Get(_ context.Context, key string) (Attempts, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.attempts[key], nil
+}
+
+func (s *MemoryStore) RecordFailure(_ context.Context, key string, now time.Time, window time.Duration) (Attempts, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// Nothing else purges memory, so drop forgotten keys about once per window
+	if now.Sub(s.lastPurge) >= window {
+		s.purge(now.Add(-window), now)
+		s.lastPurge = now
+	}
+
+	attempts := s.attempts[key]
+	if attempts.LastFailedAt.Before(now.Add(-window)) {
+		attempts.Failures = 0
+	}
+	attempts.Failures++
+	attempts.LastFailedAt = now
+	s.attempts[key] = attempts
+
+	return attempts, nil
+}
+
+func (s *MemoryStore) Lock(_ context.Context, key string, until time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	attempts := s.attempts[key]
+	attempts.LockedUntil = until
+	s.attempts[key] = attempts
+
+	return nil
+}
+
+func (s *MemoryStore) Reset(_ context.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.attempts, key)
+
+	return nil
+}
+
+func (s *MemoryStore) Purge(_ context.Context, failedBefore, now time.Time) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.purge(failedBefore, now), nil
+}
+
+func (s *MemoryStore) purge(failedBefore, now time.Time) int64 {
+	var purged int64
+	for key, attempts := range s.attempts {
+		if attempts.LastFailedAt.Before(failedBefore) && !attempts.LockedUntil.After(now) {
+			delete(s.attempts, key)
+			purged++
+		}
+	}
+	return purged
+}
@@ -0,0 +1,105 @@
+package lockout
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/fikryfahrezy/let-it-go/pkg/database"
+)
+
+// MySQLStore keeps attempts in the login_attempts table so every instance shares them
+type MySQLStore struct {
+	db *database.DB
+}
+
+func NewMySQLStore(db *database.DB) *MySQLStore {
+	return &MySQLStore{db: db}
+}
+
+func (s *MySQLStore) Get(ctx context.Context, key string) (Attempts, error) {
+	query := `
+		SELECT failures, last_failed_at, locked_until
+		FROM login_attempts
+		WHERE attempt_key = ?
+	`
+
+	var attempts Attempts
+	var lockedUntil sql.NullTime
+	err := s.db.QueryRowContext(ctx, query, key).Scan(&attempts.Failures, &attempts.LastFailedAt, &lockedUntil)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return Attempts{}, nil
+		}
+		return Attempts{}, fmt.Errorf("%w: %w", ErrFailedToAccessStore, err)
+	}
+	attempts.LockedUntil = lockedUntil.Time
+
+	return attempts, nil
+}
+
+func (s *MySQLStore) RecordFailure(ctx context.Context, key string, now time.Time, window time.Duration) (Attempts, error) {
+	// The failures assignment reads last_failed_at before it is overwritten
+	query := `
+		INSERT INTO login_attempts (attempt_key, failures, last_failed_at)
+		VALUES (?, 1, ?)
+		ON DUPLICATE KEY UPDATE
+			failures = IF(last_failed_at < ?, 1, failures + 1),
+			last_failed_at = ?
+	`
+
+	_, err := s.db.ExecContext(ctx, query, key, now, now.Add(-window), now)
+	if err != nil {
+		return Attempts{}, fmt.Errorf("%w: %w", ErrFailedToAccessStore, err)
+	}
+
+	return s.Get(ctx, key)
+}
+
+func (s *MySQLStore) Lock(ctx context.Context, key string, until time.Time) error {
+	query := `
+		UPDATE login_attempts
+		SET locked_until = ?
+		WHERE attempt_key = ?
+	`
+
+	if _, err := s.db.ExecContext(ctx, query, until, key); err != nil {
+		return fmt.Errorf("%w: %w", ErrFailedToAccessStore, err)
+	}
+
+	return nil
+}
+
+func (s *MySQLStore) Reset(ctx context.Context, key string) error {
+	query := `
+		DELETE FROM login_attempts
+		WHERE attempt_key = ?
+	`
+
+	if _, err := s.db.ExecContext(ctx, query, key); err != nil {
+		return fmt.Errorf("%w: %w", ErrFailedToAccessStore, err)
+	}
+
+	return nil
+}
+
+func (s *MySQLStore) Purge(ctx context.Context, failedBefore, now time.Time) (int64, error) {
+	query := `
+		DELETE FROM login_attempts
+		WHERE last_failed_at < ? AND (locked_until IS NULL OR locked_until <= ?)
+	`
+
+	result, err := s.db.ExecContext(ctx, query, failedBefore, now)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %w", ErrFailedToAccessStore, err)
+	}
+
+	purged, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("%w: %w", ErrFailedToAccessStore, err)
+	}
+
+	return purged, nil
+}
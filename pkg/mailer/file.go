@@ -0,0 +1,49 @@
+package mailer
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+)
+
+// FileMailer writes messages to a file or any writer instead of delivering them.
+// It is meant for local development and tests.
+type FileMailer struct {
+	mu   sync.Mutex
+	from string
+	w    io.Writer
+}
+
+// NewFileMailer appends messages to config.FilePath, or writes them to stdout when it is empty
+func NewFileMailer(config Config) (*FileMailer, error) {
+	if config.FilePath == "" {
+		return NewWriterMailer(config.From, os.Stdout), nil
+	}
+
+	f, err := os.OpenFile(config.FilePath, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open mail file: %w", err)
+	}
+
+	return NewWriterMailer(config.From, f), nil
+}
+
+// NewWriterMailer writes messages to w
+func NewWriterMailer(from string, w io.Writer) *FileMailer {
+	return &FileMailer{from: from, w: w}
+}
+
+func (m *FileMailer) Send(_ context.Context, message Message) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	content := strings.ReplaceAll(string(formatMessage(m.from, message)), "\r\n", "\n")
+	if _, err := fmt.Fprintf(m.w, "%s\n\n", content); err != nil {
+		return fmt.Errorf("%w: %w", ErrFailedToSendMail, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,53 @@
+package mailer
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/fikryfahrezy/let-it-go/pkg/app_error"
+)
+
+const (
+	DriverSMTP = "smtp"
+	DriverFile = "file"
+)
+
+var (
+	ErrFailedToSendMail  = app_error.New("MAILER-FAILED_TO_SEND_MAIL", "failed to send mail")
+	ErrUnsupportedDriver = app_error.New("MAILER-UNSUPPORTED_DRIVER", "unsupported mail driver")
+)
+
+type Config struct {
+	Driver       string
+	From         string
+	SMTPHost     string
+	SMTPPort     int
+	SMTPUsername string
+	SMTPPassword string
+	// FilePath is where the file driver appends messages; empty writes to stdout
+	FilePath string
+}
+
+// Message is a plain text email
+type Message struct {
+	To      string
+	Subject string
+	Body    string
+}
+
+// Mailer delivers email messages
+type Mailer interface {
+	Send(ctx context.Context, message Message) error
+}
+
+// New creates the mailer selected by config.Driver
+func New(config Config) (Mailer, error) {
+	switch config.Driver {
+	case DriverSMTP:
+		return NewSMTPMailer(config), nil
+	case DriverFile:
+		return NewFileMailer(config)
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedDriver, config.Driver)
+	}
+}
@@ -0,0 +1,59 @@
+package mailer
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/smtp"
+	"strconv"
+	"strings"
+)
+
+// SMTPMailer sends messages through an SMTP server, using STARTTLS when the server offers it
+type SMTPMailer struct {
+	addr string
+	from string
+	auth smtp.Auth
+}
+
+func NewSMTPMailer(config Config) *SMTPMailer {
+	m := &SMTPMailer{
+		addr: net.JoinHostPort(config.SMTPHost, strconv.Itoa(config.SMTPPort)),
+		from: config.From,
+	}
+	if config.SMTPUsername != "" {
+		m.auth = smtp.PlainAuth("", config.SMTPUsername, config.SMTPPassword, config.SMTPHost)
+	}
+	return m
+}
+
+func (m *SMTPMailer) Send(ctx context.Context, message Message) error {
+	if err := ctx.Err(); err != nil {
+		return fmt.Errorf("%w: %w", ErrFailedToSendMail, err)
+	}
+
+	err := smtp.SendMail(m.addr, m.auth, m.from, []string{message.To}, formatMessage(m.from, message))
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrFailedToSendMail, err)
+	}
+
+	return nil
+}
+
+// formatMessage renders the message as an RFC 5322 plain text email
+func formatMessage(from string, message Message) []byte {
+	var b strings.Builder
+	b.WriteString("From: " + headerValue(from) + "\r\n")
+	b.WriteString("To: " + headerValue(message.To) + "\r\n")
+	b.WriteString("Subject: " + headerValue(message.Subject) + "\r\n")
+	b.WriteString("MIME-Version: 1.0\r\n")
+	b.WriteString("Content-Type: text/plain; charset=UTF-8\r\n")
+	b.WriteString("\r\n")
+	b.WriteString(strings.ReplaceAll(message.Body, "\n", "\r\n"))
+	return []byte(b.String())
+}
+
+// headerValue strips line breaks so a value cannot inject extra headers
+func headerValue(value string) string {
+	return strings.NewReplacer("\r", "", "\n", "").Replace(value)
+}
@@ -0,0 +1,310 @@
+// Package oidc signs users in through an OpenID Connect provider with the
+// authorization code flow and PKCE (RFC 7636)
+package oidc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/fikryfahrezy/let-it-go/pkg/app_error"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+var (
+	ErrInvalidConfig   = app_error.New("OIDC-INVALID_CONFIG", "invalid openid connect configuration")
+	ErrDiscoveryFailed = app_error.New("OIDC-DISCOVERY_FAILED", "failed to discover openid connect provider")
+	ErrExchangeFailed  = app_error.New("OIDC-EXCHANGE_FAILED", "failed to exchange authorization code")
+	ErrInvalidIDToken  = app_error.New("OIDC-INVALID_ID_TOKEN", "invalid id token")
+)
+
+type Config struct {
+	// IssuerURL is where the provider publishes /.well-known/openid-configuration
+	IssuerURL    string
+	ClientID     string
+	ClientSecret string
+	// RedirectURL is the registered page the provider sends the user back to with the code
+	RedirectURL string
+	// Scopes requested besides openid, which is always requested
+	Scopes []string
+}
+
+// Claims are the identity claims of a verified ID token
+type Claims struct {
+	Subject       string
+	Email         string
+	EmailVerified bool
+	Name          string
+}
+
+// metadata is the part of the discovery document the flow needs
+type metadata struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	JWKSURI               string `json:"jwks_uri"`
+}
+
+// idTokenClaims are the claims read from an ID token
+type idTokenClaims struct {
+	Nonce         string `json:"nonce"`
+	Email         string `json:"email"`
+	EmailVerified any    `json:"email_verified"` // Some providers send "true" as a string
+	Name          string `json:"name"`
+	jwt.RegisteredClaims
+}
+
+// Provider talks to one OpenID Connect provider. The discovery document and the
+// signing keys are fetched on first use and cached, so the application starts even
+// while the provider is unreachable.
+type Provider struct {
+	config Config
+	client *http.Client
+
+	mu       sync.Mutex
+	metadata *metadata
+	keys     map[string]any // Signing keys by key ID
+}
+
+// NewProvider creates a provider client. A nil client uses a client with a 10 second timeout.
+func NewProvider(config Config, client *http.Client) (*Provider, error) {
+	if config.IssuerURL == "" || config.ClientID == "" || config.RedirectURL == "" {
+		return nil, fmt.Errorf("%w: issuer URL, client ID and redirect URL are required", ErrInvalidConfig)
+	}
+	if client == nil {
+		client = &http.Client{Timeout: 10 * time.Second}
+	}
+
+	return &Provider{
+		config: config,
+		client: client,
+	}, nil
+}
+
+// Issuer identifies the provider; together with a subject it identifies an external account
+func (p *Provider) Issuer() string {
+	return strings.TrimSuffix(p.config.IssuerURL, "/")
+}
+
+// AuthCodeURL returns the provider page the user is sent to. state and nonce are
+// echoed back and bind the response to this login; codeChallenge is the S256 PKCE
+// challenge of the verifier later passed to Exchange.
+func (p *Provider) AuthCodeURL(ctx context.Context, state, nonce, codeChallenge string) (string, error) {
+	md, err := p.discover(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	scopes := []string{"openid"}
+	for _, scope := range p.config.Scopes {
+		if !slices.Contains(scopes, scope) {
+			scopes = append(scopes, scope)
+		}
+	}
+
+	query := url.Values{}
+	query.Set("response_type", "code")
+	query.Set("client_id", p.config.ClientID)
+	query.Set("redirect_uri", p.config.RedirectURL)
+	query.Set("scope", strings.Join(scopes, " "))
+	query.Set("state", state)
+	query.Set("nonce", nonce)
+	query.Set("code_challenge", codeChallenge)
+	query.Set("code_challenge_method", "S256")
+
+	separator := "?"
+	if strings.Contains(md.AuthorizationEndpoint, "?") {
+		separator = "&"
+	}
+	return md.AuthorizationEndpoint + separator + query.Encode(), nil
+}
+
+// Exchange redeems an authorization code with its PKCE verifier and returns the claims
+// of the verified ID token, which must carry the nonce of the login
+func (p *Provider) Exchange(ctx context.Context, code, codeVerifier, nonce string) (Claims, error) {
+	md, err := p.discover(ctx)
+	if err != nil {
+		return Claims{}, err
+	}
+
+	form := url.Values{}
+	form.Set("grant_type", "authorization_code")
+	form.Set("code", code)
+	form.Set("redirect_uri", p.config.RedirectURL)
+	form.Set("code_verifier", codeVerifier)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, md.TokenEndpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return Claims{}, fmt.Errorf("%w: %w", ErrExchangeFailed, err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	// client_secret_basic, the default client authentication method of the specification
+	req.SetBasicAuth(url.QueryEscape(p.config.ClientID), url.QueryEscape(p.config.ClientSecret))
+
+	var tokenResponse struct {
+		IDToken          string `json:"id_token"`
+		Error            string `json:"error"`
+		ErrorDescription string `json:"error_description"`
+	}
+	status, err := p.doJSON(req, &tokenResponse)
+	if err != nil {
+		return Claims{}, fmt.Errorf("%w: %w", ErrExchangeFailed, err)
+	}
+	if status != http.StatusOK {
+		return Claims{}, fmt.Errorf("%w: status %d: %s %s", ErrExchangeFailed, status, tokenResponse.Error, tokenResponse.ErrorDescription)
+	}
+	if tokenResponse.IDToken == "" {
+		return Claims{}, fmt.Errorf("%w: token response has no id_token", ErrExchangeFailed)
+	}
+
+	return p.verifyIDToken(ctx, md, tokenResponse.IDToken, nonce)
+}
+
+// verifyIDToken checks the signature, issuer, audience, expiry and nonce of an ID token
+func (p *Provider) verifyIDToken(ctx context.Context, md *metadata, rawIDToken, nonce string) (Claims, error) {
+	var claims idTokenClaims
+	_, err := jwt.ParseWithClaims(rawIDToken, &claims, func(t *jwt.Token) (any, error) {
+		keyID, _ := t.Header["kid"].(string)
+		return p.signingKey(ctx, md, keyID)
+	},
+		jwt.WithValidMethods([]string{"RS256", "RS384", "RS512", "ES256", "ES384", "ES512"}),
+		jwt.WithIssuer(md.Issuer),
+		jwt.WithAudience(p.config.ClientID),
+		jwt.WithExpirationRequired(),
+		jwt.WithLeeway(time.Minute),
+	)
+	if err != nil {
+		return Claims{}, fmt.Errorf("%w: %w", ErrInvalidIDToken, err)
+	}
+
+	if claims.Nonce != nonce {
+		return Claims{}, fmt.Errorf("%w: nonce mismatch", ErrInvalidIDToken)
+	}
+	if claims.Subject == "" {
+		return Claims{}, fmt.Errorf("%w: missing subject", ErrInvalidIDToken)
+	}
+
+	emailVerified := false
+	switch v := claims.EmailVerified.(type) {
+	case bool:
+		emailVerified = v
+	case string:
+		emailVerified = v == "true"
+	}
+
+	return Claims{
+		Subject:       claims.Subject,
+		Email:         claims.Email,
+		EmailVerified: emailVerified,
+		Name:          claims.Name,
+	}, nil
+}
+
+// discover fetches and caches the discovery document
+func (p *Provider) discover(ctx context.Context) (*metadata, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.metadata != nil {
+		return p.metadata, nil
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, p.Issuer()+"/.well-known/openid-configuration", nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrDiscoveryFailed, err)
+	}
+
+	var md metadata
+	status, err := p.doJSON(req, &md)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrDiscoveryFailed, err)
+	}
+	if status != http.StatusOK {
+		return nil, fmt.Errorf("%w: status %d", ErrDiscoveryFailed, status)
+	}
+
+	// The specification requires the document to name the issuer it was fetched from
+	if strings.TrimSuffix(md.Issuer, "/") != p.Issuer() {
+		return nil, fmt.Errorf("%w: issuer %q does not match %q", ErrDiscoveryFailed, md.Issuer, p.Issuer())
+	}
+	if md.AuthorizationEndpoint == "" || md.TokenEndpoint == "" || md.JWKSURI == "" {
+		return nil, fmt.Errorf("%w: discovery document is missing endpoints", ErrDiscoveryFailed)
+	}
+
+	p.metadata = &md
+	return p.metadata, nil
+}
+
+// signingKey returns the key with the given ID, refetching the key set once when the
+// ID is unknown because the provider may have rotated its keys
+func (p *Provider) signingKey(ctx context.Context, md *metadata, keyID string) (any, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if key, ok := p.lookupKey(keyID); ok {
+		return key, nil
+	}
+
+	keys, err := p.fetchKeys(ctx, md.JWKSURI)
+	if err != nil {
+		return nil, err
+	}
+	p.keys = keys
+
+	if key, ok := p.lookupKey(keyID); ok {
+		return key, nil
+	}
+	return nil, errors.New("unknown signing key " + keyID)
+}
+
+// lookupKey finds a cached key; a token without a key ID matches when there is a single key
+func (p *Provider) lookupKey(keyID string) (any, bool) {
+	if keyID == "" && len(p.keys) == 1 {
+		for _, key := range p.keys {
+			return key, true
+		}
+	}
+	key, ok := p.keys[keyID]
+	return key, ok
+}
+
+func (p *Provider) fetchKeys(ctx context.Context, jwksURI string) (map[string]any, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, jwksURI, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var keySet jsonWebKeySet
+	status, err := p.doJSON(req, &keySet)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch signing keys: %w", err)
+	}
+	if status != http.StatusOK {
+		return nil, fmt.Errorf("failed to fetch signing keys: status %d", status)
+	}
+
+	return keySet.publicKeys(), nil
+}
+
+// doJSON sends the request and decodes the JSON body whatever the status, so error responses can be reported
+func (p *Provider) doJSON(req *http.Request, v any) (int, error) {
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	// nolint:errcheck
+	defer resp.Body.Close()
+
+	if err := json.NewDecoder(resp.Body).Decode(v); err != nil {
+		return resp.StatusCode, fmt.Errorf("failed to decode response: %w", err)
+	}
+	return resp.StatusCode, nil
+}
@@ -0,0 +1,118 @@
+package rbac
+
+import (
+	"slices"
+
+	"github.com/fikryfahrezy/let-it-go/pkg/http_server"
+	"github.com/labstack/echo/v4"
+)
+
+// Roles a user can have. Every user has exactly one.
+const (
+	RoleAdmin  = "admin"
+	RoleEditor = "editor"
+	RoleAuthor = "author"
+	RoleReader = "reader"
+)
+
+// DefaultRole is the role new users sign up with
+const DefaultRole = RoleAuthor
+
+// Permission is an action a role is allowed to take
+type Permission string
+
+const (
+	// PermissionUsersManage allows updating and deleting any user and assigning roles
+	PermissionUsersManage Permission = "users:manage"
+	// PermissionBlogsManage allows updating and deleting any blog
+	PermissionBlogsManage Permission = "blogs:manage"
+	// PermissionBlogsPublish allows publishing and archiving any blog
+	PermissionBlogsPublish Permission = "blogs:publish"
+	// PermissionBlogsWrite allows creating blogs and changing one's own drafts
+	PermissionBlogsWrite Permission = "blogs:write"
+	// PermissionCommentsModerate allows approving and rejecting comments on any blog
+	PermissionCommentsModerate Permission = "comments:moderate"
+)
+
+// Scopes a personal access token can be restricted to. Scoped tokens still act with
+// the permissions of their owner's role; a scope only narrows which routes accept them.
+const (
+	// ScopeBlogsWrite allows creating, changing, publishing and archiving blogs
+	ScopeBlogsWrite = "blogs:write"
+	// ScopeUsersRead allows listing and reading users
+	ScopeUsersRead = "users:read"
+)
+
+// rolePermissions lists the permissions of every role, ordered from most to least privileged
+var rolePermissions = []struct {
+	role        string
+	permissions []Permission
+}{
+	{role: RoleAdmin, permissions: []Permission{PermissionUsersManage, PermissionBlogsManage, PermissionBlogsPublish, PermissionBlogsWrite, PermissionCommentsModerate}},
+	{role: RoleEditor, permissions: []Permission{PermissionBlogsPublish, PermissionBlogsWrite, PermissionCommentsModerate}},
+	{role: RoleAuthor, permissions: []Permission{PermissionBlogsWrite}},
+	{role: RoleReader, permissions: []Permission{}},
+}
+
+// Roles returns every known role, ordered from most to least privileged
+func Roles() []string {
+	roles := make([]string, 0, len(rolePermissions))
+	for _, rp := range rolePermissions {
+		roles = append(roles, rp.role)
+	}
+	return roles
+}
+
+// IsValidRole reports whether role is one of the known roles
+func IsValidRole(role string) bool {
+	return slices.Contains(Roles(), role)
+}
+
+// Scopes returns every scope a personal access token can be restricted to
+func Scopes() []string {
+	return []string{ScopeBlogsWrite, ScopeUsersRead}
+}
+
+// IsValidScope reports whether scope is one of the known scopes
+func IsValidScope(scope string) bool {
+	return slices.Contains(Scopes(), scope)
+}
+
+// Permissions returns the permissions granted to role, or nil for an unknown role
+func Permissions(role string) []Permission {
+	for _, rp := range rolePermissions {
+		if rp.role == role {
+			return slices.Clone(rp.permissions)
+		}
+	}
+	return nil
+}
+
+// Can reports whether any of the principal's roles grants the permission. Principals who
+// still have to enroll a second factor their role requires are granted none.
+func Can(principal http_server.Principal, permission Permission) bool {
+	if principal.TwoFactorSetupRequired {
+		return false
+	}
+	for _, role := range principal.Roles {
+		if slices.Contains(Permissions(role), permission) {
+			return true
+		}
+	}
+	return false
+}
+
+// RequirePermission marks a route as protected and restricted to principals whose role grants
+// the permission. Unauthenticated requests get 401 and authenticated ones without it get 403.
+func RequirePermission(permission Permission) echo.MiddlewareFunc {
+	requireAuth := http_server.RequireAuth()
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return requireAuth(func(c echo.Context) error {
+			principal, _ := http_server.PrincipalFromContext(c.Request().Context())
+			if !Can(principal, permission) {
+				return http_server.ForbiddenResponse(c, "You do not have permission to access this resource", http_server.ErrForbidden)
+			}
+			return next(c)
+		})
+	}
+}
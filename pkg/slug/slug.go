@@ -0,0 +1,47 @@
+// Package slug turns titles into URL friendly identifiers such as "hello-world".
+package slug
+
+import (
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+const (
+	// MaxLength keeps room for a collision suffix within the 255 characters of the column
+	MaxLength = 200
+	// Fallback is used when a title has no characters that can be part of a slug
+	Fallback = "blog"
+)
+
+// Make lowercases the title, strips accents and joins the remaining runs of ASCII
+// letters and digits with single hyphens.
+func Make(title string) string {
+	var b strings.Builder
+	pendingHyphen := false
+	for _, r := range norm.NFD.String(strings.ToLower(title)) {
+		switch {
+		case unicode.Is(unicode.Mn, r):
+			// Combining marks left over from decomposing accented letters
+			continue
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9':
+			if pendingHyphen && b.Len() > 0 {
+				b.WriteByte('-')
+			}
+			pendingHyphen = false
+			b.WriteRune(r)
+		default:
+			pendingHyphen = true
+		}
+	}
+
+	s := b.String()
+	if len(s) > MaxLength {
+		s = strings.TrimRight(s[:MaxLength], "-")
+	}
+	if s == "" {
+		return Fallback
+	}
+	return s
+}
@@ -0,0 +1,41 @@
+package token
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+)
+
+// GenerateOpaque returns a random URL-safe token built from byteLength random bytes.
+// Opaque tokens are handed to clients once and only their Hash is stored.
+func GenerateOpaque(byteLength int) (string, error) {
+	b := make([]byte, byteLength)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate random token: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// Hash returns the hex encoded SHA-256 digest of an opaque token for storage and lookup
+func Hash(raw string) string {
+	sum := sha256.Sum256([]byte(raw))
+	return hex.EncodeToString(sum[:])
+}
+
+// Link appends an opaque token to baseURL as the token query parameter, building the
+// links emailed to users
+func Link(baseURL string, rawToken string) string {
+	link, err := url.Parse(baseURL)
+	if err != nil {
+		return baseURL + "?token=" + url.QueryEscape(rawToken)
+	}
+
+	query := link.Query()
+	query.Set("token", rawToken)
+	link.RawQuery = query.Encode()
+
+	return link.String()
+}
@@ -0,0 +1,256 @@
+package token
+
+import (
+	"context"
+	"crypto/ed25519"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/fikryfahrezy/let-it-go/pkg/app_error"
+	"github.com/fikryfahrezy/let-it-go/pkg/http_server"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+)
+
+const (
+	AlgorithmHS256 = "HS256"
+	AlgorithmEdDSA = "EdDSA"
+)
+
+var (
+	ErrInvalidToken         = app_error.New("TOKEN-INVALID_TOKEN", "invalid token")
+	ErrExpiredToken         = app_error.New("TOKEN-EXPIRED_TOKEN", "token has expired")
+	ErrFailedToSignToken    = app_error.New("TOKEN-FAILED_TO_SIGN_TOKEN", "failed to sign token")
+	ErrUnsupportedAlgorithm = app_error.New("TOKEN-UNSUPPORTED_ALGORITHM", "unsupported signing algorithm")
+	ErrInvalidSigningKeys   = app_error.New("TOKEN-INVALID_SIGNING_KEYS", "invalid signing keys")
+)
+
+type Config struct {
+	Issuer          string
+	AccessTokenTTL  time.Duration
+	RefreshTokenTTL time.Duration
+	Algorithm       string
+	HMACSecret      string
+	// Ed25519 keys are base64 encoded. The private key may be either the
+	// 32 byte seed or the 64 byte private key; the public key is derived
+	// from it when left empty.
+	Ed25519PrivateKey string
+	Ed25519PublicKey  string
+}
+
+// Subject describes who an access token is issued for
+type Subject struct {
+	UserID    uuid.UUID
+	Email     string
+	Roles     []string
+	SessionID uuid.UUID
+	// TwoFactorSetupRequired restricts the token until the subject enrolls a second factor
+	TwoFactorSetupRequired bool
+}
+
+// Impersonation describes an admin acting as the subject of an access token
+type Impersonation struct {
+	ID             uuid.UUID // The impersonation session the token is issued for
+	ImpersonatorID uuid.UUID
+	TTL            time.Duration
+}
+
+// Claims are the JWT claims carried by an access token
+type Claims struct {
+	Email     string   `json:"email,omitempty"`
+	Roles     []string `json:"roles,omitempty"`
+	SessionID string   `json:"sid,omitempty"`
+	Actor     *Actor   `json:"act,omitempty"`
+	// TwoFactorSetupRequired marks a token restricted until its subject enrolls a second factor
+	TwoFactorSetupRequired bool `json:"tfs,omitempty"`
+	jwt.RegisteredClaims
+}
+
+// Actor is who acts as the subject of an impersonation token, following the act claim of RFC 8693
+type Actor struct {
+	Subject         string `json:"sub"`
+	ImpersonationID string `json:"imp"`
+}
+
+// Manager issues and verifies signed access tokens
+type Manager struct {
+	config    Config
+	method    jwt.SigningMethod
+	signKey   any
+	verifyKey any
+}
+
+func NewManager(config Config) (*Manager, error) {
+	m := &Manager{config: config}
+
+	switch config.Algorithm {
+	case AlgorithmHS256:
+		if config.HMACSecret == "" {
+			return nil, fmt.Errorf("%w: HMAC secret is required", ErrInvalidSigningKeys)
+		}
+		m.method = jwt.SigningMethodHS256
+		m.signKey = []byte(config.HMACSecret)
+		m.verifyKey = []byte(config.HMACSecret)
+	case AlgorithmEdDSA:
+		privateKey, publicKey, err := parseEd25519Keys(config.Ed25519PrivateKey, config.Ed25519PublicKey)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidSigningKeys, err)
+		}
+		m.method = jwt.SigningMethodEdDSA
+		m.signKey = privateKey
+		m.verifyKey = publicKey
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedAlgorithm, config.Algorithm)
+	}
+
+	return m, nil
+}
+
+// RefreshTokenTTL is how long a refresh token stays valid after it is issued
+func (m *Manager) RefreshTokenTTL() time.Duration {
+	return m.config.RefreshTokenTTL
+}
+
+// IssueAccessToken signs a new access token for the given subject
+func (m *Manager) IssueAccessToken(subject Subject) (string, time.Time, error) {
+	return m.issue(subject, nil, m.config.AccessTokenTTL)
+}
+
+// IssueImpersonationToken signs an access token that lets an admin act as the subject.
+// It expires after the impersonation's TTL and has no refresh token.
+func (m *Manager) IssueImpersonationToken(subject Subject, impersonation Impersonation) (string, time.Time, error) {
+	actor := &Actor{
+		Subject:         impersonation.ImpersonatorID.String(),
+		ImpersonationID: impersonation.ID.String(),
+	}
+	return m.issue(subject, actor, impersonation.TTL)
+}
+
+func (m *Manager) issue(subject Subject, actor *Actor, ttl time.Duration) (string, time.Time, error) {
+	now := time.Now()
+	expiresAt := now.Add(ttl)
+
+	claims := Claims{
+		Email:                  subject.Email,
+		Roles:                  subject.Roles,
+		Actor:                  actor,
+		TwoFactorSetupRequired: subject.TwoFactorSetupRequired,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        uuid.NewString(),
+			Issuer:    m.config.Issuer,
+			Subject:   subject.UserID.String(),
+			IssuedAt:  jwt.NewNumericDate(now),
+			NotBefore: jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(expiresAt),
+		},
+	}
+	if subject.SessionID != uuid.Nil {
+		claims.SessionID = subject.SessionID.String()
+	}
+
+	signed, err := jwt.NewWithClaims(m.method, claims).SignedString(m.signKey)
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("%w: %w", ErrFailedToSignToken, err)
+	}
+
+	return signed, expiresAt, nil
+}
+
+// Verify parses the token, checks its signature, issuer and expiry and returns its claims
+func (m *Manager) Verify(tokenString string) (Claims, error) {
+	var claims Claims
+	_, err := jwt.ParseWithClaims(tokenString, &claims, func(t *jwt.Token) (any, error) {
+		return m.verifyKey, nil
+	},
+		jwt.WithValidMethods([]string{m.method.Alg()}),
+		jwt.WithIssuer(m.config.Issuer),
+		jwt.WithExpirationRequired(),
+	)
+	if err != nil {
+		if errors.Is(err, jwt.ErrTokenExpired) {
+			return Claims{}, ErrExpiredToken
+		}
+		return Claims{}, fmt.Errorf("%w: %w", ErrInvalidToken, err)
+	}
+
+	return claims, nil
+}
+
+// Authenticate implements http_server.Authenticator for access tokens issued by the manager
+func (m *Manager) Authenticate(_ context.Context, tokenString string) (http_server.Principal, error) {
+	claims, err := m.Verify(tokenString)
+	if err != nil {
+		if errors.Is(err, ErrExpiredToken) {
+			return http_server.Principal{}, ErrExpiredToken
+		}
+		return http_server.Principal{}, ErrInvalidToken
+	}
+
+	userID, err := uuid.Parse(claims.Subject)
+	if err != nil {
+		return http_server.Principal{}, ErrInvalidToken
+	}
+
+	principal := http_server.Principal{
+		UserID:                 userID,
+		Roles:                  claims.Roles,
+		TwoFactorSetupRequired: claims.TwoFactorSetupRequired,
+	}
+	if claims.SessionID != "" {
+		sessionID, err := uuid.Parse(claims.SessionID)
+		if err != nil {
+			return http_server.Principal{}, ErrInvalidToken
+		}
+		principal.SessionID = sessionID
+	}
+	if claims.Actor != nil {
+		impersonatorID, err := uuid.Parse(claims.Actor.Subject)
+		if err != nil {
+			return http_server.Principal{}, ErrInvalidToken
+		}
+		impersonationID, err := uuid.Parse(claims.Actor.ImpersonationID)
+		if err != nil {
+			return http_server.Principal{}, ErrInvalidToken
+		}
+		principal.ImpersonatorID = impersonatorID
+		principal.ImpersonationID = impersonationID
+	}
+
+	return principal, nil
+}
+
+func parseEd25519Keys(privateKeyB64, publicKeyB64 string) (ed25519.PrivateKey, ed25519.PublicKey, error) {
+	if privateKeyB64 == "" {
+		return nil, nil, fmt.Errorf("ed25519 private key is required")
+	}
+
+	raw, err := base64.StdEncoding.DecodeString(privateKeyB64)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to decode ed25519 private key: %w", err)
+	}
+
+	var privateKey ed25519.PrivateKey
+	switch len(raw) {
+	case ed25519.SeedSize:
+		privateKey = ed25519.NewKeyFromSeed(raw)
+	case ed25519.PrivateKeySize:
+		privateKey = ed25519.PrivateKey(raw)
+	default:
+		return nil, nil, fmt.Errorf("ed25519 private key must be %d or %d bytes", ed25519.SeedSize, ed25519.PrivateKeySize)
+	}
+
+	publicKey := privateKey.Public().(ed25519.PublicKey)
+	if publicKeyB64 != "" {
+		rawPublic, err := base64.StdEncoding.DecodeString(publicKeyB64)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to decode ed25519 public key: %w", err)
+		}
+		if len(rawPublic) != ed25519.PublicKeySize || !publicKey.Equal(ed25519.PublicKey(rawPublic)) {
+			return nil, nil, fmt.Errorf("ed25519 public key does not match private key")
+		}
+	}
+
+	return privateKey, publicKey, nil
+}